@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/downloader"
+)
+
+func runDownload(args []string) error {
+	var (
+		merchant                 merchantFlags
+		mchAPIv3Key              string
+		wechatPayCertificatePath string
+		outputPath               string
+	)
+
+	fs := newFlagSet("download")
+	merchant.register(fs)
+	fs.StringVar(&mchAPIv3Key, "k", "", "【必传】`商户APIv3密钥`")
+	fs.StringVar(&wechatPayCertificatePath, "c", "", "【可选】`平台证书路径`，用于验签，多个证书以逗号分隔。省略则跳过验签")
+	fs.StringVar(&outputPath, "o", "./", "【可选】`证书下载保存目录`")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := merchant.check(); err != nil {
+		return err
+	}
+	if mchAPIv3Key == "" {
+		return paramError{"商户APIv3密钥", mchAPIv3Key, "必传"}
+	}
+	if err := os.MkdirAll(outputPath, os.ModePerm); err != nil {
+		return paramError{"证书下载保存目录", outputPath, fmt.Sprintf("创建失败：%v", err)}
+	}
+
+	certificates, err := loadCertificates(wechatPayCertificatePath)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client, err := merchant.createClient(ctx, certificates)
+	if err != nil {
+		return err
+	}
+
+	d, err := downloader.NewCertificateDownloaderWithClient(ctx, client, mchAPIv3Key)
+	if err != nil {
+		return fmt.Errorf("下载证书失败：%v", err)
+	}
+
+	for serialNo, certContent := range d.ExportAll(ctx) {
+		outputFilePath := filepath.Join(outputPath, fmt.Sprintf("wechatpay_%v.pem", serialNo))
+		if err = writeFile(outputFilePath, certContent+"\n"); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(stdout, "写入证书到`%v`成功\n", outputFilePath)
+	}
+
+	var certificateList []namedCertificate
+	for serialNo, certificate := range d.GetAll(ctx) {
+		certificateList = append(certificateList, namedCertificate{name: serialNo, certificate: certificate})
+	}
+	printCertificates(certificateList)
+	return nil
+}
+
+func writeFile(path, content string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("创建文件`%v`失败：%v", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if _, err = f.WriteString(content); err != nil {
+		return fmt.Errorf("写入文件`%v`失败：%v", path, err)
+	}
+	return nil
+}
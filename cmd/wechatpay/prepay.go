@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+)
+
+func runPrepay(args []string) error {
+	var (
+		merchant                 merchantFlags
+		wechatPayCertificatePath string
+		appID                    string
+		description              string
+		outTradeNo               string
+		notifyURL                string
+		total                    int64
+	)
+
+	fs := newFlagSet("prepay")
+	merchant.register(fs)
+	fs.StringVar(&wechatPayCertificatePath, "c", "", "【可选】`平台证书路径`，用于验签，多个证书以逗号分隔。省略则跳过验签")
+	fs.StringVar(&appID, "a", "", "【必传】`应用ID`")
+	fs.StringVar(&description, "desc", "测试商品", "【可选】`商品描述`")
+	fs.StringVar(&outTradeNo, "no", "", "【可选】`商户订单号`，省略则根据当前时间生成")
+	fs.StringVar(&notifyURL, "notify", "https://www.weixin.qq.com/wxpay/pay.php", "【可选】`通知地址`")
+	fs.Int64Var(&total, "total", 1, "【可选】`订单金额`，单位为分")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := merchant.check(); err != nil {
+		return err
+	}
+	if appID == "" {
+		return paramError{"应用ID", appID, "必传"}
+	}
+	if total <= 0 {
+		return paramError{"订单金额", fmt.Sprint(total), "必须大于0"}
+	}
+	if outTradeNo == "" {
+		outTradeNo = "TEST" + time.Now().Format("20060102150405")
+	}
+
+	certificates, err := loadCertificates(wechatPayCertificatePath)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client, err := merchant.createClient(ctx, certificates)
+	if err != nil {
+		return err
+	}
+
+	svc := native.NativeApiService{Client: client}
+	req := native.NewPrepayRequestBuilder(appID, merchant.mchID, description, outTradeNo, notifyURL, total).Build()
+	resp, result, err := svc.Prepay(ctx, req)
+	if result != nil && result.Response != nil {
+		_, _ = fmt.Fprintf(stdout, "Request-Id：%v\n", result.Response.Header.Get(consts.RequestID))
+	}
+	if err != nil {
+		return fmt.Errorf("下单失败：%v", err)
+	}
+
+	_, _ = fmt.Fprintf(stdout, "商户订单号：%v\n", outTradeNo)
+	if resp.CodeUrl != nil {
+		_, _ = fmt.Fprintf(stdout, "二维码链接：%v\n", *resp.CodeUrl)
+	}
+	return nil
+}
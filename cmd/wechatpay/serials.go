@@ -0,0 +1,100 @@
+package main
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+type namedCertificate struct {
+	name        string
+	certificate *x509.Certificate
+}
+
+func runSerials(args []string) error {
+	var (
+		certificatePaths string
+		certificateDir   string
+	)
+
+	fs := newFlagSet("serials")
+	fs.StringVar(&certificatePaths, "c", "", "【可选】`证书路径`，多个证书以逗号分隔")
+	fs.StringVar(&certificateDir, "d", "", "【可选】`证书目录`，读取目录下所有 .pem 文件")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if certificatePaths == "" && certificateDir == "" {
+		return paramError{"证书路径/证书目录", "", "至少传入一个"}
+	}
+
+	paths := strings.Split(certificatePaths, ",")
+	if certificateDir != "" {
+		files, err := ioutil.ReadDir(certificateDir)
+		if err != nil {
+			return paramError{"证书目录", certificateDir, fmt.Sprintf("有误：%v", err)}
+		}
+		for _, file := range files {
+			if !file.IsDir() && strings.HasSuffix(file.Name(), ".pem") {
+				paths = append(paths, filepath.Join(certificateDir, file.Name()))
+			}
+		}
+	}
+
+	var certificates []namedCertificate
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		certificate, err := utils.LoadCertificateWithPath(path)
+		if err != nil {
+			// 目录中可能包含私钥等非证书文件，跳过的同时给出提示
+			reportError("跳过`%v`：%v", path, err)
+			continue
+		}
+		certificates = append(certificates, namedCertificate{name: path, certificate: certificate})
+	}
+
+	if len(certificates) == 0 {
+		return fmt.Errorf("未找到有效的证书")
+	}
+	printCertificates(certificates)
+	return nil
+}
+
+// printCertificates 按失效时间从晚到早打印证书的序列号与有效期
+func printCertificates(certificates []namedCertificate) {
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].certificate.NotAfter.After(certificates[j].certificate.NotAfter)
+	})
+
+	now := time.Now()
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "证书\t序列号\t生效时间\t失效时间\t状态")
+	for _, c := range certificates {
+		_, _ = fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n",
+			c.name, utils.GetCertificateSerialNumber(*c.certificate),
+			c.certificate.NotBefore.Local().Format(time.RFC3339),
+			c.certificate.NotAfter.Local().Format(time.RFC3339),
+			certificateStatus(c.certificate, now))
+	}
+	_ = w.Flush()
+}
+
+func certificateStatus(certificate *x509.Certificate, now time.Time) string {
+	switch {
+	case utils.IsCertExpired(*certificate, now):
+		return "已过期"
+	case !utils.IsCertValid(*certificate, now):
+		return "未生效"
+	}
+	return fmt.Sprintf("有效，剩余 %d 天", int(certificate.NotAfter.Sub(now).Hours()/24))
+}
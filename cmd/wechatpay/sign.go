@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/signers"
+)
+
+func runSign(args []string) error {
+	var (
+		merchant    merchantFlags
+		message     string
+		messagePath string
+	)
+
+	fs := newFlagSet("sign")
+	merchant.register(fs)
+	fs.StringVar(&message, "msg", "", "【可选】`待签名消息`")
+	fs.StringVar(&messagePath, "f", "", "【可选】`待签名消息文件路径`。-msg 与 -f 均省略时从标准输入读取")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if err := merchant.check(); err != nil {
+		return err
+	}
+
+	if message == "" {
+		content, err := readMessage(messagePath)
+		if err != nil {
+			return err
+		}
+		message = content
+	}
+
+	privateKey, err := merchant.privateKey()
+	if err != nil {
+		return err
+	}
+	signer := &signers.SHA256WithRSASigner{
+		MchID:               merchant.mchID,
+		CertificateSerialNo: merchant.mchSerialNo,
+		PrivateKey:          privateKey,
+	}
+
+	result, err := signer.Sign(context.Background(), message)
+	if err != nil {
+		return fmt.Errorf("签名失败：%v", err)
+	}
+
+	// 使用 %q 输出签名原文，便于比对换行符等不可见字符
+	_, _ = fmt.Fprintf(stdout, "签名原文：%q\n", message)
+	_, _ = fmt.Fprintf(stdout, "签名算法：%v\n", signer.Algorithm())
+	_, _ = fmt.Fprintf(stdout, "商户证书序列号：%v\n", result.CertificateSerialNo)
+	_, _ = fmt.Fprintf(stdout, "签名值：%v\n", result.Signature)
+	return nil
+}
+
+func readMessage(path string) (string, error) {
+	if path == "" {
+		content, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("读取标准输入失败：%v", err)
+		}
+		return string(content), nil
+	}
+
+	if err := checkFile("待签名消息文件路径", path); err != nil {
+		return "", err
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("读取`%v`失败：%v", path, err)
+	}
+	return string(content), nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/verifiers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+func runVerify(args []string) error {
+	var (
+		requestPath              string
+		wechatPayCertificatePath string
+		mchAPIv3Key              string
+	)
+
+	fs := newFlagSet("verify")
+	fs.StringVar(&requestPath, "f", "", "【必传】`回调通知请求文件路径`，内容为抓取到的完整 HTTP 请求报文（包括请求行、请求头与请求体）")
+	fs.StringVar(&wechatPayCertificatePath, "c", "", "【必传】`平台证书路径`，多个证书以逗号分隔")
+	fs.StringVar(&mchAPIv3Key, "k", "", "【可选】`商户APIv3密钥`，传入时解密并输出通知内容")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if requestPath == "" {
+		return paramError{"回调通知请求文件路径", requestPath, "必传"}
+	}
+	if err := checkFile("回调通知请求文件路径", requestPath); err != nil {
+		return err
+	}
+	if wechatPayCertificatePath == "" {
+		return paramError{"平台证书路径", wechatPayCertificatePath, "必传"}
+	}
+	certificates, err := loadCertificates(wechatPayCertificatePath)
+	if err != nil {
+		return err
+	}
+
+	header, body, err := readCapturedRequest(requestPath)
+	if err != nil {
+		return err
+	}
+
+	serialNo := strings.TrimSpace(header.Get(consts.WechatPaySerial))
+	signature := strings.TrimSpace(header.Get(consts.WechatPaySignature))
+	nonce := strings.TrimSpace(header.Get(consts.WechatPayNonce))
+	timestamp, err := strconv.ParseInt(strings.TrimSpace(header.Get(consts.WechatPayTimestamp)), 10, 64)
+	if err != nil {
+		return fmt.Errorf("请求头 %v 有误：%v", consts.WechatPayTimestamp, err)
+	}
+
+	// 抓取的通知通常已超过 5 分钟的有效期，这里直接验证签名，仅对时间戳给出提示
+	message := fmt.Sprintf("%d\n%s\n%s\n", timestamp, nonce, string(body))
+	_, _ = fmt.Fprintf(stdout, "平台证书序列号：%v\n", serialNo)
+	_, _ = fmt.Fprintf(stdout, "验签原文：%q\n", message)
+	if math.Abs(float64(timestamp-time.Now().Unix())) >= consts.FiveMinute {
+		_, _ = fmt.Fprintf(stdout, "提示：通知时间戳 %v 与当前时间相差超过 5 分钟，SDK 将拒绝该通知\n",
+			time.Unix(timestamp, 0).Format(time.RFC3339))
+	}
+
+	verifier := verifiers.NewSHA256WithRSAVerifier(core.NewCertificateMapWithList(certificates))
+	if err = verifier.Verify(context.Background(), serialNo, message, signature); err != nil {
+		return fmt.Errorf("验签失败：%v", err)
+	}
+	_, _ = fmt.Fprintln(stdout, "验签成功")
+
+	if mchAPIv3Key == "" {
+		return nil
+	}
+
+	ret := new(notify.Request)
+	if err = json.Unmarshal(body, ret); err != nil {
+		return fmt.Errorf("解析通知请求失败：%v", err)
+	}
+	if ret.Resource == nil {
+		return fmt.Errorf("通知请求中缺少 resource")
+	}
+	plaintext, err := utils.DecryptAES256GCM(
+		mchAPIv3Key, ret.Resource.AssociatedData, ret.Resource.Nonce, ret.Resource.Ciphertext,
+	)
+	if err != nil {
+		return fmt.Errorf("解密通知内容失败：%v", err)
+	}
+
+	_, _ = fmt.Fprintf(stdout, "通知ID：%v\n", ret.ID)
+	_, _ = fmt.Fprintf(stdout, "通知类型：%v\n", ret.EventType)
+	_, _ = fmt.Fprintf(stdout, "通知内容：%v\n", plaintext)
+	return nil
+}
+
+// readCapturedRequest 读取抓取到的 HTTP 请求报文，返回请求头与请求体
+func readCapturedRequest(path string) (http.Header, []byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("打开`%v`失败：%v", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	request, err := http.ReadRequest(bufio.NewReader(f))
+	if err != nil {
+		return nil, nil, fmt.Errorf("解析 HTTP 请求报文失败：%v", err)
+	}
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取请求体失败：%v", err)
+	}
+	return request.Header, body, nil
+}
@@ -0,0 +1,226 @@
+// wechatpay 微信支付 API v3 命令行诊断工具
+//
+// 用于排查签名、验签相关问题，支持以下子命令：
+//
+//	download  下载并解密平台证书
+//	serials   打印证书序列号与有效期
+//	sign      使用商户私钥对任意消息签名
+//	verify    验证抓取到的回调通知请求，并可解密通知内容
+//	prepay    发起一笔 Native 测试下单
+//
+// 执行 wechatpay <子命令> -h 查看子命令的参数说明。
+package main
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{name: "download", usage: "下载并解密平台证书", run: runDownload},
+	{name: "serials", usage: "打印证书序列号与有效期", run: runSerials},
+	{name: "sign", usage: "使用商户私钥对任意消息签名", run: runSign},
+	{name: "verify", usage: "验证抓取到的回调通知请求，并可解密通知内容", run: runVerify},
+	{name: "prepay", usage: "发起一笔 Native 测试下单", run: runPrepay},
+}
+
+// 标准输入输出，测试时可替换
+var (
+	stdin  io.Reader = os.Stdin
+	stdout io.Writer = os.Stdout
+	stderr io.Writer = os.Stderr
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run 执行 args 指定的子命令，返回进程退出码：成功为 0，执行失败为 1，参数有误为 2
+func run(args []string) int {
+	if len(args) < 1 {
+		usage()
+		return 2
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		err := cmd.run(args[1:])
+		var flagErr flagError
+		switch {
+		case err == nil:
+			return 0
+		case errors.Is(err, flag.ErrHelp):
+			return 0
+		case errors.As(err, &flagErr):
+			// flag 已输出错误原因与使用说明
+			return 2
+		}
+		reportError("%v", err)
+		return 1
+	}
+
+	reportError("未知的子命令：%v", args[0])
+	usage()
+	return 2
+}
+
+func reportError(format string, a ...interface{}) {
+	_, _ = fmt.Fprintf(stderr, format+"\n", a...)
+}
+
+func usage() {
+	_, _ = fmt.Fprintf(stderr, "usage of wechatpay:\n  wechatpay <command> [arguments]\n\ncommands:\n")
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(stderr, "  %-10s%v\n", cmd.name, cmd.usage)
+	}
+}
+
+// newFlagSet 创建子命令的参数集合，参数有误时输出子命令的使用说明
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(stderr, "usage of wechatpay %v:\n", name)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// flagError 解析命令行参数失败
+type flagError struct {
+	err error
+}
+
+func (e flagError) Error() string {
+	return e.err.Error()
+}
+
+func (e flagError) Unwrap() error {
+	return e.err
+}
+
+// parseFlags 解析子命令的参数，失败时返回 flagError
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return flagError{err}
+	}
+	return nil
+}
+
+type paramError struct {
+	name    string
+	value   string
+	message string
+}
+
+func (e paramError) Error() string {
+	if e.value != "" {
+		return fmt.Sprintf("参数有误：%v(%v) %v", e.name, e.value, e.message)
+	}
+	return fmt.Sprintf("参数有误：%v %v", e.name, e.message)
+}
+
+func checkFile(name, path string) error {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return paramError{name, path, fmt.Sprintf("有误：%v", err)}
+	}
+	if fileInfo.IsDir() {
+		return paramError{name, path, "不是合法的文件路径"}
+	}
+	return nil
+}
+
+// merchantFlags 商户身份相关的参数，多个子命令共用
+type merchantFlags struct {
+	mchID             string
+	mchSerialNo       string
+	mchPrivateKeyPath string
+}
+
+func (f *merchantFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.mchID, "m", "", "【必传】`商户号`")
+	fs.StringVar(&f.mchSerialNo, "s", "", "【必传】`商户证书序列号`")
+	fs.StringVar(&f.mchPrivateKeyPath, "p", "", "【必传】`商户私钥路径`")
+}
+
+func (f *merchantFlags) check() error {
+	if f.mchID == "" {
+		return paramError{"商户号", f.mchID, "必传"}
+	}
+	if f.mchSerialNo == "" {
+		return paramError{"商户证书序列号", f.mchSerialNo, "必传"}
+	}
+	if f.mchPrivateKeyPath == "" {
+		return paramError{"商户私钥路径", f.mchPrivateKeyPath, "必传"}
+	}
+	return checkFile("商户私钥路径", f.mchPrivateKeyPath)
+}
+
+func (f *merchantFlags) privateKey() (*rsa.PrivateKey, error) {
+	privateKey, err := utils.LoadPrivateKeyWithPath(f.mchPrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("商户私钥有误：%v", err)
+	}
+	return privateKey, nil
+}
+
+// createClient 创建 Client。未提供平台证书时，不对应答进行验签
+func (f *merchantFlags) createClient(ctx context.Context, certificates []*x509.Certificate) (*core.Client, error) {
+	privateKey, err := f.privateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []core.ClientOption{option.WithMerchantCredential(f.mchID, f.mchSerialNo, privateKey)}
+	if len(certificates) > 0 {
+		opts = append(opts, option.WithWechatPayCertificate(certificates))
+	} else {
+		opts = append(opts, option.WithoutValidator())
+	}
+
+	client, err := core.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("创建 Client 失败：%v", err)
+	}
+	return client, nil
+}
+
+// loadCertificates 加载以逗号分隔的多个证书文件
+func loadCertificates(paths string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+	for _, path := range strings.Split(paths, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		if err := checkFile("平台证书路径", path); err != nil {
+			return nil, err
+		}
+		certificate, err := utils.LoadCertificateWithPath(path)
+		if err != nil {
+			return nil, fmt.Errorf("平台证书`%v`有误：%v", path, err)
+		}
+		certificates = append(certificates, certificate)
+	}
+	return certificates, nil
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/verifiers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const (
+	testMchID       = "1900009191"
+	testMchSerialNo = "3775B6A45ACD588826D15E583A95F5DD********"
+	testAPIv3Key    = "a8Fq3xZ0pLmN7sT2vW9yB4cD6eG1hJ5k"
+	testSerialNo    = "5157F09EFDC096DE"
+)
+
+var (
+	testPrivateKey     *rsa.PrivateKey
+	testPrivateKeyPEM  string
+	testCertificatePEM string
+	testCertificate    *x509.Certificate
+)
+
+func init() {
+	var err error
+	testPrivateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(testPrivateKey)
+	testPrivateKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x5157F09EFDC096DE),
+		Subject:      pkix.Name{CommonName: "Tenpay.com Root CA"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err = x509.CreateCertificate(rand.Reader, template, template, &testPrivateKey.PublicKey, testPrivateKey)
+	if err != nil {
+		panic(err)
+	}
+	testCertificatePEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	testCertificate, _ = x509.ParseCertificate(der)
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "wechatpay-cmd")
+	require.NoError(t, err)
+	return dir
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+// execute 以 input 作为标准输入执行命令，返回退出码、标准输出与标准错误
+func execute(input string, args ...string) (code int, out, errOut string) {
+	var outBuf, errBuf bytes.Buffer
+	stdin, stdout, stderr = strings.NewReader(input), &outBuf, &errBuf
+	defer func() { stdin, stdout, stderr = os.Stdin, os.Stdout, os.Stderr }()
+
+	code = run(args)
+	return code, outBuf.String(), errBuf.String()
+}
+
+func TestRun_Dispatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		code    int
+		wantErr string
+	}{
+		{name: "no command", code: 2, wantErr: "usage of wechatpay:"},
+		{name: "unknown command", args: []string{"unknown"}, code: 2, wantErr: "未知的子命令：unknown"},
+		{name: "help", args: []string{"sign", "-h"}, code: 0, wantErr: "usage of wechatpay sign:"},
+		{name: "undefined flag", args: []string{"serials", "-x"}, code: 2, wantErr: "flag provided but not defined: -x"},
+		{name: "invalid flag value", args: []string{"prepay", "-total", "abc"}, code: 2, wantErr: "invalid value \"abc\" for flag -total"},
+		{name: "command failed", args: []string{"serials"}, code: 1, wantErr: "参数有误：证书路径/证书目录 至少传入一个"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, errOut := execute("", tt.args...)
+			assert.Equal(t, tt.code, code)
+			assert.Empty(t, out)
+			assert.Contains(t, errOut, tt.wantErr)
+		})
+	}
+
+	// 使用说明列出全部子命令
+	_, _, errOut := execute("")
+	for _, cmd := range commands {
+		assert.Contains(t, errOut, cmd.name)
+	}
+}
+
+func TestRun_RequiredFlags(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	keyPath := writeTestFile(t, dir, "apiclient_key.pem", testPrivateKeyPEM)
+	merchant := []string{"-m", testMchID, "-s", testMchSerialNo, "-p", keyPath}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "sign without mchid", args: []string{"sign", "-s", testMchSerialNo, "-p", keyPath}, wantErr: "商户号 必传"},
+		{name: "sign without serial no", args: []string{"sign", "-m", testMchID, "-p", keyPath}, wantErr: "商户证书序列号 必传"},
+		{name: "sign without private key", args: []string{"sign", "-m", testMchID, "-s", testMchSerialNo}, wantErr: "商户私钥路径 必传"},
+		{name: "private key not exist", args: []string{"sign", "-m", testMchID, "-s", testMchSerialNo, "-p", filepath.Join(dir, "none.pem")}, wantErr: "商户私钥路径"},
+		{name: "private key is dir", args: []string{"sign", "-m", testMchID, "-s", testMchSerialNo, "-p", dir}, wantErr: "不是合法的文件路径"},
+		{name: "download without apiv3 key", args: append([]string{"download"}, merchant...), wantErr: "商户APIv3密钥 必传"},
+		{name: "prepay without appid", args: append([]string{"prepay"}, merchant...), wantErr: "应用ID 必传"},
+		{name: "prepay with invalid total", args: append([]string{"prepay", "-a", "wxd678efh567hg6787", "-total", "0"}, merchant...), wantErr: "订单金额(0) 必须大于0"},
+		{name: "verify without request", args: []string{"verify", "-c", keyPath}, wantErr: "回调通知请求文件路径 必传"},
+		{name: "verify without certificate", args: []string{"verify", "-f", keyPath}, wantErr: "平台证书路径 必传"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, errOut := execute("", tt.args...)
+			assert.Equal(t, 1, code)
+			assert.Contains(t, errOut, tt.wantErr)
+		})
+	}
+}
+
+func TestRun_Sign(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	keyPath := writeTestFile(t, dir, "apiclient_key.pem", testPrivateKeyPEM)
+	message := "GET\n/v3/certificates\n1624523846\nB8YcN0Cn8s3KdfMxI2RbjZ1je2XayFyO\n\n"
+	messagePath := writeTestFile(t, dir, "message.txt", message)
+	merchant := []string{"-m", testMchID, "-s", testMchSerialNo, "-p", keyPath}
+
+	for name, tt := range map[string]struct {
+		input string
+		args  []string
+	}{
+		"msg flag": {args: append([]string{"sign", "-msg", message}, merchant...)},
+		"file":     {args: append([]string{"sign", "-f", messagePath}, merchant...)},
+		"stdin":    {input: message, args: append([]string{"sign"}, merchant...)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			code, out, errOut := execute(tt.input, tt.args...)
+			require.Equal(t, 0, code, errOut)
+			assert.Contains(t, out, fmt.Sprintf("签名原文：%q\n", message))
+			assert.Contains(t, out, "商户证书序列号："+testMchSerialNo)
+
+			// 输出的签名值可以使用商户公钥验证
+			var signature string
+			for _, line := range strings.Split(out, "\n") {
+				if strings.HasPrefix(line, "签名值：") {
+					signature = strings.TrimPrefix(line, "签名值：")
+				}
+			}
+			verifier := verifiers.NewSHA256WithRSAPubkeyVerifier(testSerialNo, &testPrivateKey.PublicKey)
+			assert.NoError(t, verifier.Verify(context.Background(), testSerialNo, message, signature))
+		})
+	}
+}
+
+func TestRun_Serials(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	certificatePath := writeTestFile(t, dir, "wechatpay.pem", testCertificatePEM)
+	// 目录中的非证书文件被跳过
+	writeTestFile(t, dir, "apiclient_key.pem", testPrivateKeyPEM)
+	writeTestFile(t, dir, "readme.txt", "not a certificate")
+
+	code, out, errOut := execute("", "serials", "-c", certificatePath)
+	require.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, testSerialNo)
+	assert.Contains(t, out, "有效，剩余 0 天")
+
+	code, out, errOut = execute("", "serials", "-d", dir)
+	require.Equal(t, 0, code, errOut)
+	assert.Equal(t, 1, strings.Count(out, testSerialNo))
+	assert.Contains(t, errOut, "跳过")
+	assert.NotContains(t, errOut, "readme.txt")
+
+	code, _, errOut = execute("", "serials", "-c", filepath.Join(dir, "apiclient_key.pem"))
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "未找到有效的证书")
+}
+
+// capturedNotification 生成使用测试私钥签名的回调通知请求报文
+func capturedNotification(t *testing.T, plaintext string, timestamp int64) string {
+	ciphertext, err := utils.EncryptAES256GCM(testAPIv3Key, "transaction", "fdasflkja484", plaintext)
+	require.NoError(t, err)
+	body, err := json.Marshal(map[string]interface{}{
+		"id":            "EV-2018022511223320873",
+		"create_time":   "2015-05-20T13:29:35+08:00",
+		"resource_type": "encrypt-resource",
+		"event_type":    "TRANSACTION.SUCCESS",
+		"summary":       "支付成功",
+		"resource": map[string]interface{}{
+			"algorithm":       "AEAD_AES_256_GCM",
+			"ciphertext":      ciphertext,
+			"associated_data": "transaction",
+			"nonce":           "fdasflkja484",
+			"original_type":   "transaction",
+		},
+	})
+	require.NoError(t, err)
+
+	const nonce = "5K8264ILTKCH16CQ2502SI8ZNMTM67VS"
+	signature, err := utils.SignSHA256WithRSA(fmt.Sprintf("%d\n%s\n%s\n", timestamp, nonce, body), testPrivateKey)
+	require.NoError(t, err)
+	return fmt.Sprintf("POST /notify HTTP/1.1\r\nHost: www.example.com\r\nContent-Type: application/json\r\n"+
+		"%s: %s\r\n%s: %d\r\n%s: %s\r\n%s: %s\r\nContent-Length: %d\r\n\r\n%s",
+		consts.WechatPaySerial, testSerialNo, consts.WechatPayTimestamp, timestamp, consts.WechatPayNonce, nonce,
+		consts.WechatPaySignature, signature, len(body), body)
+}
+
+func TestRun_Verify(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	certificatePath := writeTestFile(t, dir, "wechatpay.pem", testCertificatePEM)
+	const plaintext = `{"out_trade_no":"1217752501201407033233368018"}`
+	require.Equal(t, testSerialNo, utils.GetCertificateSerialNumber(*testCertificate))
+
+	requestPath := writeTestFile(t, dir, "request.txt", capturedNotification(t, plaintext, time.Now().Unix()))
+	code, out, errOut := execute("", "verify", "-f", requestPath, "-c", certificatePath)
+	require.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "平台证书序列号："+testSerialNo)
+	assert.Contains(t, out, "验签成功")
+	assert.NotContains(t, out, "提示")
+	assert.NotContains(t, out, "通知内容")
+
+	// 传入 APIv3 密钥时解密通知内容
+	code, out, errOut = execute("", "verify", "-f", requestPath, "-c", certificatePath, "-k", testAPIv3Key)
+	require.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "通知类型：TRANSACTION.SUCCESS")
+	assert.Contains(t, out, "通知内容："+plaintext)
+
+	code, _, errOut = execute("", "verify", "-f", requestPath, "-c", certificatePath, "-k", strings.Repeat("0", 32))
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "解密通知内容失败")
+
+	// 过期的通知仍然验签，并给出提示
+	expiredPath := writeTestFile(t, dir, "expired.txt", capturedNotification(t, plaintext, time.Now().Add(-time.Hour).Unix()))
+	code, out, errOut = execute("", "verify", "-f", expiredPath, "-c", certificatePath)
+	require.Equal(t, 0, code, errOut)
+	assert.Contains(t, out, "与当前时间相差超过 5 分钟")
+	assert.Contains(t, out, "验签成功")
+
+	tamperedPath := writeTestFile(t, dir, "tampered.txt",
+		strings.Replace(capturedNotification(t, plaintext, time.Now().Unix()), "支付成功", "支付失败", 1))
+	code, _, errOut = execute("", "verify", "-f", tamperedPath, "-c", certificatePath)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "验签失败")
+
+	invalidPath := writeTestFile(t, dir, "invalid.txt", "not a http request")
+	code, _, errOut = execute("", "verify", "-f", invalidPath, "-c", certificatePath)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "解析 HTTP 请求报文失败")
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// AuditRecord 一次已签名请求的审计记录，不包含请求与应答的包体
+type AuditRecord struct {
+	// Time 发送请求的时间
+	Time time.Time
+	// MchID 商户号，签名器无法提供商户号时为空
+	MchID string
+	// Method HTTP 方法
+	Method string
+	// Path 接口路径，不包含域名与查询参数
+	Path string
+	// RequestID 微信支付应答头中的 Request-Id，未收到应答时为空
+	RequestID string
+	// StatusCode 应答的 HTTP 状态码，未收到应答时为 0
+	StatusCode int
+	// Code 应答失败时微信支付返回的错误码，如 PARAM_ERROR，成功时为空
+	Code string
+	// Latency 发送请求到收到应答头的耗时
+	Latency time.Duration
+	// Err 未收到应答时请求的错误，如网络错误或超时
+	Err error
+}
+
+// AuditSink 请求审计日志的接收器，Client 在每次发出已签名的请求并收到应答（或请求失败）后同步调用 Record
+//
+// Record 在应答验签前调用，且会被并发调用，实现需要是并发安全的，并应尽快返回（如写入缓冲区后异步落盘）。
+// core/auditlog 提供了按行写入 JSON 文件的实现。
+type AuditSink interface {
+	Record(ctx context.Context, record *AuditRecord)
+}
+
+// AuditSinkFunc 将函数适配为 AuditSink
+type AuditSinkFunc func(ctx context.Context, record *AuditRecord)
+
+// Record 调用 f(ctx, record)
+func (f AuditSinkFunc) Record(ctx context.Context, record *AuditRecord) {
+	f(ctx, record)
+}
+
+// audit 记录已签名请求的审计日志
+func (client *Client) audit(ctx context.Context, info *RequestInfo, start time.Time, result *APIResult, err error) {
+	if client.auditSink == nil {
+		return
+	}
+	record := &AuditRecord{
+		Time:      start,
+		MchID:     info.MchID,
+		Method:    info.Method,
+		Path:      info.Path,
+		RequestID: info.RequestID,
+		Latency:   time.Since(start),
+	}
+	var apiErr *APIError
+	switch {
+	case errors.As(err, &apiErr):
+		record.StatusCode, record.Code = apiErr.StatusCode, apiErr.Code
+	case result != nil && result.Response != nil:
+		record.StatusCode = result.Response.StatusCode
+		record.Err = err
+	default:
+		record.Err = err
+	}
+	client.auditSink.Record(ctx, record)
+}
@@ -0,0 +1,114 @@
+// Package auditlog 微信支付 API v3 Go SDK 请求审计日志
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// Entry 审计日志文件中的一行
+type Entry struct {
+	Time       string  `json:"time"`
+	MchID      string  `json:"mchid"`
+	Method     string  `json:"method"`
+	Path       string  `json:"path"`
+	RequestID  string  `json:"request_id"`
+	StatusCode int     `json:"status_code"`
+	Code       string  `json:"code,omitempty"`
+	LatencyMs  float64 `json:"latency_ms"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// NewEntry 将审计记录转换为审计日志文件中的一行，时间为 RFC3339 格式（精确到毫秒）
+func NewEntry(record *core.AuditRecord) *Entry {
+	e := &Entry{
+		Time:       record.Time.Format("2006-01-02T15:04:05.000Z07:00"),
+		MchID:      record.MchID,
+		Method:     record.Method,
+		Path:       record.Path,
+		RequestID:  record.RequestID,
+		StatusCode: record.StatusCode,
+		Code:       record.Code,
+		LatencyMs:  float64(record.Latency.Microseconds()) / 1000,
+	}
+	if record.Err != nil {
+		e.Error = record.Err.Error()
+	}
+	return e
+}
+
+// FileSink 将审计记录按行写入 JSON Lines 格式文件的 core.AuditSink，并发安全
+//
+// 每条记录以一次 Write 追加写入，多个进程可以写入同一文件。FileSink 不负责轮转文件，可配合 logrotate 的 copytruncate 使用。
+type FileSink struct {
+	lock sync.Mutex
+	w    io.Writer
+	c    io.Closer
+	err  error
+}
+
+// NewFileSink 以追加方式打开（不存在时创建）审计日志文件
+func NewFileSink(name string) (*FileSink, error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log file err:%v", err)
+	}
+	return &FileSink{w: f, c: f}, nil
+}
+
+// NewWriterSink 将审计记录按行写入 w，w 需自行关闭
+func NewWriterSink(w io.Writer) *FileSink {
+	return &FileSink{w: w}
+}
+
+// Record 写入一条审计记录，写入失败时记录第一个错误，可通过 Err 读取
+func (s *FileSink) Record(_ context.Context, record *core.AuditRecord) {
+	line, err := json.Marshal(NewEntry(record))
+	if err != nil {
+		s.setErr(fmt.Errorf("marshal audit record err:%v", err))
+		return
+	}
+	line = append(line, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err = s.w.Write(line); err != nil && s.err == nil {
+		s.err = fmt.Errorf("write audit log err:%v", err)
+	}
+}
+
+func (s *FileSink) setErr(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Err 返回第一次写入失败的错误，未失败时返回 nil
+func (s *FileSink) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+// Close 关闭审计日志文件，返回关闭文件或第一次写入失败的错误
+func (s *FileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.c != nil {
+		if err := s.c.Close(); err != nil && s.err == nil {
+			s.err = err
+		}
+		s.c = nil
+	}
+	return s.err
+}
+
+var _ core.AuditSink = (*FileSink)(nil)
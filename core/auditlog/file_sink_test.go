@@ -0,0 +1,100 @@
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+func TestNewEntry(t *testing.T) {
+	record := &core.AuditRecord{
+		Time:       time.Date(2021, 7, 9, 10, 34, 56, 123456789, time.FixedZone("CST", 8*3600)),
+		MchID:      "1900000109",
+		Method:     "POST",
+		Path:       "/v3/pay/transactions/jsapi",
+		RequestID:  "08F78BCA9C1A0C1E",
+		StatusCode: 400,
+		Code:       "PARAM_ERROR",
+		Latency:    12345678 * time.Nanosecond,
+	}
+	line, err := json.Marshal(NewEntry(record))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"time":"2021-07-09T10:34:56.123+08:00","mchid":"1900000109","method":"POST",`+
+		`"path":"/v3/pay/transactions/jsapi","request_id":"08F78BCA9C1A0C1E","status_code":400,"code":"PARAM_ERROR",`+
+		`"latency_ms":12.345}`, string(line))
+
+	entry := NewEntry(&core.AuditRecord{Err: fmt.Errorf("dial tcp: i/o timeout")})
+	assert.Equal(t, "dial tcp: i/o timeout", entry.Error)
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditlog")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	name := filepath.Join(dir, "audit.jsonl")
+
+	sink, err := NewFileSink(name)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sink.Record(context.Background(), &core.AuditRecord{Method: "GET", Path: fmt.Sprintf("/v3/%d", i)})
+		}(i)
+	}
+	wg.Wait()
+	require.NoError(t, sink.Close())
+
+	// 再次打开时追加写入
+	sink, err = NewFileSink(name)
+	require.NoError(t, err)
+	sink.Record(context.Background(), &core.AuditRecord{Method: "POST", Path: "/v3/last"})
+	require.NoError(t, sink.Close())
+
+	content, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	require.Len(t, lines, 21)
+	for _, line := range lines {
+		var entry Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+	}
+	assert.Contains(t, lines[20], `"path":"/v3/last"`)
+
+	_, err = NewFileSink(filepath.Join(dir, "missing", "audit.jsonl"))
+	assert.Error(t, err)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, fmt.Errorf("disk full")
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+	sink.Record(context.Background(), &core.AuditRecord{Method: "GET"})
+	assert.NoError(t, sink.Err())
+	assert.Contains(t, buf.String(), `"method":"GET"`)
+	assert.NoError(t, sink.Close())
+
+	sink = NewWriterSink(failingWriter{})
+	sink.Record(context.Background(), &core.AuditRecord{})
+	assert.EqualError(t, sink.Err(), "write audit log err:disk full")
+	assert.EqualError(t, sink.Close(), "write audit log err:disk full")
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"bytes"
+)
+
+// BufferedBody 已完整读入内存的 HTTP 包体
+//
+// 验签器读取应答包体后使用 BufferedBody 还原 http.Response.Body，core.UnMarshalResponse 等后续处理可通过 Bytes 直接取得包体，
+// 无需再次读取与复制
+type BufferedBody struct {
+	bytes.Reader
+	b []byte
+}
+
+// NewBufferedBody 使用 b 创建 BufferedBody，b 不会被复制，调用方不应再修改 b
+func NewBufferedBody(b []byte) *BufferedBody {
+	body := &BufferedBody{b: b}
+	body.Reset(b)
+	return body
+}
+
+// Bytes 返回尚未读取的包体，返回值与 BufferedBody 共享底层数组，不应修改
+func (b *BufferedBody) Bytes() []byte {
+	return b.b[len(b.b)-b.Len():]
+}
+
+// Close 实现 io.Closer，不做任何操作
+func (b *BufferedBody) Close() error {
+	return nil
+}
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferedBody(t *testing.T) {
+	body := NewBufferedBody([]byte(`{"hello":"wechatpay"}`))
+	assert.Equal(t, `{"hello":"wechatpay"}`, string(body.Bytes()))
+
+	p := make([]byte, 1)
+	_, err := body.Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, `"hello":"wechatpay"}`, string(body.Bytes()))
+
+	rest, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, `"hello":"wechatpay"}`, string(rest))
+	assert.Empty(t, body.Bytes())
+	assert.NoError(t, body.Close())
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Clock 时钟，用于生成请求签名的时间戳与检查报文时间戳是否过期
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc 将函数转换为 Clock
+type ClockFunc func() time.Time
+
+// Now 返回当前时间
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// OffsetClock 返回比 clock 快 offset 的时钟，offset 可以为负数。clock 为 nil 时使用系统时钟
+//
+// 服务器时钟存在无法消除的偏差时，可以按偏差设置 offset，使签名时间戳与微信支付的时间一致
+func OffsetClock(clock Clock, offset time.Duration) Clock {
+	if clock == nil {
+		clock = ClockFunc(time.Now)
+	}
+	return ClockFunc(func() time.Time {
+		return clock.Now().Add(offset)
+	})
+}
+
+// AdjustableClock 可在运行时调整偏差的时钟，可安全地并发使用
+//
+// 适用于偏差会随时间变化的服务器（如时钟漂移的虚拟机），可以定期根据 NTP 或微信支付服务器时间计算出的偏差调用 SetOffset 更新
+type AdjustableClock struct {
+	// offset 需 64 位对齐，以便在 32 位平台上原子地读写
+	offset int64
+	clock  Clock
+}
+
+// NewAdjustableClock 创建偏差为 0 的 AdjustableClock，clock 为 nil 时使用系统时钟
+func NewAdjustableClock(clock Clock) *AdjustableClock {
+	if clock == nil {
+		clock = ClockFunc(time.Now)
+	}
+	return &AdjustableClock{clock: clock}
+}
+
+// Now 返回修正偏差后的当前时间
+func (c *AdjustableClock) Now() time.Time {
+	return c.clock.Now().Add(c.Offset())
+}
+
+// SetOffset 设置偏差，offset 为正数时时钟变快
+func (c *AdjustableClock) SetOffset(offset time.Duration) {
+	atomic.StoreInt64(&c.offset, int64(offset))
+}
+
+// Offset 返回当前的偏差
+func (c *AdjustableClock) Offset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.offset))
+}
+
+type clockContextKey struct{}
+
+// WithClock 为请求设置生成签名时间戳与检查应答时间戳所使用的时钟，返回更新后的 Context
+//
+// 通过 ctx 设置的时钟优先于 option.WithClock 为 Client 设置的时钟，可用于在测试中冻结时间
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, clock)
+}
+
+// HasClock 判断 ctx 中是否已设置时钟
+func HasClock(ctx context.Context) bool {
+	_, ok := ctx.Value(clockContextKey{}).(Clock)
+	return ok
+}
+
+// Now 返回 ctx 中设置的时钟的当前时间，未设置时返回系统时间
+func Now(ctx context.Context) time.Time {
+	if clock, ok := ctx.Value(clockContextKey{}).(Clock); ok && clock != nil {
+		return clock.Now()
+	}
+	return time.Now()
+}
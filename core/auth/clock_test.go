@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock(t *testing.T) {
+	frozen := time.Unix(1624523846, 0)
+	clock := ClockFunc(func() time.Time { return frozen })
+
+	ctx := context.Background()
+	assert.False(t, HasClock(ctx))
+	assert.WithinDuration(t, time.Now(), Now(ctx), time.Second)
+
+	ctx = WithClock(ctx, clock)
+	assert.True(t, HasClock(ctx))
+	assert.Equal(t, frozen, Now(ctx))
+
+	assert.Equal(t, frozen.Add(-3*time.Minute), OffsetClock(clock, -3*time.Minute).Now())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), OffsetClock(nil, time.Hour).Now(), time.Second)
+}
+
+func TestAdjustableClock(t *testing.T) {
+	frozen := time.Unix(1624523846, 0)
+	clock := NewAdjustableClock(ClockFunc(func() time.Time { return frozen }))
+	assert.Equal(t, time.Duration(0), clock.Offset())
+	assert.Equal(t, frozen, clock.Now())
+
+	clock.SetOffset(-90 * time.Second)
+	assert.Equal(t, -90*time.Second, clock.Offset())
+	assert.Equal(t, frozen.Add(-90*time.Second), clock.Now())
+
+	system := NewAdjustableClock(nil)
+	system.SetOffset(time.Hour)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), system.Now(), time.Second)
+}
@@ -7,3 +7,27 @@ import "context"
 type Credential interface {
 	GenerateAuthorizationHeader(ctx context.Context, method, canonicalURL, signBody string) (string, error)
 }
+
+// SchemeCredential 声明认证类型的 Credential
+//
+// 认证类型即 Authorization 信息中的第一部分，如 WECHATPAY2-SHA256-RSA2048。
+// Client 配置了多种认证类型时，按认证类型选择生成 Authorization 信息的 Credential
+type SchemeCredential interface {
+	Credential
+	Scheme() string // 认证类型
+}
+
+type schemeContextKey struct{}
+
+// WithScheme 为请求指定 Authorization 信息的认证类型，返回更新后的 Context
+//
+// 通过 ctx 指定的认证类型优先于 option.WithAuthScheme 为 Client 设置的默认认证类型，指定的认证类型未配置时请求失败
+func WithScheme(ctx context.Context, scheme string) context.Context {
+	return context.WithValue(ctx, schemeContextKey{}, scheme)
+}
+
+// SchemeFromContext 返回 ctx 中指定的认证类型
+func SchemeFromContext(ctx context.Context) (string, bool) {
+	scheme, ok := ctx.Value(schemeContextKey{}).(string)
+	return scheme, ok && scheme != ""
+}
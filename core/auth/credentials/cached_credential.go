@@ -0,0 +1,107 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+const (
+	// MaxSignatureCacheTTL 签名缓存的最大有效期，需小于微信支付允许的请求时间戳偏差（5 分钟）
+	MaxSignatureCacheTTL = time.Minute
+	// DefaultSignatureCacheSize 签名缓存默认的最大条目数
+	DefaultSignatureCacheSize = 1024
+)
+
+// CachedCredential 缓存 Authorization 信息的生成器
+//
+// 在有效期内，方法、URL 与请求包体均相同的请求复用同一个 Authorization 信息（包括时间戳与随机串），
+// 适用于高频且完全相同的 GET 请求（如平台证书查询、健康检查），以减少 RSA 签名的 CPU 消耗。
+// 命中缓存的请求不会重新签名，也不会调用 auth.WithSignRecorder 设置的回调。
+type CachedCredential struct {
+	credential auth.Credential
+	ttl        time.Duration
+	size       int
+
+	mu      sync.Mutex
+	entries map[string]cachedAuthorization
+}
+
+type cachedAuthorization struct {
+	authorization string
+	expireAt      time.Time
+}
+
+// NewCachedCredential 创建缓存 credential 生成结果的 CachedCredential
+//
+// ttl 为缓存有效期，不能超过 MaxSignatureCacheTTL；size 为最大缓存条目数，不大于 0 时使用 DefaultSignatureCacheSize。
+// 缓存已满时不再缓存新的请求，直至已有条目过期
+func NewCachedCredential(credential auth.Credential, ttl time.Duration, size int) (*CachedCredential, error) {
+	if credential == nil {
+		return nil, fmt.Errorf("credential is required")
+	}
+	if ttl <= 0 || ttl > MaxSignatureCacheTTL {
+		return nil, fmt.Errorf("signature cache ttl must be in (0, %s]", MaxSignatureCacheTTL)
+	}
+	if size <= 0 {
+		size = DefaultSignatureCacheSize
+	}
+	return &CachedCredential{
+		credential: credential,
+		ttl:        ttl,
+		size:       size,
+		entries:    make(map[string]cachedAuthorization),
+	}, nil
+}
+
+// GenerateAuthorizationHeader 返回缓存中未过期的 Authorization 信息，未命中时生成并缓存
+func (c *CachedCredential) GenerateAuthorizationHeader(
+	ctx context.Context, method, canonicalURL, signBody string,
+) (string, error) {
+	key := method + "\n" + canonicalURL + "\n" + signBody + "\n"
+	if scheme, ok := auth.SchemeFromContext(ctx); ok {
+		key = scheme + "\n" + key
+	}
+	now := auth.Now(ctx)
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expireAt) {
+		return entry.authorization, nil
+	}
+
+	authorization, err := c.credential.GenerateAuthorizationHeader(ctx, method, canonicalURL, signBody)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		c.evictExpired(now)
+	}
+	if _, ok := c.entries[key]; ok || len(c.entries) < c.size {
+		c.entries[key] = cachedAuthorization{authorization: authorization, expireAt: now.Add(c.ttl)}
+	}
+	return authorization, nil
+}
+
+// Scheme 返回被缓存的 Credential 的认证类型，其未实现 auth.SchemeCredential 时返回空字符串
+func (c *CachedCredential) Scheme() string {
+	if credential, ok := c.credential.(auth.SchemeCredential); ok {
+		return credential.Scheme()
+	}
+	return ""
+}
+
+func (c *CachedCredential) evictExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expireAt) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package credentials
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/signers"
+)
+
+type countingCredential struct {
+	calls int
+}
+
+func (c *countingCredential) GenerateAuthorizationHeader(_ context.Context, method, canonicalURL, _ string) (string, error) {
+	c.calls++
+	return fmt.Sprintf("%s %s %d", method, canonicalURL, c.calls), nil
+}
+
+func TestCachedCredential_GenerateAuthorizationHeader(t *testing.T) {
+	now := time.Unix(mockTimestamp, 0)
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+
+	counting := &countingCredential{}
+	c, err := NewCachedCredential(counting, 10*time.Second, 2)
+	require.NoError(t, err)
+
+	first, err := c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "")
+	require.NoError(t, err)
+	second, err := c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "")
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, counting.calls)
+
+	// 方法、URL、包体或认证类型不同时不复用
+	_, _ = c.GenerateAuthorizationHeader(ctx, "POST", "/v3/certificates", "")
+	_, _ = c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "{}")
+	_, _ = c.GenerateAuthorizationHeader(auth.WithScheme(ctx, "WECHATPAY2-SM2-WITH-SM3"), "GET", "/v3/certificates", "")
+	assert.Equal(t, 4, counting.calls)
+
+	// 缓存已满时不缓存新的请求
+	_, _ = c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "{}")
+	assert.Equal(t, 5, counting.calls)
+
+	// 过期后重新生成
+	now = now.Add(10 * time.Second)
+	third, err := c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, first, third)
+	assert.Equal(t, 6, counting.calls)
+	_, _ = c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "")
+	assert.Equal(t, 6, counting.calls)
+}
+
+func TestNewCachedCredential(t *testing.T) {
+	_, err := NewCachedCredential(nil, time.Second, 0)
+	assert.Error(t, err)
+	_, err = NewCachedCredential(&countingCredential{}, 0, 0)
+	assert.Error(t, err)
+	_, err = NewCachedCredential(&countingCredential{}, MaxSignatureCacheTTL+time.Second, 0)
+	assert.Error(t, err)
+
+	c, err := NewCachedCredential(&WechatPayCredentials{Signer: &mockSigner{}}, time.Second, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "WECHATPAY2-Mock", c.Scheme())
+	c, err = NewCachedCredential(&countingCredential{}, time.Second, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "", c.Scheme())
+}
+
+func newBenchmarkCredential(b *testing.B) *WechatPayCredentials {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(b, err)
+	return &WechatPayCredentials{Signer: &signers.SHA256WithRSASigner{
+		MchID:               testMchID,
+		CertificateSerialNo: testCertificateSerial,
+		PrivateKey:          privateKey,
+	}}
+}
+
+// 以 2000 QPS 的相同 GET 请求为例，每秒的签名 CPU 时间约为 2000 * ns/op，
+// 对比两个基准测试即可得到使用签名缓存节省的 CPU 时间
+func BenchmarkWechatPayCredentials_GenerateAuthorizationHeader(b *testing.B) {
+	credential := newBenchmarkCredential(b)
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := credential.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", ""); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCachedCredential_GenerateAuthorizationHeader(b *testing.B) {
+	credential, err := NewCachedCredential(newBenchmarkCredential(b), 30*time.Second, 0)
+	require.NoError(b, err)
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := credential.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", ""); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// SchemeCredentials 支持多种认证类型的 Authorization 信息生成器
+//
+// 请求使用 auth.WithScheme 为 ctx 指定的认证类型，未指定时使用默认认证类型。
+// 新增认证类型（如国密）时只需实现 auth.SchemeCredential，无需修改各个服务的接口。
+type SchemeCredentials struct {
+	defaultScheme string
+	credentials   map[string]auth.SchemeCredential
+	schemes       []string
+}
+
+// NewSchemeCredentials 使用多种认证类型的 Credential 创建 SchemeCredentials
+//
+// defaultScheme 为空时使用第一个 Credential 的认证类型作为默认认证类型；认证类型为空、重复或默认认证类型未配置时返回错误
+func NewSchemeCredentials(defaultScheme string, credentials ...auth.SchemeCredential) (*SchemeCredentials, error) {
+	if len(credentials) == 0 {
+		return nil, fmt.Errorf("at least one credential is required")
+	}
+	c := &SchemeCredentials{credentials: make(map[string]auth.SchemeCredential, len(credentials))}
+	for _, credential := range credentials {
+		if credential == nil {
+			return nil, fmt.Errorf("credential is nil")
+		}
+		scheme := credential.Scheme()
+		if scheme == "" {
+			return nil, fmt.Errorf("credential %T has no authorization scheme", credential)
+		}
+		if _, ok := c.credentials[scheme]; ok {
+			return nil, fmt.Errorf("multiple credentials for authorization scheme %s", scheme)
+		}
+		c.credentials[scheme] = credential
+		c.schemes = append(c.schemes, scheme)
+	}
+
+	if defaultScheme == "" {
+		defaultScheme = c.schemes[0]
+	}
+	if _, ok := c.credentials[defaultScheme]; !ok {
+		return nil, fmt.Errorf("no credential for default authorization scheme %s", defaultScheme)
+	}
+	c.defaultScheme = defaultScheme
+	return c, nil
+}
+
+// GenerateAuthorizationHeader 使用 ctx 指定的认证类型或默认认证类型生成请求报文头中的 Authorization 信息
+func (c *SchemeCredentials) GenerateAuthorizationHeader(
+	ctx context.Context, method, canonicalURL, signBody string,
+) (string, error) {
+	scheme, ok := auth.SchemeFromContext(ctx)
+	if !ok {
+		scheme = c.defaultScheme
+	}
+	credential, ok := c.credentials[scheme]
+	if !ok {
+		return "", fmt.Errorf("unsupported authorization scheme %s, supported: %v", scheme, c.schemes)
+	}
+	return credential.GenerateAuthorizationHeader(ctx, method, canonicalURL, signBody)
+}
+
+// Scheme 返回默认认证类型
+func (c *SchemeCredentials) Scheme() string {
+	return c.defaultScheme
+}
+
+// Schemes 按配置顺序返回支持的全部认证类型
+func (c *SchemeCredentials) Schemes() []string {
+	return append([]string(nil), c.schemes...)
+}
@@ -0,0 +1,64 @@
+package credentials
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+type mockSchemeCredential string
+
+func (c mockSchemeCredential) GenerateAuthorizationHeader(context.Context, string, string, string) (string, error) {
+	return string(c) + " signature", nil
+}
+
+func (c mockSchemeCredential) Scheme() string {
+	return string(c)
+}
+
+func TestSchemeCredentials_GenerateAuthorizationHeader(t *testing.T) {
+	rsa := &WechatPayCredentials{Signer: &mockSigner{MchID: testMchID, CertificateSerialNo: testCertificateSerial}}
+	assert.Equal(t, "WECHATPAY2-Mock", rsa.Scheme())
+
+	c, err := NewSchemeCredentials("", rsa, mockSchemeCredential("WECHATPAY2-SM2-WITH-SM3"))
+	require.NoError(t, err)
+	assert.Equal(t, "WECHATPAY2-Mock", c.Scheme())
+	assert.Equal(t, []string{"WECHATPAY2-Mock", "WECHATPAY2-SM2-WITH-SM3"}, c.Schemes())
+
+	ctx := context.Background()
+	authorization, err := c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(authorization, "WECHATPAY2-Mock "), authorization)
+
+	authorization, err = c.GenerateAuthorizationHeader(
+		auth.WithScheme(ctx, "WECHATPAY2-SM2-WITH-SM3"), "GET", "/v3/certificates", "",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "WECHATPAY2-SM2-WITH-SM3 signature", authorization)
+
+	_, err = c.GenerateAuthorizationHeader(auth.WithScheme(ctx, "WECHATPAY2-UNKNOWN"), "GET", "/v3/certificates", "")
+	assert.Error(t, err)
+
+	c, err = NewSchemeCredentials("WECHATPAY2-SM2-WITH-SM3", rsa, mockSchemeCredential("WECHATPAY2-SM2-WITH-SM3"))
+	require.NoError(t, err)
+	authorization, err = c.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "")
+	require.NoError(t, err)
+	assert.Equal(t, "WECHATPAY2-SM2-WITH-SM3 signature", authorization)
+}
+
+func TestNewSchemeCredentials(t *testing.T) {
+	_, err := NewSchemeCredentials("")
+	assert.Error(t, err)
+	_, err = NewSchemeCredentials("", nil)
+	assert.Error(t, err)
+	_, err = NewSchemeCredentials("", &WechatPayCredentials{})
+	assert.Error(t, err)
+	_, err = NewSchemeCredentials("", mockSchemeCredential("A"), mockSchemeCredential("A"))
+	assert.Error(t, err)
+	_, err = NewSchemeCredentials("B", mockSchemeCredential("A"))
+	assert.Error(t, err)
+}
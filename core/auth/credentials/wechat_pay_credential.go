@@ -4,11 +4,9 @@ package credentials
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
-	"github.com/wechatpay-apiv3/wechatpay-go/utils"
 )
 
 // WechatPayCredentials 微信支付请求报文头 Authorization 信息生成器
@@ -18,29 +16,58 @@ type WechatPayCredentials struct {
 
 // GenerateAuthorizationHeader 生成请求报文头中的 Authorization 信息，详见：
 // https://wechatpay-api.gitbook.io/wechatpay-api-v3/qian-ming-zhi-nan-1/qian-ming-sheng-cheng
+//
+// 签名时间戳使用 auth.WithClock 为 ctx 设置的时钟生成，未设置时使用系统时间；随机串使用 auth.WithNonceGenerator 为 ctx 设置的生成器生成。
+// 签名成功后调用 auth.WithSignRecorder 为 ctx 设置的回调
 func (c *WechatPayCredentials) GenerateAuthorizationHeader(
 	ctx context.Context, method, canonicalURL, signBody string,
 ) (string, error) {
-	if c.Signer == nil {
-		return "", fmt.Errorf("you must init WechatPayCredentials with signer")
-	}
-	nonce, err := utils.GenerateNonce()
+	nonce, err := auth.GenerateNonce(ctx)
 	if err != nil {
 		return "", err
 	}
-	timestamp := time.Now().Unix()
-	message := fmt.Sprintf(consts.SignatureMessageFormat, method, canonicalURL, timestamp, nonce, signBody)
-	signatureResult, err := c.Signer.Sign(ctx, message)
+	return c.GenerateAuthorizationForMessage(ctx, &auth.SignatureMessage{
+		Method:    method,
+		URL:       canonicalURL,
+		Timestamp: auth.Now(ctx).Unix(),
+		Nonce:     nonce,
+		Body:      signBody,
+	})
+}
+
+// GenerateAuthorizationForMessage 使用指定的签名原文生成 Authorization 信息，时间戳与随机串由调用方决定
+//
+// 适用于需要自行构造请求的场景，如预签名的下载地址。签名成功后调用 auth.WithSignRecorder 为 ctx 设置的回调
+func (c *WechatPayCredentials) GenerateAuthorizationForMessage(
+	ctx context.Context, message *auth.SignatureMessage,
+) (string, error) {
+	if c.Signer == nil {
+		return "", fmt.Errorf("you must init WechatPayCredentials with signer")
+	}
+	signMessage := message.String()
+	signatureResult, err := c.Signer.Sign(ctx, signMessage)
 	if err != nil {
 		return "", err
 	}
+	auth.RecordSign(ctx, &auth.SignRecord{
+		Message: signMessage, Timestamp: message.Timestamp, Nonce: message.Nonce, Result: signatureResult,
+	})
 	authorization := fmt.Sprintf(
 		consts.HeaderAuthorizationFormat, c.getAuthorizationType(),
-		signatureResult.MchID, nonce, timestamp, signatureResult.CertificateSerialNo, signatureResult.Signature,
+		signatureResult.MchID, message.Nonce, message.Timestamp, signatureResult.CertificateSerialNo,
+		signatureResult.Signature,
 	)
 	return authorization, nil
 }
 
+// Scheme 返回 Authorization 信息的认证类型，如 WECHATPAY2-SHA256-RSA2048
+func (c *WechatPayCredentials) Scheme() string {
+	if c.Signer == nil {
+		return ""
+	}
+	return c.getAuthorizationType()
+}
+
 func (c *WechatPayCredentials) getAuthorizationType() string {
 	return "WECHATPAY2-" + c.Signer.Algorithm()
 }
@@ -2,13 +2,12 @@ package credentials
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
-	"github.com/agiledragon/gomonkey"
 	"github.com/stretchr/testify/require"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
-	"github.com/wechatpay-apiv3/wechatpay-go/utils"
 )
 
 type mockSigner struct {
@@ -32,24 +31,18 @@ func (s *mockSigner) Algorithm() string {
 const (
 	testMchID             = "1234567890"
 	testCertificateSerial = "0123456789ABC"
-	mockNonce             = "A1B2C3D4E5F6G7"
+	mockNonce             = "A1B2C3D4E5F6G7H8"
 	mockTimestamp         = 1624523846
 )
 
 func TestWechatPayCredentials_GenerateAuthorizationHeader(t *testing.T) {
-	patches := gomonkey.NewPatches()
-	defer patches.Reset()
-
-	patches.ApplyFunc(
-		utils.GenerateNonce, func() (string, error) {
-			return mockNonce, nil
-		},
-	)
-	patches.ApplyFunc(
-		time.Now, func() time.Time {
-			return time.Unix(mockTimestamp, 0)
-		},
-	)
+	// 固定随机串与时间戳，使签名结果可预期
+	ctx := auth.WithNonceGenerator(context.Background(), auth.NonceGeneratorFunc(func() (string, error) {
+		return mockNonce, nil
+	}))
+	ctx = auth.WithClock(ctx, auth.ClockFunc(func() time.Time {
+		return time.Unix(mockTimestamp, 0)
+	}))
 
 	signer := mockSigner{
 		MchID:               testMchID,
@@ -75,37 +68,37 @@ func TestWechatPayCredentials_GenerateAuthorizationHeader(t *testing.T) {
 			args: args{
 				signer: &signer,
 
-				ctx:          context.Background(),
+				ctx:          ctx,
 				method:       "GET",
 				canonicalURL: "/v3/certificates",
 				signBody:     "",
 			},
 			wantErr: false,
-			want: `WECHATPAY2-Mock mchid="1234567890",nonce_str="A1B2C3D4E5F6G7",timestamp="1624523846",` +
+			want: `WECHATPAY2-Mock mchid="1234567890",nonce_str="A1B2C3D4E5F6G7H8",timestamp="1624523846",` +
 				`serial_no="0123456789ABC",signature=` +
-				"\"Sign:GET\n/v3/certificates\n1624523846\nA1B2C3D4E5F6G7\n\n\"",
+				"\"Sign:GET\n/v3/certificates\n1624523846\nA1B2C3D4E5F6G7H8\n\n\"",
 		},
 		{
 			name: "gen success with body",
 			args: args{
 				signer: &signer,
 
-				ctx:          context.Background(),
+				ctx:          ctx,
 				method:       "POST",
 				canonicalURL: "/v3/certificates",
 				signBody:     "Hello World!\n",
 			},
 			wantErr: false,
-			want: `WECHATPAY2-Mock mchid="1234567890",nonce_str="A1B2C3D4E5F6G7",timestamp="1624523846",` +
+			want: `WECHATPAY2-Mock mchid="1234567890",nonce_str="A1B2C3D4E5F6G7H8",timestamp="1624523846",` +
 				`serial_no="0123456789ABC",signature=` +
-				"\"Sign:POST\n/v3/certificates\n1624523846\nA1B2C3D4E5F6G7\nHello World!\n\n\"",
+				"\"Sign:POST\n/v3/certificates\n1624523846\nA1B2C3D4E5F6G7H8\nHello World!\n\n\"",
 		},
 		{
 			name: "gen error wihout signer",
 			args: args{
 				signer: nil,
 
-				ctx:          context.Background(),
+				ctx:          ctx,
 				method:       "post",
 				canonicalURL: "/v3/certificates",
 				signBody:     "Hello World!\n",
@@ -127,3 +120,61 @@ func TestWechatPayCredentials_GenerateAuthorizationHeader(t *testing.T) {
 		)
 	}
 }
+
+func TestWechatPayCredentials_GenerateAuthorizationHeaderWithClock(t *testing.T) {
+	credential := WechatPayCredentials{Signer: &mockSigner{MchID: testMchID, CertificateSerialNo: testCertificateSerial}}
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time {
+		return time.Unix(mockTimestamp, 0)
+	}))
+
+	authorization, err := credential.GenerateAuthorizationHeader(ctx, "GET", "/v3/certificates", "")
+	require.NoError(t, err)
+	require.Contains(t, authorization, `timestamp="1624523846"`)
+	require.Contains(t, authorization, "\n1624523846\n")
+}
+
+func TestWechatPayCredentials_GenerateAuthorizationHeaderWithSignRecorder(t *testing.T) {
+	credential := WechatPayCredentials{Signer: &mockSigner{MchID: testMchID, CertificateSerialNo: testCertificateSerial}}
+	var record *auth.SignRecord
+	ctx := auth.WithSignRecorder(context.Background(), func(ctx context.Context, r *auth.SignRecord) {
+		record = r
+	})
+
+	authorization, err := credential.GenerateAuthorizationHeader(ctx, "POST", "/v3/certificates", "Hello World!\n")
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	require.Equal(t,
+		fmt.Sprintf("POST\n/v3/certificates\n%d\n%s\nHello World!\n\n", record.Timestamp, record.Nonce), record.Message)
+	require.Equal(t, "Sign:"+record.Message, record.Result.Signature)
+	require.Contains(t, authorization, fmt.Sprintf(`nonce_str="%s"`, record.Nonce))
+
+	// 未设置回调时不记录
+	record = nil
+	_, err = credential.GenerateAuthorizationHeader(context.Background(), "GET", "/v3/certificates", "")
+	require.NoError(t, err)
+	require.Nil(t, record)
+}
+
+func TestWechatPayCredentials_GenerateAuthorizationForMessage(t *testing.T) {
+	credential := WechatPayCredentials{Signer: &mockSigner{MchID: testMchID, CertificateSerialNo: testCertificateSerial}}
+	message, err := auth.NewSignatureMessage(
+		"GET", "https://api.mch.weixin.qq.com/v3/billdownload/file?token=6XIv5TUPto7pByrTQKhd6kwvyKLG2uY2wMMR8cNXqaA",
+		mockTimestamp, mockNonce, "",
+	)
+	require.NoError(t, err)
+
+	var record *auth.SignRecord
+	ctx := auth.WithSignRecorder(context.Background(), func(_ context.Context, r *auth.SignRecord) { record = r })
+	authorization, err := credential.GenerateAuthorizationForMessage(ctx, message)
+	require.NoError(t, err)
+	require.Equal(t,
+		`WECHATPAY2-Mock mchid="1234567890",nonce_str="A1B2C3D4E5F6G7H8",timestamp="1624523846",`+
+			`serial_no="0123456789ABC",signature=`+
+			"\"Sign:GET\n/v3/billdownload/file?token=6XIv5TUPto7pByrTQKhd6kwvyKLG2uY2wMMR8cNXqaA\n1624523846\nA1B2C3D4E5F6G7H8\n\n\"",
+		authorization,
+	)
+	require.Equal(t, message.String(), record.Message)
+
+	_, err = (&WechatPayCredentials{}).GenerateAuthorizationForMessage(ctx, message)
+	require.Error(t, err)
+}
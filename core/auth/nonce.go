@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// NonceGenerator 随机串生成器，用于生成请求签名与调起支付签名中的随机串，utils.NonceGenerator 实现了该接口
+type NonceGenerator interface {
+	GenerateNonce() (string, error)
+}
+
+// NonceGeneratorFunc 将函数转换为 NonceGenerator
+type NonceGeneratorFunc func() (string, error)
+
+// GenerateNonce 生成随机串
+func (f NonceGeneratorFunc) GenerateNonce() (string, error) {
+	return f()
+}
+
+type nonceGeneratorContextKey struct{}
+
+// WithNonceGenerator 为请求设置随机串生成器，返回更新后的 Context
+//
+// 通过 ctx 设置的生成器优先于 option.WithNonceGenerator 为 Client 设置的生成器
+func WithNonceGenerator(ctx context.Context, generator NonceGenerator) context.Context {
+	return context.WithValue(ctx, nonceGeneratorContextKey{}, generator)
+}
+
+// HasNonceGenerator 判断 ctx 中是否已设置随机串生成器
+func HasNonceGenerator(ctx context.Context) bool {
+	_, ok := ctx.Value(nonceGeneratorContextKey{}).(NonceGenerator)
+	return ok
+}
+
+// GenerateNonce 使用 ctx 中设置的生成器生成随机串，未设置时使用 utils.GenerateNonce
+//
+// 自定义生成器生成的随机串需通过 utils.CheckNonce 的检查，否则返回错误
+func GenerateNonce(ctx context.Context) (string, error) {
+	generator, ok := ctx.Value(nonceGeneratorContextKey{}).(NonceGenerator)
+	if !ok || generator == nil {
+		return utils.GenerateNonce()
+	}
+	nonce, err := generator.GenerateNonce()
+	if err != nil {
+		return "", err
+	}
+	if err = utils.CheckNonce(nonce); err != nil {
+		return "", fmt.Errorf("invalid nonce from NonceGenerator err:%v", err)
+	}
+	return nonce, nil
+}
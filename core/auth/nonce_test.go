@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+func TestGenerateNonce(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, HasNonceGenerator(ctx))
+	nonce, err := GenerateNonce(ctx)
+	require.NoError(t, err)
+	assert.Len(t, nonce, utils.NonceLength)
+
+	generator, err := utils.NewNonceGenerator(16, "0123456789")
+	require.NoError(t, err)
+	ctx = WithNonceGenerator(ctx, generator)
+	assert.True(t, HasNonceGenerator(ctx))
+	nonce, err = GenerateNonce(ctx)
+	require.NoError(t, err)
+	assert.Regexp(t, `^[0-9]{16}$`, nonce)
+
+	_, err = GenerateNonce(WithNonceGenerator(ctx, NonceGeneratorFunc(func() (string, error) {
+		return "short", nil
+	})))
+	assert.Error(t, err)
+
+	errGenerate := errors.New("no entropy")
+	_, err = GenerateNonce(WithNonceGenerator(ctx, NonceGeneratorFunc(func() (string, error) {
+		return "", errGenerate
+	})))
+	assert.Equal(t, errGenerate, err)
+}
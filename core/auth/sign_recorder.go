@@ -0,0 +1,41 @@
+package auth
+
+import "context"
+
+// SignRecord 请求签名的调试信息，可与微信支付签名排查工具的结果对比
+type SignRecord struct {
+	// Message 签名原文，即 HTTP方法\nURL\n时间戳\n随机串\n请求包体\n
+	Message string
+	// Timestamp 签名时间戳
+	Timestamp int64
+	// Nonce 签名随机串
+	Nonce string
+	// Result 签名结果，包括商户号、商户证书序列号与签名值
+	Result *SignatureResult
+}
+
+// SignRecorder 请求签名调试信息的回调，在签名成功后、发送请求前同步执行
+type SignRecorder func(ctx context.Context, record *SignRecord)
+
+type signRecorderContextKey struct{}
+
+// WithSignRecorder 为请求设置签名调试信息的回调，返回更新后的 Context
+//
+// 签名原文包含完整的请求包体，可能含有用户的敏感信息，请仅在排查签名问题时使用，且不要在日志中长期保存。
+// 通过 ctx 设置的回调优先于 option.WithSignRecorder 为 Client 设置的回调
+func WithSignRecorder(ctx context.Context, recorder SignRecorder) context.Context {
+	return context.WithValue(ctx, signRecorderContextKey{}, recorder)
+}
+
+// HasSignRecorder 判断 ctx 中是否已设置签名调试信息的回调
+func HasSignRecorder(ctx context.Context) bool {
+	_, ok := ctx.Value(signRecorderContextKey{}).(SignRecorder)
+	return ok
+}
+
+// RecordSign 调用 ctx 中设置的签名调试信息的回调，未设置时不执行任何操作
+func RecordSign(ctx context.Context, record *SignRecord) {
+	if recorder, ok := ctx.Value(signRecorderContextKey{}).(SignRecorder); ok && recorder != nil {
+		recorder(ctx, record)
+	}
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// SignatureMessage 请求签名原文的组成部分，详见：
+// https://wechatpay-api.gitbook.io/wechatpay-api-v3/qian-ming-zhi-nan-1/qian-ming-sheng-cheng
+//
+// 签名原文为 HTTP方法\nURL\n时间戳\n随机串\n请求包体\n，可用于为 SDK 未封装的请求（如预签名的下载地址、长连接的握手请求）自行签名
+type SignatureMessage struct {
+	Method    string // HTTP 方法，如 GET，总是以大写参与签名
+	URL       string // 请求的绝对路径及查询参数，不包含域名，如 /v3/certificates?offset=0，可使用 CanonicalURL 生成
+	Timestamp int64  // 签名时间戳，单位为秒
+	Nonce     string // 签名随机串
+	Body      string // 请求包体，没有包体的请求（如 GET）为空字符串；上传文件接口为 meta 的 JSON
+}
+
+// NewSignatureMessage 使用请求地址 requestURL（可以是完整地址或绝对路径）构造签名原文，URL 使用 CanonicalURL 生成
+func NewSignatureMessage(method, requestURL string, timestamp int64, nonce, body string) (*SignatureMessage, error) {
+	canonicalURL, err := CanonicalURL(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	return &SignatureMessage{Method: method, URL: canonicalURL, Timestamp: timestamp, Nonce: nonce, Body: body}, nil
+}
+
+// String 返回签名原文
+func (m *SignatureMessage) String() string {
+	return fmt.Sprintf(consts.SignatureMessageFormat, strings.ToUpper(m.Method), m.URL, m.Timestamp, m.Nonce, m.Body)
+}
+
+// CanonicalURL 返回请求地址参与签名的部分：绝对路径及查询参数，不包含协议、域名与片段
+//
+// 参与签名的 URL 须与实际发出的请求完全一致，因此路径与查询参数保持原有的顺序与编码，不会重新排序或解码；
+// 查询参数中未编码的中文、空格等字符按 NormalizeQuery 编码，路径为空时为 /。core.Client 签名与发送请求时使用相同的规则
+func CanonicalURL(requestURL string) (string, error) {
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return "", fmt.Errorf("parse request url err:%v", err)
+	}
+	if u.Opaque != "" {
+		return "", fmt.Errorf("request url %s must be an absolute url or path", requestURL)
+	}
+	u.RawQuery = NormalizeQuery(u.RawQuery)
+	return u.RequestURI(), nil
+}
+
+// NormalizeQuery 对查询参数原文中不允许出现在 URL 中的字符进行百分号编码，其余字符（包括已编码的部分）保持不变
+//
+// 需要编码的字符为中文等非 ASCII 字符、空格与控制字符、"<>\^`{|} 及不构成编码的 %。
+// 这些字符未编码时，微信支付收到的 URL 与签名原文中的 URL 可能不一致，导致 SIGN_ERROR。
+// 已编码的 + 仍表示空格，需要传递 + 本身时请编码为 %2B，或使用 core.BuildRequestURL 构造请求地址
+func NormalizeQuery(rawQuery string) string {
+	var buf strings.Builder
+	for i := 0; i < len(rawQuery); i++ {
+		c := rawQuery[i]
+		if shouldEscapeQueryByte(rawQuery, i) {
+			_, _ = fmt.Fprintf(&buf, "%%%02X", c)
+		} else {
+			buf.WriteByte(c)
+		}
+	}
+	return buf.String()
+}
+
+// shouldEscapeQueryByte 判断查询参数原文中第 i 个字节是否需要编码
+func shouldEscapeQueryByte(rawQuery string, i int) bool {
+	c := rawQuery[i]
+	switch {
+	case c <= ' ' || c >= 0x7f:
+		return true
+	case c == '%':
+		return i+2 >= len(rawQuery) || !isHex(rawQuery[i+1]) || !isHex(rawQuery[i+2])
+	}
+	return strings.IndexByte("\"<>\\^`{|}", c) >= 0
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "full url", url: "https://api.mch.weixin.qq.com/v3/certificates", want: "/v3/certificates"},
+		{name: "path only", url: "/v3/certificates", want: "/v3/certificates"},
+		{name: "empty path", url: "https://api.mch.weixin.qq.com", want: "/"},
+		{name: "fragment removed", url: "https://api.mch.weixin.qq.com/v3/certificates#x", want: "/v3/certificates"},
+		{
+			name: "query order preserved",
+			url:  "https://api.mch.weixin.qq.com/v3/bill/tradebill?bill_date=2019-06-11&bill_type=ALL&a=1",
+			want: "/v3/bill/tradebill?bill_date=2019-06-11&bill_type=ALL&a=1",
+		},
+		{
+			name: "query encoding preserved",
+			url:  "/v3/resource?first=this+is+a+field&second=was+it+clear+%28already%29%3F",
+			want: "/v3/resource?first=this+is+a+field&second=was+it+clear+%28already%29%3F",
+		},
+		{
+			name: "path encoding preserved",
+			url:  "/v3/merchant/media/%E5%9B%BE%E7%89%87.jpg",
+			want: "/v3/merchant/media/%E5%9B%BE%E7%89%87.jpg",
+		},
+		{name: "empty query kept", url: "/v3/certificates?", want: "/v3/certificates?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CanonicalURL(tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := CanonicalURL("mailto:someone@example.com")
+	assert.Error(t, err)
+	_, err = CanonicalURL("https://api.mch.weixin.qq.com/%zz")
+	assert.Error(t, err)
+}
+
+func TestSignatureMessage_String(t *testing.T) {
+	message, err := NewSignatureMessage(
+		"get", "https://api.mch.weixin.qq.com/v3/certificates", 1554208460, "593BEC0C930BF1AFEB40B4A08C8FB242", "",
+	)
+	require.NoError(t, err)
+	// 没有包体的请求，包体为空行
+	assert.Equal(t, "GET\n/v3/certificates\n1554208460\n593BEC0C930BF1AFEB40B4A08C8FB242\n\n", message.String())
+
+	message = &SignatureMessage{
+		Method:    "POST",
+		URL:       "/v3/pay/transactions/native",
+		Timestamp: 1554208460,
+		Nonce:     "593BEC0C930BF1AFEB40B4A08C8FB242",
+		Body:      `{"appid":"wxd678efh567hg6787"}`,
+	}
+	assert.Equal(t,
+		"POST\n/v3/pay/transactions/native\n1554208460\n593BEC0C930BF1AFEB40B4A08C8FB242\n{\"appid\":\"wxd678efh567hg6787\"}\n",
+		message.String(),
+	)
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "", want: ""},
+		{query: "a=1&b=2", want: "a=1&b=2"},
+		{query: "b=2&a=1&b=1", want: "b=2&a=1&b=1"},
+		{query: "name=中文", want: "name=%E4%B8%AD%E6%96%87"},
+		{query: "name=%E4%B8%AD%E6%96%87", want: "name=%E4%B8%AD%E6%96%87"},
+		{query: "q=a b", want: "q=a%20b"},
+		{query: "q=a+b", want: "q=a+b"},
+		{query: "q=%2B", want: "q=%2B"},
+		{query: "q=100%", want: "q=100%25"},
+		{query: "q=%zz%4", want: "q=%25zz%254"},
+		{query: `q="<x>"|{y}^\` + "`", want: "q=%22%3Cx%3E%22%7C%7By%7D%5E%5C%60"},
+		{query: "q=\t\n\x7f", want: "q=%09%0A%7F"},
+		{query: "q=a/b?c:d@e!$'()*,;", want: "q=a/b?c:d@e!$'()*,;"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeQuery(tt.query), tt.query)
+	}
+}
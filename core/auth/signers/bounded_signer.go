@@ -0,0 +1,49 @@
+package signers
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// BoundedSigner 限制并发签名数的签名器
+//
+// RSA 签名是 CPU 密集的操作，突发流量下大量请求同时签名会占满 CPU。
+// BoundedSigner 最多同时执行 concurrency 个签名，其余请求排队等待，ctx 结束时放弃等待并返回 ctx.Err()
+type BoundedSigner struct {
+	signer auth.Signer
+	tokens chan struct{}
+}
+
+// NewBoundedSigner 创建最多同时执行 concurrency 个签名的 BoundedSigner，concurrency 不大于 0 时使用 runtime.NumCPU()
+func NewBoundedSigner(signer auth.Signer, concurrency int) *BoundedSigner {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+	return &BoundedSigner{signer: signer, tokens: make(chan struct{}, concurrency)}
+}
+
+// Sign 等待空闲的签名名额后使用被包装的签名器签名
+func (s *BoundedSigner) Sign(ctx context.Context, message string) (*auth.SignatureResult, error) {
+	select {
+	case s.tokens <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-s.tokens }()
+	return s.signer.Sign(ctx, message)
+}
+
+// Algorithm 返回被包装的签名器使用的签名算法
+func (s *BoundedSigner) Algorithm() string {
+	return s.signer.Algorithm()
+}
+
+// GetMchID 返回被包装的签名器所用的商户号，其未提供商户号时返回空字符串
+func (s *BoundedSigner) GetMchID() string {
+	if signer, ok := s.signer.(interface{ GetMchID() string }); ok {
+		return signer.GetMchID()
+	}
+	return ""
+}
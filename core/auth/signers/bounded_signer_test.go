@@ -0,0 +1,105 @@
+package signers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+type blockingSigner struct {
+	running int32
+	peak    int32
+	release chan struct{}
+}
+
+func (s *blockingSigner) Sign(_ context.Context, message string) (*auth.SignatureResult, error) {
+	running := atomic.AddInt32(&s.running, 1)
+	defer atomic.AddInt32(&s.running, -1)
+	for {
+		peak := atomic.LoadInt32(&s.peak)
+		if running <= peak || atomic.CompareAndSwapInt32(&s.peak, peak, running) {
+			break
+		}
+	}
+	<-s.release
+	return &auth.SignatureResult{Signature: message}, nil
+}
+
+func (s *blockingSigner) Algorithm() string {
+	return "Blocking"
+}
+
+func TestBoundedSigner_Sign(t *testing.T) {
+	signer := &blockingSigner{release: make(chan struct{})}
+	bounded := NewBoundedSigner(signer, 2)
+	assert.Equal(t, "Blocking", bounded.Algorithm())
+	assert.Equal(t, "", bounded.GetMchID())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := bounded.Sign(context.Background(), "message")
+			assert.NoError(t, err)
+			assert.Equal(t, "message", result.Signature)
+		}()
+	}
+
+	// 名额已满时，等待签名的请求可以通过 ctx 取消
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&signer.running) == 2 }, time.Second, time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := bounded.Sign(ctx, "message")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	close(signer.release)
+	wg.Wait()
+	assert.Equal(t, int32(2), signer.peak)
+}
+
+func TestBoundedSigner_GetMchID(t *testing.T) {
+	bounded := NewBoundedSigner(&SHA256WithRSASigner{MchID: testMchID}, 0)
+	assert.Equal(t, testMchID, bounded.GetMchID())
+	assert.Equal(t, "SHA256-RSA2048", bounded.Algorithm())
+	assert.NotZero(t, cap(bounded.tokens))
+}
+
+func BenchmarkSHA256WithRSASigner_Sign(b *testing.B) {
+	privateKey, err := utils.LoadPrivateKey(testPrivateKeyStr)
+	require.NoError(b, err)
+	signer := &SHA256WithRSASigner{MchID: testMchID, CertificateSerialNo: testCertificateSerial, PrivateKey: privateKey}
+	ctx := context.Background()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := signer.Sign(ctx, testMessage); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkBoundedSigner_Sign(b *testing.B) {
+	privateKey, err := utils.LoadPrivateKey(testPrivateKeyStr)
+	require.NoError(b, err)
+	signer := NewBoundedSigner(
+		&SHA256WithRSASigner{MchID: testMchID, CertificateSerialNo: testCertificateSerial, PrivateKey: privateKey}, 0,
+	)
+	ctx := context.Background()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := signer.Sign(ctx, testMessage); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
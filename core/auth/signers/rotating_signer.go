@@ -0,0 +1,113 @@
+package signers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// RotatingSigner 支持平滑更换商户 API 证书的签名器
+//
+// 商户在商户平台申请新的 API 证书后，新旧证书在旧证书吊销前同时有效。使用 Schedule 注册新证书的签名器与切换时间，
+// 切换时间之前使用旧签名器签名，之后（以 ctx 中的时钟为准，见 auth.Now）使用新签名器签名。
+// 每次签名开始时选择签名器，切换时正在进行的签名仍使用旧签名器完成，因此不会影响进行中的请求。
+// 请在新证书切换完成、且所有使用旧证书签名的请求结束后再吊销旧证书。
+type RotatingSigner struct {
+	lock    sync.RWMutex
+	current auth.Signer
+	next    auth.Signer
+	cutover time.Time
+}
+
+// NewRotatingSigner 使用当前的签名器创建 RotatingSigner
+func NewRotatingSigner(current auth.Signer) *RotatingSigner {
+	return &RotatingSigner{current: current}
+}
+
+// Schedule 注册新的签名器，在 cutover 之后使用其签名。已注册但未切换的签名器将被替换。
+// 新签名器的商户号需与当前签名器一致
+func (s *RotatingSigner) Schedule(next auth.Signer, cutover time.Time) error {
+	if next == nil {
+		return fmt.Errorf("next signer is required")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if mchID, nextMchID := getMchID(s.current), getMchID(next); mchID != nextMchID {
+		return fmt.Errorf("next signer mchid %s mismatches current signer mchid %s", nextMchID, mchID)
+	}
+	s.next, s.cutover = next, cutover
+	return nil
+}
+
+// Rotate 立即切换为新的签名器，并丢弃已注册但未切换的签名器
+func (s *RotatingSigner) Rotate(next auth.Signer) error {
+	return s.Schedule(next, time.Time{})
+}
+
+// Cancel 取消已注册但未切换的签名器，返回是否存在这样的签名器。已切换的签名器不受影响
+func (s *RotatingSigner) Cancel(ctx context.Context) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.promote(auth.Now(ctx))
+	canceled := s.next != nil
+	s.next, s.cutover = nil, time.Time{}
+	return canceled
+}
+
+// Current 返回 ctx 中的当前时间所使用的签名器
+func (s *RotatingSigner) Current(ctx context.Context) auth.Signer {
+	now := auth.Now(ctx)
+	s.lock.RLock()
+	if s.next == nil || now.Before(s.cutover) {
+		defer s.lock.RUnlock()
+		return s.current
+	}
+	s.lock.RUnlock()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.promote(now)
+	return s.current
+}
+
+// Pending 返回已注册但未切换的签名器及其切换时间，不存在时 ok 为 false
+func (s *RotatingSigner) Pending(ctx context.Context) (next auth.Signer, cutover time.Time, ok bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.promote(auth.Now(ctx))
+	return s.next, s.cutover, s.next != nil
+}
+
+// promote 到达切换时间后使用新的签名器替换当前的签名器，调用方需持有写锁
+func (s *RotatingSigner) promote(now time.Time) {
+	if s.next != nil && !now.Before(s.cutover) {
+		s.current, s.next, s.cutover = s.next, nil, time.Time{}
+	}
+}
+
+// Sign 使用当前时间所对应的签名器签名
+func (s *RotatingSigner) Sign(ctx context.Context, message string) (*auth.SignatureResult, error) {
+	return s.Current(ctx).Sign(ctx, message)
+}
+
+// Algorithm 返回当前签名器使用的签名算法
+func (s *RotatingSigner) Algorithm() string {
+	return s.Current(context.Background()).Algorithm()
+}
+
+// GetMchID 返回签名所用的商户号，当前签名器未提供商户号时返回空字符串
+func (s *RotatingSigner) GetMchID() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return getMchID(s.current)
+}
+
+func getMchID(signer auth.Signer) string {
+	if signer, ok := signer.(interface{ GetMchID() string }); ok {
+		return signer.GetMchID()
+	}
+	return ""
+}
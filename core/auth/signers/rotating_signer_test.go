@@ -0,0 +1,102 @@
+package signers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+type serialSigner struct {
+	mchID    string
+	serialNo string
+}
+
+func (s serialSigner) Sign(_ context.Context, message string) (*auth.SignatureResult, error) {
+	return &auth.SignatureResult{MchID: s.mchID, CertificateSerialNo: s.serialNo, Signature: message}, nil
+}
+
+func (s serialSigner) Algorithm() string {
+	return "Serial"
+}
+
+func (s serialSigner) GetMchID() string {
+	return s.mchID
+}
+
+func signAt(t *testing.T, signer auth.Signer, now time.Time) string {
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+	result, err := signer.Sign(ctx, "source")
+	require.NoError(t, err)
+	return result.CertificateSerialNo
+}
+
+func TestRotatingSigner_Schedule(t *testing.T) {
+	cutover := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	signer := NewRotatingSigner(serialSigner{mchID: "1900009191", serialNo: "OLD"})
+	assert.Equal(t, "1900009191", signer.GetMchID())
+	assert.Equal(t, "Serial", signer.Algorithm())
+
+	require.NoError(t, signer.Schedule(serialSigner{mchID: "1900009191", serialNo: "NEW"}, cutover))
+	assert.Equal(t, "OLD", signAt(t, signer, cutover.Add(-time.Second)))
+
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return cutover.Add(-time.Second) }))
+	next, at, ok := signer.Pending(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "NEW", next.(serialSigner).serialNo)
+	assert.Equal(t, cutover, at)
+
+	assert.Equal(t, "NEW", signAt(t, signer, cutover))
+	// 切换后即使时钟回拨，也不会切回旧签名器
+	assert.Equal(t, "NEW", signAt(t, signer, cutover.Add(-time.Hour)))
+	_, _, ok = signer.Pending(ctx)
+	assert.False(t, ok)
+}
+
+func TestRotatingSigner_CancelAndRotate(t *testing.T) {
+	ctx := context.Background()
+	signer := NewRotatingSigner(serialSigner{mchID: "1900009191", serialNo: "OLD"})
+
+	assert.False(t, signer.Cancel(ctx))
+	require.NoError(t, signer.Schedule(serialSigner{mchID: "1900009191", serialNo: "NEW"}, time.Now().Add(time.Hour)))
+	assert.True(t, signer.Cancel(ctx))
+	assert.Equal(t, "OLD", signAt(t, signer, time.Now().Add(2*time.Hour)))
+
+	require.NoError(t, signer.Rotate(serialSigner{mchID: "1900009191", serialNo: "NEW"}))
+	assert.Equal(t, "NEW", signAt(t, signer, time.Now()))
+
+	assert.Error(t, signer.Schedule(nil, time.Now()))
+	assert.Error(t, signer.Schedule(serialSigner{mchID: "1230000109", serialNo: "OTHER"}, time.Now()))
+	assert.Equal(t, "NEW", signAt(t, signer, time.Now()))
+}
+
+func TestRotatingSigner_Concurrent(t *testing.T) {
+	signer := NewRotatingSigner(serialSigner{mchID: "1900009191", serialNo: "OLD"})
+	cutover := time.Now().Add(20 * time.Millisecond)
+	require.NoError(t, signer.Schedule(serialSigner{mchID: "1900009191", serialNo: "NEW"}, cutover))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				start := time.Now()
+				result, err := signer.Sign(context.Background(), "source")
+				if !assert.NoError(t, err) {
+					return
+				}
+				if start.After(cutover) {
+					assert.Equal(t, "NEW", result.CertificateSerialNo)
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, "NEW", signAt(t, signer, time.Now()))
+}
@@ -33,6 +33,11 @@ func (s *SHA256WithRSASigner) Sign(_ context.Context, message string) (*auth.Sig
 	return &auth.SignatureResult{MchID: s.MchID, CertificateSerialNo: s.CertificateSerialNo, Signature: signature}, nil
 }
 
+// GetMchID 返回签名所用的商户号
+func (s *SHA256WithRSASigner) GetMchID() string {
+	return s.MchID
+}
+
 // Algorithm 返回使用的签名算法：SHA256-RSA2048
 func (s *SHA256WithRSASigner) Algorithm() string {
 	return "SHA256-RSA2048"
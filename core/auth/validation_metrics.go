@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidationOutcome 验签结果的分类
+type ValidationOutcome string
+
+// 验签结果的分类，可用于区分时钟偏差与密钥配置错误
+const (
+	// ValidationSuccess 验签通过
+	ValidationSuccess ValidationOutcome = "success"
+	// ValidationMissingHeader 缺少微信支付签名头或其格式有误
+	ValidationMissingHeader ValidationOutcome = "missing_header"
+	// ValidationTimestampExpired 报文时间戳与本地时钟的偏差超过 5 分钟，通常是本地时钟偏差或报文被重放
+	ValidationTimestampExpired ValidationOutcome = "timestamp_expired"
+	// ValidationUnknownSerial 验签器中没有报文的 Wechatpay-Serial 对应的平台证书或微信支付公钥
+	ValidationUnknownSerial ValidationOutcome = "unknown_serial"
+	// ValidationSignatureMismatch 签名与报文不匹配，通常是使用了错误的平台证书或微信支付公钥，或报文被篡改
+	ValidationSignatureMismatch ValidationOutcome = "signature_mismatch"
+	// ValidationReplayed 签名正确，但报文的 Wechatpay-Nonce 已被使用过，报文可能被重放
+	ValidationReplayed ValidationOutcome = "replayed"
+)
+
+// 被验签的报文类型
+const (
+	// ValidationTargetResponse API 应答
+	ValidationTargetResponse = "response"
+	// ValidationTargetNotify 回调通知
+	ValidationTargetNotify = "notify"
+)
+
+// ValidationMetrics 验签结果的计数接口，可基于 Prometheus 等监控系统实现，实现需支持并发调用
+type ValidationMetrics interface {
+	// IncValidation 将 target（ValidationTargetResponse 或 ValidationTargetNotify）的 outcome 计数加一
+	IncValidation(ctx context.Context, target string, outcome ValidationOutcome)
+}
+
+// ValidationMetricsFunc 将函数转换为 ValidationMetrics
+type ValidationMetricsFunc func(ctx context.Context, target string, outcome ValidationOutcome)
+
+// IncValidation 调用 f
+func (f ValidationMetricsFunc) IncValidation(ctx context.Context, target string, outcome ValidationOutcome) {
+	f(ctx, target, outcome)
+}
+
+// SerialNotFoundError 验签器中没有序列号对应的平台证书或微信支付公钥，Verifier 的实现应返回该错误以便区分验签结果
+type SerialNotFoundError struct {
+	// Kind 密钥类型，如 certificate 或 public key
+	Kind string
+	// SerialNo 平台证书序列号或微信支付公钥ID
+	SerialNo string
+}
+
+func (e *SerialNotFoundError) Error() string {
+	return fmt.Sprintf("%s[%s] not found in verifier", e.Kind, e.SerialNo)
+}
+
+type validationMetricsContextKey struct{}
+
+// WithValidationMetrics 为请求设置验签结果的计数接口，返回更新后的 Context
+//
+// 通过 ctx 设置的计数接口优先于 option.WithValidationMetrics 为 Client 设置的计数接口。
+// 验证回调通知时，请为 notify.Handler.ParseNotifyRequest 的 ctx 设置计数接口
+func WithValidationMetrics(ctx context.Context, metrics ValidationMetrics) context.Context {
+	return context.WithValue(ctx, validationMetricsContextKey{}, metrics)
+}
+
+// HasValidationMetrics 判断 ctx 中是否已设置验签结果的计数接口
+func HasValidationMetrics(ctx context.Context) bool {
+	_, ok := ctx.Value(validationMetricsContextKey{}).(ValidationMetrics)
+	return ok
+}
+
+// RecordValidation 调用 ctx 中设置的验签结果的计数接口，未设置时不执行任何操作
+func RecordValidation(ctx context.Context, target string, outcome ValidationOutcome) {
+	if metrics, ok := ctx.Value(validationMetricsContextKey{}).(ValidationMetrics); ok && metrics != nil {
+		metrics.IncValidation(ctx, target, outcome)
+	}
+}
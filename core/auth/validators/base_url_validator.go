@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// BaseURLValidator 按请求的 API 地址选择应答报文验证器
+//
+// 适用于同一程序既访问微信支付、又访问使用自有密钥签名的模拟器或测试网关的场景：
+// 发往模拟器地址的请求使用模拟器的证书验签，其他请求仍使用微信支付平台证书验签。
+type BaseURLValidator struct {
+	fallback auth.Validator
+	routes   []baseURLRoute
+}
+
+type baseURLRoute struct {
+	scheme    string
+	host      string
+	path      string
+	validator auth.Validator
+}
+
+// NewBaseURLValidator 创建 BaseURLValidator
+//
+// validators 的键为 API 地址，如 http://wxpay-simulator:8080 或 https://example.com/wxpay，请求地址以其为前缀时使用对应的验证器，
+// 多个地址匹配时使用最长的地址；未匹配任何地址的请求使用 fallback 验证
+func NewBaseURLValidator(fallback auth.Validator, validators map[string]auth.Validator) (*BaseURLValidator, error) {
+	if fallback == nil {
+		return nil, fmt.Errorf("fallback validator is required")
+	}
+	v := &BaseURLValidator{fallback: fallback}
+	for baseURL, validator := range validators {
+		if validator == nil {
+			return nil, fmt.Errorf("validator for base url %q is nil", baseURL)
+		}
+		u, err := url.Parse(baseURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" ||
+			u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+			return nil, fmt.Errorf("invalid base url %q, scheme and host are required", baseURL)
+		}
+		v.routes = append(v.routes, baseURLRoute{
+			scheme:    u.Scheme,
+			host:      strings.ToLower(u.Host),
+			path:      strings.TrimSuffix(u.Path, "/"),
+			validator: validator,
+		})
+	}
+	sort.Slice(v.routes, func(i, j int) bool {
+		return len(v.routes[i].path) > len(v.routes[j].path)
+	})
+	return v, nil
+}
+
+// Validate 使用与请求地址匹配的验证器对应答报文进行验证
+func (v *BaseURLValidator) Validate(ctx context.Context, response *http.Response) error {
+	return v.match(response).Validate(ctx, response)
+}
+
+func (v *BaseURLValidator) match(response *http.Response) auth.Validator {
+	if response == nil || response.Request == nil || response.Request.URL == nil {
+		return v.fallback
+	}
+	u := response.Request.URL
+	host := strings.ToLower(u.Host)
+	for _, route := range v.routes {
+		if route.scheme != u.Scheme || route.host != host {
+			continue
+		}
+		if route.path == "" || u.Path == route.path || strings.HasPrefix(u.Path, route.path+"/") {
+			return route.validator
+		}
+	}
+	return v.fallback
+}
@@ -0,0 +1,67 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+type namedValidator string
+
+func (v namedValidator) Validate(context.Context, *http.Response) error {
+	return fmt.Errorf("%s", string(v))
+}
+
+func TestBaseURLValidator_Validate(t *testing.T) {
+	validator, err := NewBaseURLValidator(namedValidator("wechatpay"), map[string]auth.Validator{
+		"http://wxpay-simulator:8080":          namedValidator("simulator"),
+		"https://staging.example.com/wxpay/":   namedValidator("staging"),
+		"https://staging.example.com/wxpay/v2": namedValidator("staging-v2"),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "http://wxpay-simulator:8080/v3/certificates", want: "simulator"},
+		{url: "http://WXPAY-SIMULATOR:8080/v3/certificates", want: "simulator"},
+		{url: "https://wxpay-simulator:8080/v3/certificates", want: "wechatpay"},
+		{url: "http://wxpay-simulator/v3/certificates", want: "wechatpay"},
+		{url: "https://staging.example.com/wxpay", want: "staging"},
+		{url: "https://staging.example.com/wxpay/v3/certificates", want: "staging"},
+		{url: "https://staging.example.com/wxpay/v2/certificates", want: "staging-v2"},
+		{url: "https://staging.example.com/wxpayment/v3/certificates", want: "wechatpay"},
+		{url: "https://api.mch.weixin.qq.com/v3/certificates", want: "wechatpay"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+			err = validator.Validate(context.Background(), &http.Response{Request: req})
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+
+	assert.EqualError(t, validator.Validate(context.Background(), &http.Response{}), "wechatpay")
+}
+
+func TestNewBaseURLValidator(t *testing.T) {
+	_, err := NewBaseURLValidator(nil, nil)
+	assert.Error(t, err)
+
+	for _, baseURL := range []string{"wxpay-simulator:8080", "ftp://wxpay-simulator", "http://wxpay-simulator?a=1", "http://"} {
+		_, err = NewBaseURLValidator(namedValidator("wechatpay"), map[string]auth.Validator{
+			baseURL: namedValidator("simulator"),
+		})
+		assert.Error(t, err, baseURL)
+	}
+
+	_, err = NewBaseURLValidator(namedValidator("wechatpay"), map[string]auth.Validator{"http://wxpay-simulator": nil})
+	assert.Error(t, err)
+}
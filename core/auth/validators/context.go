@@ -0,0 +1,41 @@
+package validators
+
+import "context"
+
+type contextKey string
+
+func (c contextKey) String() string {
+	return "WPValidatorContext(" + string(c) + ")"
+}
+
+const (
+	// 应答包体的验签方式
+	contextKeyBodyMode contextKey = "BodyMode"
+)
+
+// BodyMode 应答包体的验签方式
+type BodyMode int
+
+const (
+	// BodyModeBuffered 读取并缓存完整的应答包体后验签，为默认方式
+	BodyModeBuffered BodyMode = iota
+	// BodyModeEmpty 仅使用应答头验签，签名对应的包体为空，适用于 204 No Content 等无包体的应答。该方式不会读取应答包体
+	BodyModeEmpty
+	// BodyModeStream 以流的方式读取应答包体，读取到包体末尾时验签，验签失败时 Read 返回验签错误而非 io.EOF。
+	// 应答头在 Validate 时即被检查，但在读到包体末尾前已读取的内容均未经验证；提前关闭包体将跳过验签
+	BodyModeStream
+)
+
+// WithBodyMode 设置本次请求应答包体的验签方式，返回更新后的 Context
+//
+//	ctx = validators.WithBodyMode(ctx, validators.BodyModeStream)
+//	result, err := client.Get(ctx, url)
+func WithBodyMode(ctx context.Context, mode BodyMode) context.Context {
+	return context.WithValue(ctx, contextKeyBodyMode, mode)
+}
+
+// getBodyMode 从 Context 中读取应答包体的验签方式，未设置时为 BodyModeBuffered
+func getBodyMode(ctx context.Context) BodyMode {
+	mode, _ := ctx.Value(contextKeyBodyMode).(BodyMode)
+	return mode
+}
@@ -0,0 +1,74 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// NonceTTL 随机串的保留时间。时间戳与当前时间偏差在 5 分钟内的报文均被接受，因此随机串需至少保留 10 分钟
+const NonceTTL = 2 * consts.FiveMinute * time.Second
+
+// NonceStore 已使用的报文随机串（Wechatpay-Nonce）存储，用于检查通知请求是否被重放，实现需支持并发调用
+//
+// 多实例部署时请使用 Redis、数据库等共享存储，store 包提供了基于 database/sql 与 Redis 的实现
+type NonceStore interface {
+	// Add 记录随机串 nonce 并保留 ttl，nonce 已被记录且尚未过期时返回 false
+	Add(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
+
+// checkReplay 在签名正确后记录报文的随机串，随机串已被使用过时返回错误
+func (v *wechatPayValidator) checkReplay(ctx context.Context, header http.Header, args wechatPayHeaders) error {
+	if v.nonceStore == nil {
+		return nil
+	}
+	added, err := v.nonceStore.Add(ctx, args.Nonce, NonceTTL)
+	if err != nil {
+		return fmt.Errorf("check nonce err:%v request-id=[%s]", err, header.Get(consts.RequestID))
+	}
+	if !added {
+		auth.RecordValidation(ctx, v.target, auth.ValidationReplayed)
+		return fmt.Errorf("notify replayed. nonce=[%s] request-id=[%s]", args.Nonce, header.Get(consts.RequestID))
+	}
+	return nil
+}
+
+// MemoryNonceStore 基于内存的 NonceStore，适用于单实例部署或测试
+type MemoryNonceStore struct {
+	lock      sync.Mutex
+	expires   map[string]time.Time
+	lastSweep time.Time
+}
+
+// NewMemoryNonceStore 创建 MemoryNonceStore
+func NewMemoryNonceStore() *MemoryNonceStore {
+	return &MemoryNonceStore{expires: make(map[string]time.Time)}
+}
+
+// Add 记录随机串 nonce 并保留 ttl，nonce 已被记录且尚未过期时返回 false
+func (s *MemoryNonceStore) Add(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	now := auth.Now(ctx)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// 每过 ttl 清理一次过期的随机串
+	if now.Sub(s.lastSweep) >= ttl {
+		for key, expire := range s.expires {
+			if !now.Before(expire) {
+				delete(s.expires, key)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	if expire, ok := s.expires[nonce]; ok && now.Before(expire) {
+		return false, nil
+	}
+	s.expires[nonce] = now.Add(ttl)
+	return true, nil
+}
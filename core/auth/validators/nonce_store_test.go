@@ -0,0 +1,75 @@
+package validators
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+type failingNonceStore struct{}
+
+func (failingNonceStore) Add(context.Context, string, time.Duration) (bool, error) {
+	return false, fmt.Errorf("mock store error")
+}
+
+func TestWechatPayNotifyValidator_NonceStore(t *testing.T) {
+	const body = `{"a":"b"}`
+	metrics := &countingMetrics{}
+	ctx := auth.WithValidationMetrics(context.Background(), metrics)
+	validator := NewWechatPayNotifyValidatorWithNonceStore(&mockVerifier{}, NewMemoryNonceStore())
+
+	header := newMetricsTestHeader("SERIAL1", time.Now().Unix(), body)
+	require.NoError(t, validator.Validate(ctx, header, []byte(body)))
+	err := validator.Validate(ctx, header, []byte(body))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notify replayed")
+
+	// 以流的方式验签时同样检查随机串
+	reader, err := validator.ValidateStream(ctx, header, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(reader)
+	assert.Error(t, err)
+
+	// 签名错误的报文不会记录随机串
+	tampered := newMetricsTestHeader("SERIAL1", time.Now().Unix(), body)
+	tampered.Set("Wechatpay-Nonce", "ANOTHERNONCE")
+	assert.Error(t, validator.Validate(ctx, tampered, []byte(body)))
+
+	assert.Equal(t, map[string]int{
+		"notify/success":            1,
+		"notify/replayed":           2,
+		"notify/signature_mismatch": 1,
+	}, metrics.counts)
+
+	failing := NewWechatPayNotifyValidatorWithNonceStore(&mockVerifier{}, failingNonceStore{})
+	err = failing.Validate(ctx, header, []byte(body))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mock store error")
+}
+
+func TestMemoryNonceStore_Add(t *testing.T) {
+	now := time.Now()
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+	store := NewMemoryNonceStore()
+
+	added, err := store.Add(ctx, "NONCE1", NonceTTL)
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, _ = store.Add(ctx, "NONCE1", NonceTTL)
+	assert.False(t, added)
+	added, _ = store.Add(ctx, "NONCE2", NonceTTL)
+	assert.True(t, added)
+
+	// 过期后随机串可以再次使用，过期的随机串会被清理
+	now = now.Add(NonceTTL)
+	added, _ = store.Add(ctx, "NONCE1", NonceTTL)
+	assert.True(t, added)
+	assert.Len(t, store.expires, 1)
+}
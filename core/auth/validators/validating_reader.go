@@ -0,0 +1,73 @@
+package validators
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// validatingReader 在读取到包体末尾时对已读取的完整包体验签，验签失败时 Read 返回验签错误而非 io.EOF
+//
+// Verifier 实现了 auth.DigestVerifier 时边读取边计算签名原文的摘要，不缓存包体；否则缓存完整的包体后验签
+type validatingReader struct {
+	body   io.ReadCloser
+	hash   hash.Hash
+	buf    bytes.Buffer
+	verify func() error
+	err    error
+}
+
+// newValidatingReader 返回在读取到 body 末尾时验签的 validatingReader，args 为已检查的微信支付签名头
+func (v *wechatPayValidator) newValidatingReader(
+	ctx context.Context, header http.Header, args wechatPayHeaders, body io.ReadCloser,
+) *validatingReader {
+	r := &validatingReader{body: body}
+	digestVerifier, ok := v.verifier.(auth.DigestVerifier)
+	if !ok {
+		r.verify = func() error {
+			return v.verifyHTTPMessage(ctx, header, args, r.buf.Bytes())
+		}
+		return r
+	}
+
+	r.hash = sha256.New()
+	_, _ = fmt.Fprintf(r.hash, "%d\n%s\n", args.Timestamp, args.Nonce)
+	r.verify = func() error {
+		_, _ = r.hash.Write([]byte("\n"))
+		err := digestVerifier.VerifyDigest(ctx, args.SerialNo, r.hash.Sum(nil), args.Signature)
+		return v.verifyResult(ctx, header, args, err)
+	}
+	return r
+}
+
+// Read 读取包体，读取到包体末尾时返回验签结果
+func (r *validatingReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.body.Read(p)
+	if r.hash != nil {
+		_, _ = r.hash.Write(p[:n])
+	} else {
+		r.buf.Write(p[:n])
+	}
+	if err == io.EOF {
+		if verr := r.verify(); verr != nil {
+			err = verr
+		}
+		r.buf.Reset()
+		r.err = err
+	}
+	return n, err
+}
+
+// Close 关闭包体
+func (r *validatingReader) Close() error {
+	return r.body.Close()
+}
@@ -0,0 +1,144 @@
+package validators
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// countingMetrics 按报文类型与验签结果计数
+type countingMetrics struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (m *countingMetrics) IncValidation(_ context.Context, target string, outcome auth.ValidationOutcome) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.counts == nil {
+		m.counts = make(map[string]int)
+	}
+	m.counts[target+"/"+string(outcome)]++
+}
+
+// knownSerialVerifier 仅认识 serialNo 的 mockVerifier
+type knownSerialVerifier struct {
+	mockVerifier
+	serialNo string
+}
+
+func (v *knownSerialVerifier) Verify(ctx context.Context, serialNumber, message, signature string) error {
+	if serialNumber != v.serialNo {
+		return &auth.SerialNotFoundError{Kind: "certificate", SerialNo: serialNumber}
+	}
+	return v.mockVerifier.Verify(ctx, serialNumber, message, signature)
+}
+
+func newMetricsTestHeader(serialNo string, timestamp int64, body string) http.Header {
+	verifier := &mockVerifier{}
+	header := http.Header{}
+	header.Set(consts.RequestID, "any-request-id")
+	header.Set(consts.WechatPaySerial, serialNo)
+	header.Set(consts.WechatPayNonce, "NONCE1234567890")
+	header.Set(consts.WechatPayTimestamp, fmt.Sprint(timestamp))
+	header.Set(consts.WechatPaySignature, verifier.pack(
+		fmt.Sprintf("%s-%d\nNONCE1234567890\n%s\n", serialNo, timestamp, body),
+	))
+	return header
+}
+
+func TestValidationMetrics(t *testing.T) {
+	const body = `{"a":"b"}`
+	now := time.Now().Unix()
+	validator := NewWechatPayResponseValidator(&knownSerialVerifier{serialNo: "SERIAL1"})
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		outcome auth.ValidationOutcome
+	}{
+		{name: "success", header: newMetricsTestHeader("SERIAL1", now, body), outcome: auth.ValidationSuccess},
+		{
+			name: "missing header",
+			header: func() http.Header {
+				h := newMetricsTestHeader("SERIAL1", now, body)
+				h.Del(consts.WechatPaySignature)
+				return h
+			}(),
+			outcome: auth.ValidationMissingHeader,
+		},
+		{
+			name: "invalid timestamp",
+			header: func() http.Header {
+				h := newMetricsTestHeader("SERIAL1", now, body)
+				h.Set(consts.WechatPayTimestamp, "not a number")
+				return h
+			}(),
+			outcome: auth.ValidationMissingHeader,
+		},
+		{
+			name:    "timestamp expired",
+			header:  newMetricsTestHeader("SERIAL1", now-6*60, body),
+			outcome: auth.ValidationTimestampExpired,
+		},
+		{name: "unknown serial", header: newMetricsTestHeader("SERIAL2", now, body), outcome: auth.ValidationUnknownSerial},
+		{
+			name:    "signature mismatch",
+			header:  newMetricsTestHeader("SERIAL1", now, `{"a":"c"}`),
+			outcome: auth.ValidationSignatureMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := &countingMetrics{}
+			ctx := auth.WithValidationMetrics(context.Background(), metrics)
+			err := validator.Validate(ctx, &http.Response{
+				Header: tt.header, Body: ioutil.NopCloser(bytes.NewBufferString(body)),
+			})
+			assert.Equal(t, tt.outcome == auth.ValidationSuccess, err == nil, "err: %v", err)
+			assert.Equal(t, map[string]int{auth.ValidationTargetResponse + "/" + string(tt.outcome): 1}, metrics.counts)
+		})
+	}
+
+	// 未设置计数接口时不影响验签
+	assert.NoError(t, validator.Validate(context.Background(), &http.Response{
+		Header: newMetricsTestHeader("SERIAL1", now, body), Body: ioutil.NopCloser(bytes.NewBufferString(body)),
+	}))
+}
+
+func TestValidationMetrics_StreamAndNotify(t *testing.T) {
+	const body = `{"a":"b"}`
+	now := time.Now().Unix()
+	metrics := &countingMetrics{}
+	ctx := auth.WithValidationMetrics(context.Background(), metrics)
+
+	// 以流的方式验签时，读取到包体末尾后记录验签结果
+	response := &http.Response{
+		Header: newMetricsTestHeader("SERIAL1", now, body), Body: ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+	validator := NewWechatPayResponseValidator(&mockDigestVerifier{})
+	require.NoError(t, validator.Validate(WithBodyMode(ctx, BodyModeStream), response))
+	assert.Empty(t, metrics.counts)
+	_, err := ioutil.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	notifyValidator := NewWechatPayNotifyValidator(&knownSerialVerifier{serialNo: "SERIAL1"})
+	assert.Error(t, notifyValidator.Validate(ctx, newMetricsTestHeader("SERIAL2", now, body), []byte(body)))
+	assert.Error(t, notifyValidator.Validate(ctx, newMetricsTestHeader("SERIAL1", now-6*60, body), []byte(body)))
+
+	assert.Equal(t, map[string]int{
+		"response/success":         1,
+		"notify/unknown_serial":    1,
+		"notify/timestamp_expired": 1,
+	}, metrics.counts)
+}
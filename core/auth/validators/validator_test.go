@@ -3,6 +3,7 @@ package validators
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
 )
 
@@ -44,6 +46,21 @@ func (v *mockVerifier) Verify(ctx context.Context, serialNumber string, message
 	return fmt.Errorf("verification failed: sign(actual=%s expected=%s", dump(signActual), dump(signature))
 }
 
+// mockDigestVerifier 使用签名原文的摘要验签的 mockVerifier
+type mockDigestVerifier struct {
+	mockVerifier
+	digested bool
+}
+
+func (v *mockDigestVerifier) VerifyDigest(ctx context.Context, serialNumber string, digest []byte, signature string) error {
+	v.digested = true
+	message := strings.TrimPrefix(v.unpack(signature), serialNumber+"-")
+	if expected := sha256.Sum256([]byte(message)); bytes.Equal(expected[:], digest) {
+		return nil
+	}
+	return fmt.Errorf("verification failed: digest mismatch")
+}
+
 func TestWechatPayResponseValidator_Validate(t *testing.T) {
 	mockTimestamp := time.Now().Unix()
 	mockTimestampStr := fmt.Sprintf("%d", mockTimestamp)
@@ -144,6 +161,65 @@ func TestWechatPayResponseValidator_Validate(t *testing.T) {
 	}
 }
 
+func TestWechatPayResponseValidator_ValidateBodyMode(t *testing.T) {
+	verifier := &mockVerifier{}
+	validator := NewWechatPayResponseValidator(verifier)
+
+	newResponse := func(signedBody, body string) *http.Response {
+		timestamp := fmt.Sprintf("%d", time.Now().Unix())
+		return &http.Response{
+			Header: http.Header{
+				consts.WechatPaySignature: {verifier.pack("SERIAL1234567890-" + timestamp + "\nNONCE1234567890\n" + signedBody + "\n")},
+				consts.WechatPaySerial:    {"SERIAL1234567890"},
+				consts.WechatPayTimestamp: {timestamp},
+				consts.WechatPayNonce:     {"NONCE1234567890"},
+				consts.RequestID:          {"any-request-id"},
+			},
+			Body: ioutil.NopCloser(bytes.NewBufferString(body)),
+		}
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		ctx := WithBodyMode(context.Background(), BodyModeEmpty)
+		response := newResponse("", "BODY")
+		require.NoError(t, validator.Validate(ctx, response))
+		// 不读取应答包体
+		body, err := ioutil.ReadAll(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "BODY", string(body))
+
+		assert.Error(t, validator.Validate(ctx, newResponse("BODY", "BODY")))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		ctx := WithBodyMode(context.Background(), BodyModeStream)
+		response := newResponse("BODY", "BODY")
+		require.NoError(t, validator.Validate(ctx, response))
+		body, err := ioutil.ReadAll(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "BODY", string(body))
+		assert.NoError(t, response.Body.Close())
+
+		response = newResponse("BODY", "TAMPERED")
+		require.NoError(t, validator.Validate(ctx, response))
+		_, err = ioutil.ReadAll(response.Body)
+		assert.Error(t, err)
+		// 验签失败后继续读取，仍返回验签错误
+		_, err = response.Body.Read(make([]byte, 1))
+		assert.Error(t, err)
+
+		response = newResponse("BODY", "BODY")
+		response.Header.Del(consts.WechatPayNonce)
+		assert.Error(t, validator.Validate(ctx, response))
+	})
+
+	t.Run("buffered", func(t *testing.T) {
+		ctx := WithBodyMode(context.Background(), BodyModeBuffered)
+		assert.NoError(t, validator.Validate(ctx, newResponse("BODY", "BODY")))
+		assert.Error(t, validator.Validate(ctx, newResponse("", "BODY")))
+	})
+}
+
 func Test_WechatPayNotifyValidator_Validate(t *testing.T) {
 	type args struct {
 		response *http.Response
@@ -279,3 +355,66 @@ func TestNullValidator_Validate(t *testing.T) {
 	assert.NoError(t, nullValidator.Validate(context.Background(), &http.Response{}))
 	assert.NoError(t, nullValidator.Validate(context.Background(), nil))
 }
+
+func TestWechatPayResponseValidator_ValidateWithClock(t *testing.T) {
+	// 应答时间戳为一小时前，使用冻结在该时间附近的时钟时仍可通过校验
+	timestamp := time.Now().Add(-time.Hour).Unix()
+	timestampStr := strconv.FormatInt(timestamp, 10)
+	newResponse := func() *http.Response {
+		return &http.Response{
+			Header: http.Header{
+				consts.WechatPaySignature: {(&mockVerifier{}).pack("SERIAL1234567890-" + timestampStr + "\nNONCE1234567890\nBODY\n")},
+				consts.WechatPaySerial:    {"SERIAL1234567890"},
+				consts.WechatPayTimestamp: {timestampStr},
+				consts.WechatPayNonce:     {"NONCE1234567890"},
+			},
+			Body: ioutil.NopCloser(bytes.NewBuffer([]byte("BODY"))),
+		}
+	}
+	verifier := &mockVerifier{}
+	validator := NewWechatPayResponseValidator(verifier)
+
+	assert.Error(t, validator.Validate(context.Background(), newResponse()))
+
+	ctx := auth.WithClock(context.Background(), auth.OffsetClock(nil, -time.Hour))
+	assert.NoError(t, validator.Validate(ctx, newResponse()))
+}
+
+func TestWechatPayNotifyValidator_ValidateStream(t *testing.T) {
+	timestampStr := strconv.FormatInt(time.Now().Unix(), 10)
+	header := http.Header{
+		consts.WechatPaySignature: {(&mockVerifier{}).pack("SERIAL1234567890-" + timestampStr + "\nNONCE1234567890\nBODY\n")},
+		consts.WechatPaySerial:    {"SERIAL1234567890"},
+		consts.WechatPayTimestamp: {timestampStr},
+		consts.WechatPayNonce:     {"NONCE1234567890"},
+	}
+	digestVerifier := &mockDigestVerifier{}
+	verifiers := map[string]auth.Verifier{
+		"buffered": &mockVerifier{},
+		"digest":   digestVerifier,
+	}
+
+	for name, verifier := range verifiers {
+		t.Run(name, func(t *testing.T) {
+			validator := NewWechatPayNotifyValidator(verifier)
+
+			body, err := validator.ValidateStream(context.Background(), header, strings.NewReader("BODY"))
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(body)
+			require.NoError(t, err)
+			assert.Equal(t, "BODY", string(data))
+
+			body, err = validator.ValidateStream(context.Background(), header, strings.NewReader("FAKE"))
+			require.NoError(t, err)
+			_, err = ioutil.ReadAll(body)
+			assert.Error(t, err)
+			// 验签失败后继续读取仍返回验签错误
+			_, err2 := body.Read(make([]byte, 1))
+			assert.Equal(t, err, err2)
+
+			_, err = validator.ValidateStream(context.Background(), http.Header{}, strings.NewReader("BODY"))
+			assert.Error(t, err)
+		})
+	}
+	assert.True(t, digestVerifier.digested)
+}
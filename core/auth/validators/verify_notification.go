@@ -0,0 +1,77 @@
+package validators
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// VerifyNotification 使用微信支付平台证书校验回调通知报文的签名，certificates 的 key 为平台证书序列号
+//
+// 本函数无状态，无需初始化 Client、notify.Handler 或平台证书下载器，适用于仅需校验回调通知、对冷启动耗时敏感的云函数。
+// 通知的时间戳与当前时间的偏差需小于 tolerance，tolerance 小于等于 0 时为 5 分钟。
+// 校验通过后，可以使用 utils.DecryptAES256GCM 解密通知内容。
+func VerifyNotification(
+	header http.Header, body []byte, certificates map[string]*x509.Certificate, tolerance time.Duration,
+) error {
+	return verifyNotification(header, body, tolerance, func(serialNo string) (*rsa.PublicKey, error) {
+		certificate, ok := certificates[serialNo]
+		if !ok {
+			return nil, fmt.Errorf("certificate[%s] not found", serialNo)
+		}
+		publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("certificate[%s] has no rsa public key", serialNo)
+		}
+		return publicKey, nil
+	})
+}
+
+// VerifyNotificationWithPublicKey 使用微信支付公钥校验回调通知报文的签名，keyID 为微信支付公钥ID
+//
+// 使用微信支付公钥验签时，通知的 Wechatpay-Serial 为公钥ID，与 keyID 不一致时校验失败。其他说明同 VerifyNotification
+func VerifyNotificationWithPublicKey(
+	header http.Header, body []byte, keyID string, publicKey *rsa.PublicKey, tolerance time.Duration,
+) error {
+	return verifyNotification(header, body, tolerance, func(serialNo string) (*rsa.PublicKey, error) {
+		if serialNo != keyID || publicKey == nil {
+			return nil, fmt.Errorf("public key[%s] not found", serialNo)
+		}
+		return publicKey, nil
+	})
+}
+
+func verifyNotification(
+	header http.Header, body []byte, tolerance time.Duration, getKey func(serialNo string) (*rsa.PublicKey, error),
+) error {
+	if tolerance <= 0 {
+		tolerance = consts.FiveMinute * time.Second
+	}
+	requestID := header.Get(consts.RequestID)
+	args, err := newWechatpayHeaders(header, time.Now(), tolerance)
+	if err != nil {
+		return fmt.Errorf("%w request-id=[%s]", err, requestID)
+	}
+
+	publicKey, err := getKey(args.SerialNo)
+	if err != nil {
+		return fmt.Errorf("validate verify fail serialNo=%s request-id=[%s] err=%v", args.SerialNo, requestID, err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(args.Signature)
+	if err != nil {
+		return fmt.Errorf("validate verify fail serialNo=%s request-id=[%s] err=signature not base64 encoded",
+			args.SerialNo, requestID)
+	}
+	hashed := sha256.Sum256([]byte(fmt.Sprintf("%d\n%s\n%s\n", args.Timestamp, args.Nonce, body)))
+	if err = rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hashed[:], signature); err != nil {
+		return fmt.Errorf("validate verify fail serialNo=%s request-id=[%s] err=%v", args.SerialNo, requestID, err)
+	}
+	return nil
+}
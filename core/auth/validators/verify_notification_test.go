@@ -0,0 +1,71 @@
+package validators
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+func signNotification(t *testing.T, key *rsa.PrivateKey, serialNo string, timestamp int64, body string) http.Header {
+	nonce := "NONCE1234567890"
+	hashed := sha256.Sum256([]byte(fmt.Sprintf("%d\n%s\n%s\n", timestamp, nonce, body)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	require.NoError(t, err)
+	return http.Header{
+		consts.WechatPaySignature: {base64.StdEncoding.EncodeToString(signature)},
+		consts.WechatPaySerial:    {serialNo},
+		consts.WechatPayTimestamp: {strconv.FormatInt(timestamp, 10)},
+		consts.WechatPayNonce:     {nonce},
+		consts.RequestID:          {"any-request-id"},
+	}
+}
+
+func TestVerifyNotification(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	certificates := map[string]*x509.Certificate{"SERIAL1234567890": {PublicKey: &key.PublicKey}}
+	body := `{"id":"EV-2018022511223320873"}`
+	now := time.Now().Unix()
+
+	header := signNotification(t, key, "SERIAL1234567890", now, body)
+	assert.NoError(t, VerifyNotification(header, []byte(body), certificates, 0))
+	assert.Error(t, VerifyNotification(header, []byte(body+" "), certificates, 0))
+	assert.Error(t, VerifyNotification(header, []byte(body), map[string]*x509.Certificate{}, 0))
+
+	// 时间戳超出允许的偏差
+	header = signNotification(t, key, "SERIAL1234567890", now-120, body)
+	assert.NoError(t, VerifyNotification(header, []byte(body), certificates, 0))
+	assert.Error(t, VerifyNotification(header, []byte(body), certificates, time.Minute))
+	header = signNotification(t, key, "SERIAL1234567890", now-600, body)
+	assert.Error(t, VerifyNotification(header, []byte(body), certificates, 0))
+
+	header = signNotification(t, key, "SERIAL1234567890", now, body)
+	header.Del(consts.WechatPayNonce)
+	assert.Error(t, VerifyNotification(header, []byte(body), certificates, 0))
+}
+
+func TestVerifyNotificationWithPublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	body := `{"id":"EV-2018022511223320873"}`
+
+	header := signNotification(t, key, "PUB_KEY_ID_0114232647", time.Now().Unix(), body)
+	assert.NoError(t, VerifyNotificationWithPublicKey(header, []byte(body), "PUB_KEY_ID_0114232647", &key.PublicKey, 0))
+	assert.Error(t, VerifyNotificationWithPublicKey(header, []byte(body), "PUB_KEY_ID_OTHER", &key.PublicKey, 0))
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	assert.Error(t, VerifyNotificationWithPublicKey(header, []byte(body), "PUB_KEY_ID_0114232647", &otherKey.PublicKey, 0))
+}
@@ -2,6 +2,8 @@ package validators
 
 import (
 	"context"
+	"io"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
@@ -17,9 +19,33 @@ func (v *WechatPayNotifyValidator) Validate(ctx context.Context, headers http.He
 	return v.validateHTTPMessage(ctx, headers, body)
 }
 
+// ValidateStream 检查通知请求报文的签名头，并返回在读取到 body 末尾时验签的 io.Reader，适用于包体较大的通知
+//
+// 调用方可以在读取的同时解析包体，但在读取到末尾且未返回错误前，已读取的内容均未经验证，不能用于业务处理。
+// 验签失败时 Read 返回验签错误而非 io.EOF。Verifier 实现了 auth.DigestVerifier（如 verifiers.SHA256WithRSAVerifier）时，
+// 验签过程不会缓存包体。
+func (v *WechatPayNotifyValidator) ValidateStream(
+	ctx context.Context, headers http.Header, body io.Reader,
+) (io.Reader, error) {
+	args, err := v.checkHTTPHeaders(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
+	return v.newValidatingReader(ctx, headers, args, ioutil.NopCloser(body)), nil
+}
+
 // NewWechatPayNotifyValidator 使用 auth.Verifier 初始化一个 WechatPayNotifyValidator
 func NewWechatPayNotifyValidator(verifier auth.Verifier) *WechatPayNotifyValidator {
 	return &WechatPayNotifyValidator{
-		wechatPayValidator{verifier: verifier},
+		wechatPayValidator{verifier: verifier, target: auth.ValidationTargetNotify},
+	}
+}
+
+// NewWechatPayNotifyValidatorWithNonceStore 使用 auth.Verifier 与 NonceStore 初始化一个检查通知是否被重放的 WechatPayNotifyValidator
+//
+// 签名正确的通知，其 Wechatpay-Nonce 已记录在 store 中时验签失败，否则记录该随机串。多实例部署时请使用共享的 store
+func NewWechatPayNotifyValidatorWithNonceStore(verifier auth.Verifier, store NonceStore) *WechatPayNotifyValidator {
+	return &WechatPayNotifyValidator{
+		wechatPayValidator{verifier: verifier, target: auth.ValidationTargetNotify, nonceStore: store},
 	}
 }
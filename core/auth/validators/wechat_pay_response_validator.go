@@ -2,7 +2,6 @@
 package validators
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -16,13 +15,31 @@ type WechatPayResponseValidator struct {
 	wechatPayValidator
 }
 
-// Validate 使用验证器对微信支付应答报文进行验证
+// Validate 使用验证器对微信支付应答报文进行验证，可通过 WithBodyMode 设置应答包体的验签方式
 func (v *WechatPayResponseValidator) Validate(ctx context.Context, response *http.Response) error {
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return fmt.Errorf("read response body err:[%s]", err.Error())
+	switch getBodyMode(ctx) {
+	case BodyModeEmpty:
+		return v.validateHTTPMessage(ctx, response.Header, nil)
+	case BodyModeStream:
+		args, err := v.checkHTTPHeaders(ctx, response.Header)
+		if err != nil {
+			return err
+		}
+		response.Body = v.newValidatingReader(ctx, response.Header, args, response.Body)
+		return nil
 	}
-	response.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+	// 包体已读入内存时无需再次读取
+	buffered, ok := response.Body.(*auth.BufferedBody)
+	if !ok {
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("read response body err:[%s]", err.Error())
+		}
+		buffered = auth.NewBufferedBody(body)
+		response.Body = buffered
+	}
+	body := buffered.Bytes()
 
 	return v.validateHTTPMessage(ctx, response.Header, body)
 }
@@ -30,6 +47,6 @@ func (v *WechatPayResponseValidator) Validate(ctx context.Context, response *htt
 // NewWechatPayResponseValidator 使用 auth.Verifier 初始化一个 WechatPayResponseValidator
 func NewWechatPayResponseValidator(verifier auth.Verifier) *WechatPayResponseValidator {
 	return &WechatPayResponseValidator{
-		wechatPayValidator{verifier: verifier},
+		wechatPayValidator{verifier: verifier, target: auth.ValidationTargetResponse},
 	}
 }
@@ -2,6 +2,7 @@ package validators
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -15,24 +16,84 @@ import (
 
 type wechatPayValidator struct {
 	verifier auth.Verifier
+	// target 被验签的报文类型，用于验签结果计数
+	target string
+	// nonceStore 记录已使用的随机串，为 nil 时不检查报文是否被重放
+	nonceStore NonceStore
 }
 
 func (v *wechatPayValidator) validateHTTPMessage(ctx context.Context, header http.Header, body []byte) error {
+	args, err := v.checkHTTPHeaders(ctx, header)
+	if err != nil {
+		return err
+	}
+	return v.verifyHTTPMessage(ctx, header, args, body)
+}
+
+// checkHTTPHeaders 检查并解析报文中的微信支付签名头，使用 auth.WithClock 为 ctx 设置的时钟检查时间戳是否过期
+func (v *wechatPayValidator) checkHTTPHeaders(ctx context.Context, header http.Header) (wechatPayHeaders, error) {
 	requestId := header.Get(consts.RequestID)
 	if v.verifier == nil {
-		return fmt.Errorf("you must init Validator with auth.Verifier. request-id=[%s]", requestId)
+		return wechatPayHeaders{}, fmt.Errorf("you must init Validator with auth.Verifier. request-id=[%s]", requestId)
 	}
 
-	args, err := newWechatpayHeaders(header)
+	args, err := newWechatpayHeaders(header, auth.Now(ctx), consts.FiveMinute*time.Second)
 	if err != nil {
-		return fmt.Errorf("%w request-id=[%s]", err, requestId)
+		auth.RecordValidation(ctx, v.target, headerOutcome(err))
+		return args, fmt.Errorf("%w request-id=[%s]", err, requestId)
 	}
+	return args, nil
+}
 
+// verifyHTTPMessage 验证报文的签名
+func (v *wechatPayValidator) verifyHTTPMessage(
+	ctx context.Context, header http.Header, args wechatPayHeaders, body []byte,
+) error {
 	message := args.buildMessage(ctx, header, body)
-	if err := v.verifier.Verify(ctx, args.SerialNo, message, args.Signature); err != nil {
-		return fmt.Errorf("validate verify fail serialNo=%s request-id=[%s] err=%v", args.SerialNo, requestId, err)
+	err := v.verifier.Verify(ctx, args.SerialNo, message, args.Signature)
+	return v.verifyResult(ctx, header, args, err)
+}
+
+// verifyResult 记录验签结果，并为验签失败的错误补充序列号与 Request-Id
+func (v *wechatPayValidator) verifyResult(ctx context.Context, header http.Header, args wechatPayHeaders, err error) error {
+	if err == nil {
+		if err = v.checkReplay(ctx, header, args); err != nil {
+			return err
+		}
+		auth.RecordValidation(ctx, v.target, auth.ValidationSuccess)
+		return nil
+	}
+	var notFound *auth.SerialNotFoundError
+	if errors.As(err, &notFound) {
+		auth.RecordValidation(ctx, v.target, auth.ValidationUnknownSerial)
+	} else {
+		auth.RecordValidation(ctx, v.target, auth.ValidationSignatureMismatch)
 	}
-	return nil
+	return fmt.Errorf("validate verify fail serialNo=%s request-id=[%s] err=%v",
+		args.SerialNo, header.Get(consts.RequestID), err)
+}
+
+// headerError 微信支付签名头缺失、格式有误或时间戳过期
+type headerError struct {
+	outcome auth.ValidationOutcome
+	err     error
+}
+
+func (e *headerError) Error() string {
+	return e.err.Error()
+}
+
+func (e *headerError) Unwrap() error {
+	return e.err
+}
+
+// headerOutcome 返回签名头检查失败的验签结果分类
+func headerOutcome(err error) auth.ValidationOutcome {
+	var hErr *headerError
+	if errors.As(err, &hErr) {
+		return hErr.outcome
+	}
+	return auth.ValidationMissingHeader
 }
 
 // 微信支付回调信息上下文
@@ -43,11 +104,12 @@ type wechatPayHeaders struct {
 	Timestamp int64
 }
 
-func newWechatpayHeaders(headers http.Header) (rs wechatPayHeaders, err error) {
+// newWechatpayHeaders 解析报文中的微信支付签名头，并检查时间戳与当前时间 now 的偏差小于 tolerance
+func newWechatpayHeaders(headers http.Header, now time.Time, tolerance time.Duration) (rs wechatPayHeaders, err error) {
 	getHeader := func(name string) (string, error) {
 		v := strings.TrimSpace(headers.Get(name))
 		if v == "" {
-			return v, fmt.Errorf("empty '%s'", name)
+			return v, &headerError{outcome: auth.ValidationMissingHeader, err: fmt.Errorf("empty '%s'", name)}
 		}
 		return v, nil
 	}
@@ -56,7 +118,11 @@ func newWechatpayHeaders(headers http.Header) (rs wechatPayHeaders, err error) {
 		if err != nil {
 			return 0, err
 		}
-		return strconv.ParseInt(v, 10, 64)
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, &headerError{outcome: auth.ValidationMissingHeader, err: err}
+		}
+		return i, nil
 	}
 
 	rs.SerialNo, err = getHeader(consts.WechatPaySerial)
@@ -76,9 +142,11 @@ func newWechatpayHeaders(headers http.Header) (rs wechatPayHeaders, err error) {
 		return
 	}
 
-	now := time.Now()
-	if math.Abs(float64(rs.Timestamp-now.Unix())) >= consts.FiveMinute {
-		err = fmt.Errorf("notify expired. timestamp=[%d]", rs.Timestamp)
+	if math.Abs(float64(rs.Timestamp-now.Unix())) >= tolerance.Seconds() {
+		err = &headerError{
+			outcome: auth.ValidationTimestampExpired,
+			err:     fmt.Errorf("notify expired. timestamp=[%d]", rs.Timestamp),
+		}
 	}
 	return
 }
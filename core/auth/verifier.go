@@ -7,3 +7,12 @@ import "context"
 type Verifier interface {
 	Verify(ctx context.Context, serial, message, signature string) error // 对签名信息进行验证
 }
+
+// DigestVerifier 可以直接使用签名原文的摘要验证签名的验证器
+//
+// 验签器以流的方式验证较大的报文时，如果 Verifier 实现了本接口，将边读取边计算摘要，而无需缓存完整的报文
+type DigestVerifier interface {
+	Verifier
+	// VerifyDigest 对签名信息进行验证，digest 为签名原文的 SHA256 摘要
+	VerifyDigest(ctx context.Context, serial string, digest []byte, signature string) error
+}
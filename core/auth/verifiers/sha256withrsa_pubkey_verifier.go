@@ -0,0 +1,62 @@
+package verifiers
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// SHA256WithRSAPubkeyVerifier 使用微信支付公钥的 SHA256WithRSA 数字签名验证器
+//
+// 使用微信支付公钥验签的商户，应答的 Wechatpay-Serial 为微信支付公钥ID，与 keyID 不一致时验签失败
+type SHA256WithRSAPubkeyVerifier struct {
+	keyID     string
+	publicKey *rsa.PublicKey
+}
+
+// Verify 对数字签名信息进行验证
+func (verifier *SHA256WithRSAPubkeyVerifier) Verify(ctx context.Context, serialNumber, message, signature string) error {
+	err := checkParameter(ctx, serialNumber, message, signature)
+	if err != nil {
+		return err
+	}
+	hashed := sha256.Sum256([]byte(message))
+	return verifier.VerifyDigest(ctx, serialNumber, hashed[:], signature)
+}
+
+// VerifyDigest 使用签名原文的 SHA256 摘要对数字签名信息进行验证
+func (verifier *SHA256WithRSAPubkeyVerifier) VerifyDigest(
+	ctx context.Context, serialNumber string, digest []byte, signature string,
+) error {
+	if err := checkSignatureParameter(ctx, serialNumber, signature); err != nil {
+		return err
+	}
+	if len(digest) != sha256.Size {
+		return fmt.Errorf("digest is not a sha256 digest, verifier need input sha256 digest")
+	}
+	if verifier.publicKey == nil {
+		return fmt.Errorf("verifier has no public key")
+	}
+	if serialNumber != verifier.keyID {
+		return &auth.SerialNotFoundError{Kind: "public key", SerialNo: serialNumber}
+	}
+	sigBytes, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("verify failed: signature not base64 encoded")
+	}
+	err = rsa.VerifyPKCS1v15(verifier.publicKey, crypto.SHA256, digest, sigBytes)
+	if err != nil {
+		return fmt.Errorf("verifty signature with public key err:%s", err.Error())
+	}
+	return nil
+}
+
+// NewSHA256WithRSAPubkeyVerifier 使用微信支付公钥ID与微信支付公钥初始化 SHA256WithRSAPubkeyVerifier
+func NewSHA256WithRSAPubkeyVerifier(keyID string, publicKey *rsa.PublicKey) *SHA256WithRSAPubkeyVerifier {
+	return &SHA256WithRSAPubkeyVerifier{keyID: keyID, publicKey: publicKey}
+}
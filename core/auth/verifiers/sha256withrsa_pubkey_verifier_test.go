@@ -0,0 +1,30 @@
+package verifiers
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSHA256WithRSAPubkeyVerifier(t *testing.T) {
+	const (
+		keyID     = "PUB_KEY_ID_0114232134912410000000000000"
+		signature = "BKyAfU4iMCuvXMXS0Wzam3V/cnxZ+JaqigPM5OhljS2iOT95OO6Fsuml2JkFANJU9K6q9bLlDhPXuoVz+pp4hAm6pHU4ld815U4jsKu1RkyaII+1CYBUYC8TK0XtJ8FwUXXz8vZHh58rrAVN1XwNyvD1vfpxrMT4SL536GLwvpUHlCqIMzoZUguLli/K8V29QiOhuH6IEqLNJn8e9b3nwNcQ7be3CzYGpDAKBfDGPCqCv8Rw5zndhlffk2FEA70G4hvMwe51qMN/RAJbknXG23bSlObuTCN7Ndj1aJGH6/L+hdwfLpUtJm4QYVazzW7DFD27EpSQEqA8bX9+8m1rLg=="
+	)
+	verifier := NewSHA256WithRSAPubkeyVerifier(keyID, certificate.PublicKey.(*rsa.PublicKey))
+	ctx := context.Background()
+
+	assert.NoError(t, verifier.Verify(ctx, keyID, "source", signature))
+	assert.Error(t, verifier.Verify(ctx, keyID, "wrong source", signature))
+	assert.Error(t, verifier.Verify(ctx, testWechatPayVerifierPlatformSerialNumber, "source", signature))
+	assert.Error(t, verifier.Verify(ctx, keyID, "source", "not base64"))
+
+	digest := sha256.Sum256([]byte("source"))
+	assert.NoError(t, verifier.VerifyDigest(ctx, keyID, digest[:], signature))
+	assert.Error(t, verifier.VerifyDigest(ctx, keyID, digest[:16], signature))
+
+	assert.Error(t, NewSHA256WithRSAPubkeyVerifier(keyID, nil).Verify(ctx, keyID, "source", signature))
+}
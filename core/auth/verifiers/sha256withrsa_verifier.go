@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
 )
 
 // SHA256WithRSAVerifier SHA256WithRSA 数字签名验证器
@@ -25,6 +26,20 @@ func (verifier *SHA256WithRSAVerifier) Verify(ctx context.Context, serialNumber,
 	if err != nil {
 		return err
 	}
+	hashed := sha256.Sum256([]byte(message))
+	return verifier.VerifyDigest(ctx, serialNumber, hashed[:], signature)
+}
+
+// VerifyDigest 使用签名原文的 SHA256 摘要对数字签名信息进行验证
+func (verifier *SHA256WithRSAVerifier) VerifyDigest(
+	ctx context.Context, serialNumber string, digest []byte, signature string,
+) error {
+	if err := checkSignatureParameter(ctx, serialNumber, signature); err != nil {
+		return err
+	}
+	if len(digest) != sha256.Size {
+		return fmt.Errorf("digest is not a sha256 digest, verifier need input sha256 digest")
+	}
 	if verifier.certGetter == nil {
 		return fmt.Errorf("verifier has no validator")
 	}
@@ -34,10 +49,9 @@ func (verifier *SHA256WithRSAVerifier) Verify(ctx context.Context, serialNumber,
 	}
 	certificate, ok := verifier.certGetter.Get(ctx, serialNumber)
 	if !ok {
-		return fmt.Errorf("certificate[%s] not found in verifier", serialNumber)
+		return &auth.SerialNotFoundError{Kind: "certificate", SerialNo: serialNumber}
 	}
-	hashed := sha256.Sum256([]byte(message))
-	err = rsa.VerifyPKCS1v15(certificate.PublicKey.(*rsa.PublicKey), crypto.SHA256, hashed[:], sigBytes)
+	err = rsa.VerifyPKCS1v15(certificate.PublicKey.(*rsa.PublicKey), crypto.SHA256, digest, sigBytes)
 	if err != nil {
 		return fmt.Errorf("verifty signature with public key err:%s", err.Error())
 	}
@@ -45,15 +59,22 @@ func (verifier *SHA256WithRSAVerifier) Verify(ctx context.Context, serialNumber,
 }
 
 func checkParameter(ctx context.Context, serialNumber, message, signature string) error {
+	if err := checkSignatureParameter(ctx, serialNumber, signature); err != nil {
+		return err
+	}
+	if strings.TrimSpace(message) == "" {
+		return fmt.Errorf("message is empty, verifier need input message")
+	}
+	return nil
+}
+
+func checkSignatureParameter(ctx context.Context, serialNumber, signature string) error {
 	if ctx == nil {
 		return fmt.Errorf("context is nil, verifier need input context.Context")
 	}
 	if strings.TrimSpace(serialNumber) == "" {
 		return fmt.Errorf("serialNumber is empty, verifier need input serialNumber")
 	}
-	if strings.TrimSpace(message) == "" {
-		return fmt.Errorf("message is empty, verifier need input message")
-	}
 	if strings.TrimSpace(signature) == "" {
 		return fmt.Errorf("signature is empty, verifier need input signature")
 	}
@@ -2,9 +2,12 @@ package verifiers
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/x509"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
 	"github.com/wechatpay-apiv3/wechatpay-go/utils"
 )
@@ -101,3 +104,35 @@ func TestWechatPayVerifier_Verify(t *testing.T) {
 		})
 	}
 }
+
+func TestWechatPayVerifier_VerifyDigest(t *testing.T) {
+	const signature = "BKyAfU4iMCuvXMXS0Wzam3V/cnxZ+JaqigPM5OhljS2iOT95OO6Fsuml2JkFANJU9K6q9bLlDhPXuoVz+pp4hAm6pHU4ld815U4jsKu1RkyaII+1CYBUYC8TK0XtJ8FwUXXz8vZHh58rrAVN1XwNyvD1vfpxrMT4SL536GLwvpUHlCqIMzoZUguLli/K8V29QiOhuH6IEqLNJn8e9b3nwNcQ7be3CzYGpDAKBfDGPCqCv8Rw5zndhlffk2FEA70G4hvMwe51qMN/RAJbknXG23bSlObuTCN7Ndj1aJGH6/L+hdwfLpUtJm4QYVazzW7DFD27EpSQEqA8bX9+8m1rLg=="
+	verifier := NewSHA256WithRSAVerifier(core.NewCertificateMap(
+		map[string]*x509.Certificate{testWechatPayVerifierPlatformSerialNumber: certificate},
+	))
+	ctx := context.Background()
+
+	digest := sha256.Sum256([]byte("source"))
+	assert.NoError(t, verifier.VerifyDigest(ctx, testWechatPayVerifierPlatformSerialNumber, digest[:], signature))
+
+	wrongDigest := sha256.Sum256([]byte("wrong source"))
+	assert.Error(t, verifier.VerifyDigest(ctx, testWechatPayVerifierPlatformSerialNumber, wrongDigest[:], signature))
+	assert.Error(t, verifier.VerifyDigest(ctx, testWechatPayVerifierPlatformSerialNumber, digest[:16], signature))
+	assert.Error(t, verifier.VerifyDigest(ctx, "", digest[:], signature))
+}
+
+func BenchmarkSHA256WithRSAVerifier_Verify(b *testing.B) {
+	const signature = "BKyAfU4iMCuvXMXS0Wzam3V/cnxZ+JaqigPM5OhljS2iOT95OO6Fsuml2JkFANJU9K6q9bLlDhPXuoVz+pp4hAm6pHU4ld815U4jsKu1RkyaII+1CYBUYC8TK0XtJ8FwUXXz8vZHh58rrAVN1XwNyvD1vfpxrMT4SL536GLwvpUHlCqIMzoZUguLli/K8V29QiOhuH6IEqLNJn8e9b3nwNcQ7be3CzYGpDAKBfDGPCqCv8Rw5zndhlffk2FEA70G4hvMwe51qMN/RAJbknXG23bSlObuTCN7Ndj1aJGH6/L+hdwfLpUtJm4QYVazzW7DFD27EpSQEqA8bX9+8m1rLg=="
+	verifier := NewSHA256WithRSAVerifier(core.NewCertificateMap(
+		map[string]*x509.Certificate{testWechatPayVerifierPlatformSerialNumber: certificate},
+	))
+	ctx := context.Background()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if err := verifier.Verify(ctx, testWechatPayVerifierPlatformSerialNumber, "source", signature); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
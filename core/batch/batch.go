@@ -0,0 +1,157 @@
+// Package batch 微信支付 API v3 Go SDK 批量请求辅助工具
+//
+// 对账、补单等脚本常需要逐个查询大量订单的状态。Query 以固定数量的并发执行查询，
+// 对可重试的错误按指数退避重试，并按下标顺序返回全部结果，单个查询失败不影响其他查询。
+// 并发数之外的请求频率控制请为 core.Client 设置 option.WithRateLimiter。
+package batch
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+const (
+	defaultConcurrency   = 8
+	defaultMaxAttempts   = 3
+	defaultRetryInterval = time.Second
+)
+
+// QueryFunc 执行下标为 i 的查询，返回的值将保存在对应下标的 Result.Value 中
+type QueryFunc func(ctx context.Context, i int) (interface{}, error)
+
+// Result 单个查询的结果
+type Result struct {
+	Value    interface{} // 最后一次查询返回的值
+	Err      error       // 最后一次查询返回的错误，ctx 结束前未开始的查询为 ctx 的错误
+	Attempts int         // 查询次数，ctx 结束前未开始的查询为 0
+}
+
+// Option Query 的配置项
+type Option func(o *options)
+
+type options struct {
+	concurrency   int
+	maxAttempts   int
+	retryInterval time.Duration
+	retryable     func(err error) bool
+}
+
+// WithConcurrency 设置同时执行的查询数，默认为 8
+func WithConcurrency(concurrency int) Option {
+	return func(o *options) {
+		if concurrency > 0 {
+			o.concurrency = concurrency
+		}
+	}
+}
+
+// WithRetry 设置单个查询的最大尝试次数与首次重试间隔，默认为 3 次、1s。maxAttempts 为 1 时不重试
+func WithRetry(maxAttempts int, interval time.Duration) Option {
+	return func(o *options) {
+		if maxAttempts > 0 {
+			o.maxAttempts = maxAttempts
+		}
+		if interval >= 0 {
+			o.retryInterval = interval
+		}
+	}
+}
+
+// WithRetryable 设置判断查询错误是否可以重试的方法，默认为 IsRetryable
+func WithRetryable(retryable func(err error) bool) Option {
+	return func(o *options) {
+		if retryable != nil {
+			o.retryable = retryable
+		}
+	}
+}
+
+// Query 以有限的并发执行 n 个查询，返回长度为 n、与下标一一对应的结果
+//
+// 全部查询结束后返回。ctx 结束后不再开始新的查询与重试，尚未开始的查询的 Result.Err 为 ctx 的错误。
+func Query(ctx context.Context, n int, fn QueryFunc, opts ...Option) []Result {
+	o := options{
+		concurrency:   defaultConcurrency,
+		maxAttempts:   defaultMaxAttempts,
+		retryInterval: defaultRetryInterval,
+		retryable:     IsRetryable,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	results := make([]Result, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < o.concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = o.query(ctx, i, fn)
+			}
+		}()
+	}
+
+	next := 0
+dispatch:
+	for ; next < n; next++ {
+		select {
+		case indexes <- next:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i := next; i < n; i++ {
+		results[i] = Result{Err: ctx.Err()}
+	}
+	return results
+}
+
+// query 执行单个查询，在错误可以重试时按指数退避重试
+func (o *options) query(ctx context.Context, i int, fn QueryFunc) (result Result) {
+	if err := ctx.Err(); err != nil {
+		return Result{Err: err}
+	}
+	interval := o.retryInterval
+	for {
+		result.Attempts++
+		result.Value, result.Err = fn(ctx, i)
+		if result.Err == nil || result.Attempts >= o.maxAttempts || ctx.Err() != nil || !o.retryable(result.Err) {
+			return result
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+// IsRetryable 判断查询错误是否可以重试：HTTP 状态码为 5XX 或错误码为 SYSTEM_ERROR、FREQUENCY_LIMITED 的 *core.APIError，
+// 以及网络错误。ctx 结束时不重试
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError ||
+			apiErr.Code == "SYSTEM_ERROR" || apiErr.Code == "FREQUENCY_LIMITED"
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,96 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+func TestQuery(t *testing.T) {
+	var running, maxRunning int32
+	attempts := make([]int32, 20)
+	results := Query(context.Background(), 20, func(ctx context.Context, i int) (interface{}, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		switch {
+		case i%5 == 0 && atomic.AddInt32(&attempts[i], 1) == 1:
+			return nil, &core.APIError{StatusCode: http.StatusInternalServerError, Code: "SYSTEM_ERROR"}
+		case i == 7:
+			return nil, &core.APIError{StatusCode: http.StatusNotFound, Code: "ORDER_NOT_EXIST"}
+		}
+		return fmt.Sprintf("order-%d", i), nil
+	}, WithConcurrency(3), WithRetry(2, time.Millisecond))
+
+	require.Len(t, results, 20)
+	assert.LessOrEqual(t, maxRunning, int32(3))
+	for i, result := range results {
+		if i == 7 {
+			assert.Error(t, result.Err)
+			assert.Equal(t, 1, result.Attempts)
+			continue
+		}
+		assert.NoError(t, result.Err)
+		assert.Equal(t, fmt.Sprintf("order-%d", i), result.Value)
+		if i%5 == 0 {
+			assert.Equal(t, 2, result.Attempts)
+		} else {
+			assert.Equal(t, 1, result.Attempts)
+		}
+	}
+}
+
+func TestQuery_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	results := Query(ctx, 10, func(ctx context.Context, i int) (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) == 2 {
+			cancel()
+		}
+		return i, nil
+	}, WithConcurrency(1))
+
+	require.Len(t, results, 10)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.NoError(t, results[0].Err)
+	assert.NoError(t, results[1].Err)
+	for _, result := range results[2:] {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+		assert.Equal(t, 0, result.Attempts)
+	}
+}
+
+func TestQuery_RetryStopsOnContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	results := Query(ctx, 1, func(ctx context.Context, i int) (interface{}, error) {
+		return nil, &core.APIError{StatusCode: http.StatusTooManyRequests, Code: "FREQUENCY_LIMITED"}
+	}, WithRetry(10, time.Hour))
+
+	assert.Equal(t, 1, results[0].Attempts)
+	assert.Error(t, results[0].Err)
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(&core.APIError{StatusCode: http.StatusBadGateway}))
+	assert.True(t, IsRetryable(&core.APIError{StatusCode: http.StatusTooManyRequests, Code: "FREQUENCY_LIMITED"}))
+	assert.False(t, IsRetryable(&core.APIError{StatusCode: http.StatusBadRequest, Code: "PARAM_ERROR"}))
+	assert.False(t, IsRetryable(fmt.Errorf("query err: %w", context.DeadlineExceeded)))
+	assert.False(t, IsRetryable(fmt.Errorf("invalid out_trade_no")))
+	assert.False(t, IsRetryable(nil))
+}
@@ -0,0 +1,37 @@
+package batch_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/batch"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+)
+
+func ExampleQuery() {
+	var (
+		ctx         context.Context
+		client      *core.Client
+		outTradeNos []string
+	)
+
+	svc := native.NativeApiService{Client: client}
+	results := batch.Query(ctx, len(outTradeNos), func(ctx context.Context, i int) (interface{}, error) {
+		resp, _, err := svc.QueryOrderByOutTradeNo(ctx, native.QueryOrderByOutTradeNoRequest{
+			OutTradeNo: core.String(outTradeNos[i]),
+			Mchid:      core.String("1900000109"),
+		})
+		return resp, err
+	}, batch.WithConcurrency(16))
+
+	for i, result := range results {
+		if result.Err != nil {
+			log.Printf("query order %s err:%s", outTradeNos[i], result.Err)
+			continue
+		}
+		transaction := result.Value.(*payments.Transaction)
+		log.Printf("order %s state: %s", outTradeNos[i], *transaction.TradeState)
+	}
+}
@@ -0,0 +1,12 @@
+package core
+
+import "context"
+
+// Breaker 熔断器，接口持续故障时快速失败，不再向微信支付发送请求
+//
+// 为 Client 设置 Breaker 后，每个请求在发送前都会调用 Allow。应答验签失败不视为请求结果，不会传递给 done。
+type Breaker interface {
+	// Allow 判断是否允许向接口发送请求，不允许时返回错误，请求将不会被发送。
+	// 允许时返回 done，请求完成后 Client 会以请求的错误（成功时为 nil）调用一次 done
+	Allow(ctx context.Context, method, path string) (done func(err error), err error)
+}
@@ -0,0 +1,44 @@
+package encryptors
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// WechatPayPubKeyEncryptor 使用微信支付公钥的字符串加密器
+type WechatPayPubKeyEncryptor struct {
+	// 微信支付公钥ID
+	keyID string
+	// 微信支付公钥
+	publicKey *rsa.PublicKey
+}
+
+// NewWechatPayPubKeyEncryptor 使用微信支付公钥ID与微信支付公钥新建一个 WechatPayPubKeyEncryptor
+func NewWechatPayPubKeyEncryptor(keyID string, publicKey *rsa.PublicKey) *WechatPayPubKeyEncryptor {
+	return &WechatPayPubKeyEncryptor{keyID: keyID, publicKey: publicKey}
+}
+
+// SelectCertificate 返回微信支付公钥ID，请求头 Wechatpay-Serial 需设置为该值
+func (e *WechatPayPubKeyEncryptor) SelectCertificate(ctx context.Context) (serial string, err error) {
+	if e.keyID == "" || e.publicKey == nil {
+		return "", fmt.Errorf("no public key for encryption")
+	}
+	return e.keyID, nil
+}
+
+// Encrypt 对字符串加密
+func (e *WechatPayPubKeyEncryptor) Encrypt(ctx context.Context, serial, plaintext string) (ciphertext string, err error) {
+	if serial != e.keyID || e.publicKey == nil {
+		return plaintext, fmt.Errorf("public key for EncryptSerial(%v) not found", serial)
+	}
+
+	// 不需要对空串进行加密
+	if plaintext == "" {
+		return "", nil
+	}
+
+	return utils.EncryptOAEPWithPublicKey(plaintext, e.publicKey)
+}
@@ -0,0 +1,41 @@
+package encryptors
+
+import (
+	"context"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+func TestWechatPayPubKeyEncryptor(t *testing.T) {
+	const keyID = "PUB_KEY_ID_0114232134912410000000000000"
+	cert, err := utils.LoadCertificate(testCertStrList[0])
+	require.NoError(t, err)
+	e := NewWechatPayPubKeyEncryptor(keyID, cert.PublicKey.(*rsa.PublicKey))
+	ctx := context.Background()
+
+	serial, err := e.SelectCertificate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, keyID, serial)
+
+	ciphertext, err := e.Encrypt(ctx, serial, "hello world")
+	require.NoError(t, err)
+	privateKey, err := utils.LoadPrivateKey(privateKeyStr)
+	require.NoError(t, err)
+	plaintext, err := utils.DecryptOAEP(ciphertext, privateKey)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", plaintext)
+
+	ciphertext, err = e.Encrypt(ctx, serial, "")
+	require.NoError(t, err)
+	assert.Empty(t, ciphertext)
+
+	_, err = e.Encrypt(ctx, "D7CE59D1F522D701", "hello world")
+	assert.Error(t, err)
+
+	_, err = NewWechatPayPubKeyEncryptor("", nil).SelectCertificate(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,294 @@
+// Package circuitbreaker 微信支付 API v3 Go SDK 熔断器
+//
+// CircuitBreaker 按接口分别统计请求结果：连续出现微信支付系统错误（5XX、SYSTEM_ERROR）或超时达到阈值后打开熔断，
+// 在熔断期间请求立即失败；熔断时间结束后进入半开状态，放行少量探测请求，探测成功后恢复，失败则再次打开熔断。
+// 调用方可以据此快速切换到其他支付渠道，而不必等待每个请求超时。
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// ErrOpen 熔断器已打开（或处于半开状态且探测请求数已满），请求未被发送
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State 熔断器状态
+type State int
+
+// State 可能枚举
+const (
+	StateClosed   State = iota // 关闭，请求正常发送
+	StateOpen                  // 打开，请求立即失败
+	StateHalfOpen              // 半开，放行少量探测请求
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+const (
+	// DefaultFailureThreshold 打开熔断所需的默认连续失败次数
+	DefaultFailureThreshold = 5
+	// DefaultOpenTimeout 熔断打开后进入半开状态前的默认等待时间
+	DefaultOpenTimeout = 30 * time.Second
+	// DefaultHalfOpenProbes 半开状态下默认放行的探测请求数
+	DefaultHalfOpenProbes = 1
+)
+
+// IsFailure 判断请求错误是否应计为接口故障：
+// HTTP 状态码为 5XX 或错误码为 SYSTEM_ERROR 的 *core.APIError，以及网络超时、ctx 超时。
+// 业务错误（如 ORDER_PAID、PARAM_ERROR）与调用方取消请求不计为故障
+func IsFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError || apiErr.Code == "SYSTEM_ERROR"
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// EndpointOf 使用 DefaultPathTemplates 返回请求所属的接口，例如 GET /v3/pay/transactions/out-trade-no/{out_trade_no}，
+// 参见 EndpointClassifier.EndpointOf
+func EndpointOf(method, path string) string {
+	return defaultClassifier.EndpointOf(method, path)
+}
+
+// CircuitBreaker 按接口分别计数的熔断器，实现了 core.Breaker
+type CircuitBreaker struct {
+	failureThreshold int
+	openTimeout      time.Duration
+	halfOpenProbes   int
+	isFailure        func(err error) bool
+	endpointOf       func(method, path string) string
+	onStateChange    func(endpoint string, from, to State)
+
+	lock      sync.Mutex
+	endpoints map[string]*endpointState
+}
+
+// CircuitBreakerOption CircuitBreaker 的配置项
+type CircuitBreakerOption func(b *CircuitBreaker)
+
+// WithFailureThreshold 设置打开熔断所需的连续失败次数，默认为 DefaultFailureThreshold
+func WithFailureThreshold(threshold int) CircuitBreakerOption {
+	return func(b *CircuitBreaker) {
+		if threshold > 0 {
+			b.failureThreshold = threshold
+		}
+	}
+}
+
+// WithOpenTimeout 设置熔断打开后进入半开状态前的等待时间，默认为 DefaultOpenTimeout
+func WithOpenTimeout(timeout time.Duration) CircuitBreakerOption {
+	return func(b *CircuitBreaker) {
+		if timeout > 0 {
+			b.openTimeout = timeout
+		}
+	}
+}
+
+// WithHalfOpenProbes 设置半开状态下放行的探测请求数，全部探测成功后关闭熔断，默认为 DefaultHalfOpenProbes
+func WithHalfOpenProbes(probes int) CircuitBreakerOption {
+	return func(b *CircuitBreaker) {
+		if probes > 0 {
+			b.halfOpenProbes = probes
+		}
+	}
+}
+
+// WithFailureFunc 设置判断请求错误是否计为接口故障的方法，默认为 IsFailure
+func WithFailureFunc(isFailure func(err error) bool) CircuitBreakerOption {
+	return func(b *CircuitBreaker) {
+		if isFailure != nil {
+			b.isFailure = isFailure
+		}
+	}
+}
+
+// WithEndpointFunc 设置划分接口的方法，同一接口的请求共用熔断状态，默认为 EndpointOf
+func WithEndpointFunc(endpointOf func(method, path string) string) CircuitBreakerOption {
+	return func(b *CircuitBreaker) {
+		if endpointOf != nil {
+			b.endpointOf = endpointOf
+		}
+	}
+}
+
+// WithStateChangeCallback 设置熔断状态变化时的回调，可用于告警或切换支付渠道。
+// 回调在状态变化的请求所在的 goroutine 中同步执行，请勿在回调中执行耗时操作
+func WithStateChangeCallback(onStateChange func(endpoint string, from, to State)) CircuitBreakerOption {
+	return func(b *CircuitBreaker) {
+		b.onStateChange = onStateChange
+	}
+}
+
+// NewCircuitBreaker 创建 CircuitBreaker
+func NewCircuitBreaker(opts ...CircuitBreakerOption) *CircuitBreaker {
+	b := &CircuitBreaker{
+		failureThreshold: DefaultFailureThreshold,
+		openTimeout:      DefaultOpenTimeout,
+		halfOpenProbes:   DefaultHalfOpenProbes,
+		isFailure:        IsFailure,
+		endpointOf:       EndpointOf,
+		endpoints:        make(map[string]*endpointState),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Allow 判断是否允许向接口发送请求，熔断打开时返回 ErrOpen
+func (b *CircuitBreaker) Allow(_ context.Context, method, path string) (func(err error), error) {
+	endpoint := b.endpointOf(method, path)
+
+	b.lock.Lock()
+	state := b.endpoint(endpoint)
+	from, changed := state.beforeRequest(time.Now(), b)
+	allowed := state.state != StateOpen && (state.state != StateHalfOpen || state.probes < b.halfOpenProbes)
+	var generation uint64
+	if allowed {
+		if state.state == StateHalfOpen {
+			state.probes++
+		}
+		generation = state.generation
+	}
+	to := state.state
+	b.lock.Unlock()
+
+	if changed {
+		b.notify(endpoint, from, to)
+	}
+	if !allowed {
+		return nil, fmt.Errorf("%w: %s", ErrOpen, endpoint)
+	}
+
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() { b.afterRequest(endpoint, generation, err) })
+	}, nil
+}
+
+// State 返回接口当前的熔断状态
+func (b *CircuitBreaker) State(method, path string) State {
+	endpoint := b.endpointOf(method, path)
+
+	b.lock.Lock()
+	state := b.endpoint(endpoint)
+	from, changed := state.beforeRequest(time.Now(), b)
+	to := state.state
+	b.lock.Unlock()
+
+	if changed {
+		b.notify(endpoint, from, to)
+	}
+	return to
+}
+
+func (b *CircuitBreaker) afterRequest(endpoint string, generation uint64, err error) {
+	failed := b.isFailure(err)
+
+	b.lock.Lock()
+	state := b.endpoint(endpoint)
+	if state.generation != generation {
+		// 请求发出后熔断状态已变化，结果不再计入
+		b.lock.Unlock()
+		return
+	}
+
+	from := state.state
+	switch {
+	case !failed && errors.Is(err, context.Canceled):
+		// 调用方取消的请求不计入结果，归还探测名额
+		if state.state == StateHalfOpen {
+			state.probes--
+		}
+	case failed && state.state == StateHalfOpen:
+		state.transit(StateOpen, time.Now())
+	case failed:
+		state.failures++
+		if state.failures >= b.failureThreshold {
+			state.transit(StateOpen, time.Now())
+		}
+	case state.state == StateHalfOpen:
+		state.successes++
+		if state.successes >= b.halfOpenProbes {
+			state.transit(StateClosed, time.Now())
+		}
+	default:
+		state.failures = 0
+	}
+	to := state.state
+	b.lock.Unlock()
+
+	if from != to {
+		b.notify(endpoint, from, to)
+	}
+}
+
+// endpoint 返回接口的熔断状态，调用方需持有 b.lock
+func (b *CircuitBreaker) endpoint(endpoint string) *endpointState {
+	state, ok := b.endpoints[endpoint]
+	if !ok {
+		state = &endpointState{}
+		b.endpoints[endpoint] = state
+	}
+	return state
+}
+
+func (b *CircuitBreaker) notify(endpoint string, from, to State) {
+	if b.onStateChange != nil {
+		b.onStateChange(endpoint, from, to)
+	}
+}
+
+// endpointState 单个接口的熔断状态
+type endpointState struct {
+	state      State
+	generation uint64 // 每次状态变化时递增，用于忽略状态变化前发出的请求的结果
+	failures   int    // 关闭状态下的连续失败次数
+	probes     int    // 半开状态下已放行的探测请求数
+	successes  int    // 半开状态下成功的探测请求数
+	openedAt   time.Time
+}
+
+// beforeRequest 熔断时间结束时进入半开状态
+func (s *endpointState) beforeRequest(now time.Time, b *CircuitBreaker) (from State, changed bool) {
+	if s.state == StateOpen && now.Sub(s.openedAt) >= b.openTimeout {
+		s.transit(StateHalfOpen, now)
+		return StateOpen, true
+	}
+	return s.state, false
+}
+
+func (s *endpointState) transit(to State, now time.Time) {
+	s.state = to
+	s.generation++
+	s.failures, s.probes, s.successes = 0, 0, 0
+	if to == StateOpen {
+		s.openedAt = now
+	}
+}
@@ -0,0 +1,254 @@
+package circuitbreaker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/circuitbreaker"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/generator"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var (
+	systemError   = &core.APIError{StatusCode: http.StatusInternalServerError, Code: "SYSTEM_ERROR"}
+	businessError = &core.APIError{StatusCode: http.StatusBadRequest, Code: "ORDER_PAID"}
+)
+
+func TestIsFailure(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{businessError, false},
+		{systemError, true},
+		{&core.APIError{StatusCode: http.StatusBadGateway}, true},
+		{&core.APIError{StatusCode: http.StatusForbidden, Code: "SYSTEM_ERROR"}, true},
+		{fmt.Errorf("wrapped: %w", systemError), true},
+		{&url.Error{Op: "Post", URL: "https://api.mch.weixin.qq.com", Err: timeoutError{}}, true},
+		{context.DeadlineExceeded, true},
+		{context.Canceled, false},
+		{errors.New("generate authorization err"), false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, circuitbreaker.IsFailure(tt.err), "%v", tt.err)
+	}
+}
+
+func TestEndpointOf(t *testing.T) {
+	tests := []struct {
+		method, path string
+		want         string
+	}{
+		{"get", "/v3/pay/transactions/out-trade-no/1217752501201407033233368018", "GET /v3/pay/transactions/out-trade-no/{out_trade_no}"},
+		// 不含数字的参数值同样归为一个接口
+		{http.MethodGet, "/v3/pay/transactions/out-trade-no/ORDER_abc", "GET /v3/pay/transactions/out-trade-no/{out_trade_no}"},
+		{http.MethodPost, "/v3/pay/transactions/out-trade-no/ORDER_abc/close", "POST /v3/pay/transactions/out-trade-no/{out_trade_no}/close"},
+		{http.MethodPost, "/v3/pay/transactions/native", "POST /v3/pay/transactions/native"},
+		{http.MethodGet, "/v3/pay/transactions/id/4200000001", "GET /v3/pay/transactions/id/{transaction_id}"},
+		// 含数字的固定段不会被当作参数，不同业务的接口互不影响
+		{http.MethodPost, "/v3/applyment4sub/applyment/", "POST /v3/applyment4sub/applyment/"},
+		{http.MethodGet, "/v3/apply4sub/sub_merchants/1900000109/settlement", "GET /v3/apply4sub/sub_merchants/{sub_mchid}/settlement"},
+		{http.MethodGet, "/v3/applyment4sub/applyment/business_code/1900013511_10000", "GET /v3/applyment4sub/applyment/business_code/{business_code}"},
+		// 未知接口按路径前两段归类，熔断器数量有限
+		{http.MethodGet, "/v3/unknown/orders/ORDER1", "GET /v3/unknown/*"},
+		{http.MethodGet, "/v3/unknown/orders/ORDER2/detail", "GET /v3/unknown/*"},
+		{http.MethodGet, "/v3/unknown", "GET /v3/unknown"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, circuitbreaker.EndpointOf(tt.method, tt.path), tt.path)
+	}
+}
+
+func TestEndpointClassifier(t *testing.T) {
+	c, err := circuitbreaker.NewEndpointClassifier(
+		"/v3/orders/{id}",
+		"/v3/orders/{id}/items/{item_id}",
+		// 固定段更多的模板优先，与注册顺序无关
+		"/v3/orders/latest",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "GET /v3/orders/latest", c.EndpointOf(http.MethodGet, "/v3/orders/latest"))
+	assert.Equal(t, "GET /v3/orders/{id}", c.EndpointOf(http.MethodGet, "/v3/orders/abc"))
+	assert.Equal(t, "PUT /v3/orders/{id}/items/{item_id}", c.EndpointOf("put", "/v3/orders/abc/items/1"))
+	// 参数段不匹配空字符串
+	assert.Equal(t, "GET /v3/orders/*", c.EndpointOf(http.MethodGet, "/v3/orders//items/1"))
+	assert.Equal(t, "GET /v3/orders/*", c.EndpointOf(http.MethodGet, "/v3/orders/"))
+
+	for _, template := range []string{"v3/orders", "/v3/orders/{id", "/v3/orders/x{id}"} {
+		_, err = circuitbreaker.NewEndpointClassifier(template)
+		assert.Error(t, err, template)
+	}
+}
+
+// TestDefaultPathTemplates 确保 SDK 中的每个接口路径都在 DefaultPathTemplates 中
+func TestDefaultPathTemplates(t *testing.T) {
+	templates := make(map[string]bool)
+	for _, template := range circuitbreaker.DefaultPathTemplates {
+		templates[template] = true
+	}
+
+	apiPath := regexp.MustCompile(`WechatPayAPIServer \+ "(/v3/[^"]*)"`)
+	err := filepath.Walk("../../services", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range apiPath.FindAllStringSubmatch(string(content), -1) {
+			// 以 / 结尾的可能是拼接其他路径的前缀，如 /v3/pay/transactions/
+			if strings.HasSuffix(match[1], "/") && !templates[match[1]] {
+				continue
+			}
+			assert.True(t, templates[match[1]], "%s: %s is not in DefaultPathTemplates", path, match[1])
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestDefaultPathTemplatesCoverSpecs 确保 internal/generator/specs 中每个接口定义的路径都在 DefaultPathTemplates 中，
+// 新增或修改接口定义后未重新执行 go generate 时失败
+func TestDefaultPathTemplatesCoverSpecs(t *testing.T) {
+	templates := make(map[string]bool)
+	for _, template := range circuitbreaker.DefaultPathTemplates {
+		templates[template] = true
+	}
+
+	specPaths, err := filepath.Glob("../../internal/generator/specs/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, specPaths)
+	for _, specPath := range specPaths {
+		spec, err := generator.LoadSpec(specPath)
+		require.NoError(t, err)
+		for path := range spec.Paths {
+			assert.True(t, templates[path], "%s: %s is not in DefaultPathTemplates", filepath.Base(specPath), path)
+		}
+	}
+
+	// DefaultPathTemplates 有序且不含重复的模板
+	assert.True(t, sort.StringsAreSorted(circuitbreaker.DefaultPathTemplates))
+	assert.Len(t, templates, len(circuitbreaker.DefaultPathTemplates))
+}
+
+type stateChange struct {
+	endpoint string
+	from, to circuitbreaker.State
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	var changes []stateChange
+	b := circuitbreaker.NewCircuitBreaker(
+		circuitbreaker.WithFailureThreshold(3),
+		circuitbreaker.WithOpenTimeout(50*time.Millisecond),
+		circuitbreaker.WithStateChangeCallback(func(endpoint string, from, to circuitbreaker.State) {
+			changes = append(changes, stateChange{endpoint, from, to})
+		}),
+	)
+	const method, path = http.MethodPost, "/v3/pay/transactions/native"
+	const endpoint = "POST /v3/pay/transactions/native"
+
+	request := func(err error) error {
+		done, allowErr := b.Allow(ctx, method, path)
+		if allowErr != nil {
+			return allowErr
+		}
+		done(err)
+		return nil
+	}
+
+	// 业务错误与成功请求重置连续失败次数
+	require.NoError(t, request(systemError))
+	require.NoError(t, request(systemError))
+	require.NoError(t, request(businessError))
+	require.NoError(t, request(systemError))
+	require.NoError(t, request(systemError))
+	assert.Equal(t, circuitbreaker.StateClosed, b.State(method, path))
+
+	// 连续失败达到阈值后打开熔断
+	require.NoError(t, request(systemError))
+	assert.Equal(t, circuitbreaker.StateOpen, b.State(method, path))
+	assert.True(t, errors.Is(request(nil), circuitbreaker.ErrOpen))
+
+	// 其他接口不受影响
+	done, err := b.Allow(ctx, http.MethodGet, "/v3/pay/transactions/out-trade-no/123")
+	require.NoError(t, err)
+	done(nil)
+
+	// 熔断时间结束后进入半开状态，仅放行一个探测请求，探测失败则再次打开熔断
+	time.Sleep(60 * time.Millisecond)
+	probe, err := b.Allow(ctx, method, path)
+	require.NoError(t, err)
+	assert.True(t, errors.Is(request(nil), circuitbreaker.ErrOpen))
+	probe(timeoutError{})
+	assert.Equal(t, circuitbreaker.StateOpen, b.State(method, path))
+
+	// 探测成功后关闭熔断
+	time.Sleep(60 * time.Millisecond)
+	probe, err = b.Allow(ctx, method, path)
+	require.NoError(t, err)
+	probe(nil)
+	probe(systemError) // done 仅第一次调用有效
+	assert.Equal(t, circuitbreaker.StateClosed, b.State(method, path))
+
+	assert.Equal(t, []stateChange{
+		{endpoint, circuitbreaker.StateClosed, circuitbreaker.StateOpen},
+		{endpoint, circuitbreaker.StateOpen, circuitbreaker.StateHalfOpen},
+		{endpoint, circuitbreaker.StateHalfOpen, circuitbreaker.StateOpen},
+		{endpoint, circuitbreaker.StateOpen, circuitbreaker.StateHalfOpen},
+		{endpoint, circuitbreaker.StateHalfOpen, circuitbreaker.StateClosed},
+	}, changes)
+}
+
+func TestCircuitBreaker_StaleResult(t *testing.T) {
+	ctx := context.Background()
+	b := circuitbreaker.NewCircuitBreaker(
+		circuitbreaker.WithFailureThreshold(1),
+		circuitbreaker.WithOpenTimeout(20*time.Millisecond),
+	)
+	const method, path = http.MethodGet, "/v3/certificates"
+
+	slow, err := b.Allow(ctx, method, path)
+	require.NoError(t, err)
+	failed, err := b.Allow(ctx, method, path)
+	require.NoError(t, err)
+	failed(systemError)
+	assert.Equal(t, circuitbreaker.StateOpen, b.State(method, path))
+
+	// 熔断打开前发出的请求的结果不再计入
+	time.Sleep(30 * time.Millisecond)
+	probe, err := b.Allow(ctx, method, path)
+	require.NoError(t, err)
+	slow(nil)
+	assert.Equal(t, circuitbreaker.StateHalfOpen, b.State(method, path))
+
+	// 被取消的探测请求归还探测名额
+	probe(context.Canceled)
+	assert.Equal(t, circuitbreaker.StateHalfOpen, b.State(method, path))
+	probe, err = b.Allow(ctx, method, path)
+	require.NoError(t, err)
+	probe(nil)
+	assert.Equal(t, circuitbreaker.StateClosed, b.State(method, path))
+}
@@ -0,0 +1,129 @@
+package circuitbreaker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultPathTemplates SDK 中各接口的路径模板，{} 包围的段为路径参数，用于划分默认的熔断接口
+//
+// 包含 internal/generator/specs 中全部接口定义的路径（由 go generate 生成，见 endpoint_paths_generated.go）
+// 与 servicePathTemplates 中其余接口的路径。
+var DefaultPathTemplates = mergePathTemplates(generatedPathTemplates, servicePathTemplates)
+
+// servicePathTemplates 没有接口定义的服务（如基础支付、图片上传与账单下载）的路径模板，需手动维护
+var servicePathTemplates = []string{
+	"/v3/billdownload/file",
+	"/v3/marketing/favor/media/image-upload",
+	"/v3/merchant-service/images/upload",
+	"/v3/merchant-service/images/{media_id}",
+	"/v3/merchant/media/upload",
+	"/v3/merchant/media/video_upload",
+	"/v3/pay/transactions/app",
+	"/v3/pay/transactions/h5",
+	"/v3/pay/transactions/jsapi",
+}
+
+// mergePathTemplates 合并路径模板，去除重复的模板并按字典序排列
+func mergePathTemplates(lists ...[]string) []string {
+	seen := make(map[string]bool)
+	var merged []string
+	for _, list := range lists {
+		for _, template := range list {
+			if !seen[template] {
+				seen[template] = true
+				merged = append(merged, template)
+			}
+		}
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+var defaultClassifier = mustEndpointClassifier(DefaultPathTemplates...)
+
+// EndpointClassifier 按接口路径模板划分请求所属的接口
+//
+// 路径模板中 {} 包围的段（如 {out_trade_no}）匹配任意非空的路径段，其他段需完全相同；
+// 请求路径匹配多个模板时，使用固定段最多的模板，固定段数相同时使用先注册的模板。
+type EndpointClassifier struct {
+	templates map[int][]pathTemplate
+}
+
+type pathTemplate struct {
+	template string
+	segments []string
+	static   int
+}
+
+// NewEndpointClassifier 使用路径模板创建 EndpointClassifier，可以在 DefaultPathTemplates 的基础上追加 SDK 未包含的接口
+func NewEndpointClassifier(templates ...string) (*EndpointClassifier, error) {
+	c := &EndpointClassifier{templates: make(map[int][]pathTemplate)}
+	for _, template := range templates {
+		if !strings.HasPrefix(template, "/") {
+			return nil, fmt.Errorf("path template %q must start with /", template)
+		}
+		t := pathTemplate{template: template, segments: strings.Split(template, "/")}
+		for _, segment := range t.segments {
+			if isPathParam(segment) {
+				continue
+			}
+			if strings.ContainsAny(segment, "{}") {
+				return nil, fmt.Errorf("path template %q has invalid segment %q", template, segment)
+			}
+			t.static++
+		}
+		c.templates[len(t.segments)] = append(c.templates[len(t.segments)], t)
+	}
+	return c, nil
+}
+
+func mustEndpointClassifier(templates ...string) *EndpointClassifier {
+	c, err := NewEndpointClassifier(templates...)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// EndpointOf 返回请求所属的接口：HTTP 方法与匹配的路径模板，例如 GET /v3/pay/transactions/out-trade-no/{out_trade_no}。
+//
+// 未匹配任何模板的请求按路径的前两段归为同一接口，例如 GET /v3/marketing/*，以免每个订单号等参数值各自创建熔断器
+func (c *EndpointClassifier) EndpointOf(method, path string) string {
+	method = strings.ToUpper(method)
+	segments := strings.Split(path, "/")
+
+	var matched *pathTemplate
+	candidates := c.templates[len(segments)]
+	for i := range candidates {
+		if candidates[i].match(segments) && (matched == nil || candidates[i].static > matched.static) {
+			matched = &candidates[i]
+		}
+	}
+	if matched != nil {
+		return method + " " + matched.template
+	}
+
+	if len(segments) > 3 {
+		segments = append(segments[:3], "*")
+	}
+	return method + " " + strings.Join(segments, "/")
+}
+
+func (t *pathTemplate) match(segments []string) bool {
+	for i, segment := range t.segments {
+		if isPathParam(segment) {
+			if segments[i] == "" {
+				return false
+			}
+		} else if segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isPathParam(segment string) bool {
+	return len(segment) > 2 && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
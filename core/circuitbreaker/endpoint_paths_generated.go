@@ -0,0 +1,101 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package circuitbreaker
+
+// generatedPathTemplates internal/generator/specs 中全部接口定义的路径模板
+var generatedPathTemplates = []string{
+	"/v3/apply4sub/sub_merchants/{sub_mchid}/application/{application_no}",
+	"/v3/apply4sub/sub_merchants/{sub_mchid}/development-config",
+	"/v3/apply4sub/sub_merchants/{sub_mchid}/modify-settlement",
+	"/v3/apply4sub/sub_merchants/{sub_mchid}/settlement",
+	"/v3/applyment4sub/applyment/",
+	"/v3/applyment4sub/applyment/applyment_id/{applyment_id}",
+	"/v3/applyment4sub/applyment/business_code/{business_code}",
+	"/v3/bill/fundflowbill",
+	"/v3/bill/tradebill",
+	"/v3/certificates",
+	"/v3/combine-transactions/app",
+	"/v3/combine-transactions/jsapi",
+	"/v3/combine-transactions/out-trade-no/{combine_out_trade_no}",
+	"/v3/combine-transactions/out-trade-no/{combine_out_trade_no}/close",
+	"/v3/ecommerce/fund/balance/{sub_mchid}",
+	"/v3/ecommerce/fund/enddaybalance/{sub_mchid}",
+	"/v3/ecommerce/fund/withdraw",
+	"/v3/ecommerce/fund/withdraw/out-request-no/{out_request_no}",
+	"/v3/ecommerce/fund/withdraw/{withdraw_id}",
+	"/v3/ecommerce/profitsharing/finish-order",
+	"/v3/ecommerce/profitsharing/returnorders",
+	"/v3/ecommerce/subsidies/cancel",
+	"/v3/ecommerce/subsidies/create",
+	"/v3/ecommerce/subsidies/return",
+	"/v3/edu-papay/contracts/id/{contract_id}",
+	"/v3/edu-papay/contracts/presign",
+	"/v3/edu-papay/contracts/{contract_id}",
+	"/v3/edu-papay/transactions",
+	"/v3/edu-papay/transactions/id/{transaction_id}",
+	"/v3/edu-papay/transactions/out-trade-no/{out_trade_no}",
+	"/v3/edu-papay/user-notifications/{contract_id}/send",
+	"/v3/edu-papay/user/{openid}/contracts",
+	"/v3/global/rate",
+	"/v3/global/transactions/app",
+	"/v3/global/transactions/id/{transaction_id}",
+	"/v3/global/transactions/jsapi",
+	"/v3/global/transactions/mweb",
+	"/v3/global/transactions/native",
+	"/v3/global/transactions/out-trade-no/{out_trade_no}",
+	"/v3/global/transactions/out-trade-no/{out_trade_no}/close",
+	"/v3/marketing/busifavor/callbacks",
+	"/v3/marketing/busifavor/stocks",
+	"/v3/marketing/busifavor/stocks/{stock_id}/budget",
+	"/v3/marketing/busifavor/stocks/{stock_id}/couponcodes",
+	"/v3/marketing/busifavor/stocks/{stock_id}/couponcodes/{upload_request_no}",
+	"/v3/marketing/favor/callbacks",
+	"/v3/marketing/favor/stocks/{stock_id}",
+	"/v3/marketing/favor/users/{openid}/coupons",
+	"/v3/marketing/partnerships",
+	"/v3/marketing/partnerships/build",
+	"/v3/marketing/partnerships/terminate",
+	"/v3/merchant-risk-manage/violation-notifications",
+	"/v3/merchant-service/complaints-v2",
+	"/v3/merchant-service/complaints-v2/{complaint_id}/response",
+	"/v3/merchant/fund/withdraw/bill-type/{bill_type}",
+	"/v3/new-tax-control-fapiao/user-title",
+	"/v3/new-tax-control-fapiao/user-title/title-url",
+	"/v3/pay/partner/transactions/app",
+	"/v3/pay/partner/transactions/h5",
+	"/v3/pay/partner/transactions/jsapi",
+	"/v3/pay/partner/transactions/native",
+	"/v3/pay/partner/transactions/out-trade-no/{out_trade_no}",
+	"/v3/pay/partner/transactions/out-trade-no/{out_trade_no}/close",
+	"/v3/pay/transactions/id/{transaction_id}",
+	"/v3/pay/transactions/native",
+	"/v3/pay/transactions/out-trade-no/{out_trade_no}",
+	"/v3/pay/transactions/out-trade-no/{out_trade_no}/close",
+	"/v3/payscore/serviceorder",
+	"/v3/payscore/serviceorder/{out_order_no}/cancel",
+	"/v3/payscore/serviceorder/{out_order_no}/complete",
+	"/v3/payscore/serviceorder/{out_order_no}/modify",
+	"/v3/payscore/serviceorder/{out_order_no}/pay",
+	"/v3/payscore/serviceorder/{out_order_no}/sync",
+	"/v3/profitsharing/merchant-configs/{sub_mchid}",
+	"/v3/profitsharing/orders",
+	"/v3/profitsharing/orders/unfreeze",
+	"/v3/profitsharing/orders/{out_order_no}",
+	"/v3/profitsharing/receivers/add",
+	"/v3/profitsharing/receivers/delete",
+	"/v3/profitsharing/transactions/{transaction_id}/amounts",
+	"/v3/refund/domestic/refunds",
+	"/v3/refund/domestic/refunds/{out_refund_no}",
+	"/v3/transfer-detail/electronic-receipts",
+	"/v3/transfer/batches",
+	"/v3/transfer/batches/batch-id/{batch_id}",
+	"/v3/transfer/batches/batch-id/{batch_id}/details/detail-id/{detail_id}",
+	"/v3/transfer/batches/out-batch-no/{out_batch_no}",
+	"/v3/transfer/batches/out-batch-no/{out_batch_no}/details/out-detail-no/{out_detail_no}",
+	"/v3/vehicle/parking/parkings",
+	"/v3/vehicle/parking/services/find",
+	"/v3/vehicle/transactions/out-trade-no/{out_trade_no}",
+	"/v3/vehicle/transactions/parking",
+}
@@ -9,6 +9,7 @@ package core
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"encoding/xml"
@@ -27,9 +28,11 @@ import (
 	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
-	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/credentials"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/signers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/validators"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/ratelimit"
 )
 
 var (
@@ -65,6 +68,24 @@ type Client struct {
 	validator  auth.Validator
 	signer     auth.Signer
 	cipher     cipher.Cipher
+	limiter    ratelimit.Limiter
+	breaker    Breaker
+	mchID      string
+	timeout    time.Duration
+
+	acceptLanguage     string
+	userAgent          string
+	acceptGzip         bool
+	gzipRequestMinSize int
+	apiServer          string
+	clock              auth.Clock
+	nonceGenerator     auth.NonceGenerator
+	signRecorder       auth.SignRecorder
+	validationMetrics  auth.ValidationMetrics
+	hedgeDelay         time.Duration
+	hedgePaths         []string
+	auditSink          AuditSink
+	dryRun             bool
 }
 
 // NewClient 初始化一个微信支付API v3 HTTPClient
@@ -99,26 +120,87 @@ func NewClientWithValidator(client *Client, validator auth.Validator) *Client {
 		signer:     client.signer,
 		validator:  validator,
 		cipher:     client.cipher,
+		limiter:    client.limiter,
+		breaker:    client.breaker,
+		mchID:      client.mchID,
+		timeout:    client.timeout,
+
+		acceptLanguage:     client.acceptLanguage,
+		userAgent:          client.userAgent,
+		acceptGzip:         client.acceptGzip,
+		gzipRequestMinSize: client.gzipRequestMinSize,
+		apiServer:          client.apiServer,
+		clock:              client.clock,
+		nonceGenerator:     client.nonceGenerator,
+		signRecorder:       client.signRecorder,
+		validationMetrics:  client.validationMetrics,
+		hedgeDelay:         client.hedgeDelay,
+		hedgePaths:         client.hedgePaths,
+		auditSink:          client.auditSink,
+		dryRun:             client.dryRun,
 	}
 }
 
 func initClientWithSettings(_ context.Context, settings *DialSettings) *Client {
+	signer := settings.Signer
+	if settings.SignConcurrency > 0 {
+		signer = signers.NewBoundedSigner(signer, settings.SignConcurrency)
+	}
 	client := &Client{
-		signer:     settings.Signer,
+		signer:     signer,
 		validator:  settings.Validator,
-		credential: &credentials.WechatPayCredentials{Signer: settings.Signer},
 		httpClient: settings.HTTPClient,
 		cipher:     settings.Cipher,
+		limiter:    settings.Limiter,
+		breaker:    settings.Breaker,
+		mchID:      signerMchID(settings.Signer),
+		timeout:    settings.Timeout,
+
+		acceptLanguage:     settings.AcceptLanguage,
+		userAgent:          fmt.Sprintf(consts.UserAgentFormat, consts.Version, runtime.GOOS, runtime.Version()),
+		acceptGzip:         settings.AcceptGzip,
+		gzipRequestMinSize: settings.GzipRequestMinSize,
+		apiServer:          settings.APIServer,
+		clock:              settings.Clock,
+		nonceGenerator:     settings.NonceGenerator,
+		signRecorder:       settings.SignRecorder,
+		validationMetrics:  settings.ValidationMetrics,
+		hedgeDelay:         settings.HedgeDelay,
+		hedgePaths:         settings.HedgePaths,
+		auditSink:          settings.AuditSink,
+		dryRun:             settings.DryRun,
+	}
+	// Credentials、AuthScheme 与 BaseURLValidators 已在 DialSettings.Validate 中校验
+	client.credential, _ = newCredential(settings, signer)
+	if len(settings.BaseURLValidators) > 0 {
+		client.validator, _ = validators.NewBaseURLValidator(settings.Validator, settings.BaseURLValidators)
+	}
+	if settings.UserAgentSuffix != "" {
+		client.userAgent += " " + settings.UserAgentSuffix
+	}
+
+	if client.timeout == 0 {
+		client.timeout = consts.DefaultTimeout
+	}
+	if settings.Resolver != nil {
+		client.httpClient = httpClientWithResolver(client.httpClient, settings.Resolver)
 	}
-
 	if client.httpClient == nil {
-		client.httpClient = &http.Client{
-			Timeout: consts.DefaultTimeout,
-		}
+		// 超时由请求的 ctx 控制（未设置截止时间时使用默认超时时间），
+		// 不设置 http.Client.Timeout，以免截止时间较长的请求（如下载大文件）被提前中断
+		client.httpClient = &http.Client{}
 	}
 	return client
 }
 
+// signerMchID 返回签名器对应的商户号，签名器未提供商户号时返回空字符串
+func signerMchID(signer auth.Signer) string {
+	if s, ok := signer.(interface{ GetMchID() string }); ok {
+		return s.GetMchID()
+	}
+	return ""
+}
+
 func initSettings(opts []ClientOption) (*DialSettings, error) {
 	var (
 		o   DialSettings
@@ -188,6 +270,48 @@ func (client *Client) doRequest(
 	reqBody io.Reader,
 	signBody string,
 ) (*APIResult, error) {
+	ctx = client.withContextDefaults(ctx)
+	defer recordRequestInfo(ctx)
+	if _, dryRun := client.getDryRun(ctx); !dryRun && reqBody == nil && client.shouldHedge(method, requestURL) {
+		return client.doHedgedRequest(ctx, method, requestURL, header, contentType)
+	}
+	result, err := client.sendRequest(ctx, method, requestURL, header, contentType, reqBody, signBody)
+	if err != nil {
+		return result, err
+	}
+	// Validate WechatPay Signature
+	if err = client.validator.Validate(ctx, result.Response); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// withContextDefaults 为 ctx 设置本次请求新的日志关联信息（请求结束后使用 recordRequestInfo 记录），以及 Client 的时钟、随机串生成器与签名调试信息的回调，ctx 中已设置的时钟与回调优先
+func (client *Client) withContextDefaults(ctx context.Context) context.Context {
+	ctx = AttachRequestInfo(ctx, RequestInfo{MchID: client.mchID})
+	if client.clock != nil && !auth.HasClock(ctx) {
+		ctx = auth.WithClock(ctx, client.clock)
+	}
+	ctx = client.withNonceGenerator(ctx)
+	if client.signRecorder != nil && !auth.HasSignRecorder(ctx) {
+		ctx = auth.WithSignRecorder(ctx, client.signRecorder)
+	}
+	if client.validationMetrics != nil && !auth.HasValidationMetrics(ctx) {
+		ctx = auth.WithValidationMetrics(ctx, client.validationMetrics)
+	}
+	return ctx
+}
+
+// sendRequest 签名并发送请求，检查应答状态码，但不对应答进行验签
+func (client *Client) sendRequest(
+	ctx context.Context,
+	method string,
+	requestURL string,
+	header http.Header,
+	contentType string,
+	reqBody io.Reader,
+	signBody string,
+) (*APIResult, error) {
 
 	var (
 		err           error
@@ -195,10 +319,52 @@ func (client *Client) doRequest(
 		request       *http.Request
 	)
 
+	parentCtx := ctx
+	dryRun, isDryRun := client.getDryRun(ctx)
+
+	// Apply Default Timeout if ctx has no deadline.
+	// The timeout context is released when the response body is read to EOF or closed.
+	cancel := context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok && client.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, client.timeout)
+	}
+	releaseOnReturn := true
+	defer func() {
+		if releaseOnReturn {
+			cancel()
+		}
+	}()
+
+	// Compress Request Body, the signature is still calculated on the uncompressed body
+	compressed := false
+	if reqBody != nil && client.gzipRequestMinSize > 0 && len(signBody) >= client.gzipRequestMinSize &&
+		strings.HasPrefix(contentType, consts.ApplicationJSON) {
+		if reqBody, err = gzipBody(signBody); err != nil {
+			return nil, fmt.Errorf("compress request body err:%v", err)
+		}
+		compressed = true
+	}
+
 	// Construct Request
+	requestURL = client.rewriteURL(requestURL)
+	// RequestInfo has been set by withContextDefaults
+	info, _ := RequestInfoFromContext(ctx)
+	info.Method = method
 	if request, err = http.NewRequestWithContext(ctx, method, requestURL, reqBody); err != nil {
 		return nil, err
 	}
+	// Escape characters not allowed in URL, so that the signed URL is exactly what WechatPay receives
+	request.URL.RawQuery = auth.NormalizeQuery(request.URL.RawQuery)
+	info.Path = request.URL.Path
+	trackRequestProgress(request, getRequestProgress(ctx))
+
+	// Wait for Rate Limiter before signing, so that the signature timestamp is not stale
+	if client.limiter != nil && !isDryRun {
+		key := ratelimit.Key{MchID: client.mchID, Method: method, Path: request.URL.Path}
+		if err = client.limiter.Wait(ctx, key); err != nil {
+			return nil, fmt.Errorf("wait for rate limiter err:%w", err)
+		}
+	}
 
 	// Header Setting Priority:
 	// Fixed Headers > Per-Request Header Parameters
@@ -210,12 +376,43 @@ func (client *Client) doRequest(
 		}
 	}
 
+	// Set Default Headers, which can be overridden by Per-Request Header Parameters
+	if client.acceptLanguage != "" && request.Header.Get(consts.AcceptLanguage) == "" {
+		request.Header.Set(consts.AcceptLanguage, client.acceptLanguage)
+	}
+	if client.acceptGzip && request.Header.Get(consts.AcceptEncoding) == "" {
+		request.Header.Set(consts.AcceptEncoding, "gzip")
+	}
+	if request.Header.Get(consts.IdempotencyKey) == "" {
+		idempotencyKey, err := getIdempotencyKey(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("generate idempotency key err:%v", err)
+		}
+		if idempotencyKey != "" {
+			request.Header.Set(consts.IdempotencyKey, idempotencyKey)
+		}
+	}
+
+	encryptSerial, err := getEncryptSerial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if encryptSerial != "" {
+		if serial := request.Header.Get(consts.WechatPaySerial); serial == "" {
+			request.Header.Set(consts.WechatPaySerial, encryptSerial)
+		} else if serial != encryptSerial {
+			return nil, fmt.Errorf("%s header %s does not match the serial %s used for encryption",
+				consts.WechatPaySerial, serial, encryptSerial)
+		}
+	}
+
 	// Set Fixed Headers
 	request.Header.Set(consts.Accept, "*/*")
 	request.Header.Set(consts.ContentType, contentType)
-
-	ua := fmt.Sprintf(consts.UserAgentFormat, consts.Version, runtime.GOOS, runtime.Version())
-	request.Header.Set(consts.UserAgent, ua)
+	request.Header.Set(consts.UserAgent, client.userAgent)
+	if compressed {
+		request.Header.Set(consts.ContentEncoding, "gzip")
+	}
 
 	// Set Authentication
 	if authorization, err = client.credential.GenerateAuthorizationHeader(
@@ -226,22 +423,139 @@ func (client *Client) doRequest(
 	}
 	request.Header.Set(consts.Authorization, authorization)
 
+	// Return the signed request without sending it in dry run mode
+	if isDryRun {
+		dryRun.setRequest(request.WithContext(parentCtx))
+		return nil, ErrDryRun
+	}
+
+	// Check Circuit Breaker
+	var done func(error)
+	if client.breaker != nil {
+		if done, err = client.breaker.Allow(ctx, method, request.URL.Path); err != nil {
+			return nil, fmt.Errorf("circuit breaker err:%w", err)
+		}
+	}
+
 	// Send HTTP Request
+	start := time.Now()
 	result, err := client.doHTTP(request)
-	if err != nil {
-		return result, err
+	if err == nil {
+		info.RequestID = result.Response.Header.Get(consts.RequestID)
+		// Decompress before validating, WechatPay signs the uncompressed body
+		decompressResponse(result.Response)
+		// Check if Success
+		err = CheckResponse(result.Response)
 	}
-	// Check if Success
-	if err = CheckResponse(result.Response); err != nil {
-		return result, err
+	client.audit(ctx, info, start, result, err)
+	if done != nil {
+		done(err)
 	}
-	// Validate WechatPay Signature
-	if err = client.validator.Validate(ctx, result.Response); err != nil {
+	if err != nil {
 		return result, err
 	}
+	releaseOnReturn = false
+	result.Response.Body = &cancelOnEOFBody{ReadCloser: result.Response.Body, cancel: cancel}
 	return result, nil
 }
 
+// rewriteURL 将发往 consts.WechatPayAPIServer 的请求改写为发往 client.apiServer，其他地址（如账单下载地址）保持不变
+//
+// 签名只包含请求路径而不包含域名，因此改写不影响签名
+func (client *Client) rewriteURL(requestURL string) string {
+	if client.apiServer == "" || !strings.HasPrefix(requestURL, consts.WechatPayAPIServer) {
+		return requestURL
+	}
+	rest := requestURL[len(consts.WechatPayAPIServer):]
+	if rest != "" && rest[0] != '/' && rest[0] != '?' {
+		return requestURL
+	}
+	return client.apiServer + rest
+}
+
+// gzipBody 使用 gzip 压缩请求包体
+func gzipBody(body string) (*bytes.Buffer, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := io.WriteString(w, body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// decompressResponse 解压 Content-Encoding 为 gzip 的应答包体，解压后的应答与未压缩的应答一致
+//
+// 未主动设置 Accept-Encoding 时，http.Transport 已透明地完成解压，不会进入本方法的解压流程
+func decompressResponse(resp *http.Response) {
+	if !strings.EqualFold(resp.Header.Get(consts.ContentEncoding), "gzip") {
+		return
+	}
+	resp.Body = &gzipReadCloser{body: resp.Body}
+	resp.Header.Del(consts.ContentEncoding)
+	resp.Header.Del(consts.ContentLength)
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+}
+
+// gzipReadCloser 在首次读取时创建 gzip.Reader，关闭时关闭原应答包体
+type gzipReadCloser struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+func (r *gzipReadCloser) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.zr == nil {
+		if r.zr, r.err = gzip.NewReader(r.body); r.err != nil {
+			r.err = fmt.Errorf("decompress response body err:%w", r.err)
+			return 0, r.err
+		}
+	}
+	return r.zr.Read(p)
+}
+
+func (r *gzipReadCloser) Close() error {
+	return r.body.Close()
+}
+
+// cancelOnEOFBody 应答包体读取完毕或被关闭时，释放请求的超时 context
+type cancelOnEOFBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnEOFBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.cancel()
+	}
+	return n, err
+}
+
+func (b *cancelOnEOFBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// Download 下载账单文件、媒体文件（图片、视频）等资源，downloadURL 通常为申请账单等接口返回的 download_url
+//
+// 这类接口成功应答的包体为文件内容，微信支付不会对其签名，因此本方法不对成功应答进行验签，也不会读取应答包体，
+// 无需为下载另外创建使用 NullValidator 的 Client。调用方可以直接以流的方式读取 result.Response.Body，并在使用完毕后关闭。
+// 文件的完整性请使用申请接口返回的摘要（如账单的 hash_type 与 hash_value）进行校验，申请接口的应答仍会被正常验签。
+// 失败应答（非 2XX）与其他接口一致，返回 *APIError。
+func (client *Client) Download(ctx context.Context, downloadURL string) (*APIResult, error) {
+	ctx = client.withContextDefaults(ctx)
+	defer recordRequestInfo(ctx)
+	return client.sendRequest(ctx, http.MethodGet, downloadURL, nil, consts.ApplicationJSON, nil, "")
+}
+
 // Request 向微信支付发送请求
 //
 // 相比于 Get / Post / Put / Patch / Delete 方法，本方法可以设置更多内容
@@ -301,11 +615,17 @@ func (client *Client) doHTTP(req *http.Request) (result *APIResult, err error) {
 // 未设置 cipher 时将跳过加密，并返回空序列号。
 //
 // 本方法会对结构中的敏感字段进行原地加密，因此需要传入结构体的指针。
+// ctx 通过 WithWechatPaySerial 设置后，使用该 ctx 发出的请求将自动设置 Wechatpay-Serial 请求头为返回的序列号。
 func (client *Client) EncryptRequest(ctx context.Context, req interface{}) (string, error) {
 	if client.cipher == nil {
 		return "", nil
 	}
-	return client.cipher.Encrypt(ctx, req)
+	serial, err := client.cipher.Encrypt(ctx, req)
+	if err != nil {
+		return serial, err
+	}
+	recordEncryptSerial(ctx, serial)
+	return serial, nil
 }
 
 // DecryptResponse 使用 cipher 对应答结构进行原地解密，未设置 cipher 时将跳过解密
@@ -323,6 +643,26 @@ func (client *Client) Sign(ctx context.Context, message string) (result *auth.Si
 	return client.signer.Sign(ctx, message)
 }
 
+// GenerateNonce 使用 Client 的随机串生成器生成随机串，用于调起支付等需要由商户签名的参数，ctx 中通过 auth.WithNonceGenerator 设置的生成器优先
+func (client *Client) GenerateNonce(ctx context.Context) (string, error) {
+	return auth.GenerateNonce(client.withNonceGenerator(ctx))
+}
+
+// Now 返回 Client 的时钟的当前时间，用于调起支付等需要由商户签名的时间戳，ctx 中通过 auth.WithClock 设置的时钟优先
+func (client *Client) Now(ctx context.Context) time.Time {
+	if client.clock != nil && !auth.HasClock(ctx) {
+		return client.clock.Now()
+	}
+	return auth.Now(ctx)
+}
+
+func (client *Client) withNonceGenerator(ctx context.Context) context.Context {
+	if client.nonceGenerator != nil && !auth.HasNonceGenerator(ctx) {
+		ctx = auth.WithNonceGenerator(ctx, client.nonceGenerator)
+	}
+	return ctx
+}
+
 // CheckResponse 校验请求是否成功
 //
 // 当http回包的状态码的范围不是200-299之间的时候，会返回相应的错误信息，主要包括http状态码、回包错误码、回包错误信息提示
@@ -352,17 +692,25 @@ func CheckResponse(resp *http.Response) error {
 }
 
 // UnMarshalResponse 将回包组织成结构化数据
+//
+// 应答包体已被验签器读入内存（auth.BufferedBody）时直接使用，不再复制
 func UnMarshalResponse(httpResp *http.Response, resp interface{}) error {
-	body, err := ioutil.ReadAll(httpResp.Body)
-	_ = httpResp.Body.Close()
-
-	if err != nil {
-		return err
+	var body []byte
+	if buffered, ok := httpResp.Body.(*auth.BufferedBody); ok {
+		body = buffered.Bytes()
+	} else {
+		var err error
+		body, err = ioutil.ReadAll(httpResp.Body)
+		_ = httpResp.Body.Close()
+
+		if err != nil {
+			return err
+		}
 	}
 
-	httpResp.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	httpResp.Body = auth.NewBufferedBody(body)
 
-	err = json.Unmarshal(body, resp)
+	err := json.Unmarshal(body, resp)
 	if err != nil {
 		return err
 	}
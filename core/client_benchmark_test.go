@@ -0,0 +1,124 @@
+package core_test
+
+import (
+	"bytes"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+)
+
+const benchmarkPrepayResponse = `{"prepay_id":"wx26112221580621e9b071c00d9e093b0000"}`
+
+func newBenchmarkServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+		writeSignature(w, benchmarkPrepayResponse)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(benchmarkPrepayResponse))
+	}))
+}
+
+// BenchmarkClient_Post 对比默认连接池与按并发量调大空闲连接数的连接池下，下单请求（签名、发送、验签、解析）的性能
+func BenchmarkClient_Post(b *testing.B) {
+	ts := newBenchmarkServer()
+	defer ts.Close()
+
+	pooled := http.DefaultTransport.(*http.Transport).Clone()
+	pooled.MaxIdleConns = 256
+	pooled.MaxIdleConnsPerHost = 256
+	defer pooled.CloseIdleConnections()
+
+	transports := []struct {
+		name      string
+		transport http.RoundTripper
+	}{
+		{"DefaultTransport", http.DefaultTransport},
+		{"PooledTransport", pooled},
+	}
+	request := map[string]string{"appid": "wxd678efh567hg6787", "description": "Image形象店-深圳腾大-QQ公仔"}
+	for _, tt := range transports {
+		b.Run(tt.name, func(b *testing.B) {
+			client, err := core.NewClient(ctx,
+				option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+				option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+				option.WithHTTPClient(&http.Client{Transport: tt.transport}),
+			)
+			require.NoError(b, err)
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					result, err := client.Post(ctx, ts.URL+"/v3/pay/transactions/jsapi", request)
+					if err != nil {
+						b.Fatal(err)
+					}
+					resp := make(map[string]string)
+					if err = core.UnMarshalResponse(result.Response, &resp); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkSigningTransport 不使用 Client、仅以 SigningTransport 签名与验签时的性能
+func BenchmarkSigningTransport(b *testing.B) {
+	ts := newBenchmarkServer()
+	defer ts.Close()
+
+	transport, err := core.NewSigningTransport(nil,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+	)
+	require.NoError(b, err)
+	client := &http.Client{Transport: transport}
+	const request = `{"appid":"wxd678efh567hg6787","description":"Image形象店-深圳腾大-QQ公仔"}`
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			resp, err := client.Post(ts.URL+"/v3/pay/transactions/jsapi", "application/json", strings.NewReader(request))
+			if err != nil {
+				b.Fatal(err)
+			}
+			_, _ = ioutil.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+		}
+	})
+}
+
+// BenchmarkUnMarshalResponse 对比已读入内存的应答包体与需要再次读取的应答包体的解析性能
+func BenchmarkUnMarshalResponse(b *testing.B) {
+	body := []byte(`{"prepay_id":"` + strings.Repeat("x", 4096) + `"}`)
+	bodies := []struct {
+		name string
+		new  func() *http.Response
+	}{
+		{"BufferedBody", func() *http.Response {
+			return &http.Response{Body: auth.NewBufferedBody(body)}
+		}},
+		{"Reader", func() *http.Response {
+			return &http.Response{Body: ioutil.NopCloser(bytes.NewReader(body))}
+		}},
+	}
+	for _, tt := range bodies {
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				resp := make(map[string]string)
+				if err := core.UnMarshalResponse(tt.new(), &resp); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
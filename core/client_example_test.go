@@ -121,3 +121,17 @@ func ExampleCreateFormFile() {
 		// TODO: 处理错误
 	}
 }
+
+func ExampleClient_SelfTest() {
+	// 示例参数，实际使用时请自行初始化
+	var (
+		client      *core.Client
+		mchAPIv3Key string
+	)
+
+	report := client.SelfTest(context.Background(), mchAPIv3Key)
+	if !report.OK() {
+		// 按项目输出检查结果，如 "validator: FAILED (...)"，便于定位有误的配置
+		log.Fatalf("wechat pay client self test failed, request id: %s\n%s", report.RequestID, report)
+	}
+}
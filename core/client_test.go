@@ -2,6 +2,7 @@ package core_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto"
 	"crypto/rsa"
@@ -9,6 +10,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -19,6 +21,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -27,8 +30,14 @@ import (
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/signers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/validators"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/verifiers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/decryptors"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/encryptors"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/ratelimit"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/resolver"
 	"github.com/wechatpay-apiv3/wechatpay-go/utils"
 )
 
@@ -444,3 +453,1126 @@ func TestClient_Upload(t *testing.T) {
 }
 
 func testingKey(s string) string { return strings.ReplaceAll(s, "TESTING KEY", "PRIVATE KEY") }
+
+func TestClient_Download(t *testing.T) {
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+	}
+	client, err := core.NewClient(ctx, opts...)
+	require.NoError(t, err)
+
+	const (
+		downloadURI = "/v3/billdownload/file?token=xxx"
+		fileContent = "交易时间,公众账号ID,商户号\n"
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RequestURI() != downloadURI {
+			w.Header().Set("Request-Id", "0")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":"NO_STATEMENT_EXIST","message":"the bill does not exist"}`)
+			return
+		}
+		schema, params := parseAuthorization(t, r.Header.Get("Authorization"))
+		assertAuthorization(t, schema, http.MethodGet, downloadURI, params, nil)
+
+		// 下载接口的应答包体不含微信支付签名
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, fileContent)
+	}))
+	defer ts.Close()
+
+	result, err := client.Download(ctx, ts.URL+downloadURI)
+	require.NoError(t, err)
+	defer result.Response.Body.Close()
+	body, err := ioutil.ReadAll(result.Response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, fileContent, string(body))
+
+	// 使用普通请求下载时验签失败
+	_, err = client.Get(ctx, ts.URL+downloadURI)
+	assert.Error(t, err)
+
+	_, err = client.Download(ctx, ts.URL+"/v3/billdownload/file?token=yyy")
+	assert.True(t, core.IsAPIError(err, "NO_STATEMENT_EXIST"))
+}
+
+type recordingLimiter struct {
+	keys []ratelimit.Key
+	err  error
+}
+
+func (l *recordingLimiter) Wait(_ context.Context, key ratelimit.Key) error {
+	l.keys = append(l.keys, key)
+	return l.err
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	limiter := &recordingLimiter{}
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithRateLimiter(limiter),
+	}
+	client, err := core.NewClient(ctx, opts...)
+	require.NoError(t, err)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	_, err = client.Post(ctx, ts.URL+"/v3/pay/transactions/out-trade-no/1217752501201407033233368018/close", map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, []ratelimit.Key{{
+		MchID:  testMchID,
+		Method: http.MethodPost,
+		Path:   "/v3/pay/transactions/out-trade-no/1217752501201407033233368018/close",
+	}}, limiter.keys)
+
+	// 复制的 Client 使用相同的限流器
+	limiter.err = ratelimit.ErrLimitExceeded
+	_, err = core.NewClientWithValidator(client, &validators.NullValidator{}).Get(ctx, ts.URL+"/v3/certificates?a=b")
+	assert.True(t, errors.Is(err, ratelimit.ErrLimitExceeded))
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, "/v3/certificates", limiter.keys[1].Path)
+}
+
+type recordingBreaker struct {
+	results []error
+	err     error
+}
+
+func (b *recordingBreaker) Allow(_ context.Context, _, _ string) (func(err error), error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return func(err error) { b.results = append(b.results, err) }, nil
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	breaker := &recordingBreaker{}
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+		option.WithCircuitBreaker(breaker),
+	}
+	client, err := core.NewClient(ctx, opts...)
+	require.NoError(t, err)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/v3/unsigned" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("Request-Id", "0")
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"code":"SYSTEM_ERROR","message":"system error"}`)
+	}))
+	defer ts.Close()
+
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	assert.True(t, core.IsAPIError(err, "SYSTEM_ERROR"))
+	require.Len(t, breaker.results, 1)
+	assert.True(t, core.IsAPIError(breaker.results[0], "SYSTEM_ERROR"))
+
+	// 应答验签失败不计入请求结果
+	_, err = client.Get(ctx, ts.URL+"/v3/unsigned")
+	assert.Error(t, err)
+	require.Len(t, breaker.results, 2)
+	assert.NoError(t, breaker.results[1])
+
+	// 熔断器拒绝时不发送请求
+	breaker.err = errors.New("circuit breaker is open")
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	assert.Error(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestClient_DefaultTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delay, _ := time.ParseDuration(r.URL.Query().Get("delay"))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "part1,")
+		w.(http.Flusher).Flush()
+		time.Sleep(delay)
+		fmt.Fprint(w, "part2")
+	}))
+	defer ts.Close()
+
+	newClient := func(opts ...core.ClientOption) *core.Client {
+		opts = append(opts,
+			option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+			option.WithoutValidator(),
+		)
+		client, err := core.NewClient(ctx, opts...)
+		require.NoError(t, err)
+		return client
+	}
+	download := func(ctx context.Context, client *core.Client, delay time.Duration) (string, error) {
+		result, err := client.Download(ctx, ts.URL+"/v3/billdownload/file?delay="+delay.String())
+		if err != nil {
+			return "", err
+		}
+		defer result.Response.Body.Close()
+		body, err := ioutil.ReadAll(result.Response.Body)
+		return string(body), err
+	}
+
+	client := newClient(option.WithDefaultTimeout(100 * time.Millisecond))
+
+	// 默认超时时间包括读取应答包体的时间
+	_, err := download(ctx, client, 300*time.Millisecond)
+	assert.Error(t, err)
+
+	body, err := download(ctx, client, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "part1,part2", body)
+
+	// 设置了截止时间的请求以 ctx 为准，不受默认超时时间的限制
+	longCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	body, err = download(longCtx, client, 300*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, "part1,part2", body)
+
+	shortCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = download(shortCtx, newClient(option.WithDefaultTimeout(0)), 300*time.Millisecond)
+	assert.Error(t, err)
+
+	// 不设置默认超时
+	body, err = download(ctx, newClient(option.WithDefaultTimeout(0)), 300*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, "part1,part2", body)
+}
+
+func TestClient_HeaderOptions(t *testing.T) {
+	var header http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithAcceptLanguage(consts.LanguageEn),
+		option.WithUserAgentSuffix("MyShop/1.0"),
+	}
+	client, err := core.NewClient(ctx, opts...)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	assert.Equal(t, consts.LanguageEn, header.Get(consts.AcceptLanguage))
+	assert.True(t, strings.HasPrefix(header.Get(consts.UserAgent), "WechatPay-Go/"+consts.Version+" "))
+	assert.True(t, strings.HasSuffix(header.Get(consts.UserAgent), " MyShop/1.0"))
+
+	// 单个请求可以设置其他语言
+	_, err = client.Request(ctx, http.MethodGet, ts.URL+"/v3/certificates",
+		http.Header{consts.AcceptLanguage: []string{consts.LanguageZhCN}}, nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{consts.LanguageZhCN}, header[consts.AcceptLanguage])
+
+	// 未设置时不发送 Accept-Language
+	client, err = core.NewClient(ctx, opts[:2]...)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	assert.Empty(t, header.Get(consts.AcceptLanguage))
+	assert.False(t, strings.Contains(header.Get(consts.UserAgent), "MyShop"))
+
+	_, err = core.NewClient(ctx, append(opts[:2], option.WithUserAgentSuffix("MyShop\r\nX-Injected: 1"))...)
+	assert.Error(t, err)
+}
+
+func TestClient_Gzip(t *testing.T) {
+	const requestURI = "/v3/applyment4sub/applyment/"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(bytes.NewReader(body))
+			require.NoError(t, err)
+			body, err = ioutil.ReadAll(zr)
+			require.NoError(t, err)
+		}
+		// 请求签名使用未压缩的包体计算
+		schema, params := parseAuthorization(t, r.Header.Get("Authorization"))
+		assertAuthorization(t, schema, r.Method, requestURI, params, body)
+
+		// 应答签名使用未压缩的包体计算
+		writeSignature(w, responseBody)
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, responseBody)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		zw := gzip.NewWriter(w)
+		_, _ = io.WriteString(zw, responseBody)
+		_ = zw.Close()
+	}))
+	defer ts.Close()
+
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+		option.WithHTTPClient(&http.Client{Transport: &http.Transport{DisableCompression: true}}),
+		option.WithGzipResponse(),
+		option.WithGzipRequest(16),
+	}
+	client, err := core.NewClient(ctx, opts...)
+	require.NoError(t, err)
+
+	var compressed []bool
+	for _, reqBody := range []interface{}{
+		map[string]string{"a": "b"},
+		map[string]string{"business_code": strings.Repeat("x", 64)},
+	} {
+		result, err := client.Post(ctx, ts.URL+requestURI, reqBody)
+		require.NoError(t, err)
+		compressed = append(compressed, result.Request.Header.Get("Content-Encoding") == "gzip")
+
+		body, err := ioutil.ReadAll(result.Response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, responseBody, string(body))
+		assert.Empty(t, result.Response.Header.Get("Content-Encoding"))
+	}
+	assert.Equal(t, []bool{false, true}, compressed)
+
+	// 未声明 Accept-Encoding 时应答不压缩
+	client, err = core.NewClient(ctx, opts[:3]...)
+	require.NoError(t, err)
+	result, err := client.Post(ctx, ts.URL+requestURI, map[string]string{"a": "b"})
+	require.NoError(t, err)
+	assert.Empty(t, result.Request.Header.Get("Accept-Encoding"))
+}
+
+func TestClient_RequestProgress(t *testing.T) {
+	var received int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received = len(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+	)
+	require.NoError(t, err)
+
+	var sent, total int64
+	calls := 0
+	progressCtx := core.WithRequestProgress(ctx, func(s, t int64) {
+		calls++
+		sent, total = s, t
+	})
+	body := strings.Repeat("x", 256*1024)
+	_, err = client.Upload(progressCtx, ts.URL+"/v3/merchant/media/video_upload", "{}", body, "multipart/form-data")
+	require.NoError(t, err)
+	assert.Equal(t, len(body), received)
+	assert.Equal(t, int64(len(body)), sent)
+	assert.Equal(t, int64(len(body)), total)
+	assert.True(t, calls > 0)
+
+	// 未设置回调或请求无包体时不回调
+	calls = 0
+	_, err = client.Get(progressCtx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	assert.Equal(t, 0, calls)
+}
+
+func TestClient_IdempotencyKey(t *testing.T) {
+	var keys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(consts.IdempotencyKey))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+	)
+	require.NoError(t, err)
+
+	requestURL := ts.URL + "/v3/marketing/partnerships/build"
+
+	// 未设置时不发送
+	_, err = client.Post(ctx, requestURL, map[string]string{})
+	require.NoError(t, err)
+
+	// 自动生成的幂等值在同一 ctx 的请求间复用
+	autoCtx := core.WithIdempotencyKey(ctx, "")
+	assert.Empty(t, core.IdempotencyKey(autoCtx))
+	_, err = client.Post(autoCtx, requestURL, map[string]string{})
+	require.NoError(t, err)
+	_, err = client.Post(autoCtx, requestURL, map[string]string{})
+	require.NoError(t, err)
+	generated := core.IdempotencyKey(autoCtx)
+	assert.Len(t, generated, 32)
+
+	// 指定的幂等值，请求参数中的幂等值优先
+	keyCtx := core.WithIdempotencyKey(ctx, "12345")
+	_, err = client.Post(keyCtx, requestURL, map[string]string{})
+	require.NoError(t, err)
+	_, err = client.Request(keyCtx, http.MethodPost, requestURL,
+		http.Header{consts.IdempotencyKey: []string{"67890"}}, nil, map[string]string{}, consts.ApplicationJSON)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", generated, generated, "12345", "67890"}, keys)
+}
+
+type recordTransport struct {
+	urls []string
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.urls = append(t.urls, req.URL.String())
+	return &http.Response{
+		StatusCode: http.StatusNoContent,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_APIServer(t *testing.T) {
+	transport := &recordTransport{}
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	}
+	client, err := core.NewClient(ctx, append(opts, option.WithGlobal())...)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, consts.WechatPayAPIServer+"/v3/global/transactions/id/1217752501201407033233368018?mchid=1")
+	require.NoError(t, err)
+	_, err = client.Request(ctx, http.MethodGet, consts.WechatPayAPIServer+"/v3/certificates", nil, nil, nil, "")
+	require.NoError(t, err)
+	// 其他地址（如账单下载地址）不改写
+	_, err = client.Download(ctx, "https://api.mch.weixin.qq.com.example.com/v3/billdownload/file?token=1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		consts.WechatPayGlobalAPIServer + "/v3/global/transactions/id/1217752501201407033233368018?mchid=1",
+		consts.WechatPayGlobalAPIServer + "/v3/certificates",
+		"https://api.mch.weixin.qq.com.example.com/v3/billdownload/file?token=1",
+	}, transport.urls)
+
+	// 末尾的 / 被忽略
+	transport.urls = nil
+	client, err = core.NewClient(ctx, append(opts, option.WithAPIServer(consts.WechatPayAPIServerBackup+"/"))...)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, consts.WechatPayAPIServer+"/v3/certificates")
+	require.NoError(t, err)
+	assert.Equal(t, []string{consts.WechatPayAPIServerBackup + "/v3/certificates"}, transport.urls)
+
+	for _, server := range []string{"apihk.mch.weixin.qq.com", "https://apihk.mch.weixin.qq.com/v3", "ftp://apihk.mch.weixin.qq.com"} {
+		_, err = core.NewClient(ctx, append(opts, option.WithAPIServer(server))...)
+		assert.Error(t, err, server)
+	}
+}
+
+func TestClient_AlternateValidator(t *testing.T) {
+	const simulator = "http://wxpay-simulator:8080"
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate(nil),
+		option.WithAlternateValidator(simulator, &validators.NullValidator{}),
+		option.WithHTTPClient(&http.Client{Transport: &recordTransport{}}),
+	)
+	require.NoError(t, err)
+
+	// 发往模拟器的请求使用模拟器的验证器
+	_, err = client.Get(ctx, simulator+"/v3/certificates")
+	assert.NoError(t, err)
+	// 其他请求仍使用平台证书验签，未签名的应答验签失败
+	_, err = client.Get(ctx, consts.WechatPayAPIServer+"/v3/certificates")
+	assert.Error(t, err)
+
+	_, err = core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithAlternateValidator("wxpay-simulator:8080", &validators.NullValidator{}),
+	)
+	assert.Error(t, err)
+}
+
+type schemeCredential string
+
+func (c schemeCredential) GenerateAuthorizationHeader(context.Context, string, string, string) (string, error) {
+	return string(c) + " mchid=\"" + testMchID + "\"", nil
+}
+
+func (c schemeCredential) Scheme() string {
+	return string(c)
+}
+
+func TestClient_AuthScheme(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get(consts.Authorization)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	const sm2 = "WECHATPAY2-SM2-WITH-SM3"
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithCredential(schemeCredential(sm2)),
+	}
+	client, err := core.NewClient(ctx, opts...)
+	require.NoError(t, err)
+
+	// 默认使用 Signer 对应的认证类型
+	_, err = client.Get(ctx, ts.URL)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(authorization, "WECHATPAY2-SHA256-RSA2048 "), authorization)
+
+	_, err = client.Get(auth.WithScheme(ctx, sm2), ts.URL)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(authorization, sm2+" "), authorization)
+
+	client, err = core.NewClient(ctx, append(opts, option.WithAuthScheme(sm2))...)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, ts.URL)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(authorization, sm2+" "), authorization)
+
+	_, err = client.Get(auth.WithScheme(ctx, "WECHATPAY2-UNKNOWN"), ts.URL)
+	assert.Error(t, err)
+
+	_, err = core.NewClient(ctx, append(opts, option.WithAuthScheme("WECHATPAY2-UNKNOWN"))...)
+	assert.Error(t, err)
+	_, err = core.NewClient(ctx, append(opts, option.WithCredential(schemeCredential(sm2)))...)
+	assert.Error(t, err)
+}
+
+func TestClient_SignatureCache(t *testing.T) {
+	var authorizations []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get(consts.Authorization))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+	}
+	client, err := core.NewClient(ctx, append(opts, option.WithSignatureCache(10*time.Second))...)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+		require.NoError(t, err)
+	}
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates?page=2")
+	require.NoError(t, err)
+	require.Len(t, authorizations, 3)
+	assert.Equal(t, authorizations[0], authorizations[1])
+	assert.NotEqual(t, authorizations[0], authorizations[2])
+
+	_, err = core.NewClient(ctx, append(opts, option.WithSignatureCache(10*time.Minute))...)
+	assert.Error(t, err)
+}
+
+func TestClient_SignConcurrency(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get(consts.Authorization), "WECHATPAY2-SHA256-RSA2048 "))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	opts := []core.ClientOption{
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+	}
+	client, err := core.NewClient(ctx, append(opts, option.WithSignConcurrency(2))...)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, ts.URL)
+	assert.NoError(t, err)
+	_, err = client.Sign(ctx, "source")
+	assert.NoError(t, err)
+
+	_, err = core.NewClient(ctx, append(opts, option.WithSignConcurrency(-1))...)
+	assert.Error(t, err)
+}
+
+func TestClient_Clock(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get(consts.Authorization)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	frozen := time.Unix(1624523846, 0)
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithClock(auth.ClockFunc(func() time.Time { return frozen })),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	_, params := parseAuthorization(t, authorization)
+	assert.Equal(t, "1624523846", params["timestamp"])
+
+	// 通过 ctx 设置的时钟优先
+	_, err = client.Get(auth.WithClock(ctx, auth.OffsetClock(auth.ClockFunc(func() time.Time { return frozen }), time.Minute)),
+		ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	_, params = parseAuthorization(t, authorization)
+	assert.Equal(t, "1624523906", params["timestamp"])
+
+	// 运行时调整偏差的时钟
+	adjustable := auth.NewAdjustableClock(auth.ClockFunc(func() time.Time { return frozen }))
+	client, err = core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithClock(adjustable),
+	)
+	require.NoError(t, err)
+	adjustable.SetOffset(-time.Minute)
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	_, params = parseAuthorization(t, authorization)
+	assert.Equal(t, "1624523786", params["timestamp"])
+	assert.Equal(t, frozen.Add(-time.Minute), client.Now(ctx))
+	assert.Equal(t, frozen, client.Now(auth.WithClock(ctx, auth.ClockFunc(func() time.Time { return frozen }))))
+}
+
+func TestClient_NonceGenerator(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get(consts.Authorization)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	const fixedNonce = "0123456789abcdef0123456789abcdef"
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithNonceGenerator(auth.NonceGeneratorFunc(func() (string, error) { return fixedNonce, nil })),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	_, params := parseAuthorization(t, authorization)
+	assert.Equal(t, fixedNonce, params["nonce_str"])
+
+	nonce, err := client.GenerateNonce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, fixedNonce, nonce)
+
+	// 通过 ctx 设置的生成器优先，生成的随机串不合法时请求失败
+	invalid := auth.WithNonceGenerator(ctx, auth.NonceGeneratorFunc(func() (string, error) { return "nonce", nil }))
+	_, err = client.Get(invalid, ts.URL+"/v3/certificates")
+	assert.Error(t, err)
+	_, err = client.GenerateNonce(invalid)
+	assert.Error(t, err)
+}
+
+func TestClient_SignRecorder(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get(consts.Authorization)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var records []*auth.SignRecord
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithSignRecorder(func(ctx context.Context, record *auth.SignRecord) {
+			records = append(records, record)
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Post(ctx, ts.URL+"/v3/marketing/partnerships/build?x=1", map[string]string{"a": "b"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	_, params := parseAuthorization(t, authorization)
+	assert.Equal(t, fmt.Sprintf("POST\n/v3/marketing/partnerships/build?x=1\n%s\n%s\n{\"a\":\"b\"}\n\n",
+		params["timestamp"], params["nonce_str"]), records[0].Message)
+	assert.Equal(t, params["signature"], records[0].Result.Signature)
+
+	// 通过 ctx 设置的回调优先
+	var recorded bool
+	_, err = client.Get(auth.WithSignRecorder(ctx, func(ctx context.Context, record *auth.SignRecord) {
+		recorded = true
+	}), ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	assert.Len(t, records, 1)
+}
+
+func TestClient_ValidationMetrics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := `{"a":"b"}`
+		writeSignature(w, body)
+		if r.URL.Path == "/v3/expired" {
+			w.Header().Set("Wechatpay-Timestamp", strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10))
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	counts := make(map[auth.ValidationOutcome]int)
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+		option.WithValidationMetrics(auth.ValidationMetricsFunc(
+			func(ctx context.Context, target string, outcome auth.ValidationOutcome) {
+				assert.Equal(t, auth.ValidationTargetResponse, target)
+				counts[outcome]++
+			},
+		)),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, ts.URL+"/v3/ok")
+	require.NoError(t, err)
+	_, err = client.Get(ctx, ts.URL+"/v3/expired")
+	require.Error(t, err)
+	assert.Equal(t, map[auth.ValidationOutcome]int{
+		auth.ValidationSuccess:          1,
+		auth.ValidationTimestampExpired: 1,
+	}, counts)
+}
+
+type requestInfoTransport struct {
+	info core.RequestInfo
+}
+
+func (t *requestInfoTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if info, ok := core.RequestInfoFromContext(req.Context()); ok {
+		t.info = *info
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_RequestInfo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(consts.RequestID, "08F78BCA9C1A0C1E-"+r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	transport := &requestInfoTransport{}
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	require.NoError(t, err)
+
+	infoCtx, info := core.WithRequestInfo(ctx)
+	_, err = client.Get(infoCtx, ts.URL+"/v3/certificates?x=1")
+	require.NoError(t, err)
+	assert.Equal(t, core.RequestInfo{
+		MchID: testMchID, Method: http.MethodGet, Path: "/v3/certificates", RequestID: "08F78BCA9C1A0C1E-GET",
+	}, *info)
+	assert.Equal(t, core.RequestInfo{MchID: testMchID, Method: http.MethodGet, Path: "/v3/certificates"}, transport.info)
+	assert.Equal(t, "mchid=example-mchid method=GET path=/v3/certificates request_id=08F78BCA9C1A0C1E-GET", info.String())
+
+	// 最近一次请求的信息
+	_, err = client.Post(infoCtx, ts.URL+"/v3/marketing/partnerships/build", map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/marketing/partnerships/build", info.Path)
+	assert.Equal(t, "08F78BCA9C1A0C1E-POST", info.RequestID)
+
+	// 未使用 WithRequestInfo 时同样为请求设置
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/certificates", transport.info.Path)
+	_, ok := core.RequestInfoFromContext(ctx)
+	assert.False(t, ok)
+
+	// AttachRequestInfo 设置的信息（如回调通知的信息）不被发出的请求修改
+	notifyInfo := core.RequestInfo{Method: http.MethodPost, Path: "/notify", RequestID: "NOTIFY-REQUEST-ID"}
+	notifyCtx := core.AttachRequestInfo(ctx, notifyInfo)
+	_, err = client.Get(notifyCtx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	assert.Equal(t, core.RequestInfo{MchID: testMchID, Method: http.MethodGet, Path: "/v3/certificates"}, transport.info)
+	inherited, ok := core.RequestInfoFromContext(notifyCtx)
+	require.True(t, ok)
+	assert.Equal(t, notifyInfo, *inherited)
+
+	// 发出请求时使用新的 RequestInfo，WithRequestInfo 返回的 RequestInfo 在请求结束后才更新
+	infoCtx, info = core.WithRequestInfo(notifyCtx)
+	client, err = core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			current, _ := core.RequestInfoFromContext(req.Context())
+			assert.NotSame(t, info, current)
+			assert.Equal(t, core.RequestInfo{}, *info)
+			return http.DefaultTransport.RoundTrip(req)
+		})}),
+	)
+	require.NoError(t, err)
+	_, err = client.Get(infoCtx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	assert.Equal(t, "08F78BCA9C1A0C1E-GET", info.RequestID)
+	assert.Equal(t, notifyInfo, *inherited)
+}
+
+func TestClient_Hedging(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/v3/pay/transactions/out-trade-no/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":"ORDER_NOT_EXIST","message":"订单不存在"}`)
+			return
+		}
+		if n == 1 && strings.HasPrefix(r.URL.Path, "/v3/pay/") {
+			// 第一次查询订单的请求响应缓慢
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+		w.Header().Set(consts.RequestID, fmt.Sprintf("request-%d", n))
+		w.Header().Set(consts.ContentType, consts.ApplicationJSON)
+		fmt.Fprintf(w, `{"attempt":%d}`, n)
+	}))
+	defer ts.Close()
+
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHedging(50*time.Millisecond, "/v3/pay/transactions/"),
+	)
+	require.NoError(t, err)
+
+	infoCtx, info := core.WithRequestInfo(ctx)
+	start := time.Now()
+	result, err := client.Get(infoCtx, ts.URL+"/v3/pay/transactions/out-trade-no/1217752501201407033233368018")
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(result.Response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"attempt":2}`, string(body))
+	assert.Less(t, int64(time.Since(start)), int64(500*time.Millisecond))
+	assert.Equal(t, "request-2", info.RequestID)
+	assert.Equal(t, "/v3/pay/transactions/out-trade-no/1217752501201407033233368018", info.Path)
+
+	// 失败应答在对冲前返回时不再发出对冲请求
+	atomic.StoreInt32(&requests, 0)
+	_, err = client.Get(ctx, ts.URL+"/v3/pay/transactions/out-trade-no/missing")
+	assert.True(t, core.IsAPIError(err, "ORDER_NOT_EXIST"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// 未匹配路径前缀的请求不发出对冲请求
+	atomic.StoreInt32(&requests, 0)
+	result, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	require.NoError(t, err)
+	body, err = ioutil.ReadAll(result.Response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"attempt":1}`, string(body))
+
+	_, err = core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHedging(50*time.Millisecond, "v3/certificates"),
+	)
+	assert.Error(t, err)
+}
+
+func TestClient_HedgingReleasesFailedAttempt(t *testing.T) {
+	var requests int32
+	firstCtx := make(chan context.Context, 1)
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set(consts.ContentType, consts.ApplicationJSON)
+		if atomic.AddInt32(&requests, 1) == 1 {
+			// 第一次尝试在发出对冲请求后失败
+			firstCtx <- req.Context()
+			time.Sleep(80 * time.Millisecond)
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Header:     header,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"code":"SYSTEM_ERROR","message":"系统错误"}`)),
+				Request:    req,
+			}, nil
+		}
+		time.Sleep(100 * time.Millisecond)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"attempt":2}`)),
+			Request:    req,
+		}, nil
+	})
+
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+		option.WithHedging(50*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	result, err := client.Get(ctx, "https://api.mch.weixin.qq.com/v3/pay/transactions/id/4200000001")
+	require.NoError(t, err)
+	defer result.Response.Body.Close()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// 采用对冲请求的结果时，已失败的第一次尝试的 Context 随即被取消
+	first := <-firstCtx
+	assert.Error(t, first.Err())
+
+	body, err := ioutil.ReadAll(result.Response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"attempt":2}`, string(body))
+}
+
+func TestClient_Resolver(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "wechatpay.test", strings.Split(r.Host, ":")[0])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	port := ts.URL[strings.LastIndex(ts.URL, ":")+1:]
+
+	r, err := resolver.NewCachingResolver(nil, resolver.WithStaticHost("wechatpay.test", "127.0.0.1"))
+	require.NoError(t, err)
+
+	httpClient := &http.Client{Transport: &http.Transport{}}
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(httpClient),
+		option.WithResolver(r),
+	)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, "http://wechatpay.test:"+port+"/v3/certificates")
+	require.NoError(t, err)
+	// 原 HTTPClient 不受影响
+	assert.Nil(t, httpClient.Transport.(*http.Transport).DialContext)
+
+	_, err = core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: &requestInfoTransport{}}),
+		option.WithResolver(r),
+	)
+	assert.Error(t, err)
+}
+
+func TestClient_NormalizeQuery(t *testing.T) {
+	var requestURI string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURI = r.RequestURI
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var signMessage string
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithSignRecorder(func(_ context.Context, record *auth.SignRecord) { signMessage = record.Message }),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, ts.URL+"/v3/resource?store_name=腾讯 大厦&sign=a%2Bb")
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/resource?store_name=%E8%85%BE%E8%AE%AF%20%E5%A4%A7%E5%8E%A6&sign=a%2Bb", requestURI)
+	assert.True(t, strings.HasPrefix(signMessage, "GET\n"+requestURI+"\n"))
+}
+
+func TestClient_WechatPaySerial(t *testing.T) {
+	var serials []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serials = append(serials, r.Header.Get(consts.WechatPaySerial))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	encryptor := &encryptors.MockEncryptor{Serial: "PUB_KEY_ID_0114232134912410000000000000"}
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithWechatPayCipher(encryptor, &decryptors.MockDecryptor{}),
+	)
+	require.NoError(t, err)
+
+	type receiver struct {
+		Name *string `json:"name" encryption:"EM_APIV3"`
+	}
+	requestURL := ts.URL + "/v3/profitsharing/receivers/add"
+
+	// 未设置时不自动设置请求头
+	req := receiver{Name: core.String("张三")}
+	_, err = client.EncryptRequest(ctx, &req)
+	require.NoError(t, err)
+	_, err = client.Post(ctx, requestURL, req)
+	require.NoError(t, err)
+
+	serialCtx := core.WithWechatPaySerial(ctx)
+	assert.Empty(t, core.WechatPaySerial(serialCtx))
+	req = receiver{Name: core.String("张三")}
+	serial, err := client.EncryptRequest(serialCtx, &req)
+	require.NoError(t, err)
+	assert.Equal(t, serial, core.WechatPaySerial(serialCtx))
+	_, err = client.Post(serialCtx, requestURL, req)
+	require.NoError(t, err)
+
+	// 请求参数中一致的序列号可以发送，不一致时返回错误
+	_, err = client.Request(serialCtx, http.MethodPost, requestURL,
+		http.Header{consts.WechatPaySerial: []string{serial}}, nil, req, consts.ApplicationJSON)
+	require.NoError(t, err)
+	_, err = client.Request(serialCtx, http.MethodPost, requestURL,
+		http.Header{consts.WechatPaySerial: []string{"5157F09EFDC096DE15EBE81A47057A7232F1B8E1"}}, nil, req,
+		consts.ApplicationJSON)
+	assert.Error(t, err)
+
+	// 同一 ctx 加密时使用了不同的序列号
+	encryptor.Serial = "5157F09EFDC096DE15EBE81A47057A7232F1B8E1"
+	req = receiver{Name: core.String("李四")}
+	_, err = client.EncryptRequest(serialCtx, &req)
+	require.NoError(t, err)
+	_, err = client.Post(serialCtx, requestURL, req)
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"", serial, serial}, serials)
+}
+
+func TestClient_AuditSink(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(consts.RequestID, "08F78BCA9C1A0C1E")
+		if r.URL.Path == "/v3/error" {
+			w.Header().Set(consts.ContentType, consts.ApplicationJSON)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"PARAM_ERROR","message":"参数错误"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var records []*core.AuditRecord
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithAuditSink(core.AuditSinkFunc(func(_ context.Context, record *core.AuditRecord) {
+			records = append(records, record)
+		})),
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Get(ctx, ts.URL+"/v3/resource?offset=0")
+	require.NoError(t, err)
+	_, err = client.Post(ctx, ts.URL+"/v3/error", map[string]string{"a": "b"})
+	require.Error(t, err)
+	_, err = client.Get(ctx, "http://127.0.0.1:0/v3/unreachable")
+	require.Error(t, err)
+
+	require.Len(t, records, 3)
+	assert.False(t, records[0].Time.Before(start))
+	assert.Equal(t, testMchID, records[0].MchID)
+	assert.Equal(t, http.MethodGet, records[0].Method)
+	assert.Equal(t, "/v3/resource", records[0].Path)
+	assert.Equal(t, "08F78BCA9C1A0C1E", records[0].RequestID)
+	assert.Equal(t, http.StatusNoContent, records[0].StatusCode)
+	assert.Empty(t, records[0].Code)
+	assert.NoError(t, records[0].Err)
+	assert.True(t, records[0].Latency > 0)
+
+	assert.Equal(t, http.MethodPost, records[1].Method)
+	assert.Equal(t, http.StatusBadRequest, records[1].StatusCode)
+	assert.Equal(t, "PARAM_ERROR", records[1].Code)
+	assert.NoError(t, records[1].Err)
+
+	assert.Equal(t, 0, records[2].StatusCode)
+	assert.Error(t, records[2].Err)
+	assert.Empty(t, records[2].RequestID)
+}
+
+func TestClient_DryRun(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var audited int
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHedging(time.Millisecond),
+		option.WithAuditSink(core.AuditSinkFunc(func(context.Context, *core.AuditRecord) { audited++ })),
+	)
+	require.NoError(t, err)
+
+	dryRunCtx, dryRun := core.WithDryRun(ctx)
+	assert.Nil(t, dryRun.Request())
+	result, err := client.Post(dryRunCtx, ts.URL+"/v3/pay/transactions/jsapi?a=b", map[string]string{"appid": "wx"})
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, core.ErrDryRun))
+
+	request := dryRun.Request()
+	require.NotNil(t, request)
+	assert.Equal(t, http.MethodPost, request.Method)
+	assert.Equal(t, "/v3/pay/transactions/jsapi?a=b", request.URL.RequestURI())
+	assert.True(t, strings.HasPrefix(request.Header.Get(consts.Authorization), "WECHATPAY2-SHA256-RSA2048 "))
+	assert.NoError(t, request.Context().Err())
+	body, err := request.GetBody()
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"appid":"wx"}`, string(content))
+
+	// 请求参数校验失败时不生成请求
+	_, err = client.Post(dryRunCtx, ts.URL+"/v3/pay/transactions/jsapi", func() {})
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, core.ErrDryRun))
+
+	// 不对 GET 请求发出对冲请求
+	_, err = client.Get(dryRunCtx, ts.URL+"/v3/pay/transactions/id/1")
+	assert.True(t, errors.Is(err, core.ErrDryRun))
+	assert.Equal(t, http.MethodGet, dryRun.Request().Method)
+
+	// 已签名的请求可以直接发送
+	resp, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	assert.Equal(t, 0, audited)
+
+	// Client 级别的预演模式
+	client, err = core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithDryRun(),
+	)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, ts.URL+"/v3/certificates")
+	assert.True(t, errors.Is(err, core.ErrDryRun))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
@@ -0,0 +1,273 @@
+// Package config 微信支付 API v3 Go SDK 启动配置，可以从 JSON/YAML 文件或环境变量加载商户配置并初始化 core.Client
+package config
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// DefaultEnvPrefix FromEnv 默认使用的环境变量前缀
+const DefaultEnvPrefix = "WECHATPAY_"
+
+const apiV3KeyLength = 32
+
+// Config 初始化 Client 所需的商户配置
+//
+// 根据配置的内容，Client 使用以下方式之一验证应答签名与加密敏感字段：
+//   - 设置了 public_key_id 时，使用微信支付公钥
+//   - 设置了 platform_certificate_paths 时，使用本地的平台证书
+//   - 否则使用 apiv3_key 自动下载并定时更新平台证书
+//
+// 每个字段对应的环境变量为前缀加上大写的字段名，如 WECHATPAY_MCH_ID、WECHATPAY_APIV3_KEY
+type Config struct {
+	// MchID 商户号
+	MchID string `json:"mch_id" yaml:"mch_id"`
+	// MchCertificateSerialNo 商户 API 证书序列号
+	MchCertificateSerialNo string `json:"mch_certificate_serial_no" yaml:"mch_certificate_serial_no"`
+	// PrivateKeyPath 商户 API 私钥文件路径，与 PrivateKey 二选一
+	PrivateKeyPath string `json:"private_key_path" yaml:"private_key_path"`
+	// PrivateKey PEM 格式的商户 API 私钥，与 PrivateKeyPath 二选一
+	PrivateKey string `json:"private_key" yaml:"private_key"`
+	// APIv3Key 商户 APIv3 密钥，未使用微信支付公钥或本地平台证书时必填
+	APIv3Key string `json:"apiv3_key" yaml:"apiv3_key"`
+
+	// PublicKeyID 微信支付公钥ID，设置后使用微信支付公钥模式
+	PublicKeyID string `json:"public_key_id" yaml:"public_key_id"`
+	// PublicKeyPath 微信支付公钥文件路径，与 PublicKey 二选一
+	PublicKeyPath string `json:"public_key_path" yaml:"public_key_path"`
+	// PublicKey PEM 格式的微信支付公钥，与 PublicKeyPath 二选一
+	PublicKey string `json:"public_key" yaml:"public_key"`
+	// PlatformCertificatePaths 本地平台证书文件路径，环境变量中使用逗号分隔
+	PlatformCertificatePaths []string `json:"platform_certificate_paths" yaml:"platform_certificate_paths"`
+
+	// Timeout 请求默认超时时间，如 10s，使用 time.ParseDuration 解析。为空时为 consts.DefaultTimeout，为 0 时不设置默认超时
+	Timeout string `json:"timeout" yaml:"timeout"`
+	// APIServer API 地址，为空时为 consts.WechatPayAPIServer
+	APIServer string `json:"api_server" yaml:"api_server"`
+}
+
+// FieldError 配置字段有误，Field 为 JSON/YAML 中的字段名
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("config %s %s", e.Field, e.Message)
+}
+
+func required(field string) error {
+	return &FieldError{Field: field, Message: "is required"}
+}
+
+// UnmarshalFunc 配置文件的解析函数，与 json.Unmarshal 的签名一致，如 yaml.Unmarshal
+type UnmarshalFunc func(data []byte, v interface{}) error
+
+// LoadFile 读取并解析配置文件，unmarshal 为 nil 时按 JSON 解析
+//
+// SDK 不依赖 YAML 库，解析 YAML 文件时请传入所使用的 YAML 库的解析函数，如 gopkg.in/yaml.v3 的 yaml.Unmarshal。
+// 本函数不校验配置，请使用 Config.Validate 或 NewClient
+func LoadFile(path string, unmarshal UnmarshalFunc) (*Config, error) {
+	if unmarshal == nil {
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext == ".yaml" || ext == ".yml" {
+			return nil, fmt.Errorf("load config %s err:yaml config requires an UnmarshalFunc such as yaml.Unmarshal", path)
+		}
+		unmarshal = json.Unmarshal
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load config %s err:%v", path, err)
+	}
+	cfg := new(Config)
+	if err = unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parse config %s err:%v", path, err)
+	}
+	return cfg, nil
+}
+
+// FromEnv 从环境变量加载配置，prefix 为空时为 DefaultEnvPrefix
+func FromEnv(prefix string) *Config {
+	cfg := new(Config)
+	cfg.LoadEnv(prefix)
+	return cfg
+}
+
+// LoadEnv 使用已设置的环境变量覆盖配置中的对应字段，prefix 为空时为 DefaultEnvPrefix，
+// 可以在读取配置文件后使用环境变量传入私钥、APIv3 密钥等敏感配置
+//
+// private_key 与 public_key 中的 \n 将被替换为换行符，以便在单行的环境变量中传入 PEM
+func (c *Config) LoadEnv(prefix string) {
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Tag.Get("json")
+		value, ok := os.LookupEnv(prefix + strings.ToUpper(name))
+		if !ok {
+			continue
+		}
+		if name == "private_key" || name == "public_key" {
+			value = strings.Replace(value, `\n`, "\n", -1)
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.Slice {
+			var paths []string
+			for _, path := range strings.Split(value, ",") {
+				if path = strings.TrimSpace(path); path != "" {
+					paths = append(paths, path)
+				}
+			}
+			field.Set(reflect.ValueOf(paths))
+		} else {
+			field.SetString(value)
+		}
+	}
+}
+
+// Validate 校验配置是否完整，返回的错误为 *FieldError，指明第一个缺失或有误的字段。本方法不读取文件
+func (c *Config) Validate() error {
+	if c.MchID == "" {
+		return required("mch_id")
+	}
+	if c.MchCertificateSerialNo == "" {
+		return required("mch_certificate_serial_no")
+	}
+	if err := validateOneOf("private_key", c.PrivateKey, c.PrivateKeyPath); err != nil {
+		return err
+	}
+	if c.PublicKeyID != "" {
+		if err := validateOneOf("public_key", c.PublicKey, c.PublicKeyPath); err != nil {
+			return err
+		}
+	} else if c.PublicKey != "" || c.PublicKeyPath != "" {
+		return required("public_key_id")
+	} else if len(c.PlatformCertificatePaths) == 0 && c.APIv3Key == "" {
+		return required("apiv3_key")
+	}
+	if c.APIv3Key != "" && len(c.APIv3Key) != apiV3KeyLength {
+		return &FieldError{
+			Field: "apiv3_key", Message: fmt.Sprintf("must be %d bytes, got %d", apiV3KeyLength, len(c.APIv3Key)),
+		}
+	}
+	if c.Timeout != "" {
+		if _, err := time.ParseDuration(c.Timeout); err != nil {
+			return &FieldError{Field: "timeout", Message: fmt.Sprintf("is not a valid duration: %v", err)}
+		}
+	}
+	return nil
+}
+
+// validateOneOf 校验 field 与 field_path 有且仅有一个不为空
+func validateOneOf(field, value, path string) error {
+	if value == "" && path == "" {
+		return required(field + " or " + field + "_path")
+	}
+	if value != "" && path != "" {
+		return &FieldError{Field: field, Message: "conflicts with " + field + "_path"}
+	}
+	return nil
+}
+
+// ClientOptions 校验配置并加载密钥与证书，返回初始化 Client 所需的 ClientOption
+func (c *Config) ClientOptions() ([]core.ClientOption, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	privateKey, err := loadPrivateKey(c.PrivateKey, c.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []core.ClientOption
+	switch {
+	case c.PublicKeyID != "":
+		publicKey, err := loadPublicKey(c.PublicKey, c.PublicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, option.WithWechatPayPublicKeyAuthCipher(
+			c.MchID, c.MchCertificateSerialNo, privateKey, c.PublicKeyID, publicKey,
+		))
+	case len(c.PlatformCertificatePaths) > 0:
+		certificates := make([]*x509.Certificate, 0, len(c.PlatformCertificatePaths))
+		for _, path := range c.PlatformCertificatePaths {
+			certificate, err := utils.LoadCertificateWithPath(path)
+			if err != nil {
+				return nil, &FieldError{Field: "platform_certificate_paths", Message: err.Error()}
+			}
+			certificates = append(certificates, certificate)
+		}
+		opts = append(opts, option.WithWechatPayAuthCipher(
+			c.MchID, c.MchCertificateSerialNo, privateKey, certificates,
+		))
+	default:
+		opts = append(opts, option.WithWechatPayAutoAuthCipher(
+			c.MchID, c.MchCertificateSerialNo, privateKey, c.APIv3Key,
+		))
+	}
+
+	if c.Timeout != "" {
+		timeout, _ := time.ParseDuration(c.Timeout)
+		opts = append(opts, option.WithDefaultTimeout(timeout))
+	}
+	if c.APIServer != "" {
+		opts = append(opts, option.WithAPIServer(c.APIServer))
+	}
+	return opts, nil
+}
+
+// NewClient 使用配置初始化 Client，opts 在配置生成的 ClientOption 之后生效，可以覆盖配置中的设置
+//
+// 未使用微信支付公钥或本地平台证书时，本函数将注册平台证书下载器并下载平台证书，请确保网络可用
+func NewClient(ctx context.Context, cfg *Config, opts ...core.ClientOption) (*core.Client, error) {
+	cfgOpts, err := cfg.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	return core.NewClient(ctx, append(cfgOpts, opts...)...)
+}
+
+func loadPrivateKey(pem, path string) (*rsa.PrivateKey, error) {
+	if path != "" {
+		privateKey, err := utils.LoadPrivateKeyWithPath(path)
+		if err != nil {
+			return nil, &FieldError{Field: "private_key_path", Message: err.Error()}
+		}
+		return privateKey, nil
+	}
+	privateKey, err := utils.LoadPrivateKey(pem)
+	if err != nil {
+		return nil, &FieldError{Field: "private_key", Message: err.Error()}
+	}
+	return privateKey, nil
+}
+
+func loadPublicKey(pem, path string) (*rsa.PublicKey, error) {
+	if path != "" {
+		publicKey, err := utils.LoadPublicKeyWithPath(path)
+		if err != nil {
+			return nil, &FieldError{Field: "public_key_path", Message: err.Error()}
+		}
+		return publicKey, nil
+	}
+	publicKey, err := utils.LoadPublicKey(pem)
+	if err != nil {
+		return nil, &FieldError{Field: "public_key", Message: err.Error()}
+	}
+	return publicKey, nil
+}
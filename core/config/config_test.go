@@ -0,0 +1,284 @@
+package config
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const (
+	testMchID       = "1900009191"
+	testSerialNo    = "3775B6A45ACD588826D15E583A95F5DD********"
+	testAPIv3Key    = "a8Fq3xZ0pLmN7sT2vW9yB4cD6eG1hJ5k"
+	testPublicKeyID = "PUB_KEY_ID_0119000091912024110000000000"
+)
+
+var (
+	testPrivateKey     *rsa.PrivateKey
+	testPrivateKeyPEM  string
+	testPublicKeyPEM   string
+	testCertificatePEM string
+)
+
+func init() {
+	var err error
+	testPrivateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(testPrivateKey)
+	testPrivateKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	der, _ = x509.MarshalPKIXPublicKey(&testPrivateKey.PublicKey)
+	testPublicKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x5157F09EFDC096DE),
+		Subject:      pkix.Name{CommonName: "Tenpay.com Root CA"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err = x509.CreateCertificate(rand.Reader, template, template, &testPrivateKey.PublicKey, testPrivateKey)
+	if err != nil {
+		panic(err)
+	}
+	testCertificatePEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "wechatpay-config")
+	require.NoError(t, err)
+	return dir
+}
+
+func validConfig() *Config {
+	return &Config{
+		MchID:                  testMchID,
+		MchCertificateSerialNo: testSerialNo,
+		PrivateKey:             testPrivateKeyPEM,
+		APIv3Key:               testAPIv3Key,
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		field  string
+	}{
+		{name: "valid", modify: func(c *Config) {}},
+		{name: "missing mch_id", modify: func(c *Config) { c.MchID = "" }, field: "mch_id"},
+		{
+			name:   "missing serial",
+			modify: func(c *Config) { c.MchCertificateSerialNo = "" },
+			field:  "mch_certificate_serial_no",
+		},
+		{name: "missing private key", modify: func(c *Config) { c.PrivateKey = "" }, field: "private_key or private_key_path"},
+		{name: "both private keys", modify: func(c *Config) { c.PrivateKeyPath = "key.pem" }, field: "private_key"},
+		{name: "missing apiv3 key", modify: func(c *Config) { c.APIv3Key = "" }, field: "apiv3_key"},
+		{name: "short apiv3 key", modify: func(c *Config) { c.APIv3Key = "short" }, field: "apiv3_key"},
+		{
+			name:   "platform certificates without apiv3 key",
+			modify: func(c *Config) { c.APIv3Key, c.PlatformCertificatePaths = "", []string{"cert.pem"} },
+		},
+		{
+			name:   "public key without apiv3 key",
+			modify: func(c *Config) { c.APIv3Key, c.PublicKeyID, c.PublicKeyPath = "", testPublicKeyID, "pub.pem" },
+		},
+		{
+			name:   "public key id without public key",
+			modify: func(c *Config) { c.PublicKeyID = testPublicKeyID },
+			field:  "public_key or public_key_path",
+		},
+		{name: "public key without id", modify: func(c *Config) { c.PublicKey = testPublicKeyPEM }, field: "public_key_id"},
+		{name: "valid timeout", modify: func(c *Config) { c.Timeout = "10s" }},
+		{name: "invalid timeout", modify: func(c *Config) { c.Timeout = "10" }, field: "timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var fieldErr *FieldError
+			require.True(t, errors.As(err, &fieldErr), "err: %v", err)
+			assert.Equal(t, tt.field, fieldErr.Field)
+			assert.Contains(t, err.Error(), tt.field)
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := writeFile(t, dir, "wechatpay.json", `{
+		"mch_id": "1900009191",
+		"mch_certificate_serial_no": "3775B6A45ACD588826D15E583A95F5DD********",
+		"private_key_path": "/etc/wechatpay/apiclient_key.pem",
+		"platform_certificate_paths": ["/etc/wechatpay/wechatpay.pem"],
+		"timeout": "5s"
+	}`)
+	cfg, err := LoadFile(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Config{
+		MchID:                    testMchID,
+		MchCertificateSerialNo:   testSerialNo,
+		PrivateKeyPath:           "/etc/wechatpay/apiclient_key.pem",
+		PlatformCertificatePaths: []string{"/etc/wechatpay/wechatpay.pem"},
+		Timeout:                  "5s",
+	}, cfg)
+
+	yamlPath := writeFile(t, dir, "wechatpay.yaml", "mch_id: 1900009191\n")
+	_, err = LoadFile(yamlPath, nil)
+	assert.Error(t, err)
+
+	// 模拟 yaml.Unmarshal
+	cfg, err = LoadFile(yamlPath, func(data []byte, v interface{}) error {
+		parts := strings.SplitN(strings.TrimSpace(string(data)), ": ", 2)
+		require.Equal(t, "mch_id", parts[0])
+		v.(*Config).MchID = parts[1]
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testMchID, cfg.MchID)
+
+	_, err = LoadFile(writeFile(t, dir, "broken.json", "{"), nil)
+	assert.Error(t, err)
+	_, err = LoadFile(filepath.Join(dir, "missing.json"), nil)
+	assert.Error(t, err)
+}
+
+func TestLoadEnv(t *testing.T) {
+	env := map[string]string{
+		"TEST_WECHATPAY_MCH_ID":                     testMchID,
+		"TEST_WECHATPAY_MCH_CERTIFICATE_SERIAL_NO":  testSerialNo,
+		"TEST_WECHATPAY_PRIVATE_KEY":                strings.Replace(testPrivateKeyPEM, "\n", `\n`, -1),
+		"TEST_WECHATPAY_APIV3_KEY":                  testAPIv3Key,
+		"TEST_WECHATPAY_PLATFORM_CERTIFICATE_PATHS": "a.pem, b.pem,",
+		"TEST_WECHATPAY_TIMEOUT":                    "3s",
+	}
+	for k, v := range env {
+		require.NoError(t, os.Setenv(k, v))
+		defer os.Unsetenv(k)
+	}
+
+	cfg := FromEnv("TEST_WECHATPAY_")
+	assert.Equal(t, &Config{
+		MchID:                    testMchID,
+		MchCertificateSerialNo:   testSerialNo,
+		PrivateKey:               testPrivateKeyPEM,
+		APIv3Key:                 testAPIv3Key,
+		PlatformCertificatePaths: []string{"a.pem", "b.pem"},
+		Timeout:                  "3s",
+	}, cfg)
+
+	// 环境变量覆盖配置文件中的字段，未设置的环境变量不影响原有的值
+	cfg = &Config{MchID: "1230000109", APIServer: consts.WechatPayAPIServerBackup}
+	cfg.LoadEnv("TEST_WECHATPAY_")
+	assert.Equal(t, testMchID, cfg.MchID)
+	assert.Equal(t, consts.WechatPayAPIServerBackup, cfg.APIServer)
+}
+
+func TestConfig_ClientOptions(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	cfg := validConfig()
+	cfg.APIv3Key = ""
+	cfg.PlatformCertificatePaths = []string{writeFile(t, dir, "wechatpay.pem", testCertificatePEM)}
+	_, err := cfg.ClientOptions()
+	assert.NoError(t, err)
+
+	cfg.PlatformCertificatePaths = []string{filepath.Join(dir, "missing.pem")}
+	_, err = cfg.ClientOptions()
+	var fieldErr *FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "platform_certificate_paths", fieldErr.Field)
+
+	cfg.PrivateKey = "not a pem"
+	_, err = cfg.ClientOptions()
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "private_key", fieldErr.Field)
+
+	cfg.PrivateKey, cfg.PrivateKeyPath = "", filepath.Join(dir, "missing_key.pem")
+	_, err = cfg.ClientOptions()
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "private_key_path", fieldErr.Field)
+}
+
+func TestNewClientWithPublicKey(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	const body = `{"code_url":"weixin://wxpay/bizpayurl?pr=p4lpSuKzz"}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get(consts.Authorization), "WECHATPAY2-SHA256-RSA2048 "))
+		assert.Contains(t, r.Header.Get(consts.Authorization), `mchid="`+testMchID+`"`)
+
+		timestamp, nonce := strconv.FormatInt(time.Now().Unix(), 10), "this-is-a-nonce"
+		signature, _ := utils.SignSHA256WithRSA(fmt.Sprintf("%s\n%s\n%s\n", timestamp, nonce, body), testPrivateKey)
+		w.Header().Set(consts.RequestID, "0")
+		w.Header().Set(consts.WechatPaySerial, testPublicKeyID)
+		w.Header().Set(consts.WechatPayNonce, nonce)
+		w.Header().Set(consts.WechatPayTimestamp, timestamp)
+		w.Header().Set(consts.WechatPaySignature, signature)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		MchID:                  testMchID,
+		MchCertificateSerialNo: testSerialNo,
+		PrivateKeyPath:         writeFile(t, dir, "apiclient_key.pem", testPrivateKeyPEM),
+		PublicKeyID:            testPublicKeyID,
+		PublicKeyPath:          writeFile(t, dir, "pub_key.pem", testPublicKeyPEM),
+		Timeout:                "3s",
+		APIServer:              ts.URL,
+	}
+	ctx := context.Background()
+	client, err := NewClient(ctx, cfg)
+	require.NoError(t, err)
+	result, err := client.Get(ctx, consts.WechatPayAPIServer+"/v3/pay/transactions/id/1217752501201407033233368018")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, result.Response.StatusCode)
+
+	// 公钥ID与应答的 Wechatpay-Serial 不一致时验签失败
+	cfg.PublicKeyID = "PUB_KEY_ID_0000000000000000000000000000"
+	client, err = NewClient(ctx, cfg)
+	require.NoError(t, err)
+	_, err = client.Get(ctx, consts.WechatPayAPIServer+"/v3/pay/transactions/id/1217752501201407033233368018")
+	assert.Error(t, err)
+
+	cfg.MchID = ""
+	_, err = NewClient(ctx, cfg)
+	assert.EqualError(t, err, "config mch_id is required")
+}
@@ -7,6 +7,8 @@ import "time"
 const (
 	WechatPayAPIServer       = "https://api.mch.weixin.qq.com"  // 微信支付 API 地址
 	WechatPayAPIServerBackup = "https://api2.mch.weixin.qq.com" // 微信支付 API 备份地址
+	// WechatPayGlobalAPIServer 微信支付境外收单（香港及跨境商户）API 地址
+	WechatPayGlobalAPIServer = "https://apihk.mch.weixin.qq.com"
 )
 
 // SDK 相关信息
@@ -22,6 +24,20 @@ const (
 	ContentType   = "Content-Type"   // Header 中的 ContentType 字段
 	ContentLength = "Content-Length" // Header 中的 ContentLength 字段
 	UserAgent     = "User-Agent"     // Header 中的 UserAgent 字段
+	// AcceptLanguage Header 中的 Accept-Language 字段
+	AcceptLanguage = "Accept-Language"
+	// AcceptEncoding Header 中的 Accept-Encoding 字段
+	AcceptEncoding = "Accept-Encoding"
+	// ContentEncoding Header 中的 Content-Encoding 字段
+	ContentEncoding = "Content-Encoding"
+	// IdempotencyKey Header 中的 Idempotency-Key 字段，部分接口要求通过该字段传递业务请求幂等值
+	IdempotencyKey = "Idempotency-Key"
+)
+
+// 常用 Accept-Language，微信支付将以对应的语言返回错误信息
+const (
+	LanguageZhCN = "zh-CN" // 简体中文
+	LanguageEn   = "en"    // 英文
 )
 
 // 常用 ContentType
@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/signers"
@@ -41,11 +42,30 @@ type CertificateDownloader struct {
 	certificates core.CertificateMap // 证书实例
 	client       *core.Client        // 微信支付 API v3 Go SDK HTTPClient
 	mchAPIv3Key  string              // 商户APIv3密钥
+	apiV3KeyFunc func() string       // 返回最新的商户APIv3密钥，可为空
+	store        CertificateStore    // 平台证书持久化存储，可为空
 	lock         sync.RWMutex
+
+	minRefreshInterval time.Duration // 因证书序列号未知触发的两次下载之间的最小间隔
+	refresh            refreshGroup  // 合并并发的下载请求
 }
 
 // Get 获取证书序列号对应的平台证书
+//
+// 序列号未知时（如微信支付已启用新的平台证书），将立即下载一次平台证书后再次查找。
+// 并发的下载请求会被合并为一次，且距上次下载不足最小间隔（默认为 DefaultMinRefreshInterval）时不再下载。
 func (d *CertificateDownloader) Get(ctx context.Context, serialNo string) (*x509.Certificate, bool) {
+	if certificate, ok := d.get(ctx, serialNo); ok {
+		return certificate, true
+	}
+
+	if err := d.refresh.do(ctx, d.minRefreshInterval, d.downloadCertificates); err != nil {
+		return nil, false
+	}
+	return d.get(ctx, serialNo)
+}
+
+func (d *CertificateDownloader) get(ctx context.Context, serialNo string) (*x509.Certificate, bool) {
 	d.lock.RLock()
 	defer d.lock.RUnlock()
 
@@ -93,8 +113,12 @@ func (d *CertificateDownloader) ExportAll(_ context.Context) map[string]string {
 func (d *CertificateDownloader) decryptCertificate(
 	_ context.Context, encryptCertificate *encryptCertificate,
 ) (string, error) {
+	mchAPIv3Key := d.mchAPIv3Key
+	if d.apiV3KeyFunc != nil {
+		mchAPIv3Key = d.apiV3KeyFunc()
+	}
 	plaintext, err := utils.DecryptAES256GCM(
-		d.mchAPIv3Key, *encryptCertificate.AssociatedData,
+		mchAPIv3Key, *encryptCertificate.AssociatedData,
 		*encryptCertificate.Nonce, *encryptCertificate.Ciphertext,
 	)
 	if err != nil {
@@ -104,21 +128,24 @@ func (d *CertificateDownloader) decryptCertificate(
 	return plaintext, nil
 }
 
+// updateCertificates 更新平台证书，返回证书是否发生了变化
 func (d *CertificateDownloader) updateCertificates(
 	ctx context.Context, certContents map[string]string, certificates map[string]*x509.Certificate,
-) {
+) bool {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	if isSameCertificateMap(d.certificates.GetAll(ctx), certificates) {
-		return
+		return false
 	}
 
 	d.certContents = certContents
 	d.certificates.Reset(certificates)
+	// 下载请求的应答验签不能因序列号未知再次触发下载，故使用不会触发下载的 cachedCertificateGetter
 	d.client = core.NewClientWithValidator(
 		d.client,
-		validators.NewWechatPayResponseValidator(verifiers.NewSHA256WithRSAVerifier(d)),
+		validators.NewWechatPayResponseValidator(verifiers.NewSHA256WithRSAVerifier(cachedCertificateGetter{d: d})),
 	)
+	return true
 }
 
 func (d *CertificateDownloader) performDownloading(ctx context.Context) (*downloadCertificatesResponse, error) {
@@ -135,7 +162,14 @@ func (d *CertificateDownloader) performDownloading(ctx context.Context) (*downlo
 }
 
 // DownloadCertificates 立即下载平台证书列表
+//
+// 设置了 CertificateStore 时，证书发生变化后将被保存到存储中。证书已更新但保存失败时返回错误。
+// 有下载正在进行时不会再次发起，而是等待并返回该次下载的结果。
 func (d *CertificateDownloader) DownloadCertificates(ctx context.Context) error {
+	return d.refresh.do(ctx, 0, d.downloadCertificates)
+}
+
+func (d *CertificateDownloader) downloadCertificates(ctx context.Context) error {
 	resp, err := d.performDownloading(ctx)
 	if err != nil {
 		return err
@@ -164,7 +198,21 @@ func (d *CertificateDownloader) DownloadCertificates(ctx context.Context) error
 		return fmt.Errorf("no certificate downloaded")
 	}
 
-	d.updateCertificates(ctx, rawCertContentMap, certificateMap)
+	if d.updateCertificates(ctx, rawCertContentMap, certificateMap) && d.store != nil {
+		if err = d.store.Save(ctx, rawCertContentMap); err != nil {
+			return fmt.Errorf("save downloaded certificates err: %v", err)
+		}
+	}
+	return nil
+}
+
+// loadCertificatesFromStore 使用存储中尚未过期的平台证书初始化下载器
+func (d *CertificateDownloader) loadCertificatesFromStore(ctx context.Context) error {
+	certContents, certificates, err := loadStoredCertificates(ctx, d.store)
+	if err != nil {
+		return err
+	}
+	d.updateCertificates(ctx, certContents, certificates)
 	return nil
 }
 
@@ -172,6 +220,7 @@ func (d *CertificateDownloader) DownloadCertificates(ctx context.Context) error
 // 初始化完成后会立即发起一次下载，确保下载器被正确初始化。
 func NewCertificateDownloader(
 	ctx context.Context, mchID string, privateKey *rsa.PrivateKey, certificateSerialNo string, mchAPIv3Key string,
+	opts ...DownloaderOption,
 ) (*CertificateDownloader, error) {
 	settings := core.DialSettings{
 		Signer: &signers.SHA256WithRSASigner{
@@ -187,21 +236,35 @@ func NewCertificateDownloader(
 		return nil, fmt.Errorf("create downloader failed, create client err:%v", err)
 	}
 
-	return NewCertificateDownloaderWithClient(ctx, client, mchAPIv3Key)
+	return NewCertificateDownloaderWithClient(ctx, client, mchAPIv3Key, opts...)
 }
 
 // NewCertificateDownloaderWithClient 使用 core.Client 初始化商户的平台证书下载器 CertificateDownloader
 // 初始化完成后会立即发起一次下载，确保下载器被正确初始化。
+// 设置了 CertificateStore 时，如果下载失败，将使用存储中尚未过期的平台证书完成初始化。
 func NewCertificateDownloaderWithClient(
-	ctx context.Context, client *core.Client, mchAPIv3Key string,
+	ctx context.Context, client *core.Client, mchAPIv3Key string, opts ...DownloaderOption,
 ) (*CertificateDownloader, error) {
 	downloader := CertificateDownloader{
-		client:      client,
-		mchAPIv3Key: mchAPIv3Key,
+		client:             client,
+		mchAPIv3Key:        mchAPIv3Key,
+		minRefreshInterval: DefaultMinRefreshInterval,
+	}
+	for _, opt := range opts {
+		opt(&downloader)
 	}
 
 	if err := downloader.DownloadCertificates(ctx); err != nil {
-		return nil, err
+		if len(downloader.GetAll(ctx)) > 0 {
+			// 证书已下载完成，仅保存到存储失败，下次证书更新时将再次保存
+			return &downloader, nil
+		}
+		if downloader.store == nil {
+			return nil, err
+		}
+		if loadErr := downloader.loadCertificatesFromStore(ctx); loadErr != nil {
+			return nil, fmt.Errorf("%v, load certificates from store err: %v", err, loadErr)
+		}
 	}
 
 	return &downloader, nil
@@ -52,17 +52,22 @@ func (d *pseudoCertificateDownloader) Export(ctx context.Context, serialNo strin
 // CertificateDownloaderMgr 不会被 GoGC 自动回收，不再使用时应调用 Stop 方法，防止发生资源泄漏
 type CertificateDownloaderMgr struct {
 	ctx           context.Context
+	cancel        context.CancelFunc
 	task          *task.RepeatedTask
 	downloaderMap map[string]*CertificateDownloader
 	lock          sync.RWMutex
+	stopLock      sync.Mutex
 }
 
-// Stop 停止 CertificateDownloaderMgr 的自动下载 Goroutine
+// Stop 停止 CertificateDownloaderMgr 的自动下载 Goroutine，正在进行的自动下载将被取消
 // 当且仅当不再需要当前管理器自动下载后调用
 // 一旦调用成功，当前管理器无法再次启动
 func (mgr *CertificateDownloaderMgr) Stop() {
-	mgr.lock.Lock()
-	defer mgr.lock.Unlock()
+	// 等待自动下载 Goroutine 退出时不能持有 mgr.lock，否则会与正在进行的下载争用锁
+	mgr.cancel()
+
+	mgr.stopLock.Lock()
+	defer mgr.stopLock.Unlock()
 
 	mgr.task.Stop()
 }
@@ -160,9 +165,9 @@ func (mgr *CertificateDownloaderMgr) DownloadCertificates(ctx context.Context) {
 // RegisterDownloaderWithPrivateKey 向 Mgr 注册商户的平台证书下载器
 func (mgr *CertificateDownloaderMgr) RegisterDownloaderWithPrivateKey(
 	ctx context.Context, privateKey *rsa.PrivateKey,
-	certificateSerialNo string, mchID string, mchAPIv3Key string,
+	certificateSerialNo string, mchID string, mchAPIv3Key string, opts ...DownloaderOption,
 ) error {
-	downloader, err := NewCertificateDownloader(ctx, mchID, privateKey, certificateSerialNo, mchAPIv3Key)
+	downloader, err := NewCertificateDownloader(ctx, mchID, privateKey, certificateSerialNo, mchAPIv3Key, opts...)
 	if err != nil {
 		return err
 	}
@@ -176,9 +181,9 @@ func (mgr *CertificateDownloaderMgr) RegisterDownloaderWithPrivateKey(
 
 // RegisterDownloaderWithClient 向 Mgr 注册商户的平台证书下载器
 func (mgr *CertificateDownloaderMgr) RegisterDownloaderWithClient(
-	ctx context.Context, client *core.Client, mchID string, mchAPIv3Key string,
+	ctx context.Context, client *core.Client, mchID string, mchAPIv3Key string, opts ...DownloaderOption,
 ) error {
-	downloader, err := NewCertificateDownloaderWithClient(ctx, client, mchAPIv3Key)
+	downloader, err := NewCertificateDownloaderWithClient(ctx, client, mchAPIv3Key, opts...)
 	if err != nil {
 		return err
 	}
@@ -233,8 +238,10 @@ func NewCertificateDownloaderMgrWithInterval(
 		downloadInterval = DefaultDownloadInterval
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
 	downloader := CertificateDownloaderMgr{
 		ctx:           ctx,
+		cancel:        cancel,
 		downloaderMap: make(map[string]*CertificateDownloader),
 	}
 	downloader.task = task.NewRepeatedTask(downloadInterval, downloader.getTickHandler())
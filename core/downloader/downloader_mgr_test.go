@@ -2,6 +2,8 @@ package downloader_test
 
 import (
 	"context"
+	"net/http"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,6 +16,9 @@ import (
 )
 
 func TestAutoCertificateDownloader(t *testing.T) {
+	if testing.Short() {
+		t.Skip("依赖 gomonkey 拦截 http.Client.Do，拦截失效时会访问微信支付服务器，short 模式下跳过")
+	}
 	patches := mockDownloadServer(t)
 	defer patches.Reset()
 
@@ -51,3 +56,39 @@ func TestAutoCertificateDownloader(t *testing.T) {
 	mgr.RemoveDownloader(ctx, mockMchID)
 	assert.Empty(t, provider.GetAll(ctx))
 }
+
+func TestCertificateDownloaderMgr_StopCancelsDownloading(t *testing.T) {
+	ctx := context.Background()
+
+	var requests int32
+	downloading := make(chan struct{}, 1)
+	client := newTransportTestClient(t, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			return mockCertificatesResponse(req)
+		}
+		// 自动下载一直等待至请求被取消
+		downloading <- struct{}{}
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}))
+
+	mgr := downloader.NewCertificateDownloaderMgrWithInterval(ctx, 10*time.Millisecond)
+	require.NoError(t, mgr.RegisterDownloaderWithClient(ctx, client, mockMchID, mockAPIv3Key))
+
+	select {
+	case <-downloading:
+	case <-time.After(time.Second):
+		t.Fatal("auto downloading not started")
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		mgr.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Stop is blocked by downloading")
+	}
+}
@@ -13,6 +13,9 @@ import (
 )
 
 func TestNewCertificateDownloaderWithClient(t *testing.T) {
+	if testing.Short() {
+		t.Skip("依赖 gomonkey 拦截 http.Client.Do，拦截失效时会访问微信支付服务器，short 模式下跳过")
+	}
 	patches := mockDownloadServer(t)
 	defer patches.Reset()
 
@@ -46,6 +49,9 @@ func TestNewCertificateDownloaderWithClient(t *testing.T) {
 }
 
 func TestNewCertificateDownloader(t *testing.T) {
+	if testing.Short() {
+		t.Skip("依赖 gomonkey 拦截 http.Client.Do，拦截失效时会访问微信支付服务器，short 模式下跳过")
+	}
 	patches := mockDownloadServer(t)
 	defer patches.Reset()
 
@@ -86,3 +86,26 @@ func ExampleNewCertificateDownloaderMgr_useMgr() {
 	// 使用下载管理器初始化 Client 成功
 	_ = client
 }
+
+func ExampleWithCertificateStore() {
+	ctx := context.Background()
+
+	var (
+		mchID           string
+		mchCertSerialNo string
+		mchPrivateKey   *rsa.PrivateKey
+		mchAPIv3Key     string
+	)
+	// 假设以上参数已初始化完成
+
+	// 证书更新后保存到文件中，启动时下载失败则使用文件中尚未过期的证书
+	store := downloader.NewFileCertificateStore("/var/lib/wechatpay/" + mchID + ".json")
+	d, err := downloader.NewCertificateDownloader(
+		ctx, mchID, mchPrivateKey, mchCertSerialNo, mchAPIv3Key, downloader.WithCertificateStore(store),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	_ = d
+}
@@ -0,0 +1,91 @@
+package downloader
+
+import (
+	"context"
+	"crypto/x509"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMinRefreshInterval 因证书序列号未知触发的两次平台证书下载之间的默认最小间隔
+	DefaultMinRefreshInterval = time.Minute
+)
+
+// WithMinRefreshInterval 设置因证书序列号未知触发的两次平台证书下载之间的最小间隔，默认为 DefaultMinRefreshInterval
+//
+// 最小间隔可以避免携带伪造序列号的请求（如伪造的回调通知）频繁触发下载，导致请求被微信支付限频。
+func WithMinRefreshInterval(interval time.Duration) DownloaderOption {
+	return func(d *CertificateDownloader) {
+		if interval >= 0 {
+			d.minRefreshInterval = interval
+		}
+	}
+}
+
+// refreshCall 一次正在进行或已完成的下载
+type refreshCall struct {
+	done chan struct{}
+	err  error
+}
+
+// refreshGroup 合并并发的平台证书下载：下载进行中时，其他调用方等待并共享该次下载的结果
+type refreshGroup struct {
+	lock     sync.Mutex
+	call     *refreshCall
+	lastDone time.Time
+}
+
+// do 执行 fn，已有下载进行中时等待其结果。minInterval 大于 0 且距上次下载完成不足 minInterval 时不执行 fn，直接返回 nil
+//
+// fn 使用首个调用方的 ctx 执行，其他调用方的 ctx 结束时将停止等待并返回 ctx.Err()。
+func (g *refreshGroup) do(ctx context.Context, minInterval time.Duration, fn func(context.Context) error) error {
+	g.lock.Lock()
+	if call := g.call; call != nil {
+		g.lock.Unlock()
+		select {
+		case <-call.done:
+			return call.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if minInterval > 0 && !g.lastDone.IsZero() && time.Since(g.lastDone) < minInterval {
+		g.lock.Unlock()
+		return nil
+	}
+
+	call := &refreshCall{done: make(chan struct{})}
+	g.call = call
+	g.lock.Unlock()
+
+	defer func() {
+		g.lock.Lock()
+		g.call = nil
+		g.lastDone = time.Now()
+		g.lock.Unlock()
+		close(call.done)
+	}()
+	call.err = fn(ctx)
+	return call.err
+}
+
+// cachedCertificateGetter 仅查找已下载的平台证书，序列号未知时不会触发下载，用于下载请求本身的应答验签
+type cachedCertificateGetter struct {
+	d *CertificateDownloader
+}
+
+// Get 获取证书序列号对应的平台证书
+func (g cachedCertificateGetter) Get(ctx context.Context, serialNo string) (*x509.Certificate, bool) {
+	return g.d.get(ctx, serialNo)
+}
+
+// GetAll 获取平台证书Map
+func (g cachedCertificateGetter) GetAll(ctx context.Context) map[string]*x509.Certificate {
+	return g.d.GetAll(ctx)
+}
+
+// GetNewestSerial 获取最新的平台证书的证书序列号
+func (g cachedCertificateGetter) GetNewestSerial(ctx context.Context) string {
+	return g.d.GetNewestSerial(ctx)
+}
@@ -0,0 +1,87 @@
+package downloader_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/downloader"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+func newCountingTestClientDownloader(
+	t *testing.T, requests *int32, delay time.Duration, opts ...downloader.DownloaderOption,
+) *downloader.CertificateDownloader {
+	client := newTransportTestClient(t, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(requests, 1)
+		time.Sleep(delay)
+		return mockCertificatesResponse(req)
+	}))
+
+	d, err := downloader.NewCertificateDownloaderWithClient(context.Background(), client, mockAPIv3Key, opts...)
+	require.NoError(t, err)
+	return d
+}
+
+func TestCertificateDownloader_GetUnknownSerialCoalesced(t *testing.T) {
+	ctx := context.Background()
+	var requests int32
+	d := newCountingTestClientDownloader(t, &requests, 100*time.Millisecond, downloader.WithMinRefreshInterval(0))
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, ok := d.Get(ctx, "UNKNOWN")
+			assert.False(t, ok)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	serialNo := utils.GetCertificateSerialNumber(*mockWechatPayCertificate)
+	_, ok := d.Get(ctx, serialNo)
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestCertificateDownloader_GetUnknownSerialMinInterval(t *testing.T) {
+	ctx := context.Background()
+	var requests int32
+	d := newCountingTestClientDownloader(t, &requests, 0)
+
+	for i := 0; i < 10; i++ {
+		_, ok := d.Get(ctx, "UNKNOWN")
+		assert.False(t, ok)
+	}
+	// 初始化下载后不足最小间隔，不会再次下载
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// 主动下载不受最小间隔限制
+	require.NoError(t, d.DownloadCertificates(ctx))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestCertificateDownloader_DownloadCertificatesCoalesced(t *testing.T) {
+	ctx := context.Background()
+	var requests int32
+	d := newCountingTestClientDownloader(t, &requests, 100*time.Millisecond)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, d.DownloadCertificates(ctx))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,130 @@
+package downloader
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// CertificateStore 平台证书持久化存储，用于保存解密后的平台证书，可基于文件、Redis 等实现
+//
+// 配置了 CertificateStore 的下载器在证书更新后会保存最新的证书；初始化时如果下载失败（如微信支付证书下载接口故障），
+// 将使用存储中尚未过期的证书完成初始化，避免进程重启后无法对应答与回调通知进行验签。
+type CertificateStore interface {
+	// Load 读取已保存的平台证书，key 为证书序列号，value 为 PEM 格式的证书内容。没有保存过证书时返回空 map
+	Load(ctx context.Context) (map[string]string, error)
+	// Save 保存最新的平台证书，key 为证书序列号，value 为 PEM 格式的证书内容
+	Save(ctx context.Context, certContents map[string]string) error
+}
+
+// DownloaderOption CertificateDownloader 的配置项
+type DownloaderOption func(d *CertificateDownloader)
+
+// WithCertificateStore 设置平台证书持久化存储
+func WithCertificateStore(store CertificateStore) DownloaderOption {
+	return func(d *CertificateDownloader) {
+		d.store = store
+	}
+}
+
+// WithAPIv3KeyFunc 设置返回最新商户APIv3密钥的函数，每次解密平台证书时调用，用于 APIv3 密钥轮换后无需重新注册下载器
+//
+// 设置后将忽略注册下载器时传入的 mchAPIv3Key，可以传入 secrets.Manager.APIv3Key
+func WithAPIv3KeyFunc(apiV3KeyFunc func() string) DownloaderOption {
+	return func(d *CertificateDownloader) {
+		d.apiV3KeyFunc = apiV3KeyFunc
+	}
+}
+
+// FileCertificateStore 基于本地文件的平台证书存储，证书以 JSON 格式保存在单个文件中
+//
+// 多个商户请使用不同的文件。写入时先写临时文件再重命名，保证进程在写入过程中退出时不会损坏已保存的证书。
+type FileCertificateStore struct {
+	path string
+}
+
+// NewFileCertificateStore 使用文件路径创建 FileCertificateStore
+func NewFileCertificateStore(path string) *FileCertificateStore {
+	return &FileCertificateStore{path: path}
+}
+
+// Load 读取文件中保存的平台证书，文件不存在时返回空 map
+func (s *FileCertificateStore) Load(_ context.Context) (map[string]string, error) {
+	content, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read certificate store %s err:%v", s.path, err)
+	}
+
+	certContents := make(map[string]string)
+	if err = json.Unmarshal(content, &certContents); err != nil {
+		return nil, fmt.Errorf("parse certificate store %s err:%v", s.path, err)
+	}
+	return certContents, nil
+}
+
+// Save 将平台证书保存到文件
+func (s *FileCertificateStore) Save(_ context.Context, certContents map[string]string) error {
+	content, err := json.MarshalIndent(certContents, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create certificate store %s err:%v", s.path, err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err = tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write certificate store %s err:%v", s.path, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("write certificate store %s err:%v", s.path, err)
+	}
+	if err = os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("write certificate store %s err:%v", s.path, err)
+	}
+	return nil
+}
+
+// loadStoredCertificates 读取存储中尚未过期的平台证书
+func loadStoredCertificates(ctx context.Context, store CertificateStore) (
+	map[string]string, map[string]*x509.Certificate, error,
+) {
+	stored, err := store.Load(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	certContents := make(map[string]string)
+	certificates := make(map[string]*x509.Certificate)
+	for _, certContent := range stored {
+		certificate, err := utils.LoadCertificate(certContent)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse stored certificate failed: %v", err)
+		}
+		if now.After(certificate.NotAfter) {
+			continue
+		}
+
+		serialNo := utils.GetCertificateSerialNumber(*certificate)
+		certContents[serialNo] = certContent
+		certificates[serialNo] = certificate
+	}
+	if len(certificates) == 0 {
+		return nil, nil, fmt.Errorf("no valid certificate in store")
+	}
+	return certContents, certificates, nil
+}
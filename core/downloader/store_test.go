@@ -0,0 +1,205 @@
+package downloader_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/downloader"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const expiredCertificateStr = `-----BEGIN CERTIFICATE-----
+MIIBETCBuKADAgECAgJe7TAKBggqhkjOPQQDAjASMRAwDgYDVQQDEwdleHBpcmVk
+MB4XDTIwMDEwMTAwMDAwMFoXDTIwMDEwMjAwMDAwMFowEjEQMA4GA1UEAxMHZXhw
+aXJlZDBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABLnFSEM72xV7ZWi+Bz2P3uFL
+wj5DPLG4cca/Dv7OF+NOsAdHrmKlDQ9IKoI0zwY6r0Ib5YSkyGmyCZH8Xbw+lM0w
+CgYIKoZIzj0EAwIDSAAwRQIgIWFfGM/RuA7EqvYb4LUxx8q3CeBA6O7DzicmVo4D
+NrQCIQDP5gJkJ+9PGWBTaVWHZtE9DHE8FC1TY5QCg/MzRoy1qw==
+-----END CERTIFICATE-----`
+
+type memoryCertificateStore struct {
+	certContents map[string]string
+	saveErr      error
+	saved        int
+}
+
+func (s *memoryCertificateStore) Load(_ context.Context) (map[string]string, error) {
+	return s.certContents, nil
+}
+
+func (s *memoryCertificateStore) Save(_ context.Context, certContents map[string]string) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.certContents = certContents
+	s.saved++
+	return nil
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// mockCertificatesResponse 返回已签名的平台证书下载应答
+func mockCertificatesResponse(req *http.Request) (*http.Response, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature, err := utils.SignSHA256WithRSA(
+		fmt.Sprintf("%s\n%s\n%s\n", timestamp, mockNonce, data), mockWechatPayPrivateKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{}
+	header.Set(consts.ContentType, "application/json; charset=utf-8")
+	header.Set(consts.WechatPaySerial, utils.GetCertificateSerialNumber(*mockWechatPayCertificate))
+	header.Set(consts.WechatPayNonce, mockNonce)
+	header.Set(consts.WechatPayTimestamp, timestamp)
+	header.Set(consts.WechatPaySignature, signature)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(data)),
+		Request:    req,
+	}, nil
+}
+
+func newTransportTestClient(t *testing.T, transport http.RoundTripper) *core.Client {
+	privateKey, err := utils.LoadPrivateKey(mockMchPrivateKey)
+	require.NoError(t, err)
+
+	client, err := core.NewClient(
+		context.Background(),
+		option.WithMerchantCredential(mockMchID, mockMchCertificateSerial, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func newStoreTestClient(t *testing.T, available bool) *core.Client {
+	return newTransportTestClient(t, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !available {
+			return nil, fmt.Errorf("mock network error")
+		}
+		return mockCertificatesResponse(req)
+	}))
+}
+
+func TestFileCertificateStore(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "certificate-store")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "certificates.json")
+	store := downloader.NewFileCertificateStore(path)
+
+	certContents, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, certContents)
+
+	serialNo := utils.GetCertificateSerialNumber(*mockWechatPayCertificate)
+	require.NoError(t, store.Save(ctx, map[string]string{serialNo: mockWechatPayCertificateStr}))
+
+	certContents, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{serialNo: mockWechatPayCertificateStr}, certContents)
+
+	files, err := ioutil.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("not json"), 0600))
+	_, err = store.Load(ctx)
+	assert.Error(t, err)
+}
+
+func TestNewCertificateDownloaderWithStore(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryCertificateStore{}
+
+	d, err := downloader.NewCertificateDownloaderWithClient(
+		ctx, newStoreTestClient(t, true), mockAPIv3Key, downloader.WithCertificateStore(store),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, store.saved)
+	assert.Equal(t, d.ExportAll(ctx), store.certContents)
+
+	// 证书未变化时不再保存
+	require.NoError(t, d.DownloadCertificates(ctx))
+	assert.Equal(t, 1, store.saved)
+}
+
+func TestNewCertificateDownloaderWithStoreSaveFailed(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryCertificateStore{saveErr: fmt.Errorf("mock save error")}
+
+	d, err := downloader.NewCertificateDownloaderWithClient(
+		ctx, newStoreTestClient(t, true), mockAPIv3Key, downloader.WithCertificateStore(store),
+	)
+	require.NoError(t, err)
+	assert.Len(t, d.GetAll(ctx), 1)
+}
+
+func TestNewCertificateDownloaderLoadFromStore(t *testing.T) {
+	ctx := context.Background()
+	serialNo := utils.GetCertificateSerialNumber(*mockWechatPayCertificate)
+
+	t.Run("download failed without store", func(t *testing.T) {
+		_, err := downloader.NewCertificateDownloaderWithClient(ctx, newStoreTestClient(t, false), mockAPIv3Key)
+		assert.Error(t, err)
+	})
+
+	t.Run("download failed and load from store", func(t *testing.T) {
+		store := &memoryCertificateStore{certContents: map[string]string{
+			serialNo:  mockWechatPayCertificateStr,
+			"EXPIRED": expiredCertificateStr,
+		}}
+		d, err := downloader.NewCertificateDownloaderWithClient(
+			ctx, newStoreTestClient(t, false), mockAPIv3Key, downloader.WithCertificateStore(store),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{serialNo: mockWechatPayCertificateStr}, d.ExportAll(ctx))
+		assert.Equal(t, serialNo, d.GetNewestSerial(ctx))
+	})
+
+	t.Run("download failed and no valid certificate in store", func(t *testing.T) {
+		store := &memoryCertificateStore{certContents: map[string]string{"EXPIRED": expiredCertificateStr}}
+		_, err := downloader.NewCertificateDownloaderWithClient(
+			ctx, newStoreTestClient(t, false), mockAPIv3Key, downloader.WithCertificateStore(store),
+		)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewCertificateDownloaderWithAPIv3KeyFunc(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := downloader.NewCertificateDownloaderWithClient(
+		ctx, newStoreTestClient(t, true), mockAPIv3Key,
+		downloader.WithAPIv3KeyFunc(func() string { return "wrongAPIv3Key123" }),
+	)
+	assert.Error(t, err)
+
+	d, err := downloader.NewCertificateDownloaderWithClient(
+		ctx, newStoreTestClient(t, true), "",
+		downloader.WithAPIv3KeyFunc(func() string { return mockAPIv3Key }),
+	)
+	require.NoError(t, err)
+	assert.Len(t, d.GetAll(ctx), 1)
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+const (
+	// 预演请求的结果
+	contextKeyDryRun contextKey = "DryRun"
+)
+
+// ErrDryRun 请求以预演模式执行，已构造并签名但未发送
+var ErrDryRun = errors.New("dry run, request is signed but not sent")
+
+// DryRun 预演模式下最近一次构造并签名的请求
+type DryRun struct {
+	lock    sync.Mutex
+	request *http.Request
+}
+
+// Request 返回最近一次构造并签名的请求，尚未执行请求时返回 nil
+//
+// 请求的 Context 为发起请求时传入的 Context，请求包体可通过 http.Request.GetBody 重复读取，可以直接发送或转交审批网关发送。
+func (d *DryRun) Request() *http.Request {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.request
+}
+
+func (d *DryRun) setRequest(request *http.Request) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.request = request
+}
+
+// WithDryRun 以预演模式执行使用返回的 Context 发出的请求，返回更新后的 Context 与记录请求的 DryRun
+//
+// 预演模式下 Client 照常构造请求、校验请求参数、加密敏感字段并签名，但不等待限流器、不经过熔断器、不发送请求，
+// 返回 ErrDryRun，可通过 DryRun.Request 读取已签名的请求。可用于上线前检查商户凭据，或生成需经审批网关发送的请求。
+//
+//	ctx, dryRun := core.WithDryRun(ctx)
+//	_, _, err = svc.Prepay(ctx, req)
+//	if errors.Is(err, core.ErrDryRun) {
+//		request := dryRun.Request()
+//	}
+func WithDryRun(ctx context.Context) (context.Context, *DryRun) {
+	d := new(DryRun)
+	return context.WithValue(ctx, contextKeyDryRun, d), d
+}
+
+// getDryRun 返回 Context 中的 DryRun，Client 设置了 option.WithDryRun 而 Context 中未设置时返回不记录请求的 DryRun
+func (client *Client) getDryRun(ctx context.Context) (*DryRun, bool) {
+	if d, ok := ctx.Value(contextKeyDryRun).(*DryRun); ok && d != nil {
+		return d, true
+	}
+	if client.dryRun {
+		return new(DryRun), true
+	}
+	return nil, false
+}
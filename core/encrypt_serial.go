@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const (
+	// 加密请求中敏感字段所用的平台证书序列号或微信支付公钥ID
+	contextKeyEncryptSerial contextKey = "EncryptSerial"
+)
+
+// encryptSerial 在同一 Context 的 EncryptRequest 与请求间共享的加密序列号
+type encryptSerial struct {
+	lock     sync.Mutex
+	serial   string
+	conflict string
+}
+
+func (s *encryptSerial) set(serial string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch {
+	case s.serial == "":
+		s.serial = serial
+	case s.serial != serial && s.conflict == "":
+		s.conflict = serial
+	}
+}
+
+func (s *encryptSerial) get() (serial, conflict string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.serial, s.conflict
+}
+
+// WithWechatPaySerial 为使用返回的 Context 发出的请求自动设置 Wechatpay-Serial 请求头，返回更新后的 Context
+//
+// 使用返回的 Context 调用 Client.EncryptRequest 加密请求后，Client 记录加密所用的平台证书序列号（或微信支付公钥ID），
+// 使用同一 Context 发出的请求将自动设置 Wechatpay-Serial 请求头，services 中请求结构的 WechatpaySerial 字段可以不填。
+// 请求参数中显式设置的 Wechatpay-Serial 与加密所用的序列号不一致，或同一 Context 加密时使用了不同的序列号时，请求不会被发送并返回错误。
+//
+//	ctx = core.WithWechatPaySerial(ctx)
+//	if _, err = client.EncryptRequest(ctx, &req); err != nil {
+//		return err
+//	}
+//	resp, result, err := svc.AddReceiver(ctx, req)
+func WithWechatPaySerial(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyEncryptSerial, &encryptSerial{})
+}
+
+// WechatPaySerial 返回 Context 中记录的加密所用的序列号。未通过 WithWechatPaySerial 设置，或尚未加密时返回空字符串
+func WechatPaySerial(ctx context.Context) string {
+	s, ok := ctx.Value(contextKeyEncryptSerial).(*encryptSerial)
+	if !ok {
+		return ""
+	}
+	serial, _ := s.get()
+	return serial
+}
+
+// recordEncryptSerial 记录加密所用的序列号，ctx 未通过 WithWechatPaySerial 设置时不记录
+func recordEncryptSerial(ctx context.Context, serial string) {
+	if s, ok := ctx.Value(contextKeyEncryptSerial).(*encryptSerial); ok && serial != "" {
+		s.set(serial)
+	}
+}
+
+// getEncryptSerial 读取 Context 中记录的加密所用的序列号，同一 Context 加密时使用了不同的序列号时返回错误
+func getEncryptSerial(ctx context.Context) (string, error) {
+	s, ok := ctx.Value(contextKeyEncryptSerial).(*encryptSerial)
+	if !ok {
+		return "", nil
+	}
+	serial, conflict := s.get()
+	if conflict != "" {
+		return "", fmt.Errorf("request is encrypted with different serials %s and %s", serial, conflict)
+	}
+	return serial, nil
+}
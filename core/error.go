@@ -3,8 +3,11 @@ package core
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -52,3 +55,120 @@ func IsAPIError(err error, code string) bool {
 	}
 	return false
 }
+
+// DetailMap 返回解析为键值对的 Detail，Detail 不是 JSON 对象时返回 nil
+func (e *APIError) DetailMap() map[string]interface{} {
+	m, _ := e.Detail.(map[string]interface{})
+	return m
+}
+
+// DetailString 返回 Detail 中 key 对应的字符串，key 不存在或对应的值不是字符串时 ok 为 false
+func (e *APIError) DetailString(key string) (value string, ok bool) {
+	value, ok = e.DetailMap()[key].(string)
+	return value, ok
+}
+
+// FieldError 错误详情中的字段级错误，指出请求中被拒绝的字段
+type FieldError struct {
+	Pointer  string      // 被拒绝字段的 JSON Pointer（RFC 6901），如 /amount/currency
+	Field    string      // 错误详情中 field 的原文
+	Value    interface{} // 被拒绝的字段值，错误详情中未返回时为 nil
+	Issue    string      // 字段被拒绝的原因
+	Location string      // 字段所在的位置，如 body、query、path
+}
+
+func (e FieldError) Error() string {
+	if e.Issue == "" {
+		return fmt.Sprintf("invalid field %s", e.Pointer)
+	}
+	return fmt.Sprintf("invalid field %s: %s", e.Pointer, e.Issue)
+}
+
+// FieldErrors 解析 Detail 中的字段级错误
+//
+// 支持的 Detail 格式：含 field 的对象（如 {"field":"/amount/currency","issue":"..."}），
+// 此类对象的数组，以及某个键的值为此类对象数组的对象（如 {"errors":[...]}）。
+// field 可以是 JSON Pointer 或以 . 分隔的路径（如 payer.openid、goods_detail[0].quantity），均转换为 JSON Pointer。
+// Detail 中不包含字段级错误时返回 nil。
+func (e *APIError) FieldErrors() []FieldError {
+	switch detail := e.Detail.(type) {
+	case map[string]interface{}:
+		if fe, ok := parseFieldError(detail); ok {
+			return []FieldError{fe}
+		}
+		keys := make([]string, 0, len(detail))
+		for key := range detail {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if list, ok := detail[key].([]interface{}); ok {
+				if fes := parseFieldErrors(list); len(fes) > 0 {
+					return fes
+				}
+			}
+		}
+	case []interface{}:
+		return parseFieldErrors(detail)
+	}
+	return nil
+}
+
+// FieldErrorsOf 返回 err 链中 *APIError 的字段级错误，err 不是 *APIError 时返回 nil
+func FieldErrorsOf(err error) []FieldError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.FieldErrors()
+	}
+	return nil
+}
+
+func parseFieldErrors(list []interface{}) []FieldError {
+	var fes []FieldError
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			if fe, ok := parseFieldError(m); ok {
+				fes = append(fes, fe)
+			}
+		}
+	}
+	return fes
+}
+
+func parseFieldError(m map[string]interface{}) (FieldError, bool) {
+	field, ok := m["field"].(string)
+	if !ok || field == "" {
+		return FieldError{}, false
+	}
+	fe := FieldError{Pointer: toJSONPointer(field), Field: field, Value: m["value"]}
+	fe.Issue, _ = m["issue"].(string)
+	fe.Location, _ = m["location"].(string)
+	return fe, true
+}
+
+// toJSONPointer 将以 . 分隔的字段路径转换为 JSON Pointer，已经是 JSON Pointer 时原样返回
+func toJSONPointer(field string) string {
+	if strings.HasPrefix(field, "/") {
+		return field
+	}
+	var buf strings.Builder
+	for _, segment := range strings.Split(field, ".") {
+		name := segment
+		var indexes []string
+		if i := strings.IndexByte(segment, '['); i >= 0 && strings.HasSuffix(segment, "]") {
+			name = segment[:i]
+			for _, index := range strings.Split(segment[i+1:len(segment)-1], "][") {
+				if _, err := strconv.Atoi(index); err != nil {
+					name, indexes = segment, nil
+					break
+				}
+				indexes = append(indexes, index)
+			}
+		}
+		buf.WriteString("/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name))
+		for _, index := range indexes {
+			buf.WriteString("/" + index)
+		}
+	}
+	return buf.String()
+}
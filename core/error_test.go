@@ -0,0 +1,87 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPIError(t *testing.T, body string) *APIError {
+	apiErr := &APIError{StatusCode: 400, Body: body}
+	require.NoError(t, json.Unmarshal([]byte(body), apiErr))
+	return apiErr
+}
+
+func TestAPIError_DetailMap(t *testing.T) {
+	apiErr := newTestAPIError(t,
+		`{"code":"PARAM_ERROR","message":"参数错误","detail":{"field":"/amount/currency","value":"XYZ","issue":"Currency code is invalid","location":"body"}}`)
+
+	assert.Equal(t, "body", apiErr.DetailMap()["location"])
+	issue, ok := apiErr.DetailString("issue")
+	assert.True(t, ok)
+	assert.Equal(t, "Currency code is invalid", issue)
+	_, ok = apiErr.DetailString("missing")
+	assert.False(t, ok)
+
+	assert.Nil(t, newTestAPIError(t, `{"code":"SYSTEM_ERROR","message":"系统错误"}`).DetailMap())
+	assert.Nil(t, newTestAPIError(t, `{"code":"PARAM_ERROR","detail":[1,2]}`).DetailMap())
+}
+
+func TestAPIError_FieldErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []FieldError
+	}{
+		{
+			name: "single field error",
+			body: `{"code":"PARAM_ERROR","detail":{"field":"/amount/currency","value":"XYZ","issue":"Currency code is invalid","location":"body"}}`,
+			want: []FieldError{
+				{Pointer: "/amount/currency", Field: "/amount/currency", Value: "XYZ", Issue: "Currency code is invalid", Location: "body"},
+			},
+		},
+		{
+			name: "field error list",
+			body: `{"code":"PARAM_ERROR","detail":[{"field":"contact_info.contact_name","issue":"姓名不能为空"},{"field":"goods_detail[1].quantity","value":0}]}`,
+			want: []FieldError{
+				{Pointer: "/contact_info/contact_name", Field: "contact_info.contact_name", Issue: "姓名不能为空"},
+				{Pointer: "/goods_detail/1/quantity", Field: "goods_detail[1].quantity", Value: float64(0)},
+			},
+		},
+		{
+			name: "nested field error list",
+			body: `{"code":"PARAM_ERROR","detail":{"errors":[{"field":"subject_info.business_license_info.license_copy","issue":"图片无效"}],"request_id":"x"}}`,
+			want: []FieldError{
+				{Pointer: "/subject_info/business_license_info/license_copy", Field: "subject_info.business_license_info.license_copy", Issue: "图片无效"},
+			},
+		},
+		{name: "no detail", body: `{"code":"SYSTEM_ERROR","message":"系统错误"}`},
+		{name: "detail without field", body: `{"code":"PARAM_ERROR","detail":{"issue":"x"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newTestAPIError(t, tt.body).FieldErrors())
+		})
+	}
+}
+
+func TestFieldErrorsOf(t *testing.T) {
+	apiErr := newTestAPIError(t, `{"code":"PARAM_ERROR","detail":{"field":"payer.openid","issue":"openid 无效"}}`)
+
+	fes := FieldErrorsOf(fmt.Errorf("prepay err:%w", apiErr))
+	require.Len(t, fes, 1)
+	assert.Equal(t, "/payer/openid", fes[0].Pointer)
+	assert.Equal(t, "invalid field /payer/openid: openid 无效", fes[0].Error())
+	assert.Nil(t, FieldErrorsOf(fmt.Errorf("network error")))
+}
+
+func TestToJSONPointer(t *testing.T) {
+	assert.Equal(t, "/amount/total", toJSONPointer("/amount/total"))
+	assert.Equal(t, "/amount/total", toJSONPointer("amount.total"))
+	assert.Equal(t, "/detail/0/goods/2", toJSONPointer("detail[0].goods[2]"))
+	assert.Equal(t, "/a~1b/c~0d", toJSONPointer("a/b.c~d"))
+	assert.Equal(t, "/list[x]", toJSONPointer("list[x]"))
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// hedgeAttempt 一次对冲尝试的结果
+type hedgeAttempt struct {
+	result *APIResult
+	err    error
+	info   RequestInfo
+	cancel context.CancelFunc
+}
+
+// release 取消尝试的 Context 并关闭应答包体，用于丢弃未被采用的尝试
+func (a hedgeAttempt) release() {
+	a.cancel()
+	if a.result != nil && a.result.Response != nil {
+		_ = a.result.Response.Body.Close()
+	}
+}
+
+// shouldHedge 判断请求是否需要发出对冲请求：仅对 GET 请求，且接口路径匹配 hedgePaths（为空时全部匹配）
+func (client *Client) shouldHedge(method, requestURL string) bool {
+	if client.hedgeDelay <= 0 || method != http.MethodGet {
+		return false
+	}
+	if len(client.hedgePaths) == 0 {
+		return true
+	}
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range client.hedgePaths {
+		if strings.HasPrefix(u.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// doHedgedRequest 发出请求，在 hedgeDelay 内未得到应答时再发出一个对冲请求，返回先得到的通过验签的成功应答
+//
+// 两次尝试各自签名，使用各自的 RequestInfo，采用的尝试的信息会写回 ctx 中的 RequestInfo。
+// 某次尝试失败时等待另一次尝试的结果，均失败时返回最后一次失败的结果；第一次尝试在 hedgeDelay 内失败时直接返回，不再发出对冲请求。
+func (client *Client) doHedgedRequest(
+	ctx context.Context, method, requestURL string, header http.Header, contentType string,
+) (*APIResult, error) {
+	// 默认超时时间对整个请求生效，而不是每次尝试分别计算
+	cancel := context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok && client.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, client.timeout)
+	}
+	// RequestInfo has been set by withContextDefaults
+	info, _ := RequestInfoFromContext(ctx)
+
+	attempts := make(chan hedgeAttempt, 2)
+	start := func() {
+		attemptInfo := *info
+		attemptCtx, attemptCancel := context.WithCancel(context.WithValue(ctx, contextKeyRequestInfo, &attemptInfo))
+		go func() {
+			result, err := client.sendRequest(attemptCtx, method, requestURL, header, contentType, nil, "")
+			if err == nil {
+				err = client.validator.Validate(attemptCtx, result.Response)
+			}
+			attempts <- hedgeAttempt{result: result, err: err, info: attemptInfo, cancel: attemptCancel}
+		}()
+	}
+
+	start()
+	pending, hedged := 1, false
+	timer := time.NewTimer(client.hedgeDelay)
+	defer timer.Stop()
+
+	var last hedgeAttempt
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if !hedged {
+				hedged = true
+				pending++
+				start()
+			}
+		case attempt := <-attempts:
+			pending--
+			if attempt.err != nil {
+				if last.cancel != nil {
+					last.release()
+				}
+				last = attempt
+				// 第一次尝试在对冲前失败时直接返回
+				hedged = true
+				continue
+			}
+			// 释放此前失败的尝试
+			if last.cancel != nil {
+				last.release()
+			}
+			// 丢弃仍在进行的尝试，其结果在后台释放
+			go func(n int) {
+				for i := 0; i < n; i++ {
+					(<-attempts).release()
+				}
+			}(pending)
+			*info = attempt.info
+			attemptCancel := attempt.cancel
+			attempt.result.Response.Body = &cancelOnEOFBody{
+				ReadCloser: attempt.result.Response.Body,
+				cancel:     func() { attemptCancel(); cancel() },
+			}
+			return attempt.result, nil
+		}
+	}
+
+	*info = last.info
+	last.cancel()
+	cancel()
+	return last.result, last.err
+}
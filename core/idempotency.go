@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"sync"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const (
+	// 请求的业务幂等值
+	contextKeyIdempotencyKey contextKey = "IdempotencyKey"
+)
+
+// idempotencyKey 在同一 Context 发出的请求间共享的幂等值，未指定时在首次请求时生成
+type idempotencyKey struct {
+	lock sync.Mutex
+	key  string
+}
+
+func (k *idempotencyKey) get() (string, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.key == "" {
+		key, err := utils.GenerateNonce()
+		if err != nil {
+			return "", err
+		}
+		k.key = key
+	}
+	return k.key, nil
+}
+
+// WithIdempotencyKey 为使用返回的 Context 发出的请求设置 Idempotency-Key 请求头，返回更新后的 Context
+//
+// 部分接口（如委托营销的建立、终止合作关系）要求通过 Idempotency-Key 传递业务请求幂等值，重试时必须使用相同的幂等值。
+// key 为空时，SDK 在首次请求时生成随机的幂等值，使用同一 Context 重试的请求将复用该值，可通过 IdempotencyKey 读取。
+// 请求参数中显式设置的 Idempotency-Key 优先于 Context 中的幂等值。
+//
+//	ctx = core.WithIdempotencyKey(ctx, "")
+//	for i := 0; i < 3; i++ {
+//		if _, _, err = svc.BuildPartnership(ctx, req); !retryable(err) {
+//			break
+//		}
+//	}
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, contextKeyIdempotencyKey, &idempotencyKey{key: key})
+}
+
+// IdempotencyKey 返回 Context 中的幂等值。未通过 WithIdempotencyKey 设置，或幂等值尚未生成（未发出请求）时返回空字符串
+func IdempotencyKey(ctx context.Context) string {
+	k, ok := ctx.Value(contextKeyIdempotencyKey).(*idempotencyKey)
+	if !ok {
+		return ""
+	}
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	return k.key
+}
+
+// getIdempotencyKey 从 Context 中读取幂等值，必要时生成。未设置时返回空字符串
+func getIdempotencyKey(ctx context.Context) (string, error) {
+	k, ok := ctx.Value(contextKeyIdempotencyKey).(*idempotencyKey)
+	if !ok {
+		return "", nil
+	}
+	return k.get()
+}
@@ -0,0 +1,137 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// 微信支付以 HTTP 状态码判断商户是否成功接收通知：2XX 表示接收成功，不再重发；4XX/5XX 表示接收失败，将按策略重发
+
+type ackType int
+
+const (
+	ackRetry ackType = iota
+	ackReject
+)
+
+// AckError 处理函数返回的应答错误，决定如何应答微信支付的通知请求
+type AckError struct {
+	ack ackType
+	err error
+}
+
+func (e *AckError) Error() string {
+	return e.err.Error()
+}
+
+func (e *AckError) Unwrap() error {
+	return e.err
+}
+
+// Retry 表示业务处理失败但可以重试，应答接收失败，由微信支付稍后重发通知
+func Retry(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &AckError{ack: ackRetry, err: err}
+}
+
+// Reject 表示永久拒绝该通知，重发也无法处理成功（如订单已关闭）。应答时使用 200 状态码以停止重发，应答报文中的 code 为 FAIL
+func Reject(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &AckError{ack: ackReject, err: err}
+}
+
+// IsRetry 判断 err 是否需要微信支付重发通知。未使用 Retry/Reject 包装的错误均视为需要重发
+func IsRetry(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *AckError
+	return !errors.As(err, &e) || e.ack == ackRetry
+}
+
+// IsReject 判断 err 是否为永久拒绝
+func IsReject(err error) bool {
+	var e *AckError
+	return errors.As(err, &e) && e.ack == ackReject
+}
+
+// WriteAck 根据处理结果应答微信支付的通知请求
+//
+// err 为 nil 时应答成功；err 为 Reject 错误时应答 200 以停止重发；其余错误应答 500，由微信支付稍后重发。
+func WriteAck(w http.ResponseWriter, err error) {
+	switch {
+	case err == nil, IsReject(err):
+		writeResponse(w, http.StatusOK, err)
+	default:
+		writeResponse(w, http.StatusInternalServerError, err)
+	}
+}
+
+// HandleFunc 通知处理函数，返回值通过 WriteAck 转换为对微信支付的应答
+type HandleFunc func(ctx context.Context, req *Request) error
+
+// HTTPHandler 返回验签、解密通知后调用 fn 处理的 http.Handler，应答报文由处理结果自动生成
+//
+// 验签失败时应答 401，通知无法解析或解密时应答 400，二者均不会调用 fn。fn 发生 panic 时应答 500，由微信支付稍后重发通知。
+// fn 可以通过 core.RequestInfoFromContext 读取通知请求的 Request-Id 等日志关联信息。
+func (h *Handler) HTTPHandler(fn HandleFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := withRequestInfo(r)
+		body, err := getRequestBody(r)
+		if err != nil {
+			writeResponse(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		if err = h.validator.Validate(ctx, r.Header, body); err != nil {
+			writeResponse(w, http.StatusUnauthorized, fmt.Errorf("not valid wechatpay notify request: %v", err))
+			return
+		}
+
+		req, err := h.decryptRequest(body)
+		if err != nil {
+			writeResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		req.RawRequest = r
+
+		WriteAck(w, Recover(fn, nil)(ctx, req))
+	})
+}
+
+// withRequestInfo 返回设置了通知请求日志关联信息的 Context
+func withRequestInfo(r *http.Request) context.Context {
+	return core.AttachRequestInfo(r.Context(), core.RequestInfo{
+		Method: r.Method, Path: r.URL.Path, RequestID: r.Header.Get(consts.RequestID),
+	})
+}
+
+// writeResponse 按微信支付要求的格式应答通知请求，err 为 nil 时应答成功
+func writeResponse(w http.ResponseWriter, status int, err error) {
+	resp := struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{Code: "SUCCESS", Message: "成功"}
+	if err != nil {
+		resp.Code, resp.Message = "FAIL", err.Error()
+	}
+	// 不在应答中暴露 panic 的详情
+	var panicErr *PanicError
+	if errors.As(err, &panicErr) {
+		resp.Message = "notify handler panic"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
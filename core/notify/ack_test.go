@@ -0,0 +1,129 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+func TestAckErrors(t *testing.T) {
+	assert.Nil(t, Retry(nil))
+	assert.Nil(t, Reject(nil))
+
+	assert.False(t, IsRetry(nil))
+	assert.True(t, IsRetry(fmt.Errorf("untyped error")))
+	assert.True(t, IsRetry(Retry(fmt.Errorf("database unavailable"))))
+	assert.True(t, IsRetry(ErrNotifyProcessing))
+	assert.False(t, IsRetry(Reject(fmt.Errorf("order closed"))))
+
+	err := fmt.Errorf("handle err: %w", Reject(fmt.Errorf("order closed")))
+	assert.True(t, IsReject(err))
+	assert.False(t, IsReject(Retry(fmt.Errorf("database unavailable"))))
+	assert.Equal(t, "order closed", Reject(fmt.Errorf("order closed")).Error())
+}
+
+func TestWriteAck(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{name: "success", err: nil, status: http.StatusOK, code: "SUCCESS"},
+		{name: "retry", err: Retry(fmt.Errorf("database unavailable")), status: http.StatusInternalServerError, code: "FAIL"},
+		{name: "untyped", err: fmt.Errorf("unknown"), status: http.StatusInternalServerError, code: "FAIL"},
+		{name: "reject", err: Reject(fmt.Errorf("order closed")), status: http.StatusOK, code: "FAIL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			WriteAck(recorder, tt.err)
+
+			resp := struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			}{}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tt.status, recorder.Code)
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.err != nil {
+				assert.Equal(t, tt.err.Error(), resp.Message)
+			}
+		})
+	}
+}
+
+func TestHandler_HTTPHandler(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+
+	t.Run("handled", func(t *testing.T) {
+		var content struct {
+			OutTradeNo string `json:"out_trade_no"`
+		}
+		var info core.RequestInfo
+		h := handler.HTTPHandler(func(ctx context.Context, req *Request) error {
+			assert.NotNil(t, req.RawRequest)
+			if i, ok := core.RequestInfoFromContext(ctx); ok {
+				info = *i
+			}
+			return req.UnmarshalContent(&content)
+		})
+
+		recorder := httptest.NewRecorder()
+		notifyRequest := newForwarderRequest(t, encryptForTest(t, testForwarderContent))
+		notifyRequest.Header.Set("Request-Id", "08F78BCA9C1A0C1E")
+		h.ServeHTTP(recorder, notifyRequest)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, core.RequestInfo{Method: http.MethodPost, Path: "/notify", RequestID: "08F78BCA9C1A0C1E"}, info)
+		assert.Equal(t, "1217752501201407033233368018", content.OutTradeNo)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		h := handler.HTTPHandler(func(ctx context.Context, req *Request) error {
+			return Retry(fmt.Errorf("database unavailable"))
+		})
+
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(recorder, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+
+	t.Run("bad request", func(t *testing.T) {
+		called := false
+		h := handler.HTTPHandler(func(ctx context.Context, req *Request) error {
+			called = true
+			return nil
+		})
+
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(recorder, newForwarderRequest(t, "bad ciphertext"))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		h = NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{err: fmt.Errorf("verify failed")}).
+			HTTPHandler(func(ctx context.Context, req *Request) error {
+				called = true
+				return nil
+			})
+		recorder = httptest.NewRecorder()
+		h.ServeHTTP(recorder, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.False(t, called)
+	})
+}
+
+func TestRequest_UnmarshalContent(t *testing.T) {
+	content := make(map[string]interface{})
+	assert.Error(t, (&Request{}).UnmarshalContent(&content))
+	assert.Error(t, (&Request{Resource: &EncryptedResource{Plaintext: "not json"}}).UnmarshalContent(&content))
+
+	require.NoError(t, (&Request{Resource: &EncryptedResource{Plaintext: `{"a":"b"}`}}).UnmarshalContent(&content))
+	assert.Equal(t, "b", content["a"])
+}
@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrAmountMismatch 通知中的订单金额与商户系统中的订单金额不一致，通知可能被篡改或错发
+	ErrAmountMismatch = errors.New("notify amount mismatch")
+	// ErrOrderNotFound 商户系统中找不到通知对应的订单，通知可能被错发到了其他商户或环境
+	ErrOrderNotFound = errors.New("notify order not found")
+)
+
+// OrderAmount 商户系统中订单的金额信息
+type OrderAmount struct {
+	// Total 订单总金额，单位为分
+	Total int64
+	// Currency 货币类型，为空时不检查
+	Currency string
+}
+
+// OrderLookupFunc 根据商户订单号查找商户系统中的订单金额，订单不存在时返回 nil, nil
+type OrderLookupFunc func(ctx context.Context, outTradeNo string) (*OrderAmount, error)
+
+// AmountMismatch 通知与商户系统中的订单不一致的详情，用于告警
+type AmountMismatch struct {
+	// Request 不一致的通知
+	Request *Request
+	// OutTradeNo 通知中的商户订单号
+	OutTradeNo string
+	// Total 通知中的订单总金额，单位为分
+	Total int64
+	// Currency 通知中的货币类型
+	Currency string
+	// Expected 商户系统中的订单金额，订单不存在时为 nil
+	Expected *OrderAmount
+	// Err ErrAmountMismatch 或 ErrOrderNotFound
+	Err error
+}
+
+// AmountGuard 检查支付通知中的商户订单号与金额是否与商户系统中的订单一致，拒绝不一致的通知
+//
+// 仅检查通知类型以 TRANSACTION. 开头且通知内容包含 out_trade_no 的通知（如支付成功通知），其余通知（如退款通知、合单支付通知）直接处理。
+type AmountGuard struct {
+	lookup OrderLookupFunc
+	alert  func(ctx context.Context, mismatch *AmountMismatch)
+}
+
+// AmountGuardOption AmountGuard 的配置项
+type AmountGuardOption func(g *AmountGuard)
+
+// WithAmountAlert 设置通知与订单不一致时的告警回调，可用于记录日志或通知运维人员
+func WithAmountAlert(fn func(ctx context.Context, mismatch *AmountMismatch)) AmountGuardOption {
+	return func(g *AmountGuard) {
+		g.alert = fn
+	}
+}
+
+// NewAmountGuard 使用订单查询函数创建 AmountGuard
+func NewAmountGuard(lookup OrderLookupFunc, opts ...AmountGuardOption) *AmountGuard {
+	g := &AmountGuard{lookup: lookup}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Process 检查通知与商户系统中的订单一致后调用 handle 处理
+//
+// 订单不存在或金额、货币类型不一致时调用告警回调，不调用 handle，并返回 Reject 包装的 ErrOrderNotFound 或 ErrAmountMismatch，
+// 应答 200 以停止重发；通知内容无法解析时返回 Reject 错误；查询订单失败时返回 Retry 错误，由微信支付稍后重发通知。
+func (g *AmountGuard) Process(
+	ctx context.Context, req *Request, handle func(ctx context.Context, req *Request) error,
+) error {
+	if !strings.HasPrefix(req.EventType, "TRANSACTION.") || req.Resource == nil || req.Resource.Plaintext == "" {
+		return handle(ctx, req)
+	}
+
+	content := struct {
+		OutTradeNo string `json:"out_trade_no"`
+		Amount     *struct {
+			Total    *int64 `json:"total"`
+			Currency string `json:"currency"`
+		} `json:"amount"`
+	}{}
+	if err := json.Unmarshal([]byte(req.Resource.Plaintext), &content); err != nil {
+		return Reject(fmt.Errorf("unmarshal plaintext for amount check err: %v", err))
+	}
+	if content.OutTradeNo == "" {
+		return handle(ctx, req)
+	}
+
+	mismatch := &AmountMismatch{Request: req, OutTradeNo: content.OutTradeNo}
+	if content.Amount != nil && content.Amount.Total != nil {
+		mismatch.Total, mismatch.Currency = *content.Amount.Total, content.Amount.Currency
+	}
+
+	expected, err := g.lookup(ctx, content.OutTradeNo)
+	if err != nil {
+		return Retry(fmt.Errorf("lookup order %s err: %w", content.OutTradeNo, err))
+	}
+	mismatch.Expected = expected
+	switch {
+	case expected == nil:
+		mismatch.Err = fmt.Errorf("%w: out_trade_no=%s", ErrOrderNotFound, content.OutTradeNo)
+	case content.Amount == nil || content.Amount.Total == nil:
+		mismatch.Err = fmt.Errorf("%w: out_trade_no=%s, missing amount", ErrAmountMismatch, content.OutTradeNo)
+	case mismatch.Total != expected.Total, expected.Currency != "" && mismatch.Currency != expected.Currency:
+		mismatch.Err = fmt.Errorf(
+			"%w: out_trade_no=%s, expected %d %s, got %d %s", ErrAmountMismatch, content.OutTradeNo,
+			expected.Total, expected.Currency, mismatch.Total, mismatch.Currency,
+		)
+	default:
+		return handle(ctx, req)
+	}
+
+	if g.alert != nil {
+		g.alert(ctx, mismatch)
+	}
+	return Reject(mismatch.Err)
+}
@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmountGuard_Process(t *testing.T) {
+	ctx := context.Background()
+	orders := map[string]*OrderAmount{
+		"T1": {Total: 100, Currency: "CNY"},
+		"T2": {Total: 200},
+	}
+	var alerts []*AmountMismatch
+	g := NewAmountGuard(func(_ context.Context, outTradeNo string) (*OrderAmount, error) {
+		if outTradeNo == "ERR" {
+			return nil, fmt.Errorf("database unavailable")
+		}
+		return orders[outTradeNo], nil
+	}, WithAmountAlert(func(_ context.Context, mismatch *AmountMismatch) { alerts = append(alerts, mismatch) }))
+
+	var calls int
+	handle := func(context.Context, *Request) error {
+		calls++
+		return nil
+	}
+	process := func(eventType, plaintext string) error {
+		return g.Process(ctx, newDedupRequest("EV-1", eventType, plaintext), handle)
+	}
+
+	// 一致时调用处理函数
+	require.NoError(t, process("TRANSACTION.SUCCESS", `{"out_trade_no":"T1","amount":{"total":100,"currency":"CNY"}}`))
+	require.NoError(t, process("TRANSACTION.SUCCESS", `{"out_trade_no":"T2","amount":{"total":200,"currency":"USD"}}`))
+	// 其他类型的通知与合单支付通知不检查
+	require.NoError(t, process("REFUND.SUCCESS", `{"out_trade_no":"T1","amount":{"total":1,"refund":1}}`))
+	require.NoError(t, process("TRANSACTION.SUCCESS", `{"combine_out_trade_no":"C1"}`))
+	assert.Equal(t, 4, calls)
+	assert.Empty(t, alerts)
+
+	tests := []struct {
+		name      string
+		plaintext string
+		want      error
+	}{
+		{"total", `{"out_trade_no":"T1","amount":{"total":1,"currency":"CNY"}}`, ErrAmountMismatch},
+		{"currency", `{"out_trade_no":"T1","amount":{"total":100,"currency":"USD"}}`, ErrAmountMismatch},
+		{"missing amount", `{"out_trade_no":"T1"}`, ErrAmountMismatch},
+		{"not found", `{"out_trade_no":"T3","amount":{"total":100,"currency":"CNY"}}`, ErrOrderNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts = nil
+			err := process("TRANSACTION.SUCCESS", tt.plaintext)
+			assert.True(t, IsReject(err))
+			assert.True(t, errors.Is(err, tt.want))
+			require.Len(t, alerts, 1)
+			assert.Equal(t, err.Error(), alerts[0].Err.Error())
+			assert.Equal(t, "EV-1", alerts[0].Request.ID)
+		})
+	}
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, "T3", alerts[0].OutTradeNo)
+	assert.Nil(t, alerts[0].Expected)
+	assert.EqualError(t, process("TRANSACTION.SUCCESS", `{"out_trade_no":"T1","amount":{"total":1,"currency":"CNY"}}`),
+		"notify amount mismatch: out_trade_no=T1, expected 100 CNY, got 1 CNY")
+
+	// 查询订单失败时重试，无法解析的通知拒绝
+	alerts = nil
+	assert.True(t, IsRetry(process("TRANSACTION.SUCCESS", `{"out_trade_no":"ERR","amount":{"total":1}}`)))
+	assert.True(t, IsReject(process("TRANSACTION.SUCCESS", `not json`)))
+	assert.Empty(t, alerts)
+	assert.Equal(t, 4, calls)
+}
@@ -0,0 +1,249 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// OverflowPolicy 异步处理队列已满时的处理策略
+type OverflowPolicy int
+
+const (
+	// OverflowRetry 立即应答接收失败，由微信支付稍后重发通知。通知不会丢失，但会增加微信支付的重发次数
+	OverflowRetry OverflowPolicy = iota
+	// OverflowBlock 等待队列空出位置，等待超过 WithEnqueueTimeout 设置的时间后应答接收失败
+	OverflowBlock
+	// OverflowCallerRuns 在通知请求的协程中同步处理通知，并根据处理结果应答，相当于未使用异步处理
+	OverflowCallerRuns
+)
+
+const (
+	defaultAsyncConcurrency    = 8
+	defaultAsyncQueueSize      = 1024
+	defaultAsyncEnqueueTimeout = time.Second
+)
+
+// ErrProcessorClosed 异步处理器已关闭，不再接收新的通知
+var ErrProcessorClosed = errors.New("notify async processor closed")
+
+// ErrQueueFull 异步处理队列已满
+var ErrQueueFull = errors.New("notify async queue full")
+
+type asyncTask struct {
+	ctx context.Context
+	req *Request
+}
+
+// AsyncProcessor 异步处理微信支付通知的工作池，实现了 http.Handler，可直接作为通知地址的处理器
+//
+// 通知在验签并解密后放入有界队列，随即向微信支付应答成功，再由固定数量的工作协程调用处理函数，
+// 避免业务处理耗时过长导致微信支付判定通知失败。验签、解密失败时的应答与 Handler.HTTPHandler 一致。
+//
+// 应答成功后微信支付不再重发通知，因此处理函数返回的错误（包括 Retry）只会交给 WithAsyncErrorHandler 设置的函数处理，
+// 进程退出前请调用 Shutdown 等待队列中的通知处理完成。对于不能丢失的通知，建议改用 Forwarder 投递到消息队列。
+//
+// 队列中的通知在 ServeHTTP 返回后才被处理，此时原始请求已交还给 HTTP 服务器，因此 Request.RawRequest 为 nil；
+// 仅在 OverflowCallerRuns 策略下于当前请求中处理时，RawRequest 为原始请求。
+type AsyncProcessor struct {
+	handler        *Handler
+	fn             HandleFunc
+	concurrency    int
+	queueSize      int
+	overflow       OverflowPolicy
+	enqueueTimeout time.Duration
+	errorHandler   func(ctx context.Context, req *Request, err error)
+
+	queue    chan *asyncTask
+	lock     sync.RWMutex
+	closed   bool
+	finished chan struct{}
+}
+
+// AsyncProcessorOption AsyncProcessor 的配置项
+type AsyncProcessorOption func(p *AsyncProcessor)
+
+// WithConcurrency 设置处理通知的工作协程数量，默认为 8
+func WithConcurrency(concurrency int) AsyncProcessorOption {
+	return func(p *AsyncProcessor) {
+		if concurrency > 0 {
+			p.concurrency = concurrency
+		}
+	}
+}
+
+// WithQueueSize 设置等待处理的通知队列长度，默认为 1024
+func WithQueueSize(size int) AsyncProcessorOption {
+	return func(p *AsyncProcessor) {
+		if size >= 0 {
+			p.queueSize = size
+		}
+	}
+}
+
+// WithOverflowPolicy 设置队列已满时的处理策略，默认为 OverflowRetry
+func WithOverflowPolicy(policy OverflowPolicy) AsyncProcessorOption {
+	return func(p *AsyncProcessor) {
+		p.overflow = policy
+	}
+}
+
+// WithEnqueueTimeout 设置 OverflowBlock 策略下等待队列空出位置的最长时间，默认为 1s
+func WithEnqueueTimeout(timeout time.Duration) AsyncProcessorOption {
+	return func(p *AsyncProcessor) {
+		if timeout >= 0 {
+			p.enqueueTimeout = timeout
+		}
+	}
+}
+
+// WithAsyncErrorHandler 设置异步处理通知失败时的回调，可用于记录日志或将通知保存到补偿任务中
+func WithAsyncErrorHandler(fn func(ctx context.Context, req *Request, err error)) AsyncProcessorOption {
+	return func(p *AsyncProcessor) {
+		p.errorHandler = fn
+	}
+}
+
+// NewAsyncProcessor 使用通知处理器与处理函数创建 AsyncProcessor，并启动工作协程
+func NewAsyncProcessor(handler *Handler, fn HandleFunc, opts ...AsyncProcessorOption) *AsyncProcessor {
+	p := &AsyncProcessor{
+		handler:        handler,
+		fn:             fn,
+		concurrency:    defaultAsyncConcurrency,
+		queueSize:      defaultAsyncQueueSize,
+		overflow:       OverflowRetry,
+		enqueueTimeout: defaultAsyncEnqueueTimeout,
+		finished:       make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	p.queue = make(chan *asyncTask, p.queueSize)
+	var wg sync.WaitGroup
+	wg.Add(p.concurrency)
+	for i := 0; i < p.concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for task := range p.queue {
+				p.process(task)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(p.finished)
+	}()
+	return p
+}
+
+// ServeHTTP 处理微信支付通知请求
+func (p *AsyncProcessor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := withRequestInfo(r)
+	body, err := getRequestBody(r)
+	if err != nil {
+		writeResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err = p.handler.validator.Validate(ctx, r.Header, body); err != nil {
+		writeResponse(w, http.StatusUnauthorized, fmt.Errorf("not valid wechatpay notify request: %v", err))
+		return
+	}
+
+	req, err := p.handler.decryptRequest(body)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	task := &asyncTask{ctx: detachContext(ctx), req: req}
+	if err = p.enqueue(r.Context(), task); err != nil {
+		if err == ErrQueueFull && p.overflow == OverflowCallerRuns {
+			req.RawRequest = r
+			WriteAck(w, Recover(p.fn, nil)(ctx, req))
+			return
+		}
+		writeResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeResponse(w, http.StatusOK, nil)
+}
+
+// enqueue 将通知放入队列，队列已满时按 OverflowPolicy 处理
+func (p *AsyncProcessor) enqueue(ctx context.Context, task *asyncTask) error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if p.closed {
+		return ErrProcessorClosed
+	}
+
+	select {
+	case p.queue <- task:
+		return nil
+	default:
+	}
+	if p.overflow != OverflowBlock || p.enqueueTimeout == 0 {
+		return ErrQueueFull
+	}
+
+	timer := time.NewTimer(p.enqueueTimeout)
+	defer timer.Stop()
+	select {
+	case p.queue <- task:
+		return nil
+	case <-timer.C:
+		return ErrQueueFull
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// process 调用处理函数，处理失败或发生 panic 时调用错误回调，panic 时的错误为 Retry 包装的 *PanicError
+func (p *AsyncProcessor) process(task *asyncTask) {
+	if err := Recover(p.fn, nil)(task.ctx, task.req); err != nil {
+		p.handleError(task, err)
+	}
+}
+
+func (p *AsyncProcessor) handleError(task *asyncTask, err error) {
+	if p.errorHandler != nil {
+		p.errorHandler(task.ctx, task.req, err)
+	}
+}
+
+// Len 返回队列中等待处理的通知数量，可用于监控积压情况
+func (p *AsyncProcessor) Len() int {
+	return len(p.queue)
+}
+
+// Shutdown 停止接收新的通知，并等待队列中的通知处理完成。ctx 结束时不再等待，返回 ctx.Err()，剩余的通知仍会在后台继续处理
+func (p *AsyncProcessor) Shutdown(ctx context.Context) error {
+	p.lock.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.lock.Unlock()
+
+	select {
+	case <-p.finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// detachContext 返回不随通知请求结束而取消的 Context，并复制通知请求的日志关联信息
+func detachContext(ctx context.Context) context.Context {
+	var info core.RequestInfo
+	if existing, ok := core.RequestInfoFromContext(ctx); ok {
+		info = *existing
+	}
+	return core.AttachRequestInfo(context.Background(), info)
+}
@@ -0,0 +1,162 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveAsync(p *AsyncProcessor, req *http.Request) int {
+	recorder := httptest.NewRecorder()
+	p.ServeHTTP(recorder, req)
+	return recorder.Code
+}
+
+func TestAsyncProcessor_ServeHTTP(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+	processed := make(chan *Request, 1)
+	errCh := make(chan error, 1)
+	p := NewAsyncProcessor(handler, func(ctx context.Context, req *Request) error {
+		processed <- req
+		return Retry(fmt.Errorf("order not found"))
+	}, WithAsyncErrorHandler(func(_ context.Context, _ *Request, err error) { errCh <- err }))
+	defer func() { _ = p.Shutdown(context.Background()) }()
+
+	// 处理函数返回错误也不影响应答，错误交给错误回调处理
+	assert.Equal(t, http.StatusOK, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+	select {
+	case req := <-processed:
+		assert.Equal(t, "EV-2018022511223320873", req.ID)
+		assert.Equal(t, testForwarderContent, req.Resource.Plaintext)
+		// 工作协程处理时原始请求已交还给 HTTP 服务器
+		assert.Nil(t, req.RawRequest)
+	case <-time.After(time.Second):
+		t.Fatal("notify not processed")
+	}
+	select {
+	case err := <-errCh:
+		assert.EqualError(t, err, "order not found")
+	case <-time.After(time.Second):
+		t.Fatal("error handler not called")
+	}
+
+	assert.Equal(t, http.StatusBadRequest, serveAsync(p, newForwarderRequest(t, "invalid ciphertext")))
+	invalid := NewAsyncProcessor(NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{err: fmt.Errorf("mock verify error")}),
+		func(context.Context, *Request) error { return nil })
+	defer func() { _ = invalid.Shutdown(context.Background()) }()
+	assert.Equal(t, http.StatusUnauthorized, serveAsync(invalid, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+}
+
+func TestAsyncProcessor_Overflow(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+
+	newBlockedProcessor := func(opts ...AsyncProcessorOption) (*AsyncProcessor, chan struct{}) {
+		started, release := make(chan struct{}, 1), make(chan struct{})
+		opts = append([]AsyncProcessorOption{WithConcurrency(1), WithQueueSize(1)}, opts...)
+		p := NewAsyncProcessor(handler, func(context.Context, *Request) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-release
+			return nil
+		}, opts...)
+
+		// 第一个通知占用唯一的工作协程，第二个通知填满队列
+		require.Equal(t, http.StatusOK, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+		<-started
+		require.Equal(t, http.StatusOK, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+		require.Equal(t, 1, p.Len())
+		return p, release
+	}
+
+	t.Run("retry", func(t *testing.T) {
+		p, release := newBlockedProcessor()
+		assert.Equal(t, http.StatusInternalServerError, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+		close(release)
+		assert.NoError(t, p.Shutdown(context.Background()))
+	})
+
+	t.Run("block until timeout", func(t *testing.T) {
+		p, release := newBlockedProcessor(WithOverflowPolicy(OverflowBlock), WithEnqueueTimeout(20*time.Millisecond))
+		start := time.Now()
+		assert.Equal(t, http.StatusInternalServerError, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+		assert.True(t, time.Since(start) >= 20*time.Millisecond)
+		close(release)
+		assert.NoError(t, p.Shutdown(context.Background()))
+	})
+
+	t.Run("block until dequeued", func(t *testing.T) {
+		p, release := newBlockedProcessor(WithOverflowPolicy(OverflowBlock), WithEnqueueTimeout(time.Second))
+		time.AfterFunc(20*time.Millisecond, func() { close(release) })
+		assert.Equal(t, http.StatusOK, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+		assert.NoError(t, p.Shutdown(context.Background()))
+	})
+
+	t.Run("caller runs", func(t *testing.T) {
+		p, release := newBlockedProcessor(WithOverflowPolicy(OverflowCallerRuns))
+		close(release)
+		// 同步处理时按处理结果应答
+		assert.Equal(t, http.StatusOK, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+		assert.NoError(t, p.Shutdown(context.Background()))
+	})
+}
+
+func TestAsyncProcessor_Shutdown(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+	var (
+		mu        sync.Mutex
+		processed int
+	)
+	release := make(chan struct{})
+	p := NewAsyncProcessor(handler, func(context.Context, *Request) error {
+		<-release
+		mu.Lock()
+		defer mu.Unlock()
+		processed++
+		return nil
+	}, WithConcurrency(2))
+
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusOK, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, p.Shutdown(ctx))
+	assert.Equal(t, http.StatusInternalServerError, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+
+	// Shutdown 等待队列中的通知全部处理完成
+	close(release)
+	require.NoError(t, p.Shutdown(context.Background()))
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 5, processed)
+}
+
+func TestAsyncProcessor_Panic(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+	errCh := make(chan error, 1)
+	p := NewAsyncProcessor(handler, func(context.Context, *Request) error {
+		panic("mock panic")
+	}, WithConcurrency(1), WithAsyncErrorHandler(func(_ context.Context, _ *Request, err error) { errCh <- err }))
+
+	assert.Equal(t, http.StatusOK, serveAsync(p, newForwarderRequest(t, encryptForTest(t, testForwarderContent))))
+	select {
+	case err := <-errCh:
+		assert.Contains(t, err.Error(), "mock panic")
+		var panicErr *PanicError
+		assert.True(t, errors.As(err, &panicErr))
+	case <-time.After(time.Second):
+		t.Fatal("error handler not called")
+	}
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
@@ -0,0 +1,316 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// DedupState 通知去重键的状态
+type DedupState int
+
+const (
+	// DedupAcquired 成功锁定去重键，当前调用方负责处理该通知
+	DedupAcquired DedupState = iota
+	// DedupProcessing 去重键已被锁定，同一业务事件正在其他请求中处理
+	DedupProcessing
+	// DedupDone 同一业务事件已处理成功
+	DedupDone
+)
+
+// DedupStore 通知去重存储，可基于 Redis、数据库等实现，以便在多个实例间共享去重状态
+//
+// 锁定去重键时生成随机的令牌并保存为 key 的值，Done 与 Release 仅在 key 的值仍为该令牌时修改，
+// 避免锁定过期后被其他请求重新锁定的去重键被原持有者标记或释放。以 Redis 为例：
+// Acquire 可使用 SET key token NX PX ttl 实现，未设置成功时根据 key 的值返回 DedupProcessing 或 DedupDone；
+// Done 可使用 Lua 脚本在 GET key 等于 token 时执行 SET key done PX ttl，否则返回 ErrDedupLockLost；
+// Release 可使用 Lua 脚本在 GET key 等于 token 时执行 DEL key。
+type DedupStore interface {
+	// Acquire 尝试锁定去重键，锁定在 ttl 后自动失效，避免处理进程异常退出导致的死锁。
+	// 状态为 DedupAcquired 时返回本次锁定的令牌，其余状态下令牌为空
+	Acquire(ctx context.Context, key string, ttl time.Duration) (state DedupState, token string, err error)
+	// Done 在去重键仍由 token 锁定时将其标记为已处理，并保留 ttl；已被其他请求锁定或已失效时返回 ErrDedupLockLost
+	Done(ctx context.Context, key, token string, ttl time.Duration) error
+	// Release 在去重键仍由 token 锁定时释放，以便后续重试的通知能够被重新处理；否则不做修改
+	Release(ctx context.Context, key, token string) error
+}
+
+// ErrNotifyProcessing 同一业务事件正在其他请求中处理，应答失败以便微信支付稍后重试
+var ErrNotifyProcessing = errors.New("notify is being processed")
+
+// ErrDedupLockLost 去重键的锁定已失效或已被其他请求重新锁定，通常是处理时间超过了锁定时间
+var ErrDedupLockLost = errors.New("dedup lock is lost")
+
+// DedupKeyFunc 根据通知生成去重键
+type DedupKeyFunc func(req *Request) (string, error)
+
+// DedupKeyByEventID 使用通知 ID 作为去重键，仅对微信支付重发的同一通知去重
+func DedupKeyByEventID(req *Request) (string, error) {
+	if req.ID == "" {
+		return "", fmt.Errorf("notify id is empty")
+	}
+	return req.ID, nil
+}
+
+// DedupKeyByOutTradeNo 使用 通知类型 + 商户号 + 商户单号 作为去重键，对同一业务事件的不同通知去重
+//
+// 退款通知使用商户退款单号 out_refund_no，其余通知使用商户订单号 out_trade_no。商户单号仅在商户号下唯一，
+// 因此通知内容中的 mchid、sp_mchid 与 sub_mchid 也会加入去重键，避免服务商模式下不同子商户的相同单号被误判为重复。
+// 通知内容中没有商户单号时，使用通知 ID 作为去重键。
+func DedupKeyByOutTradeNo(req *Request) (string, error) {
+	if req.Resource != nil && req.Resource.Plaintext != "" {
+		content := struct {
+			Mchid       string `json:"mchid"`
+			SpMchid     string `json:"sp_mchid"`
+			SubMchid    string `json:"sub_mchid"`
+			OutTradeNo  string `json:"out_trade_no"`
+			OutRefundNo string `json:"out_refund_no"`
+		}{}
+		if err := json.Unmarshal([]byte(req.Resource.Plaintext), &content); err != nil {
+			return "", fmt.Errorf("unmarshal plaintext for dedup key err: %v", err)
+		}
+		outNo := content.OutTradeNo
+		if content.OutRefundNo != "" {
+			outNo = content.OutRefundNo
+		}
+		if outNo != "" {
+			key := req.EventType
+			for _, mchid := range []string{content.Mchid, content.SpMchid, content.SubMchid} {
+				if mchid != "" {
+					key += ":" + mchid
+				}
+			}
+			return key + ":" + outNo, nil
+		}
+	}
+	return DedupKeyByEventID(req)
+}
+
+const (
+	defaultDedupLockTTL           = time.Minute
+	defaultDedupDoneTTL           = 7 * 24 * time.Hour
+	defaultDedupDoneMaxAttempts   = 3
+	defaultDedupDoneRetryInterval = 100 * time.Millisecond
+)
+
+// Deduplicator 通知去重处理器
+//
+// 同一业务事件的处理函数不会在锁定时间内被并发执行，处理成功并标记为已处理后，重发的通知不再执行处理函数；
+// 处理函数返回错误时会释放去重键，微信支付重发通知时将再次执行处理函数。
+//
+// 以下情况下同一业务事件的处理函数可能被再次执行，因此处理函数仍需保证幂等（如更新订单状态前检查当前状态）：
+// 处理时间超过锁定时间，去重键被其他请求重新锁定；处理成功后多次重试仍无法标记为已处理，锁定过期后重发的通知再次被处理。
+// 这两种情况下 Process 均返回错误。
+type Deduplicator struct {
+	store             DedupStore
+	keyFunc           DedupKeyFunc
+	lockTTL           time.Duration
+	doneTTL           time.Duration
+	doneMaxAttempts   int
+	doneRetryInterval time.Duration
+}
+
+// DeduplicatorOption Deduplicator 的配置项
+type DeduplicatorOption func(d *Deduplicator)
+
+// WithDedupKeyFunc 设置去重键的生成方法，默认为 DedupKeyByOutTradeNo
+func WithDedupKeyFunc(keyFunc DedupKeyFunc) DeduplicatorOption {
+	return func(d *Deduplicator) {
+		if keyFunc != nil {
+			d.keyFunc = keyFunc
+		}
+	}
+}
+
+// WithDedupLockTTL 设置处理过程中去重键的锁定时间，应大于处理函数的最长执行时间，默认为 1 分钟
+func WithDedupLockTTL(ttl time.Duration) DeduplicatorOption {
+	return func(d *Deduplicator) {
+		if ttl > 0 {
+			d.lockTTL = ttl
+		}
+	}
+}
+
+// WithDedupDoneTTL 设置处理成功后去重键的保留时间，应大于微信支付重发通知的时间跨度，默认为 7 天
+func WithDedupDoneTTL(ttl time.Duration) DeduplicatorOption {
+	return func(d *Deduplicator) {
+		if ttl > 0 {
+			d.doneTTL = ttl
+		}
+	}
+}
+
+// WithDedupDoneRetry 设置处理成功后标记去重键的最大尝试次数与首次重试间隔，默认为 3 次、100ms
+func WithDedupDoneRetry(maxAttempts int, interval time.Duration) DeduplicatorOption {
+	return func(d *Deduplicator) {
+		if maxAttempts > 0 {
+			d.doneMaxAttempts = maxAttempts
+		}
+		if interval >= 0 {
+			d.doneRetryInterval = interval
+		}
+	}
+}
+
+// NewDeduplicator 使用去重存储创建 Deduplicator
+func NewDeduplicator(store DedupStore, opts ...DeduplicatorOption) *Deduplicator {
+	d := &Deduplicator{
+		store:             store,
+		keyFunc:           DedupKeyByOutTradeNo,
+		lockTTL:           defaultDedupLockTTL,
+		doneTTL:           defaultDedupDoneTTL,
+		doneMaxAttempts:   defaultDedupDoneMaxAttempts,
+		doneRetryInterval: defaultDedupDoneRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Process 对通知去重后调用 handle 处理
+//
+// 同一业务事件已处理成功时，不再调用 handle 并返回 nil；正在其他请求中处理时返回 ErrNotifyProcessing；
+// handle 返回错误时释放去重键并返回该错误。handle 成功后将去重键标记为已处理，失败时按指数退避重试，
+// 去重键已被其他请求重新锁定（ErrDedupLockLost）时不重试；最终仍未标记成功时返回错误，此时 handle 已执行成功。
+func (d *Deduplicator) Process(
+	ctx context.Context, req *Request, handle func(ctx context.Context, req *Request) error,
+) error {
+	key, err := d.keyFunc(req)
+	if err != nil {
+		return fmt.Errorf("generate dedup key err: %v", err)
+	}
+
+	state, token, err := d.store.Acquire(ctx, key, d.lockTTL)
+	if err != nil {
+		return fmt.Errorf("acquire dedup key %s err: %v", key, err)
+	}
+	switch state {
+	case DedupDone:
+		return nil
+	case DedupProcessing:
+		return ErrNotifyProcessing
+	}
+
+	if err = handle(ctx, req); err != nil {
+		if releaseErr := d.store.Release(ctx, key, token); releaseErr != nil {
+			return fmt.Errorf("%w, release dedup key %s err: %v", err, key, releaseErr)
+		}
+		return err
+	}
+
+	if err = d.done(ctx, key, token); err != nil {
+		return fmt.Errorf("mark dedup key %s done err: %w", key, err)
+	}
+	return nil
+}
+
+// done 将去重键标记为已处理，失败时按指数退避重试
+func (d *Deduplicator) done(ctx context.Context, key, token string) (err error) {
+	interval := d.doneRetryInterval
+	for attempt := 1; ; attempt++ {
+		err = d.store.Done(ctx, key, token, d.doneTTL)
+		if err == nil || errors.Is(err, ErrDedupLockLost) || attempt >= d.doneMaxAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w, last err: %v", ctx.Err(), err)
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+type memoryDedupEntry struct {
+	state    DedupState
+	token    string
+	expireAt time.Time
+}
+
+// MemoryDedupStore 基于内存的去重存储，仅适用于单实例部署与测试
+//
+// 去重键的过期时间按 auth.Now(ctx) 计算，可通过 auth.WithClock 使用与验签相同的时钟
+type MemoryDedupStore struct {
+	entries  map[string]memoryDedupEntry
+	purgedAt time.Time
+	lock     sync.Mutex
+}
+
+// NewMemoryDedupStore 创建基于内存的去重存储
+func NewMemoryDedupStore() *MemoryDedupStore {
+	return &MemoryDedupStore{entries: make(map[string]memoryDedupEntry)}
+}
+
+// Acquire 尝试锁定去重键
+func (s *MemoryDedupStore) Acquire(ctx context.Context, key string, ttl time.Duration) (DedupState, string, error) {
+	token, err := utils.GenerateNonce()
+	if err != nil {
+		return 0, "", fmt.Errorf("generate dedup token err: %v", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := auth.Now(ctx)
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expireAt) {
+		return entry.state, "", nil
+	}
+
+	s.purge(now)
+	s.entries[key] = memoryDedupEntry{state: DedupProcessing, token: token, expireAt: now.Add(ttl)}
+	return DedupAcquired, token, nil
+}
+
+// Done 在去重键仍由 token 锁定时将其标记为已处理
+//
+// 锁定已过期但未被其他请求重新锁定（也未被清理）时，仍视为由 token 锁定。
+func (s *MemoryDedupStore) Done(ctx context.Context, key, token string, ttl time.Duration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.lockedBy(key, token) {
+		return ErrDedupLockLost
+	}
+	s.entries[key] = memoryDedupEntry{state: DedupDone, expireAt: auth.Now(ctx).Add(ttl)}
+	return nil
+}
+
+// Release 在去重键仍由 token 锁定时释放
+func (s *MemoryDedupStore) Release(_ context.Context, key, token string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.lockedBy(key, token) {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+// lockedBy 判断去重键是否由 token 锁定，调用方需持有锁
+func (s *MemoryDedupStore) lockedBy(key, token string) bool {
+	entry, ok := s.entries[key]
+	return ok && entry.state == DedupProcessing && token != "" && entry.token == token
+}
+
+// purge 每分钟最多清理一次已过期的去重键，调用方需持有锁
+func (s *MemoryDedupStore) purge(now time.Time) {
+	if now.Sub(s.purgedAt) < time.Minute {
+		return
+	}
+	s.purgedAt = now
+	for key, entry := range s.entries {
+		if !now.Before(entry.expireAt) {
+			delete(s.entries, key)
+		}
+	}
+}
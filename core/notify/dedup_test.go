@@ -0,0 +1,334 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+func newDedupRequest(id, eventType, plaintext string) *Request {
+	return &Request{ID: id, EventType: eventType, Resource: &EncryptedResource{Plaintext: plaintext}}
+}
+
+func TestDedupKeyByOutTradeNo(t *testing.T) {
+	key, err := DedupKeyByOutTradeNo(newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "TRANSACTION.SUCCESS:T1", key)
+
+	key, err = DedupKeyByOutTradeNo(
+		newDedupRequest("EV-2", "REFUND.SUCCESS", `{"out_trade_no":"T1","out_refund_no":"R1"}`),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "REFUND.SUCCESS:R1", key)
+
+	key, err = DedupKeyByOutTradeNo(
+		newDedupRequest("EV-5", "TRANSACTION.SUCCESS", `{"mchid":"1230000109","out_trade_no":"T1"}`),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "TRANSACTION.SUCCESS:1230000109:T1", key)
+
+	key, err = DedupKeyByOutTradeNo(newDedupRequest("EV-3", "PAYSCORE.USER_OPEN_SERVICE", `{"openid":"o1"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "EV-3", key)
+
+	_, err = DedupKeyByOutTradeNo(newDedupRequest("EV-4", "TRANSACTION.SUCCESS", `not json`))
+	assert.Error(t, err)
+
+	_, err = DedupKeyByEventID(&Request{})
+	assert.Error(t, err)
+}
+
+func TestDedupKeyByOutTradeNo_SubMerchants(t *testing.T) {
+	ctx := context.Background()
+	d := NewDeduplicator(NewMemoryDedupStore())
+
+	// 两个子商户使用了相同的商户订单号，各自的通知都应被处理
+	var processed []string
+	handler := func(ctx context.Context, req *Request) error {
+		processed = append(processed, req.ID)
+		return nil
+	}
+	requests := []*Request{
+		newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"sp_mchid":"1230000109","sub_mchid":"1900000109","out_trade_no":"T1"}`),
+		newDedupRequest("EV-2", "TRANSACTION.SUCCESS", `{"sp_mchid":"1230000109","sub_mchid":"1900000110","out_trade_no":"T1"}`),
+		newDedupRequest("EV-3", "TRANSACTION.SUCCESS", `{"sp_mchid":"1230000109","sub_mchid":"1900000109","out_trade_no":"T1"}`),
+	}
+	for _, req := range requests {
+		require.NoError(t, d.Process(ctx, req, handler))
+	}
+	assert.Equal(t, []string{"EV-1", "EV-2"}, processed)
+
+	key, err := DedupKeyByOutTradeNo(requests[1])
+	require.NoError(t, err)
+	assert.Equal(t, "TRANSACTION.SUCCESS:1230000109:1900000110:T1", key)
+}
+
+func TestDeduplicator_Process(t *testing.T) {
+	ctx := context.Background()
+	d := NewDeduplicator(NewMemoryDedupStore())
+
+	var calls int
+	handle := func(context.Context, *Request) error {
+		calls++
+		return nil
+	}
+
+	// 同一订单的不同通知只处理一次
+	require.NoError(t, d.Process(ctx, newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`), handle))
+	require.NoError(t, d.Process(ctx, newDedupRequest("EV-2", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`), handle))
+	assert.Equal(t, 1, calls)
+
+	// 不同的业务事件分别处理
+	require.NoError(t, d.Process(ctx, newDedupRequest("EV-3", "TRANSACTION.SUCCESS", `{"out_trade_no":"T2"}`), handle))
+	assert.Equal(t, 2, calls)
+}
+
+func TestDeduplicator_ProcessFailed(t *testing.T) {
+	ctx := context.Background()
+	d := NewDeduplicator(NewMemoryDedupStore(), WithDedupKeyFunc(DedupKeyByEventID))
+	req := newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`)
+
+	handleErr := fmt.Errorf("database unavailable")
+	err := d.Process(ctx, req, func(context.Context, *Request) error { return handleErr })
+	assert.Equal(t, handleErr, err)
+
+	// 处理失败后重发的通知可以再次处理
+	var calls int
+	require.NoError(t, d.Process(ctx, req, func(context.Context, *Request) error {
+		calls++
+		return nil
+	}))
+	assert.Equal(t, 1, calls)
+}
+
+func TestDeduplicator_ProcessConcurrently(t *testing.T) {
+	ctx := context.Background()
+	d := NewDeduplicator(NewMemoryDedupStore())
+	req := newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`)
+
+	var (
+		calls      int32
+		processing int32
+		wg         sync.WaitGroup
+		release    = make(chan struct{})
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := d.Process(ctx, req, func(context.Context, *Request) error {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return nil
+			})
+			if err == ErrNotifyProcessing {
+				atomic.AddInt32(&processing, 1)
+			}
+		}()
+	}
+
+	// 等待其余请求全部返回 ErrNotifyProcessing 后再结束处理
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&processing) == 9 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+// flakyDedupStore 在前 failures 次标记去重键时返回错误
+type flakyDedupStore struct {
+	*MemoryDedupStore
+	failures  int
+	doneCalls int
+}
+
+func (s *flakyDedupStore) Done(ctx context.Context, key, token string, ttl time.Duration) error {
+	s.doneCalls++
+	if s.doneCalls <= s.failures {
+		return fmt.Errorf("connection refused")
+	}
+	return s.MemoryDedupStore.Done(ctx, key, token, ttl)
+}
+
+func TestDeduplicator_ProcessDoneRetry(t *testing.T) {
+	ctx := context.Background()
+	store := &flakyDedupStore{MemoryDedupStore: NewMemoryDedupStore(), failures: 2}
+	d := NewDeduplicator(store, WithDedupDoneRetry(3, time.Millisecond))
+	req := newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`)
+
+	var calls int
+	handle := func(context.Context, *Request) error {
+		calls++
+		return nil
+	}
+	require.NoError(t, d.Process(ctx, req, handle))
+	assert.Equal(t, 3, store.doneCalls)
+
+	// 标记成功后不再处理
+	require.NoError(t, d.Process(ctx, req, handle))
+	assert.Equal(t, 1, calls)
+}
+
+func TestDeduplicator_ProcessDoneRetryExhausted(t *testing.T) {
+	store := &flakyDedupStore{MemoryDedupStore: NewMemoryDedupStore(), failures: 3}
+	d := NewDeduplicator(store, WithDedupDoneRetry(3, time.Millisecond))
+	req := newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`)
+
+	err := d.Process(context.Background(), req, func(context.Context, *Request) error { return nil })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection refused")
+	assert.Equal(t, 3, store.doneCalls)
+}
+
+func TestDeduplicator_ProcessDoneRetryContextCanceled(t *testing.T) {
+	store := &flakyDedupStore{MemoryDedupStore: NewMemoryDedupStore(), failures: 1}
+	d := NewDeduplicator(store, WithDedupDoneRetry(3, time.Hour))
+	req := newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := d.Process(ctx, req, func(context.Context, *Request) error { return nil })
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 1, store.doneCalls)
+}
+
+func TestDeduplicator_ProcessLockLost(t *testing.T) {
+	now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+	store := &flakyDedupStore{MemoryDedupStore: NewMemoryDedupStore()}
+	d := NewDeduplicator(store, WithDedupKeyFunc(DedupKeyByEventID), WithDedupLockTTL(time.Minute))
+	req := newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`)
+
+	var calls int
+	handle := func(context.Context, *Request) error {
+		calls++
+		return nil
+	}
+	err := d.Process(ctx, req, func(ctx context.Context, req *Request) error {
+		calls++
+		// 处理时间超过锁定时间，重发的通知重新锁定去重键并处理成功
+		now = now.Add(time.Minute)
+		require.NoError(t, d.Process(ctx, req, handle))
+		return nil
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrDedupLockLost))
+	assert.Equal(t, 2, calls)
+	// 锁定已失效时不重试标记
+	assert.Equal(t, 2, store.doneCalls)
+
+	require.NoError(t, d.Process(ctx, req, handle))
+	assert.Equal(t, 2, calls)
+}
+
+func TestDeduplicator_ProcessStaleReleaseKeepsNewLock(t *testing.T) {
+	now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+	store := NewMemoryDedupStore()
+	d := NewDeduplicator(store, WithDedupKeyFunc(DedupKeyByEventID), WithDedupLockTTL(time.Minute))
+	req := newDedupRequest("EV-1", "TRANSACTION.SUCCESS", `{"out_trade_no":"T1"}`)
+
+	var token string
+	err := d.Process(ctx, req, func(ctx context.Context, req *Request) error {
+		// 处理时间超过锁定时间，其他请求重新锁定了去重键
+		now = now.Add(time.Minute)
+		state, newToken, err := store.Acquire(ctx, "EV-1", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, DedupAcquired, state)
+		token = newToken
+		return fmt.Errorf("timeout")
+	})
+	assert.EqualError(t, err, "timeout")
+
+	// 超时的请求释放去重键时，不会删除其他请求的锁定
+	assert.Equal(t, ErrNotifyProcessing, d.Process(ctx, req, func(context.Context, *Request) error { return nil }))
+	require.NoError(t, store.Done(ctx, "EV-1", token, time.Hour))
+}
+
+func TestMemoryDedupStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryDedupStore()
+
+	state, token, err := store.Acquire(ctx, "k", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, DedupAcquired, state)
+	assert.NotEmpty(t, token)
+
+	state, other, err := store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupProcessing, state)
+	assert.Empty(t, other)
+
+	// 锁定过期后可以重新锁定，原令牌失效
+	time.Sleep(2 * time.Millisecond)
+	state, newToken, err := store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupAcquired, state)
+	assert.NotEqual(t, token, newToken)
+	assert.Equal(t, ErrDedupLockLost, store.Done(ctx, "k", token, time.Minute))
+	require.NoError(t, store.Release(ctx, "k", token))
+	state, _, err = store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupProcessing, state)
+
+	require.NoError(t, store.Done(ctx, "k", newToken, time.Minute))
+	state, _, err = store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupDone, state)
+
+	// 已处理的去重键不能再次标记或释放
+	assert.Equal(t, ErrDedupLockLost, store.Done(ctx, "k", newToken, time.Minute))
+	require.NoError(t, store.Release(ctx, "k", newToken))
+	state, _, err = store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupDone, state)
+
+	state, token, err = store.Acquire(ctx, "k2", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupAcquired, state)
+	require.NoError(t, store.Release(ctx, "k2", token))
+	state, _, err = store.Acquire(ctx, "k2", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupAcquired, state)
+}
+
+func TestMemoryDedupStore_Clock(t *testing.T) {
+	now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+	store := NewMemoryDedupStore()
+
+	state, _, err := store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupAcquired, state)
+
+	// 过期时间按 ctx 中的时钟计算，与系统时间无关
+	now = now.Add(59 * time.Second)
+	state, _, err = store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupProcessing, state)
+
+	now = now.Add(time.Second)
+	state, token, err := store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupAcquired, state)
+
+	require.NoError(t, store.Done(ctx, "k", token, time.Hour))
+	now = now.Add(time.Hour - time.Second)
+	state, _, err = store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupDone, state)
+
+	now = now.Add(time.Second)
+	state, _, err = store.Acquire(ctx, "k", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, DedupAcquired, state)
+}
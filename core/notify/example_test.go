@@ -40,3 +40,137 @@ func ExampleHandler_ParseNotifyRequest_no_model() {
 	fmt.Println(notifyReq.Summary)
 	fmt.Println(content)
 }
+
+func ExampleNewForwarder() {
+	var handler *notify.Handler
+	// 假设 produce 为消息队列客户端的同步发送方法，如 Kafka 的 SyncProducer.SendMessage，
+	// NSQ 的 Producer.Publish 或开启了 publisher confirm 的 RabbitMQ Channel.Publish
+	var produce func(topic, key string, value []byte) error
+
+	publisher := notify.PublisherFunc(func(ctx context.Context, message *notify.Message) error {
+		value, err := message.Marshal()
+		if err != nil {
+			return notify.PoisonError(err)
+		}
+		// 以通知 ID 作为消息 Key，便于消费方去重
+		return produce("wechatpay-notify", message.ID, value)
+	})
+	deadLetter := notify.PublisherFunc(func(ctx context.Context, message *notify.Message) error {
+		value, err := message.Marshal()
+		if err != nil {
+			return err
+		}
+		return produce("wechatpay-notify-dead-letter", message.ID, value)
+	})
+
+	forwarder := notify.NewForwarder(handler, publisher, notify.WithDeadLetterPublisher(deadLetter))
+	http.Handle("/wechatpay/notify", forwarder)
+}
+
+func ExampleDeduplicator_Process() {
+	var handler notify.Handler
+	var request *http.Request
+	// 多实例部署时，请使用基于 Redis 等共享存储实现的 notify.DedupStore
+	deduplicator := notify.NewDeduplicator(notify.NewMemoryDedupStore())
+
+	content := new(payments.Transaction)
+	notifyReq, err := handler.ParseNotifyRequest(context.Background(), request, content)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	err = deduplicator.Process(context.Background(), notifyReq, func(ctx context.Context, req *notify.Request) error {
+		// 处理通知内容，同一订单的支付成功通知不会被并发处理，处理成功后重复的通知将被跳过
+		fmt.Println(content)
+		return nil
+	})
+	if err != nil {
+		// 处理失败或同一通知正在处理中，应答失败以便微信支付稍后重试
+		fmt.Println(err)
+	}
+}
+
+func ExampleHandler_HTTPHandler() {
+	var handler *notify.Handler
+
+	http.Handle("/wechatpay/notify", handler.HTTPHandler(func(ctx context.Context, req *notify.Request) error {
+		content := new(payments.Transaction)
+		if err := req.UnmarshalContent(content); err != nil {
+			return notify.Reject(err)
+		}
+
+		// 处理通知内容，返回 nil 时应答成功
+		// 暂时无法处理时返回 notify.Retry(err)，微信支付将稍后重发通知
+		// 永久无法处理时返回 notify.Reject(err)，微信支付将不再重发通知
+		fmt.Println(content)
+		return nil
+	}))
+}
+
+func ExampleRouter() {
+	var handler *notify.Handler
+
+	router := notify.NewRouter()
+	router.Handle("TRANSACTION.SUCCESS", func(ctx context.Context, req *notify.Request) error {
+		transaction := new(payments.Transaction)
+		if err := req.UnmarshalContent(transaction); err != nil {
+			return notify.Reject(err)
+		}
+		// 处理支付成功通知
+		return nil
+	})
+	router.HandlePrefix("REFUND.", func(ctx context.Context, req *notify.Request) error {
+		// 处理退款成功、退款异常与退款关闭通知
+		return nil
+	})
+
+	http.Handle("/notify", handler.HTTPHandler(router.Dispatch))
+}
+
+func ExampleRegistry() {
+	registry := notify.NewRegistry()
+	registry.Register("TRANSACTION.SUCCESS", new(payments.Transaction))
+
+	router := notify.NewRouter()
+	router.HandleDefault(registry.HandleFunc(
+		func(ctx context.Context, req *notify.Request, content *notify.Content) error {
+			switch value := content.Value.(type) {
+			case *payments.Transaction:
+				fmt.Println(*value.OutTradeNo, *value.TradeState)
+			case notify.ContentMap:
+				// 未注册的通知类型，可在运行时调用 registry.Register 注册
+				fmt.Println("unregistered event type", content.EventType)
+			}
+			// SDK 尚未定义的字段
+			for key, value := range content.Unknown {
+				fmt.Println(key, string(value))
+			}
+			return nil
+		},
+	))
+
+	_ = router.Dispatch(context.Background(), &notify.Request{
+		EventType: "TRANSACTION.SUCCESS",
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"out_trade_no":"1217752501201407033233368018","trade_state":"SUCCESS","new_field":"value"}`,
+		},
+	})
+	// Output:
+	// 1217752501201407033233368018 SUCCESS
+	// new_field "value"
+}
+
+func ExampleDecryptNotifyResource() {
+	// 使用测试数据演示，实际使用时传入商户 APIv3 密钥与日志中记录的通知请求体或 resource
+	vector := notify.ResourceTestVectors()[0]
+
+	resource, err := notify.DecryptNotifyResource(vector.APIv3Key, vector.Resource())
+	fmt.Println(err, resource.Plaintext == vector.Plaintext)
+
+	_, err = notify.DecryptNotifyResource("00000000000000000000000000000000", vector.Resource())
+	fmt.Println(err)
+	// Output:
+	// <nil> true
+	// decrypt notify resource err: cipher: message authentication failed, please check the apiv3 key
+}
@@ -0,0 +1,226 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Message 转发到消息队列的微信支付通知
+//
+// 在至少一次（at-least-once）的投递语义下，同一通知可能被投递多次，消费方应使用 ID 进行幂等处理。
+type Message struct {
+	ID           string     `json:"id"`
+	CreateTime   *time.Time `json:"create_time,omitempty"`
+	EventType    string     `json:"event_type"`
+	ResourceType string     `json:"resource_type,omitempty"`
+	Summary      string     `json:"summary,omitempty"`
+	OriginalType string     `json:"original_type,omitempty"`
+	// Plaintext 解密后的通知内容，为 JSON 格式
+	Plaintext string `json:"plaintext,omitempty"`
+
+	// Raw 原始通知请求体，仅在投递到死信队列时设置
+	Raw string `json:"raw,omitempty"`
+	// Error 通知无法被正常处理的原因，仅在投递到死信队列时设置
+	Error string `json:"error,omitempty"`
+}
+
+// Marshal 将消息序列化为 JSON，可直接作为消息队列的消息体
+func (m *Message) Marshal() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// Publisher 消息发布器，用于将通知投递到 Kafka/NSQ/RabbitMQ 等消息队列
+//
+// Publish 返回 nil 时，应保证消息已被消息队列确认（如 Kafka 的 acks、RabbitMQ 的 publisher confirm）。
+// 对于重试也无法成功的消息（如消息体超过限制），应使用 PoisonError 包装返回的错误，该消息将被投递到死信队列。
+type Publisher interface {
+	Publish(ctx context.Context, message *Message) error
+}
+
+// PublisherFunc 将函数适配为 Publisher
+type PublisherFunc func(ctx context.Context, message *Message) error
+
+// Publish 调用 f(ctx, message)
+func (f PublisherFunc) Publish(ctx context.Context, message *Message) error {
+	return f(ctx, message)
+}
+
+type poisonError struct {
+	err error
+}
+
+func (e *poisonError) Error() string {
+	return e.err.Error()
+}
+
+func (e *poisonError) Unwrap() error {
+	return e.err
+}
+
+// PoisonError 将 err 标记为不可重试的错误，Forwarder 收到该错误后不再重试，直接将消息投递到死信队列
+func PoisonError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &poisonError{err: err}
+}
+
+// IsPoisonError 判断 err 是否为不可重试的错误
+func IsPoisonError(err error) bool {
+	var e *poisonError
+	return errors.As(err, &e)
+}
+
+const (
+	defaultMaxAttempts   = 3
+	defaultRetryInterval = 100 * time.Millisecond
+)
+
+// Forwarder 将验签并解密后的微信支付通知转发到消息队列，实现了 http.Handler，可直接作为通知地址的处理器
+//
+// 仅当消息被成功投递后才向微信支付应答成功，否则应答失败，由微信支付重新发送通知，以此实现至少一次的投递语义。
+// 无法解析的通知（毒消息，包括解密后的通知内容不是 JSON 的通知）以及被 Publisher 标记为 PoisonError 的消息，
+// 将被投递到死信队列后应答成功，避免微信支付无意义的重试。
+// 解密失败的通知通常是 APIv3 密钥配置错误或正在更换导致的，修正后重试即可成功，因此直接应答失败，由微信支付重新发送。
+// 验签失败的请求不会被转发。
+type Forwarder struct {
+	handler       *Handler
+	publisher     Publisher
+	deadLetter    Publisher
+	maxAttempts   int
+	retryInterval time.Duration
+}
+
+// ForwarderOption Forwarder 的配置项
+type ForwarderOption func(f *Forwarder)
+
+// WithDeadLetterPublisher 设置死信队列的消息发布器。未设置时，毒消息将应答失败且不会被转发
+func WithDeadLetterPublisher(publisher Publisher) ForwarderOption {
+	return func(f *Forwarder) {
+		f.deadLetter = publisher
+	}
+}
+
+// WithMaxAttempts 设置单次通知中投递消息的最大尝试次数，默认为 3 次
+func WithMaxAttempts(maxAttempts int) ForwarderOption {
+	return func(f *Forwarder) {
+		if maxAttempts > 0 {
+			f.maxAttempts = maxAttempts
+		}
+	}
+}
+
+// WithRetryInterval 设置投递失败后的重试间隔，每次重试间隔翻倍，默认为 100ms
+func WithRetryInterval(interval time.Duration) ForwarderOption {
+	return func(f *Forwarder) {
+		if interval >= 0 {
+			f.retryInterval = interval
+		}
+	}
+}
+
+// NewForwarder 使用通知处理器与消息发布器创建 Forwarder
+func NewForwarder(handler *Handler, publisher Publisher, opts ...ForwarderOption) *Forwarder {
+	f := &Forwarder{
+		handler:       handler,
+		publisher:     publisher,
+		maxAttempts:   defaultMaxAttempts,
+		retryInterval: defaultRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// ServeHTTP 处理微信支付通知请求
+func (f *Forwarder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := withRequestInfo(r)
+	body, err := getRequestBody(r)
+	if err != nil {
+		writeResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err = f.handler.validator.Validate(ctx, r.Header, body); err != nil {
+		writeResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	req, err := f.handler.decryptRequest(body)
+	if err == nil && !json.Valid([]byte(req.Resource.Plaintext)) {
+		err = fmt.Errorf("parse notify plaintext error: invalid json")
+	} else if err != nil && req != nil && req.Resource != nil {
+		// 通知已成功解析，仅解密失败
+		writeResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	if err != nil {
+		f.handlePoison(ctx, w, &Message{Raw: string(body), Error: err.Error()}, http.StatusBadRequest, err)
+		return
+	}
+
+	message := newMessage(req)
+	if err = f.publish(ctx, f.publisher, message); err != nil {
+		if IsPoisonError(err) {
+			message.Raw, message.Error = string(body), err.Error()
+			f.handlePoison(ctx, w, message, http.StatusInternalServerError, err)
+			return
+		}
+		writeResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, nil)
+}
+
+// handlePoison 将毒消息投递到死信队列。未设置死信队列或投递失败时，使用 failStatus 应答失败
+func (f *Forwarder) handlePoison(
+	ctx context.Context, w http.ResponseWriter, message *Message, failStatus int, cause error,
+) {
+	if f.deadLetter == nil {
+		writeResponse(w, failStatus, cause)
+		return
+	}
+	if err := f.publish(ctx, f.deadLetter, message); err != nil {
+		writeResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeResponse(w, http.StatusOK, nil)
+}
+
+// publish 投递消息，失败时按指数退避重试，直至成功、达到最大尝试次数或遇到不可重试的错误
+func (f *Forwarder) publish(ctx context.Context, publisher Publisher, message *Message) error {
+	interval := f.retryInterval
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = publisher.Publish(ctx, message); err == nil || IsPoisonError(err) || attempt >= f.maxAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+func newMessage(req *Request) *Message {
+	return &Message{
+		ID:           req.ID,
+		CreateTime:   req.CreateTime,
+		EventType:    req.EventType,
+		ResourceType: req.ResourceType,
+		Summary:      req.Summary,
+		OriginalType: req.Resource.OriginalType,
+		Plaintext:    req.Resource.Plaintext,
+	}
+}
@@ -0,0 +1,214 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testForwarderAPIv3Key = "testMchAPIv3Key0testMchAPIv3Key0"
+	testForwarderNonce    = "Kj7QIyUiYx1q"
+	testForwarderContent  = `{"out_trade_no":"1217752501201407033233368018","trade_state":"SUCCESS"}`
+)
+
+type mockVerifier struct {
+	err error
+}
+
+func (v *mockVerifier) Verify(context.Context, string, string, string) error {
+	return v.err
+}
+
+type recordPublisher struct {
+	errs     []error
+	messages []*Message
+}
+
+func (p *recordPublisher) Publish(_ context.Context, message *Message) error {
+	p.messages = append(p.messages, message)
+	if len(p.errs) == 0 {
+		return nil
+	}
+	err := p.errs[0]
+	p.errs = p.errs[1:]
+	return err
+}
+
+func encryptForTest(t *testing.T, plaintext string) string {
+	block, err := aes.NewCipher([]byte(testForwarderAPIv3Key))
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nil, []byte(testForwarderNonce), []byte(plaintext), nil))
+}
+
+func newForwarderRequest(t *testing.T, ciphertext string) *http.Request {
+	body := fmt.Sprintf(
+		`{"id":"EV-2018022511223320873","create_time":"2015-05-20T13:29:35+08:00","resource_type":"encrypt-resource",`+
+			`"event_type":"TRANSACTION.SUCCESS","summary":"支付成功","resource":{"original_type":"transaction",`+
+			`"algorithm":"AEAD_AES_256_GCM","ciphertext":"%s","associated_data":"","nonce":"%s"}}`,
+		ciphertext, testForwarderNonce,
+	)
+	req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1/notify", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set("Wechatpay-Serial", "D7CE59D1F522D701")
+	req.Header.Set("Wechatpay-Signature", "fake signature")
+	req.Header.Set("Wechatpay-Nonce", "EcZ9Cmy4Xyx1i6RlJQzLcCyEqDa26NBz")
+	req.Header.Set("Wechatpay-Timestamp", strconv.FormatInt(time.Now().Unix(), 10))
+	return req
+}
+
+func serveForwarder(f *Forwarder, req *http.Request) (int, string) {
+	recorder := httptest.NewRecorder()
+	f.ServeHTTP(recorder, req)
+
+	resp := struct {
+		Code string `json:"code"`
+	}{}
+	_ = json.Unmarshal(recorder.Body.Bytes(), &resp)
+	return recorder.Code, resp.Code
+}
+
+func TestForwarder_ServeHTTP(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+
+	t.Run("publish success", func(t *testing.T) {
+		publisher := &recordPublisher{}
+		f := NewForwarder(handler, publisher)
+
+		status, code := serveForwarder(f, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "SUCCESS", code)
+		require.Len(t, publisher.messages, 1)
+
+		message := publisher.messages[0]
+		assert.Equal(t, "EV-2018022511223320873", message.ID)
+		assert.Equal(t, "TRANSACTION.SUCCESS", message.EventType)
+		assert.Equal(t, "transaction", message.OriginalType)
+		assert.Equal(t, testForwarderContent, message.Plaintext)
+		assert.Empty(t, message.Raw)
+	})
+
+	t.Run("retry until success", func(t *testing.T) {
+		publisher := &recordPublisher{errs: []error{fmt.Errorf("broker unavailable"), fmt.Errorf("broker unavailable")}}
+		f := NewForwarder(handler, publisher, WithRetryInterval(0))
+
+		status, _ := serveForwarder(f, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, publisher.messages, 3)
+	})
+
+	t.Run("publish failed", func(t *testing.T) {
+		publisher := &recordPublisher{errs: []error{
+			fmt.Errorf("broker unavailable"), fmt.Errorf("broker unavailable"), fmt.Errorf("broker unavailable"),
+		}}
+		deadLetter := &recordPublisher{}
+		f := NewForwarder(handler, publisher, WithMaxAttempts(2), WithRetryInterval(0), WithDeadLetterPublisher(deadLetter))
+
+		status, code := serveForwarder(f, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+		assert.Equal(t, http.StatusInternalServerError, status)
+		assert.Equal(t, "FAIL", code)
+		assert.Len(t, publisher.messages, 2)
+		assert.Empty(t, deadLetter.messages, "transient failures should be retried by wechatpay, not dead lettered")
+	})
+
+	t.Run("publisher reports poison", func(t *testing.T) {
+		publisher := &recordPublisher{errs: []error{PoisonError(fmt.Errorf("message too large"))}}
+		deadLetter := &recordPublisher{}
+		f := NewForwarder(handler, publisher, WithDeadLetterPublisher(deadLetter))
+
+		status, _ := serveForwarder(f, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, publisher.messages, 1)
+		require.Len(t, deadLetter.messages, 1)
+		assert.Equal(t, "message too large", deadLetter.messages[0].Error)
+		assert.NotEmpty(t, deadLetter.messages[0].Raw)
+	})
+
+	t.Run("undecryptable notify", func(t *testing.T) {
+		publisher := &recordPublisher{}
+		deadLetter := &recordPublisher{}
+		f := NewForwarder(handler, publisher, WithDeadLetterPublisher(deadLetter))
+
+		status, code := serveForwarder(f, newForwarderRequest(t, "bad ciphertext"))
+		assert.Equal(t, http.StatusInternalServerError, status, "decrypt failures should be retried by wechatpay")
+		assert.Equal(t, "FAIL", code)
+		assert.Empty(t, publisher.messages)
+		assert.Empty(t, deadLetter.messages)
+	})
+
+	t.Run("undecodable plaintext", func(t *testing.T) {
+		publisher := &recordPublisher{}
+		deadLetter := &recordPublisher{}
+		f := NewForwarder(handler, publisher, WithDeadLetterPublisher(deadLetter))
+
+		status, _ := serveForwarder(f, newForwarderRequest(t, encryptForTest(t, "not json")))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, publisher.messages)
+		require.Len(t, deadLetter.messages, 1)
+		assert.Contains(t, deadLetter.messages[0].Error, "parse notify plaintext error")
+		assert.NotEmpty(t, deadLetter.messages[0].Raw)
+
+		f = NewForwarder(handler, publisher)
+		status, code := serveForwarder(f, newForwarderRequest(t, encryptForTest(t, "not json")))
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "FAIL", code)
+	})
+
+	t.Run("unparsable notify", func(t *testing.T) {
+		for _, body := range []string{`not json`, `{"id":"EV-2018022511223320873"}`} {
+			publisher := &recordPublisher{}
+			deadLetter := &recordPublisher{}
+			f := NewForwarder(handler, publisher, WithDeadLetterPublisher(deadLetter))
+
+			req := newForwarderRequest(t, "")
+			req.Body = ioutil.NopCloser(strings.NewReader(body))
+			status, _ := serveForwarder(f, req)
+			assert.Equal(t, http.StatusOK, status, body)
+			assert.Empty(t, publisher.messages, body)
+			require.Len(t, deadLetter.messages, 1, body)
+			assert.Contains(t, deadLetter.messages[0].Error, "parse request body error", body)
+			assert.Equal(t, body, deadLetter.messages[0].Raw)
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		publisher := &recordPublisher{}
+		deadLetter := &recordPublisher{}
+		f := NewForwarder(
+			NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{err: fmt.Errorf("verify failed")}),
+			publisher, WithDeadLetterPublisher(deadLetter),
+		)
+
+		status, code := serveForwarder(f, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "FAIL", code)
+		assert.Empty(t, publisher.messages)
+		assert.Empty(t, deadLetter.messages)
+	})
+}
+
+func TestPoisonError(t *testing.T) {
+	assert.Nil(t, PoisonError(nil))
+	assert.False(t, IsPoisonError(fmt.Errorf("transient")))
+
+	err := PoisonError(fmt.Errorf("message too large"))
+	assert.True(t, IsPoisonError(err))
+	assert.True(t, IsPoisonError(fmt.Errorf("publish err: %w", err)))
+	assert.Equal(t, "message too large", err.Error())
+}
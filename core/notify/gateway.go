@@ -0,0 +1,124 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GatewayEvent API 网关触发云函数时传入的 HTTP 请求事件
+//
+// 字段与 AWS API Gateway（REST API 及 HTTP API 1.0 格式）、腾讯云 SCF API 网关触发器的事件一致，
+// 云函数可以直接以本结构接收事件，无需依赖云厂商的 SDK。HTTP API 2.0 格式的事件同样可以解析，其请求方法视为 POST
+type GatewayEvent struct {
+	HTTPMethod        string              `json:"httpMethod"`
+	Path              string              `json:"path"`
+	Headers           map[string]string   `json:"headers"`
+	MultiValueHeaders map[string][]string `json:"multiValueHeaders"`
+	Body              string              `json:"body"`
+	IsBase64Encoded   bool                `json:"isBase64Encoded"`
+}
+
+// GatewayResponse 云函数返回给 API 网关的 HTTP 应答
+type GatewayResponse struct {
+	StatusCode      int               `json:"statusCode"`
+	Headers         map[string]string `json:"headers"`
+	Body            string            `json:"body"`
+	IsBase64Encoded bool              `json:"isBase64Encoded"`
+}
+
+// ServeGatewayEvent 将 API 网关事件转换为 http.Request 交由 handler 处理，并将处理结果转换为 API 网关应答
+//
+// handler 通常为 Handler.HTTPHandler 或 Forwarder，由其完成验签、解密与应答：
+//
+//	httpHandler := handler.HTTPHandler(router.Dispatch)
+//	lambda.Start(func(ctx context.Context, event notify.GatewayEvent) (*notify.GatewayResponse, error) {
+//		return notify.ServeGatewayEvent(ctx, httpHandler, &event)
+//	})
+//
+// 仅在事件无法转换为 HTTP 请求时返回错误，此时 API 网关将应答失败，微信支付会稍后重发通知。
+func ServeGatewayEvent(ctx context.Context, handler http.Handler, event *GatewayEvent) (*GatewayResponse, error) {
+	request, err := event.toRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &gatewayResponseWriter{header: make(http.Header)}
+	handler.ServeHTTP(w, request)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	resp := &GatewayResponse{
+		StatusCode: w.status,
+		Headers:    make(map[string]string, len(w.header)),
+		Body:       w.body.String(),
+	}
+	for key, values := range w.header {
+		resp.Headers[key] = strings.Join(values, ", ")
+	}
+	return resp, nil
+}
+
+// toRequest 将事件转换为 http.Request，多值请求头优先于单值请求头
+func (e *GatewayEvent) toRequest(ctx context.Context) (*http.Request, error) {
+	body := []byte(e.Body)
+	if e.IsBase64Encoded {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(e.Body); err != nil {
+			return nil, fmt.Errorf("decode gateway event body err: %v", err)
+		}
+	}
+
+	method := e.HTTPMethod
+	if method == "" {
+		method = http.MethodPost
+	}
+	path := e.Path
+	if path == "" {
+		path = "/"
+	}
+	request, err := http.NewRequestWithContext(ctx, method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request from gateway event err: %v", err)
+	}
+
+	for key, values := range e.MultiValueHeaders {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+	for key, value := range e.Headers {
+		if _, ok := request.Header[http.CanonicalHeaderKey(key)]; !ok {
+			request.Header.Set(key, value)
+		}
+	}
+	return request, nil
+}
+
+// gatewayResponseWriter 记录 handler 的应答
+type gatewayResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *gatewayResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *gatewayResponseWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(p)
+}
+
+func (w *gatewayResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGatewayEvent 使用 newForwarderRequest 构造的通知请求生成 API 网关事件
+func newGatewayEvent(t *testing.T, base64Encoded bool) *GatewayEvent {
+	req := newForwarderRequest(t, encryptForTest(t, testForwarderContent))
+	body, err := ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+
+	event := &GatewayEvent{
+		HTTPMethod: http.MethodPost,
+		Path:       "/notify",
+		Headers:    map[string]string{},
+		Body:       string(body),
+	}
+	for key := range req.Header {
+		event.Headers[key] = req.Header.Get(key)
+	}
+	// API 网关可能将请求头转为小写
+	event.Headers["wechatpay-nonce"] = event.Headers["Wechatpay-Nonce"]
+	delete(event.Headers, "Wechatpay-Nonce")
+	if base64Encoded {
+		event.Body = base64.StdEncoding.EncodeToString(body)
+		event.IsBase64Encoded = true
+	}
+	return event
+}
+
+func TestServeGatewayEvent(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+
+	var outTradeNo string
+	h := handler.HTTPHandler(func(ctx context.Context, req *Request) error {
+		content := struct {
+			OutTradeNo string `json:"out_trade_no"`
+		}{}
+		if err := req.UnmarshalContent(&content); err != nil {
+			return Reject(err)
+		}
+		outTradeNo = content.OutTradeNo
+		return nil
+	})
+
+	for _, base64Encoded := range []bool{false, true} {
+		outTradeNo = ""
+		resp, err := ServeGatewayEvent(context.Background(), h, newGatewayEvent(t, base64Encoded))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Headers["Content-Type"])
+		assert.JSONEq(t, `{"code":"SUCCESS","message":"成功"}`, resp.Body)
+		assert.Equal(t, "1217752501201407033233368018", outTradeNo)
+	}
+
+	// 处理失败时应答 500，由微信支付重发
+	resp, err := ServeGatewayEvent(context.Background(), handler.HTTPHandler(func(ctx context.Context, req *Request) error {
+		return fmt.Errorf("database unavailable")
+	}), newGatewayEvent(t, false))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	// 验签失败
+	resp, err = ServeGatewayEvent(context.Background(),
+		NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{err: fmt.Errorf("bad signature")}).HTTPHandler(
+			func(ctx context.Context, req *Request) error { return nil },
+		), newGatewayEvent(t, false))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	event := newGatewayEvent(t, false)
+	event.IsBase64Encoded = true
+	_, err = ServeGatewayEvent(context.Background(), h, event)
+	assert.Error(t, err)
+}
+
+func TestGatewayEvent_Unmarshal(t *testing.T) {
+	event := new(GatewayEvent)
+	require.NoError(t, json.Unmarshal([]byte(`{"httpMethod":"POST","path":"/notify",`+
+		`"headers":{"wechatpay-serial":"D7CE59D1F522D701"},"multiValueHeaders":{"Wechatpay-Serial":["D7CE59D1F522D701"]},`+
+		`"body":"{}","isBase64Encoded":false}`), event))
+
+	req, err := event.toRequest(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"D7CE59D1F522D701"}, req.Header["Wechatpay-Serial"])
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 
@@ -15,8 +16,9 @@ import (
 
 // Handler 微信支付通知 Handler
 type Handler struct {
-	mchAPIv3Key string
-	validator   validators.WechatPayNotifyValidator
+	mchAPIv3Key  string
+	apiV3KeyFunc func() string
+	validator    validators.WechatPayNotifyValidator
 }
 
 // ParseNotifyRequest 从 HTTP 请求(http.Request) 中解析 微信支付通知(notify.Request)
@@ -32,27 +34,83 @@ func (h *Handler) ParseNotifyRequest(ctx context.Context, request *http.Request,
 		return nil, fmt.Errorf("not valid wechatpay notify request: %v", err)
 	}
 
-	ret := new(Request)
-	if err = json.Unmarshal(body, ret); err != nil {
-		return nil, fmt.Errorf("parse request body error: %v", err)
+	ret, err := h.decryptRequest(body)
+	if err != nil {
+		return ret, err
 	}
 
-	plaintext, err := utils.DecryptAES256GCM(
-		h.mchAPIv3Key, ret.Resource.AssociatedData, ret.Resource.Nonce, ret.Resource.Ciphertext,
-	)
+	if err = json.Unmarshal([]byte(ret.Resource.Plaintext), &content); err != nil {
+		return ret, fmt.Errorf("unmarshal plaintext to content failed: %v", err)
+	}
+
+	return ret, nil
+}
+
+// ParseNotifyRequestStream 与 ParseNotifyRequest 相同，但以流的方式读取通知请求体，在读取的同时解析与验签
+//
+// 适用于投诉列表、批量转账明细等包体较大的通知：验签时边读取边计算摘要，无需另外缓存完整的请求体与签名原文。
+// 验签在请求体读取完毕后完成，验签通过前不会解密或返回通知内容。
+func (h *Handler) ParseNotifyRequestStream(ctx context.Context, request *http.Request, content interface{}) (
+	*Request, error,
+) {
+	body, err := h.validator.ValidateStream(ctx, request.Header, request.Body)
 	if err != nil {
-		return ret, fmt.Errorf("decrypt request error: %v", err)
+		return nil, fmt.Errorf("not valid wechatpay notify request: %v", err)
 	}
+	defer func() { _ = request.Body.Close() }()
 
-	ret.Resource.Plaintext = plaintext
+	ret := new(Request)
+	decodeErr := json.NewDecoder(body).Decode(ret)
+	// 读取剩余的请求体以完成验签，验签失败时的错误优先于解析错误
+	if _, err = io.Copy(ioutil.Discard, body); err != nil {
+		return nil, fmt.Errorf("not valid wechatpay notify request: %v", err)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("parse request body error: %v", decodeErr)
+	}
 
-	if err = json.Unmarshal([]byte(plaintext), &content); err != nil {
+	if err = h.decryptResource(ret); err != nil {
+		return ret, err
+	}
+	if err = json.Unmarshal([]byte(ret.Resource.Plaintext), &content); err != nil {
 		return ret, fmt.Errorf("unmarshal plaintext to content failed: %v", err)
 	}
+	return ret, nil
+}
 
+// decryptRequest 解析已通过验签的通知请求体，并解密其中的通知内容
+func (h *Handler) decryptRequest(body []byte) (*Request, error) {
+	ret := new(Request)
+	if err := json.Unmarshal(body, ret); err != nil {
+		return nil, fmt.Errorf("parse request body error: %v", err)
+	}
+	if err := h.decryptResource(ret); err != nil {
+		return ret, err
+	}
 	return ret, nil
 }
 
+// decryptResource 解密通知请求中的通知内容
+func (h *Handler) decryptResource(ret *Request) error {
+	if ret.Resource == nil {
+		return fmt.Errorf("parse request body error: missing resource")
+	}
+
+	mchAPIv3Key := h.mchAPIv3Key
+	if h.apiV3KeyFunc != nil {
+		mchAPIv3Key = h.apiV3KeyFunc()
+	}
+	plaintext, err := utils.DecryptAES256GCM(
+		mchAPIv3Key, ret.Resource.AssociatedData, ret.Resource.Nonce, ret.Resource.Ciphertext,
+	)
+	if err != nil {
+		return fmt.Errorf("decrypt request error: %v", err)
+	}
+
+	ret.Resource.Plaintext = plaintext
+	return nil
+}
+
 func getRequestBody(request *http.Request) ([]byte, error) {
 	body, err := ioutil.ReadAll(request.Body)
 	if err != nil {
@@ -69,3 +127,21 @@ func NewNotifyHandler(mchAPIv3Key string, verifier auth.Verifier) *Handler {
 		validator:   *validators.NewWechatPayNotifyValidator(verifier),
 	}
 }
+
+// NewNotifyHandlerWithAPIv3KeyFunc 创建通知处理器，每次解密通知内容时调用 apiV3KeyFunc 获取最新的商户APIv3密钥，
+// 用于 APIv3 密钥轮换后无需重新创建通知处理器，可以传入 secrets.Manager.APIv3Key
+func NewNotifyHandlerWithAPIv3KeyFunc(apiV3KeyFunc func() string, verifier auth.Verifier) *Handler {
+	return &Handler{
+		apiV3KeyFunc: apiV3KeyFunc,
+		validator:    *validators.NewWechatPayNotifyValidator(verifier),
+	}
+}
+
+// NewNotifyHandlerWithValidator 使用自定义的通知验证器创建通知处理器，
+// 如使用 validators.NewWechatPayNotifyValidatorWithNonceStore 检查通知是否被重放
+func NewNotifyHandlerWithValidator(mchAPIv3Key string, validator *validators.WechatPayNotifyValidator) *Handler {
+	return &Handler{
+		mchAPIv3Key: mchAPIv3Key,
+		validator:   *validator,
+	}
+}
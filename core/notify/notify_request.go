@@ -1,6 +1,8 @@
 package notify
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 )
@@ -18,6 +20,17 @@ type Request struct {
 	RawRequest *http.Request
 }
 
+// UnmarshalContent 将解密后的通知内容解析到 content
+func (r *Request) UnmarshalContent(content interface{}) error {
+	if r.Resource == nil {
+		return fmt.Errorf("notify resource is empty")
+	}
+	if err := json.Unmarshal([]byte(r.Resource.Plaintext), content); err != nil {
+		return fmt.Errorf("unmarshal plaintext to content failed: %v", err)
+	}
+	return nil
+}
+
 // EncryptedResource 微信支付通知请求中的内容
 type EncryptedResource struct {
 	Algorithm      string `json:"algorithm"`
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/validators"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/verifiers"
 	"github.com/wechatpay-apiv3/wechatpay-go/utils"
 
@@ -166,3 +167,66 @@ cTJOU9TxuGvNASMtjj7pYIerTx+xgZDXEVBWFW9PjJ0TV06tCRsgSHItgg==
 	createTime, _ := time.Parse(time.RFC3339, "2020-06-30T12:12:00+08:00")
 	assert.Zero(t, content.CreateTime.Sub(createTime))
 }
+
+func TestHandler_ParseNotifyRequestStream(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+
+	content := make(map[string]interface{})
+	notifyReq, err := handler.ParseNotifyRequestStream(
+		context.Background(), newForwarderRequest(t, encryptForTest(t, testForwarderContent)), &content,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "EV-2018022511223320873", notifyReq.ID)
+	assert.Equal(t, "TRANSACTION.SUCCESS", notifyReq.EventType)
+	assert.Equal(t, testForwarderContent, notifyReq.Resource.Plaintext)
+	assert.Equal(t, "SUCCESS", content["trade_state"])
+
+	_, err = NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{err: fmt.Errorf("verify failed")}).
+		ParseNotifyRequestStream(
+			context.Background(), newForwarderRequest(t, encryptForTest(t, testForwarderContent)), &content,
+		)
+	assert.Error(t, err)
+
+	_, err = handler.ParseNotifyRequestStream(
+		context.Background(), newForwarderRequest(t, encryptForTest(t, "not json")), &content,
+	)
+	assert.Error(t, err)
+}
+
+func TestNewNotifyHandlerWithAPIv3KeyFunc(t *testing.T) {
+	apiV3Key := "wrongAPIv3Key1234567890123456789"
+	handler := NewNotifyHandlerWithAPIv3KeyFunc(func() string { return apiV3Key }, &mockVerifier{})
+
+	content := make(map[string]interface{})
+	_, err := handler.ParseNotifyRequest(
+		context.Background(), newForwarderRequest(t, encryptForTest(t, testForwarderContent)), &content,
+	)
+	assert.Error(t, err)
+
+	// 轮换 APIv3 密钥后无需重新创建通知处理器
+	apiV3Key = testForwarderAPIv3Key
+	notifyReq, err := handler.ParseNotifyRequest(
+		context.Background(), newForwarderRequest(t, encryptForTest(t, testForwarderContent)), &content,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, testForwarderContent, notifyReq.Resource.Plaintext)
+}
+
+func TestNewNotifyHandlerWithValidator(t *testing.T) {
+	handler := NewNotifyHandlerWithValidator(
+		testForwarderAPIv3Key,
+		validators.NewWechatPayNotifyValidatorWithNonceStore(&mockVerifier{}, validators.NewMemoryNonceStore()),
+	)
+
+	content := make(map[string]interface{})
+	_, err := handler.ParseNotifyRequest(
+		context.Background(), newForwarderRequest(t, encryptForTest(t, testForwarderContent)), &content,
+	)
+	require.NoError(t, err)
+
+	// 随机串相同的通知被视为重放
+	_, err = handler.ParseNotifyRequest(
+		context.Background(), newForwarderRequest(t, encryptForTest(t, testForwarderContent)), &content,
+	)
+	assert.Error(t, err)
+}
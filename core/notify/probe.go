@@ -0,0 +1,124 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// DefaultProbeTimeout ProbeEndpoint 的默认超时时间，与微信支付等待通知应答的时间一致
+const DefaultProbeTimeout = 5 * time.Second
+
+// probeSerial 探测通知使用的平台证书序列号，不对应任何真实的平台证书
+const probeSerial = "0000000000000000000000000000000000000000"
+
+var (
+	// ErrProbeAccepted 通知地址接受了签名无效的探测通知，说明通知地址没有验证通知的签名
+	ErrProbeAccepted = errors.New("notify endpoint accepted a notification with an invalid signature")
+	// ErrProbeUnexpectedStatus 通知地址以非预期的状态码应答探测通知，如 404（路径错误）或 5xx（服务异常）
+	ErrProbeUnexpectedStatus = errors.New("notify endpoint responded with an unexpected status")
+)
+
+// ProbeResult 探测通知地址的结果
+type ProbeResult struct {
+	// StatusCode 通知地址应答的 HTTP 状态码
+	StatusCode int
+	// Latency 发送探测通知到收到应答的耗时
+	Latency time.Duration
+	// Body 应答报文的 Body 原文（最多 1KB）
+	Body string
+}
+
+// ProbeEndpoint 在将通知地址设置到微信支付前检查其是否可访问并正确验签
+//
+// 通知地址需为不带查询参数的 https 地址。ProbeEndpoint 向通知地址发送一条签名无效的探测通知，
+// 通知地址以 4xx（如 notify.Handler 验签失败时的 401）拒绝时检查通过；以 2xx 接受时返回 ErrProbeAccepted；
+// 以 404、405 或 5xx 应答时返回 ErrProbeUnexpectedStatus；无法访问时返回请求的错误。
+//
+// httpClient 为 nil 时使用 http.DefaultClient，ctx 没有截止时间时使用 DefaultProbeTimeout。
+// 探测通知不会通过验签，因此不会触发业务处理。
+func ProbeEndpoint(ctx context.Context, httpClient *http.Client, notifyURL string) (*ProbeResult, error) {
+	if err := checkNotifyURL(notifyURL); err != nil {
+		return nil, err
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultProbeTimeout)
+		defer cancel()
+	}
+
+	request, err := newProbeRequest(ctx, notifyURL)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("probe notify endpoint %s err:%w", notifyURL, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+	body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1024))
+
+	result := &ProbeResult{StatusCode: response.StatusCode, Latency: time.Since(start), Body: string(body)}
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return result, ErrProbeAccepted
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusMethodNotAllowed,
+		response.StatusCode < 400 || response.StatusCode >= 500:
+		return result, fmt.Errorf("%w: %d", ErrProbeUnexpectedStatus, response.StatusCode)
+	}
+	return result, nil
+}
+
+// checkNotifyURL 检查通知地址是否满足微信支付的要求：https 地址且不带查询参数
+func checkNotifyURL(notifyURL string) error {
+	u, err := url.Parse(notifyURL)
+	if err != nil {
+		return fmt.Errorf("invalid notify url %s err:%v", notifyURL, err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("notify url %s must be an absolute https url", notifyURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("notify url %s must not contain query parameters or fragment", notifyURL)
+	}
+	return nil
+}
+
+// newProbeRequest 构造格式与真实通知一致、但签名无效的探测通知
+func newProbeRequest(ctx context.Context, notifyURL string) (*http.Request, error) {
+	nonce, err := utils.GenerateNonce()
+	if err != nil {
+		return nil, err
+	}
+	body := fmt.Sprintf(`{"id":"PROBE-%s","create_time":"%s","resource_type":"encrypt-resource",`+
+		`"event_type":"WECHATPAY.PROBE","summary":"probe","resource":{"original_type":"probe",`+
+		`"algorithm":"AEAD_AES_256_GCM","ciphertext":"","associated_data":"","nonce":"%s"}}`,
+		nonce, time.Now().Format(time.RFC3339), nonce[:12])
+
+	request, err := http.NewRequest(http.MethodPost, notifyURL, bytes.NewBufferString(body))
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set(consts.ContentType, consts.ApplicationJSON)
+	request.Header.Set(consts.WechatPayTimestamp, strconv.FormatInt(time.Now().Unix(), 10))
+	request.Header.Set(consts.WechatPayNonce, nonce)
+	request.Header.Set(consts.WechatPaySerial, probeSerial)
+	request.Header.Set(consts.WechatPaySignature, base64.StdEncoding.EncodeToString([]byte("probe-"+nonce)))
+	return request, nil
+}
@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeEndpoint(t *testing.T) {
+	// 使用 notify.Handler 验签的通知地址：探测通知验签失败，应答 401
+	verified := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{err: errors.New("mock verify error")}).
+		HTTPHandler(func(context.Context, *Request) error {
+			t.Fatal("probe notification should not be handled")
+			return nil
+		})
+	server := httptest.NewTLSServer(verified)
+	defer server.Close()
+
+	result, err := ProbeEndpoint(context.Background(), server.Client(), server.URL+"/notify")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, result.StatusCode)
+	assert.Contains(t, result.Body, "FAIL")
+	assert.True(t, result.Latency > 0)
+}
+
+func TestProbeEndpoint_NotValidated(t *testing.T) {
+	var (
+		header http.Header
+		body   []byte
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = getRequestBody(r)
+		WriteAck(w, nil)
+	}))
+	defer server.Close()
+
+	result, err := ProbeEndpoint(context.Background(), server.Client(), server.URL)
+	assert.True(t, errors.Is(err, ErrProbeAccepted))
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.NotEmpty(t, header.Get("Wechatpay-Signature"))
+	assert.Equal(t, probeSerial, header.Get("Wechatpay-Serial"))
+	assert.Contains(t, string(body), `"event_type":"WECHATPAY.PROBE"`)
+}
+
+func TestProbeEndpoint_UnexpectedStatus(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusBadGateway} {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+		result, err := ProbeEndpoint(context.Background(), server.Client(), server.URL)
+		server.Close()
+
+		assert.True(t, errors.Is(err, ErrProbeUnexpectedStatus), "status %d", status)
+		assert.Equal(t, status, result.StatusCode)
+	}
+}
+
+func TestProbeEndpoint_Unreachable(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	client, url := server.Client(), server.URL
+	server.Close()
+
+	result, err := ProbeEndpoint(context.Background(), client, url)
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestProbeEndpoint_InvalidURL(t *testing.T) {
+	for _, url := range []string{
+		"http://pay.example.com/notify",
+		"https://pay.example.com/notify?order=1",
+		"/notify",
+		"://bad",
+	} {
+		_, err := ProbeEndpoint(context.Background(), nil, url)
+		assert.Error(t, err, url)
+	}
+}
@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/debug"
+)
+
+// Logger 记录通知处理异常的日志接口，*log.Logger 即实现了该接口
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// stdLogger 使用标准库 log 包输出日志
+type stdLogger struct{}
+
+func (stdLogger) Printf(format string, v ...interface{}) {
+	log.Printf(format, v...)
+}
+
+// PanicError 处理函数发生 panic 时返回的错误，视为可重试的失败，由微信支付稍后重发通知
+type PanicError struct {
+	// Value 传给 panic 的值
+	Value interface{}
+	// Stack 发生 panic 时的调用栈
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("notify handler panic: %v", e.Value)
+}
+
+// Recover 返回从 fn 的 panic 中恢复的 HandleFunc，避免单个异常的通知导致回调服务崩溃
+//
+// 发生 panic 时通过 logger 记录通知ID与调用栈（logger 为 nil 时使用标准库 log 包），并返回 Retry 包装的 *PanicError，
+// 应答接收失败，由微信支付稍后重发通知。Handler.HTTPHandler 与 AsyncProcessor 已默认恢复 panic，
+// 需要使用自定义 logger 时可以显式包装处理函数。
+func Recover(fn HandleFunc, logger Logger) HandleFunc {
+	if logger == nil {
+		logger = stdLogger{}
+	}
+	return func(ctx context.Context, req *Request) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				e := &PanicError{Value: r, Stack: debug.Stack()}
+				logger.Printf("%v, notify id:%s\n%s", e, req.ID, e.Stack)
+				err = Retry(e)
+			}
+		}()
+		return fn(ctx, req)
+	}
+}
+
+// ErrorClassifier 判断处理函数返回的错误是否为永久失败（重发也无法处理成功）
+type ErrorClassifier func(err error) (permanent bool)
+
+// Classify 返回按 classifier 对 fn 返回的错误分类的 HandleFunc：永久失败使用 Reject 包装，应答 200 以停止重发；
+// 其余错误使用 Retry 包装，由微信支付稍后重发通知。已使用 Retry/Reject 包装的错误（包括 *PanicError）保持不变。
+//
+// 适用于按错误类型统一分类，如将 sql.ErrNoRows 视为永久失败，而无需在每个处理函数中包装错误。
+func Classify(fn HandleFunc, classifier ErrorClassifier) HandleFunc {
+	return func(ctx context.Context, req *Request) error {
+		err := fn(ctx, req)
+		var ackErr *AckError
+		if err == nil || errors.As(err, &ackErr) {
+			return err
+		}
+		if classifier(err) {
+			return Reject(err)
+		}
+		return Retry(err)
+	}
+}
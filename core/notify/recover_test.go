@@ -0,0 +1,82 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecover(t *testing.T) {
+	var buf bytes.Buffer
+	fn := Recover(func(context.Context, *Request) error {
+		panic("bad payload")
+	}, log.New(&buf, "", 0))
+
+	err := fn(context.Background(), &Request{ID: "EV-2018022511223320873"})
+	require.Error(t, err)
+	assert.True(t, IsRetry(err))
+	var panicErr *PanicError
+	require.True(t, errors.As(err, &panicErr))
+	assert.Equal(t, "bad payload", panicErr.Value)
+	assert.Equal(t, "notify handler panic: bad payload", err.Error())
+	assert.Contains(t, string(panicErr.Stack), "TestRecover")
+	assert.Contains(t, buf.String(), "notify handler panic: bad payload, notify id:EV-2018022511223320873")
+	assert.Contains(t, buf.String(), "TestRecover")
+
+	// 未发生 panic 时原样返回处理结果
+	rejected := Reject(fmt.Errorf("order closed"))
+	fn = Recover(func(context.Context, *Request) error { return rejected }, nil)
+	assert.Equal(t, rejected, fn(context.Background(), &Request{}))
+}
+
+func TestClassify(t *testing.T) {
+	classifier := func(err error) bool { return errors.Is(err, sql.ErrNoRows) }
+	classify := func(err error) error {
+		return Classify(func(context.Context, *Request) error { return err }, classifier)(context.Background(), &Request{})
+	}
+
+	assert.NoError(t, classify(nil))
+	assert.True(t, IsReject(classify(fmt.Errorf("query order err:%w", sql.ErrNoRows))))
+	assert.True(t, IsRetry(classify(fmt.Errorf("database unavailable"))))
+	// 已包装的错误保持不变
+	assert.True(t, IsRetry(classify(Retry(sql.ErrNoRows))))
+	assert.True(t, IsReject(classify(Reject(fmt.Errorf("order closed")))))
+}
+
+func TestHandler_HTTPHandler_Panic(t *testing.T) {
+	handler := NewNotifyHandler(testForwarderAPIv3Key, &mockVerifier{})
+	var buf bytes.Buffer
+	h := handler.HTTPHandler(Recover(func(context.Context, *Request) error {
+		var content map[string]string
+		content["out_trade_no"] = "1217752501201407033233368018"
+		return nil
+	}, log.New(&buf, "", 0)))
+
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	resp := struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "FAIL", resp.Code)
+	assert.Equal(t, "notify handler panic", resp.Message)
+	assert.Contains(t, buf.String(), "assignment to entry in nil map")
+
+	// 未显式包装时 HTTPHandler 同样恢复 panic
+	h = handler.HTTPHandler(func(context.Context, *Request) error { panic("bad payload") })
+	recorder = httptest.NewRecorder()
+	h.ServeHTTP(recorder, newForwarderRequest(t, encryptForTest(t, testForwarderContent)))
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
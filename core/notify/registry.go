@@ -0,0 +1,157 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Content 按 Registry 中注册的类型解析的通知内容
+type Content struct {
+	// EventType 通知类型
+	EventType string
+	// Value 解析后的通知内容，类型为注册时传入的类型；未注册的通知类型解析为 ContentMap
+	Value interface{}
+	// Raw 解密后的原始通知内容
+	Raw json.RawMessage
+	// Unknown 通知内容中未被 Value 的类型定义的顶层字段，Value 不是结构体时为 nil
+	Unknown map[string]json.RawMessage
+}
+
+// Registry 通知类型（event_type）到通知内容类型的注册表
+//
+// 微信支付新增通知类型或在通知内容中新增字段时，无需等待 SDK 更新：
+// 可以在运行时使用 Register 注册新的通知类型，并通过 Content.Raw 与 Content.Unknown 读取 SDK 尚未定义的字段。
+// Registry 的方法是并发安全的。
+type Registry struct {
+	mu    sync.RWMutex
+	types map[string]*registeredType
+}
+
+type registeredType struct {
+	typ   reflect.Type
+	known map[string]bool
+}
+
+// NewRegistry 创建 Registry
+func NewRegistry() *Registry {
+	return &Registry{types: make(map[string]*registeredType)}
+}
+
+// Register 注册通知类型的通知内容类型，content 为该类型的指针，如 new(payments.Transaction)。
+// 同一通知类型重复注册时覆盖之前的注册，content 不是指针时 panic
+func (r *Registry) Register(eventType string, content interface{}) {
+	typ := reflect.TypeOf(content)
+	if eventType == "" || typ == nil || typ.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("notify: invalid content type %T for event type %s", content, eventType))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.types[eventType] = &registeredType{typ: typ.Elem(), known: knownFields(typ.Elem())}
+}
+
+// Registered 返回通知类型是否已注册
+func (r *Registry) Registered(eventType string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.types[eventType]
+	return ok
+}
+
+// Decode 按通知类型解析已解密的通知内容
+func (r *Registry) Decode(req *Request) (*Content, error) {
+	if req.Resource == nil {
+		return nil, fmt.Errorf("notify resource is empty")
+	}
+	raw := json.RawMessage(req.Resource.Plaintext)
+
+	r.mu.RLock()
+	ct := r.types[req.EventType]
+	r.mu.RUnlock()
+
+	if ct == nil {
+		value := make(ContentMap)
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("unmarshal plaintext to content failed: %v", err)
+		}
+		return &Content{EventType: req.EventType, Value: value, Raw: raw}, nil
+	}
+
+	value := reflect.New(ct.typ).Interface()
+	if err := json.Unmarshal(raw, value); err != nil {
+		return nil, fmt.Errorf("unmarshal plaintext to %s failed: %v", ct.typ, err)
+	}
+	content := &Content{EventType: req.EventType, Value: value, Raw: raw}
+	if ct.known != nil {
+		fields := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, fmt.Errorf("unmarshal plaintext to content failed: %v", err)
+		}
+		for key := range fields {
+			if ct.known[strings.ToLower(key)] {
+				delete(fields, key)
+			}
+		}
+		if len(fields) > 0 {
+			content.Unknown = fields
+		}
+	}
+	return content, nil
+}
+
+// HandleFunc 返回解析通知内容后调用 fn 的 HandleFunc，通知内容无法解析时以 Reject 错误应答
+func (r *Registry) HandleFunc(fn func(ctx context.Context, req *Request, content *Content) error) HandleFunc {
+	return func(ctx context.Context, req *Request) error {
+		content, err := r.Decode(req)
+		if err != nil {
+			return Reject(err)
+		}
+		return fn(ctx, req, content)
+	}
+}
+
+// knownFields 返回结构体类型 typ 可由 encoding/json 解析的字段名（小写），typ 不是结构体时返回 nil
+func knownFields(typ reflect.Type) map[string]bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	known := make(map[string]bool)
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := field.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+			if field.Anonymous && name == "" {
+				embedded := field.Type
+				if embedded.Kind() == reflect.Ptr {
+					embedded = embedded.Elem()
+				}
+				if embedded.Kind() == reflect.Struct {
+					collect(embedded)
+					continue
+				}
+			}
+			if field.PkgPath != "" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			known[strings.ToLower(name)] = true
+		}
+	}
+	collect(typ)
+	return known
+}
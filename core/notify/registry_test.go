@@ -0,0 +1,123 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTransactionBase struct {
+	Mchid *string `json:"mchid,omitempty"`
+}
+
+type testTransaction struct {
+	testTransactionBase
+	OutTradeNo *string `json:"out_trade_no,omitempty"`
+	TradeState *string
+	Ignored    string `json:"-"`
+}
+
+func TestRegistry_Decode(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("TRANSACTION.SUCCESS", new(testTransaction))
+	assert.True(t, registry.Registered("TRANSACTION.SUCCESS"))
+	assert.False(t, registry.Registered("TRANSACTION.NEW_EVENT"))
+
+	plaintext := `{"mchid":"1900000100","out_trade_no":"1217752501201407033233368018","tradeState":"SUCCESS",` +
+		`"Ignored":"x","new_field":{"a":1}}`
+	content, err := registry.Decode(&Request{
+		EventType: "TRANSACTION.SUCCESS",
+		Resource:  &EncryptedResource{Plaintext: plaintext},
+	})
+	require.NoError(t, err)
+
+	transaction, ok := content.Value.(*testTransaction)
+	require.True(t, ok)
+	assert.Equal(t, "1900000100", *transaction.Mchid)
+	assert.Equal(t, "1217752501201407033233368018", *transaction.OutTradeNo)
+	assert.Equal(t, "SUCCESS", *transaction.TradeState)
+	assert.Equal(t, plaintext, string(content.Raw))
+	assert.Equal(t, map[string]json.RawMessage{
+		"Ignored":   json.RawMessage(`"x"`),
+		"new_field": json.RawMessage(`{"a":1}`),
+	}, content.Unknown)
+
+	// 未注册的通知类型解析为 ContentMap
+	content, err = registry.Decode(&Request{
+		EventType: "TRANSACTION.NEW_EVENT",
+		Resource:  &EncryptedResource{Plaintext: `{"new_field":"value"}`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ContentMap{"new_field": "value"}, content.Value)
+	assert.Nil(t, content.Unknown)
+
+	_, err = registry.Decode(&Request{EventType: "TRANSACTION.SUCCESS"})
+	assert.Error(t, err)
+	_, err = registry.Decode(&Request{
+		EventType: "TRANSACTION.SUCCESS",
+		Resource:  &EncryptedResource{Plaintext: `{"out_trade_no":1}`},
+	})
+	assert.Error(t, err)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := NewRegistry()
+	assert.Panics(t, func() { registry.Register("TRANSACTION.SUCCESS", testTransaction{}) })
+	assert.Panics(t, func() { registry.Register("", new(testTransaction)) })
+	assert.Panics(t, func() { registry.Register("TRANSACTION.SUCCESS", nil) })
+
+	// 非结构体类型不计算未知字段
+	registry.Register("CUSTOM.EVENT", new(map[string]string))
+	content, err := registry.Decode(&Request{
+		EventType: "CUSTOM.EVENT",
+		Resource:  &EncryptedResource{Plaintext: `{"a":"b"}`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &map[string]string{"a": "b"}, content.Value)
+	assert.Nil(t, content.Unknown)
+
+	// 运行时注册与解析可以并发进行
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			registry.Register("TRANSACTION.SUCCESS", new(testTransaction))
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = registry.Decode(&Request{
+				EventType: "TRANSACTION.SUCCESS",
+				Resource:  &EncryptedResource{Plaintext: `{}`},
+			})
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRegistry_HandleFunc(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("TRANSACTION.SUCCESS", new(testTransaction))
+
+	var handled *Content
+	fn := registry.HandleFunc(func(ctx context.Context, req *Request, content *Content) error {
+		handled = content
+		return nil
+	})
+
+	assert.NoError(t, fn(context.Background(), &Request{
+		EventType: "TRANSACTION.SUCCESS",
+		Resource:  &EncryptedResource{Plaintext: `{"out_trade_no":"123"}`},
+	}))
+	assert.Equal(t, "123", *handled.Value.(*testTransaction).OutTradeNo)
+
+	err := fn(context.Background(), &Request{
+		EventType: "TRANSACTION.SUCCESS",
+		Resource:  &EncryptedResource{Plaintext: `not json`},
+	})
+	assert.True(t, IsReject(err))
+}
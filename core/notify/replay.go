@@ -0,0 +1,276 @@
+package notify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// CapturedRequest 记录的微信支付通知请求，可由 CaptureRequest 生成，或从网关、负载均衡的访问日志中转换得到
+type CapturedRequest struct {
+	Method string      `json:"method,omitempty"`
+	Path   string      `json:"path,omitempty"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+// CaptureRequest 记录通知请求的请求头与请求体，并重置请求体以便后续处理，可用于在通知地址前记录全部通知
+func CaptureRequest(r *http.Request) (*CapturedRequest, error) {
+	body, err := getRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return &CapturedRequest{Method: r.Method, Path: r.URL.Path, Header: r.Header.Clone(), Body: string(body)}, nil
+}
+
+// ReadCapturedRequests 从流中读取 JSON Lines 格式（每行一个 CapturedRequest）的通知请求，忽略空行
+func ReadCapturedRequests(r io.Reader) ([]*CapturedRequest, error) {
+	var ret []*CapturedRequest
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		captured := new(CapturedRequest)
+		if err := json.Unmarshal(text, captured); err != nil {
+			return nil, fmt.Errorf("parse captured request at line %d err:%v", line, err)
+		}
+		ret = append(ret, captured)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read captured requests err:%v", err)
+	}
+	return ret, nil
+}
+
+// LoadCapturedRequests 按文件名顺序读取目录下所有 .json 与 .jsonl 文件中的通知请求，每个文件为 JSON Lines 格式
+func LoadCapturedRequests(dir string) ([]*CapturedRequest, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read captured requests dir err:%v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if !entry.IsDir() && (ext == ".json" || ext == ".jsonl") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var ret []*CapturedRequest
+	for _, name := range names {
+		captured, err := loadCapturedFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, captured...)
+	}
+	return ret, nil
+}
+
+func loadCapturedFile(name string) ([]*CapturedRequest, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("open captured requests file err:%v", err)
+	}
+	defer func() { _ = f.Close() }()
+	captured, err := ReadCapturedRequests(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return captured, nil
+}
+
+// recordedSignature 记录的通知签名对应的平台证书序列号与签名原文
+type recordedSignature struct {
+	serial  string
+	message string
+}
+
+// RecordedVerifier 只接受与记录的通知请求完全一致的签名，不进行密码学验签
+//
+// 用于平台证书已轮换、无法再验证历史通知签名时重放通知，请仅使用来自可信来源（如通知地址自身的访问日志）的记录创建。
+// 签名、平台证书序列号、时间戳、随机串与请求体中的任何一项与记录不一致时验签失败。
+type RecordedVerifier struct {
+	signatures map[string]recordedSignature
+}
+
+// NewRecordedVerifier 使用记录的通知请求创建 RecordedVerifier
+func NewRecordedVerifier(captured []*CapturedRequest) *RecordedVerifier {
+	v := &RecordedVerifier{signatures: make(map[string]recordedSignature, len(captured))}
+	for _, c := range captured {
+		h := canonicalHeader(c.Header)
+		v.signatures[h.Get(consts.WechatPaySignature)] = recordedSignature{
+			serial: h.Get(consts.WechatPaySerial),
+			message: fmt.Sprintf("%s\n%s\n%s\n",
+				h.Get(consts.WechatPayTimestamp), h.Get(consts.WechatPayNonce), c.Body),
+		}
+	}
+	return v
+}
+
+// Verify 检查签名是否与记录的通知请求一致
+func (v *RecordedVerifier) Verify(_ context.Context, serial, message, signature string) error {
+	recorded, ok := v.signatures[signature]
+	if !ok || recorded.serial != serial || recorded.message != message {
+		return fmt.Errorf("signature of serial %s is not recorded", serial)
+	}
+	return nil
+}
+
+// ReplayResult 重放单个通知请求的结果
+type ReplayResult struct {
+	// Captured 重放的通知请求
+	Captured *CapturedRequest
+	// ID 通知ID，请求体无法解析时为空
+	ID string
+	// StatusCode 处理器应答的 HTTP 状态码
+	StatusCode int
+	// Code 处理器应答报文中的 code，SUCCESS 表示处理成功
+	Code string
+	// Message 处理器应答报文中的 message
+	Message string
+	// Err 通知请求无法重放的原因
+	Err error
+}
+
+// Succeeded 通知是否被处理成功：应答 2XX 且应答报文的 code 为 SUCCESS（以 Reject 拒绝的通知不视为成功）
+func (r *ReplayResult) Succeeded() bool {
+	return r.Err == nil && r.StatusCode >= 200 && r.StatusCode < 300 && r.Code == "SUCCESS"
+}
+
+// Replayer 通过本地的通知处理器重放记录的通知请求，用于在故障恢复后重新处理期间未能处理的通知
+type Replayer struct {
+	handler  http.Handler
+	interval time.Duration
+}
+
+// ReplayerOption Replayer 的配置项
+type ReplayerOption func(r *Replayer)
+
+// WithReplayInterval 设置相邻两次重放的间隔，避免集中重放对下游造成压力，默认不等待
+func WithReplayInterval(interval time.Duration) ReplayerOption {
+	return func(r *Replayer) {
+		if interval >= 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// NewReplayer 使用通知处理器创建 Replayer，handler 通常为 Handler.HTTPHandler 或 Forwarder
+//
+// 重放的通知会再次执行业务处理，处理函数需要是幂等的（如使用 Deduplicator）。
+// 请勿为 handler 使用检查随机串的 NonceStore，否则已处理过的通知将因重放检查而验签失败。
+func NewReplayer(handler http.Handler, opts ...ReplayerOption) *Replayer {
+	r := &Replayer{handler: handler}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Replay 按顺序重放通知请求，返回每个通知请求的结果。ctx 结束时停止重放并返回已重放的结果与 ctx.Err()
+//
+// 重放时以通知请求的 Wechatpay-Timestamp 作为验签时钟（auth.WithClock），使历史通知通过时间戳检查，签名仍会正常验证。
+func (r *Replayer) Replay(ctx context.Context, captured []*CapturedRequest) ([]*ReplayResult, error) {
+	results := make([]*ReplayResult, 0, len(captured))
+	for i, c := range captured {
+		if i > 0 && r.interval > 0 {
+			timer := time.NewTimer(r.interval)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return results, ctx.Err()
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		results = append(results, r.replay(ctx, c))
+	}
+	return results, nil
+}
+
+func (r *Replayer) replay(ctx context.Context, c *CapturedRequest) *ReplayResult {
+	result := &ReplayResult{Captured: c}
+	notification := struct {
+		ID string `json:"id"`
+	}{}
+	if err := json.Unmarshal([]byte(c.Body), &notification); err == nil {
+		result.ID = notification.ID
+	}
+
+	header := canonicalHeader(c.Header)
+	timestamp, err := strconv.ParseInt(header.Get(consts.WechatPayTimestamp), 10, 64)
+	if err != nil {
+		result.Err = fmt.Errorf("invalid %s of captured request: %v", consts.WechatPayTimestamp, err)
+		return result
+	}
+	recordedAt := time.Unix(timestamp, 0)
+	ctx = auth.WithClock(ctx, auth.ClockFunc(func() time.Time { return recordedAt }))
+
+	method, path := c.Method, c.Path
+	if method == "" {
+		method = http.MethodPost
+	}
+	if path == "" {
+		path = "/"
+	}
+	request, err := http.NewRequest(method, path, strings.NewReader(c.Body))
+	if err != nil {
+		result.Err = fmt.Errorf("build replay request err:%v", err)
+		return result
+	}
+	request = request.WithContext(ctx)
+	request.Header = header
+
+	w := &gatewayResponseWriter{header: make(http.Header)}
+	r.handler.ServeHTTP(w, request)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	result.StatusCode = w.status
+
+	ack := struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{}
+	if w.body.Len() > 0 {
+		_ = json.Unmarshal(w.body.Bytes(), &ack)
+	} else if result.StatusCode >= 200 && result.StatusCode < 300 {
+		// 微信支付约定无应答报文的 2XX 同样表示接收成功
+		ack.Code = "SUCCESS"
+	}
+	result.Code, result.Message = ack.Code, ack.Message
+	return result
+}
+
+// canonicalHeader 返回规范化请求头名称的副本，访问日志中的请求头名称可能为小写
+func canonicalHeader(header http.Header) http.Header {
+	ret := make(http.Header, len(header))
+	for key, values := range header {
+		for _, value := range values {
+			ret.Add(key, value)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,116 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCapturedRequest 记录一条 1 小时前的通知请求
+func newCapturedRequest(t *testing.T, signature string) *CapturedRequest {
+	r := newForwarderRequest(t, encryptForTest(t, testForwarderContent))
+	r.Header.Set("Wechatpay-Signature", signature)
+	r.Header.Set("Wechatpay-Timestamp", strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10))
+	captured, err := CaptureRequest(r)
+	require.NoError(t, err)
+
+	// 记录后请求体仍可读取
+	body, err := ioutil.ReadAll(r.Body)
+	require.NoError(t, err)
+	assert.Equal(t, captured.Body, string(body))
+	return captured
+}
+
+func TestReadCapturedRequests(t *testing.T) {
+	captured := newCapturedRequest(t, "signature-1")
+	line, err := json.Marshal(captured)
+	require.NoError(t, err)
+	// 访问日志中的请求头名称可能为小写
+	lower := `{"header":{"wechatpay-signature":["signature-2"]},"body":"{}"}`
+
+	dir, err := ioutil.TempDir("", "notify-replay")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b.jsonl"), []byte(lower+"\n"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.json"), append(append(line, "\n\n"...), line...), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ignored.txt"), []byte("not json"), 0600))
+
+	loaded, err := LoadCapturedRequests(dir)
+	require.NoError(t, err)
+	require.Len(t, loaded, 3)
+	assert.Equal(t, captured, loaded[0])
+	assert.Equal(t, "signature-2", canonicalHeader(loaded[2].Header).Get("Wechatpay-Signature"))
+
+	_, err = ReadCapturedRequests(bytes.NewBufferString(string(line) + "\nnot json\n"))
+	assert.EqualError(t, err, "parse captured request at line 2 err:invalid character 'o' in literal null (expecting 'u')")
+}
+
+func TestRecordedVerifier(t *testing.T) {
+	captured := newCapturedRequest(t, "signature-1")
+	v := NewRecordedVerifier([]*CapturedRequest{captured})
+
+	message := fmt.Sprintf("%s\n%s\n%s\n",
+		captured.Header.Get("Wechatpay-Timestamp"), captured.Header.Get("Wechatpay-Nonce"), captured.Body)
+	assert.NoError(t, v.Verify(context.Background(), "D7CE59D1F522D701", message, "signature-1"))
+	assert.Error(t, v.Verify(context.Background(), "D7CE59D1F522D701", message, "signature-2"))
+	assert.Error(t, v.Verify(context.Background(), "OTHER", message, "signature-1"))
+	assert.Error(t, v.Verify(context.Background(), "D7CE59D1F522D701", message+"tampered", "signature-1"))
+}
+
+func TestReplayer_Replay(t *testing.T) {
+	ok := newCapturedRequest(t, "signature-1")
+	rejected := newCapturedRequest(t, "signature-2")
+	tampered := newCapturedRequest(t, "signature-3")
+	captured := []*CapturedRequest{ok, rejected, tampered}
+	handler := NewNotifyHandler(testForwarderAPIv3Key, NewRecordedVerifier(captured))
+
+	var handled []string
+	h := handler.HTTPHandler(func(ctx context.Context, req *Request) error {
+		signature := req.RawRequest.Header.Get("Wechatpay-Signature")
+		handled = append(handled, signature)
+		if signature == "signature-2" {
+			return Reject(fmt.Errorf("order closed"))
+		}
+		return nil
+	})
+
+	// 记录后被篡改的通知无法通过验签
+	tampered.Body = tampered.Body[:len(tampered.Body)-1] + " }"
+	replayer := NewReplayer(h)
+	results, err := replayer.Replay(context.Background(), captured)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, []string{"signature-1", "signature-2"}, handled)
+
+	assert.True(t, results[0].Succeeded())
+	assert.Equal(t, "EV-2018022511223320873", results[0].ID)
+	assert.Equal(t, http.StatusOK, results[0].StatusCode)
+	assert.False(t, results[1].Succeeded())
+	assert.Equal(t, "FAIL", results[1].Code)
+	assert.Equal(t, "order closed", results[1].Message)
+	assert.False(t, results[2].Succeeded())
+	assert.Equal(t, http.StatusUnauthorized, results[2].StatusCode)
+
+	// 时间戳无效或 ctx 结束
+	results, err = replayer.Replay(context.Background(), []*CapturedRequest{{Header: http.Header{}, Body: "{}"}})
+	require.NoError(t, err)
+	assert.Error(t, results[0].Err)
+	assert.False(t, results[0].Succeeded())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = NewReplayer(h, WithReplayInterval(time.Hour)).Replay(ctx, captured)
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, results)
+}
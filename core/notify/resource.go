@@ -0,0 +1,167 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const (
+	// AlgorithmAEADAES256GCM 回调通知内容的加密算法
+	AlgorithmAEADAES256GCM = "AEAD_AES_256_GCM"
+
+	apiV3KeyLength = 32
+	gcmNonceLength = 12
+)
+
+// DecryptNotifyResource 使用商户 APIv3 密钥解密回调通知中的 resource，返回设置了 Plaintext 的 EncryptedResource
+//
+// data 可以是 resource 对应的 JSON 对象，也可以是完整的通知请求体。本函数不验证通知签名，
+// 可以使用日志中记录的通知内容确认 APIv3 密钥是否正确，而无需等待微信支付发起回调。
+func DecryptNotifyResource(mchAPIv3Key string, data []byte) (*EncryptedResource, error) {
+	var body struct {
+		Resource *EncryptedResource `json:"resource"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, fmt.Errorf("parse notify resource err: %v", err)
+	}
+	resource := body.Resource
+	if resource == nil {
+		resource = new(EncryptedResource)
+		if err := json.Unmarshal(data, resource); err != nil {
+			return nil, fmt.Errorf("parse notify resource err: %v", err)
+		}
+	}
+
+	if resource.Ciphertext == "" {
+		return nil, fmt.Errorf("parse notify resource err: missing ciphertext")
+	}
+	if resource.Algorithm != "" && resource.Algorithm != AlgorithmAEADAES256GCM {
+		return nil, fmt.Errorf("unsupported notify resource algorithm %s", resource.Algorithm)
+	}
+	if len(resource.Nonce) != gcmNonceLength {
+		return nil, fmt.Errorf("notify resource nonce must be %d bytes, got %d", gcmNonceLength, len(resource.Nonce))
+	}
+	if len(mchAPIv3Key) != apiV3KeyLength {
+		return nil, fmt.Errorf("apiv3 key must be %d bytes, got %d", apiV3KeyLength, len(mchAPIv3Key))
+	}
+
+	plaintext, err := utils.DecryptAES256GCM(
+		mchAPIv3Key, resource.AssociatedData, resource.Nonce, resource.Ciphertext,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt notify resource err: %v, please check the apiv3 key", err)
+	}
+	resource.Plaintext = plaintext
+	return resource, nil
+}
+
+// ResourceTestVector 回调通知内容的加解密测试数据
+type ResourceTestVector struct {
+	// Name 测试数据的名称
+	Name string
+	// APIv3Key 商户 APIv3 密钥
+	APIv3Key string
+	// AssociatedData 附加数据
+	AssociatedData string
+	// Nonce 加密使用的随机串
+	Nonce string
+	// Plaintext 明文
+	Plaintext string
+	// Ciphertext Base64 编码的密文
+	Ciphertext string
+}
+
+// NewResourceTestVector 使用 apiV3Key 加密 plaintext 生成测试数据，可用于构造自定义通知内容的测试用例
+func NewResourceTestVector(name, apiV3Key, associatedData, nonce, plaintext string) (ResourceTestVector, error) {
+	ciphertext, err := utils.EncryptAES256GCM(apiV3Key, associatedData, nonce, plaintext)
+	if err != nil {
+		return ResourceTestVector{}, fmt.Errorf("encrypt test vector %s err: %v", name, err)
+	}
+	return ResourceTestVector{
+		Name:           name,
+		APIv3Key:       apiV3Key,
+		AssociatedData: associatedData,
+		Nonce:          nonce,
+		Plaintext:      plaintext,
+		Ciphertext:     ciphertext,
+	}, nil
+}
+
+// Resource 返回测试数据对应的回调通知 resource JSON 对象，可以传入 DecryptNotifyResource
+func (v ResourceTestVector) Resource() []byte {
+	data, _ := json.Marshal(map[string]string{
+		"algorithm":       AlgorithmAEADAES256GCM,
+		"ciphertext":      v.Ciphertext,
+		"associated_data": v.AssociatedData,
+		"nonce":           v.Nonce,
+	})
+	return data
+}
+
+// ResourceTestVectors 返回一组固定的回调通知内容加解密测试数据，
+// 覆盖支付、退款通知内容，平台证书，空附加数据、非 ASCII 明文与空明文等情形
+//
+// 测试数据中的密钥均为测试用密钥，可用于验证自行实现的解密逻辑或通知处理流程。
+func ResourceTestVectors() []ResourceTestVector {
+	return []ResourceTestVector{
+		{
+			Name:           "transaction",
+			APIv3Key:       "a8Fq3xZ0pLmN7sT2vW9yB4cD6eG1hJ5k",
+			AssociatedData: "transaction",
+			Nonce:          "5K8264ILTKCH",
+			Plaintext: `{"mchid":"1900009191","appid":"wxd678efh567hg6787",` +
+				`"out_trade_no":"1217752501201407033233368018","transaction_id":"1217752501201407033233368018",` +
+				`"trade_type":"JSAPI","trade_state":"SUCCESS",` +
+				`"amount":{"total":100,"payer_total":100,"currency":"CNY","payer_currency":"CNY"}}`,
+			Ciphertext: "neW+dMHCMwS80++LUCLOmuoTPTdS/uPkU8X1lBClJNtTUGm0HslgQz+YtKPnTiElZMiKhU9ByCBmpGwZspqhcSwm" +
+				"85ph/XgPiZINp4FdkMvOpU/epH7VgwDAL8nUQxxfX69FIKI4XG+iGJc4wSdws/TrXHdeFdijCnr4p0GRfpgeDJpeaRfB" +
+				"KlQR4zKMCtiyi/twsWZCyEEPilLeAC/pgoxo5IAZygLdui62s9lNHjP+2auhKMtwcMLcSvqlVvJ6GHuhIbXqvDeJkYPi" +
+				"M8mIfDPrYIJyK9QQLw8QElu5jUeIcTwBPs681KnP3aOmX2TtC/OqEqx8Cs2EMSqoaNUAKCpPoBwAJozhzr7IwOV2nRn8" +
+				"z9oeJkY6ffHU6cAVI4M=",
+		},
+		{
+			Name:           "refund without associated data",
+			APIv3Key:       "Zk2mQ7rT9vX1bN4cE6gH8jL0pS3uW5yA",
+			AssociatedData: "",
+			Nonce:          "Yx2nD8qR4tVw",
+			Plaintext: `{"mchid":"1900009191","out_trade_no":"1217752501201407033233368018",` +
+				`"refund_id":"50000000382019052709732678859","out_refund_no":"1217752501201407033233368018",` +
+				`"refund_status":"SUCCESS","amount":{"total":100,"refund":100,"payer_total":100,"payer_refund":100}}`,
+			Ciphertext: "4CT+hE4oVoSJ5cH8pKKtMe16TXEfvzx5fj2qDFt4FDUAPbqTHJeNbOUexjboDNOLdQvksmRzvsl33Ein3gOPFS4o" +
+				"lDhBDryp6HhqXPDoivu6/Ek34qyJrkiSw3UPrBkswHKq8ZUZq77KnYqSeYW0hO0dWQhqJBftW4yuc2fcMoIpKkF+/o3H" +
+				"g+QRZCZU3LtN6IQikWgfR1jGvnKdfhMtLWQGsXbeb43G5QOigIGyp+pJ3GeSboQ3jOUlSDJtASXyAnjlbhuWWF+o2N7G" +
+				"Gc70XukBhWUab+FLYFEmHCNE16Zba2hTVv+EkVRrLp7TpxfC9sMh3ha1ZGLBc0E9Vm1xxdKu7YEWPqdfZSPfQi1GEj6j" +
+				"sg==",
+		},
+		{
+			Name:           "certificate",
+			APIv3Key:       "Mn4bV6cX8zL1kJ3hG5fD7sA9qW0eR2tY",
+			AssociatedData: "certificate",
+			Nonce:          "Lp9oK8iJ7uHy",
+			Plaintext:      "-----BEGIN CERTIFICATE-----\nMIIBtest\n-----END CERTIFICATE-----\n",
+			Ciphertext: "19DqrvKzr5wn2UrWsOTK0aoXHE2YoLT0J3D+GXDhx1L8g5gpkgWz1UzYWYZBK2zXWLqhNimVVNR3Z9HDEYE77RRn" +
+				"+sjarQEc/JzjTbcXEw==",
+		},
+		{
+			Name:           "non-ascii plaintext",
+			APIv3Key:       "Qw1eR3tY5uI7oP9aS2dF4gH6jK8lZ0xC",
+			AssociatedData: "complaint",
+			Nonce:          "Hg6fD4sA2qWe",
+			Plaintext: `{"complaint_id":"200201820200101080076610000","action_type":"CREATE_COMPLAINT",` +
+				`"complaint_detail":"反馈一个重复扣费的问题"}`,
+			Ciphertext: "/h1mluibA/iyjYsKriGKdrN0KzROs9jHG1d4OaG9YpweWM2JlVA3Ya5O3IpaOurs7e0FXjbZuA9SqzFAMdAiAASs" +
+				"u7bEAj3AYjwDQ9oLjHC/O4s67ECQ7EXnXuYNvyCPB2M0uacA3cVyCcsCfT0VJimKmXpEtJhhZwz10Xd0W09uVjgR8wr1" +
+				"I34hKl/n0H76H+KYkaNu",
+		},
+		{
+			Name:           "empty plaintext",
+			APIv3Key:       "Po0iU9yT8rE7wQ6aS5dF4gH3jK2lM1nB",
+			AssociatedData: "empty",
+			Nonce:          "Vb5nM3kL1jHg",
+			Plaintext:      "",
+			Ciphertext:     "/idyQhHAiOWG5A8egvaj+Q==",
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+func TestResourceTestVectors(t *testing.T) {
+	for _, v := range ResourceTestVectors() {
+		t.Run(v.Name, func(t *testing.T) {
+			ciphertext, err := utils.EncryptAES256GCM(v.APIv3Key, v.AssociatedData, v.Nonce, v.Plaintext)
+			require.NoError(t, err)
+			assert.Equal(t, v.Ciphertext, ciphertext)
+
+			resource, err := DecryptNotifyResource(v.APIv3Key, v.Resource())
+			require.NoError(t, err)
+			assert.Equal(t, v.Plaintext, resource.Plaintext)
+			assert.Equal(t, AlgorithmAEADAES256GCM, resource.Algorithm)
+
+			body := fmt.Sprintf(`{"id":"EV-2018022511223320873","event_type":"TRANSACTION.SUCCESS","resource":%s}`, v.Resource())
+			resource, err = DecryptNotifyResource(v.APIv3Key, []byte(body))
+			require.NoError(t, err)
+			assert.Equal(t, v.Plaintext, resource.Plaintext)
+		})
+	}
+}
+
+func TestDecryptNotifyResourceErrors(t *testing.T) {
+	v := ResourceTestVectors()[0]
+	resource := func(modify func(m map[string]string)) []byte {
+		m := map[string]string{}
+		require.NoError(t, json.Unmarshal(v.Resource(), &m))
+		modify(m)
+		data, err := json.Marshal(m)
+		require.NoError(t, err)
+		return data
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		data []byte
+		err  string
+	}{
+		{name: "invalid json", key: v.APIv3Key, data: []byte("not json"), err: "parse notify resource err"},
+		{name: "missing ciphertext", key: v.APIv3Key, data: []byte(`{"nonce":"5K8264ILTKCH"}`), err: "missing ciphertext"},
+		{
+			name: "unsupported algorithm", key: v.APIv3Key,
+			data: resource(func(m map[string]string) { m["algorithm"] = "AEAD_SM4_GCM" }),
+			err:  "unsupported notify resource algorithm AEAD_SM4_GCM",
+		},
+		{
+			name: "invalid nonce", key: v.APIv3Key,
+			data: resource(func(m map[string]string) { m["nonce"] = "short" }),
+			err:  "nonce must be 12 bytes, got 5",
+		},
+		{name: "short key", key: "testMchAPIv3Key0", data: v.Resource(), err: "apiv3 key must be 32 bytes, got 16"},
+		{name: "wrong key", key: strings.Repeat("0", 32), data: v.Resource(), err: "please check the apiv3 key"},
+		{
+			name: "tampered associated data", key: v.APIv3Key,
+			data: resource(func(m map[string]string) { m["associated_data"] = "refund" }),
+			err:  "please check the apiv3 key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecryptNotifyResource(tt.key, tt.data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestNewResourceTestVector(t *testing.T) {
+	v, err := NewResourceTestVector("custom", testForwarderAPIv3Key, "transaction", testForwarderNonce, testForwarderContent)
+	require.NoError(t, err)
+
+	resource, err := DecryptNotifyResource(testForwarderAPIv3Key, v.Resource())
+	require.NoError(t, err)
+	assert.Equal(t, testForwarderContent, resource.Plaintext)
+
+	_, err = NewResourceTestVector("custom", "short", "", testForwarderNonce, testForwarderContent)
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Router 按通知类型（event_type）将通知分发给不同的处理函数，Dispatch 可直接作为 HandleFunc 使用：
+//
+//	router := notify.NewRouter()
+//	router.Handle("TRANSACTION.SUCCESS", handleTransaction)
+//	router.HandlePrefix("VIOLATION.", handleViolation)
+//	http.Handle("/notify", handler.HTTPHandler(router.Dispatch))
+//
+// 处理函数需要在开始接收通知前注册完毕，注册方法不是并发安全的。
+type Router struct {
+	exact    map[string]HandleFunc
+	prefixes []prefixRoute
+	fallback HandleFunc
+}
+
+type prefixRoute struct {
+	prefix string
+	fn     HandleFunc
+}
+
+// NewRouter 创建 Router
+func NewRouter() *Router {
+	return &Router{exact: make(map[string]HandleFunc)}
+}
+
+// Handle 注册处理指定通知类型的函数，如 TRANSACTION.SUCCESS。同一通知类型重复注册时 panic
+func (r *Router) Handle(eventType string, fn HandleFunc) {
+	if eventType == "" || fn == nil {
+		panic("notify: invalid route for event type " + eventType)
+	}
+	if _, ok := r.exact[eventType]; ok {
+		panic("notify: multiple registrations for event type " + eventType)
+	}
+	r.exact[eventType] = fn
+}
+
+// HandlePrefix 注册处理以 prefix 开头的通知类型的函数，如 VIOLATION. 匹配所有违规通知。
+// 通知类型同时匹配多个前缀时使用最长的前缀，Handle 注册的精确匹配优先于前缀匹配
+func (r *Router) HandlePrefix(prefix string, fn HandleFunc) {
+	if prefix == "" || fn == nil {
+		panic("notify: invalid route for event type prefix " + prefix)
+	}
+	for _, route := range r.prefixes {
+		if route.prefix == prefix {
+			panic("notify: multiple registrations for event type prefix " + prefix)
+		}
+	}
+	r.prefixes = append(r.prefixes, prefixRoute{prefix: prefix, fn: fn})
+	sort.SliceStable(r.prefixes, func(i, j int) bool {
+		return len(r.prefixes[i].prefix) > len(r.prefixes[j].prefix)
+	})
+}
+
+// HandleDefault 注册处理未匹配任何路由的通知的函数
+//
+// 未注册时，未匹配的通知将以 Reject 错误应答，微信支付不再重发。
+func (r *Router) HandleDefault(fn HandleFunc) {
+	r.fallback = fn
+}
+
+// Dispatch 将通知分发给匹配其通知类型的处理函数
+func (r *Router) Dispatch(ctx context.Context, req *Request) error {
+	if fn := r.match(req.EventType); fn != nil {
+		return fn(ctx, req)
+	}
+	return Reject(fmt.Errorf("no handler for notify event type %q", req.EventType))
+}
+
+func (r *Router) match(eventType string) HandleFunc {
+	if fn, ok := r.exact[eventType]; ok {
+		return fn
+	}
+	for _, route := range r.prefixes {
+		if strings.HasPrefix(eventType, route.prefix) {
+			return route.fn
+		}
+	}
+	return r.fallback
+}
@@ -0,0 +1,66 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter_Dispatch(t *testing.T) {
+	var handled string
+	route := func(name string) HandleFunc {
+		return func(ctx context.Context, req *Request) error {
+			handled = name
+			return nil
+		}
+	}
+
+	router := NewRouter()
+	router.Handle("VIOLATION.PUNISH", route("punish"))
+	router.HandlePrefix("VIOLATION.", route("violation"))
+	router.HandlePrefix("VIOLATION.APPEAL", route("appeal"))
+	router.Handle("TRANSACTION.SUCCESS", func(ctx context.Context, req *Request) error {
+		return Retry(fmt.Errorf("database unavailable"))
+	})
+
+	tests := []struct {
+		eventType string
+		handled   string
+	}{
+		{eventType: "VIOLATION.PUNISH", handled: "punish"},
+		{eventType: "VIOLATION.INTERCEPT", handled: "violation"},
+		{eventType: "VIOLATION.APPEAL_RESULT", handled: "appeal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			handled = ""
+			assert.NoError(t, router.Dispatch(context.Background(), &Request{EventType: tt.eventType}))
+			assert.Equal(t, tt.handled, handled)
+		})
+	}
+
+	err := router.Dispatch(context.Background(), &Request{EventType: "TRANSACTION.SUCCESS"})
+	assert.True(t, IsRetry(err))
+
+	err = router.Dispatch(context.Background(), &Request{EventType: "REFUND.SUCCESS"})
+	assert.True(t, IsReject(err))
+
+	handled = ""
+	router.HandleDefault(route("default"))
+	assert.NoError(t, router.Dispatch(context.Background(), &Request{EventType: "REFUND.SUCCESS"}))
+	assert.Equal(t, "default", handled)
+}
+
+func TestRouter_InvalidRoutes(t *testing.T) {
+	fn := func(ctx context.Context, req *Request) error { return nil }
+	router := NewRouter()
+	router.Handle("VIOLATION.PUNISH", fn)
+	router.HandlePrefix("VIOLATION.", fn)
+
+	assert.Panics(t, func() { router.Handle("VIOLATION.PUNISH", fn) })
+	assert.Panics(t, func() { router.HandlePrefix("VIOLATION.", fn) })
+	assert.Panics(t, func() { router.Handle("", fn) })
+	assert.Panics(t, func() { router.HandlePrefix("REFUND.", nil) })
+}
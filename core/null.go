@@ -0,0 +1,74 @@
+package core
+
+import "time"
+
+// 显式 null 的哨兵指针
+//
+// 可选字段为 nil 时不会被序列化，部分修改类接口中“未设置”与“设置为 null”含义不同，后者表示清空字段。
+// 将支持 null 的字段设置为 NullString() 等函数返回的哨兵指针，该字段将被序列化为 null。
+// 哨兵指针在整个进程中共享，请勿修改其指向的值。
+var (
+	nullString  = new(string)
+	nullBool    = new(bool)
+	nullFloat64 = new(float64)
+	nullFloat32 = new(float32)
+	nullInt64   = new(int64)
+	nullInt32   = new(int32)
+	nullTime    = new(time.Time)
+)
+
+// NullString 返回表示显式 null 的 *string 哨兵指针
+func NullString() *string {
+	return nullString
+}
+
+// NullBool 返回表示显式 null 的 *bool 哨兵指针
+func NullBool() *bool {
+	return nullBool
+}
+
+// NullFloat64 返回表示显式 null 的 *float64 哨兵指针
+func NullFloat64() *float64 {
+	return nullFloat64
+}
+
+// NullFloat32 返回表示显式 null 的 *float32 哨兵指针
+func NullFloat32() *float32 {
+	return nullFloat32
+}
+
+// NullInt64 返回表示显式 null 的 *int64 哨兵指针
+func NullInt64() *int64 {
+	return nullInt64
+}
+
+// NullInt32 返回表示显式 null 的 *int32 哨兵指针
+func NullInt32() *int32 {
+	return nullInt32
+}
+
+// NullTime 返回表示显式 null 的 *time.Time 哨兵指针
+func NullTime() *time.Time {
+	return nullTime
+}
+
+// IsNull 判断 p 是否为 NullString 等函数返回的哨兵指针。值相同但不是哨兵指针的指针（如 String("")）返回 false
+func IsNull(p interface{}) bool {
+	switch v := p.(type) {
+	case *string:
+		return v == nullString
+	case *bool:
+		return v == nullBool
+	case *float64:
+		return v == nullFloat64
+	case *float32:
+		return v == nullFloat32
+	case *int64:
+		return v == nullInt64
+	case *int32:
+		return v == nullInt32
+	case *time.Time:
+		return v == nullTime
+	}
+	return false
+}
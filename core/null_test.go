@@ -0,0 +1,26 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNull(t *testing.T) {
+	assert.True(t, IsNull(NullString()))
+	assert.True(t, IsNull(NullBool()))
+	assert.True(t, IsNull(NullFloat64()))
+	assert.True(t, IsNull(NullFloat32()))
+	assert.True(t, IsNull(NullInt64()))
+	assert.True(t, IsNull(NullInt32()))
+	assert.True(t, IsNull(NullTime()))
+
+	assert.False(t, IsNull(String("")))
+	assert.False(t, IsNull(Bool(false)))
+	assert.False(t, IsNull(Int64(0)))
+	assert.False(t, IsNull(Time(time.Time{})))
+	assert.False(t, IsNull((*string)(nil)))
+	assert.False(t, IsNull(nil))
+	assert.False(t, IsNull(""))
+}
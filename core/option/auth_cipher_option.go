@@ -88,3 +88,26 @@ func WithWechatPayAutoAuthCipherUsingDownloaderMgr(
 		},
 	}
 }
+
+// WithWechatPayPublicKeyAuthCipher 一键初始化 Client，使其具备「签名/验签/敏感字段加解密」能力。
+// 使用微信支付公钥验签与加密敏感字段，适用于已切换为微信支付公钥模式、不再使用平台证书的商户，publicKeyID 为微信支付公钥ID
+func WithWechatPayPublicKeyAuthCipher(
+	mchID string, certificateSerialNo string, privateKey *rsa.PrivateKey, publicKeyID string, publicKey *rsa.PublicKey,
+) core.ClientOption {
+	return withAuthCipherOption{
+		settings: core.DialSettings{
+			Signer: &signers.SHA256WithRSASigner{
+				MchID:               mchID,
+				CertificateSerialNo: certificateSerialNo,
+				PrivateKey:          privateKey,
+			},
+			Validator: validators.NewWechatPayResponseValidator(
+				verifiers.NewSHA256WithRSAPubkeyVerifier(publicKeyID, publicKey),
+			),
+			Cipher: ciphers.NewWechatPayCipher(
+				encryptors.NewWechatPayPubKeyEncryptor(publicKeyID, publicKey),
+				decryptors.NewWechatPayDecryptor(privateKey),
+			),
+		},
+	}
+}
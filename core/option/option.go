@@ -4,6 +4,8 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
@@ -12,6 +14,9 @@ import (
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/verifiers"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/ciphers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/ratelimit"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/resolver"
 )
 
 // region SignerOption
@@ -34,6 +39,10 @@ func WithSigner(signer auth.Signer) core.ClientOption {
 
 // WithMerchantCredential 通过商户号、商户证书序列号、商户私钥构建一对 Credential/Signer，用于生成请求头中的 Authorization 信息
 func WithMerchantCredential(mchID, certificateSerialNo string, privateKey *rsa.PrivateKey) core.ClientOption {
+	if privateKey != nil {
+		// 未预计算 CRT 参数的私钥（如自行构造的私钥）在此预计算一次，避免每次签名时重复计算
+		privateKey.Precompute()
+	}
 	signer := &signers.SHA256WithRSASigner{
 		MchID:               mchID,
 		PrivateKey:          privateKey,
@@ -42,6 +51,79 @@ func WithMerchantCredential(mchID, certificateSerialNo string, privateKey *rsa.P
 	return WithSigner(signer)
 }
 
+// withSignConcurrencyOption 为 Client 设置同时执行的签名数上限
+type withSignConcurrencyOption struct {
+	Concurrency int
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withSignConcurrencyOption) Apply(o *core.DialSettings) error {
+	o.SignConcurrency = w.Concurrency
+	return nil
+}
+
+// WithSignConcurrency 返回一个限制 Client 同时执行的签名数的 ClientOption，超出上限的请求排队等待签名，
+// 以免突发流量下大量请求同时签名占满 CPU。concurrency 通常可设置为 runtime.NumCPU()
+func WithSignConcurrency(concurrency int) core.ClientOption {
+	return withSignConcurrencyOption{Concurrency: concurrency}
+}
+
+// endregion
+
+// region CredentialOption
+
+// withCredentialOption 为 Client 添加其他认证类型的 Credential
+type withCredentialOption struct {
+	Credential auth.SchemeCredential
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withCredentialOption) Apply(o *core.DialSettings) error {
+	o.Credentials = append(o.Credentials, w.Credential)
+	return nil
+}
+
+// WithCredential 返回一个为 Client 添加其他认证类型（如国密）的 Credential 的 ClientOption
+//
+// Client 默认仍使用 Signer 对应的认证类型，可通过 WithAuthScheme 设置默认认证类型，或通过 auth.WithScheme 为单个请求指定认证类型
+func WithCredential(credential auth.SchemeCredential) core.ClientOption {
+	return withCredentialOption{Credential: credential}
+}
+
+// withAuthSchemeOption 为 Client 设置默认认证类型
+type withAuthSchemeOption struct {
+	Scheme string
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withAuthSchemeOption) Apply(o *core.DialSettings) error {
+	o.AuthScheme = w.Scheme
+	return nil
+}
+
+// WithAuthScheme 返回一个设置默认认证类型（如 WECHATPAY2-SHA256-RSA2048）的 ClientOption，
+// 该认证类型需由 Signer 或 WithCredential 添加的 Credential 提供
+func WithAuthScheme(scheme string) core.ClientOption {
+	return withAuthSchemeOption{Scheme: scheme}
+}
+
+// withSignatureCacheOption 为 Client 设置签名缓存
+type withSignatureCacheOption struct {
+	TTL time.Duration
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withSignatureCacheOption) Apply(o *core.DialSettings) error {
+	o.SignatureCacheTTL = w.TTL
+	return nil
+}
+
+// WithSignatureCache 返回一个在 ttl 内为方法、URL 与请求包体均相同的请求复用 Authorization 信息的 ClientOption，
+// ttl 不能超过 credentials.MaxSignatureCacheTTL。适用于高频且完全相同的 GET 请求，如平台证书查询、健康检查
+func WithSignatureCache(ttl time.Duration) core.ClientOption {
+	return withSignatureCacheOption{TTL: ttl}
+}
+
 // endregion
 
 // region ValidatorOption
@@ -69,11 +151,41 @@ func WithWechatPayCertificate(certificateList []*x509.Certificate) core.ClientOp
 	return WithVerifier(verifier)
 }
 
-// WithoutValidator 返回一个指定validator的ClientOption，不进行验签 用于下载证书和下载账单等不需要进行验签的接口
+// WithoutValidator 返回一个指定validator的ClientOption，不进行验签 用于下载证书等不需要进行验签的接口
+//
+// 下载账单、媒体文件时无需使用本选项，可直接调用 core.Client.Download
 func WithoutValidator() core.ClientOption {
 	return withValidatorOption{Validator: &validators.NullValidator{}}
 }
 
+// withBaseURLValidatorOption 为 Client 设置发往指定 API 地址的请求所使用的 Validator
+type withBaseURLValidatorOption struct {
+	BaseURL   string
+	Validator auth.Validator
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withBaseURLValidatorOption) Apply(o *core.DialSettings) error {
+	if o.BaseURLValidators == nil {
+		o.BaseURLValidators = make(map[string]auth.Validator)
+	}
+	o.BaseURLValidators[w.BaseURL] = w.Validator
+	return nil
+}
+
+// WithAlternateValidator 返回一个为发往 baseURL（如 http://wxpay-simulator:8080）的请求设置 validator 的 ClientOption，
+// 其他请求仍使用 WithVerifier 等选项设置的 Validator。可多次使用以设置多个地址，多个地址匹配时使用最长的地址
+func WithAlternateValidator(baseURL string, validator auth.Validator) core.ClientOption {
+	return withBaseURLValidatorOption{BaseURL: baseURL, Validator: validator}
+}
+
+// WithAlternateCertificate 返回一个使用指定证书验证发往 baseURL 的请求的应答的 ClientOption，
+// 适用于使用自有密钥签名的微信支付模拟器，使同一程序中发往模拟器的请求使用模拟器证书验签，其他请求仍使用平台证书验签
+func WithAlternateCertificate(baseURL string, certificateList []*x509.Certificate) core.ClientOption {
+	verifier := verifiers.NewSHA256WithRSAVerifier(core.NewCertificateMapWithList(certificateList))
+	return WithAlternateValidator(baseURL, validators.NewWechatPayResponseValidator(verifier))
+}
+
 // endregion
 
 // region HTTPClientOption
@@ -116,3 +228,360 @@ func WithWechatPayCipher(encryptor cipher.Encryptor, decryptor cipher.Decryptor)
 }
 
 // endregion
+
+// region LimiterOption
+
+// withLimiterOption 为 Client 设置 Limiter
+type withLimiterOption struct {
+	Limiter ratelimit.Limiter
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withLimiterOption) Apply(o *core.DialSettings) error {
+	o.Limiter = w.Limiter
+	return nil
+}
+
+// WithRateLimiter 返回一个为 Client 设置请求限流器的 ClientOption，请求在签名与发送前将等待限流器的配额
+//
+// ratelimit.TokenBucketLimiter 可按接口路径前缀分别设置配额，并按商户号分别计数
+func WithRateLimiter(limiter ratelimit.Limiter) core.ClientOption {
+	return withLimiterOption{Limiter: limiter}
+}
+
+// endregion
+
+// region BreakerOption
+
+// withBreakerOption 为 Client 设置 Breaker
+type withBreakerOption struct {
+	Breaker core.Breaker
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withBreakerOption) Apply(o *core.DialSettings) error {
+	o.Breaker = w.Breaker
+	return nil
+}
+
+// WithCircuitBreaker 返回一个为 Client 设置熔断器的 ClientOption，熔断器打开时请求将不会被发送，而是立即返回错误
+//
+// circuitbreaker.CircuitBreaker 按接口分别统计微信支付系统错误与超时，可用于在接口持续故障时快速切换到其他支付渠道
+func WithCircuitBreaker(breaker core.Breaker) core.ClientOption {
+	return withBreakerOption{Breaker: breaker}
+}
+
+// endregion
+
+// region TimeoutOption
+
+// withTimeoutOption 为 Client 设置默认超时时间
+type withTimeoutOption struct {
+	Timeout time.Duration
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withTimeoutOption) Apply(o *core.DialSettings) error {
+	o.Timeout = w.Timeout
+	return nil
+}
+
+// WithDefaultTimeout 返回一个设置请求默认超时时间的 ClientOption，默认为 consts.DefaultTimeout
+//
+// 默认超时时间仅对未设置截止时间的 ctx 生效，包括读取应答包体的时间；已设置截止时间的请求以 ctx 的截止时间为准。
+// timeout 小于等于 0 时不设置默认超时，请确保请求的 ctx 会被取消或自定义的 HTTPClient 设置了超时，以免请求无限期等待
+func WithDefaultTimeout(timeout time.Duration) core.ClientOption {
+	if timeout <= 0 {
+		timeout = -1
+	}
+	return withTimeoutOption{Timeout: timeout}
+}
+
+// endregion
+
+// region ResolverOption
+
+// withResolverOption 为 Client 设置域名解析器
+type withResolverOption struct {
+	Resolver resolver.Resolver
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withResolverOption) Apply(o *core.DialSettings) error {
+	o.Resolver = w.Resolver
+	return nil
+}
+
+// WithResolver 返回一个设置域名解析器的 ClientOption，Client 建立连接时使用 r 解析域名
+//
+// resolver.CachingResolver 可以缓存并在后台刷新解析结果、在解析失败时继续使用过期的结果，以及为域名指定固定的 IP 地址。
+// 使用 WithHTTPClient 时，其 Transport 需为 *http.Transport（或为空），Client 会复制后设置 DialContext，原 HTTPClient 不受影响
+func WithResolver(r resolver.Resolver) core.ClientOption {
+	return withResolverOption{Resolver: r}
+}
+
+// endregion
+
+// region HedgingOption
+
+// withHedgingOption 为 Client 设置对冲请求
+type withHedgingOption struct {
+	Delay time.Duration
+	Paths []string
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withHedgingOption) Apply(o *core.DialSettings) error {
+	o.HedgeDelay = w.Delay
+	o.HedgePaths = w.Paths
+	return nil
+}
+
+// WithHedging 返回一个为 GET 请求开启对冲请求的 ClientOption：请求在 delay 内未得到应答时，使用新的签名再发出一个相同的请求，
+// 以先得到的通过验签的成功应答为准，并取消另一个请求，可降低微信支付响应变慢时查询订单、下载平台证书等接口的尾部延迟。
+//
+// pathPrefixes 为需要对冲的接口路径前缀（如 /v3/pay/transactions/、/v3/certificates），为空时对全部 GET 请求生效。
+// 对冲请求会占用限流器配额，且可能使接口的请求量翻倍，delay 建议设置为接口 P95 延迟左右。Client.Download 不会发出对冲请求
+func WithHedging(delay time.Duration, pathPrefixes ...string) core.ClientOption {
+	return withHedgingOption{Delay: delay, Paths: pathPrefixes}
+}
+
+// endregion
+
+// region HeaderOption
+
+// withAcceptLanguageOption 为 Client 设置 Accept-Language
+type withAcceptLanguageOption struct {
+	Language string
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withAcceptLanguageOption) Apply(o *core.DialSettings) error {
+	o.AcceptLanguage = w.Language
+	return nil
+}
+
+// WithAcceptLanguage 返回一个设置请求头 Accept-Language 的 ClientOption，微信支付将以对应的语言返回错误信息（APIError.Message）
+//
+// 常用的值为 consts.LanguageZhCN 与 consts.LanguageEn。单个请求可以通过 Client.Request 的 headerParams 设置其他语言
+func WithAcceptLanguage(language string) core.ClientOption {
+	return withAcceptLanguageOption{Language: language}
+}
+
+// withUserAgentSuffixOption 为 Client 设置 User-Agent 中的应用标识
+type withUserAgentSuffixOption struct {
+	Suffix string
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withUserAgentSuffixOption) Apply(o *core.DialSettings) error {
+	o.UserAgentSuffix = w.Suffix
+	return nil
+}
+
+// WithUserAgentSuffix 返回一个在 SDK 的 User-Agent 之后追加应用标识（如 MyShop/1.0）的 ClientOption，便于区分不同应用的请求
+func WithUserAgentSuffix(suffix string) core.ClientOption {
+	return withUserAgentSuffixOption{Suffix: suffix}
+}
+
+// endregion
+
+// region APIServerOption
+
+// withAPIServerOption 为 Client 设置 API 地址
+type withAPIServerOption struct {
+	Server string
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withAPIServerOption) Apply(o *core.DialSettings) error {
+	o.APIServer = strings.TrimSuffix(w.Server, "/")
+	return nil
+}
+
+// WithAPIServer 返回一个设置 API 地址（如 https://api2.mch.weixin.qq.com）的 ClientOption，
+// 发往 consts.WechatPayAPIServer 的请求（包括 services 中的全部接口）将改为发往该地址，其他地址的请求不受影响
+func WithAPIServer(server string) core.ClientOption {
+	return withAPIServerOption{Server: server}
+}
+
+// WithGlobal 返回一个为境外收单（香港及跨境）商户设置 API 地址为 consts.WechatPayGlobalAPIServer 的 ClientOption
+//
+// 本选项仅替换请求的 API 地址，不调整请求头、签名与验签。境外收单的下单、查单与关单接口请使用 services/globalpayments，
+// 汇率查询请使用 services/exchangerate；其余 services 均为境内接口，其路径与字段（如订单金额的币种、商户行业编码）与境外收单接口不同，
+// 不能直接用于境外收单。退款等尚未提供的境外收单接口请按其接口文档构造请求，使用 Client.Request 调用。
+// 境外商户的平台证书同样需要从境外 API 地址下载，
+// 请使用设置了本选项的 Client 调用 downloader.NewCertificateDownloaderWithClient 或 CertificateDownloaderMgr.RegisterDownloaderWithClient
+func WithGlobal() core.ClientOption {
+	return WithAPIServer(consts.WechatPayGlobalAPIServer)
+}
+
+// endregion
+
+// region ClockOption
+
+// withClockOption 为 Client 设置时钟
+type withClockOption struct {
+	Clock auth.Clock
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withClockOption) Apply(o *core.DialSettings) error {
+	o.Clock = w.Clock
+	return nil
+}
+
+// WithClock 返回一个设置时钟的 ClientOption，请求签名的时间戳与应答时间戳的过期检查均使用该时钟，默认使用系统时钟
+//
+// 服务器时钟存在无法消除的偏差时，可以使用 auth.OffsetClock 修正；单个请求可以通过 auth.WithClock 设置其他时钟
+func WithClock(clock auth.Clock) core.ClientOption {
+	return withClockOption{Clock: clock}
+}
+
+// endregion
+
+// region SignRecorderOption
+
+// withSignRecorderOption 为 Client 设置签名调试信息的回调
+type withSignRecorderOption struct {
+	Recorder auth.SignRecorder
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withSignRecorderOption) Apply(o *core.DialSettings) error {
+	o.SignRecorder = w.Recorder
+	return nil
+}
+
+// WithSignRecorder 返回一个设置签名调试信息回调的 ClientOption，每个请求签名后都会调用 recorder，传入签名原文等信息
+//
+// 遇到签名错误（SIGN_ERROR）时，可以将签名原文与微信支付签名排查工具的结果对比。签名原文包含完整的请求包体，
+// 请勿在生产环境中长期开启。仅排查单个请求时，可以使用 auth.WithSignRecorder 为该请求设置回调
+func WithSignRecorder(recorder auth.SignRecorder) core.ClientOption {
+	return withSignRecorderOption{Recorder: recorder}
+}
+
+// endregion
+
+// region ValidationMetricsOption
+
+// withValidationMetricsOption 为 Client 设置应答验签结果的计数接口
+type withValidationMetricsOption struct {
+	Metrics auth.ValidationMetrics
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withValidationMetricsOption) Apply(o *core.DialSettings) error {
+	o.ValidationMetrics = w.Metrics
+	return nil
+}
+
+// WithValidationMetrics 返回一个设置应答验签结果计数接口的 ClientOption，每个应答验签后都会按结果分类
+// （如 auth.ValidationTimestampExpired、auth.ValidationUnknownSerial）调用 metrics 计数，可用于区分时钟偏差与密钥配置错误
+func WithValidationMetrics(metrics auth.ValidationMetrics) core.ClientOption {
+	return withValidationMetricsOption{Metrics: metrics}
+}
+
+// endregion
+
+// region AuditSinkOption
+
+// withAuditSinkOption 为 Client 设置请求审计日志的接收器
+type withAuditSinkOption struct {
+	Sink core.AuditSink
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withAuditSinkOption) Apply(o *core.DialSettings) error {
+	o.AuditSink = w.Sink
+	return nil
+}
+
+// WithAuditSink 返回一个设置请求审计日志接收器的 ClientOption，每个已签名的请求收到应答（或请求失败）后都会调用 sink 记录
+// 时间、商户号、方法、路径、Request-Id、状态码、错误码与耗时，可使用 auditlog.NewFileSink 按行写入 JSON 文件
+func WithAuditSink(sink core.AuditSink) core.ClientOption {
+	return withAuditSinkOption{Sink: sink}
+}
+
+// endregion
+
+// region DryRunOption
+
+// withDryRunOption 以预演模式执行 Client 的全部请求
+type withDryRunOption struct{}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withDryRunOption) Apply(o *core.DialSettings) error {
+	o.DryRun = true
+	return nil
+}
+
+// WithDryRun 返回一个以预演模式执行全部请求的 ClientOption：请求照常构造、校验并签名，但不发送，返回 core.ErrDryRun
+//
+// 需要读取已签名的请求时，使用 core.WithDryRun 设置请求的 Context；仅预演单个请求时，无需设置本选项
+func WithDryRun() core.ClientOption {
+	return withDryRunOption{}
+}
+
+// endregion
+
+// region NonceGeneratorOption
+
+// withNonceGeneratorOption 为 Client 设置随机串生成器
+type withNonceGeneratorOption struct {
+	Generator auth.NonceGenerator
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withNonceGeneratorOption) Apply(o *core.DialSettings) error {
+	o.NonceGenerator = w.Generator
+	return nil
+}
+
+// WithNonceGenerator 返回一个设置随机串生成器的 ClientOption，请求签名与调起支付签名（如 jsapi.PrepayWithRequestPayment）均使用该生成器
+//
+// 默认生成 32 位、只包含字母与数字的随机串，可以使用 utils.NewNonceGenerator 设置其他长度与字符集
+func WithNonceGenerator(generator auth.NonceGenerator) core.ClientOption {
+	return withNonceGeneratorOption{Generator: generator}
+}
+
+// endregion
+
+// region CompressionOption
+
+// withCompressionOption 为 Client 设置 gzip 压缩
+type withCompressionOption struct {
+	AcceptGzip         bool
+	GzipRequestMinSize int
+}
+
+// Apply 将配置添加到 core.DialSettings 中
+func (w withCompressionOption) Apply(o *core.DialSettings) error {
+	if w.AcceptGzip {
+		o.AcceptGzip = true
+	}
+	if w.GzipRequestMinSize != 0 {
+		o.GzipRequestMinSize = w.GzipRequestMinSize
+	}
+	return nil
+}
+
+// WithGzipResponse 返回一个在请求头中声明 Accept-Encoding: gzip 的 ClientOption
+//
+// 默认的 http.Transport 已会自动请求并透明解压 gzip 应答，本选项适用于禁用了自动压缩（DisableCompression）的自定义 HTTPClient。
+// 压缩的应答总是在验签前解压，验签与读取应答包体的方式不受影响
+func WithGzipResponse() core.ClientOption {
+	return withCompressionOption{AcceptGzip: true}
+}
+
+// WithGzipRequest 返回一个使用 gzip 压缩 JSON 请求包体的 ClientOption，包体长度不小于 minSize 字节时压缩，并设置 Content-Encoding: gzip
+//
+// 请求签名仍使用未压缩的包体计算。请仅在确认所调用的接口支持压缩的请求包体时使用本选项，如进件等包体较大的接口
+func WithGzipRequest(minSize int) core.ClientOption {
+	if minSize <= 0 {
+		minSize = 1
+	}
+	return withCompressionOption{GzipRequestMinSize: minSize}
+}
+
+// endregion
@@ -0,0 +1,182 @@
+// Package outbox 事务性发件箱（Transactional Outbox），用于在业务数据库事务中原子地记录由回调通知驱动的状态变更与待发布的事件
+//
+// 处理回调通知时，在同一数据库事务中更新订单状态并通过 Store.Add 写入事件，事务提交后由 Relay 将事件发布到下游。
+// 事件 ID 使用通知 ID 时，微信支付重发的通知在 Add 时返回 ErrDuplicate，业务状态变更即可做到只执行一次；
+// 事件的发布为至少一次，下游消费方请使用 Event.ID 做幂等处理。
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils/task"
+)
+
+// Event 发件箱中待发布的事件
+type Event struct {
+	// ID 事件的唯一标识，同时作为幂等键，处理回调通知时建议使用通知 ID
+	ID string
+	// Topic 事件发布的主题，如消息队列的 Topic
+	Topic string
+	// Key 事件的业务键，如商户订单号，可用作消息队列的分区键
+	Key string
+	// Payload 事件内容
+	Payload []byte
+	// CreateTime 事件的写入时间，为零值时由 Store 设置为当前时间
+	CreateTime time.Time
+}
+
+// NotifyEvent 使用回调通知创建事件，事件 ID 为通知 ID，内容为解密后的通知内容
+func NotifyEvent(req *notify.Request, topic, key string) *Event {
+	event := &Event{ID: req.ID, Topic: topic, Key: key}
+	if req.Resource != nil {
+		event.Payload = []byte(req.Resource.Plaintext)
+	}
+	return event
+}
+
+// Tx 业务数据库事务
+//
+// *sql.Tx、sqlx 的 *sqlx.Tx 以及 gorm 事务中的 tx.Statement.ConnPool 均实现了该接口，因此可以直接传入业务代码所使用的事务。
+type Tx interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// ErrDuplicate 事件 ID 已存在，说明同一事件已在之前的事务中写入，通常意味着该回调通知已处理过
+var ErrDuplicate = errors.New("outbox event already exists")
+
+// Store 发件箱存储，SQLStore 为基于 database/sql 的实现
+type Store interface {
+	// Add 在业务事务 tx 中写入事件，事件随事务一同提交或回滚。事件 ID 已存在时返回 ErrDuplicate
+	Add(ctx context.Context, tx Tx, event *Event) error
+	// Pending 按写入顺序返回最多 limit 个尚未发布的事件
+	Pending(ctx context.Context, limit int) ([]*Event, error)
+	// MarkPublished 将事件标记为已发布
+	MarkPublished(ctx context.Context, id string) error
+}
+
+// Publisher 事件发布器，Publish 返回 nil 时应保证事件已被下游确认
+type Publisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// PublisherFunc 将函数适配为 Publisher
+type PublisherFunc func(ctx context.Context, event *Event) error
+
+// Publish 调用 f(ctx, event)
+func (f PublisherFunc) Publish(ctx context.Context, event *Event) error {
+	return f(ctx, event)
+}
+
+const (
+	// DefaultRelayInterval Relay 默认的发布间隔
+	DefaultRelayInterval = time.Second
+	// DefaultRelayBatchSize Relay 每次读取的默认事件数量
+	DefaultRelayBatchSize = 100
+)
+
+// Relay 定时读取发件箱中尚未发布的事件并发布
+//
+// 事件按写入顺序发布，某个事件发布失败时停止本轮发布，下一轮从该事件开始重试，并调用 WithErrorHandler 设置的回调。
+// 发布成功但标记失败的事件将被再次发布。Relay 一旦创建即启动定时发布，使用完毕请调用 Stop() 防止发生资源泄漏；
+// 多实例部署时，请仅在一个实例中运行 Relay，或在 Store.Pending 中使用 SELECT ... FOR UPDATE SKIP LOCKED 等方式避免重复发布
+type Relay struct {
+	store     Store
+	publisher Publisher
+	interval  time.Duration
+	batchSize int
+	onError   func(error)
+
+	flushLock sync.Mutex
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	task     *task.RepeatedTask
+	stopLock sync.Mutex
+}
+
+// RelayOption Relay 的配置项
+type RelayOption func(r *Relay)
+
+// WithRelayInterval 设置发布间隔，默认为 DefaultRelayInterval
+func WithRelayInterval(interval time.Duration) RelayOption {
+	return func(r *Relay) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// WithBatchSize 设置每次读取的事件数量，默认为 DefaultRelayBatchSize
+func WithBatchSize(size int) RelayOption {
+	return func(r *Relay) {
+		if size > 0 {
+			r.batchSize = size
+		}
+	}
+}
+
+// WithErrorHandler 设置定时发布失败时的回调，可用于记录日志或告警
+func WithErrorHandler(onError func(error)) RelayOption {
+	return func(r *Relay) {
+		r.onError = onError
+	}
+}
+
+// NewRelay 创建 Relay 并启动定时发布
+func NewRelay(store Store, publisher Publisher, opts ...RelayOption) *Relay {
+	r := &Relay{
+		store:     store,
+		publisher: publisher,
+		interval:  DefaultRelayInterval,
+		batchSize: DefaultRelayBatchSize,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	r.task = task.NewRepeatedTask(r.interval, func(time.Time) {
+		if _, err := r.Flush(r.ctx); err != nil && r.onError != nil {
+			r.onError(err)
+		}
+	})
+	r.task.Start()
+	return r
+}
+
+// Flush 立即发布一批尚未发布的事件，返回发布成功的事件数量
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	r.flushLock.Lock()
+	defer r.flushLock.Unlock()
+
+	events, err := r.store.Pending(ctx, r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("load pending outbox events err:%v", err)
+	}
+	for i, event := range events {
+		if err = r.publisher.Publish(ctx, event); err != nil {
+			return i, fmt.Errorf("publish outbox event %s err:%v", event.ID, err)
+		}
+		if err = r.store.MarkPublished(ctx, event.ID); err != nil {
+			return i + 1, fmt.Errorf("mark outbox event %s published err:%v", event.ID, err)
+		}
+	}
+	return len(events), nil
+}
+
+// Stop 停止定时发布，正在进行的发布将被取消
+func (r *Relay) Stop() {
+	r.cancel()
+
+	r.stopLock.Lock()
+	defer r.stopLock.Unlock()
+
+	r.task.Stop()
+}
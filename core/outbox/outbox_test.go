@@ -0,0 +1,88 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+func TestNotifyEvent(t *testing.T) {
+	req := &notify.Request{
+		ID:       "EV-2018022511223320873",
+		Resource: &notify.EncryptedResource{Plaintext: `{"trade_state":"SUCCESS"}`},
+	}
+	event := NotifyEvent(req, "payment", "1217752501201407033233368018")
+	assert.Equal(t, &Event{
+		ID:      "EV-2018022511223320873",
+		Topic:   "payment",
+		Key:     "1217752501201407033233368018",
+		Payload: []byte(`{"trade_state":"SUCCESS"}`),
+	}, event)
+}
+
+func TestRelay_Flush(t *testing.T) {
+	ctx := context.Background()
+	db, _ := openFakeDB(t)
+	defer func() { _ = db.Close() }()
+	store := NewSQLStore(db)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		event := &Event{ID: "EV-" + strconv.Itoa(i), CreateTime: start.Add(time.Duration(i) * time.Second)}
+		require.NoError(t, store.Add(ctx, db, event))
+	}
+
+	var published []string
+	var failOn string
+	relay := NewRelay(store, PublisherFunc(func(_ context.Context, event *Event) error {
+		if event.ID == failOn {
+			return fmt.Errorf("broker unavailable")
+		}
+		published = append(published, event.ID)
+		return nil
+	}), WithRelayInterval(time.Hour), WithBatchSize(2))
+	defer relay.Stop()
+
+	// 发布失败时停止本轮发布，下一轮从失败的事件开始
+	failOn = "EV-1"
+	n, err := relay.Flush(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 1, n)
+
+	failOn = ""
+	n, err = relay.Flush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	n, err = relay.Flush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, []string{"EV-0", "EV-1", "EV-2"}, published)
+}
+
+func TestRelay_Periodic(t *testing.T) {
+	ctx := context.Background()
+	db, _ := openFakeDB(t)
+	defer func() { _ = db.Close() }()
+	store := NewSQLStore(db)
+	require.NoError(t, store.Add(ctx, db, &Event{ID: "EV-1"}))
+
+	published := make(chan string, 1)
+	relay := NewRelay(store, PublisherFunc(func(_ context.Context, event *Event) error {
+		published <- event.ID
+		return nil
+	}), WithRelayInterval(10*time.Millisecond))
+	defer relay.Stop()
+
+	select {
+	case id := <-published:
+		assert.Equal(t, "EV-1", id)
+	case <-time.After(time.Second):
+		t.Fatal("event not published")
+	}
+}
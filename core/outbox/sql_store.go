@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/store"
+)
+
+// DefaultTableName SQLStore 默认使用的表名
+const DefaultTableName = "wechatpay_outbox"
+
+// MySQLSchema SQLStore 在 MySQL 中使用的表结构，其他数据库请按需调整字段类型
+const MySQLSchema = `CREATE TABLE wechatpay_outbox (
+  id             VARCHAR(64)  NOT NULL PRIMARY KEY,
+  topic          VARCHAR(128) NOT NULL,
+  event_key      VARCHAR(128) NOT NULL,
+  payload        BLOB         NOT NULL,
+  create_time    DATETIME(6)  NOT NULL,
+  published_time DATETIME(6)  NULL,
+  KEY idx_pending (published_time, create_time)
+)`
+
+// SQLStore 基于 database/sql 的发件箱存储，表结构见 MySQLSchema
+type SQLStore struct {
+	db          *sql.DB
+	table       string
+	placeholder store.Placeholder
+}
+
+// SQLStoreOption SQLStore 的配置项
+type SQLStoreOption func(s *SQLStore)
+
+// WithTableName 设置发件箱的表名，默认为 DefaultTableName
+func WithTableName(table string) SQLStoreOption {
+	return func(s *SQLStore) {
+		if table != "" {
+			s.table = table
+		}
+	}
+}
+
+// WithPlaceholder 设置 SQL 语句的参数占位符，默认为 store.QuestionPlaceholder
+func WithPlaceholder(placeholder store.Placeholder) SQLStoreOption {
+	return func(s *SQLStore) {
+		if placeholder != nil {
+			s.placeholder = placeholder
+		}
+	}
+}
+
+// NewSQLStore 使用数据库连接创建 SQLStore，db 用于读取与标记待发布的事件，事件的写入使用 Add 传入的业务事务
+func NewSQLStore(db *sql.DB, opts ...SQLStoreOption) *SQLStore {
+	s := &SQLStore{db: db, table: DefaultTableName, placeholder: store.QuestionPlaceholder}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Add 在业务事务 tx 中写入事件，事件 ID 已存在时返回 ErrDuplicate
+//
+// 并发写入同一事件 ID 时，主键冲突的事务将返回数据库的错误，该错误应使回调通知应答失败，微信支付重发后将得到 ErrDuplicate
+func (s *SQLStore) Add(ctx context.Context, tx Tx, event *Event) error {
+	if event == nil || event.ID == "" {
+		return fmt.Errorf("outbox event id is required")
+	}
+	var count int
+	query := s.placeholder.Bind("SELECT COUNT(*) FROM " + s.table + " WHERE id = ?")
+	if err := tx.QueryRowContext(ctx, query, event.ID).Scan(&count); err != nil {
+		return fmt.Errorf("query outbox event %s err:%v", event.ID, err)
+	}
+	if count > 0 {
+		return ErrDuplicate
+	}
+
+	createTime := event.CreateTime
+	if createTime.IsZero() {
+		createTime = auth.Now(ctx)
+	}
+	query = s.placeholder.Bind("INSERT INTO " + s.table +
+		" (id, topic, event_key, payload, create_time) VALUES (?, ?, ?, ?, ?)")
+	if _, err := tx.ExecContext(ctx, query, event.ID, event.Topic, event.Key, event.Payload, createTime); err != nil {
+		return fmt.Errorf("insert outbox event %s err:%v", event.ID, err)
+	}
+	return nil
+}
+
+// Pending 按写入顺序返回最多 limit 个尚未发布的事件
+func (s *SQLStore) Pending(ctx context.Context, limit int) ([]*Event, error) {
+	query := s.placeholder.Bind("SELECT id, topic, event_key, payload, create_time FROM " + s.table +
+		" WHERE published_time IS NULL ORDER BY create_time LIMIT ?")
+	rows, err := s.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var events []*Event
+	for rows.Next() {
+		event := new(Event)
+		if err = rows.Scan(&event.ID, &event.Topic, &event.Key, &event.Payload, &event.CreateTime); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// MarkPublished 将事件标记为已发布
+func (s *SQLStore) MarkPublished(ctx context.Context, id string) error {
+	query := s.placeholder.Bind("UPDATE " + s.table + " SET published_time = ? WHERE id = ?")
+	_, err := s.db.ExecContext(ctx, query, auth.Now(ctx), id)
+	return err
+}
+
+// RunInTx 在 db 的事务中执行 fn，fn 返回 nil 时提交事务，否则回滚事务并返回 fn 的错误
+//
+// 使用 sqlx 或 gorm 时，可以直接使用其事务方法，并将事务传给 Store.Add，见 Tx
+func RunInTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	if err = fn(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
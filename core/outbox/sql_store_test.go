@@ -0,0 +1,261 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/store"
+)
+
+// fakeDB 模拟 SQLStore 所使用语句的内存数据库，事务中写入的事件在提交后才可见
+type fakeDB struct {
+	mu      sync.Mutex
+	rows    map[string]*fakeRow
+	queries []string
+}
+
+type fakeRow struct {
+	event     Event
+	published bool
+}
+
+var (
+	fakeDBsLock sync.Mutex
+	fakeDBs     = map[string]*fakeDB{}
+)
+
+func init() {
+	sql.Register("outboxtest", fakeDriver{})
+}
+
+func openFakeDB(t *testing.T) (*sql.DB, *fakeDB) {
+	fake := &fakeDB{rows: map[string]*fakeRow{}}
+	fakeDBsLock.Lock()
+	fakeDBs[t.Name()] = fake
+	fakeDBsLock.Unlock()
+
+	db, err := sql.Open("outboxtest", t.Name())
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	return db, fake
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeDBsLock.Lock()
+	defer fakeDBsLock.Unlock()
+	return &fakeConn{db: fakeDBs[name]}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+	tx *fakeTx
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.tx = &fakeTx{conn: c}
+	return c.tx, nil
+}
+
+type fakeTx struct {
+	conn    *fakeConn
+	pending []*fakeRow
+}
+
+func (tx *fakeTx) Commit() error {
+	db := tx.conn.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, row := range tx.pending {
+		if _, ok := db.rows[row.event.ID]; ok {
+			return fmt.Errorf("duplicate primary key %s", row.event.ID)
+		}
+		db.rows[row.event.ID] = row
+	}
+	tx.conn.tx = nil
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.conn.tx = nil
+	return nil
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	db := s.conn.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.queries = append(db.queries, s.query)
+
+	switch {
+	case strings.HasPrefix(s.query, "INSERT INTO"):
+		row := &fakeRow{event: Event{
+			ID:         args[0].(string),
+			Topic:      args[1].(string),
+			Key:        args[2].(string),
+			Payload:    args[3].([]byte),
+			CreateTime: args[4].(time.Time),
+		}}
+		if s.conn.tx == nil {
+			db.rows[row.event.ID] = row
+		} else {
+			s.conn.tx.pending = append(s.conn.tx.pending, row)
+		}
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(s.query, "UPDATE"):
+		row, ok := db.rows[args[1].(string)]
+		if !ok {
+			return driver.RowsAffected(0), nil
+		}
+		row.published = true
+		return driver.RowsAffected(1), nil
+	}
+	return nil, fmt.Errorf("unexpected exec %s", s.query)
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	db := s.conn.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.queries = append(db.queries, s.query)
+
+	switch {
+	case strings.HasPrefix(s.query, "SELECT COUNT(*)"):
+		count := int64(0)
+		if _, ok := db.rows[args[0].(string)]; ok {
+			count = 1
+		}
+		return &fakeRows{columns: []string{"count"}, values: [][]driver.Value{{count}}}, nil
+	case strings.HasPrefix(s.query, "SELECT id"):
+		var pending []*fakeRow
+		for _, row := range db.rows {
+			if !row.published {
+				pending = append(pending, row)
+			}
+		}
+		sort.Slice(pending, func(i, j int) bool {
+			return pending[i].event.CreateTime.Before(pending[j].event.CreateTime)
+		})
+		if limit := int(args[0].(int64)); len(pending) > limit {
+			pending = pending[:limit]
+		}
+		rows := &fakeRows{columns: []string{"id", "topic", "event_key", "payload", "create_time"}}
+		for _, row := range pending {
+			e := row.event
+			rows.values = append(rows.values, []driver.Value{e.ID, e.Topic, e.Key, e.Payload, e.CreateTime})
+		}
+		return rows, nil
+	}
+	return nil, fmt.Errorf("unexpected query %s", s.query)
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestSQLStore_Add(t *testing.T) {
+	ctx := context.Background()
+	db, fake := openFakeDB(t)
+	defer func() { _ = db.Close() }()
+	store := NewSQLStore(db)
+
+	event := &Event{ID: "EV-2018022511223320873", Topic: "payment", Key: "1217752501201407033233368018",
+		Payload: []byte(`{"trade_state":"SUCCESS"}`)}
+	require.NoError(t, RunInTx(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+		return store.Add(ctx, tx, event)
+	}))
+
+	// 同一事件再次写入时返回 ErrDuplicate，业务事务随之回滚
+	err := RunInTx(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+		return store.Add(ctx, tx, event)
+	})
+	assert.Equal(t, ErrDuplicate, err)
+
+	// 业务事务回滚时，事件不会写入
+	err = RunInTx(ctx, db, func(ctx context.Context, tx *sql.Tx) error {
+		if err := store.Add(ctx, tx, &Event{ID: "EV-2"}); err != nil {
+			return err
+		}
+		return fmt.Errorf("update order failed")
+	})
+	assert.EqualError(t, err, "update order failed")
+
+	events, err := store.Pending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, event.ID, events[0].ID)
+	assert.Equal(t, event.Key, events[0].Key)
+	assert.Equal(t, event.Payload, events[0].Payload)
+	assert.False(t, events[0].CreateTime.IsZero())
+
+	require.NoError(t, store.MarkPublished(ctx, event.ID))
+	events, err = store.Pending(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	assert.Error(t, store.Add(ctx, db, &Event{}))
+	assert.Contains(t, fake.queries, "SELECT COUNT(*) FROM wechatpay_outbox WHERE id = ?")
+}
+
+func TestSQLStore_Placeholder(t *testing.T) {
+	ctx := context.Background()
+	db, fake := openFakeDB(t)
+	defer func() { _ = db.Close() }()
+	s := NewSQLStore(db, WithTableName("payment_outbox"), WithPlaceholder(store.DollarPlaceholder))
+
+	require.NoError(t, s.Add(ctx, db, &Event{ID: "EV-1"}))
+	assert.Equal(t, []string{
+		"SELECT COUNT(*) FROM payment_outbox WHERE id = $1",
+		"INSERT INTO payment_outbox (id, topic, event_key, payload, create_time) VALUES ($1, $2, $3, $4, $5)",
+	}, fake.queries)
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+type contextKey string
+
+func (c contextKey) String() string {
+	return "WPClientContext(" + string(c) + ")"
+}
+
+const (
+	// 请求包体的发送进度回调
+	contextKeyRequestProgress contextKey = "RequestProgress"
+)
+
+// ProgressFunc 请求包体的发送进度回调，sent 为已发送的字节数，total 为包体的总字节数（未知时为 -1）
+type ProgressFunc func(sent, total int64)
+
+// WithRequestProgress 设置本次请求包体的发送进度回调，返回更新后的 Context，适用于上传较大的图片、视频等文件
+//
+// 回调在发送请求的 goroutine 中同步执行，请勿在回调中执行耗时操作。请求被重新发送时，sent 将从 0 开始重新计数。
+//
+//	ctx = core.WithRequestProgress(ctx, func(sent, total int64) { log.Printf("%d/%d", sent, total) })
+func WithRequestProgress(ctx context.Context, progress ProgressFunc) context.Context {
+	return context.WithValue(ctx, contextKeyRequestProgress, progress)
+}
+
+// getRequestProgress 从 Context 中读取请求包体的发送进度回调，未设置时返回 nil
+func getRequestProgress(ctx context.Context) ProgressFunc {
+	progress, _ := ctx.Value(contextKeyRequestProgress).(ProgressFunc)
+	return progress
+}
+
+// trackRequestProgress 为请求包体设置发送进度回调
+func trackRequestProgress(request *http.Request, progress ProgressFunc) {
+	if progress == nil || request.Body == nil || request.Body == http.NoBody {
+		return
+	}
+	total := request.ContentLength
+	if total == 0 {
+		total = -1
+	}
+	request.Body = &progressBody{ReadCloser: request.Body, total: total, progress: progress}
+	if getBody := request.GetBody; getBody != nil {
+		request.GetBody = func() (io.ReadCloser, error) {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			return &progressBody{ReadCloser: body, total: total, progress: progress}, nil
+		}
+	}
+}
+
+// progressBody 统计已读取（发送）的字节数
+type progressBody struct {
+	io.ReadCloser
+	sent     int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (b *progressBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.sent += int64(n)
+		b.progress(b.sent, b.total)
+	}
+	return n, err
+}
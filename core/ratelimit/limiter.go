@@ -0,0 +1,26 @@
+// Package ratelimit 微信支付 API v3 Go SDK 请求限流相关接口与令牌桶实现
+//
+// 为 core.Client 设置 Limiter 后，每个请求在签名与发送前都会调用 Limiter.Wait 等待配额，
+// 可按接口路径与商户号分别限流，避免对账等批量任务占满配额，影响下单等关键接口，或触发微信支付的频率限制（FREQUENCY_LIMITED）。
+package ratelimit
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrLimitExceeded 等待配额所需的时间超过了允许的最长等待时间
+var ErrLimitExceeded = errors.New("rate limit exceeded")
+
+// Key 限流维度，包括商户号与本次请求的 HTTP 方法、接口路径
+type Key struct {
+	MchID  string // 商户号，签名器无法提供商户号时为空
+	Method string // HTTP 方法，如 POST
+	Path   string // 接口路径，不包含域名与查询参数，如 /v3/pay/transactions/native
+}
+
+// Limiter 请求限流器
+type Limiter interface {
+	// Wait 等待请求配额，获得配额后返回 nil。ctx 结束或无法获得配额时返回错误，请求将不会被发送
+	Wait(ctx context.Context, key Key) error
+}
@@ -0,0 +1,203 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rule 限流规则，匹配的请求按商户号分别使用独立的令牌桶
+type Rule struct {
+	Method     string  // HTTP 方法，为空时匹配所有方法
+	PathPrefix string  // 接口路径前缀，如 /v3/pay/transactions/。为 / 时匹配所有接口，可作为默认规则
+	Rate       float64 // 每秒产生的令牌数，即每秒允许的平均请求数
+	Burst      int     // 令牌桶容量，即允许的最大突发请求数，小于 1 时按 1 处理
+}
+
+// matches 判断请求是否匹配规则
+func (r *Rule) matches(key Key) bool {
+	if r.Method != "" && !strings.EqualFold(r.Method, key.Method) {
+		return false
+	}
+	return strings.HasPrefix(key.Path, r.PathPrefix)
+}
+
+// TokenBucketLimiter 基于令牌桶的请求限流器
+//
+// 请求使用匹配的规则中路径前缀最长的一条（前缀长度相同时，指定了 Method 的规则优先）；
+// 不匹配任何规则的请求不限流。同一规则下不同商户号的请求使用独立的令牌桶。
+type TokenBucketLimiter struct {
+	rules   []Rule
+	maxWait time.Duration
+
+	lock    sync.Mutex
+	buckets map[bucketKey]*tokenBucket
+}
+
+// TokenBucketLimiterOption TokenBucketLimiter 的配置项
+type TokenBucketLimiterOption func(l *TokenBucketLimiter)
+
+// WithMaxWait 设置等待配额的最长时间，所需等待时间超过 maxWait 时 Wait 立即返回 ErrLimitExceeded。
+// 默认为 0，表示一直等待至获得配额或 ctx 结束
+func WithMaxWait(maxWait time.Duration) TokenBucketLimiterOption {
+	return func(l *TokenBucketLimiter) {
+		if maxWait >= 0 {
+			l.maxWait = maxWait
+		}
+	}
+}
+
+// NewTokenBucketLimiter 使用限流规则创建 TokenBucketLimiter
+func NewTokenBucketLimiter(rules []Rule, opts ...TokenBucketLimiterOption) (*TokenBucketLimiter, error) {
+	l := &TokenBucketLimiter{
+		rules:   make([]Rule, 0, len(rules)),
+		buckets: make(map[bucketKey]*tokenBucket),
+	}
+	for i, rule := range rules {
+		if rule.PathPrefix == "" {
+			return nil, fmt.Errorf("path prefix of rule %d is required", i)
+		}
+		if rule.Rate <= 0 {
+			return nil, fmt.Errorf("rate of rule %d must be positive", i)
+		}
+		if rule.Burst < 1 {
+			rule.Burst = 1
+		}
+		l.rules = append(l.rules, rule)
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l, nil
+}
+
+// Wait 等待请求配额
+func (l *TokenBucketLimiter) Wait(ctx context.Context, key Key) error {
+	bucket := l.bucket(key)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.wait(ctx, l.maxWait)
+}
+
+// bucket 返回请求使用的令牌桶，不匹配任何规则时返回 nil
+func (l *TokenBucketLimiter) bucket(key Key) *tokenBucket {
+	index := -1
+	for i := range l.rules {
+		rule := &l.rules[i]
+		if !rule.matches(key) {
+			continue
+		}
+		if index < 0 {
+			index = i
+			continue
+		}
+		matched := &l.rules[index]
+		if len(rule.PathPrefix) > len(matched.PathPrefix) ||
+			(len(rule.PathPrefix) == len(matched.PathPrefix) && rule.Method != "" && matched.Method == "") {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+
+	k := bucketKey{rule: index, mchID: key.MchID}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	bucket, ok := l.buckets[k]
+	if !ok {
+		rule := l.rules[index]
+		bucket = newTokenBucket(rule.Rate, rule.Burst)
+		l.buckets[k] = bucket
+	}
+	return bucket
+}
+
+type bucketKey struct {
+	rule  int
+	mchID string
+}
+
+// tokenBucket 令牌桶。令牌数可以为负，表示已被预约的未来令牌
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// reserve 预约一个令牌，返回需要等待的时间。需要等待的时间超过 maxWait（maxWait 大于 0 时）则不预约，ok 为 false
+func (b *tokenBucket) reserve(now time.Time, maxWait time.Duration) (delay time.Duration, ok bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+
+	tokens := b.tokens - 1
+	if tokens < 0 {
+		delay = time.Duration(-tokens / b.rate * float64(time.Second))
+	}
+	if maxWait > 0 && delay > maxWait {
+		return delay, false
+	}
+	b.tokens = tokens
+	return delay, true
+}
+
+// cancel 归还预约的令牌
+func (b *tokenBucket) cancel() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context, maxWait time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		if untilDeadline := time.Until(deadline); maxWait <= 0 || untilDeadline < maxWait {
+			// 截止时间前无法获得配额时直接返回，不占用令牌
+			maxWait = untilDeadline
+			if maxWait <= 0 {
+				return ctx.Err()
+			}
+		}
+	}
+
+	delay, ok := b.reserve(time.Now(), maxWait)
+	if !ok {
+		return fmt.Errorf("%w: need to wait %v", ErrLimitExceeded, delay)
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
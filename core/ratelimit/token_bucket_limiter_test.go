@@ -0,0 +1,95 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/ratelimit"
+)
+
+var (
+	prepayKey = ratelimit.Key{MchID: "1900000001", Method: "POST", Path: "/v3/pay/transactions/native"}
+	queryKey  = ratelimit.Key{MchID: "1900000001", Method: "GET", Path: "/v3/pay/transactions/out-trade-no/123"}
+	billKey   = ratelimit.Key{MchID: "1900000001", Method: "GET", Path: "/v3/bill/tradebill"}
+)
+
+func TestNewTokenBucketLimiter(t *testing.T) {
+	_, err := ratelimit.NewTokenBucketLimiter([]ratelimit.Rule{{PathPrefix: "", Rate: 1}})
+	assert.Error(t, err)
+	_, err = ratelimit.NewTokenBucketLimiter([]ratelimit.Rule{{PathPrefix: "/", Rate: 0}})
+	assert.Error(t, err)
+}
+
+func TestTokenBucketLimiter_Wait(t *testing.T) {
+	ctx := context.Background()
+	limiter, err := ratelimit.NewTokenBucketLimiter([]ratelimit.Rule{
+		{PathPrefix: "/v3/pay/transactions/", Rate: 10, Burst: 2},
+		{Method: "POST", PathPrefix: "/v3/pay/transactions/", Rate: 1000, Burst: 100},
+	})
+	require.NoError(t, err)
+
+	// 不匹配任何规则时不限流
+	for i := 0; i < 100; i++ {
+		require.NoError(t, limiter.Wait(ctx, billKey))
+	}
+
+	// 指定了 Method 的规则优先，下单不受查询配额的影响
+	for i := 0; i < 50; i++ {
+		require.NoError(t, limiter.Wait(ctx, prepayKey))
+	}
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, limiter.Wait(ctx, queryKey))
+	}
+	// 前 2 个请求使用突发配额，后 2 个请求各等待约 100ms
+	assert.True(t, time.Since(start) >= 150*time.Millisecond)
+
+	// 不同商户号使用独立的令牌桶
+	otherMch := queryKey
+	otherMch.MchID = "1900000002"
+	start = time.Now()
+	require.NoError(t, limiter.Wait(ctx, otherMch))
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+}
+
+func TestTokenBucketLimiter_LongestPrefix(t *testing.T) {
+	ctx := context.Background()
+	limiter, err := ratelimit.NewTokenBucketLimiter([]ratelimit.Rule{
+		{PathPrefix: "/", Rate: 0.001, Burst: 1},
+		{PathPrefix: "/v3/pay/", Rate: 1000, Burst: 100},
+	}, ratelimit.WithMaxWait(time.Millisecond))
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, limiter.Wait(ctx, prepayKey))
+	}
+	require.NoError(t, limiter.Wait(ctx, billKey))
+	assert.True(t, errors.Is(limiter.Wait(ctx, billKey), ratelimit.ErrLimitExceeded))
+}
+
+func TestTokenBucketLimiter_WaitContext(t *testing.T) {
+	limiter, err := ratelimit.NewTokenBucketLimiter([]ratelimit.Rule{{PathPrefix: "/", Rate: 5, Burst: 1}})
+	require.NoError(t, err)
+	require.NoError(t, limiter.Wait(context.Background(), billKey))
+
+	// 截止时间前无法获得配额时立即返回
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.True(t, errors.Is(limiter.Wait(ctx, billKey), ratelimit.ErrLimitExceeded))
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+
+	// 等待过程中 ctx 被取消时返回 ctx.Err()，并归还预约的令牌
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	assert.Equal(t, context.Canceled, limiter.Wait(ctx, billKey))
+
+	start = time.Now()
+	require.NoError(t, limiter.Wait(context.Background(), billKey))
+	assert.True(t, time.Since(start) < 250*time.Millisecond)
+}
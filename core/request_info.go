@@ -0,0 +1,65 @@
+package core
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// 请求的日志关联信息
+	contextKeyRequestInfo contextKey = "RequestInfo"
+	// WithRequestInfo 设置的、记录最近一次请求信息的 RequestInfo
+	contextKeyRequestInfoRecorder contextKey = "RequestInfoRecorder"
+)
+
+// RequestInfo 请求的日志关联信息，可用于将应用日志与 SDK 日志、微信支付商户平台的请求记录关联
+type RequestInfo struct {
+	MchID     string // 商户号，签名器无法提供商户号时为空
+	Method    string // HTTP 方法
+	Path      string // 接口路径，不包含域名与查询参数
+	RequestID string // 微信支付应答头中的 Request-Id，收到应答前为空
+}
+
+// String 返回适合写入日志的格式，如 mchid=1900000109 method=GET path=/v3/certificates request_id=08F78BC...
+func (i RequestInfo) String() string {
+	return fmt.Sprintf("mchid=%s method=%s path=%s request_id=%s", i.MchID, i.Method, i.Path, i.RequestID)
+}
+
+// WithRequestInfo 返回可以记录请求日志关联信息的 Context，以及记录信息的 RequestInfo
+//
+// Client 为每次请求设置新的 RequestInfo，请求结束后将其复制到返回的 RequestInfo 中，
+// 因此使用返回的 Context 发出请求后，RequestInfo 中为最近一次请求的信息。请勿使用同一 Context 并发地发出请求。
+//
+//	ctx, info := core.WithRequestInfo(ctx)
+//	resp, _, err := svc.QueryOrderByOutTradeNo(ctx, req)
+//	log.Printf("query order err:%v %s", err, info)
+func WithRequestInfo(ctx context.Context) (context.Context, *RequestInfo) {
+	info := new(RequestInfo)
+	ctx = context.WithValue(ctx, contextKeyRequestInfoRecorder, info)
+	return context.WithValue(ctx, contextKeyRequestInfo, info), info
+}
+
+// AttachRequestInfo 为 ctx 设置日志关联信息，可通过 RequestInfoFromContext 读取
+//
+// 与 WithRequestInfo 不同，使用返回的 Context 发出的请求不会修改该信息，适用于以接收到的请求（如回调通知）作为日志关联信息的场景
+func AttachRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, contextKeyRequestInfo, &info)
+}
+
+// RequestInfoFromContext 读取 Context 中的请求日志关联信息
+//
+// Client 发出请求时总是在请求的 Context 中设置该信息，因此限流器、熔断器、签名器、验签器及自定义 http.RoundTripper
+// 均可以通过请求的 Context 读取。回调通知的处理函数同样可以读取，其中 RequestID 为通知请求头中的 Request-Id
+func RequestInfoFromContext(ctx context.Context) (*RequestInfo, bool) {
+	info, ok := ctx.Value(contextKeyRequestInfo).(*RequestInfo)
+	return info, ok && info != nil
+}
+
+// recordRequestInfo 将 ctx 中本次请求的日志关联信息复制到 WithRequestInfo 设置的 RequestInfo
+func recordRequestInfo(ctx context.Context) {
+	info, ok := RequestInfoFromContext(ctx)
+	recorder, _ := ctx.Value(contextKeyRequestInfoRecorder).(*RequestInfo)
+	if ok && recorder != nil && recorder != info {
+		*recorder = *info
+	}
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// QueryParam 查询参数
+type QueryParam struct {
+	Key   string
+	Value string
+}
+
+// BuildRequestURL 为 baseURL 追加查询参数，返回可以直接发送（签名与实际请求一致）的请求地址
+//
+// 查询参数按 params 的顺序排列，同名的参数全部保留；参数名与值中的中文按 UTF-8 编码，空格编码为 %20，
+// + & = 等字符编码为 %2B %26 %3D，不会被微信支付解析为空格或参数分隔符。
+// baseURL 中已有的查询参数保留在前，其中未编码的中文、空格等字符会按 auth.NormalizeQuery 编码。
+//
+//	requestURL, err := core.BuildRequestURL("https://api.mch.weixin.qq.com/v3/marketing/favor/stocks",
+//		core.QueryParam{Key: "stock_creator_mchid", Value: "1900000109"},
+//		core.QueryParam{Key: "offset", Value: "0"},
+//	)
+func BuildRequestURL(baseURL string, params ...QueryParam) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse request url err:%v", err)
+	}
+	u.Fragment = ""
+
+	query := make([]string, 0, len(params)+1)
+	if u.RawQuery != "" {
+		query = append(query, auth.NormalizeQuery(u.RawQuery))
+	}
+	for _, param := range params {
+		query = append(query, escapeQueryComponent(param.Key)+"="+escapeQueryComponent(param.Value))
+	}
+	u.RawQuery = strings.Join(query, "&")
+	return u.String(), nil
+}
+
+// escapeQueryComponent 编码查询参数的名称或值，空格编码为 %20 而不是 +
+func escapeQueryComponent(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
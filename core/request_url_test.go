@@ -0,0 +1,93 @@
+package core
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+func TestBuildRequestURL(t *testing.T) {
+	const base = "https://api.mch.weixin.qq.com/v3/resource"
+	tests := []struct {
+		name    string
+		baseURL string
+		params  []QueryParam
+		want    string
+	}{
+		{name: "no params", baseURL: base, want: base},
+		{
+			name:    "order preserved",
+			baseURL: base,
+			params:  []QueryParam{{"offset", "0"}, {"limit", "10"}, {"bill_date", "2019-06-11"}},
+			want:    base + "?offset=0&limit=10&bill_date=2019-06-11",
+		},
+		{
+			name:    "chinese",
+			baseURL: base,
+			params:  []QueryParam{{"store_name", "腾讯大厦分店"}},
+			want:    base + "?store_name=%E8%85%BE%E8%AE%AF%E5%A4%A7%E5%8E%A6%E5%88%86%E5%BA%97",
+		},
+		{
+			name:    "plus and space",
+			baseURL: base,
+			params:  []QueryParam{{"sign", "a+b/c=="}, {"remark", "hello world"}},
+			want:    base + "?sign=a%2Bb%2Fc%3D%3D&remark=hello%20world",
+		},
+		{
+			name:    "separators in value",
+			baseURL: base,
+			params:  []QueryParam{{"q", "a&b=c?d#e"}},
+			want:    base + "?q=a%26b%3Dc%3Fd%23e",
+		},
+		{
+			name:    "repeated params",
+			baseURL: base,
+			params:  []QueryParam{{"state", "SUCCESS"}, {"state", "REFUND"}, {"state", "SUCCESS"}},
+			want:    base + "?state=SUCCESS&state=REFUND&state=SUCCESS",
+		},
+		{
+			name:    "empty value",
+			baseURL: base,
+			params:  []QueryParam{{"empty", ""}},
+			want:    base + "?empty=",
+		},
+		{
+			name:    "existing query normalized",
+			baseURL: base + "?city=深圳 南山&limit=10",
+			params:  []QueryParam{{"offset", "0"}},
+			want:    base + "?city=%E6%B7%B1%E5%9C%B3%20%E5%8D%97%E5%B1%B1&limit=10&offset=0",
+		},
+		{
+			name:    "chinese path",
+			baseURL: "https://api.mch.weixin.qq.com/v3/商品/详情#top",
+			params:  []QueryParam{{"id", "1"}},
+			want:    "https://api.mch.weixin.qq.com/v3/%E5%95%86%E5%93%81/%E8%AF%A6%E6%83%85?id=1",
+		},
+		{
+			name:    "path only",
+			baseURL: "/v3/certificates",
+			params:  []QueryParam{{"x", "中"}},
+			want:    "/v3/certificates?x=%E4%B8%AD",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildRequestURL(tt.baseURL, tt.params...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			// 签名使用的 URL 与实际发出的请求一致
+			canonicalURL, err := auth.CanonicalURL(got)
+			require.NoError(t, err)
+			request, err := http.NewRequest(http.MethodGet, got, nil)
+			require.NoError(t, err)
+			assert.Equal(t, request.URL.RequestURI(), canonicalURL)
+		})
+	}
+
+	_, err := BuildRequestURL("https://api.mch.weixin.qq.com/%zz")
+	assert.Error(t, err)
+}
@@ -0,0 +1,206 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+const (
+	// DefaultCacheTTL 解析结果的默认缓存时间
+	DefaultCacheTTL = time.Minute
+	// DefaultStaleTTL 解析失败时，过期的解析结果默认可以继续使用的时间
+	DefaultStaleTTL = time.Hour
+)
+
+// cacheEntry 域名的解析结果
+type cacheEntry struct {
+	addrs     []string
+	resolveAt time.Time
+}
+
+// CachingResolver 缓存解析结果的域名解析器
+//
+// 解析结果在缓存时间内直接返回；过期后重新解析，解析失败时在 staleTTL 内继续返回过期的结果。
+// 使用 WithStaticHost 指定了 IP 地址的域名不进行解析。可使用 StartRefresh 在后台定期刷新微信支付 API 域名的解析结果，
+// 使请求不必等待解析。
+type CachingResolver struct {
+	base     Resolver
+	ttl      time.Duration
+	staleTTL time.Duration
+	static   map[string][]string
+
+	lock    sync.Mutex
+	entries map[string]*cacheEntry
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// CachingResolverOption CachingResolver 的配置项
+type CachingResolverOption func(r *CachingResolver)
+
+// WithCacheTTL 设置解析结果的缓存时间，默认为 DefaultCacheTTL
+func WithCacheTTL(ttl time.Duration) CachingResolverOption {
+	return func(r *CachingResolver) {
+		if ttl > 0 {
+			r.ttl = ttl
+		}
+	}
+}
+
+// WithStaleTTL 设置解析失败时过期的解析结果可以继续使用的时间（自缓存过期时起），默认为 DefaultStaleTTL，为 0 时不使用过期的结果
+func WithStaleTTL(ttl time.Duration) CachingResolverOption {
+	return func(r *CachingResolver) {
+		if ttl >= 0 {
+			r.staleTTL = ttl
+		}
+	}
+}
+
+// WithStaticHost 为域名 host 指定固定的 IP 地址，不再进行解析，适用于 DNS 故障时的紧急切换。可多次使用以指定多个域名
+func WithStaticHost(host string, ips ...string) CachingResolverOption {
+	return func(r *CachingResolver) {
+		r.static[host] = append([]string(nil), ips...)
+	}
+}
+
+// NewCachingResolver 创建 CachingResolver，base 为实际进行解析的解析器，为 nil 时使用 net.DefaultResolver
+func NewCachingResolver(base Resolver, opts ...CachingResolverOption) (*CachingResolver, error) {
+	if base == nil {
+		base = net.DefaultResolver
+	}
+	r := &CachingResolver{
+		base:     base,
+		ttl:      DefaultCacheTTL,
+		staleTTL: DefaultStaleTTL,
+		static:   make(map[string][]string),
+		entries:  make(map[string]*cacheEntry),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	for host, ips := range r.static {
+		if len(ips) == 0 {
+			return nil, fmt.Errorf("static host %s requires at least one ip", host)
+		}
+		for _, ip := range ips {
+			if net.ParseIP(ip) == nil {
+				return nil, fmt.Errorf("invalid ip %q for static host %s", ip, host)
+			}
+		}
+	}
+	return r, nil
+}
+
+// LookupHost 解析域名 host，优先使用固定的 IP 地址与缓存的解析结果
+func (r *CachingResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if ips, ok := r.static[host]; ok {
+		return append([]string(nil), ips...), nil
+	}
+
+	r.lock.Lock()
+	entry := r.entries[host]
+	r.lock.Unlock()
+	if entry != nil && time.Since(entry.resolveAt) < r.ttl {
+		return append([]string(nil), entry.addrs...), nil
+	}
+
+	addrs, err := r.resolve(ctx, host)
+	if err != nil {
+		if entry != nil && time.Since(entry.resolveAt) < r.ttl+r.staleTTL {
+			return append([]string(nil), entry.addrs...), nil
+		}
+		return nil, err
+	}
+	return addrs, nil
+}
+
+// Prefetch 解析并缓存 hosts 的解析结果，hosts 为空时解析微信支付 API 域名（包括备用域名）。返回第一个解析失败的错误
+func (r *CachingResolver) Prefetch(ctx context.Context, hosts ...string) error {
+	if len(hosts) == 0 {
+		hosts = wechatPayHosts()
+	}
+	var firstErr error
+	for _, host := range hosts {
+		if _, ok := r.static[host]; ok {
+			continue
+		}
+		if _, err := r.resolve(ctx, host); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// StartRefresh 在后台每隔 interval 刷新一次 hosts 的解析结果，hosts 为空时刷新微信支付 API 域名（包括备用域名）
+//
+// interval 应小于缓存时间，以便请求总能使用未过期的结果。重复调用时，先停止此前的刷新。使用 Stop 停止刷新
+func (r *CachingResolver) StartRefresh(interval time.Duration, hosts ...string) {
+	r.Stop()
+
+	stop := make(chan struct{})
+	r.lock.Lock()
+	r.stop = stop
+	r.lock.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			_ = r.Prefetch(ctx, hosts...)
+			cancel()
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop 停止后台刷新，并等待正在进行的刷新结束
+func (r *CachingResolver) Stop() {
+	r.lock.Lock()
+	stop := r.stop
+	r.stop = nil
+	r.lock.Unlock()
+	if stop != nil {
+		close(stop)
+	}
+	r.wg.Wait()
+}
+
+// resolve 使用 base 解析 host，成功时更新缓存
+func (r *CachingResolver) resolve(ctx context.Context, host string) ([]string, error) {
+	addrs, err := r.base.LookupHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("resolve %s err:no address found", host)
+	}
+
+	r.lock.Lock()
+	r.entries[host] = &cacheEntry{addrs: append([]string(nil), addrs...), resolveAt: time.Now()}
+	r.lock.Unlock()
+	return addrs, nil
+}
+
+// wechatPayHosts 返回微信支付 API 域名与备用域名
+func wechatPayHosts() []string {
+	var hosts []string
+	for _, server := range []string{consts.WechatPayAPIServer, consts.WechatPayAPIServerBackup} {
+		if u, err := url.Parse(server); err == nil {
+			hosts = append(hosts, u.Hostname())
+		}
+	}
+	return hosts
+}
@@ -0,0 +1,150 @@
+package resolver_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/resolver"
+)
+
+// fakeResolver 记录解析次数，并按设置返回解析结果或错误
+type fakeResolver struct {
+	lock    sync.Mutex
+	addrs   map[string][]string
+	err     error
+	lookups map[string]int
+}
+
+func newFakeResolver(addrs map[string][]string) *fakeResolver {
+	return &fakeResolver{addrs: addrs, lookups: make(map[string]int)}
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.lookups[host]++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.addrs[host], nil
+}
+
+func (r *fakeResolver) setErr(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.err = err
+}
+
+func (r *fakeResolver) count(host string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.lookups[host]
+}
+
+func TestNewCachingResolver(t *testing.T) {
+	_, err := resolver.NewCachingResolver(nil, resolver.WithStaticHost("api.mch.weixin.qq.com"))
+	assert.Error(t, err)
+	_, err = resolver.NewCachingResolver(nil, resolver.WithStaticHost("api.mch.weixin.qq.com", "not-an-ip"))
+	assert.Error(t, err)
+	_, err = resolver.NewCachingResolver(nil, resolver.WithStaticHost("api.mch.weixin.qq.com", "101.226.90.150"))
+	assert.NoError(t, err)
+}
+
+func TestCachingResolver_LookupHost(t *testing.T) {
+	ctx := context.Background()
+	base := newFakeResolver(map[string][]string{"api.mch.weixin.qq.com": {"101.226.90.150", "101.226.90.151"}})
+	r, err := resolver.NewCachingResolver(base,
+		resolver.WithCacheTTL(50*time.Millisecond),
+		resolver.WithStaleTTL(100*time.Millisecond),
+		resolver.WithStaticHost("api2.mch.weixin.qq.com", "183.3.234.100"),
+	)
+	require.NoError(t, err)
+
+	// 缓存时间内不重复解析
+	for i := 0; i < 3; i++ {
+		addrs, err := r.LookupHost(ctx, "api.mch.weixin.qq.com")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"101.226.90.150", "101.226.90.151"}, addrs)
+	}
+	assert.Equal(t, 1, base.count("api.mch.weixin.qq.com"))
+
+	// 固定的 IP 地址不进行解析
+	addrs, err := r.LookupHost(ctx, "api2.mch.weixin.qq.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"183.3.234.100"}, addrs)
+	assert.Equal(t, 0, base.count("api2.mch.weixin.qq.com"))
+
+	// 缓存过期后解析失败时继续使用过期的结果
+	base.setErr(errors.New("i/o timeout"))
+	time.Sleep(60 * time.Millisecond)
+	addrs, err = r.LookupHost(ctx, "api.mch.weixin.qq.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"101.226.90.150", "101.226.90.151"}, addrs)
+	assert.Equal(t, 2, base.count("api.mch.weixin.qq.com"))
+
+	// 超过 staleTTL 后返回解析错误
+	time.Sleep(100 * time.Millisecond)
+	_, err = r.LookupHost(ctx, "api.mch.weixin.qq.com")
+	assert.Error(t, err)
+
+	// 没有缓存时返回解析错误
+	_, err = r.LookupHost(ctx, "api.mch.weixin.qq.com.cn")
+	assert.Error(t, err)
+}
+
+func TestCachingResolver_Refresh(t *testing.T) {
+	base := newFakeResolver(map[string][]string{
+		"api.mch.weixin.qq.com":  {"101.226.90.150"},
+		"api2.mch.weixin.qq.com": {"183.3.234.100"},
+	})
+	r, err := resolver.NewCachingResolver(base)
+	require.NoError(t, err)
+
+	// 默认预解析微信支付 API 域名与备用域名
+	require.NoError(t, r.Prefetch(context.Background()))
+	assert.Equal(t, 1, base.count("api.mch.weixin.qq.com"))
+	assert.Equal(t, 1, base.count("api2.mch.weixin.qq.com"))
+
+	r.StartRefresh(20*time.Millisecond, "api.mch.weixin.qq.com")
+	require.Eventually(t, func() bool {
+		return base.count("api.mch.weixin.qq.com") >= 3
+	}, time.Second, 10*time.Millisecond)
+	r.Stop()
+	n := base.count("api.mch.weixin.qq.com")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, n, base.count("api.mch.weixin.qq.com"))
+	assert.Equal(t, 1, base.count("api2.mch.weixin.qq.com"))
+}
+
+func TestDialContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	_, port, err := net.SplitHostPort(u.Host)
+	require.NoError(t, err)
+
+	// 第一个地址无法连接时尝试下一个地址
+	base := newFakeResolver(map[string][]string{"wechatpay.test": {"127.0.0.2", "127.0.0.1"}})
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: resolver.DialContext(base, &net.Dialer{Timeout: time.Second}),
+	}}
+	resp, err := client.Get("http://wechatpay.test:" + port + "/v3/certificates")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err = client.Get("http://unknown.test:" + port + "/v3/certificates")
+	assert.Error(t, err)
+}
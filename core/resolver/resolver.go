@@ -0,0 +1,52 @@
+// Package resolver 微信支付 API v3 Go SDK 域名解析相关接口与缓存实现
+//
+// 为 core.Client 设置 Resolver 后，Client 建立连接时使用该解析器解析域名，而不是直接使用系统的 DNS 解析。
+// CachingResolver 缓存解析结果，并可在后台定期刷新，解析失败时在一定时间内继续使用过期的结果，
+// 避免容器环境中偶发的 DNS 故障导致下单等请求失败；还可以为域名指定固定的 IP 地址，用于故障时的紧急切换。
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// Resolver 域名解析器，*net.Resolver 实现了该接口
+type Resolver interface {
+	// LookupHost 解析域名 host，返回其 IP 地址列表
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+}
+
+// DialContext 返回使用 r 解析域名后建立连接的 DialContext 函数，可用于 http.Transport.DialContext
+//
+// 解析得到多个 IP 地址时按顺序尝试建立连接，返回第一个成功的连接。address 为 IP 地址时不进行解析。
+// 建立 TLS 连接时，证书校验仍使用请求的域名，不受解析结果的影响。
+func DialContext(r Resolver, dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		if net.ParseIP(host) != nil {
+			return dialer.DialContext(ctx, network, address)
+		}
+
+		addrs, err := r.LookupHost(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("resolve %s err:%w", host, err)
+		}
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("resolve %s err:no address found", host)
+		}
+		for _, addr := range addrs {
+			var conn net.Conn
+			if conn, err = dialer.DialContext(ctx, network, net.JoinHostPort(addr, port)); err == nil {
+				return conn, nil
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		return nil, err
+	}
+}
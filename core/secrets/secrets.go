@@ -0,0 +1,250 @@
+// Package secrets 微信支付 API v3 Go SDK 商户密钥管理，从密钥管理服务定时获取商户私钥与 APIv3 密钥，轮换后无需重启服务
+package secrets
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/signers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/decryptors"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils/task"
+)
+
+const (
+	// DefaultRefreshInterval Manager 默认的刷新间隔
+	DefaultRefreshInterval = 10 * time.Minute
+
+	apiV3KeyLength = 32
+)
+
+// Secrets 密钥管理服务中保存的商户密钥，JSON 字段名与 core/config 一致
+type Secrets struct {
+	// MchCertificateSerialNo 商户 API 证书序列号，为空时使用 NewManager 传入的序列号
+	MchCertificateSerialNo string `json:"mch_certificate_serial_no"`
+	// PrivateKey PEM 格式的商户 API 私钥
+	PrivateKey string `json:"private_key"`
+	// APIv3Key 商户 APIv3 密钥，可为空
+	APIv3Key string `json:"apiv3_key"`
+}
+
+// Source 商户密钥的来源，如 Vault、AWS Secrets Manager、腾讯云凭据管理系统（SSM）
+type Source interface {
+	// Fetch 获取最新的商户密钥
+	Fetch(ctx context.Context) (*Secrets, error)
+}
+
+// SourceFunc 使用函数实现 Source
+type SourceFunc func(ctx context.Context) (*Secrets, error)
+
+// Fetch 调用 f 获取商户密钥
+func (f SourceFunc) Fetch(ctx context.Context) (*Secrets, error) {
+	return f(ctx)
+}
+
+// snapshot 一次获取并解析后的商户密钥
+type snapshot struct {
+	signer     *signers.SHA256WithRSASigner
+	privateKey *rsa.PrivateKey
+	apiV3Key   string
+}
+
+// Manager 定时从 Source 刷新商户密钥，并提供使用最新密钥的签名器与解密器
+//
+// 刷新失败或获取到的密钥无效时继续使用已有的密钥，并调用 WithErrorHandler 设置的回调。
+// 密钥轮换后，正在进行的签名使用旧密钥完成，之后的签名使用新密钥。
+// Manager 一旦创建即启动定时刷新，使用完毕请调用 Stop() 防止发生资源泄漏
+type Manager struct {
+	mchID               string
+	certificateSerialNo string
+	source              Source
+	interval            time.Duration
+	onError             func(error)
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	task     *task.RepeatedTask
+	stopLock sync.Mutex
+
+	lock    sync.RWMutex
+	current *snapshot
+}
+
+// ManagerOption Manager 的配置项
+type ManagerOption func(m *Manager)
+
+// WithRefreshInterval 设置刷新间隔，默认为 DefaultRefreshInterval
+func WithRefreshInterval(interval time.Duration) ManagerOption {
+	return func(m *Manager) {
+		if interval > 0 {
+			m.interval = interval
+		}
+	}
+}
+
+// WithErrorHandler 设置定时刷新失败时的回调，可用于记录日志或告警
+func WithErrorHandler(onError func(error)) ManagerOption {
+	return func(m *Manager) {
+		m.onError = onError
+	}
+}
+
+// NewManager 从 source 获取商户密钥并创建 Manager，首次获取失败时返回错误
+//
+// certificateSerialNo 为商户 API 证书序列号，Secrets 中设置了序列号时以 Secrets 为准
+func NewManager(
+	ctx context.Context, mchID, certificateSerialNo string, source Source, opts ...ManagerOption,
+) (*Manager, error) {
+	if source == nil {
+		return nil, fmt.Errorf("secrets source is required")
+	}
+	m := &Manager{
+		mchID:               mchID,
+		certificateSerialNo: certificateSerialNo,
+		source:              source,
+		interval:            DefaultRefreshInterval,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if err := m.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	m.task = task.NewRepeatedTask(m.interval, func(time.Time) {
+		if err := m.Refresh(m.ctx); err != nil && m.onError != nil {
+			m.onError(err)
+		}
+	})
+	m.task.Start()
+	return m, nil
+}
+
+// Refresh 立即从 Source 获取一次商户密钥，获取失败或密钥无效时返回错误并继续使用已有的密钥
+func (m *Manager) Refresh(ctx context.Context) error {
+	secrets, err := m.source.Fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch secrets err:%v", err)
+	}
+	if secrets == nil {
+		return fmt.Errorf("fetch secrets err:no secrets returned")
+	}
+	s, err := m.parse(secrets)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	m.current = s
+	m.lock.Unlock()
+	return nil
+}
+
+func (m *Manager) parse(secrets *Secrets) (*snapshot, error) {
+	if secrets.PrivateKey == "" {
+		return nil, fmt.Errorf("invalid secrets: private_key is empty")
+	}
+	privateKey, err := utils.LoadPrivateKey(secrets.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secrets: %v", err)
+	}
+	privateKey.Precompute()
+	if secrets.APIv3Key != "" && len(secrets.APIv3Key) != apiV3KeyLength {
+		return nil, fmt.Errorf(
+			"invalid secrets: apiv3_key must be %d bytes, got %d", apiV3KeyLength, len(secrets.APIv3Key),
+		)
+	}
+	serialNo := secrets.MchCertificateSerialNo
+	if serialNo == "" {
+		serialNo = m.certificateSerialNo
+	}
+	if serialNo == "" {
+		return nil, fmt.Errorf("invalid secrets: mch_certificate_serial_no is empty")
+	}
+	return &snapshot{
+		signer: &signers.SHA256WithRSASigner{
+			MchID:               m.mchID,
+			CertificateSerialNo: serialNo,
+			PrivateKey:          privateKey,
+		},
+		privateKey: privateKey,
+		apiV3Key:   secrets.APIv3Key,
+	}, nil
+}
+
+func (m *Manager) snapshot() *snapshot {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.current
+}
+
+// Stop 停止定时刷新，正在进行的刷新将被取消。Stop 之后签名器与解密器继续使用最后一次获取的密钥
+func (m *Manager) Stop() {
+	m.cancel()
+
+	m.stopLock.Lock()
+	defer m.stopLock.Unlock()
+
+	m.task.Stop()
+}
+
+// PrivateKey 返回当前的商户 API 私钥
+func (m *Manager) PrivateKey() *rsa.PrivateKey {
+	return m.snapshot().privateKey
+}
+
+// CertificateSerialNo 返回当前的商户 API 证书序列号
+func (m *Manager) CertificateSerialNo() string {
+	return m.snapshot().signer.CertificateSerialNo
+}
+
+// APIv3Key 返回当前的商户 APIv3 密钥，可以传入 downloader.WithAPIv3KeyFunc 与 notify.NewNotifyHandlerWithAPIv3KeyFunc
+func (m *Manager) APIv3Key() string {
+	return m.snapshot().apiV3Key
+}
+
+// Signer 返回使用当前商户私钥签名的签名器，可以传入 option.WithSigner
+func (m *Manager) Signer() auth.Signer {
+	return managedSigner{m: m}
+}
+
+// Decryptor 返回使用当前商户私钥解密的解密器，可以传入 option.WithWechatPayCipher
+func (m *Manager) Decryptor() cipher.Decryptor {
+	return managedDecryptor{m: m}
+}
+
+// managedSigner 每次签名时使用 Manager 中最新的商户私钥
+type managedSigner struct {
+	m *Manager
+}
+
+// Sign 使用当前的商户私钥签名
+func (s managedSigner) Sign(ctx context.Context, message string) (*auth.SignatureResult, error) {
+	return s.m.snapshot().signer.Sign(ctx, message)
+}
+
+// Algorithm 返回使用的签名算法：SHA256-RSA2048
+func (s managedSigner) Algorithm() string {
+	return s.m.snapshot().signer.Algorithm()
+}
+
+// GetMchID 返回签名所用的商户号
+func (s managedSigner) GetMchID() string {
+	return s.m.mchID
+}
+
+// managedDecryptor 每次解密时使用 Manager 中最新的商户私钥
+type managedDecryptor struct {
+	m *Manager
+}
+
+// Decrypt 使用当前的商户私钥解密
+func (d managedDecryptor) Decrypt(ctx context.Context, ciphertext string) (string, error) {
+	return decryptors.NewWechatPayDecryptor(d.m.PrivateKey()).Decrypt(ctx, ciphertext)
+}
@@ -0,0 +1,100 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// NewSecretStringSource 使用返回 JSON 格式凭据内容的函数创建 Source，JSON 的字段名见 Secrets
+//
+// 适用于 AWS Secrets Manager 与腾讯云凭据管理系统（SSM）等以字符串保存凭据的服务。SDK 不依赖这些服务的客户端，
+// 请在 fetch 中使用其官方 SDK 调用 GetSecretValue 并返回 SecretString，例如：
+//
+//	source := secrets.NewSecretStringSource(func(ctx context.Context) (string, error) {
+//		output, err := smClient.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String("wechatpay/1900009191")})
+//		if err != nil {
+//			return "", err
+//		}
+//		return aws.ToString(output.SecretString), nil
+//	})
+func NewSecretStringSource(fetch func(ctx context.Context) (string, error)) Source {
+	return SourceFunc(func(ctx context.Context) (*Secrets, error) {
+		secretString, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		secrets := new(Secrets)
+		if err = json.Unmarshal([]byte(secretString), secrets); err != nil {
+			return nil, fmt.Errorf("parse secret string err:%v", err)
+		}
+		return secrets, nil
+	})
+}
+
+// VaultSource 从 HashiCorp Vault 的 KV 引擎读取商户密钥，同时支持 KV v1 与 v2
+type VaultSource struct {
+	address    string
+	path       string
+	token      string
+	httpClient *http.Client
+}
+
+// NewVaultSource 创建 VaultSource
+//
+// address 为 Vault 地址（如 https://vault.example.com:8200），path 为 API 路径中 /v1/ 之后的部分，
+// KV v2 的路径需包含 data（如 secret/data/wechatpay/1900009191）。httpClient 为 nil 时使用 http.DefaultClient
+func NewVaultSource(address, path, token string, httpClient *http.Client) *VaultSource {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &VaultSource{
+		address:    strings.TrimSuffix(address, "/"),
+		path:       strings.Trim(path, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// Fetch 读取 Vault 中保存的商户密钥
+func (s *VaultSource) Fetch(ctx context.Context) (*Secrets, error) {
+	request, err := http.NewRequest(http.MethodGet, s.address+"/v1/"+s.path, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("X-Vault-Token", s.token)
+	response, err := s.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("read vault secret %s err:%v", s.path, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read vault secret %s err:%v", s.path, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("read vault secret %s err:http status %d", s.path, response.StatusCode)
+	}
+
+	var ret struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err = json.Unmarshal(body, &ret); err != nil {
+		return nil, fmt.Errorf("parse vault secret %s err:%v", s.path, err)
+	}
+	// KV v2 的密钥位于 data.data，KV v1 的密钥位于 data
+	var v2 struct {
+		Data *Secrets `json:"data"`
+	}
+	if err = json.Unmarshal(ret.Data, &v2); err == nil && v2.Data != nil {
+		return v2.Data, nil
+	}
+	secrets := new(Secrets)
+	if err = json.Unmarshal(ret.Data, secrets); err != nil {
+		return nil, fmt.Errorf("parse vault secret %s err:%v", s.path, err)
+	}
+	return secrets, nil
+}
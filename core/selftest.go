@@ -0,0 +1,203 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// selfTestURL 自检使用的下载平台证书接口，该接口只读且应答中包含使用 APIv3 密钥加密的内容
+const selfTestURL = consts.WechatPayAPIServer + "/v3/certificates"
+
+const selfTestNonceLength = 12
+
+// SelfTestComponent 自检项目，对应 Client 的一项配置
+type SelfTestComponent string
+
+// 自检项目，按检查顺序排列
+const (
+	// SelfTestSignature 请求签名：商户号、商户证书序列号与商户私钥
+	SelfTestSignature SelfTestComponent = "signature"
+	// SelfTestRequest 发送请求：网络、API 地址、限流器与熔断器
+	SelfTestRequest SelfTestComponent = "request"
+	// SelfTestValidator 应答验签：平台证书或微信支付公钥
+	SelfTestValidator SelfTestComponent = "validator"
+	// SelfTestAPIv3Key 使用商户 APIv3 密钥解密应答中的平台证书
+	SelfTestAPIv3Key SelfTestComponent = "apiv3_key"
+)
+
+var selfTestComponents = []SelfTestComponent{SelfTestSignature, SelfTestRequest, SelfTestValidator, SelfTestAPIv3Key}
+
+// SelfTestStatus 自检项目的结果
+type SelfTestStatus string
+
+// 自检项目的结果
+const (
+	SelfTestPassed  SelfTestStatus = "PASSED"  // 检查通过
+	SelfTestFailed  SelfTestStatus = "FAILED"  // 检查失败，原因见 SelfTestCheck.Err
+	SelfTestSkipped SelfTestStatus = "SKIPPED" // 前序检查失败或未提供所需配置，未检查
+)
+
+// SelfTestCheck 单个自检项目的结果
+type SelfTestCheck struct {
+	Component SelfTestComponent
+	Status    SelfTestStatus
+	// Err 检查失败的原因，检查通过或未检查时为 nil
+	Err error
+}
+
+// SelfTestReport Client 自检报告
+type SelfTestReport struct {
+	// Checks 各自检项目的结果，按检查顺序排列
+	Checks []SelfTestCheck
+	// RequestID 自检请求的 Request-Id，未收到应答时为空
+	RequestID string
+	// SerialNos 应答中的平台证书序列号
+	SerialNos []string
+}
+
+// Check 返回 component 的检查结果
+func (r *SelfTestReport) Check(component SelfTestComponent) SelfTestCheck {
+	for _, check := range r.Checks {
+		if check.Component == component {
+			return check
+		}
+	}
+	return SelfTestCheck{Component: component, Status: SelfTestSkipped}
+}
+
+// OK 所有自检项目均未失败时返回 true，未检查的项目不视为失败
+func (r *SelfTestReport) OK() bool {
+	return r.Err() == nil
+}
+
+// Err 返回第一个失败的自检项目的错误，所有项目均未失败时返回 nil
+func (r *SelfTestReport) Err() error {
+	for _, check := range r.Checks {
+		if check.Status == SelfTestFailed {
+			return fmt.Errorf("self test %s failed: %w", check.Component, check.Err)
+		}
+	}
+	return nil
+}
+
+func (r *SelfTestReport) String() string {
+	lines := make([]string, 0, len(r.Checks))
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			lines = append(lines, fmt.Sprintf("%s: %s (%v)", check.Component, check.Status, check.Err))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s: %s", check.Component, check.Status))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *SelfTestReport) set(component SelfTestComponent, err error) {
+	status := SelfTestPassed
+	if err != nil {
+		status = SelfTestFailed
+	}
+	for i := range r.Checks {
+		if r.Checks[i].Component == component {
+			r.Checks[i].Status, r.Checks[i].Err = status, err
+		}
+	}
+}
+
+// selfTestCertificates 下载平台证书接口的应答
+type selfTestCertificates struct {
+	Data []struct {
+		SerialNo           string `json:"serial_no"`
+		EncryptCertificate *struct {
+			Nonce          string `json:"nonce"`
+			AssociatedData string `json:"associated_data"`
+			Ciphertext     string `json:"ciphertext"`
+		} `json:"encrypt_certificate"`
+	} `json:"data"`
+}
+
+// SelfTest 使用下载平台证书接口检查 Client 的配置是否可用，适用于服务启动时的预检
+//
+// SelfTest 依次检查请求签名、发送请求、应答验签，并在 mchAPIv3Key 不为空时使用其解密应答中的平台证书，
+// 返回各项目的检查结果。某一项目失败时，依赖该项目的后续项目不再检查。应答状态码为 401 时视为请求签名有误。
+// 自检请求不会修改商户数据，但与其他请求一样受限流器与熔断器的约束。
+func (client *Client) SelfTest(ctx context.Context, mchAPIv3Key string) *SelfTestReport {
+	report := &SelfTestReport{Checks: make([]SelfTestCheck, 0, len(selfTestComponents))}
+	for _, component := range selfTestComponents {
+		report.Checks = append(report.Checks, SelfTestCheck{Component: component, Status: SelfTestSkipped})
+	}
+
+	ctx = client.withContextDefaults(ctx)
+	defer recordRequestInfo(ctx)
+	if _, err := client.credential.GenerateAuthorizationHeader(ctx, http.MethodGet, "/v3/certificates", ""); err != nil {
+		report.set(SelfTestSignature, err)
+		return report
+	}
+
+	result, err := client.sendRequest(ctx, http.MethodGet, selfTestURL, nil, consts.ApplicationJSON, nil, "")
+	if result != nil && result.Response != nil {
+		report.RequestID = result.Response.Header.Get(consts.RequestID)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
+		report.set(SelfTestRequest, nil)
+		report.set(SelfTestSignature, err)
+		return report
+	}
+	if err != nil {
+		report.set(SelfTestRequest, err)
+		return report
+	}
+	defer func() { _ = result.Response.Body.Close() }()
+	report.set(SelfTestSignature, nil)
+	report.set(SelfTestRequest, nil)
+
+	// 验签失败时仍然检查 APIv3 密钥，以便一次报告所有有误的配置
+	report.set(SelfTestValidator, client.validator.Validate(ctx, result.Response))
+
+	body, err := ioutil.ReadAll(result.Response.Body)
+	if err != nil {
+		report.set(SelfTestRequest, fmt.Errorf("read response body err:%v", err))
+		return report
+	}
+	certificates := new(selfTestCertificates)
+	if err = json.Unmarshal(body, certificates); err != nil {
+		report.set(SelfTestRequest, fmt.Errorf("unmarshal response body err:%v", err))
+		return report
+	}
+	for _, data := range certificates.Data {
+		report.SerialNos = append(report.SerialNos, data.SerialNo)
+	}
+
+	if mchAPIv3Key == "" {
+		return report
+	}
+	if len(certificates.Data) == 0 {
+		report.set(SelfTestAPIv3Key, fmt.Errorf("no certificate to decrypt in response"))
+		return report
+	}
+	for _, data := range certificates.Data {
+		encrypted := data.EncryptCertificate
+		// 随机串长度有误时 AES-GCM 解密会 panic，需提前检查
+		if encrypted == nil || len(encrypted.Nonce) != selfTestNonceLength {
+			report.set(SelfTestAPIv3Key, fmt.Errorf("invalid encrypt_certificate of %s", data.SerialNo))
+			return report
+		}
+		if _, err = utils.DecryptAES256GCM(
+			mchAPIv3Key, encrypted.AssociatedData, encrypted.Nonce, encrypted.Ciphertext,
+		); err != nil {
+			report.set(SelfTestAPIv3Key, fmt.Errorf("decrypt certificate %s err:%v", data.SerialNo, err))
+			return report
+		}
+	}
+	report.set(SelfTestAPIv3Key, nil)
+	return report
+}
@@ -0,0 +1,136 @@
+package core_test
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const testSelfTestAPIv3Key = "testMchAPIv3Key0testMchAPIv3Key0"
+
+func selfTestServer(t *testing.T, status int, sign bool) *httptest.Server {
+	ciphertext, err := utils.EncryptAES256GCM(testSelfTestAPIv3Key, "certificate", "Kj7QIyUiYx1q", testWechatCertificateStr)
+	require.NoError(t, err)
+	body := fmt.Sprintf(`{"data":[{"serial_no":"%s","encrypt_certificate":{"algorithm":"AEAD_AES_256_GCM",`+
+		`"nonce":"Kj7QIyUiYx1q","associated_data":"certificate","ciphertext":"%s"}}]}`,
+		utils.GetCertificateSerialNumber(*wechatPayCertificate), ciphertext)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/certificates", r.URL.Path)
+		if status != http.StatusOK {
+			w.Header().Set("Request-Id", "0")
+			w.WriteHeader(status)
+			fmt.Fprint(w, `{"code":"SIGN_ERROR","message":"签名错误"}`)
+			return
+		}
+		if sign {
+			writeSignature(w, body)
+		} else {
+			writeSignature(w, "tampered")
+		}
+		fmt.Fprint(w, body)
+	}))
+}
+
+func newSelfTestClient(t *testing.T, ts *httptest.Server) *core.Client {
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+		option.WithAPIServer(ts.URL),
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func assertSelfTestStatus(t *testing.T, report *core.SelfTestReport, statuses ...core.SelfTestStatus) {
+	require.Len(t, report.Checks, len(statuses))
+	for i, status := range statuses {
+		assert.Equal(t, status, report.Checks[i].Status, string(report.Checks[i].Component))
+	}
+}
+
+func TestClient_SelfTest(t *testing.T) {
+	const (
+		passed  = core.SelfTestPassed
+		failed  = core.SelfTestFailed
+		skipped = core.SelfTestSkipped
+	)
+
+	t.Run("passed", func(t *testing.T) {
+		ts := selfTestServer(t, http.StatusOK, true)
+		defer ts.Close()
+
+		report := newSelfTestClient(t, ts).SelfTest(ctx, testSelfTestAPIv3Key)
+		assertSelfTestStatus(t, report, passed, passed, passed, passed)
+		assert.True(t, report.OK())
+		assert.NoError(t, report.Err())
+		assert.Equal(t, "0", report.RequestID)
+		assert.Equal(t, []string{utils.GetCertificateSerialNumber(*wechatPayCertificate)}, report.SerialNos)
+		assert.Equal(t, "signature: PASSED\nrequest: PASSED\nvalidator: PASSED\napiv3_key: PASSED", report.String())
+	})
+
+	t.Run("apiv3 key not provided", func(t *testing.T) {
+		ts := selfTestServer(t, http.StatusOK, true)
+		defer ts.Close()
+
+		report := newSelfTestClient(t, ts).SelfTest(ctx, "")
+		assertSelfTestStatus(t, report, passed, passed, passed, skipped)
+		assert.True(t, report.OK())
+	})
+
+	t.Run("wrong apiv3 key", func(t *testing.T) {
+		ts := selfTestServer(t, http.StatusOK, true)
+		defer ts.Close()
+
+		report := newSelfTestClient(t, ts).SelfTest(ctx, "00000000000000000000000000000000")
+		assertSelfTestStatus(t, report, passed, passed, passed, failed)
+		assert.False(t, report.OK())
+		assert.Contains(t, report.Err().Error(), "self test apiv3_key failed: decrypt certificate")
+	})
+
+	t.Run("invalid response signature", func(t *testing.T) {
+		ts := selfTestServer(t, http.StatusOK, false)
+		defer ts.Close()
+
+		report := newSelfTestClient(t, ts).SelfTest(ctx, testSelfTestAPIv3Key)
+		assertSelfTestStatus(t, report, passed, passed, failed, passed)
+		assert.Error(t, report.Check(core.SelfTestValidator).Err)
+	})
+
+	t.Run("request signature rejected", func(t *testing.T) {
+		ts := selfTestServer(t, http.StatusUnauthorized, true)
+		defer ts.Close()
+
+		report := newSelfTestClient(t, ts).SelfTest(ctx, testSelfTestAPIv3Key)
+		assertSelfTestStatus(t, report, failed, passed, skipped, skipped)
+		assert.True(t, core.IsAPIError(report.Check(core.SelfTestSignature).Err, "SIGN_ERROR"))
+		assert.Equal(t, "0", report.RequestID)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		ts := selfTestServer(t, http.StatusInternalServerError, true)
+		defer ts.Close()
+
+		report := newSelfTestClient(t, ts).SelfTest(ctx, testSelfTestAPIv3Key)
+		assertSelfTestStatus(t, report, skipped, failed, skipped, skipped)
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		ts := selfTestServer(t, http.StatusOK, true)
+		client := newSelfTestClient(t, ts)
+		ts.Close()
+
+		report := client.SelfTest(ctx, testSelfTestAPIv3Key)
+		assertSelfTestStatus(t, report, skipped, failed, skipped, skipped)
+		assert.Empty(t, report.RequestID)
+		assert.Equal(t, core.SelfTestSkipped, report.Check("unknown").Status)
+	})
+}
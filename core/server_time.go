@@ -0,0 +1,180 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// serverTimeURL 探测服务器时间使用的接口。探测请求不签名，应答（通常为 401）的应答头中同样包含服务器时间
+const serverTimeURL = consts.WechatPayAPIServer + "/v3/certificates"
+
+// ServerTimeProbe 一次服务器时间探测的结果
+type ServerTimeProbe struct {
+	// ServerTime 微信支付的服务器时间，取自 Wechatpay-Timestamp 应答头，没有时取自 Date 应答头。
+	// 应答头的精度为秒，ServerTime 取该秒的中点
+	ServerTime time.Time
+	// LocalTime 请求往返中点的本地系统时间
+	LocalTime time.Time
+	// RoundTrip 请求往返时间，Offset 的误差不超过 RoundTrip 的一半加上 0.5 秒
+	RoundTrip time.Duration
+	// Offset 服务器时间与本地系统时间的偏差，即 ServerTime - LocalTime，可直接用于 auth.AdjustableClock.SetOffset
+	Offset time.Duration
+}
+
+// ProbeServerTime 向微信支付发送一个不签名的请求，根据应答头中的服务器时间计算本地系统时钟的偏差
+//
+// 探测请求不经过限流器与熔断器，且不依赖本地时钟是否准确，因此可用于签名因时间戳过期而失败时的排查
+func (client *Client) ProbeServerTime(ctx context.Context) (*ServerTimeProbe, error) {
+	if _, ok := ctx.Deadline(); !ok && client.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, client.timeout)
+		defer cancel()
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, client.rewriteURL(serverTimeURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set(consts.Accept, "*/*")
+	request.Header.Set(consts.UserAgent, client.userAgent)
+
+	start := time.Now()
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("probe server time err:%w", err)
+	}
+	end := time.Now()
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	_ = response.Body.Close()
+
+	serverTime, err := parseServerTime(response.Header)
+	if err != nil {
+		return nil, err
+	}
+	roundTrip := end.Sub(start)
+	localTime := start.Add(roundTrip / 2)
+	return &ServerTimeProbe{
+		ServerTime: serverTime,
+		LocalTime:  localTime,
+		RoundTrip:  roundTrip,
+		Offset:     serverTime.Sub(localTime),
+	}, nil
+}
+
+// parseServerTime 从应答头中取得服务器时间，优先使用 Wechatpay-Timestamp
+func parseServerTime(header http.Header) (time.Time, error) {
+	if timestamp := header.Get(consts.WechatPayTimestamp); timestamp != "" {
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s header %q", consts.WechatPayTimestamp, timestamp)
+		}
+		return time.Unix(seconds, 0).Add(500 * time.Millisecond), nil
+	}
+	if date := header.Get("Date"); date != "" {
+		t, err := http.ParseTime(date)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid Date header %q", date)
+		}
+		return t.Add(500 * time.Millisecond), nil
+	}
+	return time.Time{}, fmt.Errorf("response has neither %s nor Date header", consts.WechatPayTimestamp)
+}
+
+// ClockSyncer 探测微信支付服务器时间，并据此更新 auth.AdjustableClock 的偏差
+//
+// clock 通常同时通过 option.WithClock 设置给 Client，使签名时间戳与微信支付的时间一致
+type ClockSyncer struct {
+	client   *Client
+	clock    *auth.AdjustableClock
+	callback func(probe *ServerTimeProbe, err error)
+
+	lock sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// ClockSyncerOption ClockSyncer 的可选配置
+type ClockSyncerOption func(s *ClockSyncer)
+
+// WithClockSyncCallback 设置每次探测后的回调，可用于记录偏差或探测失败的原因
+func WithClockSyncCallback(callback func(probe *ServerTimeProbe, err error)) ClockSyncerOption {
+	return func(s *ClockSyncer) {
+		s.callback = callback
+	}
+}
+
+// NewClockSyncer 创建使用 client 探测服务器时间、更新 clock 偏差的 ClockSyncer
+//
+// clock 需以系统时钟为基准（即 auth.NewAdjustableClock(nil)），因为探测得到的偏差是相对于本地系统时间的
+func NewClockSyncer(client *Client, clock *auth.AdjustableClock, opts ...ClockSyncerOption) *ClockSyncer {
+	s := &ClockSyncer{client: client, clock: clock}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Sync 探测一次服务器时间，成功时将 clock 的偏差设置为探测得到的偏差，失败时 clock 保持不变
+func (s *ClockSyncer) Sync(ctx context.Context) (*ServerTimeProbe, error) {
+	probe, err := s.client.ProbeServerTime(ctx)
+	if err == nil {
+		s.clock.SetOffset(probe.Offset)
+	}
+	if s.callback != nil {
+		s.callback(probe, err)
+	}
+	return probe, err
+}
+
+// Start 立即探测一次服务器时间，此后在后台每隔 interval 重新探测
+//
+// interval 需大于 0，否则返回错误且不开始探测。重复调用时，先停止此前的探测。使用 Stop 停止探测
+func (s *ClockSyncer) Start(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("clock sync interval must be positive, got %s", interval)
+	}
+	s.Stop()
+
+	stop := make(chan struct{})
+	s.lock.Lock()
+	s.stop = stop
+	s.lock.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			_, _ = s.Sync(ctx)
+			cancel()
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop 停止后台探测，并等待正在进行的探测结束
+func (s *ClockSyncer) Stop() {
+	s.lock.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.lock.Unlock()
+	if stop != nil {
+		close(stop)
+	}
+	s.wg.Wait()
+}
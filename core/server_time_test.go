@@ -0,0 +1,128 @@
+package core_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+)
+
+// serverTimeTransport 返回服务器时间比本地时间快 offset 的 401 应答
+type serverTimeTransport struct {
+	lock     sync.Mutex
+	offset   time.Duration
+	dateOnly bool
+	requests []*http.Request
+}
+
+func (t *serverTimeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.requests = append(t.requests, req)
+	header := http.Header{}
+	now := time.Now().Add(t.offset)
+	header.Set("Date", now.UTC().Format(http.TimeFormat))
+	if !t.dateOnly {
+		header.Set(consts.WechatPayTimestamp, strconv.FormatInt(now.Unix(), 10))
+	}
+	return &http.Response{
+		StatusCode: http.StatusUnauthorized,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"code":"SIGN_ERROR","message":"签名错误"}`)),
+		Request:    req,
+	}, nil
+}
+
+func newServerTimeClient(t *testing.T, transport http.RoundTripper) *core.Client {
+	client, err := core.NewClient(ctx,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_ProbeServerTime(t *testing.T) {
+	transport := &serverTimeTransport{offset: 2 * time.Minute}
+	client := newServerTimeClient(t, transport)
+
+	probe, err := client.ProbeServerTime(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, float64(2*time.Minute), float64(probe.Offset), float64(time.Second))
+	assert.Equal(t, probe.Offset, probe.ServerTime.Sub(probe.LocalTime))
+	assert.True(t, probe.RoundTrip >= 0)
+
+	require.Len(t, transport.requests, 1)
+	assert.Equal(t, consts.WechatPayAPIServer+"/v3/certificates", transport.requests[0].URL.String())
+	assert.Empty(t, transport.requests[0].Header.Get(consts.Authorization))
+
+	// 没有 Wechatpay-Timestamp 时使用 Date
+	client = newServerTimeClient(t, &serverTimeTransport{offset: -5 * time.Minute, dateOnly: true})
+	probe, err = client.ProbeServerTime(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, float64(-5*time.Minute), float64(probe.Offset), float64(time.Second))
+
+	client = newServerTimeClient(t, &recordTransport{})
+	_, err = client.ProbeServerTime(ctx)
+	assert.Error(t, err)
+}
+
+func TestClockSyncer(t *testing.T) {
+	transport := &serverTimeTransport{offset: -3 * time.Minute}
+	client := newServerTimeClient(t, transport)
+	clock := auth.NewAdjustableClock(nil)
+
+	var (
+		lock   sync.Mutex
+		probes int
+	)
+	syncer := core.NewClockSyncer(client, clock, core.WithClockSyncCallback(func(probe *core.ServerTimeProbe, err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		assert.NoError(t, err)
+		probes++
+	}))
+
+	probe, err := syncer.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, probe.Offset, clock.Offset())
+	assert.WithinDuration(t, time.Now().Add(-3*time.Minute), clock.Now(), time.Second)
+
+	// 探测失败时偏差保持不变
+	failing := core.NewClockSyncer(newServerTimeClient(t, &recordTransport{}), clock)
+	_, err = failing.Sync(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, probe.Offset, clock.Offset())
+
+	// 间隔不大于 0 时返回错误，不开始探测
+	assert.Error(t, syncer.Start(0))
+	assert.Error(t, syncer.Start(-time.Second))
+	time.Sleep(30 * time.Millisecond)
+	lock.Lock()
+	assert.Equal(t, 1, probes)
+	lock.Unlock()
+
+	require.NoError(t, syncer.Start(10*time.Millisecond))
+	time.Sleep(55 * time.Millisecond)
+	syncer.Stop()
+	lock.Lock()
+	stopped := probes
+	lock.Unlock()
+	assert.True(t, stopped >= 3, "probes %d", stopped)
+
+	time.Sleep(30 * time.Millisecond)
+	lock.Lock()
+	assert.Equal(t, stopped, probes)
+	lock.Unlock()
+}
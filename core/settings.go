@@ -2,18 +2,67 @@ package core
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/credentials"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/validators"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/ratelimit"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/resolver"
 )
 
 // DialSettings 微信支付 API v3 Go SDK core.Client 需要的配置信息
 type DialSettings struct {
-	HTTPClient *http.Client   // 自定义所使用的 HTTPClient 实例
-	Signer     auth.Signer    // 签名器
-	Validator  auth.Validator // 应答包签名校验器
-	Cipher     cipher.Cipher  // 敏感字段加解密套件
+	HTTPClient *http.Client      // 自定义所使用的 HTTPClient 实例
+	Signer     auth.Signer       // 签名器
+	Validator  auth.Validator    // 应答包签名校验器
+	Cipher     cipher.Cipher     // 敏感字段加解密套件
+	Limiter    ratelimit.Limiter // 请求限流器，可为空
+	Breaker    Breaker           // 熔断器，可为空
+	Resolver   resolver.Resolver // 建立连接时使用的域名解析器，为空时使用系统的 DNS 解析
+	// 未设置截止时间的请求的默认超时时间，为 0 时使用 consts.DefaultTimeout，为负数时不设置默认超时
+	Timeout time.Duration
+	// 请求头 Accept-Language 的值，如 consts.LanguageEn，为空时不设置
+	AcceptLanguage string
+	// 追加到 SDK User-Agent 之后的应用标识，如 MyShop/1.0
+	UserAgentSuffix string
+	// 是否在请求头中声明 Accept-Encoding: gzip。压缩的应答均会在验签前解压
+	AcceptGzip bool
+	// JSON 请求包体压缩阈值（字节），包体长度不小于该值时使用 gzip 压缩，为 0 时不压缩
+	GzipRequestMinSize int
+	// API 地址，如 consts.WechatPayGlobalAPIServer。设置后发往 consts.WechatPayAPIServer 的请求将改为发往该地址，为空时不改写
+	APIServer string
+	// 生成请求签名时间戳与检查应答时间戳所使用的时钟，为空时使用系统时钟
+	Clock auth.Clock
+	// 生成请求签名与调起支付签名随机串所使用的生成器，为空时使用 utils.GenerateNonce
+	NonceGenerator auth.NonceGenerator
+	// 请求签名调试信息的回调，可为空
+	SignRecorder auth.SignRecorder
+	// 应答验签结果的计数接口，可为空
+	ValidationMetrics auth.ValidationMetrics
+	// 按 API 地址选择的应答验证器，如使用自有密钥签名的模拟器地址，未匹配的请求使用 Validator 验证
+	BaseURLValidators map[string]auth.Validator
+	// 除使用 Signer 签名的 WECHATPAY2-<Signer.Algorithm()> 外，Client 支持的其他认证类型的 Credential，可为空
+	Credentials []auth.SchemeCredential
+	// 默认认证类型，为空时使用 Signer 对应的认证类型
+	AuthScheme string
+	// 相同请求复用 Authorization 信息的有效期，为 0 时不缓存，详见 credentials.CachedCredential
+	SignatureCacheTTL time.Duration
+	// 同时执行的签名数上限，为 0 时不限制，详见 signers.BoundedSigner
+	SignConcurrency int
+	// GET 请求在该时间内未得到应答时发出对冲请求，为 0 时不发出对冲请求
+	HedgeDelay time.Duration
+	// 发出对冲请求的接口路径前缀，如 /v3/pay/transactions/，为空时对全部 GET 请求生效
+	HedgePaths []string
+	// 已签名请求的审计日志接收器，可为空
+	AuditSink AuditSink
+	// 以预演模式执行全部请求：构造并签名请求，但不发送，详见 core.WithDryRun
+	DryRun bool
 }
 
 // Validate 校验请求配置是否有效
@@ -24,5 +73,98 @@ func (ds *DialSettings) Validate() error {
 	if ds.Signer == nil {
 		return fmt.Errorf("signer is required for Client")
 	}
+	if !isValidHeaderValue(ds.AcceptLanguage) {
+		return fmt.Errorf("invalid accept language %q", ds.AcceptLanguage)
+	}
+	if !isValidHeaderValue(ds.UserAgentSuffix) {
+		return fmt.Errorf("invalid user agent suffix %q", ds.UserAgentSuffix)
+	}
+	if ds.GzipRequestMinSize < 0 {
+		return fmt.Errorf("gzip request min size must not be negative")
+	}
+	if ds.APIServer != "" && !isValidAPIServer(ds.APIServer) {
+		return fmt.Errorf("invalid api server %q, scheme and host are required without path", ds.APIServer)
+	}
+	if ds.SignConcurrency < 0 {
+		return fmt.Errorf("sign concurrency must not be negative")
+	}
+	if ds.Resolver != nil && ds.HTTPClient != nil && ds.HTTPClient.Transport != nil {
+		if _, ok := ds.HTTPClient.Transport.(*http.Transport); !ok {
+			return fmt.Errorf("resolver requires the transport of HTTPClient to be *http.Transport")
+		}
+	}
+	if ds.HedgeDelay < 0 {
+		return fmt.Errorf("hedge delay must not be negative")
+	}
+	for _, path := range ds.HedgePaths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("invalid hedge path %q, must start with /", path)
+		}
+	}
+	if _, err := newCredential(ds, ds.Signer); err != nil {
+		return err
+	}
+	if len(ds.BaseURLValidators) > 0 {
+		if _, err := validators.NewBaseURLValidator(ds.Validator, ds.BaseURLValidators); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// newCredential 使用 signer 创建 Client 生成 Authorization 信息的 Credential，配置了多种认证类型时按认证类型选择
+func newCredential(ds *DialSettings, signer auth.Signer) (auth.Credential, error) {
+	signerCredential := &credentials.WechatPayCredentials{Signer: signer}
+	var credential auth.Credential = signerCredential
+	if len(ds.Credentials) > 0 || ds.AuthScheme != "" {
+		var err error
+		credential, err = credentials.NewSchemeCredentials(
+			ds.AuthScheme, append([]auth.SchemeCredential{signerCredential}, ds.Credentials...)...,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ds.SignatureCacheTTL != 0 {
+		return credentials.NewCachedCredential(credential, ds.SignatureCacheTTL, 0)
+	}
+	return credential, nil
+}
+
+// isValidAPIServer 检查 API 地址是否仅包含协议与域名（可带端口）
+func isValidAPIServer(server string) bool {
+	u, err := url.Parse(server)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "https" || u.Scheme == "http") && u.Host != "" && u.Path == "" &&
+		u.RawQuery == "" && u.Fragment == "" && u.User == nil
+}
+
+// isValidHeaderValue 检查字符串是否可以作为 HTTP Header 的值，不允许包含控制字符
+func isValidHeaderValue(value string) bool {
+	for _, r := range value {
+		if r < ' ' || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// httpClientWithResolver 返回使用 r 解析域名的 HTTPClient，hc 为 nil 时基于 http.DefaultTransport 创建
+//
+// hc 的 Transport 为 *http.Transport（已在 DialSettings.Validate 中校验）时复制后设置 DialContext，hc 本身不受影响
+func httpClientWithResolver(hc *http.Client, r resolver.Resolver) *http.Client {
+	client := &http.Client{}
+	if hc != nil {
+		*client = *hc
+	}
+	base, ok := client.Transport.(*http.Transport)
+	if !ok {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	transport := base.Clone()
+	transport.DialContext = resolver.DialContext(r, &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second})
+	client.Transport = transport
+	return client
+}
@@ -0,0 +1,195 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"runtime"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// SigningTransport 为请求签名、并对应答验签的 http.RoundTripper，用于在自有的 HTTP 客户端（如带有对冲、服务网格 Sidecar 的客户端）中
+// 复用 SDK 的签名与验签，而无需使用 Client
+//
+// 与 Client 一致，仅对 2XX 应答验签，其余应答原样返回，可使用 CheckResponse 解析错误。
+// 上传文件（multipart/form-data）的请求使用名为 meta 的表单字段签名。
+//
+// 不基于 net/http 的客户端（如 fasthttp）可以使用 Authorize 生成 Authorization 请求头，使用 ValidateResponse 对应答验签：
+//
+//	authorization, err := transport.Authorize(ctx, method, requestURL, body)
+//	req.Header.Set("Authorization", authorization)
+//	// 发送请求后
+//	header := http.Header{}
+//	resp.Header.VisitAll(func(k, v []byte) { header.Add(string(k), string(v)) })
+//	err = transport.ValidateResponse(ctx, resp.StatusCode(), header, resp.Body())
+type SigningTransport struct {
+	base       http.RoundTripper
+	credential auth.Credential
+	validator  auth.Validator
+	nonce      auth.NonceGenerator
+	clock      auth.Clock
+	userAgent  string
+}
+
+// NewSigningTransport 使用与 NewClient 相同的 ClientOption 创建 SigningTransport，base 为实际发送请求的 http.RoundTripper，
+// 为 nil 时使用 http.DefaultTransport
+//
+// 仅签名器、认证类型、时钟、随机串生成器与验签器相关的配置生效，如 option.WithWechatPayAutoAuthCipher、option.WithoutValidator，其余配置被忽略
+func NewSigningTransport(base http.RoundTripper, opts ...ClientOption) (*SigningTransport, error) {
+	settings, err := initSettings(opts)
+	if err != nil {
+		return nil, fmt.Errorf("init signing transport setting err:%v", err)
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	// Credentials 与 AuthScheme 已在 DialSettings.Validate 中校验
+	credential, _ := newCredential(settings, settings.Signer)
+	t := &SigningTransport{
+		base:       base,
+		credential: credential,
+		validator:  settings.Validator,
+		nonce:      settings.NonceGenerator,
+		clock:      settings.Clock,
+		userAgent:  fmt.Sprintf(consts.UserAgentFormat, consts.Version, runtime.GOOS, runtime.Version()),
+	}
+	if settings.UserAgentSuffix != "" {
+		t.userAgent += " " + settings.UserAgentSuffix
+	}
+	return t, nil
+}
+
+// RoundTrip 为请求签名后交由 base 发送，并对 2XX 应答验签，验签失败时关闭应答包体并返回错误
+func (t *SigningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTripper 需在任何情况下关闭请求的 Body，发出的请求使用已读取的包体
+	if req.Body != nil {
+		defer func() { _ = req.Body.Close() }()
+	}
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	signBody, err := requestSignBody(req.Header.Get(consts.ContentType), body)
+	if err != nil {
+		return nil, err
+	}
+
+	// RoundTripper 不应修改传入的请求
+	signed := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		signed.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	signed.URL.RawQuery = auth.NormalizeQuery(signed.URL.RawQuery)
+	ctx := t.withContextDefaults(req.Context())
+	authorization, err := t.credential.GenerateAuthorizationHeader(
+		ctx, req.Method, signed.URL.RequestURI(), signBody,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("generate authorization err:%v", err)
+	}
+	signed.Header.Set(consts.Authorization, authorization)
+	if signed.Header.Get(consts.Accept) == "" {
+		signed.Header.Set(consts.Accept, "*/*")
+	}
+	if signed.Header.Get(consts.UserAgent) == "" {
+		signed.Header.Set(consts.UserAgent, t.userAgent)
+	}
+
+	resp, err := t.base.RoundTrip(signed)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, err
+	}
+	if err = t.validator.Validate(ctx, resp); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Authorize 生成请求的 Authorization 请求头，requestURL 可以为完整地址或以 / 开头的路径，body 为请求包体（上传文件时为 meta 的内容）
+func (t *SigningTransport) Authorize(ctx context.Context, method, requestURL string, body []byte) (string, error) {
+	canonicalURL, err := auth.CanonicalURL(requestURL)
+	if err != nil {
+		return "", err
+	}
+	return t.credential.GenerateAuthorizationHeader(t.withContextDefaults(ctx), method, canonicalURL, string(body))
+}
+
+// withContextDefaults 为 ctx 设置时钟与随机串生成器，ctx 中已设置的优先
+func (t *SigningTransport) withContextDefaults(ctx context.Context) context.Context {
+	if t.clock != nil && !auth.HasClock(ctx) {
+		ctx = auth.WithClock(ctx, t.clock)
+	}
+	if t.nonce != nil && !auth.HasNonceGenerator(ctx) {
+		ctx = auth.WithNonceGenerator(ctx, t.nonce)
+	}
+	return ctx
+}
+
+// ValidateResponse 对应答验签，仅对 2XX 应答验签，其余应答返回 nil
+func (t *SigningTransport) ValidateResponse(ctx context.Context, statusCode int, header http.Header, body []byte) error {
+	if statusCode < 200 || statusCode > 299 {
+		return nil
+	}
+	return t.validator.Validate(t.withContextDefaults(ctx), &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+	})
+}
+
+// readRequestBody 读取请求包体，优先使用 GetBody 以免消耗请求的 Body。请求的 Body 由调用方关闭
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	var r io.Reader = req.Body
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("get request body err:%v", err)
+		}
+		defer func() { _ = rc.Close() }()
+		r = rc
+	}
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read request body err:%v", err)
+	}
+	return body, nil
+}
+
+// requestSignBody 返回请求的签名包体，上传文件的请求为 meta 表单字段的内容
+func requestSignBody(contentType string, body []byte) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.EqualFold(mediaType, "multipart/form-data") {
+		return string(body), nil
+	}
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return "", fmt.Errorf("multipart request body has no meta field")
+		}
+		if err != nil {
+			return "", fmt.Errorf("parse multipart request body err:%v", err)
+		}
+		if part.FormName() == "meta" {
+			meta, err := ioutil.ReadAll(part)
+			if err != nil {
+				return "", fmt.Errorf("read meta field err:%v", err)
+			}
+			return string(meta), nil
+		}
+	}
+}
+
+var _ http.RoundTripper = (*SigningTransport)(nil)
@@ -0,0 +1,202 @@
+package core_test
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+)
+
+func newTestSigningTransport(t *testing.T) *core.SigningTransport {
+	transport, err := core.NewSigningTransport(nil,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+	)
+	require.NoError(t, err)
+	return transport
+}
+
+func TestSigningTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		schema, params := parseAuthorization(t, r.Header.Get(consts.Authorization))
+		assertAuthorization(t, schema, r.Method, r.URL.RequestURI(), params, body)
+		assert.Contains(t, r.Header.Get(consts.UserAgent), "WechatPay-Go/")
+
+		switch r.URL.Path {
+		case "/v3/unsigned":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(responseBody))
+		case "/v3/error":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"PARAM_ERROR","message":"参数错误"}`))
+		default:
+			assert.Equal(t, `{"a":"b"}`, string(body))
+			writeResponse(w)
+		}
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTestSigningTransport(t)}
+
+	t.Run("post", func(t *testing.T) {
+		resp, err := client.Post(ts.URL+"/v3/resource?b=2&a=1", consts.ApplicationJSON, strings.NewReader(`{"a":"b"}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, responseBody, string(body))
+	})
+
+	t.Run("unsigned response", func(t *testing.T) {
+		_, err := client.Get(ts.URL + "/v3/unsigned")
+		assert.Error(t, err)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		resp, err := client.Get(ts.URL + "/v3/error")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Error(t, core.CheckResponse(resp))
+	})
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// closeRecorder 记录是否被关闭的请求包体
+type closeRecorder struct {
+	io.Reader
+	closed int
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed++
+	return nil
+}
+
+func TestSigningTransport_ClosesRequestBody(t *testing.T) {
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/v3/broken" {
+			return nil, errors.New("connection reset")
+		}
+		return &http.Response{StatusCode: http.StatusBadRequest, Body: ioutil.NopCloser(strings.NewReader("")), Request: req}, nil
+	})
+	transport, err := core.NewSigningTransport(base,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithWechatPayCertificate([]*x509.Certificate{wechatPayCertificate}),
+	)
+	require.NoError(t, err)
+	failingNonce := auth.NonceGeneratorFunc(func() (string, error) { return "", errors.New("no entropy") })
+
+	tests := []struct {
+		name      string
+		path      string
+		getBody   func() (io.ReadCloser, error)
+		nonce     auth.NonceGenerator
+		wantError bool
+	}{
+		{name: "success", path: "/v3/resource"},
+		{name: "success with GetBody", path: "/v3/resource", getBody: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader(`{"a":"b"}`)), nil
+		}},
+		{name: "GetBody error", path: "/v3/resource", wantError: true, getBody: func() (io.ReadCloser, error) {
+			return nil, errors.New("body is gone")
+		}},
+		{name: "sign error", path: "/v3/resource", nonce: failingNonce, wantError: true},
+		{name: "transport error", path: "/v3/broken", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := &closeRecorder{Reader: strings.NewReader(`{"a":"b"}`)}
+			req, err := http.NewRequest(http.MethodPost, consts.WechatPayAPIServer+tt.path, body)
+			require.NoError(t, err)
+			req.GetBody = tt.getBody
+			if tt.nonce != nil {
+				req = req.WithContext(auth.WithNonceGenerator(req.Context(), tt.nonce))
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				_ = resp.Body.Close()
+			}
+			assert.Equal(t, 1, body.closed)
+		})
+	}
+}
+
+func TestSigningTransport_Upload(t *testing.T) {
+	const meta = `{"filename":"picture.jpeg","sha256":"abc"}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		schema, params := parseAuthorization(t, r.Header.Get(consts.Authorization))
+		assertAuthorization(t, schema, r.Method, r.URL.RequestURI(), params, []byte(meta))
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, meta, r.FormValue("meta"))
+		writeSignature(w, "")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(t, writer.WriteField("meta", meta))
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, _ = part.Write([]byte("picture"))
+	require.NoError(t, writer.Close())
+
+	client := &http.Client{Transport: newTestSigningTransport(t)}
+	resp, err := client.Post(ts.URL+"/v3/merchant/media/upload", writer.FormDataContentType(), body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestSigningTransport_Authorize(t *testing.T) {
+	transport := newTestSigningTransport(t)
+
+	authorization, err := transport.Authorize(ctx, http.MethodGet, consts.WechatPayAPIServer+testRequestUri, nil)
+	require.NoError(t, err)
+	schema, params := parseAuthorization(t, authorization)
+	assertAuthorization(t, schema, http.MethodGet, testRequestUri, params, nil)
+
+	// 使用 option.WithClock 设置的时钟生成时间戳
+	clocked, err := core.NewSigningTransport(nil,
+		option.WithMerchantCredential(testMchID, testCertificateSerialNumber, privateKey),
+		option.WithoutValidator(),
+		option.WithClock(auth.ClockFunc(func() time.Time { return time.Unix(1624523846, 0) })),
+	)
+	require.NoError(t, err)
+	authorization, err = clocked.Authorize(ctx, http.MethodGet, testRequestUri, nil)
+	require.NoError(t, err)
+	_, params = parseAuthorization(t, authorization)
+	assert.Equal(t, "1624523846", params["timestamp"])
+
+	recorder := httptest.NewRecorder()
+	writeResponse(recorder)
+	assert.NoError(t, transport.ValidateResponse(ctx, recorder.Code, recorder.Header(), recorder.Body.Bytes()))
+	assert.Error(t, transport.ValidateResponse(ctx, http.StatusOK, recorder.Header(), []byte(`{"hello":"tampered"}`)))
+	assert.NoError(t, transport.ValidateResponse(ctx, http.StatusBadRequest, http.Header{}, nil))
+}
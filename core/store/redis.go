@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/validators"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/downloader"
+)
+
+// DefaultRedisKeyPrefix Redis 键的默认前缀
+const DefaultRedisKeyPrefix = "wechatpay:"
+
+// RedisClient Redis 存储所使用的命令，SDK 不依赖具体的 Redis 客户端，以 go-redis 为例：
+//
+//	type goRedisClient struct {
+//		rdb *redis.Client
+//	}
+//
+//	func (c goRedisClient) Get(ctx context.Context, key string) (string, bool, error) {
+//		value, err := c.rdb.Get(ctx, key).Result()
+//		if err == redis.Nil {
+//			return "", false, nil
+//		}
+//		return value, err == nil, err
+//	}
+//
+//	func (c goRedisClient) Set(ctx context.Context, key, value string) error {
+//		return c.rdb.Set(ctx, key, value, 0).Err()
+//	}
+//
+//	func (c goRedisClient) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+//		return c.rdb.SetNX(ctx, key, value, ttl).Result()
+//	}
+type RedisClient interface {
+	// Get 读取 key 的值，key 不存在时 ok 为 false
+	Get(ctx context.Context, key string) (value string, ok bool, err error)
+	// Set 设置 key 的值，不设置过期时间
+	Set(ctx context.Context, key, value string) error
+	// SetNX 在 key 不存在时设置 key 的值并在 ttl 后过期（SET key value NX PX ttl），返回是否设置成功
+	SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+}
+
+// RedisOption RedisCertificateStore 与 RedisNonceStore 的配置项
+type RedisOption func(prefix *string)
+
+// WithKeyPrefix 设置 Redis 键的前缀，默认为 DefaultRedisKeyPrefix
+func WithKeyPrefix(prefix string) RedisOption {
+	return func(p *string) {
+		*p = prefix
+	}
+}
+
+func newKeyPrefix(opts []RedisOption) string {
+	prefix := DefaultRedisKeyPrefix
+	for _, opt := range opts {
+		opt(&prefix)
+	}
+	return prefix
+}
+
+// RedisCertificateStore 基于 Redis 的平台证书存储，商户的平台证书以 JSON 格式保存在 {prefix}certificates:{mchID} 中
+type RedisCertificateStore struct {
+	client RedisClient
+	key    string
+}
+
+// NewRedisCertificateStore 创建商户 mchID 的 RedisCertificateStore
+func NewRedisCertificateStore(client RedisClient, mchID string, opts ...RedisOption) *RedisCertificateStore {
+	return &RedisCertificateStore{client: client, key: newKeyPrefix(opts) + "certificates:" + mchID}
+}
+
+// Load 读取已保存的平台证书，没有保存过证书时返回空 map
+func (s *RedisCertificateStore) Load(ctx context.Context) (map[string]string, error) {
+	value, ok, err := s.client.Get(ctx, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("load certificates from redis %s err:%v", s.key, err)
+	}
+	certContents := make(map[string]string)
+	if !ok {
+		return certContents, nil
+	}
+	if err = json.Unmarshal([]byte(value), &certContents); err != nil {
+		return nil, fmt.Errorf("parse certificates from redis %s err:%v", s.key, err)
+	}
+	return certContents, nil
+}
+
+// Save 保存最新的平台证书
+func (s *RedisCertificateStore) Save(ctx context.Context, certContents map[string]string) error {
+	value, err := json.Marshal(certContents)
+	if err != nil {
+		return err
+	}
+	if err = s.client.Set(ctx, s.key, string(value)); err != nil {
+		return fmt.Errorf("save certificates to redis %s err:%v", s.key, err)
+	}
+	return nil
+}
+
+// RedisNonceStore 基于 Redis 的通知随机串存储，随机串保存在 {prefix}nonce:{nonce} 中，过期后由 Redis 自动删除
+type RedisNonceStore struct {
+	client RedisClient
+	prefix string
+}
+
+// NewRedisNonceStore 创建 RedisNonceStore
+func NewRedisNonceStore(client RedisClient, opts ...RedisOption) *RedisNonceStore {
+	return &RedisNonceStore{client: client, prefix: newKeyPrefix(opts) + "nonce:"}
+}
+
+// Add 记录随机串 nonce 并保留 ttl，nonce 已被记录且尚未过期时返回 false
+func (s *RedisNonceStore) Add(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	added, err := s.client.SetNX(ctx, s.prefix+nonce, "1", ttl)
+	if err != nil {
+		return false, fmt.Errorf("add nonce %s to redis err:%v", nonce, err)
+	}
+	return added, nil
+}
+
+var (
+	_ downloader.CertificateStore = (*RedisCertificateStore)(nil)
+	_ validators.NonceStore       = (*RedisNonceStore)(nil)
+)
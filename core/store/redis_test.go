@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRedis 基于内存的 RedisClient，过期时间使用 now 判断
+type memoryRedis struct {
+	mu      sync.Mutex
+	now     time.Time
+	values  map[string]string
+	expires map[string]time.Time
+	err     error
+}
+
+func newMemoryRedis() *memoryRedis {
+	return &memoryRedis{now: time.Now(), values: map[string]string{}, expires: map[string]time.Time{}}
+}
+
+func (r *memoryRedis) Get(_ context.Context, key string) (string, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return "", false, r.err
+	}
+	if expire, ok := r.expires[key]; ok && !r.now.Before(expire) {
+		delete(r.values, key)
+	}
+	value, ok := r.values[key]
+	return value, ok, nil
+}
+
+func (r *memoryRedis) Set(_ context.Context, key, value string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.values[key] = value
+	delete(r.expires, key)
+	return nil
+}
+
+func (r *memoryRedis) SetNX(_ context.Context, key, value string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return false, r.err
+	}
+	if _, ok := r.values[key]; ok && r.now.Before(r.expires[key]) {
+		return false, nil
+	}
+	r.values[key] = value
+	r.expires[key] = r.now.Add(ttl)
+	return true, nil
+}
+
+func TestRedisCertificateStore(t *testing.T) {
+	ctx := context.Background()
+	client := newMemoryRedis()
+	s := NewRedisCertificateStore(client, "1900009191")
+
+	stored, err := s.Load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+
+	require.NoError(t, s.Save(ctx, map[string]string{"SERIAL1": "certificate 1"}))
+	stored, err = s.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"SERIAL1": "certificate 1"}, stored)
+	assert.Contains(t, client.values, "wechatpay:certificates:1900009191")
+
+	client.values["wechatpay:certificates:1900009191"] = "not json"
+	_, err = s.Load(ctx)
+	assert.Error(t, err)
+
+	client.err = fmt.Errorf("mock redis error")
+	_, err = s.Load(ctx)
+	assert.Error(t, err)
+	assert.Error(t, s.Save(ctx, map[string]string{}))
+}
+
+func TestRedisNonceStore(t *testing.T) {
+	ctx := context.Background()
+	client := newMemoryRedis()
+	s := NewRedisNonceStore(client, WithKeyPrefix("app:"))
+
+	added, err := s.Add(ctx, "NONCE1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.Contains(t, client.values, "app:nonce:NONCE1")
+	added, err = s.Add(ctx, "NONCE1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	client.now = client.now.Add(time.Minute)
+	added, err = s.Add(ctx, "NONCE1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	client.err = fmt.Errorf("mock redis error")
+	_, err = s.Add(ctx, "NONCE2", time.Minute)
+	assert.Error(t, err)
+}
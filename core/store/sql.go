@@ -0,0 +1,192 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth/validators"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/downloader"
+)
+
+const (
+	// DefaultCertificateTable SQLCertificateStore 默认使用的表名
+	DefaultCertificateTable = "wechatpay_certificate"
+	// DefaultNonceTable SQLNonceStore 默认使用的表名
+	DefaultNonceTable = "wechatpay_nonce"
+)
+
+// MySQLCertificateSchema SQLCertificateStore 在 MySQL 中使用的表结构，其他数据库请按需调整字段类型
+const MySQLCertificateSchema = `CREATE TABLE wechatpay_certificate (
+  mch_id      VARCHAR(32)  NOT NULL,
+  serial_no   VARCHAR(64)  NOT NULL,
+  content     TEXT         NOT NULL,
+  update_time DATETIME(6)  NOT NULL,
+  PRIMARY KEY (mch_id, serial_no)
+)`
+
+// MySQLNonceSchema SQLNonceStore 在 MySQL 中使用的表结构，其他数据库请按需调整字段类型
+const MySQLNonceSchema = `CREATE TABLE wechatpay_nonce (
+  nonce       VARCHAR(64)  NOT NULL PRIMARY KEY,
+  expire_time DATETIME(6)  NOT NULL,
+  KEY idx_expire_time (expire_time)
+)`
+
+type sqlConfig struct {
+	table       string
+	placeholder Placeholder
+}
+
+// SQLOption SQLCertificateStore 与 SQLNonceStore 的配置项
+type SQLOption func(c *sqlConfig)
+
+// WithTableName 设置数据表名，默认为 DefaultCertificateTable 或 DefaultNonceTable
+func WithTableName(table string) SQLOption {
+	return func(c *sqlConfig) {
+		if table != "" {
+			c.table = table
+		}
+	}
+}
+
+// WithPlaceholder 设置 SQL 语句的参数占位符，默认为 QuestionPlaceholder
+func WithPlaceholder(placeholder Placeholder) SQLOption {
+	return func(c *sqlConfig) {
+		if placeholder != nil {
+			c.placeholder = placeholder
+		}
+	}
+}
+
+func newSQLConfig(table string, opts []SQLOption) sqlConfig {
+	c := sqlConfig{table: table, placeholder: QuestionPlaceholder}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// runInTx 在 db 的事务中执行 fn，fn 返回 nil 时提交事务，否则回滚事务
+func runInTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// SQLCertificateStore 基于 database/sql 的平台证书存储，多个商户可共用一张表，表结构见 MySQLCertificateSchema
+type SQLCertificateStore struct {
+	db    *sql.DB
+	mchID string
+	sqlConfig
+}
+
+// NewSQLCertificateStore 创建商户 mchID 的 SQLCertificateStore
+func NewSQLCertificateStore(db *sql.DB, mchID string, opts ...SQLOption) *SQLCertificateStore {
+	return &SQLCertificateStore{db: db, mchID: mchID, sqlConfig: newSQLConfig(DefaultCertificateTable, opts)}
+}
+
+// Load 读取已保存的平台证书，没有保存过证书时返回空 map
+func (s *SQLCertificateStore) Load(ctx context.Context) (map[string]string, error) {
+	query := s.placeholder.Bind("SELECT serial_no, content FROM " + s.table + " WHERE mch_id = ?")
+	rows, err := s.db.QueryContext(ctx, query, s.mchID)
+	if err != nil {
+		return nil, fmt.Errorf("load certificates of %s err:%v", s.mchID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	certContents := make(map[string]string)
+	for rows.Next() {
+		var serialNo, content string
+		if err = rows.Scan(&serialNo, &content); err != nil {
+			return nil, fmt.Errorf("load certificates of %s err:%v", s.mchID, err)
+		}
+		certContents[serialNo] = content
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("load certificates of %s err:%v", s.mchID, err)
+	}
+	return certContents, nil
+}
+
+// Save 在一个事务中使用 certContents 替换已保存的平台证书
+func (s *SQLCertificateStore) Save(ctx context.Context, certContents map[string]string) error {
+	now := auth.Now(ctx)
+	err := runInTx(ctx, s.db, func(tx *sql.Tx) error {
+		query := s.placeholder.Bind("DELETE FROM " + s.table + " WHERE mch_id = ?")
+		if _, err := tx.ExecContext(ctx, query, s.mchID); err != nil {
+			return err
+		}
+		query = s.placeholder.Bind("INSERT INTO " + s.table +
+			" (mch_id, serial_no, content, update_time) VALUES (?, ?, ?, ?)")
+		for serialNo, content := range certContents {
+			if _, err := tx.ExecContext(ctx, query, s.mchID, serialNo, content, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("save certificates of %s err:%v", s.mchID, err)
+	}
+	return nil
+}
+
+// SQLNonceStore 基于 database/sql 的通知随机串存储，表结构见 MySQLNonceSchema
+//
+// 每次记录随机串时会同时删除已过期的随机串，无需另外清理
+type SQLNonceStore struct {
+	db *sql.DB
+	sqlConfig
+}
+
+// NewSQLNonceStore 创建 SQLNonceStore
+func NewSQLNonceStore(db *sql.DB, opts ...SQLOption) *SQLNonceStore {
+	return &SQLNonceStore{db: db, sqlConfig: newSQLConfig(DefaultNonceTable, opts)}
+}
+
+// Add 记录随机串 nonce 并保留 ttl，nonce 已被记录且尚未过期时返回 false
+//
+// 同一随机串被并发记录时，主键冲突的一方返回数据库的错误
+func (s *SQLNonceStore) Add(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	now := auth.Now(ctx)
+	added := false
+	err := runInTx(ctx, s.db, func(tx *sql.Tx) error {
+		query := s.placeholder.Bind("DELETE FROM " + s.table + " WHERE expire_time <= ?")
+		if _, err := tx.ExecContext(ctx, query, now); err != nil {
+			return err
+		}
+
+		var count int
+		query = s.placeholder.Bind("SELECT COUNT(*) FROM " + s.table + " WHERE nonce = ?")
+		if err := tx.QueryRowContext(ctx, query, nonce).Scan(&count); err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+
+		query = s.placeholder.Bind("INSERT INTO " + s.table + " (nonce, expire_time) VALUES (?, ?)")
+		if _, err := tx.ExecContext(ctx, query, nonce, now.Add(ttl)); err != nil {
+			return err
+		}
+		added = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("add nonce %s err:%v", nonce, err)
+	}
+	return added, nil
+}
+
+var (
+	_ downloader.CertificateStore = (*SQLCertificateStore)(nil)
+	_ validators.NonceStore       = (*SQLNonceStore)(nil)
+)
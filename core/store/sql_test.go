@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// fakeHandler 处理 fakeDriver 收到的 SQL 语句，Exec 时忽略返回的结果集
+type fakeHandler func(query string, args []driver.Value) (columns []string, rows [][]driver.Value, err error)
+
+var (
+	fakeHandlersLock sync.Mutex
+	fakeHandlers     = map[string]fakeHandler{}
+)
+
+func init() {
+	sql.Register("storetest", fakeDriver{})
+}
+
+func openFakeDB(t *testing.T, handler fakeHandler) *sql.DB {
+	fakeHandlersLock.Lock()
+	fakeHandlers[t.Name()] = handler
+	fakeHandlersLock.Unlock()
+
+	db, err := sql.Open("storetest", t.Name())
+	require.NoError(t, err)
+	return db
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeHandlersLock.Lock()
+	defer fakeHandlersLock.Unlock()
+	return &fakeConn{handler: fakeHandlers[name]}, nil
+}
+
+type fakeConn struct {
+	handler fakeHandler
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{handler: c.handler, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error {
+	return nil
+}
+
+func (fakeTx) Rollback() error {
+	return nil
+}
+
+type fakeStmt struct {
+	handler fakeHandler
+	query   string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if _, _, err := s.handler(s.query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	columns, rows, err := s.handler(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{columns: columns, values: rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestSQLCertificateStore(t *testing.T) {
+	ctx := context.Background()
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+	certificates := map[string]map[string]string{"1230000109": {"OTHER": "other certificate"}}
+	db := openFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		queries = append(queries, query)
+		mchID := args[0].(string)
+		switch {
+		case strings.HasPrefix(query, "SELECT"):
+			var rows [][]driver.Value
+			for serialNo, content := range certificates[mchID] {
+				rows = append(rows, []driver.Value{serialNo, content})
+			}
+			return []string{"serial_no", "content"}, rows, nil
+		case strings.HasPrefix(query, "DELETE"):
+			delete(certificates, mchID)
+		case strings.HasPrefix(query, "INSERT"):
+			if certificates[mchID] == nil {
+				certificates[mchID] = map[string]string{}
+			}
+			certificates[mchID][args[1].(string)] = args[2].(string)
+		}
+		return nil, nil, nil
+	})
+	defer func() { _ = db.Close() }()
+
+	s := NewSQLCertificateStore(db, "1900009191", WithPlaceholder(DollarPlaceholder))
+	stored, err := s.Load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+
+	require.NoError(t, s.Save(ctx, map[string]string{"SERIAL1": "certificate 1"}))
+	require.NoError(t, s.Save(ctx, map[string]string{"SERIAL2": "certificate 2"}))
+	stored, err = s.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"SERIAL2": "certificate 2"}, stored)
+	// 不影响其他商户的证书
+	assert.Equal(t, map[string]string{"OTHER": "other certificate"}, certificates["1230000109"])
+
+	assert.Equal(t, "SELECT serial_no, content FROM wechatpay_certificate WHERE mch_id = $1", queries[0])
+	assert.Equal(t, "DELETE FROM wechatpay_certificate WHERE mch_id = $1", queries[1])
+	assert.Equal(t,
+		"INSERT INTO wechatpay_certificate (mch_id, serial_no, content, update_time) VALUES ($1, $2, $3, $4)",
+		queries[2],
+	)
+}
+
+func TestSQLCertificateStore_Error(t *testing.T) {
+	ctx := context.Background()
+	db := openFakeDB(t, func(string, []driver.Value) ([]string, [][]driver.Value, error) {
+		return nil, nil, fmt.Errorf("mock db error")
+	})
+	defer func() { _ = db.Close() }()
+
+	s := NewSQLCertificateStore(db, "1900009191")
+	_, err := s.Load(ctx)
+	assert.Error(t, err)
+	assert.Error(t, s.Save(ctx, map[string]string{"SERIAL1": "certificate 1"}))
+}
+
+func TestSQLNonceStore(t *testing.T) {
+	now := time.Now()
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+	var mu sync.Mutex
+	nonces := map[string]time.Time{}
+	db := openFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case strings.HasPrefix(query, "DELETE FROM payment_nonce WHERE expire_time <= ?"):
+			for nonce, expire := range nonces {
+				if !expire.After(args[0].(time.Time)) {
+					delete(nonces, nonce)
+				}
+			}
+		case strings.HasPrefix(query, "SELECT COUNT(*) FROM payment_nonce WHERE nonce = ?"):
+			count := int64(0)
+			if _, ok := nonces[args[0].(string)]; ok {
+				count = 1
+			}
+			return []string{"count"}, [][]driver.Value{{count}}, nil
+		case strings.HasPrefix(query, "INSERT INTO payment_nonce (nonce, expire_time) VALUES (?, ?)"):
+			nonces[args[0].(string)] = args[1].(time.Time)
+		default:
+			return nil, nil, fmt.Errorf("unexpected query %s", query)
+		}
+		return nil, nil, nil
+	})
+	defer func() { _ = db.Close() }()
+
+	s := NewSQLNonceStore(db, WithTableName("payment_nonce"))
+	added, err := s.Add(ctx, "NONCE1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = s.Add(ctx, "NONCE1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	// 过期的随机串在下次记录时被删除
+	now = now.Add(time.Minute)
+	added, err = s.Add(ctx, "NONCE1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+}
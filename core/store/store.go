@@ -0,0 +1,39 @@
+// Package store 平台证书与通知随机串的共享存储，提供基于 database/sql 与 Redis 的实现
+//
+// 多实例部署时，各实例可共享下载的平台证书（downloader.CertificateStore）与已使用的通知随机串（validators.NonceStore），
+// 避免进程重启后证书下载失败导致无法验签，以及通知请求在不同实例间被重放。
+package store
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Placeholder 生成 SQL 语句中第 n 个参数（从 1 开始）的占位符
+type Placeholder func(n int) string
+
+// QuestionPlaceholder 使用 ? 作为占位符，适用于 MySQL 与 SQLite
+func QuestionPlaceholder(int) string {
+	return "?"
+}
+
+// DollarPlaceholder 使用 $1、$2 作为占位符，适用于 PostgreSQL
+func DollarPlaceholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+// Bind 将 query 中的 ? 依次替换为 p 生成的占位符，p 为 nil 时不替换
+func (p Placeholder) Bind(query string) string {
+	if p == nil {
+		return query
+	}
+	parts := strings.Split(query, "?")
+	var b strings.Builder
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteString(p(i))
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
@@ -32,7 +32,98 @@ func Int64(i int64) *int64 {
 	return &i
 }
 
-// Int32 复制 int64 对象，并返回复制体的指针
+// Int32 复制 int32 对象，并返回复制体的指针
 func Int32(i int32) *int32 {
 	return &i
 }
+
+// TimeValue 返回 p 指向的 time.Time，p 为 nil 时返回零值
+func TimeValue(p *time.Time) time.Time {
+	return TimeValueWithDefault(p, time.Time{})
+}
+
+// TimeValueWithDefault 返回 p 指向的 time.Time，p 为 nil 时返回 def
+func TimeValueWithDefault(p *time.Time, def time.Time) time.Time {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// StringValue 返回 p 指向的 string，p 为 nil 时返回空字符串
+func StringValue(p *string) string {
+	return StringValueWithDefault(p, "")
+}
+
+// StringValueWithDefault 返回 p 指向的 string，p 为 nil 时返回 def
+func StringValueWithDefault(p *string, def string) string {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// BoolValue 返回 p 指向的 bool，p 为 nil 时返回 false
+func BoolValue(p *bool) bool {
+	return BoolValueWithDefault(p, false)
+}
+
+// BoolValueWithDefault 返回 p 指向的 bool，p 为 nil 时返回 def
+func BoolValueWithDefault(p *bool, def bool) bool {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Float64Value 返回 p 指向的 float64，p 为 nil 时返回 0
+func Float64Value(p *float64) float64 {
+	return Float64ValueWithDefault(p, 0)
+}
+
+// Float64ValueWithDefault 返回 p 指向的 float64，p 为 nil 时返回 def
+func Float64ValueWithDefault(p *float64, def float64) float64 {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Float32Value 返回 p 指向的 float32，p 为 nil 时返回 0
+func Float32Value(p *float32) float32 {
+	return Float32ValueWithDefault(p, 0)
+}
+
+// Float32ValueWithDefault 返回 p 指向的 float32，p 为 nil 时返回 def
+func Float32ValueWithDefault(p *float32, def float32) float32 {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Int64Value 返回 p 指向的 int64，p 为 nil 时返回 0
+func Int64Value(p *int64) int64 {
+	return Int64ValueWithDefault(p, 0)
+}
+
+// Int64ValueWithDefault 返回 p 指向的 int64，p 为 nil 时返回 def
+func Int64ValueWithDefault(p *int64, def int64) int64 {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Int32Value 返回 p 指向的 int32，p 为 nil 时返回 0
+func Int32Value(p *int32) int32 {
+	return Int32ValueWithDefault(p, 0)
+}
+
+// Int32ValueWithDefault 返回 p 指向的 int32，p 为 nil 时返回 def
+func Int32ValueWithDefault(p *int32, def int32) int32 {
+	if p == nil {
+		return def
+	}
+	return *p
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValue(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, "a", StringValue(String("a")))
+	assert.Equal(t, "", StringValue(nil))
+	assert.Equal(t, "b", StringValueWithDefault(nil, "b"))
+	assert.Equal(t, "", StringValueWithDefault(String(""), "b"))
+
+	assert.Equal(t, int64(1), Int64Value(Int64(1)))
+	assert.Equal(t, int64(0), Int64Value(nil))
+	assert.Equal(t, int64(-1), Int64ValueWithDefault(nil, -1))
+
+	assert.Equal(t, int32(1), Int32Value(Int32(1)))
+	assert.Equal(t, int32(-1), Int32ValueWithDefault(nil, -1))
+
+	assert.True(t, BoolValue(Bool(true)))
+	assert.False(t, BoolValue(nil))
+	assert.True(t, BoolValueWithDefault(nil, true))
+
+	assert.Equal(t, 1.5, Float64Value(Float64(1.5)))
+	assert.Equal(t, 2.5, Float64ValueWithDefault(nil, 2.5))
+	assert.Equal(t, float32(1.5), Float32Value(Float32(1.5)))
+	assert.Equal(t, float32(2.5), Float32ValueWithDefault(nil, 2.5))
+
+	assert.True(t, now.Equal(TimeValue(Time(now))))
+	assert.True(t, TimeValue(nil).IsZero())
+	assert.True(t, now.Equal(TimeValueWithDefault(nil, now)))
+
+	assert.Equal(t, "", StringValue(NullString()))
+}
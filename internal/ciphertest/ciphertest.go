@@ -0,0 +1,243 @@
+// Package ciphertest 敏感字段加解密的黄金文件（golden file）测试工具
+//
+// 黄金文件记录请求或应答结构序列化后的 JSON，其中需要加密的字段记为 "ENCRYPTED:<明文>"。
+// 由于 RSA-OAEP 加密的结果是随机的，比较时对加密字段解密后与明文比较，其余字段需与黄金文件完全一致。
+// 结构中 encryption 标记的增减（如漏标敏感字段、误标普通字段）都会使比较失败。
+package ciphertest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/ciphers"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/decryptors"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/encryptors"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// EncryptedPrefix 黄金文件中加密字段的前缀，其后为字段明文
+const EncryptedPrefix = "ENCRYPTED:"
+
+// TestKeyID 测试使用的微信支付公钥ID
+const TestKeyID = "PUB_KEY_ID_TEST_CIPHER_GOLDEN"
+
+// Harness 使用随机生成的测试密钥加解密请求与应答结构，并与黄金文件比较
+type Harness struct {
+	cipher     *ciphers.WechatPayCipher
+	privateKey *rsa.PrivateKey
+}
+
+// NewHarness 生成测试密钥并创建 Harness
+func NewHarness() (*Harness, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("generate test key err:%v", err)
+	}
+	return &Harness{
+		cipher: ciphers.NewWechatPayCipher(
+			encryptors.NewWechatPayPubKeyEncryptor(TestKeyID, &privateKey.PublicKey),
+			decryptors.NewWechatPayDecryptor(privateKey),
+		),
+		privateKey: privateKey,
+	}, nil
+}
+
+// Plaintext 返回黄金文件的明文版本：去掉加密字段的 EncryptedPrefix
+func Plaintext(golden []byte) ([]byte, error) {
+	return transform(golden, func(s string) (string, error) {
+		return strings.TrimPrefix(s, EncryptedPrefix), nil
+	})
+}
+
+// EncryptRequest 将黄金文件的明文版本解析到 req（结构体指针），加密后返回序列化的 JSON
+func (h *Harness) EncryptRequest(ctx context.Context, golden []byte, req interface{}) ([]byte, error) {
+	plaintext, err := Plaintext(golden)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(plaintext, req); err != nil {
+		return nil, fmt.Errorf("unmarshal request err:%v", err)
+	}
+	serial, err := h.cipher.Encrypt(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt request err:%v", err)
+	}
+	if serial != TestKeyID {
+		return nil, fmt.Errorf("encrypt request with unexpected serial %s", serial)
+	}
+	return json.Marshal(req)
+}
+
+// DecryptResponse 使用测试密钥加密黄金文件中的加密字段，模拟微信支付的应答，解析到 resp（结构体指针）并解密后返回序列化的 JSON
+func (h *Harness) DecryptResponse(ctx context.Context, golden []byte, resp interface{}) ([]byte, error) {
+	encrypted, err := transform(golden, func(s string) (string, error) {
+		if !strings.HasPrefix(s, EncryptedPrefix) {
+			return s, nil
+		}
+		return utils.EncryptOAEPWithPublicKey(strings.TrimPrefix(s, EncryptedPrefix), &h.privateKey.PublicKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(encrypted, resp); err != nil {
+		return nil, fmt.Errorf("unmarshal response err:%v", err)
+	}
+	if err = h.cipher.Decrypt(ctx, resp); err != nil {
+		return nil, fmt.Errorf("decrypt response err:%v", err)
+	}
+	return json.Marshal(resp)
+}
+
+// Compare 比较加密后的请求 actual 与黄金文件，加密字段需能使用测试密钥解密为黄金文件中的明文
+func (h *Harness) Compare(golden, actual []byte) error {
+	var g, a interface{}
+	if err := json.Unmarshal(golden, &g); err != nil {
+		return fmt.Errorf("unmarshal golden err:%v", err)
+	}
+	if err := json.Unmarshal(actual, &a); err != nil {
+		return fmt.Errorf("unmarshal actual err:%v", err)
+	}
+	return h.compare("", g, a)
+}
+
+// Golden 将加密后的请求 actual 转换为黄金文件：可使用测试密钥解密的字段记为 EncryptedPrefix 加明文，用于更新黄金文件
+func (h *Harness) Golden(actual []byte) ([]byte, error) {
+	return transform(actual, func(s string) (string, error) {
+		if plaintext, err := utils.DecryptOAEP(s, h.privateKey); err == nil {
+			return EncryptedPrefix + plaintext, nil
+		}
+		return s, nil
+	})
+}
+
+func (h *Harness) compare(path string, golden, actual interface{}) error {
+	switch g := golden.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %v", path, actual)
+		}
+		for _, key := range unionKeys(g, a) {
+			gv, inGolden := g[key]
+			av, inActual := a[key]
+			switch {
+			case !inGolden:
+				return fmt.Errorf("%s/%s: unexpected field %v", path, key, av)
+			case !inActual:
+				return fmt.Errorf("%s/%s: missing field", path, key)
+			}
+			if err := h.compare(path+"/"+key, gv, av); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(g) {
+			return fmt.Errorf("%s: expected array of %d items, got %v", path, len(g), actual)
+		}
+		for i := range g {
+			if err := h.compare(fmt.Sprintf("%s/%d", path, i), g[i], a[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case string:
+		if !strings.HasPrefix(g, EncryptedPrefix) {
+			break
+		}
+		expected := strings.TrimPrefix(g, EncryptedPrefix)
+		ciphertext, ok := actual.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected encrypted string, got %v", path, actual)
+		}
+		if expected == "" {
+			// 空串不加密
+			if ciphertext != "" {
+				return fmt.Errorf("%s: expected empty string, got %q", path, ciphertext)
+			}
+			return nil
+		}
+		plaintext, err := utils.DecryptOAEP(ciphertext, h.privateKey)
+		if err != nil {
+			return fmt.Errorf("%s: expected encrypted field, got %q which cannot be decrypted: %v", path, ciphertext, err)
+		}
+		if plaintext != expected {
+			return fmt.Errorf("%s: decrypted %q, expected %q", path, plaintext, expected)
+		}
+		return nil
+	}
+	if !reflect.DeepEqual(golden, actual) {
+		if s, ok := actual.(string); ok && s != "" {
+			if _, err := utils.DecryptOAEP(s, h.privateKey); err == nil {
+				return fmt.Errorf("%s: field is unexpectedly encrypted", path)
+			}
+		}
+		return fmt.Errorf("%s: expected %v, got %v", path, golden, actual)
+	}
+	return nil
+}
+
+// transform 对 JSON 中所有的字符串值应用 fn，返回缩进格式的 JSON
+func transform(data []byte, fn func(string) (string, error)) ([]byte, error) {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("unmarshal json err:%v", err)
+	}
+	v, err := transformValue(v, fn)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func transformValue(v interface{}, fn func(string) (string, error)) (interface{}, error) {
+	var err error
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for key, value := range t {
+			if t[key], err = transformValue(value, fn); err != nil {
+				return nil, err
+			}
+		}
+	case []interface{}:
+		for i, value := range t {
+			if t[i], err = transformValue(value, fn); err != nil {
+				return nil, err
+			}
+		}
+	case string:
+		return fn(t)
+	}
+	return v, nil
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
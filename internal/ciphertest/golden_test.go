@@ -0,0 +1,109 @@
+package ciphertest_test
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/ciphertest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/fapiao"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/profitsharing"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/settlement"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+var update = flag.Bool("update", false, "update golden files with encrypted request output")
+
+func newHarness(t *testing.T) *ciphertest.Harness {
+	h, err := ciphertest.NewHarness()
+	require.NoError(t, err)
+	return h
+}
+
+func readGolden(t *testing.T, name string) []byte {
+	golden, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return golden
+}
+
+func TestEncryptRequestGolden(t *testing.T) {
+	h := newHarness(t)
+	tests := []struct {
+		golden string
+		req    interface{}
+	}{
+		{"applyment4sub_submit_applyment.json", &applyment4sub.SubmitApplymentRequest{}},
+		{"transferbatch_initiate_batch_transfer.json", &transferbatch.InitiateBatchTransferRequest{}},
+		{"profitsharing_add_receiver.json", &profitsharing.AddReceiverRequest{}},
+		{"settlement_modify_settlement.json", &settlement.ModifySettlementRequest{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			golden := readGolden(t, tt.golden)
+			actual, err := h.EncryptRequest(context.Background(), golden, tt.req)
+			require.NoError(t, err)
+
+			if *update {
+				golden, err = h.Golden(actual)
+				require.NoError(t, err)
+				require.NoError(t, ioutil.WriteFile(filepath.Join("testdata", tt.golden), golden, 0644))
+			}
+			assert.NoError(t, h.Compare(golden, actual))
+		})
+	}
+}
+
+func TestDecryptResponseGolden(t *testing.T) {
+	h := newHarness(t)
+	tests := []struct {
+		golden string
+		resp   interface{}
+	}{
+		{"fapiao_user_title.json", &fapiao.UserTitleEntity{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			golden := readGolden(t, tt.golden)
+			actual, err := h.DecryptResponse(context.Background(), golden, tt.resp)
+			require.NoError(t, err)
+
+			plaintext, err := ciphertest.Plaintext(golden)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(plaintext), string(actual))
+		})
+	}
+}
+
+// userInfo 用于验证 Harness 能发现 encryption 标记的错误
+type userInfo struct {
+	Name   *string `json:"name" encryption:"EM_APIV3"`
+	Mobile *string `json:"mobile"`
+}
+
+func TestCompare_TagRegression(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+
+	actual, err := h.EncryptRequest(ctx, []byte(`{"name":"ENCRYPTED:张三","mobile":"13800138000"}`), &userInfo{})
+	require.NoError(t, err)
+	assert.NoError(t, h.Compare([]byte(`{"name":"ENCRYPTED:张三","mobile":"13800138000"}`), actual))
+
+	// 漏标加密字段
+	err = h.Compare([]byte(`{"name":"ENCRYPTED:张三","mobile":"ENCRYPTED:13800138000"}`), actual)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `/mobile: expected encrypted field, got "13800138000" which cannot be decrypted`)
+	// 误标加密字段
+	err = h.Compare([]byte(`{"name":"张三","mobile":"13800138000"}`), actual)
+	assert.EqualError(t, err, "/name: field is unexpectedly encrypted")
+	// 字段缺失或多余
+	err = h.Compare([]byte(`{"name":"ENCRYPTED:张三"}`), actual)
+	assert.EqualError(t, err, "/mobile: unexpected field 13800138000")
+	err = h.Compare([]byte(`{"name":"ENCRYPTED:张三","mobile":"13800138000","email":"a@example.com"}`), actual)
+	assert.EqualError(t, err, "/email: missing field")
+}
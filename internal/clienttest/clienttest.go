@@ -0,0 +1,86 @@
+// Package clienttest 使用模拟应答的 core.Client 测试工具
+//
+// 请求不经过网络，而是交给 http.Handler 或 RoundTripFunc 处理；请求使用固定的测试签名，不验证应答签名。
+// 用于编写服务包中组合多个接口调用的辅助方法（如轮询、分批查询）的单元测试。
+package clienttest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+)
+
+const (
+	// TestMchID 测试使用的商户号
+	TestMchID = "1900000001"
+	// TestCertificateSerialNo 测试使用的商户证书序列号
+	TestCertificateSerialNo = "TEST_CERTIFICATE_SERIAL_NO"
+)
+
+// RoundTripFunc 将函数转换为 http.RoundTripper，可用于模拟网络错误
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip 处理请求
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// HandlerTransport 返回使用 handler 处理请求的 http.RoundTripper
+func HandlerTransport(handler http.Handler) http.RoundTripper {
+	return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		response := recorder.Result()
+		response.Request = req
+		return response, nil
+	})
+}
+
+// NewClient 创建使用 transport 发送请求的 core.Client，opts 追加在默认配置之后
+func NewClient(transport http.RoundTripper, opts ...core.ClientOption) (*core.Client, error) {
+	return core.NewClient(context.Background(), append([]core.ClientOption{
+		option.WithSigner(signer{}),
+		option.WithoutValidator(),
+		option.WithHTTPClient(&http.Client{Transport: transport}),
+	}, opts...)...)
+}
+
+// NewHandlerClient 创建使用 handler 处理请求的 core.Client
+func NewHandlerClient(handler http.Handler, opts ...core.ClientOption) (*core.Client, error) {
+	return NewClient(HandlerTransport(handler), opts...)
+}
+
+// WriteJSON 以 status 状态码应答 v 序列化后的 JSON
+func WriteJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// WriteError 以 status 状态码应答微信支付格式的错误
+func WriteError(w http.ResponseWriter, status int, code, message string) {
+	WriteJSON(w, status, map[string]string{"code": code, "message": message})
+}
+
+// signer 返回固定签名的测试签名器
+type signer struct{}
+
+func (signer) Sign(_ context.Context, _ string) (*auth.SignatureResult, error) {
+	return &auth.SignatureResult{
+		MchID:               TestMchID,
+		CertificateSerialNo: TestCertificateSerialNo,
+		Signature:           "TEST_SIGNATURE",
+	}, nil
+}
+
+func (signer) Algorithm() string {
+	return "SHA256-RSA2048"
+}
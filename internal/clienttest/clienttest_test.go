@@ -0,0 +1,51 @@
+package clienttest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+)
+
+func TestNewHandlerClient(t *testing.T) {
+	ctx := context.Background()
+	client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get(consts.Authorization), `mchid="`+clienttest.TestMchID+`"`)
+		if r.URL.Path == "/v3/error" {
+			clienttest.WriteError(w, http.StatusBadRequest, "PARAM_ERROR", "参数错误")
+			return
+		}
+		clienttest.WriteJSON(w, http.StatusOK, map[string]string{"path": r.URL.Path})
+	}))
+	require.NoError(t, err)
+
+	result, err := client.Get(ctx, consts.WechatPayAPIServer+"/v3/ok")
+	require.NoError(t, err)
+	var body map[string]string
+	require.NoError(t, core.UnMarshalResponse(result.Response, &body))
+	assert.Equal(t, "/v3/ok", body["path"])
+
+	_, err = client.Get(ctx, consts.WechatPayAPIServer+"/v3/error")
+	var apiErr *core.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "PARAM_ERROR", apiErr.Code)
+}
+
+func TestNewClient_TransportError(t *testing.T) {
+	client, err := clienttest.NewClient(clienttest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection reset")
+	}))
+	require.NoError(t, err)
+
+	_, err = client.Get(context.Background(), consts.WechatPayAPIServer+"/v3/ok")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection reset")
+}
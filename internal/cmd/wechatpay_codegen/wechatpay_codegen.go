@@ -0,0 +1,67 @@
+// wechatpay_codegen 根据 OpenAPI 3 接口定义生成服务 SDK，供 go:generate 使用
+//
+// 示例：
+//
+//	go run github.com/wechatpay-apiv3/wechatpay-go/internal/cmd/wechatpay_codegen -s specs/certificates.json -r ../..
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/generator"
+)
+
+var (
+	specPath string
+	rootPath string
+	skipDocs bool
+)
+
+func init() {
+	flag.StringVar(&specPath, "s", "", "【必传】`接口定义文件路径`")
+	flag.StringVar(&rootPath, "r", ".", "【可选】`仓库根目录`，生成的代码与文档将分别写入其下的 services 与 docs 目录")
+	flag.BoolVar(&skipDocs, "skip-docs", false, "【可选】不生成服务文档")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if specPath == "" {
+		reportError("参数有误：接口定义文件路径 必传")
+		usage()
+	}
+
+	spec, err := generator.LoadSpec(specPath)
+	if err != nil {
+		reportError("加载接口定义失败：%v", err)
+		os.Exit(2)
+	}
+
+	files, err := generator.Generate(spec, generator.Options{SkipDocs: skipDocs})
+	if err != nil {
+		reportError("生成代码失败：%v", err)
+		os.Exit(2)
+	}
+
+	if err = generator.WriteFiles(rootPath, files); err != nil {
+		reportError("写入文件失败：%v", err)
+		os.Exit(2)
+	}
+
+	for _, file := range files {
+		fmt.Println(file.Path)
+	}
+}
+
+func reportError(format string, a ...interface{}) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", a...)
+}
+
+func usage() {
+	_, _ = fmt.Fprintf(os.Stderr, "usage of wechatpay_codegen:\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
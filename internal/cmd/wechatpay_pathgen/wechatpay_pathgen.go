@@ -0,0 +1,73 @@
+// wechatpay_pathgen 汇总接口定义中的接口路径，生成 core/circuitbreaker 的默认路径模板，供 go:generate 使用
+//
+// 示例：
+//
+//	go run github.com/wechatpay-apiv3/wechatpay-go/internal/cmd/wechatpay_pathgen -d specs -r ../..
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/generator"
+)
+
+var (
+	specDir  string
+	rootPath string
+)
+
+func init() {
+	flag.StringVar(&specDir, "d", "", "【必传】`接口定义目录`，读取其中全部的 .json 文件")
+	flag.StringVar(&rootPath, "r", ".", "【可选】`仓库根目录`")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if specDir == "" {
+		reportError("参数有误：接口定义目录 必传")
+		usage()
+	}
+
+	specPaths, err := filepath.Glob(filepath.Join(specDir, "*.json"))
+	if err != nil || len(specPaths) == 0 {
+		reportError("接口定义目录 %s 中没有接口定义：%v", specDir, err)
+		os.Exit(2)
+	}
+
+	specs := make([]*generator.Spec, 0, len(specPaths))
+	for _, specPath := range specPaths {
+		spec, err := generator.LoadSpec(specPath)
+		if err != nil {
+			reportError("加载接口定义 %s 失败：%v", specPath, err)
+			os.Exit(2)
+		}
+		specs = append(specs, spec)
+	}
+
+	file, err := generator.GeneratePathTemplates(specs)
+	if err != nil {
+		reportError("生成路径模板失败：%v", err)
+		os.Exit(2)
+	}
+
+	if err = generator.WriteFiles(rootPath, []generator.File{file}); err != nil {
+		reportError("写入文件失败：%v", err)
+		os.Exit(2)
+	}
+	fmt.Println(file.Path)
+}
+
+func reportError(format string, a ...interface{}) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", a...)
+}
+
+func usage() {
+	_, _ = fmt.Fprintf(os.Stderr, "usage of wechatpay_pathgen:\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
@@ -0,0 +1,32 @@
+package generator
+
+// 以下服务由本生成器根据 specs 目录下的接口定义生成，修改接口定义后执行 go generate 重新生成
+//go:generate go run ../cmd/wechatpay_codegen -s specs/certificates.json -r ../.. -skip-docs
+//go:generate go run ../cmd/wechatpay_codegen -s specs/transferbatch.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/profitsharing.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/partnerships.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/payscore.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/settlement.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/merchantriskmanage.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/merchantservice.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/ecommercefund.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/ecommerceprofitsharing.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/ecommercesubsidies.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/payments_combine.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/edupapay.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/vehicle.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/exchangerate.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/bill.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/applyment4sub.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/fapiao.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/partnerpayments_jsapi.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/partnerpayments_app.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/partnerpayments_native.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/partnerpayments_h5.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/cashcoupons.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/merchantexclusivecoupon.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/subdevconfig.json -r ../..
+//go:generate go run ../cmd/wechatpay_codegen -s specs/globalpayments.json -r ../..
+
+// 熔断器的默认路径模板包含以上全部接口定义中的路径，接口定义变化后需一并重新生成
+//go:generate go run ../cmd/wechatpay_pathgen -d specs -r ../..
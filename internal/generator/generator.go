@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// File 生成的文件
+type File struct {
+	// Path 相对于仓库根目录的路径，使用 / 分隔
+	Path    string
+	Content []byte
+}
+
+// Options 生成选项
+type Options struct {
+	// SkipDocs 不生成 docs 目录下的服务文档
+	SkipDocs bool
+}
+
+// Generate 根据接口定义生成服务 SDK 的全部文件
+func Generate(spec *Spec, opts Options) ([]File, error) {
+	pkg, err := resolve(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []File
+	addGo := func(name, src string) error {
+		content, err := format.Source([]byte(src))
+		if err != nil {
+			return fmt.Errorf("format %s err: %v", name, err)
+		}
+		files = append(files, File{Path: "services/" + pkg.Path + "/" + name, Content: content})
+		return nil
+	}
+	addDoc := func(name, content string) {
+		if !opts.SkipDocs {
+			files = append(files, File{Path: "docs/" + pkg.Path + "/" + name, Content: []byte(content)})
+		}
+	}
+
+	for _, svc := range pkg.Services {
+		if err = addGo("api_"+svc.FileBase+".go", renderAPI(pkg, svc)); err != nil {
+			return nil, err
+		}
+		if err = addGo("api_"+svc.FileBase+"_example_test.go", renderExamples(pkg, svc)); err != nil {
+			return nil, err
+		}
+		addDoc(svc.Name+".md", renderServiceDoc(pkg, svc))
+	}
+	if len(pkg.Models) > 0 {
+		if err = addGo("models.go", renderModels(pkg)); err != nil {
+			return nil, err
+		}
+	}
+
+	addDoc("README.md", renderReadme(pkg))
+	for _, model := range pkg.Models {
+		addDoc(model.Name+".md", renderModelDoc(pkg, model))
+	}
+	return files, nil
+}
+
+// WriteFiles 将生成的文件写入 root 目录
+func WriteFiles(root string, files []File) error {
+	for _, file := range files {
+		if strings.Contains(file.Path, "..") {
+			return fmt.Errorf("invalid generated file path %s", file.Path)
+		}
+		target := filepath.Join(root, filepath.FromSlash(file.Path))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("create directory for %s err: %v", file.Path, err)
+		}
+		if err := ioutil.WriteFile(target, file.Content, 0644); err != nil {
+			return fmt.Errorf("write %s err: %v", file.Path, err)
+		}
+	}
+	return nil
+}
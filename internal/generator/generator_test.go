@@ -0,0 +1,337 @@
+package generator
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const repoRoot = "../.."
+
+// TestGenerateExistingServices 使用已有服务的接口定义重新生成代码，生成结果应与仓库中的代码完全一致
+func TestGenerateExistingServices(t *testing.T) {
+	tests := []struct {
+		spec string
+		// skip 不参与比对的文件。新版本 gofmt 会重排部分文档注释，导致与仓库中的代码存在格式差异
+		skip []string
+	}{
+		// payments/native 的示例代码由旧版生成器生成，字段顺序与当前版本不同
+		{spec: "payments_native.json", skip: []string{"services/payments/native/api_native_example_test.go"}},
+		{spec: "certificates.json"},
+		{spec: "refunddomestic.json", skip: []string{"services/refunddomestic/api_refunds.go"}},
+		{spec: "profitsharing.json"},
+		{spec: "transferbatch.json"},
+		{spec: "partnerships.json"},
+		{spec: "payscore.json"},
+		{spec: "settlement.json"},
+		{spec: "merchantriskmanage.json"},
+		{spec: "merchantservice.json"},
+		{spec: "ecommercefund.json"},
+		{spec: "ecommerceprofitsharing.json"},
+		{spec: "ecommercesubsidies.json"},
+		{spec: "payments_combine.json"},
+		{spec: "edupapay.json"},
+		{spec: "vehicle.json"},
+		{spec: "exchangerate.json"},
+		{spec: "bill.json"},
+		{spec: "applyment4sub.json"},
+		{spec: "fapiao.json"},
+		{spec: "partnerpayments_jsapi.json"},
+		{spec: "partnerpayments_app.json"},
+		{spec: "partnerpayments_native.json"},
+		{spec: "partnerpayments_h5.json"},
+		{spec: "cashcoupons.json"},
+		{spec: "merchantexclusivecoupon.json"},
+		{spec: "subdevconfig.json"},
+		{spec: "globalpayments.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			spec, err := LoadSpec(filepath.Join("specs", tt.spec))
+			require.NoError(t, err)
+
+			files, err := Generate(spec, Options{SkipDocs: true})
+			require.NoError(t, err)
+			require.NotEmpty(t, files)
+
+			for _, file := range files {
+				if contains(tt.skip, file.Path) {
+					continue
+				}
+				expected, err := ioutil.ReadFile(filepath.Join(repoRoot, filepath.FromSlash(file.Path)))
+				require.NoError(t, err)
+				assert.Equal(t, string(expected), string(file.Content), file.Path)
+			}
+		})
+	}
+}
+
+// TestGeneratePathTemplates 根据全部接口定义重新生成熔断器的默认路径模板，生成结果应与仓库中的代码完全一致
+func TestGeneratePathTemplates(t *testing.T) {
+	specPaths, err := filepath.Glob(filepath.Join("specs", "*.json"))
+	require.NoError(t, err)
+	var specs []*Spec
+	for _, specPath := range specPaths {
+		spec, err := LoadSpec(specPath)
+		require.NoError(t, err)
+		specs = append(specs, spec)
+	}
+
+	file, err := GeneratePathTemplates(specs)
+	require.NoError(t, err)
+	assert.Equal(t, PathTemplatesFile, file.Path)
+	expected, err := ioutil.ReadFile(filepath.Join(repoRoot, filepath.FromSlash(file.Path)))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(file.Content), "run go generate ./internal/generator to update %s", file.Path)
+
+	_, err = GeneratePathTemplates([]*Spec{{Info: Info{Package: "demo"}, Paths: map[string]PathItem{"v3/demo": {}}}})
+	assert.Error(t, err)
+}
+
+func TestGenerateDocs(t *testing.T) {
+	docs := func(specName string) map[string]string {
+		spec, err := LoadSpec(filepath.Join("specs", specName))
+		require.NoError(t, err)
+		files, err := Generate(spec, Options{})
+		require.NoError(t, err)
+
+		ret := map[string]string{}
+		for _, file := range files {
+			if strings.HasPrefix(file.Path, "docs/") {
+				ret[file.Path] = string(file.Content)
+			}
+		}
+		return ret
+	}
+
+	// 历史服务文档由多个版本的生成器生成，README 与接口文档的排版并不统一，这里只逐字比对类型文档
+	refundDocs := docs("refunddomestic.json")
+	for _, name := range []string{"Refund.md", "AmountReq.md", "Channel.md", "CreateRequest.md"} {
+		path := "docs/refunddomestic/" + name
+		require.Contains(t, refundDocs, path)
+		expected, err := ioutil.ReadFile(filepath.Join(repoRoot, filepath.FromSlash(path)))
+		require.NoError(t, err)
+		assert.Equal(t, normalizeDoc(string(expected)), normalizeDoc(refundDocs[path]), path)
+	}
+
+	api := refundDocs["docs/refunddomestic/RefundsApi.md"]
+	assert.Contains(t, api, "[**Create**](#create) | **Post** /v3/refund/domestic/refunds | 退款申请")
+	assert.Contains(t, api, "\t\t\tFundsAccount: refunddomestic.REQFUNDSACCOUNT_AVAILABLE.Ptr(),\n")
+	assert.Contains(t, refundDocs["docs/refunddomestic/README.md"], " - [QueryByOutRefundNoRequest](QueryByOutRefundNoRequest.md)\n")
+
+	nativeDocs := docs("payments_native.json")
+	assert.Contains(t, nativeDocs["docs/payments/native/NativeApi.md"], "\\*[**payments.Transaction**](../Transaction.md)")
+	assert.Contains(t, nativeDocs["docs/payments/native/README.md"], "想获取更多信息")
+
+	files, err := Generate(&Spec{Info: Info{Package: "demo"}}, Options{SkipDocs: true})
+	require.NoError(t, err)
+	for _, file := range files {
+		assert.False(t, strings.HasPrefix(file.Path, "docs/"), file.Path)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		err  string
+	}{
+		{
+			name: "missing package",
+			spec: `{"info": {"title": "t", "version": "1.0.0"}}`,
+			err:  "x-go-package",
+		},
+		{
+			name: "inline object",
+			spec: `{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+				"properties": {"b": {"type": "object", "properties": {"c": {"type": "string"}}}}}}}}`,
+			err: "inline object is not supported",
+		},
+		{
+			name: "inline enum",
+			spec: `{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+				"properties": {"b": {"type": "string", "enum": ["X"]}}}}}}`,
+			err: "inline enum is not supported",
+		},
+		{
+			name: "missing tag",
+			spec: `{"info": {"x-go-package": "demo"}, "paths": {"/v3/demo": {"get": {"operationId": "Demo"}}}}`,
+			err:  "must have a tag",
+		},
+		{
+			name: "request conflict",
+			spec: `{"info": {"x-go-package": "demo"},
+				"paths": {"/v3/demo/{id}": {"get": {"tags": ["Demo"], "operationId": "Get",
+					"parameters": [{"name": "id", "in": "path", "schema": {"type": "string"}}]}}},
+				"components": {"schemas": {"GetRequest": {"type": "object"}}}}`,
+			err: "conflicts with generated request",
+		},
+		{
+			name: "min items on non-array",
+			spec: `{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+				"properties": {"b": {"type": "string", "minItems": 1}}}}}}`,
+			err: "minItems is only supported for array",
+		},
+		{
+			name: "has method conflict",
+			spec: `{"info": {"x-go-package": "demo"},
+				"paths": {"/v3/demo": {"get": {"tags": ["Demo"], "operationId": "Get",
+					"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/A"}}}}}}}},
+				"components": {"schemas": {"A": {"type": "object",
+					"properties": {"refund": {"type": "string"}, "has_refund": {"type": "boolean"}}}}}}`,
+			err: "conflicts with field",
+		},
+		{
+			name: "body example not object",
+			spec: `{"info": {"x-go-package": "demo"},
+				"paths": {"/v3/demo": {"post": {"tags": ["Demo"], "operationId": "Add",
+					"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/A"}, "example": []}}}}}},
+				"components": {"schemas": {"A": {"type": "object", "properties": {"b": {"type": "string"}}}}}}`,
+			err: "request body example must be an object",
+		},
+		{
+			name: "body example unknown field",
+			spec: `{"info": {"x-go-package": "demo"},
+				"paths": {"/v3/demo": {"post": {"tags": ["Demo"], "operationId": "Add",
+					"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/A"}, "example": {"c": "x"}}}}}}},
+				"components": {"schemas": {"A": {"type": "object", "properties": {"b": {"type": "string"}}}}}}`,
+			err: "request body example field c is not defined in A",
+		},
+		{
+			name: "nullable required",
+			spec: `{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+				"required": ["b"], "properties": {"b": {"type": "string", "x-go-nullable": true}}}}}}`,
+			err: "x-go-nullable is only supported",
+		},
+		{
+			name: "nullable array",
+			spec: `{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+				"properties": {"b": {"type": "array", "items": {"type": "string"}, "x-go-nullable": true}}}}}}`,
+			err: "x-go-nullable is only supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := ParseSpec([]byte(tt.spec))
+			if err == nil {
+				_, err = Generate(spec, Options{})
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestGenerateNullable(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+		"properties": {"remark": {"type": "string", "x-go-nullable": true}, "count": {"type": "integer"}}}}}}`))
+	require.NoError(t, err)
+	files, err := Generate(spec, Options{SkipDocs: true})
+	require.NoError(t, err)
+
+	var models string
+	for _, file := range files {
+		if file.Path == "services/demo/models.go" {
+			models = string(file.Content)
+		}
+	}
+	assert.Contains(t, models, "\"github.com/wechatpay-apiv3/wechatpay-go/core\"\n")
+	assert.Contains(t, models, "\tif core.IsNull(o.Remark) {\n\t\ttoSerialize[\"remark\"] = nil\n\t} else if o.Remark != nil {\n")
+	assert.Contains(t, models, "\t} else if core.IsNull(o.Remark) {\n\t\tret += \"Remark:<null>, \"\n")
+	assert.Contains(t, models, "\tif core.IsNull(o.Remark) {\n\t\tret.Remark = o.Remark\n\t} else if o.Remark != nil {\n")
+	assert.NotContains(t, models, "core.IsNull(o.Count)")
+}
+
+func TestGenerateBodyExample(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{"info": {"x-go-package": "demo"},
+		"paths": {"/v3/demo/{id}": {"post": {"tags": ["Demo"], "operationId": "Add",
+			"parameters": [{"name": "id", "in": "path", "schema": {"type": "string", "example": "1900006491"}}],
+			"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/A"},
+				"example": {"b": "from_example"}}}}}}},
+		"components": {"schemas": {"A": {"type": "object", "properties": {
+			"b": {"type": "string", "example": "from_schema"}, "c": {"type": "string"}}}}}}`))
+	require.NoError(t, err)
+	files, err := Generate(spec, Options{SkipDocs: true})
+	require.NoError(t, err)
+
+	var example string
+	for _, file := range files {
+		if file.Path == "services/demo/api_demo_example_test.go" {
+			example = string(file.Content)
+		}
+	}
+	assert.Contains(t, example, "\t\t\tB:  core.String(\"from_example\"),\n\t\t\tId: core.String(\"1900006491\"),\n")
+	assert.NotContains(t, example, "C:")
+}
+
+func TestGenerateHasMethods(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{"info": {"x-go-package": "demo"},
+		"paths": {"/v3/demo": {"get": {"tags": ["Demo"], "operationId": "Get",
+			"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Resp"}}}}}}}},
+		"components": {"schemas": {
+			"Resp": {"type": "object", "required": ["id"], "properties": {"id": {"type": "string"},
+				"items": {"type": "array", "items": {"$ref": "#/components/schemas/Item"}}}},
+			"Item": {"type": "object", "properties": {"amount": {"type": "integer"}}},
+			"Other": {"type": "object", "properties": {"remark": {"type": "string"}}}}}}`))
+	require.NoError(t, err)
+	files, err := Generate(spec, Options{SkipDocs: true})
+	require.NoError(t, err)
+
+	var models string
+	for _, file := range files {
+		if file.Path == "services/demo/models.go" {
+			models = string(file.Content)
+		}
+	}
+	assert.Contains(t, models, "func (o *Resp) HasItems() bool {\n\treturn o != nil && o.Items != nil\n}\n")
+	assert.Contains(t, models, "func (o *Item) HasAmount() bool {\n\treturn o != nil && o.Amount != nil\n}\n")
+	assert.NotContains(t, models, "HasId()")
+	assert.NotContains(t, models, "HasRemark()")
+}
+
+func TestProperties_UnmarshalJSON(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+		"properties": {"z": {"type": "string"}, "a": {"type": "integer"}, "m": {"type": "boolean"}}}}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"z", "a", "m"}, spec.Components.Schemas["A"].Properties.Names)
+
+	_, err = ParseSpec([]byte(`{"info": {"x-go-package": "demo"}, "components": {"schemas": {"A": {"type": "object",
+		"properties": {"a": {"type": "string"}, "a": {"type": "integer"}}}}}}`))
+	assert.Error(t, err)
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, "OutTradeNo", camelize("out_trade_no"))
+	assert.Equal(t, "H5Info", camelize("h5_info"))
+	assert.Equal(t, "TransferBatch", camelize("transfer-batch"))
+	assert.Equal(t, "native", snakeCase("Native"))
+	assert.Equal(t, "transfer_batch", snakeCase("TransferBatch"))
+	assert.Equal(t, "CHANNEL_OTHER_BALANCE", enumConst("Channel", "OTHER_BALANCE"))
+	assert.Equal(t, "TYPE_A_B", enumConst("Type", "a-b"))
+	assert.Equal(t, "../", relativePath("payments/native", "payments"))
+	assert.Equal(t, "../../certificates/", relativePath("payments/native", "certificates"))
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeDoc 忽略行尾空白差异，历史文档由不同版本的生成器生成，行尾空白并不统一
+func normalizeDoc(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// PathTemplatesFile 生成的路径模板文件相对于仓库根目录的路径
+const PathTemplatesFile = "core/circuitbreaker/endpoint_paths_generated.go"
+
+// GeneratePathTemplates 汇总全部接口定义中的接口路径，生成 circuitbreaker 包中 generatedPathTemplates 的定义，
+// 供 DefaultPathTemplates 划分默认的熔断接口
+func GeneratePathTemplates(specs []*Spec) (File, error) {
+	seen := make(map[string]bool)
+	var paths []string
+	for _, spec := range specs {
+		for _, p := range sortedPaths(spec.Paths) {
+			if !strings.HasPrefix(p, "/") {
+				return File{}, fmt.Errorf("path %s of %s must start with /", p, spec.Info.Package)
+			}
+			if !seen[p] {
+				seen[p] = true
+				paths = append(paths, p)
+			}
+		}
+	}
+	sort.Strings(paths)
+
+	var b strings.Builder
+	b.WriteString("// Copyright 2021 Tencent Inc. All rights reserved.\n\n")
+	b.WriteString(generatedNotice)
+	b.WriteString("\npackage circuitbreaker\n\n")
+	b.WriteString("// generatedPathTemplates internal/generator/specs 中全部接口定义的路径模板\n")
+	b.WriteString("var generatedPathTemplates = []string{\n")
+	for _, p := range paths {
+		fmt.Fprintf(&b, "\t%q,\n", p)
+	}
+	b.WriteString("}\n")
+
+	content, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return File{}, fmt.Errorf("format %s err: %v", PathTemplatesFile, err)
+	}
+	return File{Path: PathTemplatesFile, Content: content}, nil
+}
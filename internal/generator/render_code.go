@@ -0,0 +1,487 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+const generatedNotice = "// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.\n"
+
+func writeHeader(b *strings.Builder, pkg *packageDef) {
+	b.WriteString("// Copyright 2021 Tencent Inc. All rights reserved.\n//\n")
+	fmt.Fprintf(b, "// %s\n//\n", pkg.Title)
+	if desc := singleLine(pkg.Description); desc != "" {
+		fmt.Fprintf(b, "// %s\n//\n", desc)
+	}
+	fmt.Fprintf(b, "// API version: %s\n\n", pkg.Version)
+	b.WriteString(generatedNotice)
+	b.WriteString("\n")
+}
+
+func writeImports(b *strings.Builder, groups ...[]string) {
+	b.WriteString("import (\n")
+	first := true
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if !first {
+			b.WriteString("\n")
+		}
+		first = false
+		for _, imp := range group {
+			fmt.Fprintf(b, "\t%s\n", imp)
+		}
+	}
+	b.WriteString(")\n\n")
+}
+
+func quoteImports(paths []string) []string {
+	ret := make([]string, 0, len(paths))
+	for _, p := range paths {
+		ret = append(ret, fmt.Sprintf("%q", p))
+	}
+	return ret
+}
+
+func writeComment(b *strings.Builder, indent, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			fmt.Fprintf(b, "%s//\n", indent)
+		} else {
+			fmt.Fprintf(b, "%s// %s\n", indent, line)
+		}
+	}
+}
+
+// renderAPI 生成 api_xxx.go
+func renderAPI(pkg *packageDef, svc *serviceDef) string {
+	var (
+		needFmt     bool
+		needStrings bool
+		types       []*typeDef
+	)
+	for _, op := range svc.Operations {
+		if len(op.PathParams) > 0 {
+			needFmt, needStrings = true, true
+		}
+		for _, f := range append(append([]*fieldDef{}, op.QueryParams...), op.HeaderParams...) {
+			needFmt = needFmt || f.Required
+		}
+		if op.Response != nil {
+			types = append(types, op.Response)
+		}
+	}
+
+	std := []string{`"context"`}
+	if needFmt {
+		std = append(std, `"fmt"`)
+	}
+	std = append(std, `nethttp "net/http"`, `neturl "net/url"`)
+	if needStrings {
+		std = append(std, `"strings"`)
+	}
+	local := []string{
+		fmt.Sprintf("%q", modulePath+"/core"),
+		fmt.Sprintf("%q", modulePath+"/core/consts"),
+		fmt.Sprintf("%q", modulePath+"/services"),
+	}
+	local = append(local, quoteImports(pkg.imports(types))...)
+
+	var b strings.Builder
+	writeHeader(&b, pkg)
+	fmt.Fprintf(&b, "package %s\n\n", pkg.Name)
+	writeImports(&b, std, local)
+	serviceType := svc.Name + "Service"
+	fmt.Fprintf(&b, "type %s services.Service\n", serviceType)
+
+	for _, op := range svc.Operations {
+		b.WriteString("\n")
+		writeOperation(&b, serviceType, op)
+	}
+	return b.String()
+}
+
+func writeOperation(b *strings.Builder, serviceType string, op *operationDef) {
+	fmt.Fprintf(b, "// %s %s\n", op.Name, op.Summary)
+	if op.Description != "" {
+		b.WriteString("//\n")
+		writeComment(b, "", op.Description)
+	}
+
+	var params, returns, errReturn, reqErrReturn, doneReturn string
+	if op.Request != nil {
+		params = fmt.Sprintf(", req %s", op.Request.Name)
+	}
+	if op.Response != nil {
+		returns = fmt.Sprintf("resp *%s, result *core.APIResult, err error", op.Response.GoType())
+		errReturn = "nil, nil, "
+		reqErrReturn = "nil, result, err"
+		doneReturn = "resp, result, nil"
+	} else {
+		returns = "result *core.APIResult, err error"
+		errReturn = "nil, "
+		reqErrReturn = "result, err"
+		doneReturn = "result, nil"
+	}
+
+	fmt.Fprintf(b, "func (a *%s) %s(ctx context.Context%s) (%s) {\n", serviceType, op.Name, params, returns)
+	b.WriteString("\tvar (\n")
+	fmt.Fprintf(b, "\t\tlocalVarHTTPMethod   = nethttp.Method%s\n", op.HTTPMethod)
+	b.WriteString("\t\tlocalVarPostBody     interface{}\n")
+	b.WriteString("\t\tlocalVarQueryParams  neturl.Values\n")
+	b.WriteString("\t\tlocalVarHeaderParams = nethttp.Header{}\n")
+	b.WriteString("\t)\n\n")
+
+	writeRequired := func(f *fieldDef) {
+		fmt.Fprintf(b, "\tif req.%s == nil {\n", f.Name)
+		fmt.Fprintf(b, "\t\treturn %sfmt.Errorf(\"field `%s` is required and must be specified in %s\")\n", errReturn, f.Name, op.Request.Name)
+		b.WriteString("\t}\n")
+	}
+
+	if len(op.PathParams) > 0 {
+		b.WriteString("\t// Make sure Path Params are properly set\n")
+		for _, f := range op.PathParams {
+			writeRequired(f)
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(b, "\tlocalVarPath := consts.WechatPayAPIServer + %q\n", op.Path)
+	if len(op.PathParams) > 0 {
+		b.WriteString("\t// Build Path with Path Params\n")
+		for _, f := range op.PathParams {
+			fmt.Fprintf(b, "\tlocalVarPath = strings.Replace(localVarPath, \"{\"+%q+\"}\", neturl.PathEscape(core.ParameterToString(*req.%s, \"\")), -1)\n", f.JSONName, f.Name)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\t// Make sure All Required Params are properly set\n")
+	for _, f := range op.QueryParams {
+		if f.Required {
+			writeRequired(f)
+		}
+	}
+	for _, f := range op.HeaderParams {
+		if f.Required {
+			writeRequired(f)
+		}
+	}
+	b.WriteString("\n")
+
+	writeParams := func(fields []*fieldDef, statement string) {
+		for _, f := range fields {
+			value := fmt.Sprintf("core.ParameterToString(*req.%s, \"\")", f.Name)
+			if f.Required {
+				fmt.Fprintf(b, "\t%s(%q, %s)\n", statement, f.JSONName, value)
+				continue
+			}
+			fmt.Fprintf(b, "\tif req.%s != nil {\n", f.Name)
+			fmt.Fprintf(b, "\t\t%s(%q, %s)\n", statement, f.JSONName, value)
+			b.WriteString("\t}\n")
+		}
+	}
+	if len(op.QueryParams) > 0 {
+		b.WriteString("\t// Setup Query Params\n")
+		b.WriteString("\tlocalVarQueryParams = neturl.Values{}\n")
+		writeParams(op.QueryParams, "localVarQueryParams.Add")
+		b.WriteString("\n")
+	}
+	if len(op.HeaderParams) > 0 {
+		b.WriteString("\t// Setup Header Params\n")
+		writeParams(op.HeaderParams, "localVarHeaderParams.Set")
+		b.WriteString("\n")
+	}
+
+	if op.Body != nil {
+		b.WriteString("\t// Setup Body Params\n")
+		if op.DirectBody {
+			b.WriteString("\tlocalVarPostBody = req\n")
+		} else {
+			fmt.Fprintf(b, "\tlocalVarPostBody = &%s{\n", op.Body.Name)
+			for _, f := range op.Body.Fields {
+				fmt.Fprintf(b, "\t\t%s: req.%s,\n", f.Name, f.Name)
+			}
+			b.WriteString("\t}\n")
+		}
+		b.WriteString("\n")
+	}
+
+	contentTypes := make([]string, 0, len(op.ContentTypes))
+	for _, contentType := range op.ContentTypes {
+		contentTypes = append(contentTypes, fmt.Sprintf("%q", contentType))
+	}
+	b.WriteString("\t// Determine the Content-Type Header\n")
+	fmt.Fprintf(b, "\tlocalVarHTTPContentTypes := []string{%s}\n", strings.Join(contentTypes, ", "))
+	b.WriteString("\t// Setup Content-Type\n")
+	b.WriteString("\tlocalVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)\n\n")
+
+	b.WriteString("\t// Perform Http Request\n")
+	b.WriteString("\tresult, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)\n")
+	b.WriteString("\tif err != nil {\n")
+	fmt.Fprintf(b, "\t\treturn %s\n", reqErrReturn)
+	b.WriteString("\t}\n\n")
+
+	if op.Response != nil {
+		respType := op.Response.GoType()
+		fmt.Fprintf(b, "\t// Extract %s from Http Response\n", respType)
+		fmt.Fprintf(b, "\tresp = new(%s)\n", respType)
+		b.WriteString("\terr = core.UnMarshalResponse(result.Response, resp)\n")
+		b.WriteString("\tif err != nil {\n")
+		fmt.Fprintf(b, "\t\treturn %s\n", reqErrReturn)
+		b.WriteString("\t}\n")
+	}
+	fmt.Fprintf(b, "\treturn %s\n", doneReturn)
+	b.WriteString("}\n")
+}
+
+// renderModels 生成 models.go
+func renderModels(pkg *packageDef) string {
+	var (
+		needTime bool
+		needCore bool
+		types    []*typeDef
+	)
+	for _, model := range pkg.Models {
+		for _, f := range model.Fields {
+			types = append(types, f.Type)
+			needCore = needCore || f.Nullable
+			for t := f.Type; t != nil; t = t.Elem {
+				needTime = needTime || t.Kind == kindTime
+			}
+		}
+	}
+	std := []string{`"encoding/json"`, `"fmt"`}
+	if needTime {
+		std = append(std, `"time"`)
+	}
+
+	var b strings.Builder
+	writeHeader(&b, pkg)
+	fmt.Fprintf(&b, "package %s\n\n", pkg.Name)
+	imports := pkg.imports(types)
+	if needCore {
+		// 外部类型均位于 services 目录下，core 总是排在最前
+		imports = append([]string{modulePath + "/core"}, imports...)
+	}
+	writeImports(&b, std, quoteImports(imports))
+
+	for i, model := range pkg.Models {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		if model.IsEnum {
+			writeEnum(&b, model)
+		} else {
+			writeModel(&b, model)
+		}
+	}
+	return b.String()
+}
+
+func writeTypeComment(b *strings.Builder, model *modelDef) {
+	if model.Description == "" {
+		fmt.Fprintf(b, "// %s\n", model.Name)
+	} else {
+		fmt.Fprintf(b, "// %s %s\n", model.Name, model.Description)
+	}
+}
+
+func writeEnum(b *strings.Builder, model *modelDef) {
+	name := model.Name
+	writeTypeComment(b, model)
+	fmt.Fprintf(b, "type %s string\n\n", name)
+	fmt.Fprintf(b, "func (e %s) Ptr() *%s {\n\treturn &e\n}\n\n", name, name)
+
+	fmt.Fprintf(b, "// Enums of %s\n", name)
+	b.WriteString("const (\n")
+	values := make([]string, 0, len(model.Enum))
+	for _, value := range model.Enum {
+		fmt.Fprintf(b, "\t%s %s = %q\n", enumConst(name, value), name, value)
+		values = append(values, fmt.Sprintf("%q", value))
+	}
+	b.WriteString(")\n\n")
+
+	fmt.Fprintf(b, "func (v *%s) UnmarshalJSON(src []byte) error {\n", name)
+	b.WriteString("\tvar value string\n")
+	b.WriteString("\terr := json.Unmarshal(src, &value)\n")
+	b.WriteString("\tif err != nil {\n\t\treturn err\n\t}\n")
+	fmt.Fprintf(b, "\tenumTypeValue := %s(value)\n", name)
+	fmt.Fprintf(b, "\tfor _, existing := range []%s{%s} {\n", name, strings.Join(values, ", "))
+	b.WriteString("\t\tif existing == enumTypeValue {\n")
+	b.WriteString("\t\t\t*v = enumTypeValue\n")
+	b.WriteString("\t\t\treturn nil\n")
+	b.WriteString("\t\t}\n")
+	b.WriteString("\t}\n\n")
+	fmt.Fprintf(b, "\treturn fmt.Errorf(\"%%+v is not a valid %s\", value)\n", name)
+	b.WriteString("}\n")
+}
+
+// enumConst 返回枚举值对应的常量名，如 Channel 的 ORIGINAL 为 CHANNEL_ORIGINAL
+func enumConst(typeName, value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return strings.ToUpper(typeName) + "_" + strings.ToUpper(b.String())
+}
+
+func fieldType(f *fieldDef) string {
+	if f.Type.IsPointer() {
+		return "*" + f.Type.GoType()
+	}
+	return f.Type.GoType()
+}
+
+// writeMinItems 校验数组字段的元素个数不少于 minItems
+func writeMinItems(b *strings.Builder, model string, f *fieldDef, indent string) {
+	if f.MinItems <= 0 {
+		return
+	}
+	fmt.Fprintf(b, "%sif len(o.%s) < %d {\n", indent, f.Name, f.MinItems)
+	fmt.Fprintf(b, "%s\treturn nil, fmt.Errorf(\"field `%s` must contain at least %d items in %s\")\n", indent, f.Name, f.MinItems, model)
+	fmt.Fprintf(b, "%s}\n", indent)
+}
+
+func writeModel(b *strings.Builder, model *modelDef) {
+	name := model.Name
+	writeTypeComment(b, model)
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, f := range model.Fields {
+		if f.Description != "" {
+			fmt.Fprintf(b, "\t// %s\n", f.Description)
+		}
+		tag := f.JSONName
+		if !f.Required {
+			tag += ",omitempty"
+		}
+		if f.Encryption != "" {
+			fmt.Fprintf(b, "\t%s %s `json:\"%s\" encryption:\"%s\"`\n", f.Name, fieldType(f), tag, f.Encryption)
+			continue
+		}
+		fmt.Fprintf(b, "\t%s %s `json:\"%s\"`\n", f.Name, fieldType(f), tag)
+	}
+	b.WriteString("}\n\n")
+
+	// MarshalJSON
+	fmt.Fprintf(b, "func (o %s) MarshalJSON() ([]byte, error) {\n", name)
+	b.WriteString("\ttoSerialize := map[string]interface{}{}\n")
+	for _, f := range model.Fields {
+		value := "o." + f.Name
+		if f.Type.Kind == kindTime {
+			value += ".Format(time.RFC3339)"
+		}
+		b.WriteString("\n")
+		if f.Required {
+			fmt.Fprintf(b, "\tif o.%s == nil {\n", f.Name)
+			fmt.Fprintf(b, "\t\treturn nil, fmt.Errorf(\"field `%s` is required and must be specified in %s\")\n", f.Name, name)
+			b.WriteString("\t}\n")
+			writeMinItems(b, name, f, "\t")
+			fmt.Fprintf(b, "\ttoSerialize[%q] = %s\n", f.JSONName, value)
+		} else {
+			if f.Nullable {
+				fmt.Fprintf(b, "\tif core.IsNull(o.%s) {\n", f.Name)
+				fmt.Fprintf(b, "\t\ttoSerialize[%q] = nil\n", f.JSONName)
+				fmt.Fprintf(b, "\t} else if o.%s != nil {\n", f.Name)
+			} else {
+				fmt.Fprintf(b, "\tif o.%s != nil {\n", f.Name)
+			}
+			writeMinItems(b, name, f, "\t\t")
+			fmt.Fprintf(b, "\t\ttoSerialize[%q] = %s\n", f.JSONName, value)
+			b.WriteString("\t}\n")
+		}
+	}
+	b.WriteString("\treturn json.Marshal(toSerialize)\n")
+	b.WriteString("}\n\n")
+
+	// String
+	fmt.Fprintf(b, "func (o %s) String() string {\n", name)
+	b.WriteString("\tvar ret string\n")
+	for i, f := range model.Fields {
+		sep := ", "
+		if i == len(model.Fields)-1 {
+			sep = ""
+		}
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		if f.Type.IsPointer() && !f.Type.IsStruct() {
+			fmt.Fprintf(b, "\tif o.%s == nil {\n", f.Name)
+			fmt.Fprintf(b, "\t\tret += \"%s:<nil>%s\"\n", f.Name, sep)
+			if f.Nullable {
+				fmt.Fprintf(b, "\t} else if core.IsNull(o.%s) {\n", f.Name)
+				fmt.Fprintf(b, "\t\tret += \"%s:<null>%s\"\n", f.Name, sep)
+			}
+			b.WriteString("\t} else {\n")
+			fmt.Fprintf(b, "\t\tret += fmt.Sprintf(\"%s:%%v%s\", *o.%s)\n", f.Name, sep, f.Name)
+			b.WriteString("\t}\n")
+		} else {
+			fmt.Fprintf(b, "\tret += fmt.Sprintf(\"%s:%%v%s\", o.%s)\n", f.Name, sep, f.Name)
+		}
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(b, "\treturn fmt.Sprintf(\"%s{%%s}\", ret)\n", name)
+	b.WriteString("}\n\n")
+
+	// Clone
+	fmt.Fprintf(b, "func (o %s) Clone() *%s {\n", name, name)
+	fmt.Fprintf(b, "\tret := %s{}\n", name)
+	for _, f := range model.Fields {
+		b.WriteString("\n")
+		if f.Nullable {
+			fmt.Fprintf(b, "\tif core.IsNull(o.%s) {\n", f.Name)
+			fmt.Fprintf(b, "\t\tret.%s = o.%s\n", f.Name, f.Name)
+			fmt.Fprintf(b, "\t} else if o.%s != nil {\n", f.Name)
+		} else {
+			fmt.Fprintf(b, "\tif o.%s != nil {\n", f.Name)
+		}
+		switch {
+		case f.Type.IsStruct():
+			fmt.Fprintf(b, "\t\tret.%s = o.%s.Clone()\n", f.Name, f.Name)
+		case f.Type.Kind == kindArray:
+			fmt.Fprintf(b, "\t\tret.%s = make(%s, len(o.%s))\n", f.Name, f.Type.GoType(), f.Name)
+			fmt.Fprintf(b, "\t\tfor i, item := range o.%s {\n", f.Name)
+			if f.Type.Elem.IsStruct() {
+				fmt.Fprintf(b, "\t\t\tret.%s[i] = *item.Clone()\n", f.Name)
+			} else {
+				fmt.Fprintf(b, "\t\t\tret.%s[i] = item\n", f.Name)
+			}
+			b.WriteString("\t\t}\n")
+		case f.Type.Kind == kindMap:
+			fmt.Fprintf(b, "\t\tret.%s = make(%s, len(o.%s))\n", f.Name, f.Type.GoType(), f.Name)
+			fmt.Fprintf(b, "\t\tfor k, v := range o.%s {\n", f.Name)
+			if f.Type.Elem.IsStruct() {
+				fmt.Fprintf(b, "\t\t\tret.%s[k] = *v.Clone()\n", f.Name)
+			} else {
+				fmt.Fprintf(b, "\t\t\tret.%s[k] = v\n", f.Name)
+			}
+			b.WriteString("\t\t}\n")
+		default:
+			fmt.Fprintf(b, "\t\tret.%s = new(%s)\n", f.Name, f.Type.GoType())
+			fmt.Fprintf(b, "\t\t*ret.%s = *o.%s\n", f.Name, f.Name)
+		}
+		b.WriteString("\t}\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("\treturn &ret\n")
+	b.WriteString("}\n")
+
+	if !model.InResponse {
+		return
+	}
+	// HasXxx：应答中未返回的字段为 nil，用于区分字段缺失与零值
+	for _, f := range model.Fields {
+		if f.Required {
+			continue
+		}
+		fmt.Fprintf(b, "\n// Has%s 应答中是否返回了 %s，o 为 nil 时返回 false\n", f.Name, f.JSONName)
+		fmt.Fprintf(b, "func (o *%s) Has%s() bool {\n", name, f.Name)
+		fmt.Fprintf(b, "\treturn o != nil && o.%s != nil\n", f.Name)
+		b.WriteString("}\n")
+	}
+}
@@ -0,0 +1,187 @@
+package generator
+
+import (
+	"fmt"
+	"go/format"
+	"path"
+	"strings"
+)
+
+const docsFooter = "[\\[返回类型列表\\]](README.md#类型列表)\n" +
+	"[\\[返回接口列表\\]](README.md#接口列表)\n" +
+	"[\\[返回服务README\\]](README.md)\n"
+
+var docsEscaper = strings.NewReplacer("`", "&#x60;", "<", "&lt;", ">", "&gt;", "\n", " ")
+
+// renderReadme 生成 docs/xxx/README.md
+func renderReadme(pkg *packageDef) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# 微信支付 API v3 Go SDK - %s\n\n", pkg.Path)
+	fmt.Fprintf(&b, "%s\n\n", strings.TrimSpace(pkg.Description))
+	b.WriteString("## 总览\n")
+	b.WriteString("本 SDK 由 WechatPay APIv3 SDK 生成器生成。生成器基于 [OpenAPI Generator](https://openapi-generator.tech) 构建。\n\n")
+	fmt.Fprintf(&b, "- API 版本: %s\n\n", pkg.Version)
+	if pkg.DocsURL != "" {
+		fmt.Fprintf(&b, "想获取更多信息，请访问 [%s](%s)\n\n", pkg.DocsURL, pkg.DocsURL)
+	}
+
+	b.WriteString("## 接口列表\n\n")
+	b.WriteString("所有URI均基于微信支付 API 地址：*https://api.mch.weixin.qq.com*\n\n")
+	b.WriteString("服务名 | 方法名 | HTTP 请求 | 描述\n")
+	b.WriteString("------------ | ------------- | ------------- | -------------\n")
+	for _, svc := range pkg.Services {
+		for _, op := range svc.Operations {
+			fmt.Fprintf(&b, "*%s* | [**%s**](%s.md#%s) | **%s** %s | %s\n",
+				svc.Name, op.Name, svc.Name, strings.ToLower(op.Name), op.HTTPMethod, op.Path, op.Summary)
+		}
+	}
+	b.WriteString("\n\n## 类型列表\n\n")
+	for _, model := range pkg.Models {
+		fmt.Fprintf(&b, " - [%s](%s.md)\n", model.Name, model.Name)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// renderServiceDoc 生成 docs/xxx/XxxApi.md
+func renderServiceDoc(pkg *packageDef, svc *serviceDef) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s/%s\n\n", pkg.Path, svc.Name)
+	b.WriteString("所有URI均基于微信支付 API 地址： *https://api.mch.weixin.qq.com*\n\n")
+	b.WriteString("方法名 | HTTP 请求 | 描述\n")
+	b.WriteString("------------- | ------------- | -------------\n")
+	for _, op := range svc.Operations {
+		fmt.Fprintf(&b, "[**%s**](#%s) | **%s** %s | %s\n", op.Name, strings.ToLower(op.Name), op.HTTPMethod, op.Path, op.Summary)
+	}
+	b.WriteString("\n\n")
+
+	anchor := strings.ToLower(pkg.Name + svc.Name)
+	for _, op := range svc.Operations {
+		respName, reqName := "void", ""
+		if op.Response != nil {
+			respName = op.Response.GoType()
+		}
+		if op.Request != nil {
+			reqName = op.Request.Name
+		}
+
+		fmt.Fprintf(&b, "\n## %s\n\n", op.Name)
+		fmt.Fprintf(&b, "> %s %s(%s)\n\n", respName, op.Name, reqName)
+		fmt.Fprintf(&b, "%s\n\n\n\n", op.Summary)
+
+		w := &exampleWriter{pkg: pkg}
+		var body strings.Builder
+		w.writeExampleBody(&body, "\t", svc, op)
+		std := []string{`"context"`}
+		if w.needTime {
+			std = append(std, `"time"`)
+		}
+		var snippet strings.Builder
+		snippet.WriteString("package main\n\n")
+		writeImports(&snippet, std, []string{fmt.Sprintf("%q", modulePath+"/core"), fmt.Sprintf("%q", pkg.ImportPath)})
+		snippet.WriteString("func main() {\n")
+		snippet.WriteString(body.String())
+		snippet.WriteString("}\n")
+		code := []byte(snippet.String())
+		if formatted, err := format.Source(code); err == nil {
+			code = formatted
+		}
+		b.WriteString("### 调用示例\n\n")
+		fmt.Fprintf(&b, "```go\n%s```\n\n", code)
+
+		b.WriteString("### 参数列表\n")
+		b.WriteString("参数名 | 参数类型 | 参数描述\n")
+		b.WriteString("------------- | ------------- | -------------\n")
+		b.WriteString("**ctx** | **context.Context** | Golang 上下文，可用于日志、请求取消、请求跟踪等功能|\n")
+		if op.Request != nil {
+			fmt.Fprintf(&b, "**req** | [**%s**](%s.md) | API `%s` 所定义的本接口需要的所有参数，包括`Path`/`Query`/`Body` 3类参数|\n",
+				op.Request.Name, op.Request.Name, pkg.Path)
+		}
+		b.WriteString("\n### 返回结果\n")
+		b.WriteString("Name | Type | Description\n")
+		b.WriteString("------------- | ------------- | -------------\n")
+		if op.Response != nil {
+			fmt.Fprintf(&b, "**resp** | \\*[**%s**](%s) | 结构化的接口返回结果\n", op.Response.GoType(), docLink(pkg, op.Response))
+		}
+		b.WriteString("**result** | **\\*core.APIResult** | 本次 API 访问的请求与应答信息\n")
+		b.WriteString("**err** | **error** | 本次 API 访问中发生的错误，当且仅当 API 失败时存在\n\n")
+		fmt.Fprintf(&b, "[\\[返回顶部\\]](#%s)\n", anchor)
+		b.WriteString("[\\[返回接口列表\\]](README.md#接口列表)\n")
+		b.WriteString("[\\[返回类型列表\\]](README.md#类型列表)\n")
+		b.WriteString("[\\[返回服务README\\]](README.md)\n\n")
+	}
+	return b.String()
+}
+
+// renderModelDoc 生成 docs/xxx/Model.md
+func renderModelDoc(pkg *packageDef, model *modelDef) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", model.Name)
+
+	if model.IsEnum {
+		fmt.Fprintf(&b, "%s \n\n", docsEscaper.Replace(model.Description))
+		b.WriteString("## 枚举\n\n")
+		for _, value := range model.Enum {
+			fmt.Fprintf(&b, "\n* `%s` (value: `%q`)\n", value, value)
+		}
+		fmt.Fprintf(&b, "\n\n%s\n\n", docsFooter)
+		return b.String()
+	}
+
+	b.WriteString("## 属性列表\n\n")
+	b.WriteString("名称 | 类型 | 描述 | 补充说明\n")
+	b.WriteString("------------ | ------------- | ------------- | -------------\n")
+	for _, f := range model.Fields {
+		desc := docsEscaper.Replace(f.Description)
+		if desc != "" {
+			desc += " "
+		}
+		optional := ""
+		if !f.Required {
+			optional = "[可选] "
+		}
+		fmt.Fprintf(&b, "**%s** | %s | %s | %s\n", f.Name, docType(pkg, f.Type), desc, optional)
+	}
+	fmt.Fprintf(&b, "\n%s\n\n", docsFooter)
+	return b.String()
+}
+
+func docType(pkg *packageDef, t *typeDef) string {
+	base := t
+	for base.Kind == kindArray || base.Kind == kindMap {
+		base = base.Elem
+	}
+	switch base.Kind {
+	case kindModel, kindEnum, kindExternal:
+		return fmt.Sprintf("[**%s**](%s)", t.GoType(), docLink(pkg, base))
+	}
+	return fmt.Sprintf("**%s**", t.GoType())
+}
+
+// docLink 返回类型文档相对于当前服务文档目录的链接
+func docLink(pkg *packageDef, t *typeDef) string {
+	if t.Kind != kindExternal {
+		return t.Name + ".md"
+	}
+	name := t.Name[strings.LastIndex(t.Name, ".")+1:]
+	target := strings.TrimPrefix(t.Import, modulePath+"/services/")
+	return relativePath(pkg.Path, target) + name + ".md"
+}
+
+// relativePath 返回从 from 目录到 to 目录的相对路径，以 / 结尾
+func relativePath(from, to string) string {
+	fromParts := strings.Split(path.Clean(from), "/")
+	toParts := strings.Split(path.Clean(to), "/")
+	i := 0
+	for i < len(fromParts) && i < len(toParts) && fromParts[i] == toParts[i] {
+		i++
+	}
+	var b strings.Builder
+	for j := i; j < len(fromParts); j++ {
+		b.WriteString("../")
+	}
+	for _, part := range toParts[i:] {
+		b.WriteString(part + "/")
+	}
+	return b.String()
+}
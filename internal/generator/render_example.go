@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxExampleDepth 示例代码中嵌套结构体的最大深度，避免循环引用导致无限展开
+const maxExampleDepth = 8
+
+var pointerHelpers = map[string]string{
+	"string":  "core.String",
+	"int64":   "core.Int64",
+	"float64": "core.Float64",
+	"float32": "core.Float32",
+	"bool":    "core.Bool",
+}
+
+type exampleWriter struct {
+	pkg      *packageDef
+	needTime bool
+}
+
+// renderExamples 生成 api_xxx_example_test.go
+func renderExamples(pkg *packageDef, svc *serviceDef) string {
+	w := &exampleWriter{pkg: pkg}
+	var body strings.Builder
+	for _, op := range svc.Operations {
+		body.WriteString("\n")
+		w.writeExampleFunc(&body, svc, op)
+	}
+
+	std := []string{`"context"`}
+	if w.needTime {
+		std = append(std, `"time"`)
+	}
+	local := []string{fmt.Sprintf("%q", modulePath+"/core"), fmt.Sprintf("%q", pkg.ImportPath)}
+
+	var b strings.Builder
+	writeHeader(&b, pkg)
+	fmt.Fprintf(&b, "package %s_test\n\n", pkg.Name)
+	writeImports(&b, std, local)
+	b.WriteString(strings.TrimPrefix(body.String(), "\n"))
+	return b.String()
+}
+
+func (w *exampleWriter) writeExampleFunc(b *strings.Builder, svc *serviceDef, op *operationDef) {
+	fmt.Fprintf(b, "func Example%sService_%s() {\n", svc.Name, op.Name)
+	w.writeExampleBody(b, "\t", svc, op)
+	b.WriteString("}\n")
+}
+
+// writeExampleBody 生成示例代码的函数体，同时用于示例测试与接口文档
+func (w *exampleWriter) writeExampleBody(b *strings.Builder, indent string, svc *serviceDef, op *operationDef) {
+	fmt.Fprintf(b, "%svar (\n", indent)
+	fmt.Fprintf(b, "%s\tctx    context.Context\n", indent)
+	fmt.Fprintf(b, "%s\tclient *core.Client\n", indent)
+	fmt.Fprintf(b, "%s)\n", indent)
+	fmt.Fprintf(b, "%s// 假设已获得初始化后的 core.Client\n\n", indent)
+
+	fmt.Fprintf(b, "%ssvc := %s.%sService{Client: client}\n", indent, w.pkg.Name, svc.Name)
+	results, discard := "result, err", "_, _ = result, err"
+	if op.Response != nil {
+		results, discard = "resp, result, err", "_, _, _ = resp, result, err"
+	}
+	if op.Request == nil {
+		fmt.Fprintf(b, "%s%s := svc.%s(ctx)\n", indent, results, op.Name)
+	} else {
+		fmt.Fprintf(b, "%s%s := svc.%s(ctx,\n", indent, results, op.Name)
+		fmt.Fprintf(b, "%s\t%s,\n", indent, w.modelLiteral(exampleRequest(op), indent+"\t", 0))
+		fmt.Fprintf(b, "%s)\n", indent)
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(b, "%s// TODO: 处理返回结果\n", indent)
+	fmt.Fprintf(b, "%s%s\n", indent, discard)
+}
+
+// exampleRequest 返回示例代码中使用的请求结构体。请求包体定义了示例时，只保留示例中出现的包体字段
+func exampleRequest(op *operationDef) *modelDef {
+	if op.BodyExample == nil {
+		return op.Request
+	}
+	isBody := make(map[*fieldDef]bool, len(op.Body.Fields))
+	for _, f := range op.Body.Fields {
+		isBody[f] = true
+	}
+	ret := &modelDef{Name: op.Request.Name}
+	for _, f := range op.Request.Fields {
+		if isBody[f] {
+			example, ok := op.BodyExample[f.JSONName]
+			if !ok {
+				continue
+			}
+			field := *f
+			field.Example = example
+			f = &field
+		}
+		ret.Fields = append(ret.Fields, f)
+	}
+	return ret
+}
+
+func (w *exampleWriter) fieldValue(f *fieldDef, indent string, depth int) string {
+	t := f.Type
+	switch t.Kind {
+	case kindPrimitive:
+		return fmt.Sprintf("%s(%s)", pointerHelpers[t.Name], primitiveLiteral(t.Name, f.Example, f.FallbackExample))
+	case kindTime:
+		w.needTime = true
+		return "core.Time(time.Now())"
+	case kindEnum:
+		return w.enumLiteral(t.Model, f.Example) + ".Ptr()"
+	case kindModel:
+		return "&" + w.modelLiteral(t.Model, indent, depth)
+	case kindArray:
+		return fmt.Sprintf("[]%s{%s}", w.qualified(t.Elem), w.elemLiteral(t.Elem, f, indent, depth))
+	case kindMap:
+		return fmt.Sprintf("map[string]%s{}", w.qualified(t.Elem))
+	}
+	return "nil"
+}
+
+func (w *exampleWriter) elemLiteral(t *typeDef, f *fieldDef, indent string, depth int) string {
+	example := firstElement(f.Example)
+	switch t.Kind {
+	case kindPrimitive:
+		return primitiveLiteral(t.Name, example, f.FallbackExample)
+	case kindTime:
+		w.needTime = true
+		return "time.Now()"
+	case kindEnum:
+		return w.enumLiteral(t.Model, example)
+	case kindModel:
+		return w.modelLiteral(t.Model, indent, depth)
+	}
+	return ""
+}
+
+// modelLiteral 生成结构体的示例值，字段按名称排序
+func (w *exampleWriter) modelLiteral(model *modelDef, indent string, depth int) string {
+	if depth >= maxExampleDepth {
+		return fmt.Sprintf("%s.%s{}", w.pkg.Name, model.Name)
+	}
+	fields := append([]*fieldDef{}, model.Fields...)
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s.%s{\n", w.pkg.Name, model.Name)
+	for _, f := range fields {
+		fmt.Fprintf(&b, "%s\t%s: %s,\n", indent, f.Name, w.fieldValue(f, indent+"\t", depth+1))
+	}
+	fmt.Fprintf(&b, "%s}", indent)
+	return b.String()
+}
+
+func (w *exampleWriter) enumLiteral(model *modelDef, example json.RawMessage) string {
+	value := model.Enum[0]
+	var s string
+	if json.Unmarshal(example, &s) == nil {
+		for _, v := range model.Enum {
+			if v == s {
+				value = v
+			}
+		}
+	}
+	return w.pkg.Name + "." + enumConst(model.Name, value)
+}
+
+func (w *exampleWriter) qualified(t *typeDef) string {
+	switch t.Kind {
+	case kindModel, kindEnum:
+		return w.pkg.Name + "." + t.Name
+	case kindArray:
+		return "[]" + w.qualified(t.Elem)
+	case kindMap:
+		return "map[string]" + w.qualified(t.Elem)
+	}
+	return t.GoType()
+}
+
+func primitiveLiteral(typeName string, example json.RawMessage, fallback string) string {
+	if typeName == "string" {
+		var s string
+		if json.Unmarshal(example, &s) != nil {
+			s = fallback
+		}
+		return strconv.Quote(s)
+	}
+	if len(example) > 0 {
+		var v interface{}
+		if json.Unmarshal(example, &v) == nil {
+			switch v.(type) {
+			case float64, bool:
+				return string(example)
+			}
+		}
+	}
+	if typeName == "bool" {
+		return "false"
+	}
+	return "0"
+}
+
+func firstElement(example json.RawMessage) json.RawMessage {
+	var items []json.RawMessage
+	if json.Unmarshal(example, &items) == nil {
+		if len(items) == 0 {
+			return nil
+		}
+		return items[0]
+	}
+	return example
+}
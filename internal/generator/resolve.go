@@ -0,0 +1,563 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	modulePath      = "github.com/wechatpay-apiv3/wechatpay-go"
+	schemaRefPrefix = "#/components/schemas/"
+)
+
+type typeKind int
+
+const (
+	kindPrimitive typeKind = iota
+	kindTime
+	kindModel
+	kindEnum
+	kindArray
+	kindMap
+	kindExternal
+)
+
+// typeDef 字段的 Go 类型
+type typeDef struct {
+	Kind typeKind
+	// Name 基础类型、本包类型或外部类型（如 payments.Transaction）的名称
+	Name   string
+	Elem   *typeDef
+	Import string
+	Model  *modelDef
+}
+
+// GoType 返回类型在本包内的 Go 表示
+func (t *typeDef) GoType() string {
+	switch t.Kind {
+	case kindTime:
+		return "time.Time"
+	case kindArray:
+		return "[]" + t.Elem.GoType()
+	case kindMap:
+		return "map[string]" + t.Elem.GoType()
+	default:
+		return t.Name
+	}
+}
+
+// IsPointer 字段是否使用指针类型
+func (t *typeDef) IsPointer() bool {
+	return t.Kind != kindArray && t.Kind != kindMap
+}
+
+// IsStruct 是否为结构体类型（拥有 Clone 方法）
+func (t *typeDef) IsStruct() bool {
+	return t.Kind == kindModel || t.Kind == kindExternal
+}
+
+type fieldDef struct {
+	Name        string
+	JSONName    string
+	Description string
+	Required    bool
+	Type        *typeDef
+	Example     json.RawMessage
+	// Encryption 敏感信息字段的加密方式，为空表示无需加密
+	Encryption string
+	// MinItems 数组字段的最少元素个数，为 0 表示不限制
+	MinItems int
+	// Nullable 字段为 core.IsNull 哨兵指针时序列化为 null
+	Nullable bool
+	// FallbackExample 未定义 Example 时示例代码中使用的字符串
+	FallbackExample string
+}
+
+type modelDef struct {
+	Name        string
+	Description string
+	Fields      []*fieldDef
+	IsEnum      bool
+	Enum        []string
+	// InResponse 结构体是否出现在接口应答中，为其可选字段生成 HasXxx 方法
+	InResponse bool
+}
+
+type operationDef struct {
+	Name         string
+	Summary      string
+	Description  string
+	HTTPMethod   string
+	Path         string
+	Request      *modelDef
+	PathParams   []*fieldDef
+	QueryParams  []*fieldDef
+	HeaderParams []*fieldDef
+	// DirectBody 为 true 时请求结构体即为请求包体
+	DirectBody bool
+	Body       *modelDef
+	// BodyExample 请求包体示例中的字段，不为 nil 时示例代码只填写其中的包体字段
+	BodyExample  map[string]json.RawMessage
+	ContentTypes []string
+	Response     *typeDef
+}
+
+type serviceDef struct {
+	Name       string
+	FileBase   string
+	Operations []*operationDef
+}
+
+type packageDef struct {
+	Name        string
+	Path        string
+	ImportPath  string
+	Title       string
+	Description string
+	Version     string
+	DocsURL     string
+	Services    []*serviceDef
+	Models      []*modelDef
+
+	spec   *Spec
+	models map[string]*modelDef
+}
+
+func resolve(spec *Spec) (*packageDef, error) {
+	pkg := &packageDef{
+		Name:        path.Base(spec.Info.Package),
+		Path:        spec.Info.Package,
+		ImportPath:  modulePath + "/services/" + spec.Info.Package,
+		Title:       spec.Info.Title,
+		Description: spec.Info.Description,
+		Version:     spec.Info.Version,
+		spec:        spec,
+		models:      map[string]*modelDef{},
+	}
+	if spec.ExternalDocs != nil {
+		pkg.DocsURL = spec.ExternalDocs.URL
+	}
+
+	names := make([]string, 0, len(spec.Components.Schemas))
+	for name := range spec.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := pkg.resolveModel(name); err != nil {
+			return nil, err
+		}
+	}
+
+	services := map[string]*serviceDef{}
+	for _, p := range sortedPaths(spec.Paths) {
+		item := spec.Paths[p]
+		for _, m := range []struct {
+			method string
+			op     *Operation
+		}{
+			{"Get", item.Get}, {"Post", item.Post}, {"Put", item.Put}, {"Patch", item.Patch}, {"Delete", item.Delete},
+		} {
+			if m.op == nil {
+				continue
+			}
+			op, err := pkg.resolveOperation(p, m.method, m.op)
+			if err != nil {
+				return nil, fmt.Errorf("resolve operation %s %s err: %v", m.method, p, err)
+			}
+			if len(m.op.Tags) == 0 {
+				return nil, fmt.Errorf("operation %s must have a tag", op.Name)
+			}
+			tag := camelize(m.op.Tags[0])
+			svc, ok := services[tag]
+			if !ok {
+				svc = &serviceDef{Name: tag + "Api", FileBase: snakeCase(tag)}
+				services[tag] = svc
+				pkg.Services = append(pkg.Services, svc)
+			}
+			svc.Operations = append(svc.Operations, op)
+		}
+	}
+
+	for _, svc := range pkg.Services {
+		for _, op := range svc.Operations {
+			if err := markInResponse(op.Response); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Slice(pkg.Services, func(i, j int) bool { return pkg.Services[i].Name < pkg.Services[j].Name })
+	for _, svc := range pkg.Services {
+		sort.Slice(svc.Operations, func(i, j int) bool { return svc.Operations[i].Name < svc.Operations[j].Name })
+	}
+	for _, model := range pkg.models {
+		pkg.Models = append(pkg.Models, model)
+	}
+	sort.Slice(pkg.Models, func(i, j int) bool { return pkg.Models[i].Name < pkg.Models[j].Name })
+	return pkg, nil
+}
+
+// markInResponse 标记应答类型及其直接或间接引用的结构体
+func markInResponse(t *typeDef) error {
+	for ; t != nil; t = t.Elem {
+		if t.Kind != kindModel || t.Model.InResponse {
+			continue
+		}
+		t.Model.InResponse = true
+		names := map[string]bool{}
+		for _, f := range t.Model.Fields {
+			names[f.Name] = true
+		}
+		for _, f := range t.Model.Fields {
+			if !f.Required && names["Has"+f.Name] {
+				return fmt.Errorf("schema %s: method Has%s conflicts with field", t.Model.Name, f.Name)
+			}
+			if err := markInResponse(f.Type); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sortedPaths(paths map[string]PathItem) []string {
+	ret := make([]string, 0, len(paths))
+	for p := range paths {
+		ret = append(ret, p)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// resolveModel 解析 components 中定义的结构体或枚举，基础类型的别名返回 nil
+func (p *packageDef) resolveModel(name string) (*modelDef, error) {
+	if model, ok := p.models[name]; ok {
+		return model, nil
+	}
+	schema, ok := p.spec.Components.Schemas[name]
+	if !ok {
+		return nil, fmt.Errorf("schema %s not found", name)
+	}
+
+	model := &modelDef{Name: name, Description: singleLine(schema.Description)}
+	switch {
+	case len(schema.Enum) > 0:
+		model.IsEnum = true
+		model.Enum = schema.Enum
+		p.models[name] = model
+	case schema.Type == "object" || (schema.Type == "" && len(schema.Properties.Names) > 0):
+		// 先登记再解析字段，以支持结构体之间的相互引用
+		p.models[name] = model
+		fields, err := p.resolveFields(schema)
+		if err != nil {
+			return nil, fmt.Errorf("resolve schema %s err: %v", name, err)
+		}
+		model.Fields = fields
+	default:
+		return nil, nil
+	}
+	return model, nil
+}
+
+func (p *packageDef) resolveFields(schema *Schema) ([]*fieldDef, error) {
+	required := map[string]bool{}
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	fields := make([]*fieldDef, 0, len(schema.Properties.Names))
+	for _, name := range schema.Properties.Names {
+		prop := schema.Properties.Schemas[name]
+		typ, err := p.resolveType(prop)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %v", name, err)
+		}
+		field := &fieldDef{
+			Name:        goName(name, prop.GoName),
+			JSONName:    name,
+			Description: singleLine(prop.Description),
+			Required:    required[name],
+			Type:        typ,
+			Example:     prop.Example,
+			Encryption:  prop.GoEncryption,
+			MinItems:    prop.MinItems,
+			Nullable:    prop.GoNullable,
+		}
+		if field.MinItems > 0 && typ.Kind != kindArray {
+			return nil, fmt.Errorf("property %s: minItems is only supported for array", name)
+		}
+		if field.Nullable && (field.Required || (typ.Kind != kindPrimitive && typ.Kind != kindTime)) {
+			return nil, fmt.Errorf("property %s: x-go-nullable is only supported for optional primitive or date-time", name)
+		}
+		field.FallbackExample = field.Name + "_example"
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func (p *packageDef) resolveType(schema *Schema) (*typeDef, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("schema is missing")
+	}
+	if schema.GoType != "" {
+		return &typeDef{Kind: kindExternal, Name: schema.GoType, Import: schema.GoImport}, nil
+	}
+	if schema.Ref != "" {
+		if !strings.HasPrefix(schema.Ref, schemaRefPrefix) {
+			return nil, fmt.Errorf("unsupported $ref %s", schema.Ref)
+		}
+		name := strings.TrimPrefix(schema.Ref, schemaRefPrefix)
+		model, err := p.resolveModel(name)
+		if err != nil {
+			return nil, err
+		}
+		if model == nil {
+			return p.resolveType(p.spec.Components.Schemas[name])
+		}
+		if model.IsEnum {
+			return &typeDef{Kind: kindEnum, Name: name, Model: model}, nil
+		}
+		return &typeDef{Kind: kindModel, Name: name, Model: model}, nil
+	}
+	if len(schema.Enum) > 0 {
+		return nil, fmt.Errorf("inline enum is not supported, define it in components and use $ref")
+	}
+
+	switch schema.Type {
+	case "string":
+		if schema.Format == "date-time" {
+			return &typeDef{Kind: kindTime, Name: "time.Time"}, nil
+		}
+		return &typeDef{Kind: kindPrimitive, Name: "string"}, nil
+	case "integer":
+		return &typeDef{Kind: kindPrimitive, Name: "int64"}, nil
+	case "number":
+		if schema.Format == "float" {
+			return &typeDef{Kind: kindPrimitive, Name: "float32"}, nil
+		}
+		return &typeDef{Kind: kindPrimitive, Name: "float64"}, nil
+	case "boolean":
+		return &typeDef{Kind: kindPrimitive, Name: "bool"}, nil
+	case "array":
+		elem, err := p.resolveType(schema.Items)
+		if err != nil {
+			return nil, fmt.Errorf("array items: %v", err)
+		}
+		return &typeDef{Kind: kindArray, Elem: elem}, nil
+	case "object":
+		if schema.AdditionalProperties == nil || len(schema.Properties.Names) > 0 {
+			return nil, fmt.Errorf("inline object is not supported, define it in components and use $ref")
+		}
+		elem, err := p.resolveType(schema.AdditionalProperties)
+		if err != nil {
+			return nil, fmt.Errorf("additionalProperties: %v", err)
+		}
+		return &typeDef{Kind: kindMap, Elem: elem}, nil
+	}
+	return nil, fmt.Errorf("unsupported schema type %q", schema.Type)
+}
+
+func (p *packageDef) resolveOperation(urlPath, method string, op *Operation) (*operationDef, error) {
+	if op.OperationID == "" {
+		return nil, fmt.Errorf("operationId is required")
+	}
+	ret := &operationDef{
+		Name:        camelize(op.OperationID),
+		Summary:     op.Summary,
+		Description: strings.TrimSpace(op.Description),
+		HTTPMethod:  method,
+		Path:        urlPath,
+	}
+
+	var bodyFields []*fieldDef
+	if op.RequestBody != nil {
+		contentTypes := make([]string, 0, len(op.RequestBody.Content))
+		for contentType := range op.RequestBody.Content {
+			contentTypes = append(contentTypes, contentType)
+		}
+		sort.Strings(contentTypes)
+		ret.ContentTypes = contentTypes
+
+		media := op.RequestBody.Content[contentTypes[0]]
+		schema := media.Schema
+		if schema == nil || !strings.HasPrefix(schema.Ref, schemaRefPrefix) {
+			return nil, fmt.Errorf("request body must be a $ref to components")
+		}
+		body, err := p.resolveModel(strings.TrimPrefix(schema.Ref, schemaRefPrefix))
+		if err != nil {
+			return nil, err
+		}
+		if body == nil || body.IsEnum {
+			return nil, fmt.Errorf("request body must be an object")
+		}
+		ret.Body = body
+		bodyFields = body.Fields
+
+		if len(media.Example) > 0 {
+			if err := json.Unmarshal(media.Example, &ret.BodyExample); err != nil || ret.BodyExample == nil {
+				return nil, fmt.Errorf("request body example must be an object")
+			}
+			for name := range ret.BodyExample {
+				if !hasField(body, name) {
+					return nil, fmt.Errorf("request body example field %s is not defined in %s", name, body.Name)
+				}
+			}
+		}
+	}
+
+	if len(op.Parameters) == 0 {
+		ret.Request = ret.Body
+		ret.DirectBody = ret.Body != nil
+	} else {
+		request := &modelDef{Name: ret.Name + "Request"}
+		if _, ok := p.spec.Components.Schemas[request.Name]; ok {
+			return nil, fmt.Errorf("schema %s conflicts with generated request of %s", request.Name, ret.Name)
+		}
+		for _, param := range op.Parameters {
+			typ, err := p.resolveType(param.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %v", param.Name, err)
+			}
+			if !typ.IsPointer() {
+				return nil, fmt.Errorf("parameter %s: only scalar parameters are supported", param.Name)
+			}
+			field := &fieldDef{
+				Name:        goName(param.Name, param.GoName),
+				JSONName:    param.Name,
+				Description: singleLine(param.Description),
+				Required:    param.Required || param.In == "path",
+				Type:        typ,
+				Example:     param.Schema.Example,
+			}
+			field.FallbackExample = lowerFirst(field.Name) + "_example"
+			switch param.In {
+			case "path":
+				ret.PathParams = append(ret.PathParams, field)
+			case "query":
+				ret.QueryParams = append(ret.QueryParams, field)
+			case "header":
+				ret.HeaderParams = append(ret.HeaderParams, field)
+			default:
+				return nil, fmt.Errorf("parameter %s: unsupported location %q", param.Name, param.In)
+			}
+			request.Fields = append(request.Fields, field)
+		}
+		request.Fields = append(request.Fields, bodyFields...)
+		p.models[request.Name] = request
+		ret.Request = request
+	}
+
+	// 仅使用第一个成功应答（2XX）的包体作为返回结果
+	if codes := sortedResponseCodes(op.Responses); len(codes) > 0 {
+		media, ok := op.Responses[codes[0]].Content["application/json"]
+		if ok && media.Schema != nil {
+			typ, err := p.resolveType(media.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("response %s: %v", codes[0], err)
+			}
+			if !typ.IsStruct() {
+				return nil, fmt.Errorf("response %s must be an object", codes[0])
+			}
+			ret.Response = typ
+		}
+	}
+	return ret, nil
+}
+
+func hasField(model *modelDef, jsonName string) bool {
+	for _, f := range model.Fields {
+		if f.JSONName == jsonName {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedResponseCodes(responses map[string]*Response) []string {
+	ret := make([]string, 0, len(responses))
+	for code := range responses {
+		if strings.HasPrefix(code, "2") {
+			ret = append(ret, code)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// imports 返回包内所有外部类型所需的导入路径
+func (p *packageDef) imports(types []*typeDef) []string {
+	set := map[string]bool{}
+	for _, t := range types {
+		for ; t != nil; t = t.Elem {
+			if t.Kind == kindExternal && t.Import != "" {
+				set[t.Import] = true
+			}
+		}
+	}
+	ret := make([]string, 0, len(set))
+	for imp := range set {
+		ret = append(ret, imp)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func goName(name, override string) string {
+	if override != "" {
+		return override
+	}
+	return camelize(name)
+}
+
+// camelize 将 out_trade_no 转换为 OutTradeNo
+func camelize(s string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		b.WriteString(upperFirst(part))
+	}
+	return b.String()
+}
+
+// snakeCase 将 OutTradeNo 转换为 out_trade_no
+func snakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && !unicode.IsUpper(runes[i-1]) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// singleLine 将多行描述合并为一行，用于字段注释
+func singleLine(s string) string {
+	return strings.TrimSpace(strings.Replace(s, "\n", " ", -1))
+}
@@ -0,0 +1,191 @@
+// Package generator 根据 OpenAPI 3 接口定义生成微信支付 API v3 服务 SDK
+//
+// 生成的代码与 services 目录下已有的服务保持一致的风格，包括：
+//   - api_xxx.go: 服务接口实现
+//   - models.go: 请求与应答结构体、枚举
+//   - api_xxx_example_test.go: 接口调用示例
+//   - docs 目录下的服务文档
+//
+// 为了保持生成结果的稳定，本包仅支持 OpenAPI 3 的一个子集，并通过以下扩展字段补充 Go 代码生成所需的信息：
+//   - info.x-go-package: 服务包相对于 services 目录的路径，如 payments/native
+//   - schema.x-go-type / schema.x-go-import: 引用其他包中已定义的类型，如 payments.Transaction
+//   - schema.x-go-name / parameter.x-go-name: 指定生成的 Go 字段名称
+//   - schema.x-go-encryption: 敏感信息字段的加密方式，如 EM_APIV3，生成 encryption 标签供 Client.EncryptRequest 使用
+//   - schema.x-go-nullable: 可选的基础类型或时间字段设置为 core.NullString() 等哨兵指针时序列化为 null，用于部分修改类接口清空字段
+//
+// 请求包体的 content.example 为 JSON 对象时，示例代码只填写该对象中出现的包体字段并使用其中的值，
+// 用于字段互斥、不能同时填写的接口。
+// 此外支持数组字段的 minItems，生成的 MarshalJSON 会校验数组元素个数。
+// 接口应答中直接或间接引用的结构体会为可选字段生成 HasXxx 方法，用于区分应答中未返回的字段与零值。
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Spec OpenAPI 3 接口定义（子集）
+type Spec struct {
+	OpenAPI      string              `json:"openapi"`
+	Info         Info                `json:"info"`
+	ExternalDocs *ExternalDocs       `json:"externalDocs,omitempty"`
+	Paths        map[string]PathItem `json:"paths"`
+	Components   Components          `json:"components"`
+}
+
+// Info 接口定义的基本信息
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+	// Package 服务包相对于 services 目录的路径
+	Package string `json:"x-go-package"`
+}
+
+// ExternalDocs 外部文档
+type ExternalDocs struct {
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+}
+
+// PathItem 单个 URL 下的所有接口
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+}
+
+// Operation 接口定义
+type Operation struct {
+	Tags        []string             `json:"tags"`
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Description string               `json:"description"`
+	Parameters  []*Parameter         `json:"parameters"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter 接口的 Path/Query/Header 参数
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+	GoName      string  `json:"x-go-name,omitempty"`
+}
+
+// RequestBody 接口的请求包体
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response 接口的应答
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+// MediaType 包体的数据格式
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+	// Example 请求包体的示例，示例代码仅填写其中出现的字段
+	Example json.RawMessage `json:"example,omitempty"`
+}
+
+// Components 可复用的数据结构定义
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema 数据结构定义
+type Schema struct {
+	Ref         string          `json:"$ref,omitempty"`
+	Type        string          `json:"type,omitempty"`
+	Format      string          `json:"format,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Required    []string        `json:"required,omitempty"`
+	Properties  Properties      `json:"properties,omitempty"`
+	Items       *Schema         `json:"items,omitempty"`
+	MinItems    int             `json:"minItems,omitempty"`
+	Enum        []string        `json:"enum,omitempty"`
+	Example     json.RawMessage `json:"example,omitempty"`
+	// AdditionalProperties 仅用于描述 map[string]T 类型
+	AdditionalProperties *Schema `json:"additionalProperties,omitempty"`
+	// GoType 引用其他包中的类型，如 payments.Transaction
+	GoType string `json:"x-go-type,omitempty"`
+	// GoImport GoType 所在包的导入路径
+	GoImport string `json:"x-go-import,omitempty"`
+	// GoName 生成的 Go 字段名称
+	GoName string `json:"x-go-name,omitempty"`
+	// GoEncryption 敏感信息字段的加密方式，如 EM_APIV3
+	GoEncryption string `json:"x-go-encryption,omitempty"`
+	// GoNullable 可选字段是否支持序列化为 null
+	GoNullable bool `json:"x-go-nullable,omitempty"`
+}
+
+// Properties 按定义顺序保存的结构体属性
+type Properties struct {
+	Names   []string
+	Schemas map[string]*Schema
+}
+
+// UnmarshalJSON 反序列化时保留属性的定义顺序
+func (p *Properties) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("properties must be an object")
+	}
+
+	p.Names = nil
+	p.Schemas = map[string]*Schema{}
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+		name := token.(string)
+		schema := new(Schema)
+		if err = decoder.Decode(schema); err != nil {
+			return fmt.Errorf("decode property %s err: %v", name, err)
+		}
+		if _, ok := p.Schemas[name]; ok {
+			return fmt.Errorf("duplicate property %s", name)
+		}
+		p.Names = append(p.Names, name)
+		p.Schemas[name] = schema
+	}
+	_, err = decoder.Token()
+	return err
+}
+
+// LoadSpec 从 JSON 文件中加载接口定义
+func LoadSpec(path string) (*Spec, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read spec file %s err: %v", path, err)
+	}
+	return ParseSpec(content)
+}
+
+// ParseSpec 解析 JSON 格式的接口定义
+func ParseSpec(content []byte) (*Spec, error) {
+	spec := new(Spec)
+	if err := json.Unmarshal(content, spec); err != nil {
+		return nil, fmt.Errorf("parse spec err: %v", err)
+	}
+	if spec.Info.Package == "" {
+		return nil, fmt.Errorf("info.x-go-package is required")
+	}
+	return spec, nil
+}
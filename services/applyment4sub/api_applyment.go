@@ -0,0 +1,191 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户进件API
+//
+// 服务商为特约商户提交进件申请，并查询申请单状态
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package applyment4sub
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ApplymentApiService services.Service
+
+// QueryApplymentByBusinessCode 通过业务申请编号查询申请状态
+//
+// # 应用场景
+// 提交申请单后，通过业务申请编号查询申请单状态。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|申请单不存在|业务申请编号不存在|请确认业务申请编号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ApplymentApiService) QueryApplymentByBusinessCode(ctx context.Context, req QueryApplymentByBusinessCodeRequest) (resp *ApplymentEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.BusinessCode == nil {
+		return nil, nil, fmt.Errorf("field `BusinessCode` is required and must be specified in QueryApplymentByBusinessCodeRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/applyment4sub/applyment/business_code/{business_code}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"business_code"+"}", neturl.PathEscape(core.ParameterToString(*req.BusinessCode, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ApplymentEntity from Http Response
+	resp = new(ApplymentEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryApplymentById 通过申请单号查询申请状态
+//
+// # 应用场景
+// 提交申请单后，通过微信支付申请单号查询申请单状态。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|申请单不存在|申请单号不存在|请确认申请单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ApplymentApiService) QueryApplymentById(ctx context.Context, req QueryApplymentByIdRequest) (resp *ApplymentEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.ApplymentId == nil {
+		return nil, nil, fmt.Errorf("field `ApplymentId` is required and must be specified in QueryApplymentByIdRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/applyment4sub/applyment/applyment_id/{applyment_id}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"applyment_id"+"}", neturl.PathEscape(core.ParameterToString(*req.ApplymentId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ApplymentEntity from Http Response
+	resp = new(ApplymentEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// SubmitApplyment 提交申请单
+//
+// # 应用场景
+// 服务商为特约商户提交进件申请。提交后可以使用业务申请编号或申请单号查询申请状态。
+//
+// 注意：
+// 1、申请单中的姓名、证件号码、手机号码、邮箱与银行账号等敏感信息需使用微信支付平台证书加密，并通过Wechatpay-Serial请求头传递加密所用的平台证书序列号
+// 2、图片需先通过图片上传接口获取 media_id
+// 3、申请单被驳回后，可以修改后使用同一业务申请编号重新提交
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|申请单状态不允许提交|申请单已提交或已完成|请使用查询接口确认申请单状态|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ApplymentApiService) SubmitApplyment(ctx context.Context, req SubmitApplymentRequest) (resp *SubmitApplymentResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/applyment4sub/applyment/"
+	// Make sure All Required Params are properly set
+
+	// Setup Header Params
+	if req.WechatpaySerial != nil {
+		localVarHeaderParams.Set("Wechatpay-Serial", core.ParameterToString(*req.WechatpaySerial, ""))
+	}
+
+	// Setup Body Params
+	localVarPostBody = &SubmitApplymentBody{
+		BusinessCode:    req.BusinessCode,
+		ContactInfo:     req.ContactInfo,
+		SubjectInfo:     req.SubjectInfo,
+		BusinessInfo:    req.BusinessInfo,
+		SettlementInfo:  req.SettlementInfo,
+		BankAccountInfo: req.BankAccountInfo,
+		AdditionInfo:    req.AdditionInfo,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract SubmitApplymentResponse from Http Response
+	resp = new(SubmitApplymentResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
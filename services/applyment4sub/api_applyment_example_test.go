@@ -0,0 +1,139 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户进件API
+//
+// 服务商为特约商户提交进件申请，并查询申请单状态
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package applyment4sub_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+)
+
+func ExampleApplymentApiService_QueryApplymentByBusinessCode() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := applyment4sub.ApplymentApiService{Client: client}
+	resp, result, err := svc.QueryApplymentByBusinessCode(ctx,
+		applyment4sub.QueryApplymentByBusinessCodeRequest{
+			BusinessCode: core.String("1900013511_10000"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleApplymentApiService_QueryApplymentById() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := applyment4sub.ApplymentApiService{Client: client}
+	resp, result, err := svc.QueryApplymentById(ctx,
+		applyment4sub.QueryApplymentByIdRequest{
+			ApplymentId: core.Int64(0),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleApplymentApiService_SubmitApplyment() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := applyment4sub.ApplymentApiService{Client: client}
+	resp, result, err := svc.SubmitApplyment(ctx,
+		applyment4sub.SubmitApplymentRequest{
+			AdditionInfo: &applyment4sub.AdditionInfo{
+				BusinessAdditionMsg:   core.String("特殊情况，说明原因"),
+				BusinessAdditionPics:  []string{"BusinessAdditionPics_example"},
+				LegalPersonCommitment: core.String("jTpGmxUX3FBWVQ5NJTZvlKX_gdU4cRz7z5NxpnFuAxhBTEO_PvWkfSCJ3zVIn001D8daLC-ehEuo0BJqRTvDujqhThn4ReFxikqJ5YW6zFQ"),
+			},
+			BankAccountInfo: &applyment4sub.BankAccountInfo{
+				AccountBank:     core.String("工商银行"),
+				AccountName:     core.String("AOZdYGISxo4y44/UgZ69bdu9X+tfMUJ9dl+LetjM45/zMbrYu+wWZ8gn4CTdo+D/m9MrPg+V4sm73oxqdQu/hj7aWyDl4GQtPXVdaztB9jVbVZh3QFzV+BEmytMNQp9dt1uWJktlfdDdLR3AMWyMB377xd+m9bSr/ioDTzagEcGe+vLYiKrzcroQv3OR0p3ppFYoQ3IfYeU/04S4t9rNFL+kyblK2FCCqQ11NdbbHoCrJc7NV4oASq6ZFonjTtgjjgKsadIKHXtb3JZKGZjduGdtkRJJp0/0eow96uY1Pk7Rq79Jtt7+I8juwEc4P4TG5xzchG/5IL9DBd+Z0zZXkw=="),
+				AccountNumber:   core.String("d+xT+MQCvrLHUVDWv/8MR/dB7TkXLVfSJUxOvr0uFLhJ8IhiVb5wY5+nmoqR+ryAUXufrjCEB9csf9ns3eS7sIszUOkqefViT0X+8R+5JJK6YCAB0hBXDQG8RBOtvYnhe2QrjXxFdm3AwmJFSPzaTSwsO1VHTNzUlul0MujgKo5SpdfyPSA/weDXNNP3fbXYNjTMTKC41W6OdYbjlMuUUGQ9fDrnLhLqjHdelOTfKjDyDtMd36U0OTRYRhb7zFp8BH9h38qNjRDTf5W0vL/cpYLtjB9z7X4fXuQ2J77VwEbH5N6R/ME+bGQ8zNrFzNllEJqDLjyCnKSGjGjUx0e0rIeA=="),
+				BankAccountType: applyment4sub.BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_CORPORATE.Ptr(),
+				BankAddressCode: core.String("110000"),
+				BankName:        core.String("施秉县农村信用合作联社城关信用社"),
+			},
+			BusinessCode: core.String("1900013511_10000"),
+			BusinessInfo: &applyment4sub.BusinessInfo{
+				MerchantShortname: core.String("张三餐饮店"),
+				SalesInfo: &applyment4sub.SalesInfo{
+					BizStoreInfo: &applyment4sub.BizStoreInfo{
+						BizAddressCode:   core.String("440305"),
+						BizStoreAddress:  core.String("南山区xx大厦x层xxxx室"),
+						BizStoreName:     core.String("大郎烧饼"),
+						IndoorPic:        []string{"IndoorPic_example"},
+						StoreEntrancePic: []string{"StoreEntrancePic_example"},
+					},
+					MpInfo: &applyment4sub.MpInfo{
+						MpAppid:    core.String("wx1234567890123456"),
+						MpPics:     []string{"MpPics_example"},
+						MpSubAppid: core.String("wx1234567890123456"),
+					},
+					SalesScenesType: []string{"SalesScenesType_example"},
+				},
+				ServicePhone: core.String("0758XXXXX"),
+			},
+			ContactInfo: &applyment4sub.ContactInfo{
+				ContactEmail:    core.String("pVd1HJ6zyvPedzGaV+X3qtmrq9bb9tPROvwia4ibL+F6mfjbzQIzfb3HHLEjZ4YiR/cJiCrZxnAqi+pjeKIEdkwzXRAI7FUhrfPK3SNjaBTEu9GmsugMIA9r3x887Q+ODuC8HH2nzAn7NGpE/e3yiHgWhk0ps5k5DP/2qIdGdONoDzZelrxCl/NWWNUyB93K9F+jC1JX2IMttdY+aQ6zBlw0xnOiNW6Hzy7UtC+xriudjD5APomty7/mYNxLMpRSvWKIjOv/69bDnuC4EL5Kz4jBHLiCyOb+tI0m2qhZ9evAM+Jv1z0NVa8MRtelw/wDa4SzfeespQO/0kjiwfqdfg=="),
+				ContactIdNumber: core.String("pVd1HJ6zyvPedzGaV+X3qtmrq9bb9tPROvwia4ibL+F6mfjbzQIzfb3HHLEjZ4YiR/cJiCrZxnAqi+pjeKIEdkwzXRAI7FUhrfPK3SNjaBTEu9GmsugMIA9r3x887Q+ODuC8HH2nzAn7NGpE/e3yiHgWhk0ps5k5DP/2qIdGdONoDzZelrxCl/NWWNUyB93K9F+jC1JX2IMttdY+aQ6zBlw0xnOiNW6Hzy7UtC+xriudjD5APomty7/mYNxLMpRSvWKIjOv/69bDnuC4EL5Kz4jBHLiCyOb+tI0m2qhZ9evAM+Jv1z0NVa8MRtelw/wDa4SzfeespQO/0kjiwfqdfg=="),
+				ContactName:     core.String("pVd1HJ6zyvPedzGaV+X3qtmrq9bb9tPROvwia4ibL+F6mfjbzQIzfb3HHLEjZ4YiR/cJiCrZxnAqi+pjeKIEdkwzXRAI7FUhrfPK3SNjaBTEu9GmsugMIA9r3x887Q+ODuC8HH2nzAn7NGpE/e3yiHgWhk0ps5k5DP/2qIdGdONoDzZelrxCl/NWWNUyB93K9F+jC1JX2IMttdY+aQ6zBlw0xnOiNW6Hzy7UtC+xriudjD5APomty7/mYNxLMpRSvWKIjOv/69bDnuC4EL5Kz4jBHLiCyOb+tI0m2qhZ9evAM+Jv1z0NVa8MRtelw/wDa4SzfeespQO/0kjiwfqdfg=="),
+				MobilePhone:     core.String("pVd1HJ6zyvPedzGaV+X3qtmrq9bb9tPROvwia4ibL+F6mfjbzQIzfb3HHLEjZ4YiR/cJiCrZxnAqi+pjeKIEdkwzXRAI7FUhrfPK3SNjaBTEu9GmsugMIA9r3x887Q+ODuC8HH2nzAn7NGpE/e3yiHgWhk0ps5k5DP/2qIdGdONoDzZelrxCl/NWWNUyB93K9F+jC1JX2IMttdY+aQ6zBlw0xnOiNW6Hzy7UtC+xriudjD5APomty7/mYNxLMpRSvWKIjOv/69bDnuC4EL5Kz4jBHLiCyOb+tI0m2qhZ9evAM+Jv1z0NVa8MRtelw/wDa4SzfeespQO/0kjiwfqdfg=="),
+			},
+			SettlementInfo: &applyment4sub.SettlementInfo{
+				ActivitiesId:      core.String("20191030111cff5b5e"),
+				ActivitiesRate:    core.String("0.6"),
+				QualificationType: core.String("餐饮"),
+				Qualifications:    []string{"Qualifications_example"},
+				SettlementId:      core.String("719"),
+			},
+			SubjectInfo: &applyment4sub.SubjectInfo{
+				BusinessLicenseInfo: &applyment4sub.BusinessLicenseInfo{
+					LegalPerson:   core.String("张三"),
+					LicenseCopy:   core.String("jTpGmxUX3FBWVQ5NJTZvlKX_gdU4cRz7z5NxpnFuAxhBTEO_PvWkfSCJ3zVIn001D8daLC-ehEuo0BJqRTvDujqhThn4ReFxikqJ5YW6zFQ"),
+					LicenseNumber: core.String("914201123033363296"),
+					MerchantName:  core.String("腾讯科技有限公司"),
+				},
+				IdentityInfo: &applyment4sub.IdentityInfo{
+					IdCardInfo: &applyment4sub.IdCardInfo{
+						CardPeriodBegin: core.String("2019-06-06"),
+						CardPeriodEnd:   core.String("2026-06-06"),
+						IdCardCopy:      core.String("jTpGmxUX3FBWVQ5NJTZvlKX_gdU4cRz7z5NxpnFuAxhBTEO_PvWkfSCJ3zVIn001D8daLC-ehEuo0BJqRTvDujqhThn4ReFxikqJ5YW6zFQ"),
+						IdCardName:      core.String("pVd1HJ6zyvPedzGaV+X3qtmrq9bb9tPROvwia4ibL+F6mfjbzQIzfb3HHLEjZ4YiR/cJiCrZxnAqi+pjeKIEdkwzXRAI7FUhrfPK3SNjaBTEu9GmsugMIA9r3x887Q+ODuC8HH2nzAn7NGpE/e3yiHgWhk0ps5k5DP/2qIdGdONoDzZelrxCl/NWWNUyB93K9F+jC1JX2IMttdY+aQ6zBlw0xnOiNW6Hzy7UtC+xriudjD5APomty7/mYNxLMpRSvWKIjOv/69bDnuC4EL5Kz4jBHLiCyOb+tI0m2qhZ9evAM+Jv1z0NVa8MRtelw/wDa4SzfeespQO/0kjiwfqdfg=="),
+						IdCardNational:  core.String("jTpGmxUX3FBWVQ5NJTZvlKX_gdU4cRz7z5NxpnFuAxhBTEO_PvWkfSCJ3zVIn001D8daLC-ehEuo0BJqRTvDujqhThn4ReFxikqJ5YW6zFQ"),
+						IdCardNumber:    core.String("pVd1HJ6zyvPedzGaV+X3qtmrq9bb9tPROvwia4ibL+F6mfjbzQIzfb3HHLEjZ4YiR/cJiCrZxnAqi+pjeKIEdkwzXRAI7FUhrfPK3SNjaBTEu9GmsugMIA9r3x887Q+ODuC8HH2nzAn7NGpE/e3yiHgWhk0ps5k5DP/2qIdGdONoDzZelrxCl/NWWNUyB93K9F+jC1JX2IMttdY+aQ6zBlw0xnOiNW6Hzy7UtC+xriudjD5APomty7/mYNxLMpRSvWKIjOv/69bDnuC4EL5Kz4jBHLiCyOb+tI0m2qhZ9evAM+Jv1z0NVa8MRtelw/wDa4SzfeespQO/0kjiwfqdfg=="),
+					},
+					IdDocType: applyment4sub.IDDOCTYPE_IDENTIFICATION_TYPE_IDCARD.Ptr(),
+					Owner:     core.Bool(true),
+				},
+				SubjectType: applyment4sub.SUBJECTTYPE_SUBJECT_TYPE_INDIVIDUAL.Ptr(),
+			},
+			WechatpaySerial: core.String("5157F09EFDC096DE15EBE81A47057A7232F1B8E1"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,272 @@
+package applyment4sub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+const (
+	defaultPollInitialInterval = 30 * time.Second
+	defaultPollMaxInterval     = 10 * time.Minute
+	defaultPollMultiplier      = 1.5
+	defaultMaxResubmits        = 3
+)
+
+// IsAuditPassed 判断申请单是否已通过审核。通过审核后，申请单将依次进入待账户验证、待签约、开通权限中与已完成状态
+func IsAuditPassed(state ApplymentState) bool {
+	switch state {
+	case APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_CONFIRMED, APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_SIGNED,
+		APPLYMENTSTATE_APPLYMENT_STATE_SIGNING, APPLYMENTSTATE_APPLYMENT_STATE_FINISHED:
+		return true
+	}
+	return false
+}
+
+// RejectedError 申请单被驳回，或因提交时发生错误处于编辑中状态，需要修改后使用同一业务申请编号重新提交
+type RejectedError struct {
+	// Applyment 最后一次查询到的申请单
+	Applyment *ApplymentEntity
+}
+
+// State 返回申请单状态
+func (e *RejectedError) State() ApplymentState {
+	if e.Applyment == nil || e.Applyment.ApplymentState == nil {
+		return ""
+	}
+	return *e.Applyment.ApplymentState
+}
+
+// Reasons 返回被驳回的资料项字段名（如 id_card_copy）到驳回原因的映射，同一字段有多个原因时以分号分隔
+func (e *RejectedError) Reasons() map[string]string {
+	reasons := make(map[string]string)
+	if e.Applyment == nil {
+		return reasons
+	}
+	for _, detail := range e.Applyment.AuditDetail {
+		field, reason := core.StringValue(detail.Field), core.StringValue(detail.RejectReason)
+		if previous, ok := reasons[field]; ok {
+			reason = previous + "; " + reason
+		}
+		reasons[field] = reason
+	}
+	return reasons
+}
+
+func (e *RejectedError) Error() string {
+	var businessCode, message string
+	var details []string
+	if e.Applyment != nil {
+		businessCode = core.StringValue(e.Applyment.BusinessCode)
+		message = core.StringValue(e.Applyment.ApplymentStateMsg)
+		for _, detail := range e.Applyment.AuditDetail {
+			details = append(details, fmt.Sprintf("%s: %s",
+				core.StringValue(detail.Field), core.StringValue(detail.RejectReason)))
+		}
+	}
+	if len(details) == 0 {
+		return fmt.Sprintf("applyment %s is %s: %s", businessCode, e.State(), message)
+	}
+	return fmt.Sprintf("applyment %s is %s: %s (%s)", businessCode, e.State(), message, strings.Join(details, ", "))
+}
+
+// FixFunc 根据驳回原因修改申请单，返回错误时不再重新提交。req 中的敏感信息为明文
+type FixFunc func(ctx context.Context, req *SubmitApplymentRequest, rejected *RejectedError) error
+
+// Applier 特约商户进件编排器
+//
+// 提交申请单时自动使用 Client 的 cipher 加密敏感信息并设置 Wechatpay-Serial 请求头；
+// 提交后按退避间隔轮询申请单状态直至审核结果确定，被驳回时可以根据驳回原因修改申请单并使用同一业务申请编号重新提交。
+type Applier struct {
+	client          *core.Client
+	applyment       ApplymentApiService
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	maxResubmits    int
+}
+
+// ApplierOption Applier 的配置项
+type ApplierOption func(a *Applier)
+
+// WithPollInterval 设置首次查询申请单状态前的等待时间与两次查询间的最长等待时间，默认为 30s 与 10min
+func WithPollInterval(initial, max time.Duration) ApplierOption {
+	return func(a *Applier) {
+		if initial > 0 {
+			a.initialInterval = initial
+		}
+		if max >= a.initialInterval {
+			a.maxInterval = max
+		}
+	}
+}
+
+// WithMaxResubmits 设置 Apply 被驳回后最多重新提交的次数，默认为 3
+func WithMaxResubmits(n int) ApplierOption {
+	return func(a *Applier) {
+		if n >= 0 {
+			a.maxResubmits = n
+		}
+	}
+}
+
+// NewApplier 创建 Applier
+func NewApplier(client *core.Client, opts ...ApplierOption) *Applier {
+	a := &Applier{
+		client:          client,
+		applyment:       ApplymentApiService{Client: client},
+		initialInterval: defaultPollInitialInterval,
+		maxInterval:     defaultPollMaxInterval,
+		maxResubmits:    defaultMaxResubmits,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Submit 提交申请单。req 未设置 WechatpaySerial 时，其中的敏感信息被视为明文并加密，req 本身不会被修改
+func (a *Applier) Submit(
+	ctx context.Context, req SubmitApplymentRequest,
+) (resp *SubmitApplymentResponse, result *core.APIResult, err error) {
+	req = *req.Clone()
+	if req.WechatpaySerial == nil {
+		serial, err := a.client.EncryptRequest(ctx, &req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encrypt applyment err: %v", err)
+		}
+		if serial == "" {
+			return nil, nil, fmt.Errorf("encrypt applyment err: cipher is not configured in client")
+		}
+		req.WechatpaySerial = core.String(serial)
+	}
+	return a.applyment.SubmitApplyment(ctx, req)
+}
+
+// WaitForAudit 按退避间隔轮询申请单，直至审核结果确定或 ctx 结束
+//
+// 通过审核（见 IsAuditPassed）时返回申请单；被驳回或处于编辑中状态时返回申请单与 *RejectedError；已作废时返回申请单与错误。
+// ctx 结束时返回 ctx 的错误，以及最后一次查询到的申请单（可能为 nil）。查询请求失败时会继续轮询，但请求参数、签名等不可重试的错误将直接返回。
+func (a *Applier) WaitForAudit(ctx context.Context, businessCode string) (*ApplymentEntity, error) {
+	return a.waitForAudit(ctx, businessCode, nil)
+}
+
+// waitForAudit 轮询申请单直至审核结果确定。previous 为重新提交前的驳回结果，查询到与之相同的驳回结果时继续轮询，
+// 避免将重新提交前的驳回结果视为新的审核结果；查询到驳回以外的状态后，此后的驳回结果均视为新的审核结果
+func (a *Applier) waitForAudit(
+	ctx context.Context, businessCode string, previous *ApplymentEntity,
+) (*ApplymentEntity, error) {
+	interval := a.initialInterval
+	var (
+		last    *ApplymentEntity
+		lastErr error
+	)
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if lastErr != nil {
+				return last, fmt.Errorf("%w, last query err: %v", ctx.Err(), lastErr)
+			}
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+
+		applyment, _, err := a.applyment.QueryApplymentByBusinessCode(ctx,
+			QueryApplymentByBusinessCodeRequest{BusinessCode: core.String(businessCode)})
+		if err != nil {
+			if !isRetryableQueryError(err) {
+				return last, err
+			}
+			lastErr = err
+		} else {
+			last, lastErr = applyment, nil
+			var state ApplymentState
+			if applyment.ApplymentState != nil {
+				state = *applyment.ApplymentState
+			}
+			switch {
+			case IsAuditPassed(state):
+				return applyment, nil
+			case state == APPLYMENTSTATE_APPLYMENT_STATE_REJECTED, state == APPLYMENTSTATE_APPLYMENT_STATE_EDITTING:
+				if previous == nil || !isSameRejection(previous, applyment) {
+					return applyment, &RejectedError{Applyment: applyment}
+				}
+			case state == APPLYMENTSTATE_APPLYMENT_STATE_CANCELED:
+				return applyment, fmt.Errorf("applyment %s is canceled: %s",
+					businessCode, core.StringValue(applyment.ApplymentStateMsg))
+			default:
+				previous = nil
+			}
+		}
+
+		interval = time.Duration(float64(interval) * defaultPollMultiplier)
+		if interval > a.maxInterval {
+			interval = a.maxInterval
+		}
+	}
+}
+
+// Apply 提交申请单并等待审核结果，被驳回时调用 fix 修改申请单后使用同一业务申请编号重新提交，最多重新提交 WithMaxResubmits 设置的次数
+//
+// fix 为 nil 时不重新提交。仍被驳回时返回最后一次查询到的申请单与 *RejectedError，其他返回值同 WaitForAudit。
+// 重新提交后，与上次驳回结果相同的查询结果被视为尚未更新的审核结果而继续轮询，因此以完全相同的原因再次驳回且期间未查询到审核中等状态时，
+// 将一直轮询至 ctx 结束，建议为 ctx 设置超时。
+func (a *Applier) Apply(ctx context.Context, req SubmitApplymentRequest, fix FixFunc) (*ApplymentEntity, error) {
+	if req.BusinessCode == nil || *req.BusinessCode == "" {
+		return nil, fmt.Errorf("field `BusinessCode` is required and must be specified in SubmitApplymentRequest")
+	}
+	req = *req.Clone()
+	var previous *ApplymentEntity
+	for resubmits := 0; ; resubmits++ {
+		if _, _, err := a.Submit(ctx, req); err != nil {
+			return nil, err
+		}
+
+		applyment, err := a.waitForAudit(ctx, *req.BusinessCode, previous)
+		var rejected *RejectedError
+		if !errors.As(err, &rejected) || fix == nil || resubmits >= a.maxResubmits {
+			return applyment, err
+		}
+		if err = fix(ctx, &req, rejected); err != nil {
+			return applyment, fmt.Errorf("fix rejected applyment err: %w", err)
+		}
+		previous = rejected.Applyment
+	}
+}
+
+// isSameRejection 判断两次查询到的驳回结果是否相同，即状态、状态描述与驳回原因详情均一致
+func isSameRejection(a, b *ApplymentEntity) bool {
+	if a.ApplymentState == nil || b.ApplymentState == nil || *a.ApplymentState != *b.ApplymentState ||
+		core.StringValue(a.ApplymentStateMsg) != core.StringValue(b.ApplymentStateMsg) ||
+		len(a.AuditDetail) != len(b.AuditDetail) {
+		return false
+	}
+	for i := range a.AuditDetail {
+		x, y := a.AuditDetail[i], b.AuditDetail[i]
+		if core.StringValue(x.Field) != core.StringValue(y.Field) ||
+			core.StringValue(x.FieldName) != core.StringValue(y.FieldName) ||
+			core.StringValue(x.RejectReason) != core.StringValue(y.RejectReason) {
+			return false
+		}
+	}
+	return true
+}
+
+// isRetryableQueryError 申请单不存在（提交后立即查询时可能出现）、频率限制及系统错误可以继续轮询
+func isRetryableQueryError(err error) bool {
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	switch apiErr.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+		return false
+	}
+	return true
+}
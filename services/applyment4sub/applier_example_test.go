@@ -0,0 +1,62 @@
+package applyment4sub_test
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+)
+
+func ExampleApplier_Apply() {
+	var (
+		ctx    context.Context
+		client *core.Client
+		req    applyment4sub.SubmitApplymentRequest // 敏感信息为明文，将被自动加密
+	)
+	// 假设已获得初始化后的 core.Client，并设置了敏感信息加解密所用的 cipher
+
+	applier := applyment4sub.NewApplier(client, applyment4sub.WithMaxResubmits(2))
+	applyment, err := applier.Apply(ctx, req,
+		func(ctx context.Context, req *applyment4sub.SubmitApplymentRequest, rejected *applyment4sub.RejectedError) error {
+			reason, ok := rejected.Reasons()["id_card_copy"]
+			if !ok {
+				return rejected // 无法自动修正的驳回原因，不再重新提交
+			}
+			log.Printf("id card copy is rejected: %s", reason)
+			// TODO: 重新上传更清晰的身份证人像面照片
+			req.SubjectInfo.IdentityInfo.IdCardInfo.IdCardCopy = core.String("new-media-id")
+			return nil
+		},
+	)
+
+	var rejected *applyment4sub.RejectedError
+	if errors.As(err, &rejected) {
+		log.Printf("applyment is rejected: %v", rejected.Reasons())
+		return
+	}
+	if err != nil {
+		log.Printf("apply err:%s", err)
+		return
+	}
+	// 审核通过，请超级管理员扫描 sign_url 中的二维码完成账户验证与签约
+	log.Printf("sign url: %s", *applyment.SignUrl)
+}
+
+func ExampleRejectedError_Reasons() {
+	var (
+		ctx          context.Context
+		client       *core.Client
+		businessCode string
+	)
+
+	applier := applyment4sub.NewApplier(client)
+	_, err := applier.WaitForAudit(ctx, businessCode)
+	var rejected *applyment4sub.RejectedError
+	if errors.As(err, &rejected) {
+		for field, reason := range rejected.Reasons() {
+			log.Printf("%s: %s", field, reason)
+		}
+	}
+}
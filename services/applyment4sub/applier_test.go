@@ -0,0 +1,291 @@
+package applyment4sub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+)
+
+const testBusinessCode = "APPLYMENT_00000000001"
+
+// fakeApplymentServer 模拟进件接口：每次提交后按顺序返回 rounds 中对应一轮的查询结果，一轮的结果用完后重复最后一个
+type fakeApplymentServer struct {
+	lock    sync.Mutex
+	rounds  [][]interface{}
+	submits int
+	queries int
+}
+
+func (s *fakeApplymentServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if r.Method == http.MethodPost {
+		s.submits++
+		s.queries = 0
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{"applyment_id": 2000002124775691})
+		return
+	}
+
+	round := s.rounds[0]
+	if s.submits > 0 {
+		round = s.rounds[s.submits-1]
+	}
+	result := round[len(round)-1]
+	if s.queries < len(round) {
+		result = round[s.queries]
+	}
+	s.queries++
+	if status, ok := result.(int); ok {
+		clienttest.WriteError(w, status, "ERROR", "模拟错误")
+		return
+	}
+	clienttest.WriteJSON(w, http.StatusOK, result)
+}
+
+func applyment(state applyment4sub.ApplymentState, reasons ...string) map[string]interface{} {
+	details := make([]map[string]string, 0, len(reasons))
+	for _, reason := range reasons {
+		details = append(details, map[string]string{
+			"field": "id_card_copy", "field_name": "身份证人像面照片", "reject_reason": reason,
+		})
+	}
+	return map[string]interface{}{
+		"business_code":       testBusinessCode,
+		"applyment_id":        2000002124775691,
+		"applyment_state":     state,
+		"applyment_state_msg": string(state),
+		"audit_detail":        details,
+	}
+}
+
+func newTestApplier(t *testing.T, server *fakeApplymentServer, opts ...applyment4sub.ApplierOption) *applyment4sub.Applier {
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	opts = append([]applyment4sub.ApplierOption{applyment4sub.WithPollInterval(time.Millisecond, time.Millisecond)}, opts...)
+	return applyment4sub.NewApplier(client, opts...)
+}
+
+func loadSubmitRequest(t *testing.T) applyment4sub.SubmitApplymentRequest {
+	data, err := ioutil.ReadFile("testdata/submit_applyment.json")
+	require.NoError(t, err)
+	var req applyment4sub.SubmitApplymentRequest
+	require.NoError(t, json.Unmarshal(data, &req))
+	// 已设置 WechatpaySerial 时不再加密，测试无需配置 cipher
+	req.WechatpaySerial = core.String("PUB_KEY_ID_TEST")
+	return req
+}
+
+func TestApplier_WaitForAudit(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		round     []interface{}
+		wantState applyment4sub.ApplymentState
+		wantErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "pass after auditing and retryable errors",
+			round: []interface{}{
+				http.StatusNotFound,
+				applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_AUDITING),
+				http.StatusTooManyRequests,
+				applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_CONFIRMED),
+			},
+			wantState: applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_CONFIRMED,
+		},
+		{
+			name: "rejected",
+			round: []interface{}{
+				applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_AUDITING),
+				applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_REJECTED, "照片模糊", "已过期"),
+			},
+			wantState: applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_REJECTED,
+			wantErr: func(t *testing.T, err error) {
+				var rejected *applyment4sub.RejectedError
+				require.True(t, errors.As(err, &rejected))
+				assert.Equal(t, map[string]string{"id_card_copy": "照片模糊; 已过期"}, rejected.Reasons())
+			},
+		},
+		{
+			name:      "editing",
+			round:     []interface{}{applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_EDITTING)},
+			wantState: applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_EDITTING,
+			wantErr: func(t *testing.T, err error) {
+				var rejected *applyment4sub.RejectedError
+				require.True(t, errors.As(err, &rejected))
+			},
+		},
+		{
+			name:      "canceled",
+			round:     []interface{}{applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_CANCELED)},
+			wantState: applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_CANCELED,
+			wantErr: func(t *testing.T, err error) {
+				var rejected *applyment4sub.RejectedError
+				assert.False(t, errors.As(err, &rejected))
+				assert.Contains(t, err.Error(), "canceled")
+			},
+		},
+		{
+			name: "non-retryable error",
+			round: []interface{}{
+				applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_AUDITING),
+				http.StatusBadRequest,
+			},
+			wantState: applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_AUDITING,
+			wantErr: func(t *testing.T, err error) {
+				var apiErr *core.APIError
+				require.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applier := newTestApplier(t, &fakeApplymentServer{rounds: [][]interface{}{tt.round}})
+			entity, err := applier.WaitForAudit(ctx, testBusinessCode)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				tt.wantErr(t, err)
+			}
+			require.NotNil(t, entity)
+			assert.Equal(t, tt.wantState, *entity.ApplymentState)
+		})
+	}
+}
+
+func TestApplier_WaitForAuditContextDone(t *testing.T) {
+	applier := newTestApplier(t, &fakeApplymentServer{rounds: [][]interface{}{{
+		applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_AUDITING),
+		http.StatusInternalServerError,
+	}}})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	entity, err := applier.WaitForAudit(ctx, testBusinessCode)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "last query err")
+	require.NotNil(t, entity)
+	assert.Equal(t, applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_AUDITING, *entity.ApplymentState)
+}
+
+func TestApplier_Apply(t *testing.T) {
+	const (
+		firstReason  = "照片模糊"
+		secondReason = "证件已过期"
+	)
+	rejected := func(reason string) interface{} {
+		return applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_REJECTED, reason)
+	}
+	auditing := applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_AUDITING)
+	passed := applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_SIGNED)
+
+	tests := []struct {
+		name         string
+		rounds       [][]interface{}
+		maxResubmits int
+		wantSubmits  int
+		wantReasons  []string
+		wantState    applyment4sub.ApplymentState
+		wantReason   string
+	}{
+		{
+			name:         "pass",
+			rounds:       [][]interface{}{{auditing, passed}},
+			maxResubmits: 3,
+			wantSubmits:  1,
+			wantState:    applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_SIGNED,
+		},
+		{
+			name: "resubmit then pass",
+			// 重新提交后首次查询到的仍是上次的驳回结果
+			rounds:       [][]interface{}{{rejected(firstReason)}, {rejected(firstReason), auditing, passed}},
+			maxResubmits: 3,
+			wantSubmits:  2,
+			wantReasons:  []string{firstReason},
+			wantState:    applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_SIGNED,
+		},
+		{
+			name: "resubmit then rejected for another reason",
+			// 未查询到审核中状态，直接由上次的驳回结果变为新的驳回结果
+			rounds:       [][]interface{}{{rejected(firstReason)}, {rejected(firstReason), rejected(secondReason)}},
+			maxResubmits: 1,
+			wantSubmits:  2,
+			wantReasons:  []string{firstReason},
+			wantState:    applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_REJECTED,
+			wantReason:   secondReason,
+		},
+		{
+			name:         "resubmit then rejected for the same reason",
+			rounds:       [][]interface{}{{rejected(firstReason)}, {rejected(firstReason), auditing, rejected(firstReason)}},
+			maxResubmits: 1,
+			wantSubmits:  2,
+			wantReasons:  []string{firstReason},
+			wantState:    applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_REJECTED,
+			wantReason:   firstReason,
+		},
+		{
+			name:         "rejected without resubmit",
+			rounds:       [][]interface{}{{auditing, rejected(firstReason)}},
+			maxResubmits: 0,
+			wantSubmits:  1,
+			wantState:    applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_REJECTED,
+			wantReason:   firstReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeApplymentServer{rounds: tt.rounds}
+			applier := newTestApplier(t, server, applyment4sub.WithMaxResubmits(tt.maxResubmits))
+			var reasons []string
+			fix := func(ctx context.Context, req *applyment4sub.SubmitApplymentRequest, r *applyment4sub.RejectedError) error {
+				reasons = append(reasons, r.Reasons()["id_card_copy"])
+				req.SubjectInfo.IdentityInfo.IdCardInfo.IdCardCopy = core.String("new-media-id")
+				return nil
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			entity, err := applier.Apply(ctx, loadSubmitRequest(t), fix)
+			if tt.wantReason == "" {
+				require.NoError(t, err)
+			} else {
+				var rejected *applyment4sub.RejectedError
+				require.True(t, errors.As(err, &rejected), "err: %v", err)
+				assert.Equal(t, tt.wantReason, rejected.Reasons()["id_card_copy"])
+			}
+			require.NotNil(t, entity)
+			assert.Equal(t, tt.wantState, *entity.ApplymentState)
+			assert.Equal(t, tt.wantSubmits, server.submits)
+			assert.Equal(t, tt.wantReasons, reasons)
+		})
+	}
+}
+
+func TestApplier_ApplyFixError(t *testing.T) {
+	server := &fakeApplymentServer{rounds: [][]interface{}{{
+		applyment(applyment4sub.APPLYMENTSTATE_APPLYMENT_STATE_REJECTED, "照片模糊"),
+	}}}
+	applier := newTestApplier(t, server)
+	errCannotFix := errors.New("cannot fix")
+
+	_, err := applier.Apply(context.Background(), loadSubmitRequest(t),
+		func(context.Context, *applyment4sub.SubmitApplymentRequest, *applyment4sub.RejectedError) error {
+			return errCannotFix
+		})
+	assert.True(t, errors.Is(err, errCannotFix))
+	assert.Equal(t, 1, server.submits)
+}
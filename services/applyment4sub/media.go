@@ -0,0 +1,237 @@
+package applyment4sub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/fileuploader"
+)
+
+// MaxImageSize 进件图片的最大字节数。图片上传接口仅支持 JPG、BMP、PNG 格式且不超过 2MB 的图片
+const MaxImageSize = 2 << 20
+
+// imageTypes 图片上传接口支持的图片格式，键为 http.DetectContentType 识别的类型
+var imageTypes = map[string]struct {
+	contentType string
+	ext         string
+}{
+	"image/jpeg": {consts.ImageJPG, ".jpg"},
+	"image/png":  {consts.ImagePNG, ".png"},
+	"image/bmp":  {"image/bmp", ".bmp"},
+}
+
+// ApplymentMedia 申请单中需要上传的本地图片路径，为空的项不上传
+type ApplymentMedia struct {
+	// LicenseCopy 营业执照照片，填入 SubjectInfo.BusinessLicenseInfo.LicenseCopy
+	LicenseCopy string
+	// IdCardCopy 身份证人像面照片，填入 SubjectInfo.IdentityInfo.IdCardInfo.IdCardCopy
+	IdCardCopy string
+	// IdCardNational 身份证国徽面照片，填入 SubjectInfo.IdentityInfo.IdCardInfo.IdCardNational
+	IdCardNational string
+	// StoreEntrancePics 线下场所门头照片，填入 BusinessInfo.SalesInfo.BizStoreInfo.StoreEntrancePic
+	StoreEntrancePics []string
+	// IndoorPics 线下场所内部照片，填入 BusinessInfo.SalesInfo.BizStoreInfo.IndoorPic
+	IndoorPics []string
+	// MpPics 公众号页面截图，填入 BusinessInfo.SalesInfo.MpInfo.MpPics
+	MpPics []string
+	// Qualifications 特殊资质图片，填入 SettlementInfo.Qualifications
+	Qualifications []string
+	// LegalPersonCommitment 法人开户承诺函，填入 AdditionInfo.LegalPersonCommitment
+	LegalPersonCommitment string
+	// BusinessAdditionPics 补充材料图片，填入 AdditionInfo.BusinessAdditionPics
+	BusinessAdditionPics []string
+}
+
+// MediaUploader 进件图片上传器
+//
+// 读取本地图片，按文件内容识别图片格式并校验大小后，使用图片上传接口上传，并将获得的 media_id 填入申请单。
+type MediaUploader struct {
+	uploader fileuploader.ImageUploader
+	opts     []fileuploader.UploadOption
+}
+
+// NewMediaUploader 创建 MediaUploader，opts 用于每张图片的上传，如 fileuploader.WithRetry
+func NewMediaUploader(client *core.Client, opts ...fileuploader.UploadOption) *MediaUploader {
+	return &MediaUploader{uploader: fileuploader.ImageUploader{Client: client}, opts: opts}
+}
+
+// UploadFile 上传本地图片，返回 media_id
+//
+// 图片格式按文件内容识别，与扩展名不一致时使用识别出的格式对应的扩展名上传；格式不受支持、文件为空或超过 MaxImageSize 时返回错误，不会发起请求。
+func (u *MediaUploader) UploadFile(ctx context.Context, path string) (string, error) {
+	content, filename, contentType, err := readImage(path)
+	if err != nil {
+		return "", err
+	}
+	resp, _, err := u.uploader.Upload(ctx, bytes.NewReader(content), filename, contentType, u.opts...)
+	if err != nil {
+		return "", fmt.Errorf("upload image %s err:%w", path, err)
+	}
+	if resp.MediaId == nil || *resp.MediaId == "" {
+		return "", fmt.Errorf("upload image %s err: media_id is empty", path)
+	}
+	return *resp.MediaId, nil
+}
+
+// Fill 上传 media 中的所有图片，并将 media_id 填入 req 中对应的字段，对应的结构为 nil 时将被创建
+//
+// 同一路径的图片只上传一次。所有图片在上传前完成校验，任一图片上传失败时返回错误，req 不会被修改。
+func (u *MediaUploader) Fill(ctx context.Context, req *SubmitApplymentRequest, media ApplymentMedia) error {
+	paths := append([]string{media.LicenseCopy, media.IdCardCopy, media.IdCardNational, media.LegalPersonCommitment},
+		media.StoreEntrancePics...)
+	paths = append(paths, media.IndoorPics...)
+	paths = append(paths, media.MpPics...)
+	paths = append(paths, media.Qualifications...)
+	paths = append(paths, media.BusinessAdditionPics...)
+
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, _, _, err := readImage(path); err != nil {
+			return err
+		}
+	}
+
+	mediaIDs := make(map[string]string)
+	for _, path := range paths {
+		if _, ok := mediaIDs[path]; ok || path == "" {
+			continue
+		}
+		mediaID, err := u.UploadFile(ctx, path)
+		if err != nil {
+			return err
+		}
+		mediaIDs[path] = mediaID
+	}
+
+	one := func(path string) *string {
+		if path == "" {
+			return nil
+		}
+		return core.String(mediaIDs[path])
+	}
+	many := func(paths []string) []string {
+		ids := make([]string, 0, len(paths))
+		for _, path := range paths {
+			ids = append(ids, mediaIDs[path])
+		}
+		return ids
+	}
+
+	if media.LicenseCopy != "" {
+		subject := subjectInfo(req)
+		if subject.BusinessLicenseInfo == nil {
+			subject.BusinessLicenseInfo = new(BusinessLicenseInfo)
+		}
+		subject.BusinessLicenseInfo.LicenseCopy = one(media.LicenseCopy)
+	}
+	if media.IdCardCopy != "" || media.IdCardNational != "" {
+		subject := subjectInfo(req)
+		if subject.IdentityInfo == nil {
+			subject.IdentityInfo = new(IdentityInfo)
+		}
+		if subject.IdentityInfo.IdCardInfo == nil {
+			subject.IdentityInfo.IdCardInfo = new(IdCardInfo)
+		}
+		if media.IdCardCopy != "" {
+			subject.IdentityInfo.IdCardInfo.IdCardCopy = one(media.IdCardCopy)
+		}
+		if media.IdCardNational != "" {
+			subject.IdentityInfo.IdCardInfo.IdCardNational = one(media.IdCardNational)
+		}
+	}
+	if len(media.StoreEntrancePics) > 0 || len(media.IndoorPics) > 0 {
+		sales := salesInfo(req)
+		if sales.BizStoreInfo == nil {
+			sales.BizStoreInfo = new(BizStoreInfo)
+		}
+		if len(media.StoreEntrancePics) > 0 {
+			sales.BizStoreInfo.StoreEntrancePic = many(media.StoreEntrancePics)
+		}
+		if len(media.IndoorPics) > 0 {
+			sales.BizStoreInfo.IndoorPic = many(media.IndoorPics)
+		}
+	}
+	if len(media.MpPics) > 0 {
+		sales := salesInfo(req)
+		if sales.MpInfo == nil {
+			sales.MpInfo = new(MpInfo)
+		}
+		sales.MpInfo.MpPics = many(media.MpPics)
+	}
+	if len(media.Qualifications) > 0 {
+		if req.SettlementInfo == nil {
+			req.SettlementInfo = new(SettlementInfo)
+		}
+		req.SettlementInfo.Qualifications = many(media.Qualifications)
+	}
+	if media.LegalPersonCommitment != "" || len(media.BusinessAdditionPics) > 0 {
+		if req.AdditionInfo == nil {
+			req.AdditionInfo = new(AdditionInfo)
+		}
+		if media.LegalPersonCommitment != "" {
+			req.AdditionInfo.LegalPersonCommitment = one(media.LegalPersonCommitment)
+		}
+		if len(media.BusinessAdditionPics) > 0 {
+			req.AdditionInfo.BusinessAdditionPics = many(media.BusinessAdditionPics)
+		}
+	}
+	return nil
+}
+
+func subjectInfo(req *SubmitApplymentRequest) *SubjectInfo {
+	if req.SubjectInfo == nil {
+		req.SubjectInfo = new(SubjectInfo)
+	}
+	return req.SubjectInfo
+}
+
+func salesInfo(req *SubmitApplymentRequest) *SalesInfo {
+	if req.BusinessInfo == nil {
+		req.BusinessInfo = new(BusinessInfo)
+	}
+	if req.BusinessInfo.SalesInfo == nil {
+		req.BusinessInfo.SalesInfo = new(SalesInfo)
+	}
+	return req.BusinessInfo.SalesInfo
+}
+
+// readImage 读取并校验本地图片，返回图片内容、上传使用的文件名与 Content-Type
+func readImage(path string) (content []byte, filename, contentType string, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("read image err:%v", err)
+	}
+	if info.IsDir() {
+		return nil, "", "", fmt.Errorf("image %s is a directory", path)
+	}
+	if info.Size() == 0 || info.Size() > MaxImageSize {
+		return nil, "", "", fmt.Errorf("image %s size %d must be in (0, %d]", path, info.Size(), MaxImageSize)
+	}
+
+	content, err = ioutil.ReadFile(path)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("read image err:%v", err)
+	}
+	detected := http.DetectContentType(content)
+	imageType, ok := imageTypes[detected]
+	if !ok {
+		return nil, "", "", fmt.Errorf("image %s is %s, only jpg, png and bmp are supported", path, detected)
+	}
+
+	filename = filepath.Base(path)
+	if ext := filepath.Ext(filename); !strings.EqualFold(ext, imageType.ext) &&
+		!(imageType.ext == ".jpg" && strings.EqualFold(ext, ".jpeg")) {
+		filename = strings.TrimSuffix(filename, ext) + imageType.ext
+	}
+	return content, filename, imageType.contentType, nil
+}
@@ -0,0 +1,37 @@
+package applyment4sub_test
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/fileuploader"
+)
+
+func ExampleMediaUploader_Fill() {
+	var (
+		ctx    context.Context
+		client *core.Client
+		req    applyment4sub.SubmitApplymentRequest
+	)
+	// 假设已获得初始化后的 core.Client，并已填写 req 中的文字资料
+
+	uploader := applyment4sub.NewMediaUploader(client, fileuploader.WithRetry(3, time.Second))
+	err := uploader.Fill(ctx, &req, applyment4sub.ApplymentMedia{
+		LicenseCopy:       "materials/license.jpg",
+		IdCardCopy:        "materials/id_card_front.jpg",
+		IdCardNational:    "materials/id_card_back.jpg",
+		StoreEntrancePics: []string{"materials/store_entrance.png"},
+		IndoorPics:        []string{"materials/store_indoor.png"},
+	})
+	if err != nil {
+		log.Printf("upload media err:%s", err)
+		return
+	}
+
+	_, err = applyment4sub.NewApplier(client).Apply(ctx, req, nil)
+	// TODO: 处理审核结果
+	_ = err
+}
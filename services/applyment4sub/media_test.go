@@ -0,0 +1,166 @@
+package applyment4sub_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+)
+
+// uploadedImage 模拟上传接口收到的图片
+type uploadedImage struct {
+	Filename    string
+	ContentType string
+}
+
+// fakeMediaServer 模拟图片上传接口，media_id 为上传顺序
+type fakeMediaServer struct {
+	lock    sync.Mutex
+	uploads []uploadedImage
+}
+
+func (s *fakeMediaServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if r.URL.Path != "/v3/merchant/media/upload" {
+		clienttest.WriteError(w, http.StatusNotFound, "NOT_FOUND", "接口不存在")
+		return
+	}
+	_, header, err := r.FormFile("file")
+	if err != nil {
+		clienttest.WriteError(w, http.StatusBadRequest, "PARAM_ERROR", err.Error())
+		return
+	}
+	s.uploads = append(s.uploads, uploadedImage{Filename: header.Filename, ContentType: header.Header.Get("Content-Type")})
+	clienttest.WriteJSON(w, http.StatusOK, map[string]string{"media_id": fmt.Sprintf("MEDIA_ID_%d", len(s.uploads))})
+}
+
+func newTestMediaUploader(t *testing.T) (*applyment4sub.MediaUploader, *fakeMediaServer) {
+	server := &fakeMediaServer{}
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	return applyment4sub.NewMediaUploader(client), server
+}
+
+// writeImages 在临时目录中生成测试图片，返回文件名到路径的映射，以及删除临时目录的函数
+func writeImages(t *testing.T) (map[string]string, func()) {
+	dir, err := ioutil.TempDir("", "applyment4sub-media")
+	require.NoError(t, err)
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	var pngData, jpegData bytes.Buffer
+	require.NoError(t, png.Encode(&pngData, img))
+	require.NoError(t, jpeg.Encode(&jpegData, img, nil))
+	oversize := append(append([]byte{}, pngData.Bytes()...), make([]byte, applyment4sub.MaxImageSize)...)
+
+	files := map[string][]byte{
+		"license.jpeg":     jpegData.Bytes(),
+		"id_card.jpg":      jpegData.Bytes(),
+		"store.jpg":        pngData.Bytes(), // 扩展名与内容不一致
+		"oversize.png":     oversize,
+		"empty.png":        {},
+		"animation.gif":    []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00;"),
+		"document.jpg":     []byte("%PDF-1.4 not an image"),
+		"boundary_max.png": append(append([]byte{}, pngData.Bytes()...), make([]byte, applyment4sub.MaxImageSize-pngData.Len())...),
+	}
+	paths := make(map[string]string, len(files))
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, content, 0600))
+		paths[name] = path
+	}
+	paths["directory"] = dir
+	paths["missing.png"] = filepath.Join(dir, "missing.png")
+	return paths, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestMediaUploader_UploadFile(t *testing.T) {
+	ctx := context.Background()
+	paths, cleanup := writeImages(t)
+	defer cleanup()
+
+	t.Run("valid images", func(t *testing.T) {
+		uploader, server := newTestMediaUploader(t)
+		for _, name := range []string{"license.jpeg", "store.jpg", "boundary_max.png"} {
+			mediaID, err := uploader.UploadFile(ctx, paths[name])
+			require.NoError(t, err, name)
+			assert.NotEmpty(t, mediaID, name)
+		}
+		assert.Equal(t, []uploadedImage{
+			{Filename: "license.jpeg", ContentType: consts.ImageJPG},
+			{Filename: "store.png", ContentType: consts.ImagePNG},
+			{Filename: "boundary_max.png", ContentType: consts.ImagePNG},
+		}, server.uploads)
+	})
+
+	t.Run("invalid images", func(t *testing.T) {
+		tests := []struct {
+			name string
+			err  string
+		}{
+			{name: "oversize.png", err: "size"},
+			{name: "empty.png", err: "size 0"},
+			{name: "animation.gif", err: "image/gif, only jpg, png and bmp are supported"},
+			{name: "document.jpg", err: "only jpg, png and bmp are supported"},
+			{name: "directory", err: "is a directory"},
+			{name: "missing.png", err: "read image err"},
+		}
+		uploader, server := newTestMediaUploader(t)
+		for _, tt := range tests {
+			_, err := uploader.UploadFile(ctx, paths[tt.name])
+			require.Error(t, err, tt.name)
+			assert.Contains(t, err.Error(), tt.err, tt.name)
+		}
+		assert.Empty(t, server.uploads, "invalid images should not be uploaded")
+	})
+}
+
+func TestMediaUploader_Fill(t *testing.T) {
+	ctx := context.Background()
+	paths, cleanup := writeImages(t)
+	defer cleanup()
+
+	uploader, server := newTestMediaUploader(t)
+	req := applyment4sub.SubmitApplymentRequest{}
+	err := uploader.Fill(ctx, &req, applyment4sub.ApplymentMedia{
+		LicenseCopy:       paths["license.jpeg"],
+		IdCardCopy:        paths["id_card.jpg"],
+		StoreEntrancePics: []string{paths["store.jpg"]},
+		IndoorPics:        []string{paths["store.jpg"], paths["license.jpeg"]},
+	})
+	require.NoError(t, err)
+	// 同一路径的图片只上传一次
+	assert.Len(t, server.uploads, 3)
+	assert.Equal(t, "MEDIA_ID_1", *req.SubjectInfo.BusinessLicenseInfo.LicenseCopy)
+	assert.Equal(t, "MEDIA_ID_2", *req.SubjectInfo.IdentityInfo.IdCardInfo.IdCardCopy)
+	assert.Nil(t, req.SubjectInfo.IdentityInfo.IdCardInfo.IdCardNational)
+	assert.Equal(t, []string{"MEDIA_ID_3"}, req.BusinessInfo.SalesInfo.BizStoreInfo.StoreEntrancePic)
+	assert.Equal(t, []string{"MEDIA_ID_3", "MEDIA_ID_1"}, req.BusinessInfo.SalesInfo.BizStoreInfo.IndoorPic)
+	assert.Nil(t, req.AdditionInfo)
+
+	// 任一图片无效时不上传任何图片，req 不被修改
+	uploader, server = newTestMediaUploader(t)
+	req = applyment4sub.SubmitApplymentRequest{}
+	err = uploader.Fill(ctx, &req, applyment4sub.ApplymentMedia{
+		LicenseCopy:    paths["license.jpeg"],
+		Qualifications: []string{paths["oversize.png"]},
+	})
+	require.Error(t, err)
+	assert.Empty(t, server.uploads)
+	assert.Equal(t, applyment4sub.SubmitApplymentRequest{}, req)
+}
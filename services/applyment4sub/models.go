@@ -0,0 +1,1787 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户进件API
+//
+// 服务商为特约商户提交进件申请，并查询申请单状态
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package applyment4sub
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AdditionInfo
+type AdditionInfo struct {
+	// 法人开户承诺函，需使用图片上传接口预先上传图片获取 media_id
+	LegalPersonCommitment *string `json:"legal_person_commitment,omitempty"`
+	// 补充材料图片，需使用图片上传接口预先上传图片获取 media_id
+	BusinessAdditionPics []string `json:"business_addition_pics,omitempty"`
+	// 补充说明
+	BusinessAdditionMsg *string `json:"business_addition_msg,omitempty"`
+}
+
+func (o AdditionInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.LegalPersonCommitment != nil {
+		toSerialize["legal_person_commitment"] = o.LegalPersonCommitment
+	}
+
+	if o.BusinessAdditionPics != nil {
+		toSerialize["business_addition_pics"] = o.BusinessAdditionPics
+	}
+
+	if o.BusinessAdditionMsg != nil {
+		toSerialize["business_addition_msg"] = o.BusinessAdditionMsg
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AdditionInfo) String() string {
+	var ret string
+	if o.LegalPersonCommitment == nil {
+		ret += "LegalPersonCommitment:<nil>, "
+	} else {
+		ret += fmt.Sprintf("LegalPersonCommitment:%v, ", *o.LegalPersonCommitment)
+	}
+
+	ret += fmt.Sprintf("BusinessAdditionPics:%v, ", o.BusinessAdditionPics)
+
+	if o.BusinessAdditionMsg == nil {
+		ret += "BusinessAdditionMsg:<nil>"
+	} else {
+		ret += fmt.Sprintf("BusinessAdditionMsg:%v", *o.BusinessAdditionMsg)
+	}
+
+	return fmt.Sprintf("AdditionInfo{%s}", ret)
+}
+
+func (o AdditionInfo) Clone() *AdditionInfo {
+	ret := AdditionInfo{}
+
+	if o.LegalPersonCommitment != nil {
+		ret.LegalPersonCommitment = new(string)
+		*ret.LegalPersonCommitment = *o.LegalPersonCommitment
+	}
+
+	if o.BusinessAdditionPics != nil {
+		ret.BusinessAdditionPics = make([]string, len(o.BusinessAdditionPics))
+		for i, item := range o.BusinessAdditionPics {
+			ret.BusinessAdditionPics[i] = item
+		}
+	}
+
+	if o.BusinessAdditionMsg != nil {
+		ret.BusinessAdditionMsg = new(string)
+		*ret.BusinessAdditionMsg = *o.BusinessAdditionMsg
+	}
+
+	return &ret
+}
+
+// ApplymentEntity
+type ApplymentEntity struct {
+	// 提交申请单时使用的业务申请编号
+	BusinessCode *string `json:"business_code"`
+	// 微信支付分配的申请单号
+	ApplymentId *int64 `json:"applyment_id"`
+	// 申请单完成审核后生成的特约商户号
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 超级管理员签约链接，申请单状态为待账户验证、待签约或开通权限中时返回
+	SignUrl *string `json:"sign_url,omitempty"`
+	// 申请单状态
+	ApplymentState *ApplymentState `json:"applyment_state"`
+	// 申请状态描述
+	ApplymentStateMsg *string `json:"applyment_state_msg"`
+	// 驳回原因详情，申请单状态为已驳回时返回
+	AuditDetail []AuditDetail `json:"audit_detail,omitempty"`
+}
+
+func (o ApplymentEntity) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BusinessCode == nil {
+		return nil, fmt.Errorf("field `BusinessCode` is required and must be specified in ApplymentEntity")
+	}
+	toSerialize["business_code"] = o.BusinessCode
+
+	if o.ApplymentId == nil {
+		return nil, fmt.Errorf("field `ApplymentId` is required and must be specified in ApplymentEntity")
+	}
+	toSerialize["applyment_id"] = o.ApplymentId
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.SignUrl != nil {
+		toSerialize["sign_url"] = o.SignUrl
+	}
+
+	if o.ApplymentState == nil {
+		return nil, fmt.Errorf("field `ApplymentState` is required and must be specified in ApplymentEntity")
+	}
+	toSerialize["applyment_state"] = o.ApplymentState
+
+	if o.ApplymentStateMsg == nil {
+		return nil, fmt.Errorf("field `ApplymentStateMsg` is required and must be specified in ApplymentEntity")
+	}
+	toSerialize["applyment_state_msg"] = o.ApplymentStateMsg
+
+	if o.AuditDetail != nil {
+		toSerialize["audit_detail"] = o.AuditDetail
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ApplymentEntity) String() string {
+	var ret string
+	if o.BusinessCode == nil {
+		ret += "BusinessCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BusinessCode:%v, ", *o.BusinessCode)
+	}
+
+	if o.ApplymentId == nil {
+		ret += "ApplymentId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ApplymentId:%v, ", *o.ApplymentId)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.SignUrl == nil {
+		ret += "SignUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SignUrl:%v, ", *o.SignUrl)
+	}
+
+	if o.ApplymentState == nil {
+		ret += "ApplymentState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ApplymentState:%v, ", *o.ApplymentState)
+	}
+
+	if o.ApplymentStateMsg == nil {
+		ret += "ApplymentStateMsg:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ApplymentStateMsg:%v, ", *o.ApplymentStateMsg)
+	}
+
+	ret += fmt.Sprintf("AuditDetail:%v", o.AuditDetail)
+
+	return fmt.Sprintf("ApplymentEntity{%s}", ret)
+}
+
+func (o ApplymentEntity) Clone() *ApplymentEntity {
+	ret := ApplymentEntity{}
+
+	if o.BusinessCode != nil {
+		ret.BusinessCode = new(string)
+		*ret.BusinessCode = *o.BusinessCode
+	}
+
+	if o.ApplymentId != nil {
+		ret.ApplymentId = new(int64)
+		*ret.ApplymentId = *o.ApplymentId
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.SignUrl != nil {
+		ret.SignUrl = new(string)
+		*ret.SignUrl = *o.SignUrl
+	}
+
+	if o.ApplymentState != nil {
+		ret.ApplymentState = new(ApplymentState)
+		*ret.ApplymentState = *o.ApplymentState
+	}
+
+	if o.ApplymentStateMsg != nil {
+		ret.ApplymentStateMsg = new(string)
+		*ret.ApplymentStateMsg = *o.ApplymentStateMsg
+	}
+
+	if o.AuditDetail != nil {
+		ret.AuditDetail = make([]AuditDetail, len(o.AuditDetail))
+		for i, item := range o.AuditDetail {
+			ret.AuditDetail[i] = *item.Clone()
+		}
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *ApplymentEntity) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasSignUrl 应答中是否返回了 sign_url，o 为 nil 时返回 false
+func (o *ApplymentEntity) HasSignUrl() bool {
+	return o != nil && o.SignUrl != nil
+}
+
+// HasAuditDetail 应答中是否返回了 audit_detail，o 为 nil 时返回 false
+func (o *ApplymentEntity) HasAuditDetail() bool {
+	return o != nil && o.AuditDetail != nil
+}
+
+// ApplymentState * `APPLYMENT_STATE_EDITTING` - 编辑中，提交申请发生错误导致，请尝试重新提交 * `APPLYMENT_STATE_AUDITING` - 审核中，申请单正在审核中 * `APPLYMENT_STATE_REJECTED` - 已驳回，请按照驳回原因修改申请资料后重新提交 * `APPLYMENT_STATE_TO_BE_CONFIRMED` - 待账户验证，请超级管理员使用微信扫描 sign_url 中的二维码完成账户验证 * `APPLYMENT_STATE_TO_BE_SIGNED` - 待签约，请超级管理员使用微信扫描 sign_url 中的二维码完成签约 * `APPLYMENT_STATE_SIGNING` - 开通权限中，系统开通相关权限中，请耐心等待 * `APPLYMENT_STATE_FINISHED` - 已完成，商户入驻申请已完成 * `APPLYMENT_STATE_CANCELED` - 已作废，申请单已被撤销
+type ApplymentState string
+
+func (e ApplymentState) Ptr() *ApplymentState {
+	return &e
+}
+
+// Enums of ApplymentState
+const (
+	APPLYMENTSTATE_APPLYMENT_STATE_EDITTING        ApplymentState = "APPLYMENT_STATE_EDITTING"
+	APPLYMENTSTATE_APPLYMENT_STATE_AUDITING        ApplymentState = "APPLYMENT_STATE_AUDITING"
+	APPLYMENTSTATE_APPLYMENT_STATE_REJECTED        ApplymentState = "APPLYMENT_STATE_REJECTED"
+	APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_CONFIRMED ApplymentState = "APPLYMENT_STATE_TO_BE_CONFIRMED"
+	APPLYMENTSTATE_APPLYMENT_STATE_TO_BE_SIGNED    ApplymentState = "APPLYMENT_STATE_TO_BE_SIGNED"
+	APPLYMENTSTATE_APPLYMENT_STATE_SIGNING         ApplymentState = "APPLYMENT_STATE_SIGNING"
+	APPLYMENTSTATE_APPLYMENT_STATE_FINISHED        ApplymentState = "APPLYMENT_STATE_FINISHED"
+	APPLYMENTSTATE_APPLYMENT_STATE_CANCELED        ApplymentState = "APPLYMENT_STATE_CANCELED"
+)
+
+func (v *ApplymentState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ApplymentState(value)
+	for _, existing := range []ApplymentState{"APPLYMENT_STATE_EDITTING", "APPLYMENT_STATE_AUDITING", "APPLYMENT_STATE_REJECTED", "APPLYMENT_STATE_TO_BE_CONFIRMED", "APPLYMENT_STATE_TO_BE_SIGNED", "APPLYMENT_STATE_SIGNING", "APPLYMENT_STATE_FINISHED", "APPLYMENT_STATE_CANCELED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ApplymentState", value)
+}
+
+// AuditDetail
+type AuditDetail struct {
+	// 提交申请单的资料项字段名
+	Field *string `json:"field"`
+	// 提交申请单的资料项字段名称
+	FieldName *string `json:"field_name"`
+	// 提交资料项被驳回的原因
+	RejectReason *string `json:"reject_reason"`
+}
+
+func (o AuditDetail) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Field == nil {
+		return nil, fmt.Errorf("field `Field` is required and must be specified in AuditDetail")
+	}
+	toSerialize["field"] = o.Field
+
+	if o.FieldName == nil {
+		return nil, fmt.Errorf("field `FieldName` is required and must be specified in AuditDetail")
+	}
+	toSerialize["field_name"] = o.FieldName
+
+	if o.RejectReason == nil {
+		return nil, fmt.Errorf("field `RejectReason` is required and must be specified in AuditDetail")
+	}
+	toSerialize["reject_reason"] = o.RejectReason
+	return json.Marshal(toSerialize)
+}
+
+func (o AuditDetail) String() string {
+	var ret string
+	if o.Field == nil {
+		ret += "Field:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Field:%v, ", *o.Field)
+	}
+
+	if o.FieldName == nil {
+		ret += "FieldName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FieldName:%v, ", *o.FieldName)
+	}
+
+	if o.RejectReason == nil {
+		ret += "RejectReason:<nil>"
+	} else {
+		ret += fmt.Sprintf("RejectReason:%v", *o.RejectReason)
+	}
+
+	return fmt.Sprintf("AuditDetail{%s}", ret)
+}
+
+func (o AuditDetail) Clone() *AuditDetail {
+	ret := AuditDetail{}
+
+	if o.Field != nil {
+		ret.Field = new(string)
+		*ret.Field = *o.Field
+	}
+
+	if o.FieldName != nil {
+		ret.FieldName = new(string)
+		*ret.FieldName = *o.FieldName
+	}
+
+	if o.RejectReason != nil {
+		ret.RejectReason = new(string)
+		*ret.RejectReason = *o.RejectReason
+	}
+
+	return &ret
+}
+
+// BankAccountInfo
+type BankAccountInfo struct {
+	// 账户类型
+	BankAccountType *BankAccountType `json:"bank_account_type"`
+	// 开户名称。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	AccountName *string `json:"account_name" encryption:"EM_APIV3"`
+	// 开户银行
+	AccountBank *string `json:"account_bank"`
+	// 开户银行省市编码
+	BankAddressCode *string `json:"bank_address_code"`
+	// 开户银行全称（含支行），开户银行为“其他银行”时必填
+	BankName *string `json:"bank_name,omitempty"`
+	// 银行账号。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	AccountNumber *string `json:"account_number" encryption:"EM_APIV3"`
+}
+
+func (o BankAccountInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BankAccountType == nil {
+		return nil, fmt.Errorf("field `BankAccountType` is required and must be specified in BankAccountInfo")
+	}
+	toSerialize["bank_account_type"] = o.BankAccountType
+
+	if o.AccountName == nil {
+		return nil, fmt.Errorf("field `AccountName` is required and must be specified in BankAccountInfo")
+	}
+	toSerialize["account_name"] = o.AccountName
+
+	if o.AccountBank == nil {
+		return nil, fmt.Errorf("field `AccountBank` is required and must be specified in BankAccountInfo")
+	}
+	toSerialize["account_bank"] = o.AccountBank
+
+	if o.BankAddressCode == nil {
+		return nil, fmt.Errorf("field `BankAddressCode` is required and must be specified in BankAccountInfo")
+	}
+	toSerialize["bank_address_code"] = o.BankAddressCode
+
+	if o.BankName != nil {
+		toSerialize["bank_name"] = o.BankName
+	}
+
+	if o.AccountNumber == nil {
+		return nil, fmt.Errorf("field `AccountNumber` is required and must be specified in BankAccountInfo")
+	}
+	toSerialize["account_number"] = o.AccountNumber
+	return json.Marshal(toSerialize)
+}
+
+func (o BankAccountInfo) String() string {
+	var ret string
+	if o.BankAccountType == nil {
+		ret += "BankAccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankAccountType:%v, ", *o.BankAccountType)
+	}
+
+	if o.AccountName == nil {
+		ret += "AccountName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountName:%v, ", *o.AccountName)
+	}
+
+	if o.AccountBank == nil {
+		ret += "AccountBank:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountBank:%v, ", *o.AccountBank)
+	}
+
+	if o.BankAddressCode == nil {
+		ret += "BankAddressCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankAddressCode:%v, ", *o.BankAddressCode)
+	}
+
+	if o.BankName == nil {
+		ret += "BankName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankName:%v, ", *o.BankName)
+	}
+
+	if o.AccountNumber == nil {
+		ret += "AccountNumber:<nil>"
+	} else {
+		ret += fmt.Sprintf("AccountNumber:%v", *o.AccountNumber)
+	}
+
+	return fmt.Sprintf("BankAccountInfo{%s}", ret)
+}
+
+func (o BankAccountInfo) Clone() *BankAccountInfo {
+	ret := BankAccountInfo{}
+
+	if o.BankAccountType != nil {
+		ret.BankAccountType = new(BankAccountType)
+		*ret.BankAccountType = *o.BankAccountType
+	}
+
+	if o.AccountName != nil {
+		ret.AccountName = new(string)
+		*ret.AccountName = *o.AccountName
+	}
+
+	if o.AccountBank != nil {
+		ret.AccountBank = new(string)
+		*ret.AccountBank = *o.AccountBank
+	}
+
+	if o.BankAddressCode != nil {
+		ret.BankAddressCode = new(string)
+		*ret.BankAddressCode = *o.BankAddressCode
+	}
+
+	if o.BankName != nil {
+		ret.BankName = new(string)
+		*ret.BankName = *o.BankName
+	}
+
+	if o.AccountNumber != nil {
+		ret.AccountNumber = new(string)
+		*ret.AccountNumber = *o.AccountNumber
+	}
+
+	return &ret
+}
+
+// BankAccountType * `BANK_ACCOUNT_TYPE_CORPORATE` - 对公银行账户 * `BANK_ACCOUNT_TYPE_PERSONAL` - 经营者个人银行卡
+type BankAccountType string
+
+func (e BankAccountType) Ptr() *BankAccountType {
+	return &e
+}
+
+// Enums of BankAccountType
+const (
+	BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_CORPORATE BankAccountType = "BANK_ACCOUNT_TYPE_CORPORATE"
+	BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_PERSONAL  BankAccountType = "BANK_ACCOUNT_TYPE_PERSONAL"
+)
+
+func (v *BankAccountType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := BankAccountType(value)
+	for _, existing := range []BankAccountType{"BANK_ACCOUNT_TYPE_CORPORATE", "BANK_ACCOUNT_TYPE_PERSONAL"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid BankAccountType", value)
+}
+
+// BizStoreInfo
+type BizStoreInfo struct {
+	// 线下场所名称
+	BizStoreName *string `json:"biz_store_name"`
+	// 线下场所省市编码
+	BizAddressCode *string `json:"biz_address_code"`
+	// 线下场所地址
+	BizStoreAddress *string `json:"biz_store_address"`
+	// 线下场所门头照片，需使用图片上传接口预先上传图片获取 media_id
+	StoreEntrancePic []string `json:"store_entrance_pic"`
+	// 线下场所内部照片，需使用图片上传接口预先上传图片获取 media_id
+	IndoorPic []string `json:"indoor_pic"`
+}
+
+func (o BizStoreInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BizStoreName == nil {
+		return nil, fmt.Errorf("field `BizStoreName` is required and must be specified in BizStoreInfo")
+	}
+	toSerialize["biz_store_name"] = o.BizStoreName
+
+	if o.BizAddressCode == nil {
+		return nil, fmt.Errorf("field `BizAddressCode` is required and must be specified in BizStoreInfo")
+	}
+	toSerialize["biz_address_code"] = o.BizAddressCode
+
+	if o.BizStoreAddress == nil {
+		return nil, fmt.Errorf("field `BizStoreAddress` is required and must be specified in BizStoreInfo")
+	}
+	toSerialize["biz_store_address"] = o.BizStoreAddress
+
+	if o.StoreEntrancePic == nil {
+		return nil, fmt.Errorf("field `StoreEntrancePic` is required and must be specified in BizStoreInfo")
+	}
+	toSerialize["store_entrance_pic"] = o.StoreEntrancePic
+
+	if o.IndoorPic == nil {
+		return nil, fmt.Errorf("field `IndoorPic` is required and must be specified in BizStoreInfo")
+	}
+	toSerialize["indoor_pic"] = o.IndoorPic
+	return json.Marshal(toSerialize)
+}
+
+func (o BizStoreInfo) String() string {
+	var ret string
+	if o.BizStoreName == nil {
+		ret += "BizStoreName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BizStoreName:%v, ", *o.BizStoreName)
+	}
+
+	if o.BizAddressCode == nil {
+		ret += "BizAddressCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BizAddressCode:%v, ", *o.BizAddressCode)
+	}
+
+	if o.BizStoreAddress == nil {
+		ret += "BizStoreAddress:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BizStoreAddress:%v, ", *o.BizStoreAddress)
+	}
+
+	ret += fmt.Sprintf("StoreEntrancePic:%v, ", o.StoreEntrancePic)
+
+	ret += fmt.Sprintf("IndoorPic:%v", o.IndoorPic)
+
+	return fmt.Sprintf("BizStoreInfo{%s}", ret)
+}
+
+func (o BizStoreInfo) Clone() *BizStoreInfo {
+	ret := BizStoreInfo{}
+
+	if o.BizStoreName != nil {
+		ret.BizStoreName = new(string)
+		*ret.BizStoreName = *o.BizStoreName
+	}
+
+	if o.BizAddressCode != nil {
+		ret.BizAddressCode = new(string)
+		*ret.BizAddressCode = *o.BizAddressCode
+	}
+
+	if o.BizStoreAddress != nil {
+		ret.BizStoreAddress = new(string)
+		*ret.BizStoreAddress = *o.BizStoreAddress
+	}
+
+	if o.StoreEntrancePic != nil {
+		ret.StoreEntrancePic = make([]string, len(o.StoreEntrancePic))
+		for i, item := range o.StoreEntrancePic {
+			ret.StoreEntrancePic[i] = item
+		}
+	}
+
+	if o.IndoorPic != nil {
+		ret.IndoorPic = make([]string, len(o.IndoorPic))
+		for i, item := range o.IndoorPic {
+			ret.IndoorPic[i] = item
+		}
+	}
+
+	return &ret
+}
+
+// BusinessInfo
+type BusinessInfo struct {
+	// 商户简称，在支付完成页向买家展示
+	MerchantShortname *string `json:"merchant_shortname"`
+	// 客服电话，将在交易记录中向买家展示
+	ServicePhone *string `json:"service_phone"`
+	// 经营场景
+	SalesInfo *SalesInfo `json:"sales_info"`
+}
+
+func (o BusinessInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.MerchantShortname == nil {
+		return nil, fmt.Errorf("field `MerchantShortname` is required and must be specified in BusinessInfo")
+	}
+	toSerialize["merchant_shortname"] = o.MerchantShortname
+
+	if o.ServicePhone == nil {
+		return nil, fmt.Errorf("field `ServicePhone` is required and must be specified in BusinessInfo")
+	}
+	toSerialize["service_phone"] = o.ServicePhone
+
+	if o.SalesInfo == nil {
+		return nil, fmt.Errorf("field `SalesInfo` is required and must be specified in BusinessInfo")
+	}
+	toSerialize["sales_info"] = o.SalesInfo
+	return json.Marshal(toSerialize)
+}
+
+func (o BusinessInfo) String() string {
+	var ret string
+	if o.MerchantShortname == nil {
+		ret += "MerchantShortname:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantShortname:%v, ", *o.MerchantShortname)
+	}
+
+	if o.ServicePhone == nil {
+		ret += "ServicePhone:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServicePhone:%v, ", *o.ServicePhone)
+	}
+
+	ret += fmt.Sprintf("SalesInfo:%v", o.SalesInfo)
+
+	return fmt.Sprintf("BusinessInfo{%s}", ret)
+}
+
+func (o BusinessInfo) Clone() *BusinessInfo {
+	ret := BusinessInfo{}
+
+	if o.MerchantShortname != nil {
+		ret.MerchantShortname = new(string)
+		*ret.MerchantShortname = *o.MerchantShortname
+	}
+
+	if o.ServicePhone != nil {
+		ret.ServicePhone = new(string)
+		*ret.ServicePhone = *o.ServicePhone
+	}
+
+	if o.SalesInfo != nil {
+		ret.SalesInfo = o.SalesInfo.Clone()
+	}
+
+	return &ret
+}
+
+// BusinessLicenseInfo
+type BusinessLicenseInfo struct {
+	// 营业执照照片，需使用图片上传接口预先上传图片获取 media_id
+	LicenseCopy *string `json:"license_copy"`
+	// 注册号或统一社会信用代码
+	LicenseNumber *string `json:"license_number"`
+	// 商户名称，需与营业执照上的商户名称一致
+	MerchantName *string `json:"merchant_name"`
+	// 个体户经营者或法人姓名
+	LegalPerson *string `json:"legal_person"`
+}
+
+func (o BusinessLicenseInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.LicenseCopy == nil {
+		return nil, fmt.Errorf("field `LicenseCopy` is required and must be specified in BusinessLicenseInfo")
+	}
+	toSerialize["license_copy"] = o.LicenseCopy
+
+	if o.LicenseNumber == nil {
+		return nil, fmt.Errorf("field `LicenseNumber` is required and must be specified in BusinessLicenseInfo")
+	}
+	toSerialize["license_number"] = o.LicenseNumber
+
+	if o.MerchantName == nil {
+		return nil, fmt.Errorf("field `MerchantName` is required and must be specified in BusinessLicenseInfo")
+	}
+	toSerialize["merchant_name"] = o.MerchantName
+
+	if o.LegalPerson == nil {
+		return nil, fmt.Errorf("field `LegalPerson` is required and must be specified in BusinessLicenseInfo")
+	}
+	toSerialize["legal_person"] = o.LegalPerson
+	return json.Marshal(toSerialize)
+}
+
+func (o BusinessLicenseInfo) String() string {
+	var ret string
+	if o.LicenseCopy == nil {
+		ret += "LicenseCopy:<nil>, "
+	} else {
+		ret += fmt.Sprintf("LicenseCopy:%v, ", *o.LicenseCopy)
+	}
+
+	if o.LicenseNumber == nil {
+		ret += "LicenseNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("LicenseNumber:%v, ", *o.LicenseNumber)
+	}
+
+	if o.MerchantName == nil {
+		ret += "MerchantName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantName:%v, ", *o.MerchantName)
+	}
+
+	if o.LegalPerson == nil {
+		ret += "LegalPerson:<nil>"
+	} else {
+		ret += fmt.Sprintf("LegalPerson:%v", *o.LegalPerson)
+	}
+
+	return fmt.Sprintf("BusinessLicenseInfo{%s}", ret)
+}
+
+func (o BusinessLicenseInfo) Clone() *BusinessLicenseInfo {
+	ret := BusinessLicenseInfo{}
+
+	if o.LicenseCopy != nil {
+		ret.LicenseCopy = new(string)
+		*ret.LicenseCopy = *o.LicenseCopy
+	}
+
+	if o.LicenseNumber != nil {
+		ret.LicenseNumber = new(string)
+		*ret.LicenseNumber = *o.LicenseNumber
+	}
+
+	if o.MerchantName != nil {
+		ret.MerchantName = new(string)
+		*ret.MerchantName = *o.MerchantName
+	}
+
+	if o.LegalPerson != nil {
+		ret.LegalPerson = new(string)
+		*ret.LegalPerson = *o.LegalPerson
+	}
+
+	return &ret
+}
+
+// ContactInfo
+type ContactInfo struct {
+	// 超级管理员姓名。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	ContactName *string `json:"contact_name" encryption:"EM_APIV3"`
+	// 超级管理员身份证件号码。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	ContactIdNumber *string `json:"contact_id_number,omitempty" encryption:"EM_APIV3"`
+	// 联系手机。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	MobilePhone *string `json:"mobile_phone" encryption:"EM_APIV3"`
+	// 联系邮箱。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	ContactEmail *string `json:"contact_email" encryption:"EM_APIV3"`
+}
+
+func (o ContactInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ContactName == nil {
+		return nil, fmt.Errorf("field `ContactName` is required and must be specified in ContactInfo")
+	}
+	toSerialize["contact_name"] = o.ContactName
+
+	if o.ContactIdNumber != nil {
+		toSerialize["contact_id_number"] = o.ContactIdNumber
+	}
+
+	if o.MobilePhone == nil {
+		return nil, fmt.Errorf("field `MobilePhone` is required and must be specified in ContactInfo")
+	}
+	toSerialize["mobile_phone"] = o.MobilePhone
+
+	if o.ContactEmail == nil {
+		return nil, fmt.Errorf("field `ContactEmail` is required and must be specified in ContactInfo")
+	}
+	toSerialize["contact_email"] = o.ContactEmail
+	return json.Marshal(toSerialize)
+}
+
+func (o ContactInfo) String() string {
+	var ret string
+	if o.ContactName == nil {
+		ret += "ContactName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContactName:%v, ", *o.ContactName)
+	}
+
+	if o.ContactIdNumber == nil {
+		ret += "ContactIdNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContactIdNumber:%v, ", *o.ContactIdNumber)
+	}
+
+	if o.MobilePhone == nil {
+		ret += "MobilePhone:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MobilePhone:%v, ", *o.MobilePhone)
+	}
+
+	if o.ContactEmail == nil {
+		ret += "ContactEmail:<nil>"
+	} else {
+		ret += fmt.Sprintf("ContactEmail:%v", *o.ContactEmail)
+	}
+
+	return fmt.Sprintf("ContactInfo{%s}", ret)
+}
+
+func (o ContactInfo) Clone() *ContactInfo {
+	ret := ContactInfo{}
+
+	if o.ContactName != nil {
+		ret.ContactName = new(string)
+		*ret.ContactName = *o.ContactName
+	}
+
+	if o.ContactIdNumber != nil {
+		ret.ContactIdNumber = new(string)
+		*ret.ContactIdNumber = *o.ContactIdNumber
+	}
+
+	if o.MobilePhone != nil {
+		ret.MobilePhone = new(string)
+		*ret.MobilePhone = *o.MobilePhone
+	}
+
+	if o.ContactEmail != nil {
+		ret.ContactEmail = new(string)
+		*ret.ContactEmail = *o.ContactEmail
+	}
+
+	return &ret
+}
+
+// IdCardInfo
+type IdCardInfo struct {
+	// 身份证人像面照片，需使用图片上传接口预先上传图片获取 media_id
+	IdCardCopy *string `json:"id_card_copy"`
+	// 身份证国徽面照片，需使用图片上传接口预先上传图片获取 media_id
+	IdCardNational *string `json:"id_card_national"`
+	// 身份证姓名。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	IdCardName *string `json:"id_card_name" encryption:"EM_APIV3"`
+	// 身份证号码。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	IdCardNumber *string `json:"id_card_number" encryption:"EM_APIV3"`
+	// 身份证有效期开始时间，格式为yyyy-MM-dd
+	CardPeriodBegin *string `json:"card_period_begin"`
+	// 身份证有效期结束时间，格式为yyyy-MM-dd，长期有效时填写“长期”
+	CardPeriodEnd *string `json:"card_period_end"`
+}
+
+func (o IdCardInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.IdCardCopy == nil {
+		return nil, fmt.Errorf("field `IdCardCopy` is required and must be specified in IdCardInfo")
+	}
+	toSerialize["id_card_copy"] = o.IdCardCopy
+
+	if o.IdCardNational == nil {
+		return nil, fmt.Errorf("field `IdCardNational` is required and must be specified in IdCardInfo")
+	}
+	toSerialize["id_card_national"] = o.IdCardNational
+
+	if o.IdCardName == nil {
+		return nil, fmt.Errorf("field `IdCardName` is required and must be specified in IdCardInfo")
+	}
+	toSerialize["id_card_name"] = o.IdCardName
+
+	if o.IdCardNumber == nil {
+		return nil, fmt.Errorf("field `IdCardNumber` is required and must be specified in IdCardInfo")
+	}
+	toSerialize["id_card_number"] = o.IdCardNumber
+
+	if o.CardPeriodBegin == nil {
+		return nil, fmt.Errorf("field `CardPeriodBegin` is required and must be specified in IdCardInfo")
+	}
+	toSerialize["card_period_begin"] = o.CardPeriodBegin
+
+	if o.CardPeriodEnd == nil {
+		return nil, fmt.Errorf("field `CardPeriodEnd` is required and must be specified in IdCardInfo")
+	}
+	toSerialize["card_period_end"] = o.CardPeriodEnd
+	return json.Marshal(toSerialize)
+}
+
+func (o IdCardInfo) String() string {
+	var ret string
+	if o.IdCardCopy == nil {
+		ret += "IdCardCopy:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IdCardCopy:%v, ", *o.IdCardCopy)
+	}
+
+	if o.IdCardNational == nil {
+		ret += "IdCardNational:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IdCardNational:%v, ", *o.IdCardNational)
+	}
+
+	if o.IdCardName == nil {
+		ret += "IdCardName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IdCardName:%v, ", *o.IdCardName)
+	}
+
+	if o.IdCardNumber == nil {
+		ret += "IdCardNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IdCardNumber:%v, ", *o.IdCardNumber)
+	}
+
+	if o.CardPeriodBegin == nil {
+		ret += "CardPeriodBegin:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CardPeriodBegin:%v, ", *o.CardPeriodBegin)
+	}
+
+	if o.CardPeriodEnd == nil {
+		ret += "CardPeriodEnd:<nil>"
+	} else {
+		ret += fmt.Sprintf("CardPeriodEnd:%v", *o.CardPeriodEnd)
+	}
+
+	return fmt.Sprintf("IdCardInfo{%s}", ret)
+}
+
+func (o IdCardInfo) Clone() *IdCardInfo {
+	ret := IdCardInfo{}
+
+	if o.IdCardCopy != nil {
+		ret.IdCardCopy = new(string)
+		*ret.IdCardCopy = *o.IdCardCopy
+	}
+
+	if o.IdCardNational != nil {
+		ret.IdCardNational = new(string)
+		*ret.IdCardNational = *o.IdCardNational
+	}
+
+	if o.IdCardName != nil {
+		ret.IdCardName = new(string)
+		*ret.IdCardName = *o.IdCardName
+	}
+
+	if o.IdCardNumber != nil {
+		ret.IdCardNumber = new(string)
+		*ret.IdCardNumber = *o.IdCardNumber
+	}
+
+	if o.CardPeriodBegin != nil {
+		ret.CardPeriodBegin = new(string)
+		*ret.CardPeriodBegin = *o.CardPeriodBegin
+	}
+
+	if o.CardPeriodEnd != nil {
+		ret.CardPeriodEnd = new(string)
+		*ret.CardPeriodEnd = *o.CardPeriodEnd
+	}
+
+	return &ret
+}
+
+// IdDocType * `IDENTIFICATION_TYPE_IDCARD` - 中国大陆居民-身份证 * `IDENTIFICATION_TYPE_OVERSEA_PASSPORT` - 其他国家或地区居民-护照 * `IDENTIFICATION_TYPE_HONGKONG_PASSPORT` - 中国香港居民-来往内地通行证 * `IDENTIFICATION_TYPE_MACAO_PASSPORT` - 中国澳门居民-来往内地通行证 * `IDENTIFICATION_TYPE_TAIWAN_PASSPORT` - 中国台湾居民-来往大陆通行证
+type IdDocType string
+
+func (e IdDocType) Ptr() *IdDocType {
+	return &e
+}
+
+// Enums of IdDocType
+const (
+	IDDOCTYPE_IDENTIFICATION_TYPE_IDCARD            IdDocType = "IDENTIFICATION_TYPE_IDCARD"
+	IDDOCTYPE_IDENTIFICATION_TYPE_OVERSEA_PASSPORT  IdDocType = "IDENTIFICATION_TYPE_OVERSEA_PASSPORT"
+	IDDOCTYPE_IDENTIFICATION_TYPE_HONGKONG_PASSPORT IdDocType = "IDENTIFICATION_TYPE_HONGKONG_PASSPORT"
+	IDDOCTYPE_IDENTIFICATION_TYPE_MACAO_PASSPORT    IdDocType = "IDENTIFICATION_TYPE_MACAO_PASSPORT"
+	IDDOCTYPE_IDENTIFICATION_TYPE_TAIWAN_PASSPORT   IdDocType = "IDENTIFICATION_TYPE_TAIWAN_PASSPORT"
+)
+
+func (v *IdDocType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := IdDocType(value)
+	for _, existing := range []IdDocType{"IDENTIFICATION_TYPE_IDCARD", "IDENTIFICATION_TYPE_OVERSEA_PASSPORT", "IDENTIFICATION_TYPE_HONGKONG_PASSPORT", "IDENTIFICATION_TYPE_MACAO_PASSPORT", "IDENTIFICATION_TYPE_TAIWAN_PASSPORT"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid IdDocType", value)
+}
+
+// IdentityInfo
+type IdentityInfo struct {
+	// 证件类型
+	IdDocType *IdDocType `json:"id_doc_type"`
+	// 身份证信息，证件类型为 IDENTIFICATION_TYPE_IDCARD 时必填
+	IdCardInfo *IdCardInfo `json:"id_card_info,omitempty"`
+	// 经营者或法人是否为受益人
+	Owner *bool `json:"owner"`
+}
+
+func (o IdentityInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.IdDocType == nil {
+		return nil, fmt.Errorf("field `IdDocType` is required and must be specified in IdentityInfo")
+	}
+	toSerialize["id_doc_type"] = o.IdDocType
+
+	if o.IdCardInfo != nil {
+		toSerialize["id_card_info"] = o.IdCardInfo
+	}
+
+	if o.Owner == nil {
+		return nil, fmt.Errorf("field `Owner` is required and must be specified in IdentityInfo")
+	}
+	toSerialize["owner"] = o.Owner
+	return json.Marshal(toSerialize)
+}
+
+func (o IdentityInfo) String() string {
+	var ret string
+	if o.IdDocType == nil {
+		ret += "IdDocType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IdDocType:%v, ", *o.IdDocType)
+	}
+
+	ret += fmt.Sprintf("IdCardInfo:%v, ", o.IdCardInfo)
+
+	if o.Owner == nil {
+		ret += "Owner:<nil>"
+	} else {
+		ret += fmt.Sprintf("Owner:%v", *o.Owner)
+	}
+
+	return fmt.Sprintf("IdentityInfo{%s}", ret)
+}
+
+func (o IdentityInfo) Clone() *IdentityInfo {
+	ret := IdentityInfo{}
+
+	if o.IdDocType != nil {
+		ret.IdDocType = new(IdDocType)
+		*ret.IdDocType = *o.IdDocType
+	}
+
+	if o.IdCardInfo != nil {
+		ret.IdCardInfo = o.IdCardInfo.Clone()
+	}
+
+	if o.Owner != nil {
+		ret.Owner = new(bool)
+		*ret.Owner = *o.Owner
+	}
+
+	return &ret
+}
+
+// MpInfo
+type MpInfo struct {
+	// 服务商公众号APPID
+	MpAppid *string `json:"mp_appid,omitempty"`
+	// 商家公众号APPID
+	MpSubAppid *string `json:"mp_sub_appid,omitempty"`
+	// 公众号页面截图，需使用图片上传接口预先上传图片获取 media_id
+	MpPics []string `json:"mp_pics"`
+}
+
+func (o MpInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.MpAppid != nil {
+		toSerialize["mp_appid"] = o.MpAppid
+	}
+
+	if o.MpSubAppid != nil {
+		toSerialize["mp_sub_appid"] = o.MpSubAppid
+	}
+
+	if o.MpPics == nil {
+		return nil, fmt.Errorf("field `MpPics` is required and must be specified in MpInfo")
+	}
+	toSerialize["mp_pics"] = o.MpPics
+	return json.Marshal(toSerialize)
+}
+
+func (o MpInfo) String() string {
+	var ret string
+	if o.MpAppid == nil {
+		ret += "MpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MpAppid:%v, ", *o.MpAppid)
+	}
+
+	if o.MpSubAppid == nil {
+		ret += "MpSubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MpSubAppid:%v, ", *o.MpSubAppid)
+	}
+
+	ret += fmt.Sprintf("MpPics:%v", o.MpPics)
+
+	return fmt.Sprintf("MpInfo{%s}", ret)
+}
+
+func (o MpInfo) Clone() *MpInfo {
+	ret := MpInfo{}
+
+	if o.MpAppid != nil {
+		ret.MpAppid = new(string)
+		*ret.MpAppid = *o.MpAppid
+	}
+
+	if o.MpSubAppid != nil {
+		ret.MpSubAppid = new(string)
+		*ret.MpSubAppid = *o.MpSubAppid
+	}
+
+	if o.MpPics != nil {
+		ret.MpPics = make([]string, len(o.MpPics))
+		for i, item := range o.MpPics {
+			ret.MpPics[i] = item
+		}
+	}
+
+	return &ret
+}
+
+// QueryApplymentByBusinessCodeRequest
+type QueryApplymentByBusinessCodeRequest struct {
+	// 服务商自定义的唯一编号，每个编号对应一个申请单
+	BusinessCode *string `json:"business_code"`
+}
+
+func (o QueryApplymentByBusinessCodeRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BusinessCode == nil {
+		return nil, fmt.Errorf("field `BusinessCode` is required and must be specified in QueryApplymentByBusinessCodeRequest")
+	}
+	toSerialize["business_code"] = o.BusinessCode
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryApplymentByBusinessCodeRequest) String() string {
+	var ret string
+	if o.BusinessCode == nil {
+		ret += "BusinessCode:<nil>"
+	} else {
+		ret += fmt.Sprintf("BusinessCode:%v", *o.BusinessCode)
+	}
+
+	return fmt.Sprintf("QueryApplymentByBusinessCodeRequest{%s}", ret)
+}
+
+func (o QueryApplymentByBusinessCodeRequest) Clone() *QueryApplymentByBusinessCodeRequest {
+	ret := QueryApplymentByBusinessCodeRequest{}
+
+	if o.BusinessCode != nil {
+		ret.BusinessCode = new(string)
+		*ret.BusinessCode = *o.BusinessCode
+	}
+
+	return &ret
+}
+
+// QueryApplymentByIdRequest
+type QueryApplymentByIdRequest struct {
+	// 微信支付分配的申请单号
+	ApplymentId *int64 `json:"applyment_id"`
+}
+
+func (o QueryApplymentByIdRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ApplymentId == nil {
+		return nil, fmt.Errorf("field `ApplymentId` is required and must be specified in QueryApplymentByIdRequest")
+	}
+	toSerialize["applyment_id"] = o.ApplymentId
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryApplymentByIdRequest) String() string {
+	var ret string
+	if o.ApplymentId == nil {
+		ret += "ApplymentId:<nil>"
+	} else {
+		ret += fmt.Sprintf("ApplymentId:%v", *o.ApplymentId)
+	}
+
+	return fmt.Sprintf("QueryApplymentByIdRequest{%s}", ret)
+}
+
+func (o QueryApplymentByIdRequest) Clone() *QueryApplymentByIdRequest {
+	ret := QueryApplymentByIdRequest{}
+
+	if o.ApplymentId != nil {
+		ret.ApplymentId = new(int64)
+		*ret.ApplymentId = *o.ApplymentId
+	}
+
+	return &ret
+}
+
+// SalesInfo
+type SalesInfo struct {
+	// 经营场景类型，如 SALES_SCENES_STORE（线下场所）、SALES_SCENES_MP（公众号）、SALES_SCENES_MINI_PROGRAM（小程序）
+	SalesScenesType []string `json:"sales_scenes_type"`
+	// 线下场所场景，经营场景包含 SALES_SCENES_STORE 时必填
+	BizStoreInfo *BizStoreInfo `json:"biz_store_info,omitempty"`
+	// 公众号场景，经营场景包含 SALES_SCENES_MP 时必填
+	MpInfo *MpInfo `json:"mp_info,omitempty"`
+}
+
+func (o SalesInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SalesScenesType == nil {
+		return nil, fmt.Errorf("field `SalesScenesType` is required and must be specified in SalesInfo")
+	}
+	toSerialize["sales_scenes_type"] = o.SalesScenesType
+
+	if o.BizStoreInfo != nil {
+		toSerialize["biz_store_info"] = o.BizStoreInfo
+	}
+
+	if o.MpInfo != nil {
+		toSerialize["mp_info"] = o.MpInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SalesInfo) String() string {
+	var ret string
+	ret += fmt.Sprintf("SalesScenesType:%v, ", o.SalesScenesType)
+
+	ret += fmt.Sprintf("BizStoreInfo:%v, ", o.BizStoreInfo)
+
+	ret += fmt.Sprintf("MpInfo:%v", o.MpInfo)
+
+	return fmt.Sprintf("SalesInfo{%s}", ret)
+}
+
+func (o SalesInfo) Clone() *SalesInfo {
+	ret := SalesInfo{}
+
+	if o.SalesScenesType != nil {
+		ret.SalesScenesType = make([]string, len(o.SalesScenesType))
+		for i, item := range o.SalesScenesType {
+			ret.SalesScenesType[i] = item
+		}
+	}
+
+	if o.BizStoreInfo != nil {
+		ret.BizStoreInfo = o.BizStoreInfo.Clone()
+	}
+
+	if o.MpInfo != nil {
+		ret.MpInfo = o.MpInfo.Clone()
+	}
+
+	return &ret
+}
+
+// SettlementInfo
+type SettlementInfo struct {
+	// 入驻结算规则ID
+	SettlementId *string `json:"settlement_id"`
+	// 所属行业
+	QualificationType *string `json:"qualification_type"`
+	// 特殊资质图片，需使用图片上传接口预先上传图片获取 media_id
+	Qualifications []string `json:"qualifications,omitempty"`
+	// 优惠费率活动ID
+	ActivitiesId *string `json:"activities_id,omitempty"`
+	// 优惠费率活动值
+	ActivitiesRate *string `json:"activities_rate,omitempty"`
+}
+
+func (o SettlementInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SettlementId == nil {
+		return nil, fmt.Errorf("field `SettlementId` is required and must be specified in SettlementInfo")
+	}
+	toSerialize["settlement_id"] = o.SettlementId
+
+	if o.QualificationType == nil {
+		return nil, fmt.Errorf("field `QualificationType` is required and must be specified in SettlementInfo")
+	}
+	toSerialize["qualification_type"] = o.QualificationType
+
+	if o.Qualifications != nil {
+		toSerialize["qualifications"] = o.Qualifications
+	}
+
+	if o.ActivitiesId != nil {
+		toSerialize["activities_id"] = o.ActivitiesId
+	}
+
+	if o.ActivitiesRate != nil {
+		toSerialize["activities_rate"] = o.ActivitiesRate
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SettlementInfo) String() string {
+	var ret string
+	if o.SettlementId == nil {
+		ret += "SettlementId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SettlementId:%v, ", *o.SettlementId)
+	}
+
+	if o.QualificationType == nil {
+		ret += "QualificationType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("QualificationType:%v, ", *o.QualificationType)
+	}
+
+	ret += fmt.Sprintf("Qualifications:%v, ", o.Qualifications)
+
+	if o.ActivitiesId == nil {
+		ret += "ActivitiesId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ActivitiesId:%v, ", *o.ActivitiesId)
+	}
+
+	if o.ActivitiesRate == nil {
+		ret += "ActivitiesRate:<nil>"
+	} else {
+		ret += fmt.Sprintf("ActivitiesRate:%v", *o.ActivitiesRate)
+	}
+
+	return fmt.Sprintf("SettlementInfo{%s}", ret)
+}
+
+func (o SettlementInfo) Clone() *SettlementInfo {
+	ret := SettlementInfo{}
+
+	if o.SettlementId != nil {
+		ret.SettlementId = new(string)
+		*ret.SettlementId = *o.SettlementId
+	}
+
+	if o.QualificationType != nil {
+		ret.QualificationType = new(string)
+		*ret.QualificationType = *o.QualificationType
+	}
+
+	if o.Qualifications != nil {
+		ret.Qualifications = make([]string, len(o.Qualifications))
+		for i, item := range o.Qualifications {
+			ret.Qualifications[i] = item
+		}
+	}
+
+	if o.ActivitiesId != nil {
+		ret.ActivitiesId = new(string)
+		*ret.ActivitiesId = *o.ActivitiesId
+	}
+
+	if o.ActivitiesRate != nil {
+		ret.ActivitiesRate = new(string)
+		*ret.ActivitiesRate = *o.ActivitiesRate
+	}
+
+	return &ret
+}
+
+// SubjectInfo
+type SubjectInfo struct {
+	// 主体类型
+	SubjectType *SubjectType `json:"subject_type"`
+	// 营业执照，主体为个体户或企业时必填
+	BusinessLicenseInfo *BusinessLicenseInfo `json:"business_license_info,omitempty"`
+	// 经营者或法人身份证件
+	IdentityInfo *IdentityInfo `json:"identity_info"`
+}
+
+func (o SubjectInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubjectType == nil {
+		return nil, fmt.Errorf("field `SubjectType` is required and must be specified in SubjectInfo")
+	}
+	toSerialize["subject_type"] = o.SubjectType
+
+	if o.BusinessLicenseInfo != nil {
+		toSerialize["business_license_info"] = o.BusinessLicenseInfo
+	}
+
+	if o.IdentityInfo == nil {
+		return nil, fmt.Errorf("field `IdentityInfo` is required and must be specified in SubjectInfo")
+	}
+	toSerialize["identity_info"] = o.IdentityInfo
+	return json.Marshal(toSerialize)
+}
+
+func (o SubjectInfo) String() string {
+	var ret string
+	if o.SubjectType == nil {
+		ret += "SubjectType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubjectType:%v, ", *o.SubjectType)
+	}
+
+	ret += fmt.Sprintf("BusinessLicenseInfo:%v, ", o.BusinessLicenseInfo)
+
+	ret += fmt.Sprintf("IdentityInfo:%v", o.IdentityInfo)
+
+	return fmt.Sprintf("SubjectInfo{%s}", ret)
+}
+
+func (o SubjectInfo) Clone() *SubjectInfo {
+	ret := SubjectInfo{}
+
+	if o.SubjectType != nil {
+		ret.SubjectType = new(SubjectType)
+		*ret.SubjectType = *o.SubjectType
+	}
+
+	if o.BusinessLicenseInfo != nil {
+		ret.BusinessLicenseInfo = o.BusinessLicenseInfo.Clone()
+	}
+
+	if o.IdentityInfo != nil {
+		ret.IdentityInfo = o.IdentityInfo.Clone()
+	}
+
+	return &ret
+}
+
+// SubjectType * `SUBJECT_TYPE_INDIVIDUAL` - 个体户 * `SUBJECT_TYPE_ENTERPRISE` - 企业 * `SUBJECT_TYPE_GOVERNMENT` - 政府机关 * `SUBJECT_TYPE_INSTITUTIONS` - 事业单位 * `SUBJECT_TYPE_OTHERS` - 社会组织
+type SubjectType string
+
+func (e SubjectType) Ptr() *SubjectType {
+	return &e
+}
+
+// Enums of SubjectType
+const (
+	SUBJECTTYPE_SUBJECT_TYPE_INDIVIDUAL   SubjectType = "SUBJECT_TYPE_INDIVIDUAL"
+	SUBJECTTYPE_SUBJECT_TYPE_ENTERPRISE   SubjectType = "SUBJECT_TYPE_ENTERPRISE"
+	SUBJECTTYPE_SUBJECT_TYPE_GOVERNMENT   SubjectType = "SUBJECT_TYPE_GOVERNMENT"
+	SUBJECTTYPE_SUBJECT_TYPE_INSTITUTIONS SubjectType = "SUBJECT_TYPE_INSTITUTIONS"
+	SUBJECTTYPE_SUBJECT_TYPE_OTHERS       SubjectType = "SUBJECT_TYPE_OTHERS"
+)
+
+func (v *SubjectType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := SubjectType(value)
+	for _, existing := range []SubjectType{"SUBJECT_TYPE_INDIVIDUAL", "SUBJECT_TYPE_ENTERPRISE", "SUBJECT_TYPE_GOVERNMENT", "SUBJECT_TYPE_INSTITUTIONS", "SUBJECT_TYPE_OTHERS"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid SubjectType", value)
+}
+
+// SubmitApplymentBody
+type SubmitApplymentBody struct {
+	// 服务商自定义的唯一编号，每个编号对应一个申请单。申请单被驳回后重新提交时使用同一编号
+	BusinessCode *string `json:"business_code"`
+	// 超级管理员信息
+	ContactInfo *ContactInfo `json:"contact_info"`
+	// 主体资料
+	SubjectInfo *SubjectInfo `json:"subject_info"`
+	// 经营资料
+	BusinessInfo *BusinessInfo `json:"business_info"`
+	// 结算规则
+	SettlementInfo *SettlementInfo `json:"settlement_info"`
+	// 结算银行账户
+	BankAccountInfo *BankAccountInfo `json:"bank_account_info"`
+	// 补充材料
+	AdditionInfo *AdditionInfo `json:"addition_info,omitempty"`
+}
+
+func (o SubmitApplymentBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BusinessCode == nil {
+		return nil, fmt.Errorf("field `BusinessCode` is required and must be specified in SubmitApplymentBody")
+	}
+	toSerialize["business_code"] = o.BusinessCode
+
+	if o.ContactInfo == nil {
+		return nil, fmt.Errorf("field `ContactInfo` is required and must be specified in SubmitApplymentBody")
+	}
+	toSerialize["contact_info"] = o.ContactInfo
+
+	if o.SubjectInfo == nil {
+		return nil, fmt.Errorf("field `SubjectInfo` is required and must be specified in SubmitApplymentBody")
+	}
+	toSerialize["subject_info"] = o.SubjectInfo
+
+	if o.BusinessInfo == nil {
+		return nil, fmt.Errorf("field `BusinessInfo` is required and must be specified in SubmitApplymentBody")
+	}
+	toSerialize["business_info"] = o.BusinessInfo
+
+	if o.SettlementInfo == nil {
+		return nil, fmt.Errorf("field `SettlementInfo` is required and must be specified in SubmitApplymentBody")
+	}
+	toSerialize["settlement_info"] = o.SettlementInfo
+
+	if o.BankAccountInfo == nil {
+		return nil, fmt.Errorf("field `BankAccountInfo` is required and must be specified in SubmitApplymentBody")
+	}
+	toSerialize["bank_account_info"] = o.BankAccountInfo
+
+	if o.AdditionInfo != nil {
+		toSerialize["addition_info"] = o.AdditionInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SubmitApplymentBody) String() string {
+	var ret string
+	if o.BusinessCode == nil {
+		ret += "BusinessCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BusinessCode:%v, ", *o.BusinessCode)
+	}
+
+	ret += fmt.Sprintf("ContactInfo:%v, ", o.ContactInfo)
+
+	ret += fmt.Sprintf("SubjectInfo:%v, ", o.SubjectInfo)
+
+	ret += fmt.Sprintf("BusinessInfo:%v, ", o.BusinessInfo)
+
+	ret += fmt.Sprintf("SettlementInfo:%v, ", o.SettlementInfo)
+
+	ret += fmt.Sprintf("BankAccountInfo:%v, ", o.BankAccountInfo)
+
+	ret += fmt.Sprintf("AdditionInfo:%v", o.AdditionInfo)
+
+	return fmt.Sprintf("SubmitApplymentBody{%s}", ret)
+}
+
+func (o SubmitApplymentBody) Clone() *SubmitApplymentBody {
+	ret := SubmitApplymentBody{}
+
+	if o.BusinessCode != nil {
+		ret.BusinessCode = new(string)
+		*ret.BusinessCode = *o.BusinessCode
+	}
+
+	if o.ContactInfo != nil {
+		ret.ContactInfo = o.ContactInfo.Clone()
+	}
+
+	if o.SubjectInfo != nil {
+		ret.SubjectInfo = o.SubjectInfo.Clone()
+	}
+
+	if o.BusinessInfo != nil {
+		ret.BusinessInfo = o.BusinessInfo.Clone()
+	}
+
+	if o.SettlementInfo != nil {
+		ret.SettlementInfo = o.SettlementInfo.Clone()
+	}
+
+	if o.BankAccountInfo != nil {
+		ret.BankAccountInfo = o.BankAccountInfo.Clone()
+	}
+
+	if o.AdditionInfo != nil {
+		ret.AdditionInfo = o.AdditionInfo.Clone()
+	}
+
+	return &ret
+}
+
+// SubmitApplymentRequest
+type SubmitApplymentRequest struct {
+	// 请求包体中存在敏感信息加密字段时，需传递用于加密的微信支付平台证书序列号
+	WechatpaySerial *string `json:"Wechatpay-Serial,omitempty"`
+	// 服务商自定义的唯一编号，每个编号对应一个申请单。申请单被驳回后重新提交时使用同一编号
+	BusinessCode *string `json:"business_code"`
+	// 超级管理员信息
+	ContactInfo *ContactInfo `json:"contact_info"`
+	// 主体资料
+	SubjectInfo *SubjectInfo `json:"subject_info"`
+	// 经营资料
+	BusinessInfo *BusinessInfo `json:"business_info"`
+	// 结算规则
+	SettlementInfo *SettlementInfo `json:"settlement_info"`
+	// 结算银行账户
+	BankAccountInfo *BankAccountInfo `json:"bank_account_info"`
+	// 补充材料
+	AdditionInfo *AdditionInfo `json:"addition_info,omitempty"`
+}
+
+func (o SubmitApplymentRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.WechatpaySerial != nil {
+		toSerialize["Wechatpay-Serial"] = o.WechatpaySerial
+	}
+
+	if o.BusinessCode == nil {
+		return nil, fmt.Errorf("field `BusinessCode` is required and must be specified in SubmitApplymentRequest")
+	}
+	toSerialize["business_code"] = o.BusinessCode
+
+	if o.ContactInfo == nil {
+		return nil, fmt.Errorf("field `ContactInfo` is required and must be specified in SubmitApplymentRequest")
+	}
+	toSerialize["contact_info"] = o.ContactInfo
+
+	if o.SubjectInfo == nil {
+		return nil, fmt.Errorf("field `SubjectInfo` is required and must be specified in SubmitApplymentRequest")
+	}
+	toSerialize["subject_info"] = o.SubjectInfo
+
+	if o.BusinessInfo == nil {
+		return nil, fmt.Errorf("field `BusinessInfo` is required and must be specified in SubmitApplymentRequest")
+	}
+	toSerialize["business_info"] = o.BusinessInfo
+
+	if o.SettlementInfo == nil {
+		return nil, fmt.Errorf("field `SettlementInfo` is required and must be specified in SubmitApplymentRequest")
+	}
+	toSerialize["settlement_info"] = o.SettlementInfo
+
+	if o.BankAccountInfo == nil {
+		return nil, fmt.Errorf("field `BankAccountInfo` is required and must be specified in SubmitApplymentRequest")
+	}
+	toSerialize["bank_account_info"] = o.BankAccountInfo
+
+	if o.AdditionInfo != nil {
+		toSerialize["addition_info"] = o.AdditionInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SubmitApplymentRequest) String() string {
+	var ret string
+	if o.WechatpaySerial == nil {
+		ret += "WechatpaySerial:<nil>, "
+	} else {
+		ret += fmt.Sprintf("WechatpaySerial:%v, ", *o.WechatpaySerial)
+	}
+
+	if o.BusinessCode == nil {
+		ret += "BusinessCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BusinessCode:%v, ", *o.BusinessCode)
+	}
+
+	ret += fmt.Sprintf("ContactInfo:%v, ", o.ContactInfo)
+
+	ret += fmt.Sprintf("SubjectInfo:%v, ", o.SubjectInfo)
+
+	ret += fmt.Sprintf("BusinessInfo:%v, ", o.BusinessInfo)
+
+	ret += fmt.Sprintf("SettlementInfo:%v, ", o.SettlementInfo)
+
+	ret += fmt.Sprintf("BankAccountInfo:%v, ", o.BankAccountInfo)
+
+	ret += fmt.Sprintf("AdditionInfo:%v", o.AdditionInfo)
+
+	return fmt.Sprintf("SubmitApplymentRequest{%s}", ret)
+}
+
+func (o SubmitApplymentRequest) Clone() *SubmitApplymentRequest {
+	ret := SubmitApplymentRequest{}
+
+	if o.WechatpaySerial != nil {
+		ret.WechatpaySerial = new(string)
+		*ret.WechatpaySerial = *o.WechatpaySerial
+	}
+
+	if o.BusinessCode != nil {
+		ret.BusinessCode = new(string)
+		*ret.BusinessCode = *o.BusinessCode
+	}
+
+	if o.ContactInfo != nil {
+		ret.ContactInfo = o.ContactInfo.Clone()
+	}
+
+	if o.SubjectInfo != nil {
+		ret.SubjectInfo = o.SubjectInfo.Clone()
+	}
+
+	if o.BusinessInfo != nil {
+		ret.BusinessInfo = o.BusinessInfo.Clone()
+	}
+
+	if o.SettlementInfo != nil {
+		ret.SettlementInfo = o.SettlementInfo.Clone()
+	}
+
+	if o.BankAccountInfo != nil {
+		ret.BankAccountInfo = o.BankAccountInfo.Clone()
+	}
+
+	if o.AdditionInfo != nil {
+		ret.AdditionInfo = o.AdditionInfo.Clone()
+	}
+
+	return &ret
+}
+
+// SubmitApplymentResponse
+type SubmitApplymentResponse struct {
+	// 微信支付分配的申请单号
+	ApplymentId *int64 `json:"applyment_id"`
+}
+
+func (o SubmitApplymentResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ApplymentId == nil {
+		return nil, fmt.Errorf("field `ApplymentId` is required and must be specified in SubmitApplymentResponse")
+	}
+	toSerialize["applyment_id"] = o.ApplymentId
+	return json.Marshal(toSerialize)
+}
+
+func (o SubmitApplymentResponse) String() string {
+	var ret string
+	if o.ApplymentId == nil {
+		ret += "ApplymentId:<nil>"
+	} else {
+		ret += fmt.Sprintf("ApplymentId:%v", *o.ApplymentId)
+	}
+
+	return fmt.Sprintf("SubmitApplymentResponse{%s}", ret)
+}
+
+func (o SubmitApplymentResponse) Clone() *SubmitApplymentResponse {
+	ret := SubmitApplymentResponse{}
+
+	if o.ApplymentId != nil {
+		ret.ApplymentId = new(int64)
+		*ret.ApplymentId = *o.ApplymentId
+	}
+
+	return &ret
+}
@@ -0,0 +1,215 @@
+package applyment4sub
+
+import (
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// ApplymentIdentity 经营者/法人身份证件信息
+//
+// 只能通过 NewIdCardIdentity 创建，从而保证证件类型与证件信息中的必填字段始终一并设置。
+type ApplymentIdentity struct {
+	info *IdentityInfo
+}
+
+// NewIdCardIdentity 使用中国大陆居民身份证的全部必填字段创建 ApplymentIdentity
+//
+// idCardCopy、idCardNational 为身份证人像面、国徽面照片的 MediaID；cardPeriodEnd 可为“长期”；
+// owner 表示经营者/法人是否为受益人。
+func NewIdCardIdentity(
+	idCardCopy, idCardNational, idCardName, idCardNumber, cardPeriodBegin, cardPeriodEnd string, owner bool,
+) ApplymentIdentity {
+	return ApplymentIdentity{
+		info: &IdentityInfo{
+			IdDocType: IDDOCTYPE_IDENTIFICATION_TYPE_IDCARD.Ptr(),
+			IdCardInfo: &IdCardInfo{
+				IdCardCopy:      core.String(idCardCopy),
+				IdCardNational:  core.String(idCardNational),
+				IdCardName:      core.String(idCardName),
+				IdCardNumber:    core.String(idCardNumber),
+				CardPeriodBegin: core.String(cardPeriodBegin),
+				CardPeriodEnd:   core.String(cardPeriodEnd),
+			},
+			Owner: core.Bool(owner),
+		},
+	}
+}
+
+// SubmitApplymentRequestBuilder 提交进件申请单请求 SubmitApplymentRequest 构造器
+//
+// 必填参数按申请单的各部分资料分步传入：NewSubmitApplymentRequestBuilder 返回的步骤依次要求超级管理员、主体、
+// 经营、结算规则与结算银行账户资料，全部传入后才能得到 SubmitApplymentRequestBuilder，可选参数再通过链式调用设置，
+// 从而在编译期避免遗漏必填参数，同时避免在一个调用中传入过多相邻的同类型参数。
+type SubmitApplymentRequestBuilder struct {
+	req SubmitApplymentRequest
+}
+
+// SubmitApplymentContactStep 等待传入超级管理员信息
+type SubmitApplymentContactStep struct {
+	req SubmitApplymentRequest
+}
+
+// SubmitApplymentSubjectStep 等待传入主体资料
+type SubmitApplymentSubjectStep struct {
+	req SubmitApplymentRequest
+}
+
+// SubmitApplymentBusinessStep 等待传入经营资料
+type SubmitApplymentBusinessStep struct {
+	req SubmitApplymentRequest
+}
+
+// SubmitApplymentSettlementStep 等待传入结算规则
+type SubmitApplymentSettlementStep struct {
+	req SubmitApplymentRequest
+}
+
+// SubmitApplymentBankAccountStep 等待传入结算银行账户
+type SubmitApplymentBankAccountStep struct {
+	req SubmitApplymentRequest
+}
+
+// NewSubmitApplymentRequestBuilder 开始构造提交进件申请单请求
+//
+// businessCode 为业务申请编号，申请单被驳回后重新提交时使用同一编号。敏感信息传入明文即可，由 Applier.Submit 自动加密。
+func NewSubmitApplymentRequestBuilder(businessCode string) SubmitApplymentContactStep {
+	return SubmitApplymentContactStep{req: SubmitApplymentRequest{BusinessCode: core.String(businessCode)}}
+}
+
+// ContactInfo 传入超级管理员姓名、手机号码与邮箱
+func (s SubmitApplymentContactStep) ContactInfo(contactName, mobilePhone, contactEmail string) SubmitApplymentSubjectStep {
+	s.req.ContactInfo = &ContactInfo{
+		ContactName:  core.String(contactName),
+		MobilePhone:  core.String(mobilePhone),
+		ContactEmail: core.String(contactEmail),
+	}
+	return SubmitApplymentSubjectStep(s)
+}
+
+// SubjectInfo 传入主体类型与经营者/法人身份证件
+func (s SubmitApplymentSubjectStep) SubjectInfo(subjectType SubjectType, identity ApplymentIdentity) SubmitApplymentBusinessStep {
+	subjectInfo := &SubjectInfo{SubjectType: subjectType.Ptr()}
+	if identity.info != nil {
+		subjectInfo.IdentityInfo = identity.info.Clone()
+	}
+	s.req.SubjectInfo = subjectInfo
+	return SubmitApplymentBusinessStep(s)
+}
+
+// BusinessInfo 传入商户简称、客服电话与经营场景类型
+func (s SubmitApplymentBusinessStep) BusinessInfo(
+	merchantShortname, servicePhone string, salesScenesType []string,
+) SubmitApplymentSettlementStep {
+	s.req.BusinessInfo = &BusinessInfo{
+		MerchantShortname: core.String(merchantShortname),
+		ServicePhone:      core.String(servicePhone),
+		SalesInfo:         &SalesInfo{SalesScenesType: append([]string{}, salesScenesType...)},
+	}
+	return SubmitApplymentSettlementStep(s)
+}
+
+// SettlementInfo 传入入驻结算规则ID与所属行业
+func (s SubmitApplymentSettlementStep) SettlementInfo(settlementId, qualificationType string) SubmitApplymentBankAccountStep {
+	s.req.SettlementInfo = &SettlementInfo{
+		SettlementId:      core.String(settlementId),
+		QualificationType: core.String(qualificationType),
+	}
+	return SubmitApplymentBankAccountStep(s)
+}
+
+// BankAccountInfo 传入结算银行账户，完成必填参数并返回 SubmitApplymentRequestBuilder
+//
+// accountBank 为开户银行，bankAddressCode 为开户银行省市编码。
+func (s SubmitApplymentBankAccountStep) BankAccountInfo(
+	bankAccountType BankAccountType, accountName, accountBank, bankAddressCode, accountNumber string,
+) *SubmitApplymentRequestBuilder {
+	s.req.BankAccountInfo = &BankAccountInfo{
+		BankAccountType: bankAccountType.Ptr(),
+		AccountName:     core.String(accountName),
+		AccountBank:     core.String(accountBank),
+		BankAddressCode: core.String(bankAddressCode),
+		AccountNumber:   core.String(accountNumber),
+	}
+	return &SubmitApplymentRequestBuilder{req: *s.req.Clone()}
+}
+
+// WithContactIdNumber 设置超级管理员身份证件号码
+func (b *SubmitApplymentRequestBuilder) WithContactIdNumber(contactIdNumber string) *SubmitApplymentRequestBuilder {
+	b.req.ContactInfo.ContactIdNumber = core.String(contactIdNumber)
+	return b
+}
+
+// WithBusinessLicenseInfo 设置营业执照，主体为个体户或企业时必须设置
+func (b *SubmitApplymentRequestBuilder) WithBusinessLicenseInfo(
+	licenseCopy, licenseNumber, merchantName, legalPerson string,
+) *SubmitApplymentRequestBuilder {
+	b.req.SubjectInfo.BusinessLicenseInfo = &BusinessLicenseInfo{
+		LicenseCopy:   core.String(licenseCopy),
+		LicenseNumber: core.String(licenseNumber),
+		MerchantName:  core.String(merchantName),
+		LegalPerson:   core.String(legalPerson),
+	}
+	return b
+}
+
+// WithBizStoreInfo 设置线下门店场景，经营场景包含 SALES_SCENES_STORE 时必须设置
+func (b *SubmitApplymentRequestBuilder) WithBizStoreInfo(
+	bizStoreName, bizAddressCode, bizStoreAddress string, storeEntrancePic, indoorPic []string,
+) *SubmitApplymentRequestBuilder {
+	b.req.BusinessInfo.SalesInfo.BizStoreInfo = &BizStoreInfo{
+		BizStoreName:     core.String(bizStoreName),
+		BizAddressCode:   core.String(bizAddressCode),
+		BizStoreAddress:  core.String(bizStoreAddress),
+		StoreEntrancePic: append([]string{}, storeEntrancePic...),
+		IndoorPic:        append([]string{}, indoorPic...),
+	}
+	return b
+}
+
+// WithMpInfo 设置公众号场景，经营场景包含 SALES_SCENES_MP 时必须设置；mpAppid、mpSubAppid 为空时不设置
+func (b *SubmitApplymentRequestBuilder) WithMpInfo(mpAppid, mpSubAppid string, mpPics []string) *SubmitApplymentRequestBuilder {
+	mpInfo := &MpInfo{MpPics: append([]string{}, mpPics...)}
+	if mpAppid != "" {
+		mpInfo.MpAppid = core.String(mpAppid)
+	}
+	if mpSubAppid != "" {
+		mpInfo.MpSubAppid = core.String(mpSubAppid)
+	}
+	b.req.BusinessInfo.SalesInfo.MpInfo = mpInfo
+	return b
+}
+
+// WithQualifications 添加特殊资质图片的 MediaID
+func (b *SubmitApplymentRequestBuilder) WithQualifications(qualifications ...string) *SubmitApplymentRequestBuilder {
+	b.req.SettlementInfo.Qualifications = append(b.req.SettlementInfo.Qualifications, qualifications...)
+	return b
+}
+
+// WithActivities 设置优惠费率活动ID与费率值
+func (b *SubmitApplymentRequestBuilder) WithActivities(activitiesId, activitiesRate string) *SubmitApplymentRequestBuilder {
+	b.req.SettlementInfo.ActivitiesId = core.String(activitiesId)
+	b.req.SettlementInfo.ActivitiesRate = core.String(activitiesRate)
+	return b
+}
+
+// WithBankName 设置开户银行全称（含支行），开户银行为“其他银行”时必须设置
+func (b *SubmitApplymentRequestBuilder) WithBankName(bankName string) *SubmitApplymentRequestBuilder {
+	b.req.BankAccountInfo.BankName = core.String(bankName)
+	return b
+}
+
+// WithAdditionInfo 设置补充材料
+func (b *SubmitApplymentRequestBuilder) WithAdditionInfo(additionInfo AdditionInfo) *SubmitApplymentRequestBuilder {
+	b.req.AdditionInfo = additionInfo.Clone()
+	return b
+}
+
+// WithWechatpaySerial 设置加密敏感信息所用的微信支付平台证书序列号，此时敏感信息需已加密；未设置时 Applier.Submit 自动加密
+func (b *SubmitApplymentRequestBuilder) WithWechatpaySerial(wechatpaySerial string) *SubmitApplymentRequestBuilder {
+	b.req.WechatpaySerial = core.String(wechatpaySerial)
+	return b
+}
+
+// Build 生成 SubmitApplymentRequest，每次调用均返回一份独立的副本
+func (b *SubmitApplymentRequestBuilder) Build() SubmitApplymentRequest {
+	return *b.req.Clone()
+}
@@ -0,0 +1,42 @@
+package applyment4sub_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+)
+
+func ExampleNewSubmitApplymentRequestBuilder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client，并已上传证件与门店照片获得 MediaID
+
+	req := applyment4sub.NewSubmitApplymentRequestBuilder("1900013511_10000").
+		ContactInfo("张三", "13800138000", "zhangsan@example.com").
+		SubjectInfo(
+			applyment4sub.SUBJECTTYPE_SUBJECT_TYPE_INDIVIDUAL,
+			applyment4sub.NewIdCardIdentity(
+				"MEDIA_ID_COPY", "MEDIA_ID_NATIONAL", "张三", "110101199003070011", "2010-01-01", "长期", true,
+			),
+		).
+		BusinessInfo("张三小店", "01012345678", []string{"SALES_SCENES_STORE"}).
+		SettlementInfo("719", "餐饮").
+		BankAccountInfo(
+			applyment4sub.BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_PERSONAL, "张三", "工商银行", "110000", "6222000000000000000",
+		).
+		WithBusinessLicenseInfo("MEDIA_ID_LICENSE", "91110000000000000X", "张三的小店", "张三").
+		WithBizStoreInfo(
+			"张三小店", "110000", "北京市东城区某某街道1号", []string{"MEDIA_ID_ENTRANCE"}, []string{"MEDIA_ID_INDOOR"},
+		).
+		Build()
+
+	// 敏感信息为明文，由 Applier.Submit 使用平台证书自动加密
+	applier := applyment4sub.NewApplier(client)
+	resp, result, err := applier.Submit(ctx, req)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,113 @@
+package applyment4sub_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/applyment4sub"
+)
+
+// newTestSubmitBuilder 使用与 testdata/submit_applyment.json 相同的资料初始化构造器
+func newTestSubmitBuilder() *applyment4sub.SubmitApplymentRequestBuilder {
+	return applyment4sub.NewSubmitApplymentRequestBuilder("APPLYMENT_00000000001").
+		ContactInfo("张三", "13800138000", "zhangsan@example.com").
+		SubjectInfo(
+			applyment4sub.SUBJECTTYPE_SUBJECT_TYPE_INDIVIDUAL,
+			applyment4sub.NewIdCardIdentity(
+				"MEDIA_ID_COPY", "MEDIA_ID_NATIONAL", "张三", "110101199003070011", "2010-01-01", "长期", true,
+			),
+		).
+		BusinessInfo("张三小店", "01012345678", []string{"SALES_SCENES_STORE"}).
+		SettlementInfo("719", "餐饮").
+		BankAccountInfo(
+			applyment4sub.BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_PERSONAL, "张三", "工商银行", "110000", "6222000000000000000",
+		).
+		WithContactIdNumber("110101199003070011").
+		WithBusinessLicenseInfo("MEDIA_ID_LICENSE", "91110000000000000X", "张三的小店", "张三").
+		WithBizStoreInfo(
+			"张三小店", "110000", "北京市东城区某某街道1号", []string{"MEDIA_ID_ENTRANCE"}, []string{"MEDIA_ID_INDOOR"},
+		)
+}
+
+func TestSubmitApplymentRequestBuilder_Build(t *testing.T) {
+	want := loadSubmitRequest(t)
+	want.WechatpaySerial = nil
+
+	builder := newTestSubmitBuilder()
+	req := builder.Build()
+	assert.Equal(t, want, req)
+
+	// 每次 Build 返回独立的副本
+	*req.SubjectInfo.IdentityInfo.IdCardInfo.IdCardCopy = "modified"
+	req.BusinessInfo.SalesInfo.SalesScenesType[0] = "modified"
+	again := builder.WithWechatpaySerial("PUB_KEY_ID_TEST").Build()
+	assert.Equal(t, "MEDIA_ID_COPY", *again.SubjectInfo.IdentityInfo.IdCardInfo.IdCardCopy)
+	assert.Equal(t, "SALES_SCENES_STORE", again.BusinessInfo.SalesInfo.SalesScenesType[0])
+	assert.Equal(t, "PUB_KEY_ID_TEST", *again.WechatpaySerial)
+	assert.Nil(t, req.WechatpaySerial)
+}
+
+func TestSubmitApplymentRequestBuilder_BuildRequiredOnly(t *testing.T) {
+	req := applyment4sub.NewSubmitApplymentRequestBuilder("APPLYMENT_00000000002").
+		ContactInfo("李四", "13900139000", "lisi@example.com").
+		SubjectInfo(
+			applyment4sub.SUBJECTTYPE_SUBJECT_TYPE_ENTERPRISE,
+			applyment4sub.NewIdCardIdentity(
+				"MEDIA_ID_COPY", "MEDIA_ID_NATIONAL", "李四", "110101199003070022", "2015-01-01", "2035-01-01", false,
+			),
+		).
+		BusinessInfo("李四科技", "01087654321", []string{"SALES_SCENES_MP"}).
+		SettlementInfo("716", "零售").
+		BankAccountInfo(
+			applyment4sub.BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_CORPORATE, "李四科技有限公司", "其他银行", "110000", "1234567890",
+		).
+		WithMpInfo("wx1234567890", "", []string{"MEDIA_ID_MP"}).
+		WithQualifications("MEDIA_ID_QUALIFICATION").
+		WithActivities("20191030111cff5b5e", "0.6").
+		WithBankName("中国农业银行股份有限公司北京分行").
+		Build()
+
+	assert.Equal(t, applyment4sub.IDDOCTYPE_IDENTIFICATION_TYPE_IDCARD, *req.SubjectInfo.IdentityInfo.IdDocType)
+	assert.False(t, *req.SubjectInfo.IdentityInfo.Owner)
+	assert.Nil(t, req.SubjectInfo.BusinessLicenseInfo)
+	assert.Nil(t, req.ContactInfo.ContactIdNumber)
+	assert.Equal(t, "wx1234567890", *req.BusinessInfo.SalesInfo.MpInfo.MpAppid)
+	assert.Nil(t, req.BusinessInfo.SalesInfo.MpInfo.MpSubAppid)
+	assert.Equal(t, []string{"MEDIA_ID_QUALIFICATION"}, req.SettlementInfo.Qualifications)
+	assert.Equal(t, "0.6", *req.SettlementInfo.ActivitiesRate)
+	assert.Equal(t, "中国农业银行股份有限公司北京分行", *req.BankAccountInfo.BankName)
+
+	// 仅通过构造器设置的字段即可通过 MarshalJSON 的逐层必填校验
+	_, err := json.Marshal(req)
+	require.NoError(t, err)
+}
+
+func TestSubmitApplymentRequestBuilder_StepsAreIndependent(t *testing.T) {
+	settlement := applyment4sub.NewSubmitApplymentRequestBuilder("APPLYMENT_00000000003").
+		ContactInfo("王五", "13700137000", "wangwu@example.com").
+		SubjectInfo(
+			applyment4sub.SUBJECTTYPE_SUBJECT_TYPE_INDIVIDUAL,
+			applyment4sub.NewIdCardIdentity(
+				"MEDIA_ID_COPY", "MEDIA_ID_NATIONAL", "王五", "110101199003070033", "2010-01-01", "长期", true,
+			),
+		).
+		BusinessInfo("王五小店", "01012345679", []string{"SALES_SCENES_STORE"})
+
+	// 同一步骤可以分别完成多个构造器，构造器之间互不影响
+	personal := settlement.SettlementInfo("719", "餐饮").
+		BankAccountInfo(applyment4sub.BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_PERSONAL, "王五", "工商银行", "110000", "6222000000000000001").
+		WithContactIdNumber("110101199003070033")
+	corporate := settlement.SettlementInfo("716", "零售").
+		BankAccountInfo(applyment4sub.BANKACCOUNTTYPE_BANK_ACCOUNT_TYPE_CORPORATE, "王五商行", "建设银行", "110000", "1234567891")
+
+	personalReq, corporateReq := personal.Build(), corporate.Build()
+	assert.Equal(t, "719", *personalReq.SettlementInfo.SettlementId)
+	assert.Equal(t, "716", *corporateReq.SettlementInfo.SettlementId)
+	assert.Equal(t, "王五", *personalReq.BankAccountInfo.AccountName)
+	assert.Equal(t, "王五商行", *corporateReq.BankAccountInfo.AccountName)
+	assert.Equal(t, "110101199003070033", *personalReq.ContactInfo.ContactIdNumber)
+	assert.Nil(t, corporateReq.ContactInfo.ContactIdNumber)
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 账单API
+//
+// 申请交易账单与资金账单，获取账单的下载地址与摘要。  账单文件请使用 core.Client.Download 下载，并使用 NewDigestReader 校验摘要
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package bill
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type FundFlowBillApiService services.Service
+
+// ApplyFundFlowBill 申请资金账单
+//
+// # 应用场景
+// 微信支付按天提供微信支付账户的资金流水账单文件，商户可以通过该接口获取账单文件的下载地址。
+//
+// 注意：
+// 1、资金账单中的数据反映的是商户微信支付账户资金变动情况
+// 2、当日账单在次日上午 9 点开始生成，建议 10 点后再获取
+// 3、资金账单中涉及金额的字段单位为“元”
+// 4、下载地址的有效期为 30s
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NO_STATEMENT_EXIST|账单文件不存在|请求的账单日期已经过期|请检查账单日期|
+// |STATEMENT_CREATING|账单生成中|账单尚未生成|请在10点后再获取|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *FundFlowBillApiService) ApplyFundFlowBill(ctx context.Context, req ApplyFundFlowBillRequest) (resp *QueryBillEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/bill/fundflowbill"
+	// Make sure All Required Params are properly set
+	if req.BillDate == nil {
+		return nil, nil, fmt.Errorf("field `BillDate` is required and must be specified in ApplyFundFlowBillRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("bill_date", core.ParameterToString(*req.BillDate, ""))
+	if req.AccountType != nil {
+		localVarQueryParams.Add("account_type", core.ParameterToString(*req.AccountType, ""))
+	}
+	if req.TarType != nil {
+		localVarQueryParams.Add("tar_type", core.ParameterToString(*req.TarType, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract QueryBillEntity from Http Response
+	resp = new(QueryBillEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
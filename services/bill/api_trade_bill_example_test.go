@@ -0,0 +1,39 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 账单API
+//
+// 申请交易账单与资金账单，获取账单的下载地址与摘要。  账单文件请使用 core.Client.Download 下载，并使用 NewDigestReader 校验摘要
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package bill_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+func ExampleTradeBillApiService_ApplyTradeBill() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := bill.TradeBillApiService{Client: client}
+	resp, result, err := svc.ApplyTradeBill(ctx,
+		bill.ApplyTradeBillRequest{
+			BillDate: core.String("2019-06-11"),
+			BillType: core.String("ALL"),
+			SubMchid: core.String("19000000001"),
+			TarType:  core.String("GZIP"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
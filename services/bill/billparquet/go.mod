@@ -0,0 +1,13 @@
+module github.com/wechatpay-apiv3/wechatpay-go/services/bill/billparquet
+
+go 1.16
+
+require (
+	github.com/stretchr/testify v1.7.0
+	github.com/wechatpay-apiv3/wechatpay-go v0.2.2
+	github.com/xitongsys/parquet-go v1.6.2
+	github.com/xitongsys/parquet-go-source v0.0.0-20200817004010-026bad9b25d0
+)
+
+// 与主模块一同开发与发布
+replace github.com/wechatpay-apiv3/wechatpay-go => ../../..
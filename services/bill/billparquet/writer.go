@@ -0,0 +1,134 @@
+// Package billparquet 将解析后的交易账单导出为 Parquet 格式
+//
+// 本包是独立的 Go Module，依赖 github.com/xitongsys/parquet-go，仅需导出 Parquet 的项目才需要引入，
+// SDK 主模块不会因此增加依赖。
+package billparquet
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+// TradeBillRow 交易账单记录在 Parquet 文件中的行，列名与 bill.TradeBillColumns 一一对应
+//
+// 交易时间为 UTC 毫秒时间戳，账单中没有交易时间时为空；金额以分为单位，其余字段为账单中去除前缀 ` 后的原始内容。
+// 读取导出的文件时可以直接使用本结构。
+type TradeBillRow struct {
+	TradeTime             *int64 `parquet:"name=trade_time, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	Appid                 string `parquet:"name=appid, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Mchid                 string `parquet:"name=mchid, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	SubMchid              string `parquet:"name=sub_mchid, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	DeviceInfo            string `parquet:"name=device_info, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	TransactionId         string `parquet:"name=transaction_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	OutTradeNo            string `parquet:"name=out_trade_no, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Openid                string `parquet:"name=openid, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TradeType             string `parquet:"name=trade_type, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	TradeState            string `parquet:"name=trade_state, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	BankType              string `parquet:"name=bank_type, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Currency              string `parquet:"name=currency, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	SettlementTotalAmount int64  `parquet:"name=settlement_total_amount, type=INT64"`
+	CouponAmount          int64  `parquet:"name=coupon_amount, type=INT64"`
+	RefundId              string `parquet:"name=refund_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	OutRefundNo           string `parquet:"name=out_refund_no, type=BYTE_ARRAY, convertedtype=UTF8"`
+	RefundAmount          int64  `parquet:"name=refund_amount, type=INT64"`
+	CouponRefundAmount    int64  `parquet:"name=coupon_refund_amount, type=INT64"`
+	RefundType            string `parquet:"name=refund_type, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	RefundStatus          string `parquet:"name=refund_status, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	GoodsName             string `parquet:"name=goods_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Attach                string `parquet:"name=attach, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ServiceFee            string `parquet:"name=service_fee, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Rate                  string `parquet:"name=rate, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	TotalAmount           int64  `parquet:"name=total_amount, type=INT64"`
+	RefundApplyAmount     int64  `parquet:"name=refund_apply_amount, type=INT64"`
+	RateRemark            string `parquet:"name=rate_remark, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// NewTradeBillRow 将交易账单记录转换为 Parquet 文件中的行
+func NewTradeBillRow(record *bill.TradeBillRecord) *TradeBillRow {
+	row := &TradeBillRow{
+		Appid:                 record.Appid,
+		Mchid:                 record.Mchid,
+		SubMchid:              record.SubMchid,
+		DeviceInfo:            record.DeviceInfo,
+		TransactionId:         record.TransactionId,
+		OutTradeNo:            record.OutTradeNo,
+		Openid:                record.Openid,
+		TradeType:             record.TradeType,
+		TradeState:            record.TradeState,
+		BankType:              record.BankType,
+		Currency:              record.Currency,
+		SettlementTotalAmount: record.SettlementTotalAmount,
+		CouponAmount:          record.CouponAmount,
+		RefundId:              record.RefundId,
+		OutRefundNo:           record.OutRefundNo,
+		RefundAmount:          record.RefundAmount,
+		CouponRefundAmount:    record.CouponRefundAmount,
+		RefundType:            record.RefundType,
+		RefundStatus:          record.RefundStatus,
+		GoodsName:             record.GoodsName,
+		Attach:                record.Attach,
+		ServiceFee:            record.ServiceFee,
+		Rate:                  record.Rate,
+		TotalAmount:           record.TotalAmount,
+		RefundApplyAmount:     record.RefundApplyAmount,
+		RateRemark:            record.RateRemark,
+	}
+	if !record.TradeTime.IsZero() {
+		millis := record.TradeTime.UnixNano() / 1e6
+		row.TradeTime = &millis
+	}
+	return row
+}
+
+// TradeBillWriterOption ParquetTradeBillWriter 的可选配置
+type TradeBillWriterOption func(*writer.ParquetWriter)
+
+// WithCompression 设置列数据的压缩算法，默认为 SNAPPY
+func WithCompression(codec parquet.CompressionCodec) TradeBillWriterOption {
+	return func(w *writer.ParquetWriter) {
+		w.CompressionType = codec
+	}
+}
+
+// WithRowGroupSize 设置行组的大小（字节），默认为 128MB
+func WithRowGroupSize(size int64) TradeBillWriterOption {
+	return func(w *writer.ParquetWriter) {
+		w.RowGroupSize = size
+	}
+}
+
+// ParquetTradeBillWriter 将交易账单记录导出为 Parquet 格式，实现了 bill.TradeBillWriter
+//
+// 每行的结构见 TradeBillRow
+type ParquetTradeBillWriter struct {
+	writer *writer.ParquetWriter
+}
+
+var _ bill.TradeBillWriter = (*ParquetTradeBillWriter)(nil)
+
+// NewParquetTradeBillWriter 创建写入 w 的 ParquetTradeBillWriter
+func NewParquetTradeBillWriter(w io.Writer, opts ...TradeBillWriterOption) (*ParquetTradeBillWriter, error) {
+	pw, err := writer.NewParquetWriterFromWriter(w, new(TradeBillRow), 1)
+	if err != nil {
+		return nil, fmt.Errorf("create parquet writer err:%v", err)
+	}
+	for _, opt := range opts {
+		opt(pw)
+	}
+	return &ParquetTradeBillWriter{writer: pw}, nil
+}
+
+// Write 写入一条记录
+func (w *ParquetTradeBillWriter) Write(record *bill.TradeBillRecord) error {
+	return w.writer.Write(NewTradeBillRow(record))
+}
+
+// Close 写入缓存的数据与文件尾，不会关闭底层的 io.Writer
+func (w *ParquetTradeBillWriter) Close() error {
+	return w.writer.WriteStop()
+}
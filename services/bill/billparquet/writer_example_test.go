@@ -0,0 +1,29 @@
+package billparquet_test
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill/billparquet"
+)
+
+func ExampleNewParquetTradeBillWriter() {
+	file, err := os.Create("trade_bill.parquet")
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	writer, err := billparquet.NewParquetTradeBillWriter(file)
+	if err != nil {
+		return
+	}
+	count, err := bill.ExportTradeBill(bill.NewTradeBillReader(strings.NewReader(tradeBill)), writer)
+	if err != nil {
+		log.Printf("export trade bill err:%v", err)
+		return
+	}
+	log.Printf("exported %d records", count)
+}
@@ -0,0 +1,96 @@
+package billparquet_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill/billparquet"
+)
+
+const tradeBill = "交易时间,公众账号ID,商户号,特约商户号,设备号,微信订单号,商户订单号,用户标识,交易类型,交易状态,付款银行,货币种类,应结订单金额,代金券金额,微信退款单号,商户退款单号,退款金额,充值券退款金额,退款类型,退款状态,商品名称,商户数据包,手续费,费率,订单金额,申请退款金额,费率备注\n" +
+	"`2021-07-01 10:00:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200001234202107010000000001,`1217752501201407033233368018,`oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,`JSAPI,`SUCCESS,`OTHERS,`CNY,`12.34,`0.00,`0,`0,`0.00,`0.00,`,`,`Image形象店-深圳腾大-QQ公仔,`,`0.07400,`0.60%,`12.34,`0.00,`\n" +
+	"`2021-07-01 11:00:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200001234202106300000000002,`1217752501201406303233368019,`oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,`JSAPI,`REFUND,`OTHERS,`CNY,`0.00,`0.00,`50000000000000000001,`R1217752501201406303233368019,`1.00,`0.00,`ORIGINAL,`SUCCESS,`Image形象店-深圳腾大-QQ公仔,`,`-0.00600,`0.60%,`0.00,`1.00,`\n" +
+	"总交易单数,应结订单总金额,退款总金额,充值券退款总金额,手续费总金额,订单总金额,申请退款总金额\n" +
+	"`2,`12.34,`1.00,`0.00,`0.06800,`12.34,`1.00\n"
+
+func readRows(t *testing.T, data []byte) ([]billparquet.TradeBillRow, *reader.ParquetReader) {
+	file, err := buffer.NewBufferFile(data)
+	require.NoError(t, err)
+	pr, err := reader.NewParquetReader(file, new(billparquet.TradeBillRow), 1)
+	require.NoError(t, err)
+	rows := make([]billparquet.TradeBillRow, pr.GetNumRows())
+	require.NoError(t, pr.Read(&rows))
+	pr.ReadStop()
+	return rows, pr
+}
+
+func TestParquetTradeBillWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := billparquet.NewParquetTradeBillWriter(&buf)
+	require.NoError(t, err)
+
+	count, err := bill.ExportTradeBill(bill.NewTradeBillReader(strings.NewReader(tradeBill)), writer)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	rows, pr := readRows(t, buf.Bytes())
+	require.Len(t, rows, 2)
+
+	// 列名与 CSV 导出一致
+	var columns []string
+	for _, info := range pr.SchemaHandler.Infos[1:] {
+		columns = append(columns, info.ExName)
+	}
+	assert.Equal(t, bill.TradeBillColumns, columns)
+
+	tradeTime := time.Date(2021, 7, 1, 10, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	require.NotNil(t, rows[0].TradeTime)
+	assert.Equal(t, tradeTime.UnixNano()/1e6, *rows[0].TradeTime)
+	assert.Equal(t, "4200001234202107010000000001", rows[0].TransactionId)
+	assert.Equal(t, "SUCCESS", rows[0].TradeState)
+	assert.Equal(t, int64(1234), rows[0].SettlementTotalAmount)
+	assert.Equal(t, "Image形象店-深圳腾大-QQ公仔", rows[0].GoodsName)
+	assert.Equal(t, "0.07400", rows[0].ServiceFee)
+
+	assert.Equal(t, "REFUND", rows[1].TradeState)
+	assert.Equal(t, "R1217752501201406303233368019", rows[1].OutRefundNo)
+	assert.Equal(t, int64(100), rows[1].RefundAmount)
+	assert.Equal(t, "-0.00600", rows[1].ServiceFee)
+}
+
+func TestParquetTradeBillWriter_Options(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := billparquet.NewParquetTradeBillWriter(
+		&buf, billparquet.WithCompression(parquet.CompressionCodec_GZIP), billparquet.WithRowGroupSize(1024),
+	)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(&bill.TradeBillRecord{TransactionId: "4200001234202107010000000001", TotalAmount: 1}))
+	require.NoError(t, writer.Close())
+
+	rows, pr := readRows(t, buf.Bytes())
+	require.Len(t, rows, 1)
+	// 没有交易时间时为空
+	assert.Nil(t, rows[0].TradeTime)
+	assert.Equal(t, int64(1), rows[0].TotalAmount)
+	assert.Equal(t, parquet.CompressionCodec_GZIP, pr.Footer.RowGroups[0].Columns[0].MetaData.GetCodec())
+}
+
+func TestParquetTradeBillWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := billparquet.NewParquetTradeBillWriter(&buf)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	// 没有记录时仍写入合法的文件
+	rows, _ := readRows(t, buf.Bytes())
+	assert.Empty(t, rows)
+}
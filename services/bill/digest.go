@@ -0,0 +1,58 @@
+package bill
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+)
+
+// 账单摘要类型，即申请账单接口应答中的 hash_type
+const (
+	HashTypeSHA1   = "SHA1"
+	HashTypeSHA256 = "SHA256"
+)
+
+// ErrDigestMismatch 下载的账单内容与申请账单接口返回的摘要不一致
+var ErrDigestMismatch = errors.New("bill digest mismatch")
+
+// NewDigestReader 返回在读取到末尾时校验内容摘要的 io.Reader，hashType 与 hashValue 为申请账单接口应答中的 hash_type 与 hash_value
+//
+// 摘要为原始账单的摘要，tar_type 为 GZIP 时请对解压后的内容进行校验。摘要不一致时 Read 返回包装了 ErrDigestMismatch 的错误，
+// 此前读取的内容不可信，调用方应丢弃并重新下载。
+func NewDigestReader(r io.Reader, hashType, hashValue string) (io.Reader, error) {
+	var h hash.Hash
+	switch strings.ToUpper(hashType) {
+	case HashTypeSHA1:
+		h = sha1.New()
+	case HashTypeSHA256:
+		h = sha256.New()
+	default:
+		return nil, fmt.Errorf("unsupported bill hash type %q", hashType)
+	}
+	if hashValue == "" {
+		return nil, fmt.Errorf("bill hash value is empty")
+	}
+	return &digestReader{reader: r, hash: h, expected: strings.ToLower(hashValue)}, nil
+}
+
+type digestReader struct {
+	reader   io.Reader
+	hash     hash.Hash
+	expected string
+}
+
+func (r *digestReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	_, _ = r.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); actual != r.expected {
+			return n, fmt.Errorf("%w: expected %s, got %s", ErrDigestMismatch, r.expected, actual)
+		}
+	}
+	return n, err
+}
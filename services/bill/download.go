@@ -0,0 +1,284 @@
+package bill
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+const (
+	// tarTypeGzip 账单文件的 gzip 压缩格式
+	tarTypeGzip = "GZIP"
+	// billDateLayout 账单日期的格式
+	billDateLayout = "2006-01-02"
+
+	defaultRangeConcurrency = 4
+)
+
+// DownloadTradeBill 申请并下载交易账单，返回原始账单内容，调用方读取完毕后需要关闭
+//
+// req.TarType 为 GZIP 时以压缩格式下载并在读取时解压。读取到末尾时使用申请接口返回的 hash_type 与 hash_value 校验账单内容，
+// 不一致时 Read 返回包装了 ErrDigestMismatch 的错误。申请接口返回的下载地址有效期为 30s，本方法在获取后立即下载。
+func (a *TradeBillApiService) DownloadTradeBill(ctx context.Context, req ApplyTradeBillRequest) (io.ReadCloser, error) {
+	entity, _, err := a.ApplyTradeBill(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return download(ctx, a.Client, entity, req.TarType)
+}
+
+// DownloadFundFlowBill 申请并下载资金账单，返回原始账单内容，调用方读取完毕后需要关闭。解压与摘要校验同 DownloadTradeBill
+func (a *FundFlowBillApiService) DownloadFundFlowBill(
+	ctx context.Context, req ApplyFundFlowBillRequest,
+) (io.ReadCloser, error) {
+	entity, _, err := a.ApplyFundFlowBill(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return download(ctx, a.Client, entity, req.TarType)
+}
+
+func download(ctx context.Context, client *core.Client, entity *QueryBillEntity, tarType *string) (io.ReadCloser, error) {
+	if entity.DownloadUrl == nil || entity.HashType == nil || entity.HashValue == nil {
+		return nil, fmt.Errorf("download_url, hash_type and hash_value are required in bill response")
+	}
+
+	result, err := client.Download(ctx, *entity.DownloadUrl)
+	if err != nil {
+		return nil, err
+	}
+	body := result.Response.Body
+
+	var content io.Reader = body
+	if tarType != nil && strings.EqualFold(*tarType, tarTypeGzip) {
+		if content, err = gzip.NewReader(body); err != nil {
+			_ = body.Close()
+			return nil, fmt.Errorf("decompress bill err:%v", err)
+		}
+	}
+
+	content, err = NewDigestReader(content, *entity.HashType, *entity.HashValue)
+	if err != nil {
+		_ = body.Close()
+		return nil, err
+	}
+	return &billBody{Reader: content, Closer: body}, nil
+}
+
+type billBody struct {
+	io.Reader
+	io.Closer
+}
+
+// TradeBillRangeRequest 下载多日交易账单的请求
+type TradeBillRangeRequest struct {
+	// 开始账单日期（含），格式为 2006-01-02
+	StartDate string
+	// 结束账单日期（含），格式为 2006-01-02
+	EndDate string
+	// 子商户号，同 ApplyTradeBillRequest.SubMchid
+	SubMchid *string
+	// 账单类型，同 ApplyTradeBillRequest.BillType
+	BillType *string
+	// 压缩类型，同 ApplyTradeBillRequest.TarType。下载较长时间范围的账单时建议使用 GZIP
+	TarType *string
+}
+
+// RangeOption DownloadTradeBills 的配置项
+type RangeOption func(o *rangeOptions)
+
+type rangeOptions struct {
+	concurrency     int
+	skipNoStatement bool
+}
+
+// WithRangeConcurrency 设置同时下载的账单天数，默认为 4
+func WithRangeConcurrency(n int) RangeOption {
+	return func(o *rangeOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithSkipNoStatement 将申请账单时返回 NO_STATEMENT_EXIST 的日期视为没有交易，默认返回错误
+//
+// 账单日期超过三个月时同样返回 NO_STATEMENT_EXIST，使用本选项时请自行确认日期范围
+func WithSkipNoStatement() RangeOption {
+	return func(o *rangeOptions) {
+		o.skipNoStatement = true
+	}
+}
+
+// DownloadTradeBills 以有限的并发数下载日期范围内每一天的交易账单，校验摘要并解析，返回按交易时间排序的 TradeBillRangeReader，
+// 适用于按月补对账等场景
+//
+// 账单按日期顺序输出，同一天的记录按交易时间排序。同时缓存在内存中的账单不超过并发数对应的天数，
+// 调用方读取完毕或不再读取时需要调用 Close。任意一天下载或解析失败时，Read 返回该错误并停止下载其余的账单。
+func (a *TradeBillApiService) DownloadTradeBills(
+	ctx context.Context, req TradeBillRangeRequest, opts ...RangeOption,
+) (*TradeBillRangeReader, error) {
+	start, err := time.ParseInLocation(billDateLayout, req.StartDate, beijing)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date %q", req.StartDate)
+	}
+	end, err := time.ParseInLocation(billDateLayout, req.EndDate, beijing)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date %q", req.EndDate)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end date %s is before start date %s", req.EndDate, req.StartDate)
+	}
+
+	o := rangeOptions{concurrency: defaultRangeConcurrency}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var dates []string
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		dates = append(dates, date.Format(billDateLayout))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	r := &TradeBillRangeReader{
+		cancel:    cancel,
+		dates:     dates,
+		results:   make([]chan dailyTradeBill, len(dates)),
+		tokens:    make(chan struct{}, o.concurrency),
+		summaries: make(map[string]*TradeBillSummary),
+	}
+	for i := range r.results {
+		r.results[i] = make(chan dailyTradeBill, 1)
+	}
+
+	go func() {
+		for i, date := range dates {
+			select {
+			case r.tokens <- struct{}{}:
+			case <-ctx.Done():
+				for j := i; j < len(dates); j++ {
+					r.results[j] <- dailyTradeBill{err: ctx.Err()}
+				}
+				return
+			}
+
+			go func(i int, date string) {
+				r.results[i] <- a.downloadDailyTradeBill(ctx, req, date, o.skipNoStatement)
+			}(i, date)
+		}
+	}()
+	return r, nil
+}
+
+type dailyTradeBill struct {
+	records []*TradeBillRecord
+	summary *TradeBillSummary
+	err     error
+}
+
+func (a *TradeBillApiService) downloadDailyTradeBill(
+	ctx context.Context, req TradeBillRangeRequest, date string, skipNoStatement bool,
+) dailyTradeBill {
+	body, err := a.DownloadTradeBill(ctx, ApplyTradeBillRequest{
+		BillDate: core.String(date),
+		SubMchid: req.SubMchid,
+		BillType: req.BillType,
+		TarType:  req.TarType,
+	})
+	if err != nil {
+		var apiErr *core.APIError
+		if skipNoStatement && errors.As(err, &apiErr) && apiErr.Code == "NO_STATEMENT_EXIST" {
+			return dailyTradeBill{}
+		}
+		return dailyTradeBill{err: err}
+	}
+	defer func() { _ = body.Close() }()
+
+	var records []*TradeBillRecord
+	reader := NewTradeBillReader(body)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return dailyTradeBill{err: err}
+		}
+		records = append(records, record)
+	}
+	// 读取汇总信息之后的剩余内容，完成摘要校验
+	if _, err = io.Copy(ioutil.Discard, body); err != nil {
+		return dailyTradeBill{err: err}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].TradeTime.Before(records[j].TradeTime)
+	})
+	return dailyTradeBill{records: records, summary: reader.Summary()}
+}
+
+// TradeBillRangeReader 按交易时间顺序逐条读取多日交易账单
+type TradeBillRangeReader struct {
+	cancel    context.CancelFunc
+	dates     []string
+	results   []chan dailyTradeBill
+	tokens    chan struct{}
+	summaries map[string]*TradeBillSummary
+
+	day     int
+	records []*TradeBillRecord
+	err     error
+}
+
+// Read 读取下一条记录，读取完所有账单后返回 io.EOF
+func (r *TradeBillRangeReader) Read() (*TradeBillRecord, error) {
+	for len(r.records) == 0 {
+		if r.err != nil {
+			return nil, r.err
+		}
+		if r.day >= len(r.dates) {
+			return nil, io.EOF
+		}
+
+		result := <-r.results[r.day]
+		// 该日账单不再占用缓存名额，可以开始下载下一天的账单
+		select {
+		case <-r.tokens:
+		default:
+		}
+		if result.err != nil {
+			r.err = fmt.Errorf("download trade bill of %s err:%w", r.dates[r.day], result.err)
+			r.cancel()
+			return nil, r.err
+		}
+		if result.summary != nil {
+			r.summaries[r.dates[r.day]] = result.summary
+		}
+		r.records = result.records
+		r.day++
+	}
+
+	record := r.records[0]
+	r.records = r.records[1:]
+	return record, nil
+}
+
+// Summaries 返回已读取的各日账单的汇总信息，键为账单日期。没有交易的日期不包含在内
+func (r *TradeBillRangeReader) Summaries() map[string]*TradeBillSummary {
+	return r.summaries
+}
+
+// Close 停止下载尚未读取的账单
+func (r *TradeBillRangeReader) Close() error {
+	r.cancel()
+	return nil
+}
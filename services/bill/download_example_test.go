@@ -0,0 +1,80 @@
+package bill_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+func ExampleTradeBillApiService_DownloadTradeBill() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := bill.TradeBillApiService{Client: client}
+	body, err := svc.DownloadTradeBill(ctx, bill.ApplyTradeBillRequest{
+		BillDate: core.String("2021-07-01"),
+		TarType:  core.String("GZIP"),
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer body.Close()
+
+	reader := bill.NewTradeBillReader(body)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(record.OutTradeNo, record.TradeState)
+	}
+}
+
+func ExampleTradeBillApiService_DownloadTradeBills() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := bill.TradeBillApiService{Client: client}
+	reader, err := svc.DownloadTradeBills(ctx, bill.TradeBillRangeRequest{
+		StartDate: "2021-07-01",
+		EndDate:   "2021-07-31",
+		BillType:  core.String("SUCCESS"),
+		TarType:   core.String("GZIP"),
+	}, bill.WithRangeConcurrency(4), bill.WithSkipNoStatement())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer reader.Close()
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// 任意一天的账单下载失败或摘要不一致
+			fmt.Println(err)
+			return
+		}
+		// 记录按交易时间排序
+		fmt.Println(record.TradeTime, record.OutTradeNo, record.TotalAmount)
+	}
+	for date, summary := range reader.Summaries() {
+		fmt.Println(date, summary.TotalCount, summary.TotalAmount)
+	}
+}
@@ -0,0 +1,109 @@
+package bill
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// TradeBillRecordReader 逐条读取交易账单记录，TradeBillReader 与 TradeBillRangeReader 均实现了本接口
+type TradeBillRecordReader interface {
+	// Read 读取下一条记录，读取完所有记录后返回 io.EOF
+	Read() (*TradeBillRecord, error)
+}
+
+// TradeBillWriter 交易账单记录的导出器，用于将解析后的账单直接写入文件或数据湖存储
+//
+// 本包提供了 CSV 格式的 CSVTradeBillWriter，独立的 Go Module services/bill/billparquet 提供了 Parquet 格式的实现。
+// TradeBillRecord 的字段均为基本类型，也可以使用 ORC 等格式的第三方库实现本接口。
+type TradeBillWriter interface {
+	// Write 写入一条记录
+	Write(record *TradeBillRecord) error
+	// Close 写入缓存的数据并结束导出，不会关闭底层的 io.Writer
+	Close() error
+}
+
+// ExportTradeBill 将 reader 中的全部记录写入 writer 并关闭 writer，返回写入的记录数
+func ExportTradeBill(reader TradeBillRecordReader, writer TradeBillWriter) (int, error) {
+	count := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		if err = writer.Write(record); err != nil {
+			return count, fmt.Errorf("export trade bill record %d err:%v", count+1, err)
+		}
+		count++
+	}
+	if err := writer.Close(); err != nil {
+		return count, fmt.Errorf("close trade bill writer err:%v", err)
+	}
+	return count, nil
+}
+
+// TradeBillColumns 导出交易账单时使用的列名，与 TradeBillRecord 的字段一一对应
+var TradeBillColumns = []string{
+	"trade_time", "appid", "mchid", "sub_mchid", "device_info", "transaction_id", "out_trade_no", "openid",
+	"trade_type", "trade_state", "bank_type", "currency", "settlement_total_amount", "coupon_amount",
+	"refund_id", "out_refund_no", "refund_amount", "coupon_refund_amount", "refund_type", "refund_status",
+	"goods_name", "attach", "service_fee", "rate", "total_amount", "refund_apply_amount", "rate_remark",
+}
+
+// CSVTradeBillWriter 将交易账单记录导出为 CSV 格式
+//
+// 首行为 TradeBillColumns 中的列名；交易时间使用 RFC 3339 格式，金额以分为单位，其余字段为账单中去除前缀 ` 后的原始内容
+type CSVTradeBillWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVTradeBillWriter 创建写入 w 的 CSVTradeBillWriter
+func NewCSVTradeBillWriter(w io.Writer) *CSVTradeBillWriter {
+	return &CSVTradeBillWriter{writer: csv.NewWriter(w)}
+}
+
+// Write 写入一条记录，首次写入前写入表头
+func (w *CSVTradeBillWriter) Write(record *TradeBillRecord) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	var tradeTime string
+	if !record.TradeTime.IsZero() {
+		tradeTime = record.TradeTime.Format(time.RFC3339)
+	}
+	return w.writer.Write([]string{
+		tradeTime, record.Appid, record.Mchid, record.SubMchid, record.DeviceInfo, record.TransactionId,
+		record.OutTradeNo, record.Openid, record.TradeType, record.TradeState, record.BankType, record.Currency,
+		formatInt(record.SettlementTotalAmount), formatInt(record.CouponAmount), record.RefundId, record.OutRefundNo,
+		formatInt(record.RefundAmount), formatInt(record.CouponRefundAmount), record.RefundType, record.RefundStatus,
+		record.GoodsName, record.Attach, record.ServiceFee, record.Rate, formatInt(record.TotalAmount),
+		formatInt(record.RefundApplyAmount), record.RateRemark,
+	})
+}
+
+// Close 写入表头（没有记录时）与缓存的数据
+func (w *CSVTradeBillWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *CSVTradeBillWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.writer.Write(TradeBillColumns)
+}
+
+func formatInt(n int64) string {
+	return strconv.FormatInt(n, 10)
+}
@@ -0,0 +1,20 @@
+package bill_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+func ExampleExportTradeBill() {
+	reader := bill.NewTradeBillReader(strings.NewReader(tradeBill))
+	count, err := bill.ExportTradeBill(reader, bill.NewCSVTradeBillWriter(os.Stdout))
+	fmt.Println(count, err)
+	// Output:
+	// trade_time,appid,mchid,sub_mchid,device_info,transaction_id,out_trade_no,openid,trade_type,trade_state,bank_type,currency,settlement_total_amount,coupon_amount,refund_id,out_refund_no,refund_amount,coupon_refund_amount,refund_type,refund_status,goods_name,attach,service_fee,rate,total_amount,refund_apply_amount,rate_remark
+	// 2021-07-01T10:00:00+08:00,wxd678efh567hg6787,1230000109,0,,4200001234202107010000000001,1217752501201407033233368018,oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,JSAPI,SUCCESS,OTHERS,CNY,1234,0,0,0,0,0,,,Image形象店-深圳腾大-QQ公仔,,0.07400,0.60%,1234,0,
+	// 2021-07-01T11:00:00+08:00,wxd678efh567hg6787,1230000109,0,,4200001234202106300000000002,1217752501201406303233368019,oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,JSAPI,REFUND,OTHERS,CNY,0,0,50000000000000000001,R1217752501201406303233368019,100,0,ORIGINAL,SUCCESS,Image形象店-深圳腾大-QQ公仔,,-0.00600,0.60%,0,100,
+	// 2 <nil>
+}
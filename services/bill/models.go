@@ -0,0 +1,251 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 账单API
+//
+// 申请交易账单与资金账单，获取账单的下载地址与摘要。  账单文件请使用 core.Client.Download 下载，并使用 NewDigestReader 校验摘要
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package bill
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ApplyFundFlowBillRequest
+type ApplyFundFlowBillRequest struct {
+	// 账单日期，格式yyyy-MM-dd，仅支持三个月内的账单下载申请
+	BillDate *string `json:"bill_date"`
+	// 资金账户类型，不填则默认是BASIC。枚举值：BASIC（基本账户）、OPERATION（运营账户）、FEES（手续费账户）
+	AccountType *string `json:"account_type,omitempty"`
+	// 压缩类型，不填则默认是数据流。枚举值：GZIP（返回格式为.gzip的压缩包账单）
+	TarType *string `json:"tar_type,omitempty"`
+}
+
+func (o ApplyFundFlowBillRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BillDate == nil {
+		return nil, fmt.Errorf("field `BillDate` is required and must be specified in ApplyFundFlowBillRequest")
+	}
+	toSerialize["bill_date"] = o.BillDate
+
+	if o.AccountType != nil {
+		toSerialize["account_type"] = o.AccountType
+	}
+
+	if o.TarType != nil {
+		toSerialize["tar_type"] = o.TarType
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ApplyFundFlowBillRequest) String() string {
+	var ret string
+	if o.BillDate == nil {
+		ret += "BillDate:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BillDate:%v, ", *o.BillDate)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountType:%v, ", *o.AccountType)
+	}
+
+	if o.TarType == nil {
+		ret += "TarType:<nil>"
+	} else {
+		ret += fmt.Sprintf("TarType:%v", *o.TarType)
+	}
+
+	return fmt.Sprintf("ApplyFundFlowBillRequest{%s}", ret)
+}
+
+func (o ApplyFundFlowBillRequest) Clone() *ApplyFundFlowBillRequest {
+	ret := ApplyFundFlowBillRequest{}
+
+	if o.BillDate != nil {
+		ret.BillDate = new(string)
+		*ret.BillDate = *o.BillDate
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(string)
+		*ret.AccountType = *o.AccountType
+	}
+
+	if o.TarType != nil {
+		ret.TarType = new(string)
+		*ret.TarType = *o.TarType
+	}
+
+	return &ret
+}
+
+// ApplyTradeBillRequest
+type ApplyTradeBillRequest struct {
+	// 账单日期，格式yyyy-MM-dd，仅支持三个月内的账单下载申请
+	BillDate *string `json:"bill_date"`
+	// 子商户号，不填则默认返回服务商下的交易或退款数据。下载某个子商户下的交易或退款数据，则该字段必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 账单类型，不填则默认是ALL。枚举值：ALL（返回当日所有订单信息，不含充值退款订单）、SUCCESS（返回当日成功支付的订单，不含充值退款订单）、REFUND（返回当日退款订单，不含充值退款订单）
+	BillType *string `json:"bill_type,omitempty"`
+	// 压缩类型，不填则默认是数据流。枚举值：GZIP（返回格式为.gzip的压缩包账单）
+	TarType *string `json:"tar_type,omitempty"`
+}
+
+func (o ApplyTradeBillRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BillDate == nil {
+		return nil, fmt.Errorf("field `BillDate` is required and must be specified in ApplyTradeBillRequest")
+	}
+	toSerialize["bill_date"] = o.BillDate
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.BillType != nil {
+		toSerialize["bill_type"] = o.BillType
+	}
+
+	if o.TarType != nil {
+		toSerialize["tar_type"] = o.TarType
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ApplyTradeBillRequest) String() string {
+	var ret string
+	if o.BillDate == nil {
+		ret += "BillDate:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BillDate:%v, ", *o.BillDate)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.BillType == nil {
+		ret += "BillType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BillType:%v, ", *o.BillType)
+	}
+
+	if o.TarType == nil {
+		ret += "TarType:<nil>"
+	} else {
+		ret += fmt.Sprintf("TarType:%v", *o.TarType)
+	}
+
+	return fmt.Sprintf("ApplyTradeBillRequest{%s}", ret)
+}
+
+func (o ApplyTradeBillRequest) Clone() *ApplyTradeBillRequest {
+	ret := ApplyTradeBillRequest{}
+
+	if o.BillDate != nil {
+		ret.BillDate = new(string)
+		*ret.BillDate = *o.BillDate
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.BillType != nil {
+		ret.BillType = new(string)
+		*ret.BillType = *o.BillType
+	}
+
+	if o.TarType != nil {
+		ret.TarType = new(string)
+		*ret.TarType = *o.TarType
+	}
+
+	return &ret
+}
+
+// QueryBillEntity
+type QueryBillEntity struct {
+	// 原始账单（gzip需要解压缩）的摘要值，用于校验文件的完整性。枚举值：SHA1
+	HashType *string `json:"hash_type"`
+	// 原始账单（gzip需要解压缩）的摘要值，用于校验文件的完整性
+	HashValue *string `json:"hash_value"`
+	// 供下一步请求账单文件的下载地址，该地址30s内有效
+	DownloadUrl *string `json:"download_url"`
+}
+
+func (o QueryBillEntity) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.HashType == nil {
+		return nil, fmt.Errorf("field `HashType` is required and must be specified in QueryBillEntity")
+	}
+	toSerialize["hash_type"] = o.HashType
+
+	if o.HashValue == nil {
+		return nil, fmt.Errorf("field `HashValue` is required and must be specified in QueryBillEntity")
+	}
+	toSerialize["hash_value"] = o.HashValue
+
+	if o.DownloadUrl == nil {
+		return nil, fmt.Errorf("field `DownloadUrl` is required and must be specified in QueryBillEntity")
+	}
+	toSerialize["download_url"] = o.DownloadUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryBillEntity) String() string {
+	var ret string
+	if o.HashType == nil {
+		ret += "HashType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("HashType:%v, ", *o.HashType)
+	}
+
+	if o.HashValue == nil {
+		ret += "HashValue:<nil>, "
+	} else {
+		ret += fmt.Sprintf("HashValue:%v, ", *o.HashValue)
+	}
+
+	if o.DownloadUrl == nil {
+		ret += "DownloadUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("DownloadUrl:%v", *o.DownloadUrl)
+	}
+
+	return fmt.Sprintf("QueryBillEntity{%s}", ret)
+}
+
+func (o QueryBillEntity) Clone() *QueryBillEntity {
+	ret := QueryBillEntity{}
+
+	if o.HashType != nil {
+		ret.HashType = new(string)
+		*ret.HashType = *o.HashType
+	}
+
+	if o.HashValue != nil {
+		ret.HashValue = new(string)
+		*ret.HashValue = *o.HashValue
+	}
+
+	if o.DownloadUrl != nil {
+		ret.DownloadUrl = new(string)
+		*ret.DownloadUrl = *o.DownloadUrl
+	}
+
+	return &ret
+}
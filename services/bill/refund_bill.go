@@ -0,0 +1,110 @@
+package bill
+
+import (
+	"io"
+	"time"
+)
+
+// BillTypeRefund 申请交易账单时返回当日退款订单的账单类型
+const BillTypeRefund = "REFUND"
+
+// 退款订单账单特有的列名
+const (
+	columnRefundApplyTime   = "退款申请时间"
+	columnRefundSuccessTime = "退款成功时间"
+)
+
+// RefundBillRecord 退款订单（bill_type 为 REFUND）账单中的一条记录。金额单位均为分，账单中不存在的列为零值
+//
+// 与全部订单账单不同，退款订单账单的每条记录对应一笔退款，包含原订单信息与退款申请时间、退款成功时间。
+type RefundBillRecord struct {
+	TradeTime             time.Time // 交易时间
+	Appid                 string    // 公众账号ID
+	Mchid                 string    // 商户号
+	SubMchid              string    // 特约商户号
+	DeviceInfo            string    // 设备号
+	TransactionId         string    // 微信订单号
+	OutTradeNo            string    // 商户订单号
+	Openid                string    // 用户标识
+	TradeType             string    // 交易类型，如 JSAPI
+	TradeState            string    // 交易状态，退款订单账单中为 REFUND
+	BankType              string    // 付款银行
+	Currency              string    // 货币种类
+	SettlementTotalAmount int64     // 应结订单金额
+	CouponAmount          int64     // 代金券金额
+	RefundApplyTime       time.Time // 退款申请时间
+	RefundSuccessTime     time.Time // 退款成功时间，退款尚未成功时为零值
+	RefundId              string    // 微信退款单号
+	OutRefundNo           string    // 商户退款单号
+	RefundAmount          int64     // 退款金额
+	CouponRefundAmount    int64     // 充值券退款金额
+	RefundType            string    // 退款类型，如 ORIGINAL
+	RefundStatus          string    // 退款状态，如 SUCCESS、PROCESSING
+	GoodsName             string    // 商品名称
+	Attach                string    // 商户数据包
+	ServiceFee            string    // 手续费，单位为元，退款返还的手续费为负数
+	Rate                  string    // 费率，如 0.60%
+	TotalAmount           int64     // 订单金额
+	RefundApplyAmount     int64     // 申请退款金额
+	RateRemark            string    // 费率备注
+}
+
+// RefundBillReader 以流的方式逐条读取退款订单（bill_type 为 REFUND）账单
+//
+// 账单为 gzip 压缩格式（tar_type 为 GZIP）时，请先使用 gzip.NewReader 解压。
+type RefundBillReader struct {
+	table billTable
+}
+
+// NewRefundBillReader 使用账单内容创建 RefundBillReader
+func NewRefundBillReader(r io.Reader) *RefundBillReader {
+	return &RefundBillReader{table: newBillTable(r, "refund bill")}
+}
+
+// Read 读取下一条记录，读取完所有记录后返回 io.EOF，此后可以通过 Summary 获取账单的汇总信息
+func (r *RefundBillReader) Read() (*RefundBillRecord, error) {
+	p, err := r.table.next()
+	if err != nil {
+		return nil, err
+	}
+	record := &RefundBillRecord{
+		TradeTime:             p.time(columnTradeTime),
+		Appid:                 p.string(columnAppid),
+		Mchid:                 p.string(columnMchid),
+		SubMchid:              p.string(columnSubMchid),
+		DeviceInfo:            p.string(columnDeviceInfo),
+		TransactionId:         p.string(columnTransactionId),
+		OutTradeNo:            p.string(columnOutTradeNo),
+		Openid:                p.string(columnOpenid),
+		TradeType:             p.string(columnTradeType),
+		TradeState:            p.string(columnTradeState),
+		BankType:              p.string(columnBankType),
+		Currency:              p.string(columnCurrency),
+		SettlementTotalAmount: p.amount(columnSettlementTotal),
+		CouponAmount:          p.amount(columnCouponAmount),
+		RefundApplyTime:       p.time(columnRefundApplyTime),
+		RefundSuccessTime:     p.time(columnRefundSuccessTime),
+		RefundId:              p.string(columnRefundId),
+		OutRefundNo:           p.string(columnOutRefundNo),
+		RefundAmount:          p.amount(columnRefundAmount),
+		CouponRefundAmount:    p.amount(columnCouponRefund),
+		RefundType:            p.string(columnRefundType),
+		RefundStatus:          p.string(columnRefundStatus),
+		GoodsName:             p.string(columnGoodsName),
+		Attach:                p.string(columnAttach),
+		ServiceFee:            p.string(columnServiceFee),
+		Rate:                  p.string(columnRate),
+		TotalAmount:           p.amount(columnTotalAmount),
+		RefundApplyAmount:     p.amount(columnRefundApply),
+		RateRemark:            p.string(columnRateRemark),
+	}
+	if p.err != nil {
+		return nil, r.table.parseError(p.err)
+	}
+	return record, nil
+}
+
+// Summary 返回账单的汇总信息，Read 返回 io.EOF 之前或账单中不包含汇总信息时返回 nil
+func (r *RefundBillReader) Summary() *TradeBillSummary {
+	return r.table.summary
+}
@@ -0,0 +1,36 @@
+package bill_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+const refundBill = "交易时间,公众账号ID,商户号,特约商户号,设备号,微信订单号,商户订单号,用户标识,交易类型,交易状态,付款银行,货币种类,应结订单金额,代金券金额,退款申请时间,退款成功时间,微信退款单号,商户退款单号,退款金额,充值券退款金额,退款类型,退款状态,商品名称,商户数据包,手续费,费率,订单金额,申请退款金额,费率备注\n" +
+	"`2021-06-30 11:00:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200001234202106300000000002,`1217752501201406303233368019,`oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,`JSAPI,`REFUND,`OTHERS,`CNY,`0.00,`0.00,`2021-07-01 09:30:00,`2021-07-01 09:30:05,`50000000000000000001,`R1217752501201406303233368019,`1.00,`0.00,`ORIGINAL,`SUCCESS,`Image形象店-深圳腾大-QQ公仔,`,`-0.00600,`0.60%,`0.00,`1.00,`\n" +
+	"总交易单数,应结订单总金额,退款总金额,充值券退款总金额,手续费总金额,订单总金额,申请退款总金额\n" +
+	"`1,`0.00,`1.00,`0.00,`-0.00600,`0.00,`1.00\n"
+
+func ExampleRefundBillReader() {
+	reader := bill.NewRefundBillReader(strings.NewReader(refundBill))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(record.OutRefundNo, record.RefundStatus, record.RefundAmount,
+			record.RefundSuccessTime.Sub(record.RefundApplyTime))
+	}
+
+	summary := reader.Summary()
+	fmt.Println(summary.TotalCount, summary.RefundAmount, summary.ServiceFee)
+	// Output:
+	// R1217752501201406303233368019 SUCCESS 100 5s
+	// 1 100 -0.00600
+}
@@ -0,0 +1,185 @@
+package bill_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+const refundBillHeader = "交易时间,公众账号ID,商户号,特约商户号,设备号,微信订单号,商户订单号,用户标识,交易类型,交易状态,付款银行,货币种类,应结订单金额,代金券金额,退款申请时间,退款成功时间,微信退款单号,商户退款单号,退款金额,充值券退款金额,退款类型,退款状态,商品名称,商户数据包,手续费,费率,订单金额,申请退款金额,费率备注\n"
+
+const refundBillSummary = "总交易单数,应结订单总金额,退款总金额,充值券退款总金额,手续费总金额,订单总金额,申请退款总金额\n" +
+	"`2,`0.00,`3.50,`0.50,`-0.02100,`0.00,`3.50\n"
+
+// refundBillRow 生成一行退款订单账单记录，refundAmount 为以元为单位的退款金额
+func refundBillRow(outRefundNo, successTime, refundStatus, refundAmount string) string {
+	return "`2021-06-30 11:00:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200001234202106300000000002," +
+		"`1217752501201406303233368019,`oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,`JSAPI,`REFUND,`OTHERS,`CNY,`0.00,`0.00," +
+		"`2021-07-01 09:30:00,`" + successTime + ",`50000000000000000001,`" + outRefundNo + ",`" + refundAmount +
+		",`0.50,`ORIGINAL,`" + refundStatus + ",`Image形象店-深圳腾大-QQ公仔,`attach,`-0.00600,`0.60%,`0.00,`" +
+		refundAmount + ",`\n"
+}
+
+func readRefundBill(content string) ([]*bill.RefundBillRecord, *bill.RefundBillReader, error) {
+	reader := bill.NewRefundBillReader(strings.NewReader(content))
+	var records []*bill.RefundBillRecord
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return records, reader, nil
+		}
+		if err != nil {
+			return records, reader, err
+		}
+		records = append(records, record)
+	}
+}
+
+func TestRefundBillReader(t *testing.T) {
+	content := refundBillHeader +
+		refundBillRow("R1", "2021-07-01 09:30:05", "SUCCESS", "1.00") +
+		// 空行被跳过
+		"\n" +
+		refundBillRow("R2", "", "PROCESSING", "2.50") +
+		refundBillSummary
+	records, reader, err := readRefundBill(content)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	cst := time.FixedZone("CST", 8*3600)
+	r := records[0]
+	assert.True(t, time.Date(2021, 6, 30, 11, 0, 0, 0, cst).Equal(r.TradeTime), r.TradeTime)
+	assert.Equal(t, "wxd678efh567hg6787", r.Appid)
+	assert.Equal(t, "1230000109", r.Mchid)
+	assert.Equal(t, "0", r.SubMchid)
+	assert.Equal(t, "", r.DeviceInfo)
+	assert.Equal(t, "4200001234202106300000000002", r.TransactionId)
+	assert.Equal(t, "1217752501201406303233368019", r.OutTradeNo)
+	assert.Equal(t, "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o", r.Openid)
+	assert.Equal(t, "JSAPI", r.TradeType)
+	assert.Equal(t, "REFUND", r.TradeState)
+	assert.Equal(t, "OTHERS", r.BankType)
+	assert.Equal(t, "CNY", r.Currency)
+	assert.Equal(t, int64(0), r.SettlementTotalAmount)
+	assert.True(t, time.Date(2021, 7, 1, 9, 30, 0, 0, cst).Equal(r.RefundApplyTime), r.RefundApplyTime)
+	assert.True(t, time.Date(2021, 7, 1, 9, 30, 5, 0, cst).Equal(r.RefundSuccessTime), r.RefundSuccessTime)
+	assert.Equal(t, "50000000000000000001", r.RefundId)
+	assert.Equal(t, "R1", r.OutRefundNo)
+	assert.Equal(t, int64(100), r.RefundAmount)
+	assert.Equal(t, int64(50), r.CouponRefundAmount)
+	assert.Equal(t, "ORIGINAL", r.RefundType)
+	assert.Equal(t, "SUCCESS", r.RefundStatus)
+	assert.Equal(t, "Image形象店-深圳腾大-QQ公仔", r.GoodsName)
+	assert.Equal(t, "attach", r.Attach)
+	assert.Equal(t, "-0.00600", r.ServiceFee)
+	assert.Equal(t, "0.60%", r.Rate)
+	assert.Equal(t, int64(100), r.RefundApplyAmount)
+
+	// 退款尚未成功时，退款成功时间为零值
+	assert.Equal(t, "PROCESSING", records[1].RefundStatus)
+	assert.True(t, records[1].RefundSuccessTime.IsZero())
+	assert.Equal(t, int64(250), records[1].RefundAmount)
+
+	summary := reader.Summary()
+	require.NotNil(t, summary)
+	assert.Equal(t, int64(2), summary.TotalCount)
+	assert.Equal(t, int64(350), summary.RefundAmount)
+	assert.Equal(t, int64(50), summary.CouponRefundAmount)
+	assert.Equal(t, "-0.02100", summary.ServiceFee)
+	assert.Equal(t, int64(350), summary.RefundApplyAmount)
+}
+
+func TestRefundBillReader_WithoutSummary(t *testing.T) {
+	// 表头带有 UTF-8 BOM，且账单不包含汇总信息
+	records, reader, err := readRefundBill("\ufeff" + refundBillHeader + refundBillRow("R1", "", "PROCESSING", "1.00"))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "R1", records[0].OutRefundNo)
+	assert.Nil(t, reader.Summary())
+}
+
+func TestRefundBillReader_Summary(t *testing.T) {
+	reader := bill.NewRefundBillReader(strings.NewReader(refundBillHeader + refundBillRow("R1", "", "PROCESSING", "1.00") + refundBillSummary))
+	_, err := reader.Read()
+	require.NoError(t, err)
+	// 读取完所有记录前不返回汇总信息
+	assert.Nil(t, reader.Summary())
+}
+
+func TestRefundBillReader_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		records int
+		wantErr string
+	}{
+		{name: "empty", content: "", wantErr: "empty refund bill"},
+		{name: "missing out trade no column", content: "交易时间,微信退款单号\n", wantErr: "column 商户订单号 is missing"},
+		{
+			name:    "invalid refund amount",
+			content: refundBillHeader + refundBillRow("R1", "", "PROCESSING", "1.00") + refundBillRow("R2", "", "PROCESSING", "1.005"),
+			records: 1,
+			wantErr: "parse refund bill at line 3 err:invalid 退款金额 \"1.005\"",
+		},
+		{
+			name:    "invalid refund success time",
+			content: refundBillHeader + refundBillRow("R1", "2021/07/01", "SUCCESS", "1.00"),
+			wantErr: "invalid 退款成功时间 \"2021/07/01\"",
+		},
+		{
+			name:    "missing summary",
+			content: refundBillHeader + refundBillRow("R1", "", "PROCESSING", "1.00") + "总交易单数,退款总金额\n",
+			records: 1,
+			wantErr: "refund bill summary is missing",
+		},
+		{
+			name:    "invalid summary",
+			content: refundBillHeader + "总交易单数,退款总金额\n`x,`1.00\n",
+			wantErr: "parse refund bill summary at line 3 err:invalid 总交易单数 \"x\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, _, err := readRefundBill(tt.content)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Len(t, records, tt.records)
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		yuan    string
+		want    int64
+		wantErr bool
+	}{
+		{yuan: "12.34", want: 1234},
+		{yuan: "12.3", want: 1230},
+		{yuan: "12", want: 1200},
+		{yuan: " 0.01 ", want: 1},
+		{yuan: "-1.50", want: -150},
+		{yuan: "0.00", want: 0},
+		{yuan: "1.234", wantErr: true},
+		{yuan: ".50", wantErr: true},
+		{yuan: "", wantErr: true},
+		{yuan: "abc", wantErr: true},
+		{yuan: "--1", wantErr: true},
+		{yuan: "1,000.00", wantErr: true},
+	}
+	for _, tt := range tests {
+		amount, err := bill.ParseAmount(tt.yuan)
+		if tt.wantErr {
+			assert.Error(t, err, tt.yuan)
+			continue
+		}
+		require.NoError(t, err, tt.yuan)
+		assert.Equal(t, tt.want, amount, tt.yuan)
+	}
+}
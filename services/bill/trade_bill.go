@@ -0,0 +1,346 @@
+// Package bill 微信支付 API v3 Go SDK 账单文件解析
+//
+// 账单文件通过申请账单接口返回的 download_url 使用 core.Client.Download 下载，本包负责将下载的账单内容解析为结构化数据。
+package bill
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 交易账单的列名
+const (
+	columnTradeTime       = "交易时间"
+	columnAppid           = "公众账号ID"
+	columnMchid           = "商户号"
+	columnSubMchid        = "特约商户号"
+	columnDeviceInfo      = "设备号"
+	columnTransactionId   = "微信订单号"
+	columnOutTradeNo      = "商户订单号"
+	columnOpenid          = "用户标识"
+	columnTradeType       = "交易类型"
+	columnTradeState      = "交易状态"
+	columnBankType        = "付款银行"
+	columnCurrency        = "货币种类"
+	columnSettlementTotal = "应结订单金额"
+	columnCouponAmount    = "代金券金额"
+	columnRefundId        = "微信退款单号"
+	columnOutRefundNo     = "商户退款单号"
+	columnRefundAmount    = "退款金额"
+	columnCouponRefund    = "充值券退款金额"
+	columnRefundType      = "退款类型"
+	columnRefundStatus    = "退款状态"
+	columnGoodsName       = "商品名称"
+	columnAttach          = "商户数据包"
+	columnServiceFee      = "手续费"
+	columnRate            = "费率"
+	columnTotalAmount     = "订单金额"
+	columnRefundApply     = "申请退款金额"
+	columnRateRemark      = "费率备注"
+
+	summaryFirstColumn = "总交易单数"
+)
+
+// tradeTimeLayout 交易时间的格式，使用北京时间
+const tradeTimeLayout = "2006-01-02 15:04:05"
+
+var beijing = time.FixedZone("CST", 8*60*60)
+
+// TradeBillRecord 交易账单中的一条记录。金额单位均为分，账单中不存在的列为零值
+//
+// 全部订单（ALL）账单中，支付成功的订单与退款分别为独立的记录：支付记录的 TradeState 为 SUCCESS，
+// 退款记录的 TradeState 为 REFUND，并包含退款相关字段。
+type TradeBillRecord struct {
+	TradeTime             time.Time // 交易时间
+	Appid                 string    // 公众账号ID
+	Mchid                 string    // 商户号
+	SubMchid              string    // 特约商户号
+	DeviceInfo            string    // 设备号
+	TransactionId         string    // 微信订单号
+	OutTradeNo            string    // 商户订单号
+	Openid                string    // 用户标识
+	TradeType             string    // 交易类型，如 JSAPI
+	TradeState            string    // 交易状态，如 SUCCESS、REFUND
+	BankType              string    // 付款银行
+	Currency              string    // 货币种类
+	SettlementTotalAmount int64     // 应结订单金额，即订单金额减去非充值券金额
+	CouponAmount          int64     // 代金券金额
+	RefundId              string    // 微信退款单号
+	OutRefundNo           string    // 商户退款单号
+	RefundAmount          int64     // 退款金额
+	CouponRefundAmount    int64     // 充值券退款金额
+	RefundType            string    // 退款类型
+	RefundStatus          string    // 退款状态
+	GoodsName             string    // 商品名称
+	Attach                string    // 商户数据包
+	ServiceFee            string    // 手续费，单位为元。手续费的精度高于分，因此保留账单中的原始内容
+	Rate                  string    // 费率，如 0.60%
+	TotalAmount           int64     // 订单金额
+	RefundApplyAmount     int64     // 申请退款金额
+	RateRemark            string    // 费率备注
+}
+
+// TradeBillSummary 交易账单末尾的汇总信息，金额单位均为分
+type TradeBillSummary struct {
+	TotalCount            int64  // 总交易单数
+	SettlementTotalAmount int64  // 应结订单总金额
+	RefundAmount          int64  // 退款总金额
+	CouponRefundAmount    int64  // 充值券退款总金额
+	ServiceFee            string // 手续费总金额，单位为元
+	TotalAmount           int64  // 订单总金额
+	RefundApplyAmount     int64  // 申请退款总金额
+}
+
+// TradeBillReader 以流的方式逐条读取交易账单
+//
+// 按表头中的列名解析各列，因此适用于全部订单（ALL）、成功订单（SUCCESS）与退款订单（REFUND）等不同类型的账单。
+// 需要读取退款订单账单特有的退款申请时间与退款成功时间时，请使用 RefundBillReader。
+// 账单为 gzip 压缩格式（tar_type 为 GZIP）时，请先使用 gzip.NewReader 解压。
+type TradeBillReader struct {
+	table billTable
+}
+
+// NewTradeBillReader 使用账单内容创建 TradeBillReader
+func NewTradeBillReader(r io.Reader) *TradeBillReader {
+	return &TradeBillReader{table: newBillTable(r, "trade bill")}
+}
+
+// Read 读取下一条记录，读取完所有记录后返回 io.EOF，此后可以通过 Summary 获取账单的汇总信息
+func (r *TradeBillReader) Read() (*TradeBillRecord, error) {
+	p, err := r.table.next()
+	if err != nil {
+		return nil, err
+	}
+	record := parseTradeBillRecord(p)
+	if p.err != nil {
+		return nil, r.table.parseError(p.err)
+	}
+	return record, nil
+}
+
+// Summary 返回账单的汇总信息，Read 返回 io.EOF 之前或账单中不包含汇总信息时返回 nil
+func (r *TradeBillReader) Summary() *TradeBillSummary {
+	return r.table.summary
+}
+
+func parseTradeBillRecord(p *fieldParser) *TradeBillRecord {
+	return &TradeBillRecord{
+		TradeTime:             p.time(columnTradeTime),
+		Appid:                 p.string(columnAppid),
+		Mchid:                 p.string(columnMchid),
+		SubMchid:              p.string(columnSubMchid),
+		DeviceInfo:            p.string(columnDeviceInfo),
+		TransactionId:         p.string(columnTransactionId),
+		OutTradeNo:            p.string(columnOutTradeNo),
+		Openid:                p.string(columnOpenid),
+		TradeType:             p.string(columnTradeType),
+		TradeState:            p.string(columnTradeState),
+		BankType:              p.string(columnBankType),
+		Currency:              p.string(columnCurrency),
+		SettlementTotalAmount: p.amount(columnSettlementTotal),
+		CouponAmount:          p.amount(columnCouponAmount),
+		RefundId:              p.string(columnRefundId),
+		OutRefundNo:           p.string(columnOutRefundNo),
+		RefundAmount:          p.amount(columnRefundAmount),
+		CouponRefundAmount:    p.amount(columnCouponRefund),
+		RefundType:            p.string(columnRefundType),
+		RefundStatus:          p.string(columnRefundStatus),
+		GoodsName:             p.string(columnGoodsName),
+		Attach:                p.string(columnAttach),
+		ServiceFee:            p.string(columnServiceFee),
+		Rate:                  p.string(columnRate),
+		TotalAmount:           p.amount(columnTotalAmount),
+		RefundApplyAmount:     p.amount(columnRefundApply),
+		RateRemark:            p.string(columnRateRemark),
+	}
+}
+
+// billTable 按表头读取账单中的记录行，读取到汇总信息时结束。交易账单与退款账单的表格结构相同，仅列不同
+type billTable struct {
+	name    string
+	reader  *csv.Reader
+	columns map[string]int
+	summary *TradeBillSummary
+	line    int
+}
+
+func newBillTable(r io.Reader, name string) billTable {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	return billTable{name: name, reader: reader}
+}
+
+// next 返回下一条记录行的 fieldParser，读取完所有记录后返回 io.EOF
+func (t *billTable) next() (*fieldParser, error) {
+	for {
+		fields, err := t.read()
+		if err != nil {
+			return nil, err
+		}
+		if isBlank(fields) {
+			continue
+		}
+
+		if t.columns == nil {
+			t.columns = make(map[string]int, len(fields))
+			for i, name := range fields {
+				t.columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+			}
+			if _, ok := t.columns[columnOutTradeNo]; !ok {
+				return nil, fmt.Errorf("invalid %s header at line %d: column %s is missing", t.name, t.line, columnOutTradeNo)
+			}
+			continue
+		}
+
+		if strings.TrimSpace(fields[0]) == summaryFirstColumn {
+			if err = t.readSummary(fields); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+
+		return &fieldParser{columns: t.columns, fields: fields}, nil
+	}
+}
+
+func (t *billTable) parseError(err error) error {
+	return fmt.Errorf("parse %s at line %d err:%v", t.name, t.line, err)
+}
+
+func (t *billTable) read() ([]string, error) {
+	fields, err := t.reader.Read()
+	t.line++
+	if err == io.EOF {
+		if t.columns == nil {
+			return nil, fmt.Errorf("empty %s", t.name)
+		}
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s at line %d err:%v", t.name, t.line, err)
+	}
+	return fields, nil
+}
+
+func (t *billTable) readSummary(header []string) error {
+	fields, err := t.read()
+	if err == io.EOF {
+		return fmt.Errorf("%s summary is missing at line %d", t.name, t.line)
+	}
+	if err != nil {
+		return err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	p := fieldParser{columns: columns, fields: fields}
+	summary := &TradeBillSummary{
+		TotalCount:            p.int("总交易单数"),
+		SettlementTotalAmount: p.amount("应结订单总金额"),
+		RefundAmount:          p.amount("退款总金额"),
+		CouponRefundAmount:    p.amount("充值券退款总金额"),
+		ServiceFee:            p.string("手续费总金额"),
+		TotalAmount:           p.amount("订单总金额"),
+		RefundApplyAmount:     p.amount("申请退款总金额"),
+	}
+	if p.err != nil {
+		return fmt.Errorf("parse %s summary at line %d err:%v", t.name, t.line, p.err)
+	}
+	t.summary = summary
+	return nil
+}
+
+// fieldParser 按列名读取字段，记录遇到的第一个错误
+type fieldParser struct {
+	columns map[string]int
+	fields  []string
+	err     error
+}
+
+// string 返回去除前缀 ` 后的字段内容，列不存在时返回空字符串
+func (p *fieldParser) string(column string) string {
+	i, ok := p.columns[column]
+	if !ok || i >= len(p.fields) {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(p.fields[i]), "`"))
+}
+
+func (p *fieldParser) time(column string) time.Time {
+	value := p.string(column)
+	if value == "" || p.err != nil {
+		return time.Time{}
+	}
+	t, err := time.ParseInLocation(tradeTimeLayout, value, beijing)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s %q", column, value)
+	}
+	return t
+}
+
+func (p *fieldParser) amount(column string) int64 {
+	value := p.string(column)
+	if value == "" || p.err != nil {
+		return 0
+	}
+	amount, err := ParseAmount(value)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s %q", column, value)
+	}
+	return amount
+}
+
+func (p *fieldParser) int(column string) int64 {
+	value := p.string(column)
+	if value == "" || p.err != nil {
+		return 0
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s %q", column, value)
+	}
+	return n
+}
+
+// ParseAmount 将账单中以元为单位的金额（如 12.34）转换为以分为单位的整数，小数部分超过两位时返回错误
+func ParseAmount(yuan string) (int64, error) {
+	value := strings.TrimSpace(yuan)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	integer, fraction := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		integer, fraction = value[:i], value[i+1:]
+	}
+	if integer == "" || len(fraction) > 2 {
+		return 0, fmt.Errorf("invalid amount %q", yuan)
+	}
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	amount, err := strconv.ParseInt(integer+fraction, 10, 64)
+	if err != nil || amount < 0 {
+		return 0, fmt.Errorf("invalid amount %q", yuan)
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+func isBlank(fields []string) bool {
+	for _, field := range fields {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
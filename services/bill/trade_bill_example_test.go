@@ -0,0 +1,66 @@
+package bill_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+const tradeBill = "交易时间,公众账号ID,商户号,特约商户号,设备号,微信订单号,商户订单号,用户标识,交易类型,交易状态,付款银行,货币种类,应结订单金额,代金券金额,微信退款单号,商户退款单号,退款金额,充值券退款金额,退款类型,退款状态,商品名称,商户数据包,手续费,费率,订单金额,申请退款金额,费率备注\n" +
+	"`2021-07-01 10:00:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200001234202107010000000001,`1217752501201407033233368018,`oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,`JSAPI,`SUCCESS,`OTHERS,`CNY,`12.34,`0.00,`0,`0,`0.00,`0.00,`,`,`Image形象店-深圳腾大-QQ公仔,`,`0.07400,`0.60%,`12.34,`0.00,`\n" +
+	"`2021-07-01 11:00:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200001234202106300000000002,`1217752501201406303233368019,`oUpF8uMuAJO_M2pxb1Q9zNjWeS6o,`JSAPI,`REFUND,`OTHERS,`CNY,`0.00,`0.00,`50000000000000000001,`R1217752501201406303233368019,`1.00,`0.00,`ORIGINAL,`SUCCESS,`Image形象店-深圳腾大-QQ公仔,`,`-0.00600,`0.60%,`0.00,`1.00,`\n" +
+	"总交易单数,应结订单总金额,退款总金额,充值券退款总金额,手续费总金额,订单总金额,申请退款总金额\n" +
+	"`2,`12.34,`1.00,`0.00,`0.06800,`12.34,`1.00\n"
+
+func ExampleTradeBillReader() {
+	reader := bill.NewTradeBillReader(strings.NewReader(tradeBill))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(record.OutTradeNo, record.TradeState, record.TotalAmount, record.RefundAmount)
+	}
+
+	summary := reader.Summary()
+	fmt.Println(summary.TotalCount, summary.TotalAmount, summary.RefundAmount, summary.ServiceFee)
+	// Output:
+	// 1217752501201407033233368018 SUCCESS 1234 0
+	// 1217752501201406303233368019 REFUND 0 100
+	// 2 1234 100 0.06800
+}
+
+func ExampleParseAmount() {
+	amount, err := bill.ParseAmount("12.3")
+	fmt.Println(amount, err)
+	// Output:
+	// 1230 <nil>
+}
+
+func ExampleNewDigestReader() {
+	// hashType 与 hashValue 为申请账单接口应答中的 hash_type 与 hash_value
+	reader, err := bill.NewDigestReader(strings.NewReader("hello bill\n"),
+		bill.HashTypeSHA1, "684b964c065e64bf8ff48b220168232076a3b45c")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	content, err := ioutil.ReadAll(reader)
+	fmt.Printf("%q %v\n", content, err)
+
+	reader, _ = bill.NewDigestReader(strings.NewReader("tampered bill\n"),
+		bill.HashTypeSHA1, "684b964c065e64bf8ff48b220168232076a3b45c")
+	_, err = ioutil.ReadAll(reader)
+	fmt.Println(errors.Is(err, bill.ErrDigestMismatch))
+	// Output:
+	// "hello bill\n" <nil>
+	// true
+}
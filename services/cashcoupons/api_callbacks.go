@@ -0,0 +1,72 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 代金券API
+//
+// 商户创建代金券批次后，查询批次预算、发放代金券与设置消息通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package cashcoupons
+
+import (
+	"context"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type CallbacksApiService services.Service
+
+// SetCallbacks 设置消息通知地址
+//
+// # 应用场景
+// 用于设置接收代金券核销事件通知的URL，可接收核销事件通知。
+//
+// 注意：
+// 1、通知地址需为不带查询参数的https地址
+// 2、可使用 CallbacksApiService.SetVerifiedCallbacks 在设置前检查通知地址是否可访问并正确验签
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CallbacksApiService) SetCallbacks(ctx context.Context, req SetCallbacksBody) (resp *Callback, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/favor/callbacks"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Callback from Http Response
+	resp = new(Callback)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
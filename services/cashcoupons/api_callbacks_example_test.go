@@ -0,0 +1,38 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 代金券API
+//
+// 商户创建代金券批次后，查询批次预算、发放代金券与设置消息通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package cashcoupons_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/cashcoupons"
+)
+
+func ExampleCallbacksApiService_SetCallbacks() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := cashcoupons.CallbacksApiService{Client: client}
+	resp, result, err := svc.SetCallbacks(ctx,
+		cashcoupons.SetCallbacksBody{
+			Mchid:     core.String("9856888"),
+			NotifyUrl: core.String("https://pay.weixin.qq.com"),
+			Switch:    core.Bool(true),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
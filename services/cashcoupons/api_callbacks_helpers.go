@@ -0,0 +1,23 @@
+package cashcoupons
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// SetVerifiedCallbacks 使用 notify.ProbeEndpoint 检查通知地址可访问并正确验签后，再设置消息通知地址
+//
+// httpClient 用于发送探测通知，为 nil 时使用 http.DefaultClient。检查不通过时返回 notify.ProbeEndpoint 的错误，不修改通知地址。
+func (a *CallbacksApiService) SetVerifiedCallbacks(
+	ctx context.Context, httpClient *http.Client, req SetCallbacksBody,
+) (resp *Callback, result *core.APIResult, err error) {
+	if req.NotifyUrl != nil {
+		if _, err = notify.ProbeEndpoint(ctx, httpClient, *req.NotifyUrl); err != nil {
+			return nil, nil, err
+		}
+	}
+	return a.SetCallbacks(ctx, req)
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 代金券API
+//
+// 商户创建代金券批次后，查询批次预算、发放代金券与设置消息通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package cashcoupons
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type CouponApiService services.Service
+
+// SendCoupon 发放代金券
+//
+// # 应用场景
+// 商户可以通过该接口向用户发放指定批次的代金券。
+//
+// 注意：
+// 1、批次预算或单日预算耗尽后发放失败，可使用 cashcoupons.BudgetGuard 在发放前检查剩余预算
+// 2、商户单据号相同时视为同一次发放
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|批次不存在|批次号有误或批次不属于该商户|请确认批次号与创建批次的商户号|
+// |NOT_ENOUGH|批次预算不足|批次预算或单日预算已用完|请增加批次预算或次日再发放|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CouponApiService) SendCoupon(ctx context.Context, req SendCouponRequest) (resp *SendCouponResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.Openid == nil {
+		return nil, nil, fmt.Errorf("field `Openid` is required and must be specified in SendCouponRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/favor/users/{openid}/coupons"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"openid"+"}", neturl.PathEscape(core.ParameterToString(*req.Openid, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &SendCouponBody{
+		StockId:           req.StockId,
+		OutRequestNo:      req.OutRequestNo,
+		Appid:             req.Appid,
+		StockCreatorMchid: req.StockCreatorMchid,
+		CouponValue:       req.CouponValue,
+		CouponMinimum:     req.CouponMinimum,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract SendCouponResponse from Http Response
+	resp = new(SendCouponResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 代金券API
+//
+// 商户创建代金券批次后，查询批次预算、发放代金券与设置消息通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package cashcoupons_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/cashcoupons"
+)
+
+func ExampleCouponApiService_SendCoupon() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := cashcoupons.CouponApiService{Client: client}
+	resp, result, err := svc.SendCoupon(ctx,
+		cashcoupons.SendCouponRequest{
+			Appid:             core.String("wx233544546545989"),
+			CouponMinimum:     core.Int64(100),
+			CouponValue:       core.Int64(100),
+			Openid:            core.String("2323dfsdf342342"),
+			OutRequestNo:      core.String("89560002019101000121"),
+			StockCreatorMchid: core.String("8956000"),
+			StockId:           core.String("9856000"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
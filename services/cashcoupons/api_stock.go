@@ -0,0 +1,83 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 代金券API
+//
+// 商户创建代金券批次后，查询批次预算、发放代金券与设置消息通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package cashcoupons
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type StockApiService services.Service
+
+// QueryStock 查询批次详情
+//
+// # 应用场景
+// 通过此接口可查询批次信息，包括批次的配置信息以及批次概况数据。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|批次不存在|批次号有误或批次不属于该商户|请确认批次号与创建批次的商户号|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *StockApiService) QueryStock(ctx context.Context, req QueryStockRequest) (resp *Stock, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.StockId == nil {
+		return nil, nil, fmt.Errorf("field `StockId` is required and must be specified in QueryStockRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/favor/stocks/{stock_id}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"stock_id"+"}", neturl.PathEscape(core.ParameterToString(*req.StockId, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.StockCreatorMchid == nil {
+		return nil, nil, fmt.Errorf("field `StockCreatorMchid` is required and must be specified in QueryStockRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("stock_creator_mchid", core.ParameterToString(*req.StockCreatorMchid, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Stock from Http Response
+	resp = new(Stock)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,292 @@
+package cashcoupons
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+var (
+	// ErrStockNotRunning 批次不在运行中（未激活、审核中、已停止或暂停发放），无法发放代金券
+	ErrStockNotRunning = errors.New("stock is not running")
+	// ErrBudgetExhausted 批次剩余预算或剩余券数量不足，无法继续发放代金券
+	ErrBudgetExhausted = errors.New("stock budget exhausted")
+)
+
+// errCodeNotEnough 发放代金券时批次预算或单日预算不足的错误码
+const errCodeNotEnough = "NOT_ENOUGH"
+
+// StockBudget 批次的预算概况
+//
+// 固定面额批次按“已发券数量 × 面额”计算已发放金额；其他类型的批次无法得知每张券的面额，仅统计券数量，AmountKnown 为 false。
+// 查询批次详情API不返回当天已发放金额，单日预算耗尽只能在发放代金券失败时得知。
+type StockBudget struct {
+	// StockId 批次号
+	StockId string
+	// Status 批次状态
+	Status StockStatus
+	// AmountKnown 是否可以计算已发放金额与剩余预算
+	AmountKnown bool
+	// CouponAmount 固定面额批次的券面额，单位为分
+	CouponAmount int64
+	// MaxAmount 总预算，单位为分
+	MaxAmount int64
+	// MaxAmountByDay 单天发放上限金额，单位为分
+	MaxAmountByDay int64
+	// DistributedAmount 已发放金额，单位为分
+	DistributedAmount int64
+	// MaxCoupons 最大发券数
+	MaxCoupons int64
+	// DistributedCoupons 已发券数量
+	DistributedCoupons int64
+}
+
+// NewStockBudget 使用批次详情计算批次的预算概况
+func NewStockBudget(stock *Stock) *StockBudget {
+	budget := &StockBudget{}
+	if stock == nil {
+		return budget
+	}
+	if stock.StockId != nil {
+		budget.StockId = *stock.StockId
+	}
+	if stock.Status != nil {
+		budget.Status = *stock.Status
+	}
+	if stock.DistributedCoupons != nil {
+		budget.DistributedCoupons = *stock.DistributedCoupons
+	}
+	rule := stock.StockUseRule
+	if rule == nil {
+		return budget
+	}
+	if rule.MaxAmount != nil {
+		budget.MaxAmount = *rule.MaxAmount
+	}
+	if rule.MaxAmountByDay != nil {
+		budget.MaxAmountByDay = *rule.MaxAmountByDay
+	}
+	if rule.MaxCoupons != nil {
+		budget.MaxCoupons = *rule.MaxCoupons
+	}
+	if rule.FixedNormalCoupon != nil && rule.FixedNormalCoupon.CouponAmount != nil {
+		budget.AmountKnown = true
+		budget.CouponAmount = *rule.FixedNormalCoupon.CouponAmount
+		budget.DistributedAmount = budget.DistributedCoupons * budget.CouponAmount
+	}
+	return budget
+}
+
+// RemainingAmount 剩余预算，单位为分，AmountKnown 为 false 时返回 0
+func (b *StockBudget) RemainingAmount() int64 {
+	if !b.AmountKnown || b.MaxAmount <= b.DistributedAmount {
+		return 0
+	}
+	return b.MaxAmount - b.DistributedAmount
+}
+
+// RemainingCoupons 剩余可发券数量
+func (b *StockBudget) RemainingCoupons() int64 {
+	if b.MaxCoupons <= b.DistributedCoupons {
+		return 0
+	}
+	return b.MaxCoupons - b.DistributedCoupons
+}
+
+// check 检查批次能否再发放一张面额为 couponValue 的券，剩余预算需在发放后仍不少于 reserve
+func (b *StockBudget) check(couponValue, reserve int64) error {
+	if b.Status != STOCKSTATUS_RUNNING {
+		return fmt.Errorf("stock %s is %s: %w", b.StockId, b.Status, ErrStockNotRunning)
+	}
+	if b.RemainingCoupons() <= 0 {
+		return fmt.Errorf("stock %s distributed %d/%d coupons: %w",
+			b.StockId, b.DistributedCoupons, b.MaxCoupons, ErrBudgetExhausted)
+	}
+	if !b.AmountKnown {
+		return nil
+	}
+	if couponValue <= 0 {
+		couponValue = b.CouponAmount
+	}
+	if remaining := b.RemainingAmount(); remaining < couponValue+reserve {
+		return fmt.Errorf("stock %s remaining budget %d is less than %d: %w",
+			b.StockId, remaining, couponValue+reserve, ErrBudgetExhausted)
+	}
+	return nil
+}
+
+// BudgetManager 批次预算查询
+//
+// 代金券没有修改批次预算的API，批次预算只能在商户平台修改；商家券请使用 merchantexclusivecoupon.BusiFavorApiService.ModifyBudget
+// 修改批次最大发放个数。
+type BudgetManager struct {
+	svc               StockApiService
+	stockCreatorMchid string
+}
+
+// NewBudgetManager 创建查询 stockCreatorMchid 所创建批次的 BudgetManager
+func NewBudgetManager(client *core.Client, stockCreatorMchid string) *BudgetManager {
+	return &BudgetManager{svc: StockApiService{Client: client}, stockCreatorMchid: stockCreatorMchid}
+}
+
+// Budget 查询批次的预算概况
+func (m *BudgetManager) Budget(ctx context.Context, stockID string) (*StockBudget, error) {
+	stock, _, err := m.svc.QueryStock(ctx, QueryStockRequest{
+		StockId:           core.String(stockID),
+		StockCreatorMchid: core.String(m.stockCreatorMchid),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewStockBudget(stock), nil
+}
+
+const defaultBudgetCacheTTL = 30 * time.Second
+
+type cachedBudget struct {
+	budget   *StockBudget
+	expireAt time.Time
+}
+
+// BudgetGuard 在发放代金券前检查批次状态与剩余预算，预算不足时返回 ErrBudgetExhausted 而不发起发放请求
+//
+// 为避免每次发放都查询批次详情，查询结果会缓存一段时间，并在每次发放成功后扣减本地的剩余预算。
+// 缓存期间其他进程发放的券不会计入，因此剩余预算接近耗尽时请通过 WithBudgetReserve 预留部分预算。
+type BudgetGuard struct {
+	manager *BudgetManager
+	coupons CouponApiService
+	reserve int64
+	ttl     time.Duration
+
+	lock   sync.Mutex
+	cached map[string]cachedBudget
+}
+
+// BudgetGuardOption BudgetGuard 的配置项
+type BudgetGuardOption func(g *BudgetGuard)
+
+// WithBudgetReserve 设置预留预算（单位为分），发放后剩余预算将低于预留预算时拒绝发放，默认为 0
+func WithBudgetReserve(reserve int64) BudgetGuardOption {
+	return func(g *BudgetGuard) {
+		if reserve >= 0 {
+			g.reserve = reserve
+		}
+	}
+}
+
+// WithBudgetCacheTTL 设置批次预算的缓存时间，默认为 30s，为 0 时每次发放前都查询批次详情
+func WithBudgetCacheTTL(ttl time.Duration) BudgetGuardOption {
+	return func(g *BudgetGuard) {
+		if ttl >= 0 {
+			g.ttl = ttl
+		}
+	}
+}
+
+// NewBudgetGuard 创建发放 stockCreatorMchid 所创建批次代金券的 BudgetGuard
+func NewBudgetGuard(client *core.Client, stockCreatorMchid string, opts ...BudgetGuardOption) *BudgetGuard {
+	g := &BudgetGuard{
+		manager: NewBudgetManager(client, stockCreatorMchid),
+		coupons: CouponApiService{Client: client},
+		ttl:     defaultBudgetCacheTTL,
+		cached:  make(map[string]cachedBudget),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Check 检查批次能否再发放一张面额为 couponValue（为 0 时使用批次面额）的代金券
+//
+// 批次不在运行中时返回 ErrStockNotRunning，剩余券数量或预算不足时返回 ErrBudgetExhausted，可使用 errors.Is 判断
+func (g *BudgetGuard) Check(ctx context.Context, stockID string, couponValue int64) error {
+	budget, err := g.budget(ctx, stockID)
+	if err != nil {
+		return err
+	}
+	return budget.check(couponValue, g.reserve)
+}
+
+// SendCoupon 检查剩余预算后发放代金券，req.StockCreatorMchid 为空时使用创建 BudgetGuard 时指定的商户号
+//
+// 发放请求因批次预算或单日预算不足（NOT_ENOUGH）失败时，返回的错误同样可以使用 errors.Is(err, ErrBudgetExhausted) 判断
+func (g *BudgetGuard) SendCoupon(
+	ctx context.Context, req SendCouponRequest,
+) (*SendCouponResponse, *core.APIResult, error) {
+	if req.StockId == nil {
+		return nil, nil, fmt.Errorf("field `StockId` is required and must be specified in SendCouponRequest")
+	}
+	if req.StockCreatorMchid == nil {
+		req.StockCreatorMchid = core.String(g.manager.stockCreatorMchid)
+	}
+	stockID := *req.StockId
+	couponValue := int64(0)
+	if req.CouponValue != nil {
+		couponValue = *req.CouponValue
+	}
+	if err := g.Check(ctx, stockID, couponValue); err != nil {
+		return nil, nil, err
+	}
+
+	resp, result, err := g.coupons.SendCoupon(ctx, req)
+	if err != nil {
+		var apiErr *core.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == errCodeNotEnough {
+			g.invalidate(stockID)
+			return nil, result, fmt.Errorf("send coupon of stock %s err:%v: %w", stockID, err, ErrBudgetExhausted)
+		}
+		return nil, result, err
+	}
+	g.consume(stockID, couponValue)
+	return resp, result, nil
+}
+
+func (g *BudgetGuard) budget(ctx context.Context, stockID string) (*StockBudget, error) {
+	now := time.Now()
+	g.lock.Lock()
+	cached, ok := g.cached[stockID]
+	g.lock.Unlock()
+	if ok && now.Before(cached.expireAt) {
+		return cached.budget, nil
+	}
+
+	budget, err := g.manager.Budget(ctx, stockID)
+	if err != nil {
+		return nil, err
+	}
+	if g.ttl > 0 {
+		g.lock.Lock()
+		g.cached[stockID] = cachedBudget{budget: budget, expireAt: now.Add(g.ttl)}
+		g.lock.Unlock()
+	}
+	return budget, nil
+}
+
+// consume 发放成功后扣减缓存中的剩余预算
+func (g *BudgetGuard) consume(stockID string, couponValue int64) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	cached, ok := g.cached[stockID]
+	if !ok {
+		return
+	}
+	budget := *cached.budget
+	if couponValue <= 0 {
+		couponValue = budget.CouponAmount
+	}
+	budget.DistributedCoupons++
+	budget.DistributedAmount += couponValue
+	cached.budget = &budget
+	g.cached[stockID] = cached
+}
+
+func (g *BudgetGuard) invalidate(stockID string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	delete(g.cached, stockID)
+}
@@ -0,0 +1,67 @@
+package cashcoupons_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/cashcoupons"
+)
+
+func ExampleBudgetGuard_SendCoupon() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	// 剩余预算低于 100 元时停止发放，留出增加预算的时间
+	guard := cashcoupons.NewBudgetGuard(client, "9856888", cashcoupons.WithBudgetReserve(10000))
+	resp, _, err := guard.SendCoupon(ctx, cashcoupons.SendCouponRequest{
+		Openid:       core.String("2323dfsdf342342"),
+		StockId:      core.String("9856000"),
+		OutRequestNo: core.String("89560002019101000121"),
+		Appid:        core.String("wx233544546545989"),
+	})
+	if errors.Is(err, cashcoupons.ErrBudgetExhausted) {
+		// TODO: 告警并在商户平台增加批次预算
+		return
+	}
+
+	// TODO: 处理返回结果
+	_, _ = resp, err
+}
+
+func ExampleBudgetManager_Budget() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	manager := cashcoupons.NewBudgetManager(client, "9856888")
+	budget, err := manager.Budget(ctx, "9856000")
+
+	// TODO: 处理返回结果
+	_, _ = budget, err
+}
+
+func ExampleNewStockBudget() {
+	budget := cashcoupons.NewStockBudget(&cashcoupons.Stock{
+		StockId:            core.String("9856000"),
+		Status:             cashcoupons.STOCKSTATUS_RUNNING.Ptr(),
+		DistributedCoupons: core.Int64(45),
+		StockUseRule: &cashcoupons.StockUseRule{
+			MaxCoupons: core.Int64(50),
+			MaxAmount:  core.Int64(5000),
+			FixedNormalCoupon: &cashcoupons.FixedValueStockMsg{
+				CouponAmount:       core.Int64(100),
+				TransactionMinimum: core.Int64(100),
+			},
+		},
+	})
+	fmt.Println(budget.RemainingAmount(), budget.RemainingCoupons())
+	// Output:
+	// 500 5
+}
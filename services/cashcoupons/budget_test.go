@@ -0,0 +1,280 @@
+package cashcoupons_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/cashcoupons"
+)
+
+const (
+	testStockID           = "9856000"
+	testStockCreatorMchid = "9856888"
+)
+
+// fakeFavorServer 模拟查询批次详情与发放代金券API
+type fakeFavorServer struct {
+	lock        sync.Mutex
+	status      string
+	distributed int64
+	maxCoupons  int64
+	maxAmount   int64
+	// queryStatus、sendStatus 非 0 时以对应状态码应答错误
+	queryStatus int
+	sendStatus  int
+	sendCode    string
+
+	queries int
+	sends   int
+}
+
+func (s *fakeFavorServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/v3/marketing/favor/stocks/"+testStockID):
+		s.queries++
+		if s.queryStatus != 0 {
+			clienttest.WriteError(w, s.queryStatus, "SYSTEM_ERROR", "系统错误")
+			return
+		}
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"stock_id":            testStockID,
+			"stock_creator_mchid": r.URL.Query().Get("stock_creator_mchid"),
+			"stock_name":          "微信支付代金券",
+			"status":              s.status,
+			"create_time":         "2015-05-20T13:29:35+08:00",
+			"description":         "微信支付营销",
+			"distributed_coupons": s.distributed,
+			"stock_use_rule": map[string]interface{}{
+				"max_coupons": s.maxCoupons,
+				"max_amount":  s.maxAmount,
+				"fixed_normal_coupon": map[string]interface{}{
+					"coupon_amount":       100,
+					"transaction_minimum": 100,
+				},
+			},
+		})
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/v3/marketing/favor/users/"):
+		s.sends++
+		if s.sendStatus != 0 {
+			clienttest.WriteError(w, s.sendStatus, s.sendCode, "发放失败")
+			return
+		}
+		s.distributed++
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{"coupon_id": "9867041"})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *fakeFavorServer) counts() (queries, sends int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.queries, s.sends
+}
+
+func runningStock(distributed, maxCoupons, maxAmount int64) *fakeFavorServer {
+	return &fakeFavorServer{status: "running", distributed: distributed, maxCoupons: maxCoupons, maxAmount: maxAmount}
+}
+
+func newTestBudgetGuard(
+	t *testing.T, server *fakeFavorServer, opts ...cashcoupons.BudgetGuardOption,
+) *cashcoupons.BudgetGuard {
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	return cashcoupons.NewBudgetGuard(client, testStockCreatorMchid, opts...)
+}
+
+func sendCouponRequest() cashcoupons.SendCouponRequest {
+	return cashcoupons.SendCouponRequest{
+		Openid:       core.String("2323dfsdf342342"),
+		StockId:      core.String(testStockID),
+		OutRequestNo: core.String("89560002019101000121"),
+		Appid:        core.String("wx233544546545989"),
+	}
+}
+
+func TestStockBudget(t *testing.T) {
+	budget := cashcoupons.NewStockBudget(&cashcoupons.Stock{
+		StockId:            core.String(testStockID),
+		Status:             cashcoupons.STOCKSTATUS_RUNNING.Ptr(),
+		DistributedCoupons: core.Int64(60),
+		StockUseRule: &cashcoupons.StockUseRule{
+			MaxCoupons: core.Int64(50),
+			MaxAmount:  core.Int64(5000),
+			FixedNormalCoupon: &cashcoupons.FixedValueStockMsg{
+				CouponAmount:       core.Int64(100),
+				TransactionMinimum: core.Int64(100),
+			},
+		},
+	})
+	assert.True(t, budget.AmountKnown)
+	assert.Equal(t, int64(6000), budget.DistributedAmount)
+	// 已发放超过上限时剩余量为 0 而不是负数
+	assert.Equal(t, int64(0), budget.RemainingAmount())
+	assert.Equal(t, int64(0), budget.RemainingCoupons())
+
+	// 非固定面额批次无法计算金额
+	budget = cashcoupons.NewStockBudget(&cashcoupons.Stock{
+		DistributedCoupons: core.Int64(10),
+		StockUseRule:       &cashcoupons.StockUseRule{MaxCoupons: core.Int64(50), MaxAmount: core.Int64(5000)},
+	})
+	assert.False(t, budget.AmountKnown)
+	assert.Equal(t, int64(0), budget.RemainingAmount())
+	assert.Equal(t, int64(40), budget.RemainingCoupons())
+
+	assert.Equal(t, &cashcoupons.StockBudget{}, cashcoupons.NewStockBudget(nil))
+}
+
+func TestBudgetGuard_Check(t *testing.T) {
+	tests := []struct {
+		name        string
+		server      *fakeFavorServer
+		couponValue int64
+		opts        []cashcoupons.BudgetGuardOption
+		wantErr     error
+	}{
+		{name: "enough budget", server: runningStock(10, 50, 5000)},
+		{name: "stock not running", server: &fakeFavorServer{status: "paused", maxCoupons: 50, maxAmount: 5000}, wantErr: cashcoupons.ErrStockNotRunning},
+		{name: "coupons exhausted", server: runningStock(50, 50, 10000), wantErr: cashcoupons.ErrBudgetExhausted},
+		{name: "amount exhausted", server: runningStock(50, 100, 5000), wantErr: cashcoupons.ErrBudgetExhausted},
+		{name: "last coupon", server: runningStock(49, 50, 5000)},
+		{name: "coupon value exceeds remaining", server: runningStock(48, 50, 5000), couponValue: 300, wantErr: cashcoupons.ErrBudgetExhausted},
+		{
+			name:    "reserve kept",
+			server:  runningStock(40, 50, 5000),
+			opts:    []cashcoupons.BudgetGuardOption{cashcoupons.WithBudgetReserve(1000)},
+			wantErr: cashcoupons.ErrBudgetExhausted,
+		},
+		{name: "reserve not reached", server: runningStock(39, 50, 5000), opts: []cashcoupons.BudgetGuardOption{cashcoupons.WithBudgetReserve(1000)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard := newTestBudgetGuard(t, tt.server, tt.opts...)
+			err := guard.Check(context.Background(), testStockID, tt.couponValue)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.wantErr), "%v", err)
+		})
+	}
+}
+
+func TestBudgetGuard_SendCouponExhausted(t *testing.T) {
+	server := runningStock(48, 50, 5000)
+	guard := newTestBudgetGuard(t, server, cashcoupons.WithBudgetCacheTTL(time.Hour))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		resp, _, err := guard.SendCoupon(ctx, sendCouponRequest())
+		require.NoError(t, err)
+		assert.Equal(t, "9867041", *resp.CouponId)
+	}
+
+	// 缓存中的剩余预算随发放扣减，耗尽后不再发起发放请求
+	_, _, err := guard.SendCoupon(ctx, sendCouponRequest())
+	assert.True(t, errors.Is(err, cashcoupons.ErrBudgetExhausted))
+	queries, sends := server.counts()
+	assert.Equal(t, 1, queries)
+	assert.Equal(t, 2, sends)
+}
+
+func TestBudgetGuard_Cache(t *testing.T) {
+	ctx := context.Background()
+
+	server := runningStock(10, 50, 5000)
+	guard := newTestBudgetGuard(t, server, cashcoupons.WithBudgetCacheTTL(time.Hour))
+	require.NoError(t, guard.Check(ctx, testStockID, 0))
+	require.NoError(t, guard.Check(ctx, testStockID, 0))
+	queries, _ := server.counts()
+	assert.Equal(t, 1, queries)
+
+	// 缓存时间为 0 时每次都查询批次详情
+	server = runningStock(10, 50, 5000)
+	guard = newTestBudgetGuard(t, server, cashcoupons.WithBudgetCacheTTL(0))
+	require.NoError(t, guard.Check(ctx, testStockID, 0))
+	require.NoError(t, guard.Check(ctx, testStockID, 0))
+	queries, _ = server.counts()
+	assert.Equal(t, 2, queries)
+
+	// 缓存过期后重新查询
+	server = runningStock(10, 50, 5000)
+	guard = newTestBudgetGuard(t, server, cashcoupons.WithBudgetCacheTTL(time.Millisecond))
+	require.NoError(t, guard.Check(ctx, testStockID, 0))
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, guard.Check(ctx, testStockID, 0))
+	queries, _ = server.counts()
+	assert.Equal(t, 2, queries)
+}
+
+func TestBudgetGuard_SendCouponNotEnough(t *testing.T) {
+	server := runningStock(10, 50, 5000)
+	server.sendStatus, server.sendCode = http.StatusForbidden, "NOT_ENOUGH"
+	guard := newTestBudgetGuard(t, server, cashcoupons.WithBudgetCacheTTL(time.Hour))
+	ctx := context.Background()
+
+	// 单日预算不足时仍返回 ErrBudgetExhausted，并保留原始的 APIError
+	_, _, err := guard.SendCoupon(ctx, sendCouponRequest())
+	assert.True(t, errors.Is(err, cashcoupons.ErrBudgetExhausted))
+	assert.Contains(t, err.Error(), "NOT_ENOUGH")
+
+	// 发放失败后清除缓存，下次发放前重新查询
+	require.NoError(t, guard.Check(ctx, testStockID, 0))
+	queries, sends := server.counts()
+	assert.Equal(t, 2, queries)
+	assert.Equal(t, 1, sends)
+}
+
+func TestBudgetGuard_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	// 查询批次详情失败时不发起发放请求
+	server := runningStock(10, 50, 5000)
+	server.queryStatus = http.StatusInternalServerError
+	guard := newTestBudgetGuard(t, server)
+	_, _, err := guard.SendCoupon(ctx, sendCouponRequest())
+	require.Error(t, err)
+	assert.True(t, core.IsAPIError(err, "SYSTEM_ERROR"))
+	assert.False(t, errors.Is(err, cashcoupons.ErrBudgetExhausted))
+	_, sends := server.counts()
+	assert.Equal(t, 0, sends)
+
+	// 其他发放错误原样返回
+	server = runningStock(10, 50, 5000)
+	server.sendStatus, server.sendCode = http.StatusBadRequest, "PARAM_ERROR"
+	guard = newTestBudgetGuard(t, server)
+	_, _, err = guard.SendCoupon(ctx, sendCouponRequest())
+	assert.True(t, core.IsAPIError(err, "PARAM_ERROR"))
+	assert.False(t, errors.Is(err, cashcoupons.ErrBudgetExhausted))
+
+	req := sendCouponRequest()
+	req.StockId = nil
+	_, _, err = guard.SendCoupon(ctx, req)
+	assert.Error(t, err)
+}
+
+func TestBudgetManager_Budget(t *testing.T) {
+	server := runningStock(10, 50, 5000)
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+
+	budget, err := cashcoupons.NewBudgetManager(client, testStockCreatorMchid).Budget(context.Background(), testStockID)
+	require.NoError(t, err)
+	assert.Equal(t, testStockID, budget.StockId)
+	assert.Equal(t, cashcoupons.STOCKSTATUS_RUNNING, budget.Status)
+	assert.Equal(t, int64(4000), budget.RemainingAmount())
+	assert.Equal(t, int64(40), budget.RemainingCoupons())
+}
@@ -0,0 +1,1154 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 代金券API
+//
+// 商户创建代金券批次后，查询批次预算、发放代金券与设置消息通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package cashcoupons
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Callback
+type Callback struct {
+	// 商户号
+	Mchid *string `json:"mchid,omitempty"`
+	// 通知地址
+	NotifyUrl *string `json:"notify_url"`
+	// 修改时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+func (o Callback) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid != nil {
+		toSerialize["mchid"] = o.Mchid
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in Callback")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.UpdateTime != nil {
+		toSerialize["update_time"] = o.UpdateTime.Format(time.RFC3339)
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Callback) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.UpdateTime == nil {
+		ret += "UpdateTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("UpdateTime:%v", *o.UpdateTime)
+	}
+
+	return fmt.Sprintf("Callback{%s}", ret)
+}
+
+func (o Callback) Clone() *Callback {
+	ret := Callback{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.UpdateTime != nil {
+		ret.UpdateTime = new(time.Time)
+		*ret.UpdateTime = *o.UpdateTime
+	}
+
+	return &ret
+}
+
+// HasMchid 应答中是否返回了 mchid，o 为 nil 时返回 false
+func (o *Callback) HasMchid() bool {
+	return o != nil && o.Mchid != nil
+}
+
+// HasUpdateTime 应答中是否返回了 update_time，o 为 nil 时返回 false
+func (o *Callback) HasUpdateTime() bool {
+	return o != nil && o.UpdateTime != nil
+}
+
+// FixedValueStockMsg
+type FixedValueStockMsg struct {
+	// 面额，单位：分
+	CouponAmount *int64 `json:"coupon_amount"`
+	// 使用券金额门槛，单位：分
+	TransactionMinimum *int64 `json:"transaction_minimum"`
+}
+
+func (o FixedValueStockMsg) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CouponAmount == nil {
+		return nil, fmt.Errorf("field `CouponAmount` is required and must be specified in FixedValueStockMsg")
+	}
+	toSerialize["coupon_amount"] = o.CouponAmount
+
+	if o.TransactionMinimum == nil {
+		return nil, fmt.Errorf("field `TransactionMinimum` is required and must be specified in FixedValueStockMsg")
+	}
+	toSerialize["transaction_minimum"] = o.TransactionMinimum
+	return json.Marshal(toSerialize)
+}
+
+func (o FixedValueStockMsg) String() string {
+	var ret string
+	if o.CouponAmount == nil {
+		ret += "CouponAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CouponAmount:%v, ", *o.CouponAmount)
+	}
+
+	if o.TransactionMinimum == nil {
+		ret += "TransactionMinimum:<nil>"
+	} else {
+		ret += fmt.Sprintf("TransactionMinimum:%v", *o.TransactionMinimum)
+	}
+
+	return fmt.Sprintf("FixedValueStockMsg{%s}", ret)
+}
+
+func (o FixedValueStockMsg) Clone() *FixedValueStockMsg {
+	ret := FixedValueStockMsg{}
+
+	if o.CouponAmount != nil {
+		ret.CouponAmount = new(int64)
+		*ret.CouponAmount = *o.CouponAmount
+	}
+
+	if o.TransactionMinimum != nil {
+		ret.TransactionMinimum = new(int64)
+		*ret.TransactionMinimum = *o.TransactionMinimum
+	}
+
+	return &ret
+}
+
+// QueryStockRequest
+type QueryStockRequest struct {
+	// 批次号，微信为每个代金券批次分配的唯一id
+	StockId *string `json:"stock_id"`
+	// 创建批次的商户号
+	StockCreatorMchid *string `json:"stock_creator_mchid"`
+}
+
+func (o QueryStockRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in QueryStockRequest")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.StockCreatorMchid == nil {
+		return nil, fmt.Errorf("field `StockCreatorMchid` is required and must be specified in QueryStockRequest")
+	}
+	toSerialize["stock_creator_mchid"] = o.StockCreatorMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryStockRequest) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.StockCreatorMchid == nil {
+		ret += "StockCreatorMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("StockCreatorMchid:%v", *o.StockCreatorMchid)
+	}
+
+	return fmt.Sprintf("QueryStockRequest{%s}", ret)
+}
+
+func (o QueryStockRequest) Clone() *QueryStockRequest {
+	ret := QueryStockRequest{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.StockCreatorMchid != nil {
+		ret.StockCreatorMchid = new(string)
+		*ret.StockCreatorMchid = *o.StockCreatorMchid
+	}
+
+	return &ret
+}
+
+// SendCouponBody
+type SendCouponBody struct {
+	// 批次号，微信为每个代金券批次分配的唯一id
+	StockId *string `json:"stock_id"`
+	// 商户单据号，商户此次发放凭据号，商户侧需保持唯一性
+	OutRequestNo *string `json:"out_request_no"`
+	// 公众账号ID，微信为发券方商户分配的公众账号ID，接口传入的所有appid应该为公众号的appid或者小程序的appid
+	Appid *string `json:"appid"`
+	// 创建批次的商户号
+	StockCreatorMchid *string `json:"stock_creator_mchid"`
+	// 指定面额发券，面额，单位：分
+	CouponValue *int64 `json:"coupon_value,omitempty"`
+	// 指定面额发券，券门槛，单位：分
+	CouponMinimum *int64 `json:"coupon_minimum,omitempty"`
+}
+
+func (o SendCouponBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in SendCouponBody")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.OutRequestNo == nil {
+		return nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in SendCouponBody")
+	}
+	toSerialize["out_request_no"] = o.OutRequestNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in SendCouponBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.StockCreatorMchid == nil {
+		return nil, fmt.Errorf("field `StockCreatorMchid` is required and must be specified in SendCouponBody")
+	}
+	toSerialize["stock_creator_mchid"] = o.StockCreatorMchid
+
+	if o.CouponValue != nil {
+		toSerialize["coupon_value"] = o.CouponValue
+	}
+
+	if o.CouponMinimum != nil {
+		toSerialize["coupon_minimum"] = o.CouponMinimum
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SendCouponBody) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.OutRequestNo == nil {
+		ret += "OutRequestNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRequestNo:%v, ", *o.OutRequestNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.StockCreatorMchid == nil {
+		ret += "StockCreatorMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockCreatorMchid:%v, ", *o.StockCreatorMchid)
+	}
+
+	if o.CouponValue == nil {
+		ret += "CouponValue:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CouponValue:%v, ", *o.CouponValue)
+	}
+
+	if o.CouponMinimum == nil {
+		ret += "CouponMinimum:<nil>"
+	} else {
+		ret += fmt.Sprintf("CouponMinimum:%v", *o.CouponMinimum)
+	}
+
+	return fmt.Sprintf("SendCouponBody{%s}", ret)
+}
+
+func (o SendCouponBody) Clone() *SendCouponBody {
+	ret := SendCouponBody{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.OutRequestNo != nil {
+		ret.OutRequestNo = new(string)
+		*ret.OutRequestNo = *o.OutRequestNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.StockCreatorMchid != nil {
+		ret.StockCreatorMchid = new(string)
+		*ret.StockCreatorMchid = *o.StockCreatorMchid
+	}
+
+	if o.CouponValue != nil {
+		ret.CouponValue = new(int64)
+		*ret.CouponValue = *o.CouponValue
+	}
+
+	if o.CouponMinimum != nil {
+		ret.CouponMinimum = new(int64)
+		*ret.CouponMinimum = *o.CouponMinimum
+	}
+
+	return &ret
+}
+
+// SendCouponRequest
+type SendCouponRequest struct {
+	// 用户在商户appid下的唯一标识
+	Openid *string `json:"openid"`
+	// 批次号，微信为每个代金券批次分配的唯一id
+	StockId *string `json:"stock_id"`
+	// 商户单据号，商户此次发放凭据号，商户侧需保持唯一性
+	OutRequestNo *string `json:"out_request_no"`
+	// 公众账号ID，微信为发券方商户分配的公众账号ID，接口传入的所有appid应该为公众号的appid或者小程序的appid
+	Appid *string `json:"appid"`
+	// 创建批次的商户号
+	StockCreatorMchid *string `json:"stock_creator_mchid"`
+	// 指定面额发券，面额，单位：分
+	CouponValue *int64 `json:"coupon_value,omitempty"`
+	// 指定面额发券，券门槛，单位：分
+	CouponMinimum *int64 `json:"coupon_minimum,omitempty"`
+}
+
+func (o SendCouponRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Openid == nil {
+		return nil, fmt.Errorf("field `Openid` is required and must be specified in SendCouponRequest")
+	}
+	toSerialize["openid"] = o.Openid
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in SendCouponRequest")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.OutRequestNo == nil {
+		return nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in SendCouponRequest")
+	}
+	toSerialize["out_request_no"] = o.OutRequestNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in SendCouponRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.StockCreatorMchid == nil {
+		return nil, fmt.Errorf("field `StockCreatorMchid` is required and must be specified in SendCouponRequest")
+	}
+	toSerialize["stock_creator_mchid"] = o.StockCreatorMchid
+
+	if o.CouponValue != nil {
+		toSerialize["coupon_value"] = o.CouponValue
+	}
+
+	if o.CouponMinimum != nil {
+		toSerialize["coupon_minimum"] = o.CouponMinimum
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SendCouponRequest) String() string {
+	var ret string
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.OutRequestNo == nil {
+		ret += "OutRequestNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRequestNo:%v, ", *o.OutRequestNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.StockCreatorMchid == nil {
+		ret += "StockCreatorMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockCreatorMchid:%v, ", *o.StockCreatorMchid)
+	}
+
+	if o.CouponValue == nil {
+		ret += "CouponValue:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CouponValue:%v, ", *o.CouponValue)
+	}
+
+	if o.CouponMinimum == nil {
+		ret += "CouponMinimum:<nil>"
+	} else {
+		ret += fmt.Sprintf("CouponMinimum:%v", *o.CouponMinimum)
+	}
+
+	return fmt.Sprintf("SendCouponRequest{%s}", ret)
+}
+
+func (o SendCouponRequest) Clone() *SendCouponRequest {
+	ret := SendCouponRequest{}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.OutRequestNo != nil {
+		ret.OutRequestNo = new(string)
+		*ret.OutRequestNo = *o.OutRequestNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.StockCreatorMchid != nil {
+		ret.StockCreatorMchid = new(string)
+		*ret.StockCreatorMchid = *o.StockCreatorMchid
+	}
+
+	if o.CouponValue != nil {
+		ret.CouponValue = new(int64)
+		*ret.CouponValue = *o.CouponValue
+	}
+
+	if o.CouponMinimum != nil {
+		ret.CouponMinimum = new(int64)
+		*ret.CouponMinimum = *o.CouponMinimum
+	}
+
+	return &ret
+}
+
+// SendCouponResponse
+type SendCouponResponse struct {
+	// 代金券id，微信为代金券唯一分配的id
+	CouponId *string `json:"coupon_id"`
+}
+
+func (o SendCouponResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CouponId == nil {
+		return nil, fmt.Errorf("field `CouponId` is required and must be specified in SendCouponResponse")
+	}
+	toSerialize["coupon_id"] = o.CouponId
+	return json.Marshal(toSerialize)
+}
+
+func (o SendCouponResponse) String() string {
+	var ret string
+	if o.CouponId == nil {
+		ret += "CouponId:<nil>"
+	} else {
+		ret += fmt.Sprintf("CouponId:%v", *o.CouponId)
+	}
+
+	return fmt.Sprintf("SendCouponResponse{%s}", ret)
+}
+
+func (o SendCouponResponse) Clone() *SendCouponResponse {
+	ret := SendCouponResponse{}
+
+	if o.CouponId != nil {
+		ret.CouponId = new(string)
+		*ret.CouponId = *o.CouponId
+	}
+
+	return &ret
+}
+
+// SetCallbacksBody
+type SetCallbacksBody struct {
+	// 商户号，不填默认为调用方商户号
+	Mchid *string `json:"mchid,omitempty"`
+	// 通知地址，用于接收代金券核销事件通知的url，需为不带查询参数的https地址
+	NotifyUrl *string `json:"notify_url"`
+	// 回调开关，true：开启推送，false：停止推送
+	Switch *bool `json:"switch,omitempty"`
+}
+
+func (o SetCallbacksBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid != nil {
+		toSerialize["mchid"] = o.Mchid
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in SetCallbacksBody")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.Switch != nil {
+		toSerialize["switch"] = o.Switch
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SetCallbacksBody) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.Switch == nil {
+		ret += "Switch:<nil>"
+	} else {
+		ret += fmt.Sprintf("Switch:%v", *o.Switch)
+	}
+
+	return fmt.Sprintf("SetCallbacksBody{%s}", ret)
+}
+
+func (o SetCallbacksBody) Clone() *SetCallbacksBody {
+	ret := SetCallbacksBody{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.Switch != nil {
+		ret.Switch = new(bool)
+		*ret.Switch = *o.Switch
+	}
+
+	return &ret
+}
+
+// Stock
+type Stock struct {
+	// 批次号，微信为每个代金券批次分配的唯一id
+	StockId *string `json:"stock_id"`
+	// 创建批次的商户号
+	StockCreatorMchid *string `json:"stock_creator_mchid"`
+	// 批次名称
+	StockName *string `json:"stock_name"`
+	// 批次状态
+	Status *StockStatus `json:"status"`
+	// 创建时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	CreateTime *time.Time `json:"create_time"`
+	// 使用说明
+	Description *string `json:"description"`
+	// 满减券批次使用规则
+	StockUseRule *StockUseRule `json:"stock_use_rule,omitempty"`
+	// 可用开始时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	AvailableBeginTime *time.Time `json:"available_begin_time,omitempty"`
+	// 可用结束时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	AvailableEndTime *time.Time `json:"available_end_time,omitempty"`
+	// 已发券数量
+	DistributedCoupons *int64 `json:"distributed_coupons,omitempty"`
+	// 是否无资金流
+	NoCash *bool `json:"no_cash,omitempty"`
+	// 激活批次的时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	StartTime *time.Time `json:"start_time,omitempty"`
+	// 终止批次的时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	StopTime *time.Time `json:"stop_time,omitempty"`
+	// 是否单品优惠
+	Singleitem *bool `json:"singleitem,omitempty"`
+	// 批次类型，NORMAL：固定面额满减券批次，DISCOUNT：折扣券批次，EXCHANGE：换购券批次
+	StockType *string `json:"stock_type,omitempty"`
+}
+
+func (o Stock) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in Stock")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.StockCreatorMchid == nil {
+		return nil, fmt.Errorf("field `StockCreatorMchid` is required and must be specified in Stock")
+	}
+	toSerialize["stock_creator_mchid"] = o.StockCreatorMchid
+
+	if o.StockName == nil {
+		return nil, fmt.Errorf("field `StockName` is required and must be specified in Stock")
+	}
+	toSerialize["stock_name"] = o.StockName
+
+	if o.Status == nil {
+		return nil, fmt.Errorf("field `Status` is required and must be specified in Stock")
+	}
+	toSerialize["status"] = o.Status
+
+	if o.CreateTime == nil {
+		return nil, fmt.Errorf("field `CreateTime` is required and must be specified in Stock")
+	}
+	toSerialize["create_time"] = o.CreateTime.Format(time.RFC3339)
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in Stock")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.StockUseRule != nil {
+		toSerialize["stock_use_rule"] = o.StockUseRule
+	}
+
+	if o.AvailableBeginTime != nil {
+		toSerialize["available_begin_time"] = o.AvailableBeginTime.Format(time.RFC3339)
+	}
+
+	if o.AvailableEndTime != nil {
+		toSerialize["available_end_time"] = o.AvailableEndTime.Format(time.RFC3339)
+	}
+
+	if o.DistributedCoupons != nil {
+		toSerialize["distributed_coupons"] = o.DistributedCoupons
+	}
+
+	if o.NoCash != nil {
+		toSerialize["no_cash"] = o.NoCash
+	}
+
+	if o.StartTime != nil {
+		toSerialize["start_time"] = o.StartTime.Format(time.RFC3339)
+	}
+
+	if o.StopTime != nil {
+		toSerialize["stop_time"] = o.StopTime.Format(time.RFC3339)
+	}
+
+	if o.Singleitem != nil {
+		toSerialize["singleitem"] = o.Singleitem
+	}
+
+	if o.StockType != nil {
+		toSerialize["stock_type"] = o.StockType
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Stock) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.StockCreatorMchid == nil {
+		ret += "StockCreatorMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockCreatorMchid:%v, ", *o.StockCreatorMchid)
+	}
+
+	if o.StockName == nil {
+		ret += "StockName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockName:%v, ", *o.StockName)
+	}
+
+	if o.Status == nil {
+		ret += "Status:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Status:%v, ", *o.Status)
+	}
+
+	if o.CreateTime == nil {
+		ret += "CreateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CreateTime:%v, ", *o.CreateTime)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	ret += fmt.Sprintf("StockUseRule:%v, ", o.StockUseRule)
+
+	if o.AvailableBeginTime == nil {
+		ret += "AvailableBeginTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AvailableBeginTime:%v, ", *o.AvailableBeginTime)
+	}
+
+	if o.AvailableEndTime == nil {
+		ret += "AvailableEndTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AvailableEndTime:%v, ", *o.AvailableEndTime)
+	}
+
+	if o.DistributedCoupons == nil {
+		ret += "DistributedCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("DistributedCoupons:%v, ", *o.DistributedCoupons)
+	}
+
+	if o.NoCash == nil {
+		ret += "NoCash:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NoCash:%v, ", *o.NoCash)
+	}
+
+	if o.StartTime == nil {
+		ret += "StartTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StartTime:%v, ", *o.StartTime)
+	}
+
+	if o.StopTime == nil {
+		ret += "StopTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StopTime:%v, ", *o.StopTime)
+	}
+
+	if o.Singleitem == nil {
+		ret += "Singleitem:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Singleitem:%v, ", *o.Singleitem)
+	}
+
+	if o.StockType == nil {
+		ret += "StockType:<nil>"
+	} else {
+		ret += fmt.Sprintf("StockType:%v", *o.StockType)
+	}
+
+	return fmt.Sprintf("Stock{%s}", ret)
+}
+
+func (o Stock) Clone() *Stock {
+	ret := Stock{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.StockCreatorMchid != nil {
+		ret.StockCreatorMchid = new(string)
+		*ret.StockCreatorMchid = *o.StockCreatorMchid
+	}
+
+	if o.StockName != nil {
+		ret.StockName = new(string)
+		*ret.StockName = *o.StockName
+	}
+
+	if o.Status != nil {
+		ret.Status = new(StockStatus)
+		*ret.Status = *o.Status
+	}
+
+	if o.CreateTime != nil {
+		ret.CreateTime = new(time.Time)
+		*ret.CreateTime = *o.CreateTime
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.StockUseRule != nil {
+		ret.StockUseRule = o.StockUseRule.Clone()
+	}
+
+	if o.AvailableBeginTime != nil {
+		ret.AvailableBeginTime = new(time.Time)
+		*ret.AvailableBeginTime = *o.AvailableBeginTime
+	}
+
+	if o.AvailableEndTime != nil {
+		ret.AvailableEndTime = new(time.Time)
+		*ret.AvailableEndTime = *o.AvailableEndTime
+	}
+
+	if o.DistributedCoupons != nil {
+		ret.DistributedCoupons = new(int64)
+		*ret.DistributedCoupons = *o.DistributedCoupons
+	}
+
+	if o.NoCash != nil {
+		ret.NoCash = new(bool)
+		*ret.NoCash = *o.NoCash
+	}
+
+	if o.StartTime != nil {
+		ret.StartTime = new(time.Time)
+		*ret.StartTime = *o.StartTime
+	}
+
+	if o.StopTime != nil {
+		ret.StopTime = new(time.Time)
+		*ret.StopTime = *o.StopTime
+	}
+
+	if o.Singleitem != nil {
+		ret.Singleitem = new(bool)
+		*ret.Singleitem = *o.Singleitem
+	}
+
+	if o.StockType != nil {
+		ret.StockType = new(string)
+		*ret.StockType = *o.StockType
+	}
+
+	return &ret
+}
+
+// HasStockUseRule 应答中是否返回了 stock_use_rule，o 为 nil 时返回 false
+func (o *Stock) HasStockUseRule() bool {
+	return o != nil && o.StockUseRule != nil
+}
+
+// HasAvailableBeginTime 应答中是否返回了 available_begin_time，o 为 nil 时返回 false
+func (o *Stock) HasAvailableBeginTime() bool {
+	return o != nil && o.AvailableBeginTime != nil
+}
+
+// HasAvailableEndTime 应答中是否返回了 available_end_time，o 为 nil 时返回 false
+func (o *Stock) HasAvailableEndTime() bool {
+	return o != nil && o.AvailableEndTime != nil
+}
+
+// HasDistributedCoupons 应答中是否返回了 distributed_coupons，o 为 nil 时返回 false
+func (o *Stock) HasDistributedCoupons() bool {
+	return o != nil && o.DistributedCoupons != nil
+}
+
+// HasNoCash 应答中是否返回了 no_cash，o 为 nil 时返回 false
+func (o *Stock) HasNoCash() bool {
+	return o != nil && o.NoCash != nil
+}
+
+// HasStartTime 应答中是否返回了 start_time，o 为 nil 时返回 false
+func (o *Stock) HasStartTime() bool {
+	return o != nil && o.StartTime != nil
+}
+
+// HasStopTime 应答中是否返回了 stop_time，o 为 nil 时返回 false
+func (o *Stock) HasStopTime() bool {
+	return o != nil && o.StopTime != nil
+}
+
+// HasSingleitem 应答中是否返回了 singleitem，o 为 nil 时返回 false
+func (o *Stock) HasSingleitem() bool {
+	return o != nil && o.Singleitem != nil
+}
+
+// HasStockType 应答中是否返回了 stock_type，o 为 nil 时返回 false
+func (o *Stock) HasStockType() bool {
+	return o != nil && o.StockType != nil
+}
+
+// StockStatus * `unactivated` - 未激活 * `audit` - 审核中 * `running` - 运行中 * `stoped` - 已停止 * `paused` - 暂停发放
+type StockStatus string
+
+func (e StockStatus) Ptr() *StockStatus {
+	return &e
+}
+
+// Enums of StockStatus
+const (
+	STOCKSTATUS_UNACTIVATED StockStatus = "unactivated"
+	STOCKSTATUS_AUDIT       StockStatus = "audit"
+	STOCKSTATUS_RUNNING     StockStatus = "running"
+	STOCKSTATUS_STOPED      StockStatus = "stoped"
+	STOCKSTATUS_PAUSED      StockStatus = "paused"
+)
+
+func (v *StockStatus) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := StockStatus(value)
+	for _, existing := range []StockStatus{"unactivated", "audit", "running", "stoped", "paused"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid StockStatus", value)
+}
+
+// StockUseRule
+type StockUseRule struct {
+	// 发放总上限，最大发券数
+	MaxCoupons *int64 `json:"max_coupons,omitempty"`
+	// 总预算，单位：分
+	MaxAmount *int64 `json:"max_amount,omitempty"`
+	// 单天发放上限金额，单位：分
+	MaxAmountByDay *int64 `json:"max_amount_by_day,omitempty"`
+	// 固定面额批次特定信息
+	FixedNormalCoupon *FixedValueStockMsg `json:"fixed_normal_coupon,omitempty"`
+	// 单个用户可领个数
+	MaxCouponsPerUser *int64 `json:"max_coupons_per_user,omitempty"`
+	// 券类型，NORMAL：满减券，CUT_TO：减至券
+	CouponType *string `json:"coupon_type,omitempty"`
+	// 订单优惠标记
+	GoodsTag []string `json:"goods_tag,omitempty"`
+	// 支付方式，如 MICROAPP、APPPAY、PPAY、CARD、FACE、OTHER
+	TradeType []string `json:"trade_type,omitempty"`
+	// 是否可叠加其他优惠
+	CombineUse *bool `json:"combine_use,omitempty"`
+}
+
+func (o StockUseRule) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.MaxCoupons != nil {
+		toSerialize["max_coupons"] = o.MaxCoupons
+	}
+
+	if o.MaxAmount != nil {
+		toSerialize["max_amount"] = o.MaxAmount
+	}
+
+	if o.MaxAmountByDay != nil {
+		toSerialize["max_amount_by_day"] = o.MaxAmountByDay
+	}
+
+	if o.FixedNormalCoupon != nil {
+		toSerialize["fixed_normal_coupon"] = o.FixedNormalCoupon
+	}
+
+	if o.MaxCouponsPerUser != nil {
+		toSerialize["max_coupons_per_user"] = o.MaxCouponsPerUser
+	}
+
+	if o.CouponType != nil {
+		toSerialize["coupon_type"] = o.CouponType
+	}
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.TradeType != nil {
+		toSerialize["trade_type"] = o.TradeType
+	}
+
+	if o.CombineUse != nil {
+		toSerialize["combine_use"] = o.CombineUse
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o StockUseRule) String() string {
+	var ret string
+	if o.MaxCoupons == nil {
+		ret += "MaxCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxCoupons:%v, ", *o.MaxCoupons)
+	}
+
+	if o.MaxAmount == nil {
+		ret += "MaxAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxAmount:%v, ", *o.MaxAmount)
+	}
+
+	if o.MaxAmountByDay == nil {
+		ret += "MaxAmountByDay:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxAmountByDay:%v, ", *o.MaxAmountByDay)
+	}
+
+	ret += fmt.Sprintf("FixedNormalCoupon:%v, ", o.FixedNormalCoupon)
+
+	if o.MaxCouponsPerUser == nil {
+		ret += "MaxCouponsPerUser:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxCouponsPerUser:%v, ", *o.MaxCouponsPerUser)
+	}
+
+	if o.CouponType == nil {
+		ret += "CouponType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CouponType:%v, ", *o.CouponType)
+	}
+
+	ret += fmt.Sprintf("GoodsTag:%v, ", o.GoodsTag)
+
+	ret += fmt.Sprintf("TradeType:%v, ", o.TradeType)
+
+	if o.CombineUse == nil {
+		ret += "CombineUse:<nil>"
+	} else {
+		ret += fmt.Sprintf("CombineUse:%v", *o.CombineUse)
+	}
+
+	return fmt.Sprintf("StockUseRule{%s}", ret)
+}
+
+func (o StockUseRule) Clone() *StockUseRule {
+	ret := StockUseRule{}
+
+	if o.MaxCoupons != nil {
+		ret.MaxCoupons = new(int64)
+		*ret.MaxCoupons = *o.MaxCoupons
+	}
+
+	if o.MaxAmount != nil {
+		ret.MaxAmount = new(int64)
+		*ret.MaxAmount = *o.MaxAmount
+	}
+
+	if o.MaxAmountByDay != nil {
+		ret.MaxAmountByDay = new(int64)
+		*ret.MaxAmountByDay = *o.MaxAmountByDay
+	}
+
+	if o.FixedNormalCoupon != nil {
+		ret.FixedNormalCoupon = o.FixedNormalCoupon.Clone()
+	}
+
+	if o.MaxCouponsPerUser != nil {
+		ret.MaxCouponsPerUser = new(int64)
+		*ret.MaxCouponsPerUser = *o.MaxCouponsPerUser
+	}
+
+	if o.CouponType != nil {
+		ret.CouponType = new(string)
+		*ret.CouponType = *o.CouponType
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = make([]string, len(o.GoodsTag))
+		for i, item := range o.GoodsTag {
+			ret.GoodsTag[i] = item
+		}
+	}
+
+	if o.TradeType != nil {
+		ret.TradeType = make([]string, len(o.TradeType))
+		for i, item := range o.TradeType {
+			ret.TradeType[i] = item
+		}
+	}
+
+	if o.CombineUse != nil {
+		ret.CombineUse = new(bool)
+		*ret.CombineUse = *o.CombineUse
+	}
+
+	return &ret
+}
+
+// HasMaxCoupons 应答中是否返回了 max_coupons，o 为 nil 时返回 false
+func (o *StockUseRule) HasMaxCoupons() bool {
+	return o != nil && o.MaxCoupons != nil
+}
+
+// HasMaxAmount 应答中是否返回了 max_amount，o 为 nil 时返回 false
+func (o *StockUseRule) HasMaxAmount() bool {
+	return o != nil && o.MaxAmount != nil
+}
+
+// HasMaxAmountByDay 应答中是否返回了 max_amount_by_day，o 为 nil 时返回 false
+func (o *StockUseRule) HasMaxAmountByDay() bool {
+	return o != nil && o.MaxAmountByDay != nil
+}
+
+// HasFixedNormalCoupon 应答中是否返回了 fixed_normal_coupon，o 为 nil 时返回 false
+func (o *StockUseRule) HasFixedNormalCoupon() bool {
+	return o != nil && o.FixedNormalCoupon != nil
+}
+
+// HasMaxCouponsPerUser 应答中是否返回了 max_coupons_per_user，o 为 nil 时返回 false
+func (o *StockUseRule) HasMaxCouponsPerUser() bool {
+	return o != nil && o.MaxCouponsPerUser != nil
+}
+
+// HasCouponType 应答中是否返回了 coupon_type，o 为 nil 时返回 false
+func (o *StockUseRule) HasCouponType() bool {
+	return o != nil && o.CouponType != nil
+}
+
+// HasGoodsTag 应答中是否返回了 goods_tag，o 为 nil 时返回 false
+func (o *StockUseRule) HasGoodsTag() bool {
+	return o != nil && o.GoodsTag != nil
+}
+
+// HasTradeType 应答中是否返回了 trade_type，o 为 nil 时返回 false
+func (o *StockUseRule) HasTradeType() bool {
+	return o != nil && o.TradeType != nil
+}
+
+// HasCombineUse 应答中是否返回了 combine_use，o 为 nil 时返回 false
+func (o *StockUseRule) HasCombineUse() bool {
+	return o != nil && o.CombineUse != nil
+}
@@ -138,6 +138,11 @@ func (o DownloadCertificatesResponse) Clone() *DownloadCertificatesResponse {
 	return &ret
 }
 
+// HasData 应答中是否返回了 data，o 为 nil 时返回 false
+func (o *DownloadCertificatesResponse) HasData() bool {
+	return o != nil && o.Data != nil
+}
+
 // EncryptCertificate 为了保证安全性，微信支付在回调通知和平台证书下载接口中，对关键信息进行了AES-256-GCM加密
 type EncryptCertificate struct {
 	// 加密所使用的算法，目前可能取值仅为 AEAD_AES_256_GCM
@@ -0,0 +1,146 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通资金API
+//
+// 电商平台查询二级商户账户余额、申请提现与下载提现异常文件的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercefund
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type BalanceApiService services.Service
+
+// QueryBalance 查询二级商户账户实时余额
+//
+// # 应用场景
+// 电商平台可以通过该接口查询二级商户账户的实时可用余额与不可用余额。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NO_AUTH|权限异常|二级商户与电商平台的关系异常|请确认二级商户号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *BalanceApiService) QueryBalance(ctx context.Context, req QueryBalanceRequest) (resp *Balance, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryBalanceRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/fund/balance/{sub_mchid}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"sub_mchid"+"}", neturl.PathEscape(core.ParameterToString(*req.SubMchid, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.AccountType != nil {
+		localVarQueryParams.Add("account_type", core.ParameterToString(*req.AccountType, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Balance from Http Response
+	resp = new(Balance)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryEndDayBalance 查询二级商户账户日终余额
+//
+// # 应用场景
+// 电商平台可以通过该接口查询二级商户账户指定日期的日终余额，通常用于每日对账。
+//
+// 注意：
+// 1、可查询90天内的日终余额，当日的日终余额在次日生成
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NO_AUTH|权限异常|二级商户与电商平台的关系异常|请确认二级商户号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *BalanceApiService) QueryEndDayBalance(ctx context.Context, req QueryEndDayBalanceRequest) (resp *EndDayBalance, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryEndDayBalanceRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/fund/enddaybalance/{sub_mchid}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"sub_mchid"+"}", neturl.PathEscape(core.ParameterToString(*req.SubMchid, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.Date == nil {
+		return nil, nil, fmt.Errorf("field `Date` is required and must be specified in QueryEndDayBalanceRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("date", core.ParameterToString(*req.Date, ""))
+	if req.AccountType != nil {
+		localVarQueryParams.Add("account_type", core.ParameterToString(*req.AccountType, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract EndDayBalance from Http Response
+	resp = new(EndDayBalance)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
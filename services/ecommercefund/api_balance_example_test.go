@@ -0,0 +1,57 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通资金API
+//
+// 电商平台查询二级商户账户余额、申请提现与下载提现异常文件的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercefund_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommercefund"
+)
+
+func ExampleBalanceApiService_QueryBalance() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercefund.BalanceApiService{Client: client}
+	resp, result, err := svc.QueryBalance(ctx,
+		ecommercefund.QueryBalanceRequest{
+			AccountType: core.String("BASIC"),
+			SubMchid:    core.String("1222212222"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleBalanceApiService_QueryEndDayBalance() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercefund.BalanceApiService{Client: client}
+	resp, result, err := svc.QueryEndDayBalance(ctx,
+		ecommercefund.QueryEndDayBalanceRequest{
+			AccountType: core.String("BASIC"),
+			Date:        core.String("2019-08-17"),
+			SubMchid:    core.String("1222212222"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
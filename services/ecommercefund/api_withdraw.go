@@ -0,0 +1,193 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通资金API
+//
+// 电商平台查询二级商户账户余额、申请提现与下载提现异常文件的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercefund
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type WithdrawApiService services.Service
+
+// CreateWithdraw 二级商户余额提现
+//
+// # 应用场景
+// 电商平台可以通过该接口为二级商户发起提现申请，将二级商户账户的可用余额提现至其结算银行卡。
+//
+// 注意：
+// 1、提现申请受理后，资金会在T+1日到账，提现结果可通过查询提现状态接口获取
+// 2、同一商户提现单号重复请求时，微信支付将返回首次受理的结果，请在网络超时等情况下使用原商户提现单号重试
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NO_AUTH|权限异常|二级商户与电商平台的关系异常|请确认二级商户号是否正确|
+// |NOT_ENOUGH|余额不足|账户可用余额不足|请确认账户余额后重试|
+// |ACCOUNT_ERROR|账户异常|二级商户结算账户异常|请引导二级商户修改结算账户|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *WithdrawApiService) CreateWithdraw(ctx context.Context, req CreateWithdrawBody) (resp *CreateWithdrawResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/fund/withdraw"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract CreateWithdrawResponse from Http Response
+	resp = new(CreateWithdrawResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryWithdrawByOutRequestNo 商户提现单号查询二级商户提现状态
+//
+// # 应用场景
+// 电商平台可以通过该接口使用商户提现单号查询二级商户的提现状态。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|提现单不存在|提现单号不存在|请确认提现单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *WithdrawApiService) QueryWithdrawByOutRequestNo(ctx context.Context, req QueryWithdrawByOutRequestNoRequest) (resp *Withdraw, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutRequestNo == nil {
+		return nil, nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in QueryWithdrawByOutRequestNoRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/fund/withdraw/out-request-no/{out_request_no}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_request_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutRequestNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryWithdrawByOutRequestNoRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Withdraw from Http Response
+	resp = new(Withdraw)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryWithdrawByWithdrawId 微信支付提现单号查询二级商户提现状态
+//
+// # 应用场景
+// 电商平台可以通过该接口使用微信支付提现单号查询二级商户的提现状态。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|提现单不存在|提现单号不存在|请确认提现单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *WithdrawApiService) QueryWithdrawByWithdrawId(ctx context.Context, req QueryWithdrawByWithdrawIdRequest) (resp *Withdraw, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.WithdrawId == nil {
+		return nil, nil, fmt.Errorf("field `WithdrawId` is required and must be specified in QueryWithdrawByWithdrawIdRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/fund/withdraw/{withdraw_id}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"withdraw_id"+"}", neturl.PathEscape(core.ParameterToString(*req.WithdrawId, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryWithdrawByWithdrawIdRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Withdraw from Http Response
+	resp = new(Withdraw)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
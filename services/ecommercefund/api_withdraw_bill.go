@@ -0,0 +1,90 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通资金API
+//
+// 电商平台查询二级商户账户余额、申请提现与下载提现异常文件的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercefund
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type WithdrawBillApiService services.Service
+
+// GetWithdrawBill 申请提现异常文件
+//
+// # 应用场景
+// 电商平台可以通过该接口申请指定日期提现失败（即提现单状态为REFUND，资金退回账户）的提现异常文件，获取下载地址与文件摘要。
+//
+// 注意：
+// 1、提现异常文件需要使用 Client.Download 下载，并使用应答中的摘要校验文件完整性
+// 2、下载地址的有效期为30s
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NO_STATEMENT_EXIST|文件不存在|指定日期没有提现异常|请确认日期后重试|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *WithdrawBillApiService) GetWithdrawBill(ctx context.Context, req GetWithdrawBillRequest) (resp *WithdrawBill, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.BillType == nil {
+		return nil, nil, fmt.Errorf("field `BillType` is required and must be specified in GetWithdrawBillRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/merchant/fund/withdraw/bill-type/{bill_type}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"bill_type"+"}", neturl.PathEscape(core.ParameterToString(*req.BillType, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.BillDate == nil {
+		return nil, nil, fmt.Errorf("field `BillDate` is required and must be specified in GetWithdrawBillRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("bill_date", core.ParameterToString(*req.BillDate, ""))
+	if req.TarType != nil {
+		localVarQueryParams.Add("tar_type", core.ParameterToString(*req.TarType, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract WithdrawBill from Http Response
+	resp = new(WithdrawBill)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通资金API
+//
+// 电商平台查询二级商户账户余额、申请提现与下载提现异常文件的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercefund_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommercefund"
+)
+
+func ExampleWithdrawApiService_CreateWithdraw() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercefund.WithdrawApiService{Client: client}
+	resp, result, err := svc.CreateWithdraw(ctx,
+		ecommercefund.CreateWithdrawBody{
+			AccountType:  ecommercefund.ACCOUNTTYPE_BASIC.Ptr(),
+			Amount:       core.Int64(1),
+			BankMemo:     core.String("微信支付提现"),
+			OutRequestNo: core.String("20190611222222222200000000012122"),
+			Remark:       core.String("交易提现"),
+			SubMchid:     core.String("1222212222"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleWithdrawApiService_QueryWithdrawByOutRequestNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercefund.WithdrawApiService{Client: client}
+	resp, result, err := svc.QueryWithdrawByOutRequestNo(ctx,
+		ecommercefund.QueryWithdrawByOutRequestNoRequest{
+			OutRequestNo: core.String("20190611222222222200000000012122"),
+			SubMchid:     core.String("1222212222"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleWithdrawApiService_QueryWithdrawByWithdrawId() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercefund.WithdrawApiService{Client: client}
+	resp, result, err := svc.QueryWithdrawByWithdrawId(ctx,
+		ecommercefund.QueryWithdrawByWithdrawIdRequest{
+			SubMchid:   core.String("1222212222"),
+			WithdrawId: core.String("12321937198237912739132791732123"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
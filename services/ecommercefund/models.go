@@ -0,0 +1,1164 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通资金API
+//
+// 电商平台查询二级商户账户余额、申请提现与下载提现异常文件的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercefund
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AccountType * `BASIC` - 基本账户 * `OPERATION` - 运营账户 * `FEES` - 手续费账户
+type AccountType string
+
+func (e AccountType) Ptr() *AccountType {
+	return &e
+}
+
+// Enums of AccountType
+const (
+	ACCOUNTTYPE_BASIC     AccountType = "BASIC"
+	ACCOUNTTYPE_OPERATION AccountType = "OPERATION"
+	ACCOUNTTYPE_FEES      AccountType = "FEES"
+)
+
+func (v *AccountType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := AccountType(value)
+	for _, existing := range []AccountType{"BASIC", "OPERATION", "FEES"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid AccountType", value)
+}
+
+// Balance
+type Balance struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 账户类型
+	AccountType *AccountType `json:"account_type,omitempty"`
+	// 可用余额，单位为分
+	AvailableAmount *int64 `json:"available_amount"`
+	// 不可用余额，单位为分
+	PendingAmount *int64 `json:"pending_amount,omitempty"`
+}
+
+func (o Balance) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in Balance")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.AccountType != nil {
+		toSerialize["account_type"] = o.AccountType
+	}
+
+	if o.AvailableAmount == nil {
+		return nil, fmt.Errorf("field `AvailableAmount` is required and must be specified in Balance")
+	}
+	toSerialize["available_amount"] = o.AvailableAmount
+
+	if o.PendingAmount != nil {
+		toSerialize["pending_amount"] = o.PendingAmount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Balance) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountType:%v, ", *o.AccountType)
+	}
+
+	if o.AvailableAmount == nil {
+		ret += "AvailableAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AvailableAmount:%v, ", *o.AvailableAmount)
+	}
+
+	if o.PendingAmount == nil {
+		ret += "PendingAmount:<nil>"
+	} else {
+		ret += fmt.Sprintf("PendingAmount:%v", *o.PendingAmount)
+	}
+
+	return fmt.Sprintf("Balance{%s}", ret)
+}
+
+func (o Balance) Clone() *Balance {
+	ret := Balance{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(AccountType)
+		*ret.AccountType = *o.AccountType
+	}
+
+	if o.AvailableAmount != nil {
+		ret.AvailableAmount = new(int64)
+		*ret.AvailableAmount = *o.AvailableAmount
+	}
+
+	if o.PendingAmount != nil {
+		ret.PendingAmount = new(int64)
+		*ret.PendingAmount = *o.PendingAmount
+	}
+
+	return &ret
+}
+
+// HasAccountType 应答中是否返回了 account_type，o 为 nil 时返回 false
+func (o *Balance) HasAccountType() bool {
+	return o != nil && o.AccountType != nil
+}
+
+// HasPendingAmount 应答中是否返回了 pending_amount，o 为 nil 时返回 false
+func (o *Balance) HasPendingAmount() bool {
+	return o != nil && o.PendingAmount != nil
+}
+
+// CreateWithdrawBody
+type CreateWithdrawBody struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 商户提现单号，必须是字母数字，在电商平台内唯一
+	OutRequestNo *string `json:"out_request_no"`
+	// 提现金额，单位为分，不能超过账户可用余额
+	Amount *int64 `json:"amount"`
+	// 提现备注，商户对提现单的备注，商户自定义字段
+	Remark *string `json:"remark,omitempty"`
+	// 银行附言，展示在收款银行系统中的附言，数字、字母最长32个汉字（能否成功展示依赖银行系统支持）
+	BankMemo *string `json:"bank_memo,omitempty"`
+	// 出款账户类型，不填默认为基本账户
+	AccountType *AccountType `json:"account_type,omitempty"`
+}
+
+func (o CreateWithdrawBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CreateWithdrawBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OutRequestNo == nil {
+		return nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in CreateWithdrawBody")
+	}
+	toSerialize["out_request_no"] = o.OutRequestNo
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in CreateWithdrawBody")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Remark != nil {
+		toSerialize["remark"] = o.Remark
+	}
+
+	if o.BankMemo != nil {
+		toSerialize["bank_memo"] = o.BankMemo
+	}
+
+	if o.AccountType != nil {
+		toSerialize["account_type"] = o.AccountType
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateWithdrawBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OutRequestNo == nil {
+		ret += "OutRequestNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRequestNo:%v, ", *o.OutRequestNo)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Remark == nil {
+		ret += "Remark:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Remark:%v, ", *o.Remark)
+	}
+
+	if o.BankMemo == nil {
+		ret += "BankMemo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankMemo:%v, ", *o.BankMemo)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>"
+	} else {
+		ret += fmt.Sprintf("AccountType:%v", *o.AccountType)
+	}
+
+	return fmt.Sprintf("CreateWithdrawBody{%s}", ret)
+}
+
+func (o CreateWithdrawBody) Clone() *CreateWithdrawBody {
+	ret := CreateWithdrawBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OutRequestNo != nil {
+		ret.OutRequestNo = new(string)
+		*ret.OutRequestNo = *o.OutRequestNo
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Remark != nil {
+		ret.Remark = new(string)
+		*ret.Remark = *o.Remark
+	}
+
+	if o.BankMemo != nil {
+		ret.BankMemo = new(string)
+		*ret.BankMemo = *o.BankMemo
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(AccountType)
+		*ret.AccountType = *o.AccountType
+	}
+
+	return &ret
+}
+
+// CreateWithdrawResponse
+type CreateWithdrawResponse struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信支付提现单号
+	WithdrawId *string `json:"withdraw_id"`
+	// 商户提现单号，必须是字母数字，在电商平台内唯一
+	OutRequestNo *string `json:"out_request_no"`
+}
+
+func (o CreateWithdrawResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CreateWithdrawResponse")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.WithdrawId == nil {
+		return nil, fmt.Errorf("field `WithdrawId` is required and must be specified in CreateWithdrawResponse")
+	}
+	toSerialize["withdraw_id"] = o.WithdrawId
+
+	if o.OutRequestNo == nil {
+		return nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in CreateWithdrawResponse")
+	}
+	toSerialize["out_request_no"] = o.OutRequestNo
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateWithdrawResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.WithdrawId == nil {
+		ret += "WithdrawId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("WithdrawId:%v, ", *o.WithdrawId)
+	}
+
+	if o.OutRequestNo == nil {
+		ret += "OutRequestNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("OutRequestNo:%v", *o.OutRequestNo)
+	}
+
+	return fmt.Sprintf("CreateWithdrawResponse{%s}", ret)
+}
+
+func (o CreateWithdrawResponse) Clone() *CreateWithdrawResponse {
+	ret := CreateWithdrawResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.WithdrawId != nil {
+		ret.WithdrawId = new(string)
+		*ret.WithdrawId = *o.WithdrawId
+	}
+
+	if o.OutRequestNo != nil {
+		ret.OutRequestNo = new(string)
+		*ret.OutRequestNo = *o.OutRequestNo
+	}
+
+	return &ret
+}
+
+// EndDayBalance
+type EndDayBalance struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 可用余额，单位为分
+	AvailableAmount *int64 `json:"available_amount"`
+	// 不可用余额，单位为分
+	PendingAmount *int64 `json:"pending_amount,omitempty"`
+}
+
+func (o EndDayBalance) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in EndDayBalance")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.AvailableAmount == nil {
+		return nil, fmt.Errorf("field `AvailableAmount` is required and must be specified in EndDayBalance")
+	}
+	toSerialize["available_amount"] = o.AvailableAmount
+
+	if o.PendingAmount != nil {
+		toSerialize["pending_amount"] = o.PendingAmount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o EndDayBalance) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.AvailableAmount == nil {
+		ret += "AvailableAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AvailableAmount:%v, ", *o.AvailableAmount)
+	}
+
+	if o.PendingAmount == nil {
+		ret += "PendingAmount:<nil>"
+	} else {
+		ret += fmt.Sprintf("PendingAmount:%v", *o.PendingAmount)
+	}
+
+	return fmt.Sprintf("EndDayBalance{%s}", ret)
+}
+
+func (o EndDayBalance) Clone() *EndDayBalance {
+	ret := EndDayBalance{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.AvailableAmount != nil {
+		ret.AvailableAmount = new(int64)
+		*ret.AvailableAmount = *o.AvailableAmount
+	}
+
+	if o.PendingAmount != nil {
+		ret.PendingAmount = new(int64)
+		*ret.PendingAmount = *o.PendingAmount
+	}
+
+	return &ret
+}
+
+// HasPendingAmount 应答中是否返回了 pending_amount，o 为 nil 时返回 false
+func (o *EndDayBalance) HasPendingAmount() bool {
+	return o != nil && o.PendingAmount != nil
+}
+
+// GetWithdrawBillRequest
+type GetWithdrawBillRequest struct {
+	// 账单类型，目前仅支持NO_SUCC（提现异常）
+	BillType *string `json:"bill_type"`
+	// 账单日期，格式为YYYY-MM-DD，仅支持查询三个月内的账单
+	BillDate *string `json:"bill_date"`
+	// 压缩格式，不填时默认下载未压缩的文件，仅支持GZIP
+	TarType *string `json:"tar_type,omitempty"`
+}
+
+func (o GetWithdrawBillRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BillType == nil {
+		return nil, fmt.Errorf("field `BillType` is required and must be specified in GetWithdrawBillRequest")
+	}
+	toSerialize["bill_type"] = o.BillType
+
+	if o.BillDate == nil {
+		return nil, fmt.Errorf("field `BillDate` is required and must be specified in GetWithdrawBillRequest")
+	}
+	toSerialize["bill_date"] = o.BillDate
+
+	if o.TarType != nil {
+		toSerialize["tar_type"] = o.TarType
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o GetWithdrawBillRequest) String() string {
+	var ret string
+	if o.BillType == nil {
+		ret += "BillType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BillType:%v, ", *o.BillType)
+	}
+
+	if o.BillDate == nil {
+		ret += "BillDate:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BillDate:%v, ", *o.BillDate)
+	}
+
+	if o.TarType == nil {
+		ret += "TarType:<nil>"
+	} else {
+		ret += fmt.Sprintf("TarType:%v", *o.TarType)
+	}
+
+	return fmt.Sprintf("GetWithdrawBillRequest{%s}", ret)
+}
+
+func (o GetWithdrawBillRequest) Clone() *GetWithdrawBillRequest {
+	ret := GetWithdrawBillRequest{}
+
+	if o.BillType != nil {
+		ret.BillType = new(string)
+		*ret.BillType = *o.BillType
+	}
+
+	if o.BillDate != nil {
+		ret.BillDate = new(string)
+		*ret.BillDate = *o.BillDate
+	}
+
+	if o.TarType != nil {
+		ret.TarType = new(string)
+		*ret.TarType = *o.TarType
+	}
+
+	return &ret
+}
+
+// QueryBalanceRequest
+type QueryBalanceRequest struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 二级商户账户类型，不填默认查询基本账户。可选取值：BASIC（基本账户）、OPERATION（运营账户）、FEES（手续费账户）
+	AccountType *string `json:"account_type,omitempty"`
+}
+
+func (o QueryBalanceRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryBalanceRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.AccountType != nil {
+		toSerialize["account_type"] = o.AccountType
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryBalanceRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>"
+	} else {
+		ret += fmt.Sprintf("AccountType:%v", *o.AccountType)
+	}
+
+	return fmt.Sprintf("QueryBalanceRequest{%s}", ret)
+}
+
+func (o QueryBalanceRequest) Clone() *QueryBalanceRequest {
+	ret := QueryBalanceRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(string)
+		*ret.AccountType = *o.AccountType
+	}
+
+	return &ret
+}
+
+// QueryEndDayBalanceRequest
+type QueryEndDayBalanceRequest struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 指定查询的日期，格式为YYYY-MM-DD
+	Date *string `json:"date"`
+	// 二级商户账户类型，不填默认查询基本账户。可选取值：BASIC（基本账户）、OPERATION（运营账户）、FEES（手续费账户）
+	AccountType *string `json:"account_type,omitempty"`
+}
+
+func (o QueryEndDayBalanceRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryEndDayBalanceRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Date == nil {
+		return nil, fmt.Errorf("field `Date` is required and must be specified in QueryEndDayBalanceRequest")
+	}
+	toSerialize["date"] = o.Date
+
+	if o.AccountType != nil {
+		toSerialize["account_type"] = o.AccountType
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryEndDayBalanceRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Date == nil {
+		ret += "Date:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Date:%v, ", *o.Date)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>"
+	} else {
+		ret += fmt.Sprintf("AccountType:%v", *o.AccountType)
+	}
+
+	return fmt.Sprintf("QueryEndDayBalanceRequest{%s}", ret)
+}
+
+func (o QueryEndDayBalanceRequest) Clone() *QueryEndDayBalanceRequest {
+	ret := QueryEndDayBalanceRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Date != nil {
+		ret.Date = new(string)
+		*ret.Date = *o.Date
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(string)
+		*ret.AccountType = *o.AccountType
+	}
+
+	return &ret
+}
+
+// QueryWithdrawByOutRequestNoRequest
+type QueryWithdrawByOutRequestNoRequest struct {
+	// 商户提现单号，由商户自定义生成
+	OutRequestNo *string `json:"out_request_no"`
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryWithdrawByOutRequestNoRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutRequestNo == nil {
+		return nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in QueryWithdrawByOutRequestNoRequest")
+	}
+	toSerialize["out_request_no"] = o.OutRequestNo
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryWithdrawByOutRequestNoRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryWithdrawByOutRequestNoRequest) String() string {
+	var ret string
+	if o.OutRequestNo == nil {
+		ret += "OutRequestNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRequestNo:%v, ", *o.OutRequestNo)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryWithdrawByOutRequestNoRequest{%s}", ret)
+}
+
+func (o QueryWithdrawByOutRequestNoRequest) Clone() *QueryWithdrawByOutRequestNoRequest {
+	ret := QueryWithdrawByOutRequestNoRequest{}
+
+	if o.OutRequestNo != nil {
+		ret.OutRequestNo = new(string)
+		*ret.OutRequestNo = *o.OutRequestNo
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// QueryWithdrawByWithdrawIdRequest
+type QueryWithdrawByWithdrawIdRequest struct {
+	// 微信支付提现单号，提现申请受理后返回
+	WithdrawId *string `json:"withdraw_id"`
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryWithdrawByWithdrawIdRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.WithdrawId == nil {
+		return nil, fmt.Errorf("field `WithdrawId` is required and must be specified in QueryWithdrawByWithdrawIdRequest")
+	}
+	toSerialize["withdraw_id"] = o.WithdrawId
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryWithdrawByWithdrawIdRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryWithdrawByWithdrawIdRequest) String() string {
+	var ret string
+	if o.WithdrawId == nil {
+		ret += "WithdrawId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("WithdrawId:%v, ", *o.WithdrawId)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryWithdrawByWithdrawIdRequest{%s}", ret)
+}
+
+func (o QueryWithdrawByWithdrawIdRequest) Clone() *QueryWithdrawByWithdrawIdRequest {
+	ret := QueryWithdrawByWithdrawIdRequest{}
+
+	if o.WithdrawId != nil {
+		ret.WithdrawId = new(string)
+		*ret.WithdrawId = *o.WithdrawId
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// Withdraw
+type Withdraw struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 电商平台商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 提现单状态
+	Status *WithdrawStatus `json:"status"`
+	// 微信支付提现单号
+	WithdrawId *string `json:"withdraw_id"`
+	// 商户提现单号，必须是字母数字，在电商平台内唯一
+	OutRequestNo *string `json:"out_request_no"`
+	// 提现金额，单位为分
+	Amount *int64 `json:"amount"`
+	// 提现单创建时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	CreateTime *time.Time `json:"create_time"`
+	// 提现单最后一次状态变更时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	UpdateTime *time.Time `json:"update_time"`
+	// 提现失败原因，仅在提现失败、退票、关单时有值
+	Reason *string `json:"reason,omitempty"`
+	// 提现备注
+	Remark *string `json:"remark,omitempty"`
+	// 银行附言
+	BankMemo *string `json:"bank_memo,omitempty"`
+	// 出款账户类型
+	AccountType *AccountType `json:"account_type,omitempty"`
+	// 入账银行账号后四位
+	AccountNumber *string `json:"account_number,omitempty"`
+	// 入账银行
+	AccountBank *string `json:"account_bank,omitempty"`
+	// 入账银行全称（含支行）
+	BankName *string `json:"bank_name,omitempty"`
+}
+
+func (o Withdraw) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in Withdraw")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in Withdraw")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.Status == nil {
+		return nil, fmt.Errorf("field `Status` is required and must be specified in Withdraw")
+	}
+	toSerialize["status"] = o.Status
+
+	if o.WithdrawId == nil {
+		return nil, fmt.Errorf("field `WithdrawId` is required and must be specified in Withdraw")
+	}
+	toSerialize["withdraw_id"] = o.WithdrawId
+
+	if o.OutRequestNo == nil {
+		return nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in Withdraw")
+	}
+	toSerialize["out_request_no"] = o.OutRequestNo
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in Withdraw")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.CreateTime == nil {
+		return nil, fmt.Errorf("field `CreateTime` is required and must be specified in Withdraw")
+	}
+	toSerialize["create_time"] = o.CreateTime.Format(time.RFC3339)
+
+	if o.UpdateTime == nil {
+		return nil, fmt.Errorf("field `UpdateTime` is required and must be specified in Withdraw")
+	}
+	toSerialize["update_time"] = o.UpdateTime.Format(time.RFC3339)
+
+	if o.Reason != nil {
+		toSerialize["reason"] = o.Reason
+	}
+
+	if o.Remark != nil {
+		toSerialize["remark"] = o.Remark
+	}
+
+	if o.BankMemo != nil {
+		toSerialize["bank_memo"] = o.BankMemo
+	}
+
+	if o.AccountType != nil {
+		toSerialize["account_type"] = o.AccountType
+	}
+
+	if o.AccountNumber != nil {
+		toSerialize["account_number"] = o.AccountNumber
+	}
+
+	if o.AccountBank != nil {
+		toSerialize["account_bank"] = o.AccountBank
+	}
+
+	if o.BankName != nil {
+		toSerialize["bank_name"] = o.BankName
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Withdraw) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.Status == nil {
+		ret += "Status:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Status:%v, ", *o.Status)
+	}
+
+	if o.WithdrawId == nil {
+		ret += "WithdrawId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("WithdrawId:%v, ", *o.WithdrawId)
+	}
+
+	if o.OutRequestNo == nil {
+		ret += "OutRequestNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRequestNo:%v, ", *o.OutRequestNo)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.CreateTime == nil {
+		ret += "CreateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CreateTime:%v, ", *o.CreateTime)
+	}
+
+	if o.UpdateTime == nil {
+		ret += "UpdateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("UpdateTime:%v, ", *o.UpdateTime)
+	}
+
+	if o.Reason == nil {
+		ret += "Reason:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Reason:%v, ", *o.Reason)
+	}
+
+	if o.Remark == nil {
+		ret += "Remark:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Remark:%v, ", *o.Remark)
+	}
+
+	if o.BankMemo == nil {
+		ret += "BankMemo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankMemo:%v, ", *o.BankMemo)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountType:%v, ", *o.AccountType)
+	}
+
+	if o.AccountNumber == nil {
+		ret += "AccountNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountNumber:%v, ", *o.AccountNumber)
+	}
+
+	if o.AccountBank == nil {
+		ret += "AccountBank:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountBank:%v, ", *o.AccountBank)
+	}
+
+	if o.BankName == nil {
+		ret += "BankName:<nil>"
+	} else {
+		ret += fmt.Sprintf("BankName:%v", *o.BankName)
+	}
+
+	return fmt.Sprintf("Withdraw{%s}", ret)
+}
+
+func (o Withdraw) Clone() *Withdraw {
+	ret := Withdraw{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.Status != nil {
+		ret.Status = new(WithdrawStatus)
+		*ret.Status = *o.Status
+	}
+
+	if o.WithdrawId != nil {
+		ret.WithdrawId = new(string)
+		*ret.WithdrawId = *o.WithdrawId
+	}
+
+	if o.OutRequestNo != nil {
+		ret.OutRequestNo = new(string)
+		*ret.OutRequestNo = *o.OutRequestNo
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.CreateTime != nil {
+		ret.CreateTime = new(time.Time)
+		*ret.CreateTime = *o.CreateTime
+	}
+
+	if o.UpdateTime != nil {
+		ret.UpdateTime = new(time.Time)
+		*ret.UpdateTime = *o.UpdateTime
+	}
+
+	if o.Reason != nil {
+		ret.Reason = new(string)
+		*ret.Reason = *o.Reason
+	}
+
+	if o.Remark != nil {
+		ret.Remark = new(string)
+		*ret.Remark = *o.Remark
+	}
+
+	if o.BankMemo != nil {
+		ret.BankMemo = new(string)
+		*ret.BankMemo = *o.BankMemo
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(AccountType)
+		*ret.AccountType = *o.AccountType
+	}
+
+	if o.AccountNumber != nil {
+		ret.AccountNumber = new(string)
+		*ret.AccountNumber = *o.AccountNumber
+	}
+
+	if o.AccountBank != nil {
+		ret.AccountBank = new(string)
+		*ret.AccountBank = *o.AccountBank
+	}
+
+	if o.BankName != nil {
+		ret.BankName = new(string)
+		*ret.BankName = *o.BankName
+	}
+
+	return &ret
+}
+
+// HasReason 应答中是否返回了 reason，o 为 nil 时返回 false
+func (o *Withdraw) HasReason() bool {
+	return o != nil && o.Reason != nil
+}
+
+// HasRemark 应答中是否返回了 remark，o 为 nil 时返回 false
+func (o *Withdraw) HasRemark() bool {
+	return o != nil && o.Remark != nil
+}
+
+// HasBankMemo 应答中是否返回了 bank_memo，o 为 nil 时返回 false
+func (o *Withdraw) HasBankMemo() bool {
+	return o != nil && o.BankMemo != nil
+}
+
+// HasAccountType 应答中是否返回了 account_type，o 为 nil 时返回 false
+func (o *Withdraw) HasAccountType() bool {
+	return o != nil && o.AccountType != nil
+}
+
+// HasAccountNumber 应答中是否返回了 account_number，o 为 nil 时返回 false
+func (o *Withdraw) HasAccountNumber() bool {
+	return o != nil && o.AccountNumber != nil
+}
+
+// HasAccountBank 应答中是否返回了 account_bank，o 为 nil 时返回 false
+func (o *Withdraw) HasAccountBank() bool {
+	return o != nil && o.AccountBank != nil
+}
+
+// HasBankName 应答中是否返回了 bank_name，o 为 nil 时返回 false
+func (o *Withdraw) HasBankName() bool {
+	return o != nil && o.BankName != nil
+}
+
+// WithdrawBill
+type WithdrawBill struct {
+	// 原始文件摘要类型，目前仅支持SHA1
+	HashType *string `json:"hash_type"`
+	// 原始文件摘要值，用于校验文件完整性
+	HashValue *string `json:"hash_value"`
+	// 供下一步请求文件下载接口使用的下载地址，有效期为30s
+	DownloadUrl *string `json:"download_url"`
+}
+
+func (o WithdrawBill) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.HashType == nil {
+		return nil, fmt.Errorf("field `HashType` is required and must be specified in WithdrawBill")
+	}
+	toSerialize["hash_type"] = o.HashType
+
+	if o.HashValue == nil {
+		return nil, fmt.Errorf("field `HashValue` is required and must be specified in WithdrawBill")
+	}
+	toSerialize["hash_value"] = o.HashValue
+
+	if o.DownloadUrl == nil {
+		return nil, fmt.Errorf("field `DownloadUrl` is required and must be specified in WithdrawBill")
+	}
+	toSerialize["download_url"] = o.DownloadUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o WithdrawBill) String() string {
+	var ret string
+	if o.HashType == nil {
+		ret += "HashType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("HashType:%v, ", *o.HashType)
+	}
+
+	if o.HashValue == nil {
+		ret += "HashValue:<nil>, "
+	} else {
+		ret += fmt.Sprintf("HashValue:%v, ", *o.HashValue)
+	}
+
+	if o.DownloadUrl == nil {
+		ret += "DownloadUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("DownloadUrl:%v", *o.DownloadUrl)
+	}
+
+	return fmt.Sprintf("WithdrawBill{%s}", ret)
+}
+
+func (o WithdrawBill) Clone() *WithdrawBill {
+	ret := WithdrawBill{}
+
+	if o.HashType != nil {
+		ret.HashType = new(string)
+		*ret.HashType = *o.HashType
+	}
+
+	if o.HashValue != nil {
+		ret.HashValue = new(string)
+		*ret.HashValue = *o.HashValue
+	}
+
+	if o.DownloadUrl != nil {
+		ret.DownloadUrl = new(string)
+		*ret.DownloadUrl = *o.DownloadUrl
+	}
+
+	return &ret
+}
+
+// WithdrawStatus * `CREATE_SUCCESS` - 受理成功 * `SUCCESS` - 提现成功 * `FAIL` - 提现失败 * `REFUND` - 提现退票 * `CLOSE` - 关单 * `INIT` - 业务单已创建
+type WithdrawStatus string
+
+func (e WithdrawStatus) Ptr() *WithdrawStatus {
+	return &e
+}
+
+// Enums of WithdrawStatus
+const (
+	WITHDRAWSTATUS_CREATE_SUCCESS WithdrawStatus = "CREATE_SUCCESS"
+	WITHDRAWSTATUS_SUCCESS        WithdrawStatus = "SUCCESS"
+	WITHDRAWSTATUS_FAIL           WithdrawStatus = "FAIL"
+	WITHDRAWSTATUS_REFUND         WithdrawStatus = "REFUND"
+	WITHDRAWSTATUS_CLOSE          WithdrawStatus = "CLOSE"
+	WITHDRAWSTATUS_INIT           WithdrawStatus = "INIT"
+)
+
+func (v *WithdrawStatus) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := WithdrawStatus(value)
+	for _, existing := range []WithdrawStatus{"CREATE_SUCCESS", "SUCCESS", "FAIL", "REFUND", "CLOSE", "INIT"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid WithdrawStatus", value)
+}
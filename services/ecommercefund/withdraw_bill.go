@@ -0,0 +1,56 @@
+package ecommercefund
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+)
+
+// tarTypeGzip 提现异常文件的 gzip 压缩格式
+const tarTypeGzip = "GZIP"
+
+// DownloadWithdrawBill 申请并下载提现异常文件，返回原始文件内容，调用方读取完毕后需要关闭
+//
+// req.TarType 为 GZIP 时以压缩格式下载并在读取时解压。读取到末尾时使用申请接口返回的 hash_type 与 hash_value 校验文件内容，
+// 不一致时 Read 返回包装了 bill.ErrDigestMismatch 的错误。申请接口返回的下载地址有效期为 30s，请在获取后立即调用本方法下载。
+func (a *WithdrawBillApiService) DownloadWithdrawBill(
+	ctx context.Context, req GetWithdrawBillRequest,
+) (io.ReadCloser, error) {
+	withdrawBill, _, err := a.GetWithdrawBill(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawBill.DownloadUrl == nil || withdrawBill.HashType == nil || withdrawBill.HashValue == nil {
+		return nil, fmt.Errorf("download_url, hash_type and hash_value are required in withdraw bill response")
+	}
+
+	result, err := a.Client.Download(ctx, *withdrawBill.DownloadUrl)
+	if err != nil {
+		return nil, err
+	}
+	body := result.Response.Body
+
+	var content io.Reader = body
+	if req.TarType != nil && strings.EqualFold(*req.TarType, tarTypeGzip) {
+		if content, err = gzip.NewReader(body); err != nil {
+			_ = body.Close()
+			return nil, fmt.Errorf("decompress withdraw bill err:%v", err)
+		}
+	}
+
+	content, err = bill.NewDigestReader(content, *withdrawBill.HashType, *withdrawBill.HashValue)
+	if err != nil {
+		_ = body.Close()
+		return nil, err
+	}
+	return &billBody{Reader: content, Closer: body}, nil
+}
+
+type billBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,51 @@
+package ecommercefund_test
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommercefund"
+)
+
+func ExampleWithdrawBillApiService_DownloadWithdrawBill() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercefund.WithdrawBillApiService{Client: client}
+	body, err := svc.DownloadWithdrawBill(ctx,
+		ecommercefund.GetWithdrawBillRequest{
+			BillType: core.String("NO_SUCC"),
+			BillDate: core.String("2019-08-17"),
+			TarType:  core.String("GZIP"),
+		},
+	)
+	if err != nil {
+		return
+	}
+	defer body.Close()
+
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if errors.Is(err, bill.ErrDigestMismatch) {
+			// 文件内容与摘要不一致，丢弃已读取的内容并重新下载
+			return
+		}
+		if err != nil {
+			return
+		}
+		fmt.Println(record)
+	}
+}
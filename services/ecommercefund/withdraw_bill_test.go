@@ -0,0 +1,226 @@
+package ecommercefund_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommercefund"
+)
+
+const (
+	testDownloadPath = "/v3/billdownload/file"
+	testDownloadUrl  = "https://api.mch.weixin.qq.com" + testDownloadPath + "?token=TEST_TOKEN"
+)
+
+// withdrawBillContent 提现异常文件的示例内容
+var withdrawBillContent = []byte("提现单号,二级商户号,提现金额,提现状态\n" +
+	"`12000000000201907120092546540,`1900000109,`100,`FAIL\n" +
+	"`12000000000201907120092546541,`1900000110,`200,`REFUND\n" +
+	"总笔数,总金额\n" +
+	"`2,`300\n")
+
+func sha1Hex(content []byte) string {
+	sum := sha1.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// fakeWithdrawBillServer 模拟申请提现异常文件与下载文件接口
+type fakeWithdrawBillServer struct {
+	bill     map[string]interface{}
+	billErr  int
+	file     []byte
+	gzipFile bool
+
+	lock     sync.Mutex
+	query    map[string]string
+	download int
+}
+
+func (s *fakeWithdrawBillServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if r.URL.Path == testDownloadPath {
+		s.download++
+		content := s.file
+		if s.gzipFile {
+			var buf bytes.Buffer
+			gz := gzip.NewWriter(&buf)
+			_, _ = gz.Write(content)
+			_ = gz.Close()
+			content = buf.Bytes()
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(content)
+		return
+	}
+
+	s.query = map[string]string{
+		"path":      r.URL.Path,
+		"bill_date": r.URL.Query().Get("bill_date"),
+		"tar_type":  r.URL.Query().Get("tar_type"),
+	}
+	if s.billErr != 0 {
+		clienttest.WriteError(w, s.billErr, "NO_STATEMENT_EXIST", "账单文件不存在")
+		return
+	}
+	clienttest.WriteJSON(w, http.StatusOK, s.bill)
+}
+
+func newWithdrawBillServer(file []byte, hashValue string) *fakeWithdrawBillServer {
+	return &fakeWithdrawBillServer{
+		bill: map[string]interface{}{
+			"hash_type":    "SHA1",
+			"hash_value":   hashValue,
+			"download_url": testDownloadUrl,
+		},
+		file: file,
+	}
+}
+
+func downloadWithdrawBill(t *testing.T, server *fakeWithdrawBillServer, tarType string) ([]byte, error) {
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	svc := ecommercefund.WithdrawBillApiService{Client: client}
+	req := ecommercefund.GetWithdrawBillRequest{
+		BillType: core.String("NO_SUCC"),
+		BillDate: core.String("2019-08-17"),
+	}
+	if tarType != "" {
+		req.TarType = core.String(tarType)
+	}
+	body, err := svc.DownloadWithdrawBill(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+func TestDownloadWithdrawBill(t *testing.T) {
+	for _, tarType := range []string{"", "GZIP", "gzip"} {
+		server := newWithdrawBillServer(withdrawBillContent, sha1Hex(withdrawBillContent))
+		server.gzipFile = tarType != ""
+
+		content, err := downloadWithdrawBill(t, server, tarType)
+		require.NoError(t, err, tarType)
+		assert.Equal(t, withdrawBillContent, content, tarType)
+		assert.Equal(t, map[string]string{
+			"path":      "/v3/merchant/fund/withdraw/bill-type/NO_SUCC",
+			"bill_date": "2019-08-17",
+			"tar_type":  tarType,
+		}, server.query, tarType)
+		assert.Equal(t, 1, server.download, tarType)
+	}
+}
+
+func TestDownloadWithdrawBill_Parse(t *testing.T) {
+	// 摘要值不区分大小写
+	server := newWithdrawBillServer(withdrawBillContent, sha1Hex(withdrawBillContent))
+	server.bill["hash_value"] = string(bytes.ToUpper([]byte(sha1Hex(withdrawBillContent))))
+	content, err := downloadWithdrawBill(t, server, "")
+	require.NoError(t, err)
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	assert.Equal(t, []string{"提现单号", "二级商户号", "提现金额", "提现状态"}, records[0])
+	assert.Equal(t, []string{"`12000000000201907120092546540", "`1900000109", "`100", "`FAIL"}, records[1])
+	assert.Equal(t, []string{"`2", "`300"}, records[4])
+}
+
+func TestDownloadWithdrawBill_DigestMismatch(t *testing.T) {
+	tampered := bytes.Replace(withdrawBillContent, []byte("`100"), []byte("`999"), 1)
+	for _, tarType := range []string{"", "GZIP"} {
+		server := newWithdrawBillServer(tampered, sha1Hex(withdrawBillContent))
+		server.gzipFile = tarType != ""
+
+		_, err := downloadWithdrawBill(t, server, tarType)
+		require.Error(t, err, tarType)
+		assert.True(t, errors.Is(err, bill.ErrDigestMismatch), tarType)
+		assert.Contains(t, err.Error(), "expected "+sha1Hex(withdrawBillContent), tarType)
+	}
+}
+
+func TestDownloadWithdrawBill_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		server       func() *fakeWithdrawBillServer
+		tarType      string
+		wantErr      string
+		skipDownload bool
+	}{
+		{
+			name: "bill not exist",
+			server: func() *fakeWithdrawBillServer {
+				server := newWithdrawBillServer(withdrawBillContent, sha1Hex(withdrawBillContent))
+				server.billErr = http.StatusBadRequest
+				return server
+			},
+			wantErr:      "NO_STATEMENT_EXIST",
+			skipDownload: true,
+		},
+		{
+			name: "missing download url",
+			server: func() *fakeWithdrawBillServer {
+				server := newWithdrawBillServer(withdrawBillContent, sha1Hex(withdrawBillContent))
+				delete(server.bill, "download_url")
+				return server
+			},
+			wantErr:      "download_url, hash_type and hash_value are required",
+			skipDownload: true,
+		},
+		{
+			name: "unsupported hash type",
+			server: func() *fakeWithdrawBillServer {
+				server := newWithdrawBillServer(withdrawBillContent, sha1Hex(withdrawBillContent))
+				server.bill["hash_type"] = "MD5"
+				return server
+			},
+			wantErr: `unsupported bill hash type "MD5"`,
+		},
+		{
+			name: "empty hash value",
+			server: func() *fakeWithdrawBillServer {
+				return newWithdrawBillServer(withdrawBillContent, "")
+			},
+			wantErr: "bill hash value is empty",
+		},
+		{
+			name: "gzip requested but plain file returned",
+			server: func() *fakeWithdrawBillServer {
+				return newWithdrawBillServer(withdrawBillContent, sha1Hex(withdrawBillContent))
+			},
+			tarType: "GZIP",
+			wantErr: "decompress withdraw bill err",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tt.server()
+			_, err := downloadWithdrawBill(t, server, tt.tarType)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			if tt.skipDownload {
+				// 申请失败时不下载文件
+				assert.Equal(t, 0, server.download)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通分账API
+//
+// 电商平台对二级商户订单的分账进行回退与完结的API，字段与普通服务商分账不同
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommerceprofitsharing
+
+import (
+	"context"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type FinishOrderApiService services.Service
+
+// FinishOrder 完结分账
+//
+// # 应用场景
+// 不需要进行分账的订单，或分账后仍有剩余待分金额的订单，电商平台可以通过该接口将订单的剩余金额全部解冻给二级商户。
+//
+// 注意：
+// 1、完结分账以商户分账单号保证幂等，网络超时或返回SYSTEM_ERROR时，请使用相同的商户分账单号与参数重试
+// 2、完结分账后，订单不能再进行分账与补差
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|订单状态不允许该操作，如订单已完结分账|请确认订单状态|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *FinishOrderApiService) FinishOrder(ctx context.Context, req FinishOrderBody) (resp *FinishOrderResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/profitsharing/finish-order"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract FinishOrderResponse from Http Response
+	resp = new(FinishOrderResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
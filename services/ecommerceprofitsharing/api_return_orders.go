@@ -0,0 +1,140 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通分账API
+//
+// 电商平台对二级商户订单的分账进行回退与完结的API，字段与普通服务商分账不同
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommerceprofitsharing
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ReturnOrdersApiService services.Service
+
+// CreateReturnOrder 请求分账回退
+//
+// # 应用场景
+// 订单已经分账，在退款时，电商平台可以通过该接口先将分账接收方已分得的资金回退到二级商户，再进行退款。
+//
+// 注意：
+// 1、分账回退以商户回退单号保证幂等，网络超时或返回SYSTEM_ERROR时，请使用相同的商户回退单号与参数重试，不会重复回退
+// 2、同一笔分账单可以分多次回退，累计回退金额不能超过分账金额
+// 3、分账回退的时限为180天
+// 4、电商收付通的回退单使用 return_no 标识微信回退单号，不同于普通服务商分账的 return_id
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NOT_ENOUGH|余额不足|回退方账户余额不足|请确认账户余额后使用原参数重试|
+// |INVALID_REQUEST|无效请求|订单状态不允许该操作，如订单已完结分账|请确认订单状态|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ReturnOrdersApiService) CreateReturnOrder(ctx context.Context, req CreateReturnOrderBody) (resp *ReturnOrder, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/profitsharing/returnorders"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ReturnOrder from Http Response
+	resp = new(ReturnOrder)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryReturnOrder 查询分账回退结果
+//
+// # 应用场景
+// 电商平台通过该接口查询分账回退的结果。分账回退结果为处理中（PROCESSING）时，请稍后使用相同的参数重新查询。
+//
+// 注意：
+// 1、微信分账单号与商户分账单号二选一传入
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|记录不存在|分账单或回退单不存在|请确认单号后重试|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ReturnOrdersApiService) QueryReturnOrder(ctx context.Context, req QueryReturnOrderRequest) (resp *ReturnOrder, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/profitsharing/returnorders"
+	// Make sure All Required Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryReturnOrderRequest")
+	}
+	if req.OutReturnNo == nil {
+		return nil, nil, fmt.Errorf("field `OutReturnNo` is required and must be specified in QueryReturnOrderRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	if req.OrderId != nil {
+		localVarQueryParams.Add("order_id", core.ParameterToString(*req.OrderId, ""))
+	}
+	if req.OutOrderNo != nil {
+		localVarQueryParams.Add("out_order_no", core.ParameterToString(*req.OutOrderNo, ""))
+	}
+	localVarQueryParams.Add("out_return_no", core.ParameterToString(*req.OutReturnNo, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ReturnOrder from Http Response
+	resp = new(ReturnOrder)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
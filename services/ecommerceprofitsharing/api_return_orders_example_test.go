@@ -0,0 +1,63 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通分账API
+//
+// 电商平台对二级商户订单的分账进行回退与完结的API，字段与普通服务商分账不同
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommerceprofitsharing_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommerceprofitsharing"
+)
+
+func ExampleReturnOrdersApiService_CreateReturnOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommerceprofitsharing.ReturnOrdersApiService{Client: client}
+	resp, result, err := svc.CreateReturnOrder(ctx,
+		ecommerceprofitsharing.CreateReturnOrderBody{
+			Amount:      core.Int64(10),
+			Description: core.String("用户退款"),
+			OrderId:     core.String("3008450740201411110007820472"),
+			OutOrderNo:  core.String("P20150806125346"),
+			OutReturnNo: core.String("R20190516001"),
+			ReturnMchid: core.String("86693852"),
+			SubMchid:    core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleReturnOrdersApiService_QueryReturnOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommerceprofitsharing.ReturnOrdersApiService{Client: client}
+	resp, result, err := svc.QueryReturnOrder(ctx,
+		ecommerceprofitsharing.QueryReturnOrderRequest{
+			OrderId:     core.String("3008450740201411110007820472"),
+			OutOrderNo:  core.String("P20150806125346"),
+			OutReturnNo: core.String("R20190516001"),
+			SubMchid:    core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,705 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通分账API
+//
+// 电商平台对二级商户订单的分账进行回退与完结的API，字段与普通服务商分账不同
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommerceprofitsharing
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CreateReturnOrderBody
+type CreateReturnOrderBody struct {
+	// 分账出资的电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信分账单号，与商户分账单号二选一
+	OrderId *string `json:"order_id,omitempty"`
+	// 商户分账单号，与微信分账单号二选一
+	OutOrderNo *string `json:"out_order_no,omitempty"`
+	// 商户回退单号，商户系统内部的回退单号，在商户系统内部唯一，同一回退单号多次请求等同一次
+	OutReturnNo *string `json:"out_return_no"`
+	// 回退商户号，只能是电商平台商户号
+	ReturnMchid *string `json:"return_mchid"`
+	// 回退金额，单位为分，不能超过分账金额
+	Amount *int64 `json:"amount"`
+	// 分账回退的原因描述
+	Description *string `json:"description"`
+}
+
+func (o CreateReturnOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CreateReturnOrderBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OrderId != nil {
+		toSerialize["order_id"] = o.OrderId
+	}
+
+	if o.OutOrderNo != nil {
+		toSerialize["out_order_no"] = o.OutOrderNo
+	}
+
+	if o.OutReturnNo == nil {
+		return nil, fmt.Errorf("field `OutReturnNo` is required and must be specified in CreateReturnOrderBody")
+	}
+	toSerialize["out_return_no"] = o.OutReturnNo
+
+	if o.ReturnMchid == nil {
+		return nil, fmt.Errorf("field `ReturnMchid` is required and must be specified in CreateReturnOrderBody")
+	}
+	toSerialize["return_mchid"] = o.ReturnMchid
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in CreateReturnOrderBody")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in CreateReturnOrderBody")
+	}
+	toSerialize["description"] = o.Description
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateReturnOrderBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OrderId:%v, ", *o.OrderId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.OutReturnNo == nil {
+		ret += "OutReturnNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutReturnNo:%v, ", *o.OutReturnNo)
+	}
+
+	if o.ReturnMchid == nil {
+		ret += "ReturnMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ReturnMchid:%v, ", *o.ReturnMchid)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("CreateReturnOrderBody{%s}", ret)
+}
+
+func (o CreateReturnOrderBody) Clone() *CreateReturnOrderBody {
+	ret := CreateReturnOrderBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.OutReturnNo != nil {
+		ret.OutReturnNo = new(string)
+		*ret.OutReturnNo = *o.OutReturnNo
+	}
+
+	if o.ReturnMchid != nil {
+		ret.ReturnMchid = new(string)
+		*ret.ReturnMchid = *o.ReturnMchid
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
+
+// FinishOrderBody
+type FinishOrderBody struct {
+	// 分账出资的电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户分账单号，商户系统内部的分账单号，在商户系统内部唯一，同一分账单号多次请求等同一次
+	OutOrderNo *string `json:"out_order_no"`
+	// 分账的原因描述，分账账单中需要体现
+	Description *string `json:"description"`
+}
+
+func (o FinishOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in FinishOrderBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in FinishOrderBody")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in FinishOrderBody")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in FinishOrderBody")
+	}
+	toSerialize["description"] = o.Description
+	return json.Marshal(toSerialize)
+}
+
+func (o FinishOrderBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("FinishOrderBody{%s}", ret)
+}
+
+func (o FinishOrderBody) Clone() *FinishOrderBody {
+	ret := FinishOrderBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
+
+// FinishOrderResponse
+type FinishOrderResponse struct {
+	// 分账出资的电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户分账单号
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信分账单号
+	OrderId *string `json:"order_id"`
+}
+
+func (o FinishOrderResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in FinishOrderResponse")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in FinishOrderResponse")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in FinishOrderResponse")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.OrderId == nil {
+		return nil, fmt.Errorf("field `OrderId` is required and must be specified in FinishOrderResponse")
+	}
+	toSerialize["order_id"] = o.OrderId
+	return json.Marshal(toSerialize)
+}
+
+func (o FinishOrderResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>"
+	} else {
+		ret += fmt.Sprintf("OrderId:%v", *o.OrderId)
+	}
+
+	return fmt.Sprintf("FinishOrderResponse{%s}", ret)
+}
+
+func (o FinishOrderResponse) Clone() *FinishOrderResponse {
+	ret := FinishOrderResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	return &ret
+}
+
+// QueryReturnOrderRequest
+type QueryReturnOrderRequest struct {
+	// 分账出资的电商平台二级商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 微信分账单号，与商户分账单号二选一
+	OrderId *string `json:"order_id,omitempty"`
+	// 商户分账单号，与微信分账单号二选一
+	OutOrderNo *string `json:"out_order_no,omitempty"`
+	// 商户回退单号
+	OutReturnNo *string `json:"out_return_no"`
+}
+
+func (o QueryReturnOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryReturnOrderRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OrderId != nil {
+		toSerialize["order_id"] = o.OrderId
+	}
+
+	if o.OutOrderNo != nil {
+		toSerialize["out_order_no"] = o.OutOrderNo
+	}
+
+	if o.OutReturnNo == nil {
+		return nil, fmt.Errorf("field `OutReturnNo` is required and must be specified in QueryReturnOrderRequest")
+	}
+	toSerialize["out_return_no"] = o.OutReturnNo
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryReturnOrderRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OrderId:%v, ", *o.OrderId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.OutReturnNo == nil {
+		ret += "OutReturnNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("OutReturnNo:%v", *o.OutReturnNo)
+	}
+
+	return fmt.Sprintf("QueryReturnOrderRequest{%s}", ret)
+}
+
+func (o QueryReturnOrderRequest) Clone() *QueryReturnOrderRequest {
+	ret := QueryReturnOrderRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.OutReturnNo != nil {
+		ret.OutReturnNo = new(string)
+		*ret.OutReturnNo = *o.OutReturnNo
+	}
+
+	return &ret
+}
+
+// ReturnOrder
+type ReturnOrder struct {
+	// 分账出资的电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信分账单号
+	OrderId *string `json:"order_id"`
+	// 商户分账单号
+	OutOrderNo *string `json:"out_order_no"`
+	// 商户回退单号，商户系统内部的回退单号，在商户系统内部唯一，同一回退单号多次请求等同一次
+	OutReturnNo *string `json:"out_return_no"`
+	// 回退商户号，只能是电商平台商户号
+	ReturnMchid *string `json:"return_mchid"`
+	// 回退金额，单位为分
+	Amount *int64 `json:"amount"`
+	// 微信回退单号，微信支付系统返回的唯一标识
+	ReturnNo *string `json:"return_no"`
+	// 回退结果
+	Result *ReturnOrderResult `json:"result"`
+	// 失败原因，回退结果为 FAILED 时返回
+	FailReason *ReturnOrderFailReason `json:"fail_reason,omitempty"`
+	// 分账回退完成时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	FinishTime *time.Time `json:"finish_time,omitempty"`
+}
+
+func (o ReturnOrder) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OrderId == nil {
+		return nil, fmt.Errorf("field `OrderId` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["order_id"] = o.OrderId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.OutReturnNo == nil {
+		return nil, fmt.Errorf("field `OutReturnNo` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["out_return_no"] = o.OutReturnNo
+
+	if o.ReturnMchid == nil {
+		return nil, fmt.Errorf("field `ReturnMchid` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["return_mchid"] = o.ReturnMchid
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.ReturnNo == nil {
+		return nil, fmt.Errorf("field `ReturnNo` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["return_no"] = o.ReturnNo
+
+	if o.Result == nil {
+		return nil, fmt.Errorf("field `Result` is required and must be specified in ReturnOrder")
+	}
+	toSerialize["result"] = o.Result
+
+	if o.FailReason != nil {
+		toSerialize["fail_reason"] = o.FailReason
+	}
+
+	if o.FinishTime != nil {
+		toSerialize["finish_time"] = o.FinishTime.Format(time.RFC3339)
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ReturnOrder) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OrderId:%v, ", *o.OrderId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.OutReturnNo == nil {
+		ret += "OutReturnNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutReturnNo:%v, ", *o.OutReturnNo)
+	}
+
+	if o.ReturnMchid == nil {
+		ret += "ReturnMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ReturnMchid:%v, ", *o.ReturnMchid)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.ReturnNo == nil {
+		ret += "ReturnNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ReturnNo:%v, ", *o.ReturnNo)
+	}
+
+	if o.Result == nil {
+		ret += "Result:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Result:%v, ", *o.Result)
+	}
+
+	if o.FailReason == nil {
+		ret += "FailReason:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FailReason:%v, ", *o.FailReason)
+	}
+
+	if o.FinishTime == nil {
+		ret += "FinishTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("FinishTime:%v", *o.FinishTime)
+	}
+
+	return fmt.Sprintf("ReturnOrder{%s}", ret)
+}
+
+func (o ReturnOrder) Clone() *ReturnOrder {
+	ret := ReturnOrder{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.OutReturnNo != nil {
+		ret.OutReturnNo = new(string)
+		*ret.OutReturnNo = *o.OutReturnNo
+	}
+
+	if o.ReturnMchid != nil {
+		ret.ReturnMchid = new(string)
+		*ret.ReturnMchid = *o.ReturnMchid
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.ReturnNo != nil {
+		ret.ReturnNo = new(string)
+		*ret.ReturnNo = *o.ReturnNo
+	}
+
+	if o.Result != nil {
+		ret.Result = new(ReturnOrderResult)
+		*ret.Result = *o.Result
+	}
+
+	if o.FailReason != nil {
+		ret.FailReason = new(ReturnOrderFailReason)
+		*ret.FailReason = *o.FailReason
+	}
+
+	if o.FinishTime != nil {
+		ret.FinishTime = new(time.Time)
+		*ret.FinishTime = *o.FinishTime
+	}
+
+	return &ret
+}
+
+// HasFailReason 应答中是否返回了 fail_reason，o 为 nil 时返回 false
+func (o *ReturnOrder) HasFailReason() bool {
+	return o != nil && o.FailReason != nil
+}
+
+// HasFinishTime 应答中是否返回了 finish_time，o 为 nil 时返回 false
+func (o *ReturnOrder) HasFinishTime() bool {
+	return o != nil && o.FinishTime != nil
+}
+
+// ReturnOrderFailReason * `ACCOUNT_ABNORMAL` - 分账接收方账户异常 * `BALANCE_NOT_ENOUGH` - 余额不足 * `TIME_OUT_CLOSED` - 超时关单
+type ReturnOrderFailReason string
+
+func (e ReturnOrderFailReason) Ptr() *ReturnOrderFailReason {
+	return &e
+}
+
+// Enums of ReturnOrderFailReason
+const (
+	RETURNORDERFAILREASON_ACCOUNT_ABNORMAL   ReturnOrderFailReason = "ACCOUNT_ABNORMAL"
+	RETURNORDERFAILREASON_BALANCE_NOT_ENOUGH ReturnOrderFailReason = "BALANCE_NOT_ENOUGH"
+	RETURNORDERFAILREASON_TIME_OUT_CLOSED    ReturnOrderFailReason = "TIME_OUT_CLOSED"
+)
+
+func (v *ReturnOrderFailReason) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ReturnOrderFailReason(value)
+	for _, existing := range []ReturnOrderFailReason{"ACCOUNT_ABNORMAL", "BALANCE_NOT_ENOUGH", "TIME_OUT_CLOSED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ReturnOrderFailReason", value)
+}
+
+// ReturnOrderResult * `PROCESSING` - 处理中 * `SUCCESS` - 已成功 * `FAILED` - 已失败
+type ReturnOrderResult string
+
+func (e ReturnOrderResult) Ptr() *ReturnOrderResult {
+	return &e
+}
+
+// Enums of ReturnOrderResult
+const (
+	RETURNORDERRESULT_PROCESSING ReturnOrderResult = "PROCESSING"
+	RETURNORDERRESULT_SUCCESS    ReturnOrderResult = "SUCCESS"
+	RETURNORDERRESULT_FAILED     ReturnOrderResult = "FAILED"
+)
+
+func (v *ReturnOrderResult) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ReturnOrderResult(value)
+	for _, existing := range []ReturnOrderResult{"PROCESSING", "SUCCESS", "FAILED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ReturnOrderResult", value)
+}
@@ -0,0 +1,67 @@
+package ecommerceprofitsharing
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// 电商收付通分账动账通知的通知类型（event_type）
+const (
+	// EventTypeProfitSharing 分账
+	EventTypeProfitSharing = "PROFITSHARING"
+	// EventTypeProfitSharingReturn 分账回退
+	EventTypeProfitSharingReturn = "PROFITSHARING_RETURN"
+)
+
+// NotificationReceiver 分账动账通知中的分账接收方
+type NotificationReceiver struct {
+	// Type 分账接收方类型，如 MERCHANT_ID
+	Type string `json:"type"`
+	// Account 分账接收方账号
+	Account string `json:"account"`
+	// Amount 分账动账金额，单位为分
+	Amount int64 `json:"amount"`
+	// Description 分账或分账回退的原因描述
+	Description string `json:"description"`
+}
+
+// Notification 电商收付通分账动账通知解密后的内容
+type Notification struct {
+	// SpMchid 电商平台商户号
+	SpMchid string `json:"sp_mchid"`
+	// SubMchid 分账出资的电商平台二级商户号
+	SubMchid string `json:"sub_mchid"`
+	// TransactionId 微信支付订单号
+	TransactionId string `json:"transaction_id"`
+	// OrderId 微信分账单号或微信回退单号
+	OrderId string `json:"order_id"`
+	// OutOrderNo 商户分账单号或商户回退单号
+	OutOrderNo string `json:"out_order_no"`
+	// Receiver 分账接收方
+	Receiver *NotificationReceiver `json:"receiver,omitempty"`
+	// SuccessTime 分账或分账回退成功的时间
+	SuccessTime *time.Time `json:"success_time,omitempty"`
+}
+
+// NotificationHandleFunc 分账动账通知处理函数，返回值的含义同 notify.HandleFunc
+type NotificationHandleFunc func(ctx context.Context, req *notify.Request, notification *Notification) error
+
+// HandleNotification 将 fn 包装为 notify.HandleFunc：解析通知内容后调用 fn，内容无法解析时以 notify.Reject 应答
+func HandleNotification(fn NotificationHandleFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		notification := new(Notification)
+		if err := req.UnmarshalContent(notification); err != nil {
+			return notify.Reject(err)
+		}
+		return fn(ctx, req, notification)
+	}
+}
+
+// RouteNotifications 在 router 上注册处理分账与分账回退动账通知的 fn，处理函数可以通过 req.EventType 区分两种通知
+func RouteNotifications(router *notify.Router, fn NotificationHandleFunc) {
+	handler := HandleNotification(fn)
+	router.Handle(EventTypeProfitSharing, handler)
+	router.Handle(EventTypeProfitSharingReturn, handler)
+}
@@ -0,0 +1,38 @@
+package ecommerceprofitsharing_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommerceprofitsharing"
+)
+
+func ExampleRouteNotifications() {
+	router := notify.NewRouter()
+	ecommerceprofitsharing.RouteNotifications(router,
+		func(ctx context.Context, req *notify.Request, notification *ecommerceprofitsharing.Notification) error {
+			switch req.EventType {
+			case ecommerceprofitsharing.EventTypeProfitSharing:
+				fmt.Printf("%s shared %d to %s\n",
+					notification.OutOrderNo, notification.Receiver.Amount, notification.Receiver.Account)
+			case ecommerceprofitsharing.EventTypeProfitSharingReturn:
+				fmt.Printf("%s returned %d from %s\n",
+					notification.OutOrderNo, notification.Receiver.Amount, notification.Receiver.Account)
+			}
+			return nil
+		},
+	)
+
+	// 实际使用时，将 router.Dispatch 作为 notify.Handler.HTTPHandler 的处理函数，由其完成验签与解密
+	_ = router.Dispatch(context.Background(), &notify.Request{
+		EventType: ecommerceprofitsharing.EventTypeProfitSharingReturn,
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"sp_mchid":"1900000100","sub_mchid":"1900000109","transaction_id":"4200000000000000000000000000",` +
+				`"order_id":"3008450740201411110007820472","out_order_no":"R20190516001","receiver":{"type":"MERCHANT_ID",` +
+				`"account":"86693852","amount":10,"description":"用户退款"},"success_time":"2018-06-08T10:34:56+08:00"}`,
+		},
+	})
+	// Output:
+	// R20190516001 returned 10 from 86693852
+}
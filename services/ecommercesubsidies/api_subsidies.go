@@ -0,0 +1,177 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通补差API
+//
+// 电商平台对二级商户的订单进行补差、补差回退与取消补差的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercesubsidies
+
+import (
+	"context"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type SubsidiesApiService services.Service
+
+// CancelSubsidy 取消补差
+//
+// # 应用场景
+// 对于已经补差的订单，电商平台在完结分账前如不再需要补差，可以通过该接口取消补差。
+//
+// 注意：
+// 1、取消补差以微信订单号保证幂等，同一笔订单重复请求取消补差时返回相同的结果
+// 2、取消补差后，该订单不能再次补差
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|订单状态不允许该操作，如订单已完结分账|请确认订单状态|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *SubsidiesApiService) CancelSubsidy(ctx context.Context, req CancelSubsidyBody) (resp *CancelSubsidyResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/subsidies/cancel"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract CancelSubsidyResponse from Http Response
+	resp = new(CancelSubsidyResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// CreateSubsidy 请求补差
+//
+// # 应用场景
+// 电商平台通过该接口对二级商户的订单进行补差，补差金额从电商平台的补差账户转入二级商户的订单中，随订单一起结算。
+//
+// 注意：
+// 1、订单支付成功后、完结分账前可以补差，每笔订单只能补差一次
+// 2、补差接口以商户补差单号保证幂等，网络超时或返回SYSTEM_ERROR时，请使用相同的商户补差单号与参数重试，不会重复补差
+// 3、退款后补差需要传入微信退款单号
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NOT_ENOUGH|余额不足|电商平台或二级商户账户余额不足|请确认账户余额后使用原参数重试|
+// |INVALID_REQUEST|无效请求|订单状态不允许该操作，如订单已完结分账|请确认订单状态|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *SubsidiesApiService) CreateSubsidy(ctx context.Context, req CreateSubsidyBody) (resp *CreateSubsidyResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/subsidies/create"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract CreateSubsidyResponse from Http Response
+	resp = new(CreateSubsidyResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// ReturnSubsidy 请求补差回退
+//
+// # 应用场景
+// 订单发生退款时，电商平台通过该接口将已补差的金额从二级商户账户回退到电商平台的补差账户。
+//
+// 注意：
+// 1、补差回退以商户补差回退单号保证幂等，网络超时或返回SYSTEM_ERROR时，请使用相同的商户补差回退单号与参数重试，不会重复回退
+// 2、同一笔订单可以分多次回退，累计回退金额不能超过补差金额
+// 3、需要在退款申请之前调用，回退成功后再申请退款
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NOT_ENOUGH|余额不足|电商平台或二级商户账户余额不足|请确认账户余额后使用原参数重试|
+// |INVALID_REQUEST|无效请求|订单状态不允许该操作，如订单已完结分账|请确认订单状态|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *SubsidiesApiService) ReturnSubsidy(ctx context.Context, req ReturnSubsidyBody) (resp *ReturnSubsidyResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/ecommerce/subsidies/return"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ReturnSubsidyResponse from Http Response
+	resp = new(ReturnSubsidyResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
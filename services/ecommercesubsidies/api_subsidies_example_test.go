@@ -0,0 +1,84 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通补差API
+//
+// 电商平台对二级商户的订单进行补差、补差回退与取消补差的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercesubsidies_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommercesubsidies"
+)
+
+func ExampleSubsidiesApiService_CancelSubsidy() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercesubsidies.SubsidiesApiService{Client: client}
+	resp, result, err := svc.CancelSubsidy(ctx,
+		ecommercesubsidies.CancelSubsidyBody{
+			Description:   core.String("测试备注"),
+			SubMchid:      core.String("1900000109"),
+			TransactionId: core.String("4208450740201411110007820472"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleSubsidiesApiService_CreateSubsidy() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercesubsidies.SubsidiesApiService{Client: client}
+	resp, result, err := svc.CreateSubsidy(ctx,
+		ecommercesubsidies.CreateSubsidyBody{
+			Amount:        core.Int64(10),
+			Description:   core.String("测试备注"),
+			OutSubsidyNo:  core.String("P20150806125346"),
+			RefundId:      core.String("3008450740201411110007820472"),
+			SubMchid:      core.String("1900000109"),
+			TransactionId: core.String("4208450740201411110007820472"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleSubsidiesApiService_ReturnSubsidy() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := ecommercesubsidies.SubsidiesApiService{Client: client}
+	resp, result, err := svc.ReturnSubsidy(ctx,
+		ecommercesubsidies.ReturnSubsidyBody{
+			Amount:        core.Int64(10),
+			Description:   core.String("测试备注"),
+			OutOrderNo:    core.String("P20150806125346"),
+			RefundId:      core.String("3008450740201411110007820472"),
+			SubMchid:      core.String("1900000109"),
+			TransactionId: core.String("4208450740201411110007820472"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
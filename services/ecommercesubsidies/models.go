@@ -0,0 +1,821 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电商收付通补差API
+//
+// 电商平台对二级商户的订单进行补差、补差回退与取消补差的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package ecommercesubsidies
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CancelSubsidyBody
+type CancelSubsidyBody struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 取消补差备注，分账账单中需要体现
+	Description *string `json:"description"`
+}
+
+func (o CancelSubsidyBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CancelSubsidyBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in CancelSubsidyBody")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in CancelSubsidyBody")
+	}
+	toSerialize["description"] = o.Description
+	return json.Marshal(toSerialize)
+}
+
+func (o CancelSubsidyBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("CancelSubsidyBody{%s}", ret)
+}
+
+func (o CancelSubsidyBody) Clone() *CancelSubsidyBody {
+	ret := CancelSubsidyBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
+
+// CancelSubsidyResponse
+type CancelSubsidyResponse struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 取消补差结果
+	Result *SubsidyResult `json:"result"`
+	// 取消补差备注
+	Description *string `json:"description"`
+}
+
+func (o CancelSubsidyResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CancelSubsidyResponse")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in CancelSubsidyResponse")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.Result == nil {
+		return nil, fmt.Errorf("field `Result` is required and must be specified in CancelSubsidyResponse")
+	}
+	toSerialize["result"] = o.Result
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in CancelSubsidyResponse")
+	}
+	toSerialize["description"] = o.Description
+	return json.Marshal(toSerialize)
+}
+
+func (o CancelSubsidyResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.Result == nil {
+		ret += "Result:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Result:%v, ", *o.Result)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("CancelSubsidyResponse{%s}", ret)
+}
+
+func (o CancelSubsidyResponse) Clone() *CancelSubsidyResponse {
+	ret := CancelSubsidyResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.Result != nil {
+		ret.Result = new(SubsidyResult)
+		*ret.Result = *o.Result
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
+
+// CreateSubsidyBody
+type CreateSubsidyBody struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 补差金额，单位为分，不能超过订单金额
+	Amount *int64 `json:"amount"`
+	// 补差备注，分账账单中需要体现
+	Description *string `json:"description"`
+	// 微信退款单号，退款后补差时需要传入
+	RefundId *string `json:"refund_id,omitempty"`
+	// 商户补差单号，商户系统内部的补差单号，在商户系统内部唯一，同一补差单号多次请求等同一次
+	OutSubsidyNo *string `json:"out_subsidy_no"`
+}
+
+func (o CreateSubsidyBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CreateSubsidyBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in CreateSubsidyBody")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in CreateSubsidyBody")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in CreateSubsidyBody")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.RefundId != nil {
+		toSerialize["refund_id"] = o.RefundId
+	}
+
+	if o.OutSubsidyNo == nil {
+		return nil, fmt.Errorf("field `OutSubsidyNo` is required and must be specified in CreateSubsidyBody")
+	}
+	toSerialize["out_subsidy_no"] = o.OutSubsidyNo
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateSubsidyBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.RefundId == nil {
+		ret += "RefundId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RefundId:%v, ", *o.RefundId)
+	}
+
+	if o.OutSubsidyNo == nil {
+		ret += "OutSubsidyNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("OutSubsidyNo:%v", *o.OutSubsidyNo)
+	}
+
+	return fmt.Sprintf("CreateSubsidyBody{%s}", ret)
+}
+
+func (o CreateSubsidyBody) Clone() *CreateSubsidyBody {
+	ret := CreateSubsidyBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.RefundId != nil {
+		ret.RefundId = new(string)
+		*ret.RefundId = *o.RefundId
+	}
+
+	if o.OutSubsidyNo != nil {
+		ret.OutSubsidyNo = new(string)
+		*ret.OutSubsidyNo = *o.OutSubsidyNo
+	}
+
+	return &ret
+}
+
+// CreateSubsidyResponse
+type CreateSubsidyResponse struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 微信补差单号
+	SubsidyId *string `json:"subsidy_id"`
+	// 补差备注
+	Description *string `json:"description"`
+	// 补差金额，单位为分
+	Amount *int64 `json:"amount"`
+	// 补差单结果
+	Result *SubsidyResult `json:"result"`
+	// 补差完成时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	SuccessTime *time.Time `json:"success_time"`
+	// 商户补差单号，商户系统内部的补差单号，在商户系统内部唯一，同一补差单号多次请求等同一次
+	OutSubsidyNo *string `json:"out_subsidy_no,omitempty"`
+}
+
+func (o CreateSubsidyResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CreateSubsidyResponse")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in CreateSubsidyResponse")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.SubsidyId == nil {
+		return nil, fmt.Errorf("field `SubsidyId` is required and must be specified in CreateSubsidyResponse")
+	}
+	toSerialize["subsidy_id"] = o.SubsidyId
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in CreateSubsidyResponse")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in CreateSubsidyResponse")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Result == nil {
+		return nil, fmt.Errorf("field `Result` is required and must be specified in CreateSubsidyResponse")
+	}
+	toSerialize["result"] = o.Result
+
+	if o.SuccessTime == nil {
+		return nil, fmt.Errorf("field `SuccessTime` is required and must be specified in CreateSubsidyResponse")
+	}
+	toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+
+	if o.OutSubsidyNo != nil {
+		toSerialize["out_subsidy_no"] = o.OutSubsidyNo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateSubsidyResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.SubsidyId == nil {
+		ret += "SubsidyId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubsidyId:%v, ", *o.SubsidyId)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Result == nil {
+		ret += "Result:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Result:%v, ", *o.Result)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	if o.OutSubsidyNo == nil {
+		ret += "OutSubsidyNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("OutSubsidyNo:%v", *o.OutSubsidyNo)
+	}
+
+	return fmt.Sprintf("CreateSubsidyResponse{%s}", ret)
+}
+
+func (o CreateSubsidyResponse) Clone() *CreateSubsidyResponse {
+	ret := CreateSubsidyResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.SubsidyId != nil {
+		ret.SubsidyId = new(string)
+		*ret.SubsidyId = *o.SubsidyId
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Result != nil {
+		ret.Result = new(SubsidyResult)
+		*ret.Result = *o.Result
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.OutSubsidyNo != nil {
+		ret.OutSubsidyNo = new(string)
+		*ret.OutSubsidyNo = *o.OutSubsidyNo
+	}
+
+	return &ret
+}
+
+// HasOutSubsidyNo 应答中是否返回了 out_subsidy_no，o 为 nil 时返回 false
+func (o *CreateSubsidyResponse) HasOutSubsidyNo() bool {
+	return o != nil && o.OutSubsidyNo != nil
+}
+
+// ReturnSubsidyBody
+type ReturnSubsidyBody struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 商户补差回退单号，商户系统内部的补差回退单号，在商户系统内部唯一，同一回退单号多次请求等同一次
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 微信退款单号，退款后回退补差时需要传入
+	RefundId *string `json:"refund_id,omitempty"`
+	// 补差回退金额，单位为分，累计回退金额不能超过补差金额
+	Amount *int64 `json:"amount"`
+	// 补差回退备注，分账账单中需要体现
+	Description *string `json:"description"`
+}
+
+func (o ReturnSubsidyBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in ReturnSubsidyBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in ReturnSubsidyBody")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in ReturnSubsidyBody")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.RefundId != nil {
+		toSerialize["refund_id"] = o.RefundId
+	}
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in ReturnSubsidyBody")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in ReturnSubsidyBody")
+	}
+	toSerialize["description"] = o.Description
+	return json.Marshal(toSerialize)
+}
+
+func (o ReturnSubsidyBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.RefundId == nil {
+		ret += "RefundId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RefundId:%v, ", *o.RefundId)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("ReturnSubsidyBody{%s}", ret)
+}
+
+func (o ReturnSubsidyBody) Clone() *ReturnSubsidyBody {
+	ret := ReturnSubsidyBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.RefundId != nil {
+		ret.RefundId = new(string)
+		*ret.RefundId = *o.RefundId
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
+
+// ReturnSubsidyResponse
+type ReturnSubsidyResponse struct {
+	// 电商平台二级商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 商户补差回退单号，商户系统内部的补差回退单号，在商户系统内部唯一，同一回退单号多次请求等同一次
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 微信补差回退单号
+	SubsidyRefundId *string `json:"subsidy_refund_id"`
+	// 微信退款单号
+	RefundId *string `json:"refund_id,omitempty"`
+	// 补差回退金额，单位为分
+	Amount *int64 `json:"amount"`
+	// 补差回退备注
+	Description *string `json:"description"`
+	// 补差回退结果
+	Result *SubsidyResult `json:"result"`
+	// 补差回退完成时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	SuccessTime *time.Time `json:"success_time"`
+}
+
+func (o ReturnSubsidyResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.SubsidyRefundId == nil {
+		return nil, fmt.Errorf("field `SubsidyRefundId` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["subsidy_refund_id"] = o.SubsidyRefundId
+
+	if o.RefundId != nil {
+		toSerialize["refund_id"] = o.RefundId
+	}
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.Result == nil {
+		return nil, fmt.Errorf("field `Result` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["result"] = o.Result
+
+	if o.SuccessTime == nil {
+		return nil, fmt.Errorf("field `SuccessTime` is required and must be specified in ReturnSubsidyResponse")
+	}
+	toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+	return json.Marshal(toSerialize)
+}
+
+func (o ReturnSubsidyResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.SubsidyRefundId == nil {
+		ret += "SubsidyRefundId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubsidyRefundId:%v, ", *o.SubsidyRefundId)
+	}
+
+	if o.RefundId == nil {
+		ret += "RefundId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RefundId:%v, ", *o.RefundId)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.Result == nil {
+		ret += "Result:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Result:%v, ", *o.Result)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v", *o.SuccessTime)
+	}
+
+	return fmt.Sprintf("ReturnSubsidyResponse{%s}", ret)
+}
+
+func (o ReturnSubsidyResponse) Clone() *ReturnSubsidyResponse {
+	ret := ReturnSubsidyResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.SubsidyRefundId != nil {
+		ret.SubsidyRefundId = new(string)
+		*ret.SubsidyRefundId = *o.SubsidyRefundId
+	}
+
+	if o.RefundId != nil {
+		ret.RefundId = new(string)
+		*ret.RefundId = *o.RefundId
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.Result != nil {
+		ret.Result = new(SubsidyResult)
+		*ret.Result = *o.Result
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	return &ret
+}
+
+// HasRefundId 应答中是否返回了 refund_id，o 为 nil 时返回 false
+func (o *ReturnSubsidyResponse) HasRefundId() bool {
+	return o != nil && o.RefundId != nil
+}
+
+// SubsidyResult * `SUCCESS` - 成功 * `FAIL` - 失败
+type SubsidyResult string
+
+func (e SubsidyResult) Ptr() *SubsidyResult {
+	return &e
+}
+
+// Enums of SubsidyResult
+const (
+	SUBSIDYRESULT_SUCCESS SubsidyResult = "SUCCESS"
+	SUBSIDYRESULT_FAIL    SubsidyResult = "FAIL"
+)
+
+func (v *SubsidyResult) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := SubsidyResult(value)
+	for _, existing := range []SubsidyResult{"SUCCESS", "FAIL"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid SubsidyResult", value)
+}
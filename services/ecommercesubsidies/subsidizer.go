@@ -0,0 +1,177 @@
+package ecommercesubsidies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const (
+	defaultSubsidyMaxAttempts   = 3
+	defaultSubsidyRetryInterval = time.Second
+)
+
+// GenerateOutSubsidyNo 生成商户补差单号，格式为 SD + 14 位时间 + 16 位随机字符，时间取自 auth.Now(ctx)
+func GenerateOutSubsidyNo(ctx context.Context) (string, error) {
+	return generateNo(ctx, "SD")
+}
+
+// GenerateOutReturnNo 生成商户补差回退单号，格式为 SR + 14 位时间 + 16 位随机字符，时间取自 auth.Now(ctx)
+func GenerateOutReturnNo(ctx context.Context) (string, error) {
+	return generateNo(ctx, "SR")
+}
+
+func generateNo(ctx context.Context, prefix string) (string, error) {
+	nonce, err := utils.GenerateNonce()
+	if err != nil {
+		return "", err
+	}
+	return prefix + auth.Now(ctx).Format("20060102150405") + nonce[:16], nil
+}
+
+// Subsidizer 补差请求编排器
+//
+// 补差与补差回退分别以商户补差单号、商户补差回退单号保证幂等，取消补差以微信订单号保证幂等，
+// 因此结果未知（网络异常、SYSTEM_ERROR）或遇到频率限制（FREQUENCY_LIMITED）时，可以使用相同的参数安全地重试。
+// Subsidizer 在未指定单号时自动生成，并在上述情况下按指数退避使用相同的参数重试。
+//
+// 重试次数用尽后仍失败时，补差结果未知，请使用相同的单号再次请求。
+// 因此需要在失败后继续重试时，请在请求中指定单号并将其与订单一同持久化，而不要依赖自动生成的单号。
+type Subsidizer struct {
+	svc           SubsidiesApiService
+	maxAttempts   int
+	retryInterval time.Duration
+}
+
+// SubsidizerOption Subsidizer 的配置项
+type SubsidizerOption func(s *Subsidizer)
+
+// WithSubsidyRetry 设置最大尝试次数与首次重试间隔，默认为 3 次、1s
+func WithSubsidyRetry(maxAttempts int, interval time.Duration) SubsidizerOption {
+	return func(s *Subsidizer) {
+		if maxAttempts > 0 {
+			s.maxAttempts = maxAttempts
+		}
+		if interval >= 0 {
+			s.retryInterval = interval
+		}
+	}
+}
+
+// NewSubsidizer 创建 Subsidizer
+func NewSubsidizer(client *core.Client, opts ...SubsidizerOption) *Subsidizer {
+	s := &Subsidizer{
+		svc:           SubsidiesApiService{Client: client},
+		maxAttempts:   defaultSubsidyMaxAttempts,
+		retryInterval: defaultSubsidyRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Create 请求补差，req 中的 OutSubsidyNo 为空时自动生成
+//
+// 应答中的 Result 为 FAIL 时补差失败，不会返回错误，请根据 Result 判断补差结果。
+func (s *Subsidizer) Create(
+	ctx context.Context, req CreateSubsidyBody,
+) (resp *CreateSubsidyResponse, result *core.APIResult, err error) {
+	req = *req.Clone()
+	if req.OutSubsidyNo == nil || *req.OutSubsidyNo == "" {
+		outSubsidyNo, err := GenerateOutSubsidyNo(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generate out_subsidy_no err: %v", err)
+		}
+		req.OutSubsidyNo = core.String(outSubsidyNo)
+	}
+
+	result, err = s.retry(ctx, func() (*core.APIResult, error) {
+		var result *core.APIResult
+		resp, result, err = s.svc.CreateSubsidy(ctx, req)
+		return result, err
+	})
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// Return 请求补差回退，req 中的 OutOrderNo 为空时自动生成。请在申请退款之前回退补差
+func (s *Subsidizer) Return(
+	ctx context.Context, req ReturnSubsidyBody,
+) (resp *ReturnSubsidyResponse, result *core.APIResult, err error) {
+	req = *req.Clone()
+	if req.OutOrderNo == nil || *req.OutOrderNo == "" {
+		outOrderNo, err := GenerateOutReturnNo(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generate out_order_no err: %v", err)
+		}
+		req.OutOrderNo = core.String(outOrderNo)
+	}
+
+	result, err = s.retry(ctx, func() (*core.APIResult, error) {
+		var result *core.APIResult
+		resp, result, err = s.svc.ReturnSubsidy(ctx, req)
+		return result, err
+	})
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// Cancel 取消补差
+func (s *Subsidizer) Cancel(
+	ctx context.Context, req CancelSubsidyBody,
+) (resp *CancelSubsidyResponse, result *core.APIResult, err error) {
+	result, err = s.retry(ctx, func() (*core.APIResult, error) {
+		var result *core.APIResult
+		resp, result, err = s.svc.CancelSubsidy(ctx, req)
+		return result, err
+	})
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// retry 在结果未知或遇到频率限制时按指数退避重试 do
+func (s *Subsidizer) retry(ctx context.Context, do func() (*core.APIResult, error)) (result *core.APIResult, err error) {
+	interval := s.retryInterval
+	for attempt := 1; ; attempt++ {
+		result, err = do()
+		if err == nil || !IsRetryable(err) || attempt >= s.maxAttempts {
+			return result, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, fmt.Errorf("%w, last err: %v", ctx.Err(), err)
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+// IsRetryable 判断补差请求的错误是否可以使用相同的参数重试：
+// 错误码为 SYSTEM_ERROR 或 FREQUENCY_LIMITED 的 *core.APIError，以及发送请求时的网络异常。ctx 结束时不重试
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == "SYSTEM_ERROR" || apiErr.Code == "FREQUENCY_LIMITED"
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
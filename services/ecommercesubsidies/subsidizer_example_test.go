@@ -0,0 +1,49 @@
+package ecommercesubsidies_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommercesubsidies"
+)
+
+func ExampleSubsidizer_Create() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	subsidizer := ecommercesubsidies.NewSubsidizer(client)
+	resp, result, err := subsidizer.Create(ctx,
+		ecommercesubsidies.CreateSubsidyBody{
+			SubMchid:      core.String("1900000109"),
+			TransactionId: core.String("4208450740201411110007820472"),
+			Amount:        core.Int64(10),
+			Description:   core.String("平台补贴"),
+			// 商户补差单号需与订单一同持久化，以便在结果未知时使用相同的单号重试
+			OutSubsidyNo: core.String("SD20150806125346"),
+		},
+	)
+	if err != nil {
+		return
+	}
+	if *resp.Result != ecommercesubsidies.SUBSIDYRESULT_SUCCESS {
+		// 补差失败
+		return
+	}
+
+	// TODO: 处理返回结果
+	_ = result
+}
+
+func ExampleIsRetryable() {
+	fmt.Println(ecommercesubsidies.IsRetryable(&core.APIError{StatusCode: 500, Code: "SYSTEM_ERROR"}))
+	fmt.Println(ecommercesubsidies.IsRetryable(&core.APIError{StatusCode: 403, Code: "NOT_ENOUGH"}))
+	fmt.Println(ecommercesubsidies.IsRetryable(context.DeadlineExceeded))
+	// Output:
+	// true
+	// false
+	// false
+}
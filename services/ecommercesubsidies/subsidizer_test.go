@@ -0,0 +1,241 @@
+package ecommercesubsidies_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/ecommercesubsidies"
+)
+
+func subsidyRequest() ecommercesubsidies.CreateSubsidyBody {
+	return ecommercesubsidies.CreateSubsidyBody{
+		SubMchid:      core.String("1900000109"),
+		TransactionId: core.String("4208450740201411110007820472"),
+		Amount:        core.Int64(10),
+		Description:   core.String("平台补贴"),
+	}
+}
+
+// fakeSubsidyServer 依次使用 statuses 中的状态码应答补差请求，用完后应答成功
+type fakeSubsidyServer struct {
+	lock          sync.Mutex
+	statuses      []int
+	outSubsidyNos []string
+}
+
+func (s *fakeSubsidyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]interface{}
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	s.lock.Lock()
+	outSubsidyNo, _ := body["out_subsidy_no"].(string)
+	s.outSubsidyNos = append(s.outSubsidyNos, outSubsidyNo)
+	status := http.StatusOK
+	if len(s.statuses) > 0 {
+		status, s.statuses = s.statuses[0], s.statuses[1:]
+	}
+	s.lock.Unlock()
+
+	switch status {
+	case http.StatusOK:
+		clienttest.WriteJSON(w, status, map[string]interface{}{
+			"sub_mchid":      "1900000109",
+			"transaction_id": "4208450740201411110007820472",
+			"subsidy_id":     "3008450740201411110007820472",
+			"description":    "平台补贴",
+			"amount":         10,
+			"result":         "SUCCESS",
+			"success_time":   "2018-06-08T10:34:56+08:00",
+			"out_subsidy_no": outSubsidyNo,
+		})
+	case http.StatusTooManyRequests:
+		clienttest.WriteError(w, status, "FREQUENCY_LIMITED", "频率超限")
+	case http.StatusForbidden:
+		clienttest.WriteError(w, status, "NOT_ENOUGH", "补差金额超限")
+	case http.StatusBadRequest:
+		clienttest.WriteError(w, status, "PARAM_ERROR", "参数错误")
+	default:
+		clienttest.WriteError(w, status, "SYSTEM_ERROR", "系统超时")
+	}
+}
+
+func (s *fakeSubsidyServer) requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.outSubsidyNos...)
+}
+
+func newTestSubsidizer(
+	t *testing.T, server *fakeSubsidyServer, opts ...ecommercesubsidies.SubsidizerOption,
+) *ecommercesubsidies.Subsidizer {
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	return ecommercesubsidies.NewSubsidizer(client, append([]ecommercesubsidies.SubsidizerOption{
+		ecommercesubsidies.WithSubsidyRetry(3, time.Millisecond),
+	}, opts...)...)
+}
+
+func TestGenerateOutSubsidyNo(t *testing.T) {
+	now := time.Date(2021, 8, 1, 12, 30, 45, 0, time.Local)
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+
+	outSubsidyNo, err := ecommercesubsidies.GenerateOutSubsidyNo(ctx)
+	require.NoError(t, err)
+	assert.Len(t, outSubsidyNo, 32)
+	assert.True(t, strings.HasPrefix(outSubsidyNo, "SD20210801123045"), outSubsidyNo)
+
+	outReturnNo, err := ecommercesubsidies.GenerateOutReturnNo(ctx)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(outReturnNo, "SR20210801123045"), outReturnNo)
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "system error", err: &core.APIError{StatusCode: http.StatusInternalServerError, Code: "SYSTEM_ERROR"}, want: true},
+		{name: "frequency limited", err: &core.APIError{StatusCode: http.StatusTooManyRequests, Code: "FREQUENCY_LIMITED"}, want: true},
+		{name: "wrapped api error", err: fmt.Errorf("create: %w", &core.APIError{Code: "SYSTEM_ERROR"}), want: true},
+		{name: "network error", err: &url.Error{Op: "Post", URL: "https://api.mch.weixin.qq.com", Err: errors.New("EOF")}, want: true},
+		{name: "param error", err: &core.APIError{StatusCode: http.StatusBadRequest, Code: "PARAM_ERROR"}, want: false},
+		{name: "not enough", err: &core.APIError{StatusCode: http.StatusForbidden, Code: "NOT_ENOUGH"}, want: false},
+		{name: "context canceled", err: &url.Error{Op: "Post", Err: context.Canceled}, want: false},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, want: false},
+		{name: "other error", err: errors.New("invalid request"), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ecommercesubsidies.IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestSubsidizer_Create(t *testing.T) {
+	now := time.Date(2021, 8, 1, 12, 30, 45, 0, time.Local)
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+	server := &fakeSubsidyServer{}
+	subsidizer := newTestSubsidizer(t, server)
+
+	resp, _, err := subsidizer.Create(ctx, subsidyRequest())
+	require.NoError(t, err)
+	assert.Equal(t, ecommercesubsidies.SUBSIDYRESULT_SUCCESS, *resp.Result)
+
+	// 未指定商户补差单号时自动生成
+	outSubsidyNos := server.requests()
+	require.Len(t, outSubsidyNos, 1)
+	assert.True(t, strings.HasPrefix(outSubsidyNos[0], "SD20210801123045"), outSubsidyNos[0])
+	assert.Equal(t, outSubsidyNos[0], *resp.OutSubsidyNo)
+}
+
+func TestSubsidizer_CreateRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+	}{
+		{name: "system error", statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError}},
+		{name: "frequency limited", statuses: []int{http.StatusTooManyRequests, http.StatusTooManyRequests}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeSubsidyServer{statuses: tt.statuses}
+			subsidizer := newTestSubsidizer(t, server)
+
+			resp, _, err := subsidizer.Create(context.Background(), subsidyRequest())
+			require.NoError(t, err)
+			assert.Equal(t, ecommercesubsidies.SUBSIDYRESULT_SUCCESS, *resp.Result)
+
+			// 重试时使用相同的商户补差单号
+			outSubsidyNos := server.requests()
+			require.Len(t, outSubsidyNos, 3)
+			assert.Equal(t, outSubsidyNos[0], outSubsidyNos[1])
+			assert.Equal(t, outSubsidyNos[0], outSubsidyNos[2])
+		})
+	}
+}
+
+func TestSubsidizer_CreateRetryExhausted(t *testing.T) {
+	server := &fakeSubsidyServer{statuses: []int{
+		http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError,
+	}}
+	subsidizer := newTestSubsidizer(t, server)
+
+	_, _, err := subsidizer.Create(context.Background(), subsidyRequest())
+	require.Error(t, err)
+	var apiErr *core.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "SYSTEM_ERROR", apiErr.Code)
+	assert.Len(t, server.requests(), 3)
+}
+
+func TestSubsidizer_CreateRetryNotForOtherErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusForbidden} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server := &fakeSubsidyServer{statuses: []int{status}}
+			subsidizer := newTestSubsidizer(t, server)
+
+			_, _, err := subsidizer.Create(context.Background(), subsidyRequest())
+			require.Error(t, err)
+			assert.Len(t, server.requests(), 1)
+		})
+	}
+}
+
+func TestSubsidizer_CreateRetryNetworkError(t *testing.T) {
+	var (
+		lock     sync.Mutex
+		attempts int
+	)
+	server := &fakeSubsidyServer{}
+	handler := clienttest.HandlerTransport(server)
+	client, err := clienttest.NewClient(clienttest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		lock.Lock()
+		attempts++
+		attempt := attempts
+		lock.Unlock()
+		if attempt == 1 {
+			return nil, errors.New("connection reset by peer")
+		}
+		return handler.RoundTrip(req)
+	}))
+	require.NoError(t, err)
+	subsidizer := ecommercesubsidies.NewSubsidizer(client, ecommercesubsidies.WithSubsidyRetry(3, time.Millisecond))
+
+	resp, _, err := subsidizer.Create(context.Background(), subsidyRequest())
+	require.NoError(t, err)
+	assert.Equal(t, ecommercesubsidies.SUBSIDYRESULT_SUCCESS, *resp.Result)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestSubsidizer_CreateContextCanceledDuringRetry(t *testing.T) {
+	server := &fakeSubsidyServer{statuses: []int{http.StatusTooManyRequests}}
+	subsidizer := newTestSubsidizer(t, server, ecommercesubsidies.WithSubsidyRetry(3, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := subsidizer.Create(ctx, subsidyRequest())
+	require.Error(t, err)
+
+	// 等待重试时取消，不再发送请求
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "FREQUENCY_LIMITED")
+	assert.Len(t, server.requests(), 1)
+}
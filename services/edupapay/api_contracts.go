@@ -0,0 +1,252 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 教育续费通API
+//
+// 校园轻松付（教育续费通）签约与扣款相关的API，适用于学校、教育机构按签约计划向家长自动扣费的场景
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package edupapay
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ContractsApiService services.Service
+
+// ListUserContracts 通过用户信息查询签约
+//
+// # 应用场景
+// 商户可以通过该接口查询用户在指定签约计划下的签约协议。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ContractsApiService) ListUserContracts(ctx context.Context, req ListUserContractsRequest) (resp *ListContractsResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.Openid == nil {
+		return nil, nil, fmt.Errorf("field `Openid` is required and must be specified in ListUserContractsRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/user/{openid}/contracts"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"openid"+"}", neturl.PathEscape(core.ParameterToString(*req.Openid, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.Appid == nil {
+		return nil, nil, fmt.Errorf("field `Appid` is required and must be specified in ListUserContractsRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("appid", core.ParameterToString(*req.Appid, ""))
+	if req.PlanId != nil {
+		localVarQueryParams.Add("plan_id", core.ParameterToString(*req.PlanId, ""))
+	}
+	if req.SubMchid != nil {
+		localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	}
+	if req.Offset != nil {
+		localVarQueryParams.Add("offset", core.ParameterToString(*req.Offset, ""))
+	}
+	if req.Limit != nil {
+		localVarQueryParams.Add("limit", core.ParameterToString(*req.Limit, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ListContractsResponse from Http Response
+	resp = new(ListContractsResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// PresignContract 预签约
+//
+// # 应用场景
+// 商户在引导用户签约前调用该接口获取预签约token，再使用预签约token拉起签约页面，用户确认后完成签约。
+//
+// 注意：
+// 1、预签约token有效期为2小时
+// 2、同一用户在同一签约计划下只能存在一份生效的签约协议
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |USER_SIGNED|用户已签约|用户在该签约计划下已存在生效的签约协议|请勿重复签约|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ContractsApiService) PresignContract(ctx context.Context, req PresignContractBody) (resp *PresignContractResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/contracts/presign"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract PresignContractResponse from Http Response
+	resp = new(PresignContractResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryContract 通过协议号查询签约
+//
+// # 应用场景
+// 商户可以通过该接口使用签约协议号查询签约协议的详细信息。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|签约协议不存在|协议号不存在或不属于当前商户|请确认协议号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ContractsApiService) QueryContract(ctx context.Context, req QueryContractRequest) (resp *Contract, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.ContractId == nil {
+		return nil, nil, fmt.Errorf("field `ContractId` is required and must be specified in QueryContractRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/contracts/id/{contract_id}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"contract_id"+"}", neturl.PathEscape(core.ParameterToString(*req.ContractId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.SubMchid != nil {
+		localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Contract from Http Response
+	resp = new(Contract)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// TerminateContract 解约
+//
+// # 应用场景
+// 商户可以通过该接口解除与用户的签约协议，解约后不能再使用该协议扣款。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|签约协议不存在|协议号不存在或不属于当前商户|请确认协议号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ContractsApiService) TerminateContract(ctx context.Context, req TerminateContractRequest) (result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodDelete
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.ContractId == nil {
+		return nil, fmt.Errorf("field `ContractId` is required and must be specified in TerminateContractRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/contracts/{contract_id}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"contract_id"+"}", neturl.PathEscape(core.ParameterToString(*req.ContractId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.SubMchid != nil {
+		localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
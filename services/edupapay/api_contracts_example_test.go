@@ -0,0 +1,104 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 教育续费通API
+//
+// 校园轻松付（教育续费通）签约与扣款相关的API，适用于学校、教育机构按签约计划向家长自动扣费的场景
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package edupapay_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/edupapay"
+)
+
+func ExampleContractsApiService_ListUserContracts() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.ContractsApiService{Client: client}
+	resp, result, err := svc.ListUserContracts(ctx,
+		edupapay.ListUserContractsRequest{
+			Appid:    core.String("wxcbda96de0b165486"),
+			Limit:    core.Int64(10),
+			Offset:   core.Int64(0),
+			Openid:   core.String("o-MYE42l80oelYMDE34nYD456Xoy"),
+			PlanId:   core.String("1"),
+			SubMchid: core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleContractsApiService_PresignContract() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.ContractsApiService{Client: client}
+	resp, result, err := svc.PresignContract(ctx,
+		edupapay.PresignContractBody{
+			Appid:           core.String("wxcbda96de0b165486"),
+			Openid:          core.String("o-MYE42l80oelYMDE34nYD456Xoy"),
+			PeriodStartDate: core.String("2021-04-01"),
+			PlanId:          core.String("1"),
+			SubAppid:        core.String("wxcbda96de0b165484"),
+			SubMchid:        core.String("1900000109"),
+			TradeScene:      core.String("SCHOOL"),
+			UserId:          core.String("20210401001"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleContractsApiService_QueryContract() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.ContractsApiService{Client: client}
+	resp, result, err := svc.QueryContract(ctx,
+		edupapay.QueryContractRequest{
+			ContractId: core.String("wxcbda96de0b165489"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleContractsApiService_TerminateContract() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.ContractsApiService{Client: client}
+	result, err := svc.TerminateContract(ctx,
+		edupapay.TerminateContractRequest{
+			ContractId: core.String("wxcbda96de0b165489"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
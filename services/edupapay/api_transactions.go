@@ -0,0 +1,238 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 教育续费通API
+//
+// 校园轻松付（教育续费通）签约与扣款相关的API，适用于学校、教育机构按签约计划向家长自动扣费的场景
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package edupapay
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type TransactionsApiService services.Service
+
+// ApplyDeduction 受理扣款
+//
+// # 应用场景
+// 商户在发送扣款预通知后，使用签约协议号受理扣款，扣款结果通过支付结果通知或扣款查询接口获取。
+//
+// 注意：
+// 1、受理扣款成功只代表扣款请求已被受理，不代表扣款成功
+// 2、同一商户订单号重复请求时不会重复扣款，网络超时或返回SYSTEM_ERROR时请使用原商户订单号重试
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|签约协议不存在|协议号不存在或不属于当前商户|请确认协议号是否正确|
+// |CONTRACT_TERMINATED|签约协议已解约|签约协议已解约，无法扣款|请引导用户重新签约|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *TransactionsApiService) ApplyDeduction(ctx context.Context, req ApplyDeductionBody) (result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/transactions"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// QueryDeductionByOutTradeNo 商户订单号查询扣款
+//
+// # 应用场景
+// 商户可以通过该接口使用商户订单号查询扣款结果。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |ORDER_NOT_EXIST|订单不存在|商户订单号不存在|请确认商户订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *TransactionsApiService) QueryDeductionByOutTradeNo(ctx context.Context, req QueryDeductionByOutTradeNoRequest) (resp *Transaction, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutTradeNo == nil {
+		return nil, nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in QueryDeductionByOutTradeNoRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/transactions/out-trade-no/{out_trade_no}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_trade_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutTradeNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.SubMchid != nil {
+		localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Transaction from Http Response
+	resp = new(Transaction)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryDeductionByTransactionId 微信订单号查询扣款
+//
+// # 应用场景
+// 商户可以通过该接口使用微信支付订单号查询扣款结果。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |ORDER_NOT_EXIST|订单不存在|微信支付订单号不存在|请确认微信支付订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *TransactionsApiService) QueryDeductionByTransactionId(ctx context.Context, req QueryDeductionByTransactionIdRequest) (resp *Transaction, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.TransactionId == nil {
+		return nil, nil, fmt.Errorf("field `TransactionId` is required and must be specified in QueryDeductionByTransactionIdRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/transactions/id/{transaction_id}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"transaction_id"+"}", neturl.PathEscape(core.ParameterToString(*req.TransactionId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.SubMchid != nil {
+		localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Transaction from Http Response
+	resp = new(Transaction)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// SendDeductionNotification 发送扣款预通知
+//
+// # 应用场景
+// 商户在发起扣款前需要调用该接口向用户发送扣款预通知，用户收到预通知后商户才能受理扣款。
+//
+// 注意：
+// 1、每个扣款周期内需至少发送一次预通知
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|签约协议不存在|协议号不存在或不属于当前商户|请确认协议号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *TransactionsApiService) SendDeductionNotification(ctx context.Context, req SendDeductionNotificationRequest) (result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.ContractId == nil {
+		return nil, fmt.Errorf("field `ContractId` is required and must be specified in SendDeductionNotificationRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/edu-papay/user-notifications/{contract_id}/send"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"contract_id"+"}", neturl.PathEscape(core.ParameterToString(*req.ContractId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &SendDeductionNotificationBody{
+		SubMchid:       req.SubMchid,
+		AssociatedData: req.AssociatedData,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 教育续费通API
+//
+// 校园轻松付（教育续费通）签约与扣款相关的API，适用于学校、教育机构按签约计划向家长自动扣费的场景
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package edupapay_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/edupapay"
+)
+
+func ExampleTransactionsApiService_ApplyDeduction() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.TransactionsApiService{Client: client}
+	result, err := svc.ApplyDeduction(ctx,
+		edupapay.ApplyDeductionBody{
+			Amount: &edupapay.TransactionAmount{
+				Currency: core.String("CNY"),
+				Total:    core.Int64(100),
+			},
+			Appid:      core.String("wxcbda96de0b165486"),
+			Attach:     core.String("自定义数据"),
+			Body:       core.String("2021年4月课后服务费"),
+			ContractId: core.String("wxcbda96de0b165489"),
+			GoodsTag:   core.String("WXG"),
+			NotifyUrl:  core.String("https://yourapp.com/notify"),
+			OutTradeNo: core.String("1217752501201407033233368018"),
+			SubAppid:   core.String("wxcbda96de0b165484"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleTransactionsApiService_QueryDeductionByOutTradeNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.TransactionsApiService{Client: client}
+	resp, result, err := svc.QueryDeductionByOutTradeNo(ctx,
+		edupapay.QueryDeductionByOutTradeNoRequest{
+			OutTradeNo: core.String("1217752501201407033233368018"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_QueryDeductionByTransactionId() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.TransactionsApiService{Client: client}
+	resp, result, err := svc.QueryDeductionByTransactionId(ctx,
+		edupapay.QueryDeductionByTransactionIdRequest{
+			SubMchid:      core.String("1900000109"),
+			TransactionId: core.String("1217752501201407033233368018"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_SendDeductionNotification() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := edupapay.TransactionsApiService{Client: client}
+	result, err := svc.SendDeductionNotification(ctx,
+		edupapay.SendDeductionNotificationRequest{
+			AssociatedData: core.String("2021年4月课后服务费"),
+			ContractId:     core.String("wxcbda96de0b165489"),
+			SubMchid:       core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
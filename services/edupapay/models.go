@@ -0,0 +1,1836 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 教育续费通API
+//
+// 校园轻松付（教育续费通）签约与扣款相关的API，适用于学校、教育机构按签约计划向家长自动扣费的场景
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package edupapay
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ApplyDeductionBody
+type ApplyDeductionBody struct {
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户在微信公众平台或移动应用申请的appid
+	Appid *string `json:"appid"`
+	// 子商户在微信公众平台或移动应用申请的appid，服务商模式下选填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 商品描述
+	Body *string `json:"body"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 商户系统内部订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// 扣款结果通知地址，不填时使用签约计划配置的通知地址
+	NotifyUrl *string `json:"notify_url,omitempty"`
+	// 签约成功后由微信支付返回的签约协议号
+	ContractId *string `json:"contract_id"`
+	// 订单金额
+	Amount *TransactionAmount `json:"amount"`
+}
+
+func (o ApplyDeductionBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in ApplyDeductionBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.Body == nil {
+		return nil, fmt.Errorf("field `Body` is required and must be specified in ApplyDeductionBody")
+	}
+	toSerialize["body"] = o.Body
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in ApplyDeductionBody")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.NotifyUrl != nil {
+		toSerialize["notify_url"] = o.NotifyUrl
+	}
+
+	if o.ContractId == nil {
+		return nil, fmt.Errorf("field `ContractId` is required and must be specified in ApplyDeductionBody")
+	}
+	toSerialize["contract_id"] = o.ContractId
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in ApplyDeductionBody")
+	}
+	toSerialize["amount"] = o.Amount
+	return json.Marshal(toSerialize)
+}
+
+func (o ApplyDeductionBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.Body == nil {
+		ret += "Body:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Body:%v, ", *o.Body)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.ContractId == nil {
+		ret += "ContractId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContractId:%v, ", *o.ContractId)
+	}
+
+	ret += fmt.Sprintf("Amount:%v", o.Amount)
+
+	return fmt.Sprintf("ApplyDeductionBody{%s}", ret)
+}
+
+func (o ApplyDeductionBody) Clone() *ApplyDeductionBody {
+	ret := ApplyDeductionBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.Body != nil {
+		ret.Body = new(string)
+		*ret.Body = *o.Body
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.ContractId != nil {
+		ret.ContractId = new(string)
+		*ret.ContractId = *o.ContractId
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	return &ret
+}
+
+// Contract
+type Contract struct {
+	// 签约成功后由微信支付返回的签约协议号
+	ContractId *string `json:"contract_id"`
+	// 直连商户号或服务商商户号
+	Mchid *string `json:"mchid"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户在微信公众平台或移动应用申请的appid
+	Appid *string `json:"appid"`
+	// 子商户在微信公众平台或移动应用申请的appid，服务商模式下选填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 用户在商户appid下的唯一标识
+	Openid *string `json:"openid"`
+	// 用户在子商户appid下的唯一标识
+	SubOpenid *string `json:"sub_openid,omitempty"`
+	// 签约计划ID，在商户平台配置签约计划后获得
+	PlanId *string `json:"plan_id"`
+	// 签约协议状态
+	ContractState *ContractState `json:"contract_state"`
+	// 签约时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	SignTime *time.Time `json:"sign_time"`
+	// 解约时间，签约协议状态为TERMINATED时返回，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	TerminateTime *time.Time `json:"terminate_time,omitempty"`
+	// 解约原因，签约协议状态为TERMINATED时返回
+	TerminateReason *string `json:"terminate_reason,omitempty"`
+}
+
+func (o Contract) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ContractId == nil {
+		return nil, fmt.Errorf("field `ContractId` is required and must be specified in Contract")
+	}
+	toSerialize["contract_id"] = o.ContractId
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in Contract")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in Contract")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.Openid == nil {
+		return nil, fmt.Errorf("field `Openid` is required and must be specified in Contract")
+	}
+	toSerialize["openid"] = o.Openid
+
+	if o.SubOpenid != nil {
+		toSerialize["sub_openid"] = o.SubOpenid
+	}
+
+	if o.PlanId == nil {
+		return nil, fmt.Errorf("field `PlanId` is required and must be specified in Contract")
+	}
+	toSerialize["plan_id"] = o.PlanId
+
+	if o.ContractState == nil {
+		return nil, fmt.Errorf("field `ContractState` is required and must be specified in Contract")
+	}
+	toSerialize["contract_state"] = o.ContractState
+
+	if o.SignTime == nil {
+		return nil, fmt.Errorf("field `SignTime` is required and must be specified in Contract")
+	}
+	toSerialize["sign_time"] = o.SignTime.Format(time.RFC3339)
+
+	if o.TerminateTime != nil {
+		toSerialize["terminate_time"] = o.TerminateTime.Format(time.RFC3339)
+	}
+
+	if o.TerminateReason != nil {
+		toSerialize["terminate_reason"] = o.TerminateReason
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Contract) String() string {
+	var ret string
+	if o.ContractId == nil {
+		ret += "ContractId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContractId:%v, ", *o.ContractId)
+	}
+
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.SubOpenid == nil {
+		ret += "SubOpenid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubOpenid:%v, ", *o.SubOpenid)
+	}
+
+	if o.PlanId == nil {
+		ret += "PlanId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlanId:%v, ", *o.PlanId)
+	}
+
+	if o.ContractState == nil {
+		ret += "ContractState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContractState:%v, ", *o.ContractState)
+	}
+
+	if o.SignTime == nil {
+		ret += "SignTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SignTime:%v, ", *o.SignTime)
+	}
+
+	if o.TerminateTime == nil {
+		ret += "TerminateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TerminateTime:%v, ", *o.TerminateTime)
+	}
+
+	if o.TerminateReason == nil {
+		ret += "TerminateReason:<nil>"
+	} else {
+		ret += fmt.Sprintf("TerminateReason:%v", *o.TerminateReason)
+	}
+
+	return fmt.Sprintf("Contract{%s}", ret)
+}
+
+func (o Contract) Clone() *Contract {
+	ret := Contract{}
+
+	if o.ContractId != nil {
+		ret.ContractId = new(string)
+		*ret.ContractId = *o.ContractId
+	}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.SubOpenid != nil {
+		ret.SubOpenid = new(string)
+		*ret.SubOpenid = *o.SubOpenid
+	}
+
+	if o.PlanId != nil {
+		ret.PlanId = new(string)
+		*ret.PlanId = *o.PlanId
+	}
+
+	if o.ContractState != nil {
+		ret.ContractState = new(ContractState)
+		*ret.ContractState = *o.ContractState
+	}
+
+	if o.SignTime != nil {
+		ret.SignTime = new(time.Time)
+		*ret.SignTime = *o.SignTime
+	}
+
+	if o.TerminateTime != nil {
+		ret.TerminateTime = new(time.Time)
+		*ret.TerminateTime = *o.TerminateTime
+	}
+
+	if o.TerminateReason != nil {
+		ret.TerminateReason = new(string)
+		*ret.TerminateReason = *o.TerminateReason
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *Contract) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasSubAppid 应答中是否返回了 sub_appid，o 为 nil 时返回 false
+func (o *Contract) HasSubAppid() bool {
+	return o != nil && o.SubAppid != nil
+}
+
+// HasSubOpenid 应答中是否返回了 sub_openid，o 为 nil 时返回 false
+func (o *Contract) HasSubOpenid() bool {
+	return o != nil && o.SubOpenid != nil
+}
+
+// HasTerminateTime 应答中是否返回了 terminate_time，o 为 nil 时返回 false
+func (o *Contract) HasTerminateTime() bool {
+	return o != nil && o.TerminateTime != nil
+}
+
+// HasTerminateReason 应答中是否返回了 terminate_reason，o 为 nil 时返回 false
+func (o *Contract) HasTerminateReason() bool {
+	return o != nil && o.TerminateReason != nil
+}
+
+// ContractState * `SIGNED` - 已签约 * `TERMINATED` - 已解约
+type ContractState string
+
+func (e ContractState) Ptr() *ContractState {
+	return &e
+}
+
+// Enums of ContractState
+const (
+	CONTRACTSTATE_SIGNED     ContractState = "SIGNED"
+	CONTRACTSTATE_TERMINATED ContractState = "TERMINATED"
+)
+
+func (v *ContractState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ContractState(value)
+	for _, existing := range []ContractState{"SIGNED", "TERMINATED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ContractState", value)
+}
+
+// ListContractsResponse
+type ListContractsResponse struct {
+	// 签约协议列表
+	Data []Contract `json:"data,omitempty"`
+	// 签约协议总数
+	TotalCount *int64 `json:"total_count"`
+	// 分页开始位置
+	Offset *int64 `json:"offset"`
+	// 分页大小
+	Limit *int64 `json:"limit"`
+}
+
+func (o ListContractsResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Data != nil {
+		toSerialize["data"] = o.Data
+	}
+
+	if o.TotalCount == nil {
+		return nil, fmt.Errorf("field `TotalCount` is required and must be specified in ListContractsResponse")
+	}
+	toSerialize["total_count"] = o.TotalCount
+
+	if o.Offset == nil {
+		return nil, fmt.Errorf("field `Offset` is required and must be specified in ListContractsResponse")
+	}
+	toSerialize["offset"] = o.Offset
+
+	if o.Limit == nil {
+		return nil, fmt.Errorf("field `Limit` is required and must be specified in ListContractsResponse")
+	}
+	toSerialize["limit"] = o.Limit
+	return json.Marshal(toSerialize)
+}
+
+func (o ListContractsResponse) String() string {
+	var ret string
+	ret += fmt.Sprintf("Data:%v, ", o.Data)
+
+	if o.TotalCount == nil {
+		ret += "TotalCount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalCount:%v, ", *o.TotalCount)
+	}
+
+	if o.Offset == nil {
+		ret += "Offset:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Offset:%v, ", *o.Offset)
+	}
+
+	if o.Limit == nil {
+		ret += "Limit:<nil>"
+	} else {
+		ret += fmt.Sprintf("Limit:%v", *o.Limit)
+	}
+
+	return fmt.Sprintf("ListContractsResponse{%s}", ret)
+}
+
+func (o ListContractsResponse) Clone() *ListContractsResponse {
+	ret := ListContractsResponse{}
+
+	if o.Data != nil {
+		ret.Data = make([]Contract, len(o.Data))
+		for i, item := range o.Data {
+			ret.Data[i] = *item.Clone()
+		}
+	}
+
+	if o.TotalCount != nil {
+		ret.TotalCount = new(int64)
+		*ret.TotalCount = *o.TotalCount
+	}
+
+	if o.Offset != nil {
+		ret.Offset = new(int64)
+		*ret.Offset = *o.Offset
+	}
+
+	if o.Limit != nil {
+		ret.Limit = new(int64)
+		*ret.Limit = *o.Limit
+	}
+
+	return &ret
+}
+
+// HasData 应答中是否返回了 data，o 为 nil 时返回 false
+func (o *ListContractsResponse) HasData() bool {
+	return o != nil && o.Data != nil
+}
+
+// ListUserContractsRequest
+type ListUserContractsRequest struct {
+	// 用户在商户appid下的唯一标识
+	Openid *string `json:"openid"`
+	// 商户在微信公众平台或移动应用申请的appid
+	Appid *string `json:"appid"`
+	// 签约计划ID，不填时查询用户在所有签约计划下的签约协议
+	PlanId *string `json:"plan_id,omitempty"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 分页开始位置，从0开始
+	Offset *int64 `json:"offset,omitempty"`
+	// 分页大小，最大50，不传默认为10
+	Limit *int64 `json:"limit,omitempty"`
+}
+
+func (o ListUserContractsRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Openid == nil {
+		return nil, fmt.Errorf("field `Openid` is required and must be specified in ListUserContractsRequest")
+	}
+	toSerialize["openid"] = o.Openid
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in ListUserContractsRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.PlanId != nil {
+		toSerialize["plan_id"] = o.PlanId
+	}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Offset != nil {
+		toSerialize["offset"] = o.Offset
+	}
+
+	if o.Limit != nil {
+		toSerialize["limit"] = o.Limit
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ListUserContractsRequest) String() string {
+	var ret string
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.PlanId == nil {
+		ret += "PlanId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlanId:%v, ", *o.PlanId)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Offset == nil {
+		ret += "Offset:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Offset:%v, ", *o.Offset)
+	}
+
+	if o.Limit == nil {
+		ret += "Limit:<nil>"
+	} else {
+		ret += fmt.Sprintf("Limit:%v", *o.Limit)
+	}
+
+	return fmt.Sprintf("ListUserContractsRequest{%s}", ret)
+}
+
+func (o ListUserContractsRequest) Clone() *ListUserContractsRequest {
+	ret := ListUserContractsRequest{}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.PlanId != nil {
+		ret.PlanId = new(string)
+		*ret.PlanId = *o.PlanId
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Offset != nil {
+		ret.Offset = new(int64)
+		*ret.Offset = *o.Offset
+	}
+
+	if o.Limit != nil {
+		ret.Limit = new(int64)
+		*ret.Limit = *o.Limit
+	}
+
+	return &ret
+}
+
+// Payer
+type Payer struct {
+	// 用户在商户appid下的唯一标识
+	Openid *string `json:"openid,omitempty"`
+	// 用户在子商户appid下的唯一标识
+	SubOpenid *string `json:"sub_openid,omitempty"`
+}
+
+func (o Payer) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Openid != nil {
+		toSerialize["openid"] = o.Openid
+	}
+
+	if o.SubOpenid != nil {
+		toSerialize["sub_openid"] = o.SubOpenid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Payer) String() string {
+	var ret string
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.SubOpenid == nil {
+		ret += "SubOpenid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubOpenid:%v", *o.SubOpenid)
+	}
+
+	return fmt.Sprintf("Payer{%s}", ret)
+}
+
+func (o Payer) Clone() *Payer {
+	ret := Payer{}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.SubOpenid != nil {
+		ret.SubOpenid = new(string)
+		*ret.SubOpenid = *o.SubOpenid
+	}
+
+	return &ret
+}
+
+// HasOpenid 应答中是否返回了 openid，o 为 nil 时返回 false
+func (o *Payer) HasOpenid() bool {
+	return o != nil && o.Openid != nil
+}
+
+// HasSubOpenid 应答中是否返回了 sub_openid，o 为 nil 时返回 false
+func (o *Payer) HasSubOpenid() bool {
+	return o != nil && o.SubOpenid != nil
+}
+
+// PresignContractBody
+type PresignContractBody struct {
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户在微信公众平台或移动应用申请的appid
+	Appid *string `json:"appid"`
+	// 子商户在微信公众平台或移动应用申请的appid，服务商模式下选填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 用户在商户appid下的唯一标识
+	Openid *string `json:"openid"`
+	// 签约计划ID，在商户平台配置签约计划后获得
+	PlanId *string `json:"plan_id"`
+	// 商户侧的用户（如学生）标识，在商户系统内唯一
+	UserId *string `json:"user_id"`
+	// 周期扣款的开始日期，格式为YYYY-MM-DD
+	PeriodStartDate *string `json:"period_start_date"`
+	// 签约场景，如 SCHOOL（学校）、TRAINING（培训机构）
+	TradeScene *string `json:"trade_scene"`
+}
+
+func (o PresignContractBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in PresignContractBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.Openid == nil {
+		return nil, fmt.Errorf("field `Openid` is required and must be specified in PresignContractBody")
+	}
+	toSerialize["openid"] = o.Openid
+
+	if o.PlanId == nil {
+		return nil, fmt.Errorf("field `PlanId` is required and must be specified in PresignContractBody")
+	}
+	toSerialize["plan_id"] = o.PlanId
+
+	if o.UserId == nil {
+		return nil, fmt.Errorf("field `UserId` is required and must be specified in PresignContractBody")
+	}
+	toSerialize["user_id"] = o.UserId
+
+	if o.PeriodStartDate == nil {
+		return nil, fmt.Errorf("field `PeriodStartDate` is required and must be specified in PresignContractBody")
+	}
+	toSerialize["period_start_date"] = o.PeriodStartDate
+
+	if o.TradeScene == nil {
+		return nil, fmt.Errorf("field `TradeScene` is required and must be specified in PresignContractBody")
+	}
+	toSerialize["trade_scene"] = o.TradeScene
+	return json.Marshal(toSerialize)
+}
+
+func (o PresignContractBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.PlanId == nil {
+		ret += "PlanId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlanId:%v, ", *o.PlanId)
+	}
+
+	if o.UserId == nil {
+		ret += "UserId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("UserId:%v, ", *o.UserId)
+	}
+
+	if o.PeriodStartDate == nil {
+		ret += "PeriodStartDate:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PeriodStartDate:%v, ", *o.PeriodStartDate)
+	}
+
+	if o.TradeScene == nil {
+		ret += "TradeScene:<nil>"
+	} else {
+		ret += fmt.Sprintf("TradeScene:%v", *o.TradeScene)
+	}
+
+	return fmt.Sprintf("PresignContractBody{%s}", ret)
+}
+
+func (o PresignContractBody) Clone() *PresignContractBody {
+	ret := PresignContractBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.PlanId != nil {
+		ret.PlanId = new(string)
+		*ret.PlanId = *o.PlanId
+	}
+
+	if o.UserId != nil {
+		ret.UserId = new(string)
+		*ret.UserId = *o.UserId
+	}
+
+	if o.PeriodStartDate != nil {
+		ret.PeriodStartDate = new(string)
+		*ret.PeriodStartDate = *o.PeriodStartDate
+	}
+
+	if o.TradeScene != nil {
+		ret.TradeScene = new(string)
+		*ret.TradeScene = *o.TradeScene
+	}
+
+	return &ret
+}
+
+// PresignContractResponse
+type PresignContractResponse struct {
+	// 预签约token，用于拉起签约页面，有效期为2小时
+	PresignToken *string `json:"presign_token"`
+}
+
+func (o PresignContractResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PresignToken == nil {
+		return nil, fmt.Errorf("field `PresignToken` is required and must be specified in PresignContractResponse")
+	}
+	toSerialize["presign_token"] = o.PresignToken
+	return json.Marshal(toSerialize)
+}
+
+func (o PresignContractResponse) String() string {
+	var ret string
+	if o.PresignToken == nil {
+		ret += "PresignToken:<nil>"
+	} else {
+		ret += fmt.Sprintf("PresignToken:%v", *o.PresignToken)
+	}
+
+	return fmt.Sprintf("PresignContractResponse{%s}", ret)
+}
+
+func (o PresignContractResponse) Clone() *PresignContractResponse {
+	ret := PresignContractResponse{}
+
+	if o.PresignToken != nil {
+		ret.PresignToken = new(string)
+		*ret.PresignToken = *o.PresignToken
+	}
+
+	return &ret
+}
+
+// QueryContractRequest
+type QueryContractRequest struct {
+	// 签约成功后由微信支付返回的签约协议号
+	ContractId *string `json:"contract_id"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+}
+
+func (o QueryContractRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ContractId == nil {
+		return nil, fmt.Errorf("field `ContractId` is required and must be specified in QueryContractRequest")
+	}
+	toSerialize["contract_id"] = o.ContractId
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryContractRequest) String() string {
+	var ret string
+	if o.ContractId == nil {
+		ret += "ContractId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContractId:%v, ", *o.ContractId)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryContractRequest{%s}", ret)
+}
+
+func (o QueryContractRequest) Clone() *QueryContractRequest {
+	ret := QueryContractRequest{}
+
+	if o.ContractId != nil {
+		ret.ContractId = new(string)
+		*ret.ContractId = *o.ContractId
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// QueryDeductionByOutTradeNoRequest
+type QueryDeductionByOutTradeNoRequest struct {
+	// 商户系统内部订单号
+	OutTradeNo *string `json:"out_trade_no"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+}
+
+func (o QueryDeductionByOutTradeNoRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in QueryDeductionByOutTradeNoRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryDeductionByOutTradeNoRequest) String() string {
+	var ret string
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryDeductionByOutTradeNoRequest{%s}", ret)
+}
+
+func (o QueryDeductionByOutTradeNoRequest) Clone() *QueryDeductionByOutTradeNoRequest {
+	ret := QueryDeductionByOutTradeNoRequest{}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// QueryDeductionByTransactionIdRequest
+type QueryDeductionByTransactionIdRequest struct {
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+}
+
+func (o QueryDeductionByTransactionIdRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in QueryDeductionByTransactionIdRequest")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryDeductionByTransactionIdRequest) String() string {
+	var ret string
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryDeductionByTransactionIdRequest{%s}", ret)
+}
+
+func (o QueryDeductionByTransactionIdRequest) Clone() *QueryDeductionByTransactionIdRequest {
+	ret := QueryDeductionByTransactionIdRequest{}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// SendDeductionNotificationBody
+type SendDeductionNotificationBody struct {
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户自定义的扣款预通知附加数据，展示在预通知中
+	AssociatedData *string `json:"associated_data,omitempty"`
+}
+
+func (o SendDeductionNotificationBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.AssociatedData != nil {
+		toSerialize["associated_data"] = o.AssociatedData
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SendDeductionNotificationBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.AssociatedData == nil {
+		ret += "AssociatedData:<nil>"
+	} else {
+		ret += fmt.Sprintf("AssociatedData:%v", *o.AssociatedData)
+	}
+
+	return fmt.Sprintf("SendDeductionNotificationBody{%s}", ret)
+}
+
+func (o SendDeductionNotificationBody) Clone() *SendDeductionNotificationBody {
+	ret := SendDeductionNotificationBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.AssociatedData != nil {
+		ret.AssociatedData = new(string)
+		*ret.AssociatedData = *o.AssociatedData
+	}
+
+	return &ret
+}
+
+// SendDeductionNotificationRequest
+type SendDeductionNotificationRequest struct {
+	// 签约成功后由微信支付返回的签约协议号
+	ContractId *string `json:"contract_id"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户自定义的扣款预通知附加数据，展示在预通知中
+	AssociatedData *string `json:"associated_data,omitempty"`
+}
+
+func (o SendDeductionNotificationRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ContractId == nil {
+		return nil, fmt.Errorf("field `ContractId` is required and must be specified in SendDeductionNotificationRequest")
+	}
+	toSerialize["contract_id"] = o.ContractId
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.AssociatedData != nil {
+		toSerialize["associated_data"] = o.AssociatedData
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SendDeductionNotificationRequest) String() string {
+	var ret string
+	if o.ContractId == nil {
+		ret += "ContractId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContractId:%v, ", *o.ContractId)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.AssociatedData == nil {
+		ret += "AssociatedData:<nil>"
+	} else {
+		ret += fmt.Sprintf("AssociatedData:%v", *o.AssociatedData)
+	}
+
+	return fmt.Sprintf("SendDeductionNotificationRequest{%s}", ret)
+}
+
+func (o SendDeductionNotificationRequest) Clone() *SendDeductionNotificationRequest {
+	ret := SendDeductionNotificationRequest{}
+
+	if o.ContractId != nil {
+		ret.ContractId = new(string)
+		*ret.ContractId = *o.ContractId
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.AssociatedData != nil {
+		ret.AssociatedData = new(string)
+		*ret.AssociatedData = *o.AssociatedData
+	}
+
+	return &ret
+}
+
+// TerminateContractRequest
+type TerminateContractRequest struct {
+	// 签约成功后由微信支付返回的签约协议号
+	ContractId *string `json:"contract_id"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+}
+
+func (o TerminateContractRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ContractId == nil {
+		return nil, fmt.Errorf("field `ContractId` is required and must be specified in TerminateContractRequest")
+	}
+	toSerialize["contract_id"] = o.ContractId
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TerminateContractRequest) String() string {
+	var ret string
+	if o.ContractId == nil {
+		ret += "ContractId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContractId:%v, ", *o.ContractId)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("TerminateContractRequest{%s}", ret)
+}
+
+func (o TerminateContractRequest) Clone() *TerminateContractRequest {
+	ret := TerminateContractRequest{}
+
+	if o.ContractId != nil {
+		ret.ContractId = new(string)
+		*ret.ContractId = *o.ContractId
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// TradeState * `SUCCESS` - 支付成功 * `ACCEPT` - 已受理，扣款中 * `PAY_FAIL` - 扣款失败 * `REFUND` - 转入退款
+type TradeState string
+
+func (e TradeState) Ptr() *TradeState {
+	return &e
+}
+
+// Enums of TradeState
+const (
+	TRADESTATE_SUCCESS  TradeState = "SUCCESS"
+	TRADESTATE_ACCEPT   TradeState = "ACCEPT"
+	TRADESTATE_PAY_FAIL TradeState = "PAY_FAIL"
+	TRADESTATE_REFUND   TradeState = "REFUND"
+)
+
+func (v *TradeState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := TradeState(value)
+	for _, existing := range []TradeState{"SUCCESS", "ACCEPT", "PAY_FAIL", "REFUND"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid TradeState", value)
+}
+
+// Transaction
+type Transaction struct {
+	// 直连商户号或服务商商户号
+	Mchid *string `json:"mchid"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户在微信公众平台或移动应用申请的appid
+	Appid *string `json:"appid"`
+	// 子商户在微信公众平台或移动应用申请的appid，服务商模式下选填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 商户系统内部订单号
+	OutTradeNo *string `json:"out_trade_no"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id,omitempty"`
+	// 附加数据
+	Attach *string `json:"attach,omitempty"`
+	// 交易类型，教育续费通扣款为AUTH
+	TradeType *string `json:"trade_type,omitempty"`
+	// 付款银行类型
+	BankType *string `json:"bank_type,omitempty"`
+	// 支付完成时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	SuccessTime *time.Time `json:"success_time,omitempty"`
+	// 交易状态
+	TradeState *TradeState `json:"trade_state"`
+	// 交易状态描述
+	TradeStateDescription *string `json:"trade_state_description,omitempty"`
+	// 签约成功后由微信支付返回的签约协议号
+	ContractId *string `json:"contract_id,omitempty"`
+	// 支付者信息
+	Payer *Payer `json:"payer,omitempty"`
+	// 订单金额信息
+	Amount *TransactionAmountDetail `json:"amount,omitempty"`
+}
+
+func (o Transaction) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in Transaction")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in Transaction")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in Transaction")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TransactionId != nil {
+		toSerialize["transaction_id"] = o.TransactionId
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.TradeType != nil {
+		toSerialize["trade_type"] = o.TradeType
+	}
+
+	if o.BankType != nil {
+		toSerialize["bank_type"] = o.BankType
+	}
+
+	if o.SuccessTime != nil {
+		toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+	}
+
+	if o.TradeState == nil {
+		return nil, fmt.Errorf("field `TradeState` is required and must be specified in Transaction")
+	}
+	toSerialize["trade_state"] = o.TradeState
+
+	if o.TradeStateDescription != nil {
+		toSerialize["trade_state_description"] = o.TradeStateDescription
+	}
+
+	if o.ContractId != nil {
+		toSerialize["contract_id"] = o.ContractId
+	}
+
+	if o.Payer != nil {
+		toSerialize["payer"] = o.Payer
+	}
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Transaction) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.TradeType == nil {
+		ret += "TradeType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeType:%v, ", *o.TradeType)
+	}
+
+	if o.BankType == nil {
+		ret += "BankType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankType:%v, ", *o.BankType)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	if o.TradeState == nil {
+		ret += "TradeState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeState:%v, ", *o.TradeState)
+	}
+
+	if o.TradeStateDescription == nil {
+		ret += "TradeStateDescription:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeStateDescription:%v, ", *o.TradeStateDescription)
+	}
+
+	if o.ContractId == nil {
+		ret += "ContractId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ContractId:%v, ", *o.ContractId)
+	}
+
+	ret += fmt.Sprintf("Payer:%v, ", o.Payer)
+
+	ret += fmt.Sprintf("Amount:%v", o.Amount)
+
+	return fmt.Sprintf("Transaction{%s}", ret)
+}
+
+func (o Transaction) Clone() *Transaction {
+	ret := Transaction{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.TradeType != nil {
+		ret.TradeType = new(string)
+		*ret.TradeType = *o.TradeType
+	}
+
+	if o.BankType != nil {
+		ret.BankType = new(string)
+		*ret.BankType = *o.BankType
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.TradeState != nil {
+		ret.TradeState = new(TradeState)
+		*ret.TradeState = *o.TradeState
+	}
+
+	if o.TradeStateDescription != nil {
+		ret.TradeStateDescription = new(string)
+		*ret.TradeStateDescription = *o.TradeStateDescription
+	}
+
+	if o.ContractId != nil {
+		ret.ContractId = new(string)
+		*ret.ContractId = *o.ContractId
+	}
+
+	if o.Payer != nil {
+		ret.Payer = o.Payer.Clone()
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *Transaction) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasSubAppid 应答中是否返回了 sub_appid，o 为 nil 时返回 false
+func (o *Transaction) HasSubAppid() bool {
+	return o != nil && o.SubAppid != nil
+}
+
+// HasTransactionId 应答中是否返回了 transaction_id，o 为 nil 时返回 false
+func (o *Transaction) HasTransactionId() bool {
+	return o != nil && o.TransactionId != nil
+}
+
+// HasAttach 应答中是否返回了 attach，o 为 nil 时返回 false
+func (o *Transaction) HasAttach() bool {
+	return o != nil && o.Attach != nil
+}
+
+// HasTradeType 应答中是否返回了 trade_type，o 为 nil 时返回 false
+func (o *Transaction) HasTradeType() bool {
+	return o != nil && o.TradeType != nil
+}
+
+// HasBankType 应答中是否返回了 bank_type，o 为 nil 时返回 false
+func (o *Transaction) HasBankType() bool {
+	return o != nil && o.BankType != nil
+}
+
+// HasSuccessTime 应答中是否返回了 success_time，o 为 nil 时返回 false
+func (o *Transaction) HasSuccessTime() bool {
+	return o != nil && o.SuccessTime != nil
+}
+
+// HasTradeStateDescription 应答中是否返回了 trade_state_description，o 为 nil 时返回 false
+func (o *Transaction) HasTradeStateDescription() bool {
+	return o != nil && o.TradeStateDescription != nil
+}
+
+// HasContractId 应答中是否返回了 contract_id，o 为 nil 时返回 false
+func (o *Transaction) HasContractId() bool {
+	return o != nil && o.ContractId != nil
+}
+
+// HasPayer 应答中是否返回了 payer，o 为 nil 时返回 false
+func (o *Transaction) HasPayer() bool {
+	return o != nil && o.Payer != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *Transaction) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// TransactionAmount
+type TransactionAmount struct {
+	// 订单总金额，单位为分
+	Total *int64 `json:"total"`
+	// 符合ISO 4217标准的三位字母代码，目前只支持人民币：CNY
+	Currency *string `json:"currency,omitempty"`
+}
+
+func (o TransactionAmount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in TransactionAmount")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TransactionAmount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>"
+	} else {
+		ret += fmt.Sprintf("Currency:%v", *o.Currency)
+	}
+
+	return fmt.Sprintf("TransactionAmount{%s}", ret)
+}
+
+func (o TransactionAmount) Clone() *TransactionAmount {
+	ret := TransactionAmount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	return &ret
+}
+
+// TransactionAmountDetail
+type TransactionAmountDetail struct {
+	// 订单总金额，单位为分
+	Total *int64 `json:"total"`
+	// 用户支付金额，单位为分
+	PayerTotal *int64 `json:"payer_total,omitempty"`
+	// 货币类型
+	Currency *string `json:"currency,omitempty"`
+	// 用户支付币种
+	PayerCurrency *string `json:"payer_currency,omitempty"`
+}
+
+func (o TransactionAmountDetail) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in TransactionAmountDetail")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.PayerTotal != nil {
+		toSerialize["payer_total"] = o.PayerTotal
+	}
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+
+	if o.PayerCurrency != nil {
+		toSerialize["payer_currency"] = o.PayerCurrency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TransactionAmountDetail) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.PayerTotal == nil {
+		ret += "PayerTotal:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerTotal:%v, ", *o.PayerTotal)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Currency:%v, ", *o.Currency)
+	}
+
+	if o.PayerCurrency == nil {
+		ret += "PayerCurrency:<nil>"
+	} else {
+		ret += fmt.Sprintf("PayerCurrency:%v", *o.PayerCurrency)
+	}
+
+	return fmt.Sprintf("TransactionAmountDetail{%s}", ret)
+}
+
+func (o TransactionAmountDetail) Clone() *TransactionAmountDetail {
+	ret := TransactionAmountDetail{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.PayerTotal != nil {
+		ret.PayerTotal = new(int64)
+		*ret.PayerTotal = *o.PayerTotal
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	if o.PayerCurrency != nil {
+		ret.PayerCurrency = new(string)
+		*ret.PayerCurrency = *o.PayerCurrency
+	}
+
+	return &ret
+}
+
+// HasPayerTotal 应答中是否返回了 payer_total，o 为 nil 时返回 false
+func (o *TransactionAmountDetail) HasPayerTotal() bool {
+	return o != nil && o.PayerTotal != nil
+}
+
+// HasCurrency 应答中是否返回了 currency，o 为 nil 时返回 false
+func (o *TransactionAmountDetail) HasCurrency() bool {
+	return o != nil && o.Currency != nil
+}
+
+// HasPayerCurrency 应答中是否返回了 payer_currency，o 为 nil 时返回 false
+func (o *TransactionAmountDetail) HasPayerCurrency() bool {
+	return o != nil && o.PayerCurrency != nil
+}
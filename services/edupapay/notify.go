@@ -0,0 +1,47 @@
+package edupapay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// ContractNotifyFunc 签约、解约结果通知处理函数，返回值的含义同 notify.HandleFunc
+type ContractNotifyFunc func(ctx context.Context, req *notify.Request, contract *Contract) error
+
+// DeductionNotifyFunc 扣款结果通知处理函数，返回值的含义同 notify.HandleFunc
+type DeductionNotifyFunc func(ctx context.Context, req *notify.Request, transaction *Transaction) error
+
+// HandleContractNotify 将 fn 包装为 notify.HandleFunc：将通知内容解析为 Contract 后调用 fn
+//
+// 签约与解约结果使用同一通知内容，处理函数可以通过 contract.ContractState 区分。
+// 通知内容无法解析或不包含签约协议号时以 notify.Reject 应答。
+func HandleContractNotify(fn ContractNotifyFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		contract := new(Contract)
+		if err := req.UnmarshalContent(contract); err != nil {
+			return notify.Reject(err)
+		}
+		if contract.ContractId == nil || *contract.ContractId == "" {
+			return notify.Reject(fmt.Errorf("contract notify %s has no contract_id", req.ID))
+		}
+		return fn(ctx, req, contract)
+	}
+}
+
+// HandleDeductionNotify 将 fn 包装为 notify.HandleFunc：将通知内容解析为 Transaction 后调用 fn
+//
+// 扣款结果通知的通知类型为 TRANSACTION.SUCCESS，通知内容无法解析或不包含商户订单号时以 notify.Reject 应答。
+func HandleDeductionNotify(fn DeductionNotifyFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		transaction := new(Transaction)
+		if err := req.UnmarshalContent(transaction); err != nil {
+			return notify.Reject(err)
+		}
+		if transaction.OutTradeNo == nil || *transaction.OutTradeNo == "" {
+			return notify.Reject(fmt.Errorf("deduction notify %s has no out_trade_no", req.ID))
+		}
+		return fn(ctx, req, transaction)
+	}
+}
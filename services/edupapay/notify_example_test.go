@@ -0,0 +1,49 @@
+package edupapay_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/edupapay"
+)
+
+func ExampleHandleContractNotify() {
+	handle := edupapay.HandleContractNotify(
+		func(ctx context.Context, req *notify.Request, contract *edupapay.Contract) error {
+			switch *contract.ContractState {
+			case edupapay.CONTRACTSTATE_SIGNED:
+				// 保存签约协议号，后续使用该协议号扣款
+				fmt.Println("signed", *contract.ContractId, *contract.PlanId)
+			case edupapay.CONTRACTSTATE_TERMINATED:
+				// 停止对该用户扣款
+				fmt.Println("terminated", *contract.ContractId)
+			}
+			return nil
+		},
+	)
+
+	// 实际使用时，将 handle 作为 notify.Handler.HTTPHandler 的处理函数，由其完成验签与解密
+	_ = handle(context.Background(), &notify.Request{
+		ID: "EV-2018022511223320873",
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"contract_id":"wxcbda96de0b165489","mchid":"1900000100","appid":"wxcbda96de0b165486",` +
+				`"openid":"o-MYE42l80oelYMDE34nYD456Xoy","plan_id":"1","contract_state":"SIGNED","sign_time":"2021-04-01T10:00:00+08:00"}`,
+		},
+	})
+	// Output:
+	// signed wxcbda96de0b165489 1
+}
+
+func ExampleHandleDeductionNotify() {
+	var handler *notify.Handler
+
+	router := notify.NewRouter()
+	router.Handle("TRANSACTION.SUCCESS", edupapay.HandleDeductionNotify(
+		func(ctx context.Context, req *notify.Request, transaction *edupapay.Transaction) error {
+			// 根据 transaction.OutTradeNo 更新扣款单状态
+			return nil
+		},
+	))
+	_ = handler.HTTPHandler(router.Dispatch)
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 境外收单汇率查询API
+//
+// 境外商户查询外币兑人民币的汇率，用于以非人民币币种标价时估算用户支付的人民币金额。  境外收单接口需要使用 option.WithGlobal 初始化的 Client 调用
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type RateApiService services.Service
+
+// QueryExchangeRate 查询汇率
+//
+// # 应用场景
+// 境外商户可以通过该接口查询指定币种在指定日期兑人民币的汇率。
+//
+// 注意：
+// 1、汇率每日更新，查询当日汇率时可能返回前一日的汇率
+// 2、汇率仅供参考，用户实际支付的人民币金额以订单的 payer_total 为准
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|币种不支持|商户未开通该币种或币种代码错误|请检查币种代码|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *RateApiService) QueryExchangeRate(ctx context.Context, req QueryExchangeRateRequest) (resp *ExchangeRateResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/global/rate"
+	// Make sure All Required Params are properly set
+	if req.Mchid == nil {
+		return nil, nil, fmt.Errorf("field `Mchid` is required and must be specified in QueryExchangeRateRequest")
+	}
+	if req.FeeType == nil {
+		return nil, nil, fmt.Errorf("field `FeeType` is required and must be specified in QueryExchangeRateRequest")
+	}
+	if req.Date == nil {
+		return nil, nil, fmt.Errorf("field `Date` is required and must be specified in QueryExchangeRateRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("mchid", core.ParameterToString(*req.Mchid, ""))
+	if req.SubMchid != nil {
+		localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	}
+	localVarQueryParams.Add("fee_type", core.ParameterToString(*req.FeeType, ""))
+	localVarQueryParams.Add("date", core.ParameterToString(*req.Date, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ExchangeRateResponse from Http Response
+	resp = new(ExchangeRateResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 境外收单汇率查询API
+//
+// 境外商户查询外币兑人民币的汇率，用于以非人民币币种标价时估算用户支付的人民币金额。  境外收单接口需要使用 option.WithGlobal 初始化的 Client 调用
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package exchangerate_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/exchangerate"
+)
+
+func ExampleRateApiService_QueryExchangeRate() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := exchangerate.RateApiService{Client: client}
+	resp, result, err := svc.QueryExchangeRate(ctx,
+		exchangerate.QueryExchangeRateRequest{
+			Date:     core.String("20210615"),
+			FeeType:  core.String("USD"),
+			Mchid:    core.String("1900000100"),
+			SubMchid: core.String("100012321"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
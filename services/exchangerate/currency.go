@@ -0,0 +1,92 @@
+package exchangerate
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// rateScale 汇率的放大倍数，应答中的 rate 为实际汇率乘以 10^8
+const rateScale = 100000000
+
+// cnyExponent 人民币的最小货币单位（分）对应的指数
+const cnyExponent = 2
+
+// minorUnitExponents 最小货币单位不是 1/100 的币种，符合 ISO 4217 标准
+var minorUnitExponents = map[string]int{
+	"JPY": 0, "KRW": 0, "VND": 0, "CLP": 0, "ISK": 0, "UGX": 0, "XAF": 0, "XOF": 0,
+	"BHD": 3, "JOD": 3, "KWD": 3, "OMR": 3, "TND": 3,
+}
+
+// MinorUnitExponent 返回币种最小货币单位对应的指数，即 1 个货币单位等于 10^exponent 个最小货币单位
+//
+// 如 USD 的最小货币单位为分，返回 2；JPY 没有更小的货币单位，返回 0。订单中的外币金额均以最小货币单位表示
+func MinorUnitExponent(currency string) int {
+	if exponent, ok := minorUnitExponents[strings.ToUpper(currency)]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// ToCNY 将以最小货币单位表示的外币金额 amount 按汇率换算为人民币金额（分），四舍五入
+//
+// 换算结果仅供展示与估算，用户实际支付的人民币金额以订单的 payer_total 为准
+func (o ExchangeRate) ToCNY(amount int64) (int64, error) {
+	rate, exponent, err := o.parse()
+	if err != nil {
+		return 0, err
+	}
+	if amount < 0 {
+		return 0, fmt.Errorf("amount must not be negative")
+	}
+	numerator := new(big.Int).Mul(big.NewInt(amount), rate)
+	numerator.Mul(numerator, pow10(cnyExponent))
+	denominator := new(big.Int).Mul(big.NewInt(rateScale), pow10(exponent))
+	return divRound(numerator, denominator)
+}
+
+// FromCNY 将人民币金额（分）cny 按汇率换算为以最小货币单位表示的外币金额，四舍五入
+func (o ExchangeRate) FromCNY(cny int64) (int64, error) {
+	rate, exponent, err := o.parse()
+	if err != nil {
+		return 0, err
+	}
+	if cny < 0 {
+		return 0, fmt.Errorf("amount must not be negative")
+	}
+	numerator := new(big.Int).Mul(big.NewInt(cny), big.NewInt(rateScale))
+	numerator.Mul(numerator, pow10(exponent))
+	denominator := new(big.Int).Mul(rate, pow10(cnyExponent))
+	return divRound(numerator, denominator)
+}
+
+// parse 解析汇率与外币的最小货币单位指数
+func (o ExchangeRate) parse() (*big.Int, int, error) {
+	if o.FeeType == nil || *o.FeeType == "" {
+		return nil, 0, fmt.Errorf("fee_type is required in ExchangeRate")
+	}
+	if o.Rate == nil {
+		return nil, 0, fmt.Errorf("rate is required in ExchangeRate")
+	}
+	rate, ok := new(big.Int).SetString(*o.Rate, 10)
+	if !ok || rate.Sign() <= 0 {
+		return nil, 0, fmt.Errorf("invalid exchange rate %q", *o.Rate)
+	}
+	return rate, MinorUnitExponent(*o.FeeType), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// divRound 计算非负数 numerator / denominator 并四舍五入，结果超出 int64 范围时返回错误
+func divRound(numerator, denominator *big.Int) (int64, error) {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Lsh(remainder, 1).Cmp(denominator) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsInt64() {
+		return 0, fmt.Errorf("converted amount overflows int64")
+	}
+	return quotient.Int64(), nil
+}
@@ -0,0 +1,37 @@
+package exchangerate_test
+
+import (
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/exchangerate"
+)
+
+func ExampleExchangeRate_ToCNY() {
+	// 实际使用时为查询汇率接口应答中的 exchange_rate
+	rate := exchangerate.ExchangeRate{
+		FeeType: core.String("USD"),
+		Rate:    core.String("645990000"),
+	}
+
+	// 19.99 USD
+	cny, err := rate.ToCNY(1999)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(cny)
+
+	// 100.00 CNY
+	usd, _ := rate.FromCNY(10000)
+	fmt.Println(usd)
+	// Output:
+	// 12913
+	// 1548
+}
+
+func ExampleMinorUnitExponent() {
+	fmt.Println(exchangerate.MinorUnitExponent("USD"), exchangerate.MinorUnitExponent("JPY"))
+	// Output:
+	// 2 0
+}
@@ -0,0 +1,255 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 境外收单汇率查询API
+//
+// 境外商户查询外币兑人民币的汇率，用于以非人民币币种标价时估算用户支付的人民币金额。  境外收单接口需要使用 option.WithGlobal 初始化的 Client 调用
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ExchangeRate
+type ExchangeRate struct {
+	// 符合ISO 4217标准的三位字母代码
+	FeeType *string `json:"fee_type"`
+	// 汇率时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	RateTime *time.Time `json:"rate_time"`
+	// 外币兑人民币的汇率乘以10^8，如 1 USD = 6.5 CNY 时为 650000000
+	Rate *string `json:"rate"`
+}
+
+func (o ExchangeRate) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.FeeType == nil {
+		return nil, fmt.Errorf("field `FeeType` is required and must be specified in ExchangeRate")
+	}
+	toSerialize["fee_type"] = o.FeeType
+
+	if o.RateTime == nil {
+		return nil, fmt.Errorf("field `RateTime` is required and must be specified in ExchangeRate")
+	}
+	toSerialize["rate_time"] = o.RateTime.Format(time.RFC3339)
+
+	if o.Rate == nil {
+		return nil, fmt.Errorf("field `Rate` is required and must be specified in ExchangeRate")
+	}
+	toSerialize["rate"] = o.Rate
+	return json.Marshal(toSerialize)
+}
+
+func (o ExchangeRate) String() string {
+	var ret string
+	if o.FeeType == nil {
+		ret += "FeeType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FeeType:%v, ", *o.FeeType)
+	}
+
+	if o.RateTime == nil {
+		ret += "RateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RateTime:%v, ", *o.RateTime)
+	}
+
+	if o.Rate == nil {
+		ret += "Rate:<nil>"
+	} else {
+		ret += fmt.Sprintf("Rate:%v", *o.Rate)
+	}
+
+	return fmt.Sprintf("ExchangeRate{%s}", ret)
+}
+
+func (o ExchangeRate) Clone() *ExchangeRate {
+	ret := ExchangeRate{}
+
+	if o.FeeType != nil {
+		ret.FeeType = new(string)
+		*ret.FeeType = *o.FeeType
+	}
+
+	if o.RateTime != nil {
+		ret.RateTime = new(time.Time)
+		*ret.RateTime = *o.RateTime
+	}
+
+	if o.Rate != nil {
+		ret.Rate = new(string)
+		*ret.Rate = *o.Rate
+	}
+
+	return &ret
+}
+
+// ExchangeRateResponse
+type ExchangeRateResponse struct {
+	// 商户号
+	Mchid *string `json:"mchid"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 汇率信息
+	ExchangeRate *ExchangeRate `json:"exchange_rate"`
+}
+
+func (o ExchangeRateResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in ExchangeRateResponse")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.ExchangeRate == nil {
+		return nil, fmt.Errorf("field `ExchangeRate` is required and must be specified in ExchangeRateResponse")
+	}
+	toSerialize["exchange_rate"] = o.ExchangeRate
+	return json.Marshal(toSerialize)
+}
+
+func (o ExchangeRateResponse) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	ret += fmt.Sprintf("ExchangeRate:%v", o.ExchangeRate)
+
+	return fmt.Sprintf("ExchangeRateResponse{%s}", ret)
+}
+
+func (o ExchangeRateResponse) Clone() *ExchangeRateResponse {
+	ret := ExchangeRateResponse{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.ExchangeRate != nil {
+		ret.ExchangeRate = o.ExchangeRate.Clone()
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *ExchangeRateResponse) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// QueryExchangeRateRequest
+type QueryExchangeRateRequest struct {
+	// 商户号
+	Mchid *string `json:"mchid"`
+	// 子商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 符合ISO 4217标准的三位字母代码，如 USD、HKD
+	FeeType *string `json:"fee_type"`
+	// 查询日期，格式为YYYYMMDD
+	Date *string `json:"date"`
+}
+
+func (o QueryExchangeRateRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in QueryExchangeRateRequest")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.FeeType == nil {
+		return nil, fmt.Errorf("field `FeeType` is required and must be specified in QueryExchangeRateRequest")
+	}
+	toSerialize["fee_type"] = o.FeeType
+
+	if o.Date == nil {
+		return nil, fmt.Errorf("field `Date` is required and must be specified in QueryExchangeRateRequest")
+	}
+	toSerialize["date"] = o.Date
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryExchangeRateRequest) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.FeeType == nil {
+		ret += "FeeType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FeeType:%v, ", *o.FeeType)
+	}
+
+	if o.Date == nil {
+		ret += "Date:<nil>"
+	} else {
+		ret += fmt.Sprintf("Date:%v", *o.Date)
+	}
+
+	return fmt.Sprintf("QueryExchangeRateRequest{%s}", ret)
+}
+
+func (o QueryExchangeRateRequest) Clone() *QueryExchangeRateRequest {
+	ret := QueryExchangeRateRequest{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.FeeType != nil {
+		ret.FeeType = new(string)
+		*ret.FeeType = *o.FeeType
+	}
+
+	if o.Date != nil {
+		ret.Date = new(string)
+		*ret.Date = *o.Date
+	}
+
+	return &ret
+}
@@ -0,0 +1,166 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电子发票API
+//
+// 商户通过微信支付电子发票能力获取用户填写的发票抬头的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package fapiao
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type UserTitleApiService services.Service
+
+// GetUserTitle 获取用户填写的抬头
+//
+// # 应用场景
+// 商户在收到 FAPIAO.USER_APPLIED 通知或用户提交抬头后，调用该接口获取用户填写的发票抬头。
+//
+// 注意：
+// 1、应答中的手机号与邮箱地址为加密字段，使用商户私钥解密
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|抬头不存在|用户尚未填写发票抬头或发票申请单号有误|请待用户填写抬头后再查询|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *UserTitleApiService) GetUserTitle(ctx context.Context, req GetUserTitleRequest) (resp *UserTitleEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/new-tax-control-fapiao/user-title"
+	// Make sure All Required Params are properly set
+	if req.FapiaoApplyId == nil {
+		return nil, nil, fmt.Errorf("field `FapiaoApplyId` is required and must be specified in GetUserTitleRequest")
+	}
+	if req.Scene == nil {
+		return nil, nil, fmt.Errorf("field `Scene` is required and must be specified in GetUserTitleRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("fapiao_apply_id", core.ParameterToString(*req.FapiaoApplyId, ""))
+	localVarQueryParams.Add("scene", core.ParameterToString(*req.Scene, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract UserTitleEntity from Http Response
+	resp = new(UserTitleEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// GetUserTitleUrl 获取抬头填写链接
+//
+// # 应用场景
+// 商户在用户申请开票时调用该接口获取抬头填写小程序的跳转参数，用户在小程序中选择或填写发票抬头。
+//
+// 注意：
+// 1、发票申请单号由商户生成，同一次开票申请的抬头填写、抬头查询与开票须使用相同的发票申请单号
+// 2、用户提交抬头后，微信支付推送 FAPIAO.USER_APPLIED 通知，可使用 fapiao.RouteUserTitleNotifications 处理
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NO_AUTH|权限不足|商户未开通电子发票能力|请先开通电子发票能力|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *UserTitleApiService) GetUserTitleUrl(ctx context.Context, req GetUserTitleUrlRequest) (resp *TitleUrlResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/new-tax-control-fapiao/user-title/title-url"
+	// Make sure All Required Params are properly set
+	if req.FapiaoApplyId == nil {
+		return nil, nil, fmt.Errorf("field `FapiaoApplyId` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	if req.Appid == nil {
+		return nil, nil, fmt.Errorf("field `Appid` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	if req.Openid == nil {
+		return nil, nil, fmt.Errorf("field `Openid` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	if req.TotalAmount == nil {
+		return nil, nil, fmt.Errorf("field `TotalAmount` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	if req.Source == nil {
+		return nil, nil, fmt.Errorf("field `Source` is required and must be specified in GetUserTitleUrlRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("fapiao_apply_id", core.ParameterToString(*req.FapiaoApplyId, ""))
+	localVarQueryParams.Add("appid", core.ParameterToString(*req.Appid, ""))
+	localVarQueryParams.Add("openid", core.ParameterToString(*req.Openid, ""))
+	localVarQueryParams.Add("total_amount", core.ParameterToString(*req.TotalAmount, ""))
+	localVarQueryParams.Add("source", core.ParameterToString(*req.Source, ""))
+	if req.SellerName != nil {
+		localVarQueryParams.Add("seller_name", core.ParameterToString(*req.SellerName, ""))
+	}
+	if req.ShowPhoneCell != nil {
+		localVarQueryParams.Add("show_phone_cell", core.ParameterToString(*req.ShowPhoneCell, ""))
+	}
+	if req.MustInputPhone != nil {
+		localVarQueryParams.Add("must_input_phone", core.ParameterToString(*req.MustInputPhone, ""))
+	}
+	if req.ShowEmailCell != nil {
+		localVarQueryParams.Add("show_email_cell", core.ParameterToString(*req.ShowEmailCell, ""))
+	}
+	if req.MustInputEmail != nil {
+		localVarQueryParams.Add("must_input_email", core.ParameterToString(*req.MustInputEmail, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract TitleUrlResponse from Http Response
+	resp = new(TitleUrlResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
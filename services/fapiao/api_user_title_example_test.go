@@ -0,0 +1,64 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电子发票API
+//
+// 商户通过微信支付电子发票能力获取用户填写的发票抬头的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package fapiao_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/fapiao"
+)
+
+func ExampleUserTitleApiService_GetUserTitle() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := fapiao.UserTitleApiService{Client: client}
+	resp, result, err := svc.GetUserTitle(ctx,
+		fapiao.GetUserTitleRequest{
+			FapiaoApplyId: core.String("4200000444201910177461284488"),
+			Scene:         core.String("WITH_WECHATPAY"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleUserTitleApiService_GetUserTitleUrl() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := fapiao.UserTitleApiService{Client: client}
+	resp, result, err := svc.GetUserTitleUrl(ctx,
+		fapiao.GetUserTitleUrlRequest{
+			Appid:          core.String("wxb1170446a4c0a5a2"),
+			FapiaoApplyId:  core.String("4200000444201910177461284488"),
+			MustInputEmail: core.Bool(false),
+			MustInputPhone: core.Bool(false),
+			Openid:         core.String("plN5twRbHym_j-QcqCzstl0HmwEs"),
+			SellerName:     core.String("深圳市南山区测试商户"),
+			ShowEmailCell:  core.Bool(false),
+			ShowPhoneCell:  core.Bool(false),
+			Source:         core.String("WEB"),
+			TotalAmount:    core.Int64(1000),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
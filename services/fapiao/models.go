@@ -0,0 +1,581 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 电子发票API
+//
+// 商户通过微信支付电子发票能力获取用户填写的发票抬头的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package fapiao
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GetUserTitleRequest
+type GetUserTitleRequest struct {
+	// 发票申请单号，与获取抬头填写链接时使用的发票申请单号一致
+	FapiaoApplyId *string `json:"fapiao_apply_id"`
+	// 场景值，目前只支持 WITH_WECHATPAY
+	Scene *string `json:"scene"`
+}
+
+func (o GetUserTitleRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.FapiaoApplyId == nil {
+		return nil, fmt.Errorf("field `FapiaoApplyId` is required and must be specified in GetUserTitleRequest")
+	}
+	toSerialize["fapiao_apply_id"] = o.FapiaoApplyId
+
+	if o.Scene == nil {
+		return nil, fmt.Errorf("field `Scene` is required and must be specified in GetUserTitleRequest")
+	}
+	toSerialize["scene"] = o.Scene
+	return json.Marshal(toSerialize)
+}
+
+func (o GetUserTitleRequest) String() string {
+	var ret string
+	if o.FapiaoApplyId == nil {
+		ret += "FapiaoApplyId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FapiaoApplyId:%v, ", *o.FapiaoApplyId)
+	}
+
+	if o.Scene == nil {
+		ret += "Scene:<nil>"
+	} else {
+		ret += fmt.Sprintf("Scene:%v", *o.Scene)
+	}
+
+	return fmt.Sprintf("GetUserTitleRequest{%s}", ret)
+}
+
+func (o GetUserTitleRequest) Clone() *GetUserTitleRequest {
+	ret := GetUserTitleRequest{}
+
+	if o.FapiaoApplyId != nil {
+		ret.FapiaoApplyId = new(string)
+		*ret.FapiaoApplyId = *o.FapiaoApplyId
+	}
+
+	if o.Scene != nil {
+		ret.Scene = new(string)
+		*ret.Scene = *o.Scene
+	}
+
+	return &ret
+}
+
+// GetUserTitleUrlRequest
+type GetUserTitleUrlRequest struct {
+	// 发票申请单号，商户发票申请单号，唯一标识一次开票行为，只能是字母、数字、中划线-、下划线_、竖线|、星号*，最长64个字符
+	FapiaoApplyId *string `json:"fapiao_apply_id"`
+	// 应用ID，微信支付分配的公众账号ID
+	Appid *string `json:"appid"`
+	// 用户标识，用户在商户appid下的唯一标识
+	Openid *string `json:"openid"`
+	// 总金额，开具发票的总金额，单位：分
+	TotalAmount *int64 `json:"total_amount"`
+	// 开票来源，WEB：微信H5开票，MINIPROGRAM：微信小程序开票
+	Source *string `json:"source"`
+	// 销售方名称，若不传则默认取商户号的注册名称
+	SellerName *string `json:"seller_name,omitempty"`
+	// 是否需要展示手机号
+	ShowPhoneCell *bool `json:"show_phone_cell,omitempty"`
+	// 是否必须填写手机号
+	MustInputPhone *bool `json:"must_input_phone,omitempty"`
+	// 是否需要展示邮箱地址
+	ShowEmailCell *bool `json:"show_email_cell,omitempty"`
+	// 是否必须填写邮箱地址
+	MustInputEmail *bool `json:"must_input_email,omitempty"`
+}
+
+func (o GetUserTitleUrlRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.FapiaoApplyId == nil {
+		return nil, fmt.Errorf("field `FapiaoApplyId` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	toSerialize["fapiao_apply_id"] = o.FapiaoApplyId
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.Openid == nil {
+		return nil, fmt.Errorf("field `Openid` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	toSerialize["openid"] = o.Openid
+
+	if o.TotalAmount == nil {
+		return nil, fmt.Errorf("field `TotalAmount` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	toSerialize["total_amount"] = o.TotalAmount
+
+	if o.Source == nil {
+		return nil, fmt.Errorf("field `Source` is required and must be specified in GetUserTitleUrlRequest")
+	}
+	toSerialize["source"] = o.Source
+
+	if o.SellerName != nil {
+		toSerialize["seller_name"] = o.SellerName
+	}
+
+	if o.ShowPhoneCell != nil {
+		toSerialize["show_phone_cell"] = o.ShowPhoneCell
+	}
+
+	if o.MustInputPhone != nil {
+		toSerialize["must_input_phone"] = o.MustInputPhone
+	}
+
+	if o.ShowEmailCell != nil {
+		toSerialize["show_email_cell"] = o.ShowEmailCell
+	}
+
+	if o.MustInputEmail != nil {
+		toSerialize["must_input_email"] = o.MustInputEmail
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o GetUserTitleUrlRequest) String() string {
+	var ret string
+	if o.FapiaoApplyId == nil {
+		ret += "FapiaoApplyId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FapiaoApplyId:%v, ", *o.FapiaoApplyId)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	if o.Source == nil {
+		ret += "Source:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Source:%v, ", *o.Source)
+	}
+
+	if o.SellerName == nil {
+		ret += "SellerName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SellerName:%v, ", *o.SellerName)
+	}
+
+	if o.ShowPhoneCell == nil {
+		ret += "ShowPhoneCell:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ShowPhoneCell:%v, ", *o.ShowPhoneCell)
+	}
+
+	if o.MustInputPhone == nil {
+		ret += "MustInputPhone:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MustInputPhone:%v, ", *o.MustInputPhone)
+	}
+
+	if o.ShowEmailCell == nil {
+		ret += "ShowEmailCell:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ShowEmailCell:%v, ", *o.ShowEmailCell)
+	}
+
+	if o.MustInputEmail == nil {
+		ret += "MustInputEmail:<nil>"
+	} else {
+		ret += fmt.Sprintf("MustInputEmail:%v", *o.MustInputEmail)
+	}
+
+	return fmt.Sprintf("GetUserTitleUrlRequest{%s}", ret)
+}
+
+func (o GetUserTitleUrlRequest) Clone() *GetUserTitleUrlRequest {
+	ret := GetUserTitleUrlRequest{}
+
+	if o.FapiaoApplyId != nil {
+		ret.FapiaoApplyId = new(string)
+		*ret.FapiaoApplyId = *o.FapiaoApplyId
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.Source != nil {
+		ret.Source = new(string)
+		*ret.Source = *o.Source
+	}
+
+	if o.SellerName != nil {
+		ret.SellerName = new(string)
+		*ret.SellerName = *o.SellerName
+	}
+
+	if o.ShowPhoneCell != nil {
+		ret.ShowPhoneCell = new(bool)
+		*ret.ShowPhoneCell = *o.ShowPhoneCell
+	}
+
+	if o.MustInputPhone != nil {
+		ret.MustInputPhone = new(bool)
+		*ret.MustInputPhone = *o.MustInputPhone
+	}
+
+	if o.ShowEmailCell != nil {
+		ret.ShowEmailCell = new(bool)
+		*ret.ShowEmailCell = *o.ShowEmailCell
+	}
+
+	if o.MustInputEmail != nil {
+		ret.MustInputEmail = new(bool)
+		*ret.MustInputEmail = *o.MustInputEmail
+	}
+
+	return &ret
+}
+
+// TitleUrlResponse
+type TitleUrlResponse struct {
+	// 小程序AppID，抬头填写小程序的AppID
+	MiniprogramAppid *string `json:"miniprogram_appid"`
+	// 小程序路径，拉起抬头填写小程序时使用的页面路径
+	MiniprogramPath *string `json:"miniprogram_path"`
+	// 小程序原始ID，在小程序之外（如公众号H5）拉起小程序时使用
+	MiniprogramUserName *string `json:"miniprogram_user_name"`
+}
+
+func (o TitleUrlResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.MiniprogramAppid == nil {
+		return nil, fmt.Errorf("field `MiniprogramAppid` is required and must be specified in TitleUrlResponse")
+	}
+	toSerialize["miniprogram_appid"] = o.MiniprogramAppid
+
+	if o.MiniprogramPath == nil {
+		return nil, fmt.Errorf("field `MiniprogramPath` is required and must be specified in TitleUrlResponse")
+	}
+	toSerialize["miniprogram_path"] = o.MiniprogramPath
+
+	if o.MiniprogramUserName == nil {
+		return nil, fmt.Errorf("field `MiniprogramUserName` is required and must be specified in TitleUrlResponse")
+	}
+	toSerialize["miniprogram_user_name"] = o.MiniprogramUserName
+	return json.Marshal(toSerialize)
+}
+
+func (o TitleUrlResponse) String() string {
+	var ret string
+	if o.MiniprogramAppid == nil {
+		ret += "MiniprogramAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MiniprogramAppid:%v, ", *o.MiniprogramAppid)
+	}
+
+	if o.MiniprogramPath == nil {
+		ret += "MiniprogramPath:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MiniprogramPath:%v, ", *o.MiniprogramPath)
+	}
+
+	if o.MiniprogramUserName == nil {
+		ret += "MiniprogramUserName:<nil>"
+	} else {
+		ret += fmt.Sprintf("MiniprogramUserName:%v", *o.MiniprogramUserName)
+	}
+
+	return fmt.Sprintf("TitleUrlResponse{%s}", ret)
+}
+
+func (o TitleUrlResponse) Clone() *TitleUrlResponse {
+	ret := TitleUrlResponse{}
+
+	if o.MiniprogramAppid != nil {
+		ret.MiniprogramAppid = new(string)
+		*ret.MiniprogramAppid = *o.MiniprogramAppid
+	}
+
+	if o.MiniprogramPath != nil {
+		ret.MiniprogramPath = new(string)
+		*ret.MiniprogramPath = *o.MiniprogramPath
+	}
+
+	if o.MiniprogramUserName != nil {
+		ret.MiniprogramUserName = new(string)
+		*ret.MiniprogramUserName = *o.MiniprogramUserName
+	}
+
+	return &ret
+}
+
+// UserTitleEntity
+type UserTitleEntity struct {
+	// 购买方类型
+	Type *UserTitleType `json:"type"`
+	// 名称，购买方名称
+	Name *string `json:"name"`
+	// 纳税人识别号，购买方为单位时返回
+	TaxpayerId *string `json:"taxpayer_id,omitempty"`
+	// 地址，购买方地址
+	Address *string `json:"address,omitempty"`
+	// 电话，购买方电话
+	Telephone *string `json:"telephone,omitempty"`
+	// 开户银行，购买方开户银行
+	BankName *string `json:"bank_name,omitempty"`
+	// 银行账号，购买方银行账号
+	BankAccount *string `json:"bank_account,omitempty"`
+	// 手机号，用户填写的手机号，该字段已加密，可使用 Client.DecryptResponse 解密
+	Phone *string `json:"phone,omitempty" encryption:"EM_APIV3"`
+	// 邮箱地址，用户填写的邮箱地址，该字段已加密，可使用 Client.DecryptResponse 解密
+	Email *string `json:"email,omitempty" encryption:"EM_APIV3"`
+}
+
+func (o UserTitleEntity) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in UserTitleEntity")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Name == nil {
+		return nil, fmt.Errorf("field `Name` is required and must be specified in UserTitleEntity")
+	}
+	toSerialize["name"] = o.Name
+
+	if o.TaxpayerId != nil {
+		toSerialize["taxpayer_id"] = o.TaxpayerId
+	}
+
+	if o.Address != nil {
+		toSerialize["address"] = o.Address
+	}
+
+	if o.Telephone != nil {
+		toSerialize["telephone"] = o.Telephone
+	}
+
+	if o.BankName != nil {
+		toSerialize["bank_name"] = o.BankName
+	}
+
+	if o.BankAccount != nil {
+		toSerialize["bank_account"] = o.BankAccount
+	}
+
+	if o.Phone != nil {
+		toSerialize["phone"] = o.Phone
+	}
+
+	if o.Email != nil {
+		toSerialize["email"] = o.Email
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o UserTitleEntity) String() string {
+	var ret string
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.TaxpayerId == nil {
+		ret += "TaxpayerId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TaxpayerId:%v, ", *o.TaxpayerId)
+	}
+
+	if o.Address == nil {
+		ret += "Address:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Address:%v, ", *o.Address)
+	}
+
+	if o.Telephone == nil {
+		ret += "Telephone:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Telephone:%v, ", *o.Telephone)
+	}
+
+	if o.BankName == nil {
+		ret += "BankName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankName:%v, ", *o.BankName)
+	}
+
+	if o.BankAccount == nil {
+		ret += "BankAccount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankAccount:%v, ", *o.BankAccount)
+	}
+
+	if o.Phone == nil {
+		ret += "Phone:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Phone:%v, ", *o.Phone)
+	}
+
+	if o.Email == nil {
+		ret += "Email:<nil>"
+	} else {
+		ret += fmt.Sprintf("Email:%v", *o.Email)
+	}
+
+	return fmt.Sprintf("UserTitleEntity{%s}", ret)
+}
+
+func (o UserTitleEntity) Clone() *UserTitleEntity {
+	ret := UserTitleEntity{}
+
+	if o.Type != nil {
+		ret.Type = new(UserTitleType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.TaxpayerId != nil {
+		ret.TaxpayerId = new(string)
+		*ret.TaxpayerId = *o.TaxpayerId
+	}
+
+	if o.Address != nil {
+		ret.Address = new(string)
+		*ret.Address = *o.Address
+	}
+
+	if o.Telephone != nil {
+		ret.Telephone = new(string)
+		*ret.Telephone = *o.Telephone
+	}
+
+	if o.BankName != nil {
+		ret.BankName = new(string)
+		*ret.BankName = *o.BankName
+	}
+
+	if o.BankAccount != nil {
+		ret.BankAccount = new(string)
+		*ret.BankAccount = *o.BankAccount
+	}
+
+	if o.Phone != nil {
+		ret.Phone = new(string)
+		*ret.Phone = *o.Phone
+	}
+
+	if o.Email != nil {
+		ret.Email = new(string)
+		*ret.Email = *o.Email
+	}
+
+	return &ret
+}
+
+// HasTaxpayerId 应答中是否返回了 taxpayer_id，o 为 nil 时返回 false
+func (o *UserTitleEntity) HasTaxpayerId() bool {
+	return o != nil && o.TaxpayerId != nil
+}
+
+// HasAddress 应答中是否返回了 address，o 为 nil 时返回 false
+func (o *UserTitleEntity) HasAddress() bool {
+	return o != nil && o.Address != nil
+}
+
+// HasTelephone 应答中是否返回了 telephone，o 为 nil 时返回 false
+func (o *UserTitleEntity) HasTelephone() bool {
+	return o != nil && o.Telephone != nil
+}
+
+// HasBankName 应答中是否返回了 bank_name，o 为 nil 时返回 false
+func (o *UserTitleEntity) HasBankName() bool {
+	return o != nil && o.BankName != nil
+}
+
+// HasBankAccount 应答中是否返回了 bank_account，o 为 nil 时返回 false
+func (o *UserTitleEntity) HasBankAccount() bool {
+	return o != nil && o.BankAccount != nil
+}
+
+// HasPhone 应答中是否返回了 phone，o 为 nil 时返回 false
+func (o *UserTitleEntity) HasPhone() bool {
+	return o != nil && o.Phone != nil
+}
+
+// HasEmail 应答中是否返回了 email，o 为 nil 时返回 false
+func (o *UserTitleEntity) HasEmail() bool {
+	return o != nil && o.Email != nil
+}
+
+// UserTitleType * `INDIVIDUAL` - 个人 * `ORGANIZATION` - 单位
+type UserTitleType string
+
+func (e UserTitleType) Ptr() *UserTitleType {
+	return &e
+}
+
+// Enums of UserTitleType
+const (
+	USERTITLETYPE_INDIVIDUAL   UserTitleType = "INDIVIDUAL"
+	USERTITLETYPE_ORGANIZATION UserTitleType = "ORGANIZATION"
+)
+
+func (v *UserTitleType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := UserTitleType(value)
+	for _, existing := range []UserTitleType{"INDIVIDUAL", "ORGANIZATION"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid UserTitleType", value)
+}
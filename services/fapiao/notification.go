@@ -0,0 +1,42 @@
+package fapiao
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// EventTypeUserApplied 用户提交发票抬头通知的通知类型（event_type）
+const EventTypeUserApplied = "FAPIAO.USER_APPLIED"
+
+// UserTitleNotification 用户提交发票抬头通知解密后的内容
+//
+// 通知中不包含抬头信息，请使用 FapiaoApplyId 调用 UserTitleApiService.GetUserTitle 获取用户填写的抬头
+type UserTitleNotification struct {
+	// Mchid 商户号
+	Mchid string `json:"mchid"`
+	// FapiaoApplyId 发票申请单号，与获取抬头填写链接时使用的发票申请单号一致
+	FapiaoApplyId string `json:"fapiao_apply_id"`
+	// ApplyTime 用户提交抬头的时间
+	ApplyTime *time.Time `json:"apply_time,omitempty"`
+}
+
+// UserTitleHandleFunc 用户提交发票抬头通知处理函数，返回值的含义同 notify.HandleFunc
+type UserTitleHandleFunc func(ctx context.Context, req *notify.Request, notification *UserTitleNotification) error
+
+// HandleUserTitle 将 fn 包装为 notify.HandleFunc：解析通知内容后调用 fn，内容无法解析时以 notify.Reject 应答
+func HandleUserTitle(fn UserTitleHandleFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		notification := new(UserTitleNotification)
+		if err := req.UnmarshalContent(notification); err != nil {
+			return notify.Reject(err)
+		}
+		return fn(ctx, req, notification)
+	}
+}
+
+// RouteUserTitleNotifications 在 router 上注册处理用户提交发票抬头通知的 fn
+func RouteUserTitleNotifications(router *notify.Router, fn UserTitleHandleFunc) {
+	router.Handle(EventTypeUserApplied, HandleUserTitle(fn))
+}
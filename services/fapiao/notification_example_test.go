@@ -0,0 +1,31 @@
+package fapiao_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/fapiao"
+)
+
+func ExampleRouteUserTitleNotifications() {
+	router := notify.NewRouter()
+	fapiao.RouteUserTitleNotifications(router,
+		func(ctx context.Context, req *notify.Request, notification *fapiao.UserTitleNotification) error {
+			// TODO: 使用 UserTitleApiService.GetUserTitle 获取用户填写的抬头并开具发票
+			fmt.Printf("%s applied at %s\n", notification.FapiaoApplyId, notification.ApplyTime.Format("2006-01-02 15:04:05"))
+			return nil
+		},
+	)
+
+	// 实际使用时，将 router.Dispatch 作为 notify.Handler.HTTPHandler 的处理函数，由其完成验签与解密
+	_ = router.Dispatch(context.Background(), &notify.Request{
+		EventType: fapiao.EventTypeUserApplied,
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"mchid":"1900000109","fapiao_apply_id":"4200000444201910177461284488",` +
+				`"apply_time":"2021-06-01T10:00:00+08:00"}`,
+		},
+	})
+	// Output:
+	// 4200000444201910177461284488 applied at 2021-06-01 10:00:00
+}
@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
@@ -20,7 +24,12 @@ type baseFileUploader services.Service
 // upload 将指定文件内容上传到指定地址
 //
 // 注意：urlpath 不要包含微信支付API服务地址，只包含路径即可，例如 `/v3/merchant/media/upload`
-func (s *baseFileUploader) upload(ctx context.Context, urlpath string, fileReader io.Reader, filename, contentType string, extra map[string]interface{}) (*core.APIResult, error) {
+func (s *baseFileUploader) upload(ctx context.Context, urlpath string, fileReader io.Reader, filename, contentType string, extra map[string]interface{}, opts ...UploadOption) (*core.APIResult, error) {
+	o := uploadOptions{maxAttempts: 1, retryInterval: defaultUploadRetryInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	urlpath = consts.WechatPayAPIServer + urlpath
 
 	content, err := ioutil.ReadAll(fileReader)
@@ -57,6 +66,42 @@ func (s *baseFileUploader) upload(ctx context.Context, urlpath string, fileReade
 		return nil, err
 	}
 
-	result, err := s.Client.Upload(ctx, urlpath, metaStr, body.String(), writer.FormDataContentType())
-	return result, err
+	if o.progress != nil {
+		ctx = core.WithRequestProgress(ctx, o.progress)
+	}
+	return s.uploadWithRetry(ctx, o, urlpath, metaStr, body.String(), writer.FormDataContentType())
+}
+
+// uploadWithRetry 发送上传请求，失败时按 o 的设置使用相同的请求内容重试
+func (s *baseFileUploader) uploadWithRetry(ctx context.Context, o uploadOptions, urlpath, meta, body, formContentType string) (*core.APIResult, error) {
+	interval := o.retryInterval
+	for attempt := 1; ; attempt++ {
+		result, err := s.Client.Upload(ctx, urlpath, meta, body, formContentType)
+		if err == nil || attempt >= o.maxAttempts || !isRetryableUploadError(ctx, err) {
+			return result, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, fmt.Errorf("%w, last err: %v", ctx.Err(), err)
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+// isRetryableUploadError 判断上传失败是否可以重试：网络错误、微信支付系统错误与频率限制
+func isRetryableUploadError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError ||
+			apiErr.Code == "SYSTEM_ERROR" || apiErr.Code == "FREQUENCY_LIMITED"
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
 }
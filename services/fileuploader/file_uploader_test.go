@@ -0,0 +1,216 @@
+package fileuploader_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/fileuploader"
+)
+
+// errConnectionReset 模拟上传时的网络错误
+var errConnectionReset = errors.New("connection reset")
+
+// scriptedUploadServer 按顺序以 results 中的状态码或错误应答每次上传，用完后重复最后一个；200 时返回 media_id
+type scriptedUploadServer struct {
+	results []interface{}
+
+	lock     sync.Mutex
+	attempts []time.Time
+	bodies   [][]byte
+}
+
+func (s *scriptedUploadServer) client(t *testing.T) *core.Client {
+	client, err := clienttest.NewClient(clienttest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		result := s.next()
+		if err, ok := result.(error); ok {
+			// 读取包体以触发进度回调，模拟发送完毕后连接断开
+			_, _ = ioutil.ReadAll(req.Body)
+			return nil, err
+		}
+		return clienttest.HandlerTransport(s.handler(result.(int))).RoundTrip(req)
+	}))
+	require.NoError(t, err)
+	return client
+}
+
+// next 记录一次上传并返回本次的应答
+func (s *scriptedUploadServer) next() interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result := s.results[len(s.results)-1]
+	if len(s.attempts) < len(s.results) {
+		result = s.results[len(s.attempts)]
+	}
+	s.attempts = append(s.attempts, time.Now())
+	return result
+}
+
+// handler 返回以 status 应答的上传接口
+func (s *scriptedUploadServer) handler(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.lock.Lock()
+		s.bodies = append(s.bodies, body)
+		s.lock.Unlock()
+
+		switch status {
+		case http.StatusOK:
+			clienttest.WriteJSON(w, http.StatusOK, map[string]string{"media_id": "MEDIA_ID_TEST"})
+		case http.StatusTooManyRequests:
+			clienttest.WriteError(w, status, "FREQUENCY_LIMITED", "频率超限")
+		case http.StatusBadRequest:
+			clienttest.WriteError(w, status, "PARAM_ERROR", "参数错误")
+		default:
+			clienttest.WriteError(w, status, "SYSTEM_ERROR", "系统错误")
+		}
+	}
+}
+
+func (s *scriptedUploadServer) count() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.attempts)
+}
+
+// progressRecorder 记录每次上传的进度回调，sent 回到较小的值时视为新一次上传
+type progressRecorder struct {
+	lock   sync.Mutex
+	rounds [][2]int64
+}
+
+func (r *progressRecorder) record(sent, total int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if n := len(r.rounds); n == 0 || sent <= r.rounds[n-1][0] {
+		r.rounds = append(r.rounds, [2]int64{sent, total})
+		return
+	}
+	r.rounds[len(r.rounds)-1] = [2]int64{sent, total}
+}
+
+var videoContent = bytes.Repeat([]byte("video"), 64*1024)
+
+func uploadVideo(ctx context.Context, t *testing.T, server *scriptedUploadServer, opts ...fileuploader.UploadOption) (*fileuploader.VideoUploadResponse, error) {
+	svc := fileuploader.VideoUploader{Client: server.client(t)}
+	resp, _, err := svc.Upload(ctx, bytes.NewReader(videoContent), "store.mp4", consts.VideoMP4, opts...)
+	return resp, err
+}
+
+func TestUpload_RetryThenSuccess(t *testing.T) {
+	server := &scriptedUploadServer{results: []interface{}{
+		errConnectionReset, http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusOK,
+	}}
+	progress := &progressRecorder{}
+
+	resp, err := uploadVideo(context.Background(), t, server,
+		fileuploader.WithRetry(4, time.Millisecond), fileuploader.WithProgress(progress.record))
+	require.NoError(t, err)
+	assert.Equal(t, "MEDIA_ID_TEST", *resp.MediaId)
+	assert.Equal(t, 4, server.count())
+
+	// 每次重试都上传相同的完整内容
+	require.Len(t, server.bodies, 3)
+	assert.Equal(t, server.bodies[0], server.bodies[1])
+	assert.Equal(t, server.bodies[0], server.bodies[2])
+	assert.True(t, bytes.Contains(server.bodies[0], videoContent))
+
+	// 每次上传的进度从 0 开始重新计数，并最终等于包体的总字节数
+	total := int64(len(server.bodies[0]))
+	require.Len(t, progress.rounds, 4)
+	for i, round := range progress.rounds {
+		assert.Equal(t, [2]int64{total, total}, round, "attempt %d", i+1)
+	}
+}
+
+func TestUpload_RetryLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		results      []interface{}
+		opts         []fileuploader.UploadOption
+		wantAttempts int
+		wantErr      string
+	}{
+		{
+			name:         "no retry by default",
+			results:      []interface{}{http.StatusInternalServerError, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      "SYSTEM_ERROR",
+		},
+		{
+			name:         "stop at max attempts",
+			results:      []interface{}{http.StatusInternalServerError},
+			opts:         []fileuploader.UploadOption{fileuploader.WithRetry(3, time.Millisecond)},
+			wantAttempts: 3,
+			wantErr:      "SYSTEM_ERROR",
+		},
+		{
+			name:         "network error at max attempts",
+			results:      []interface{}{errConnectionReset},
+			opts:         []fileuploader.UploadOption{fileuploader.WithRetry(2, time.Millisecond)},
+			wantAttempts: 2,
+			wantErr:      "connection reset",
+		},
+		{
+			name:         "non-retryable error",
+			results:      []interface{}{http.StatusBadRequest, http.StatusOK},
+			opts:         []fileuploader.UploadOption{fileuploader.WithRetry(3, time.Millisecond)},
+			wantAttempts: 1,
+			wantErr:      "PARAM_ERROR",
+		},
+		{
+			name:         "invalid max attempts is ignored",
+			results:      []interface{}{http.StatusInternalServerError, http.StatusOK},
+			opts:         []fileuploader.UploadOption{fileuploader.WithRetry(0, time.Millisecond)},
+			wantAttempts: 1,
+			wantErr:      "SYSTEM_ERROR",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &scriptedUploadServer{results: tt.results}
+			_, err := uploadVideo(context.Background(), t, server, tt.opts...)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Equal(t, tt.wantAttempts, server.count())
+		})
+	}
+}
+
+func TestUpload_RetryBackoff(t *testing.T) {
+	const interval = 20 * time.Millisecond
+	server := &scriptedUploadServer{results: []interface{}{http.StatusInternalServerError}}
+	_, err := uploadVideo(context.Background(), t, server, fileuploader.WithRetry(3, interval))
+	require.Error(t, err)
+
+	// 重试间隔依次翻倍
+	require.Len(t, server.attempts, 3)
+	assert.True(t, server.attempts[1].Sub(server.attempts[0]) >= interval)
+	assert.True(t, server.attempts[2].Sub(server.attempts[1]) >= 2*interval)
+}
+
+func TestUpload_RetryContextDone(t *testing.T) {
+	server := &scriptedUploadServer{results: []interface{}{http.StatusInternalServerError}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := uploadVideo(ctx, t, server, fileuploader.WithRetry(3, time.Hour))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, strings.Contains(err.Error(), "last err:"), err.Error())
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, 1, server.count())
+}
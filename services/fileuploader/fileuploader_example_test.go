@@ -2,7 +2,9 @@ package fileuploader_test
 
 import (
 	"context"
+	"log"
 	"os"
+	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
 	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
@@ -88,3 +90,29 @@ func ExampleMchBizUploader_Upload() {
 	// TODO: 处理返回结果
 	_, _, _ = resp, result, err
 }
+
+func ExampleVideoUploader_Upload_progress() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	file, err := os.Open("video.mp4")
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	svc := fileuploader.VideoUploader{Client: client}
+	resp, result, err := svc.Upload(ctx, file, "video.mp4", consts.VideoMP4,
+		fileuploader.WithProgress(func(sent, total int64) {
+			log.Printf("uploaded %d/%d bytes", sent, total)
+		}),
+		// 网络错误、系统错误或频率限制时最多尝试 3 次，首次重试间隔 2s
+		fileuploader.WithRetry(3, 2*time.Second),
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
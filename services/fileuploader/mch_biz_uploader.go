@@ -20,8 +20,8 @@ type MchBizUploadResponse struct {
 type MchBizUploader services.Service
 
 // Upload 上传反馈图片至微信支付
-func (u *MchBizUploader) Upload(ctx context.Context, fileReader io.Reader, filename, contentType string) (*MchBizUploadResponse, *core.APIResult, error) {
-	result, err := (*baseFileUploader)(u).upload(ctx, "/v3/merchant-service/images/upload", fileReader, filename, contentType, map[string]interface{}{})
+func (u *MchBizUploader) Upload(ctx context.Context, fileReader io.Reader, filename, contentType string, opts ...UploadOption) (*MchBizUploadResponse, *core.APIResult, error) {
+	result, err := (*baseFileUploader)(u).upload(ctx, "/v3/merchant-service/images/upload", fileReader, filename, contentType, map[string]interface{}{}, opts...)
 	if err != nil {
 		return nil, result, err
 	}
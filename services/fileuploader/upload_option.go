@@ -0,0 +1,42 @@
+package fileuploader
+
+import (
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+const defaultUploadRetryInterval = time.Second
+
+type uploadOptions struct {
+	progress      core.ProgressFunc
+	maxAttempts   int
+	retryInterval time.Duration
+}
+
+// UploadOption 文件上传的配置项
+type UploadOption func(o *uploadOptions)
+
+// WithProgress 设置上传进度回调，sent 为已发送的请求包体字节数，total 为请求包体的总字节数（包含 meta 等表单内容）
+//
+// 上传失败重试时，sent 将从 0 开始重新计数
+func WithProgress(progress core.ProgressFunc) UploadOption {
+	return func(o *uploadOptions) {
+		o.progress = progress
+	}
+}
+
+// WithRetry 设置上传失败（网络错误、微信支付系统错误或频率限制）时的最大尝试次数与首次重试间隔，默认不重试
+//
+// 文件内容在首次上传前已被完整读取，重试时使用相同的请求内容重新上传，不会再次读取 fileReader。
+// 微信支付的媒体文件上传接口不支持断点续传，每次重试都会上传完整的文件。
+func WithRetry(maxAttempts int, interval time.Duration) UploadOption {
+	return func(o *uploadOptions) {
+		if maxAttempts > 0 {
+			o.maxAttempts = maxAttempts
+		}
+		if interval >= 0 {
+			o.retryInterval = interval
+		}
+	}
+}
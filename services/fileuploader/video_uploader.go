@@ -20,8 +20,10 @@ type VideoUploadResponse struct {
 type VideoUploader services.Service
 
 // Upload 上传视频至微信支付
-func (u *VideoUploader) Upload(ctx context.Context, fileReader io.Reader, filename, contentType string) (*VideoUploadResponse, *core.APIResult, error) {
-	result, err := (*baseFileUploader)(u).upload(ctx, "/v3/merchant/media/video_upload", fileReader, filename, contentType, map[string]interface{}{})
+//
+// 上传较大的视频文件时，可以使用 WithProgress 获取上传进度，并使用 WithRetry 在网络错误等情况下自动重新上传
+func (u *VideoUploader) Upload(ctx context.Context, fileReader io.Reader, filename, contentType string, opts ...UploadOption) (*VideoUploadResponse, *core.APIResult, error) {
+	result, err := (*baseFileUploader)(u).upload(ctx, "/v3/merchant/media/video_upload", fileReader, filename, contentType, map[string]interface{}{}, opts...)
 	if err != nil {
 		return nil, result, err
 	}
@@ -0,0 +1,250 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 境外收单
+//
+// 香港及跨境机构商户的境外收单API，需要使用 option.WithGlobal 初始化的 Client 调用
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package globalpayments_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/globalpayments"
+)
+
+func ExampleTransactionsApiService_AppPrepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, result, err := svc.AppPrepay(ctx,
+		globalpayments.AppPrepayRequest{
+			Amount: &globalpayments.Amount{
+				Currency: core.String("HKD"),
+				Total:    core.Int64(100),
+			},
+			Attach:               core.String("自定义数据"),
+			Description:          core.String("Image形象店-深圳腾大-QQ公仔"),
+			GoodsTag:             core.String("WXG"),
+			MerchantCategoryCode: core.String("4111"),
+			NotifyUrl:            core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+			OutTradeNo:           core.String("1217752501201407033233368018"),
+			SceneInfo: &globalpayments.SceneInfo{
+				DeviceId:      core.String("013467007045764"),
+				PayerClientIp: core.String("14.23.150.211"),
+				StoreInfo: &globalpayments.StoreInfo{
+					Address: core.String("香港特别行政区中环皇后大道中1号"),
+					Id:      core.String("0001"),
+					Name:    core.String("腾讯大厦分店"),
+				},
+			},
+			SpAppid:    core.String("wx8888888888888888"),
+			SpMchid:    core.String("1230000109"),
+			SubAppid:   core.String("wxd678efh567hg6999"),
+			SubMchid:   core.String("1900000109"),
+			TimeExpire: core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_CloseOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	result, err := svc.CloseOrder(ctx,
+		globalpayments.CloseOrderRequest{
+			OutTradeNo: core.String("outTradeNo_example"),
+			SpMchid:    core.String("1230000109"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleTransactionsApiService_H5Prepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, result, err := svc.H5Prepay(ctx,
+		globalpayments.H5PrepayRequest{
+			Amount: &globalpayments.Amount{
+				Currency: core.String("HKD"),
+				Total:    core.Int64(100),
+			},
+			Attach:               core.String("自定义数据"),
+			Description:          core.String("Image形象店-深圳腾大-QQ公仔"),
+			GoodsTag:             core.String("WXG"),
+			MerchantCategoryCode: core.String("4111"),
+			NotifyUrl:            core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+			OutTradeNo:           core.String("1217752501201407033233368018"),
+			SceneInfo: &globalpayments.H5SceneInfo{
+				DeviceId: core.String("013467007045764"),
+				H5Info: &globalpayments.H5Info{
+					Type: core.String("Wap"),
+				},
+				PayerClientIp: core.String("14.23.150.211"),
+				StoreInfo: &globalpayments.StoreInfo{
+					Address: core.String("香港特别行政区中环皇后大道中1号"),
+					Id:      core.String("0001"),
+					Name:    core.String("腾讯大厦分店"),
+				},
+			},
+			SpAppid:    core.String("wx8888888888888888"),
+			SpMchid:    core.String("1230000109"),
+			SubAppid:   core.String("wxd678efh567hg6999"),
+			SubMchid:   core.String("1900000109"),
+			TimeExpire: core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_JsapiPrepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, result, err := svc.JsapiPrepay(ctx,
+		globalpayments.JsapiPrepayRequest{
+			Amount: &globalpayments.Amount{
+				Currency: core.String("HKD"),
+				Total:    core.Int64(100),
+			},
+			Attach:               core.String("自定义数据"),
+			Description:          core.String("Image形象店-深圳腾大-QQ公仔"),
+			GoodsTag:             core.String("WXG"),
+			MerchantCategoryCode: core.String("4111"),
+			NotifyUrl:            core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+			OutTradeNo:           core.String("1217752501201407033233368018"),
+			Payer: &globalpayments.Payer{
+				SpOpenid:  core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"),
+				SubOpenid: core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"),
+			},
+			SceneInfo: &globalpayments.SceneInfo{
+				DeviceId:      core.String("013467007045764"),
+				PayerClientIp: core.String("14.23.150.211"),
+				StoreInfo: &globalpayments.StoreInfo{
+					Address: core.String("香港特别行政区中环皇后大道中1号"),
+					Id:      core.String("0001"),
+					Name:    core.String("腾讯大厦分店"),
+				},
+			},
+			SpAppid:    core.String("wx8888888888888888"),
+			SpMchid:    core.String("1230000109"),
+			SubAppid:   core.String("wxd678efh567hg6999"),
+			SubMchid:   core.String("1900000109"),
+			TimeExpire: core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_NativePrepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, result, err := svc.NativePrepay(ctx,
+		globalpayments.NativePrepayRequest{
+			Amount: &globalpayments.Amount{
+				Currency: core.String("HKD"),
+				Total:    core.Int64(100),
+			},
+			Attach:               core.String("自定义数据"),
+			Description:          core.String("Image形象店-深圳腾大-QQ公仔"),
+			GoodsTag:             core.String("WXG"),
+			MerchantCategoryCode: core.String("4111"),
+			NotifyUrl:            core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+			OutTradeNo:           core.String("1217752501201407033233368018"),
+			SceneInfo: &globalpayments.SceneInfo{
+				DeviceId:      core.String("013467007045764"),
+				PayerClientIp: core.String("14.23.150.211"),
+				StoreInfo: &globalpayments.StoreInfo{
+					Address: core.String("香港特别行政区中环皇后大道中1号"),
+					Id:      core.String("0001"),
+					Name:    core.String("腾讯大厦分店"),
+				},
+			},
+			SpAppid:    core.String("wx8888888888888888"),
+			SpMchid:    core.String("1230000109"),
+			SubAppid:   core.String("wxd678efh567hg6999"),
+			SubMchid:   core.String("1900000109"),
+			TimeExpire: core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_QueryOrderById() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, result, err := svc.QueryOrderById(ctx,
+		globalpayments.QueryOrderByIdRequest{
+			SpMchid:       core.String("spMchid_example"),
+			SubMchid:      core.String("subMchid_example"),
+			TransactionId: core.String("transactionId_example"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_QueryOrderByOutTradeNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, result, err := svc.QueryOrderByOutTradeNo(ctx,
+		globalpayments.QueryOrderByOutTradeNoRequest{
+			OutTradeNo: core.String("outTradeNo_example"),
+			SpMchid:    core.String("spMchid_example"),
+			SubMchid:   core.String("subMchid_example"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,102 @@
+package globalpayments_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/globalpayments"
+)
+
+func TestTransactionsApiService_NativePrepay(t *testing.T) {
+	var (
+		host string
+		body map[string]interface{}
+	)
+	client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "/v3/global/transactions/native", r.URL.Path)
+		clienttest.WriteJSON(w, http.StatusOK, map[string]string{"code_url": "weixin://wxpay/bizpayurl/up?pr=NwY5Mz9&groupid=00"})
+	}), option.WithGlobal())
+	require.NoError(t, err)
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, _, err := svc.NativePrepay(context.Background(), globalpayments.NativePrepayRequest{
+		SpAppid:              core.String("wx8888888888888888"),
+		SpMchid:              core.String("1230000109"),
+		SubMchid:             core.String("1900000109"),
+		Description:          core.String("Image形象店-深圳腾大-QQ公仔"),
+		OutTradeNo:           core.String("1217752501201407033233368018"),
+		NotifyUrl:            core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+		MerchantCategoryCode: core.String("4111"),
+		Amount:               &globalpayments.Amount{Total: core.Int64(100), Currency: core.String("HKD")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "weixin://wxpay/bizpayurl/up?pr=NwY5Mz9&groupid=00", *resp.CodeUrl)
+
+	// 使用 WithGlobal 初始化的 Client 发往境外 API 地址
+	assert.Equal(t, "apihk.mch.weixin.qq.com", host)
+	assert.Equal(t, map[string]interface{}{"total": float64(100), "currency": "HKD"}, body["amount"])
+	assert.Equal(t, "4111", body["merchant_category_code"])
+}
+
+func TestTransactionsApiService_NativePrepayRequiresCurrency(t *testing.T) {
+	req := globalpayments.NativePrepayRequest{
+		SpAppid:              core.String("wx8888888888888888"),
+		SpMchid:              core.String("1230000109"),
+		SubMchid:             core.String("1900000109"),
+		Description:          core.String("Image形象店-深圳腾大-QQ公仔"),
+		OutTradeNo:           core.String("1217752501201407033233368018"),
+		NotifyUrl:            core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+		MerchantCategoryCode: core.String("4111"),
+		Amount:               &globalpayments.Amount{Total: core.Int64(100)},
+	}
+	_, err := json.Marshal(req)
+	assert.Error(t, err)
+}
+
+func TestTransactionsApiService_QueryOrderByOutTradeNo(t *testing.T) {
+	client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/global/transactions/out-trade-no/1217752501201407033233368018", r.URL.Path)
+		assert.Equal(t, "1230000109", r.URL.Query().Get("sp_mchid"))
+		assert.Equal(t, "1900000109", r.URL.Query().Get("sub_mchid"))
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"sp_mchid":       "1230000109",
+			"sub_mchid":      "1900000109",
+			"out_trade_no":   "1217752501201407033233368018",
+			"transaction_id": "4200001234202107010000000001",
+			"trade_type":     "NATIVE",
+			"trade_state":    "SUCCESS",
+			"success_time":   "2018-06-08T10:34:56+08:00",
+			"amount": map[string]interface{}{
+				"total":          100,
+				"currency":       "HKD",
+				"payer_total":    88,
+				"payer_currency": "CNY",
+				"exchange_rate":  map[string]interface{}{"type": "SETTLEMENT_RATE", "rate": 88000000},
+			},
+		})
+	}), option.WithGlobal())
+	require.NoError(t, err)
+
+	svc := globalpayments.TransactionsApiService{Client: client}
+	resp, _, err := svc.QueryOrderByOutTradeNo(context.Background(), globalpayments.QueryOrderByOutTradeNoRequest{
+		OutTradeNo: core.String("1217752501201407033233368018"),
+		SpMchid:    core.String("1230000109"),
+		SubMchid:   core.String("1900000109"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCESS", *resp.TradeState)
+	assert.Equal(t, "HKD", *resp.Amount.Currency)
+	assert.Equal(t, int64(88), *resp.Amount.PayerTotal)
+	assert.Equal(t, "CNY", *resp.Amount.PayerCurrency)
+	assert.Equal(t, int64(88000000), *resp.Amount.ExchangeRate.Rate)
+}
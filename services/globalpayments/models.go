@@ -0,0 +1,2255 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 境外收单
+//
+// 香港及跨境机构商户的境外收单API，需要使用 option.WithGlobal 初始化的 Client 调用
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package globalpayments
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Amount 订单金额
+type Amount struct {
+	// 订单金额，单位为标价币种的最小货币单位
+	Total *int64 `json:"total"`
+	// 标价币种，符合ISO 4217标准的三位字母代码，如 HKD
+	Currency *string `json:"currency"`
+}
+
+func (o Amount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in Amount")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.Currency == nil {
+		return nil, fmt.Errorf("field `Currency` is required and must be specified in Amount")
+	}
+	toSerialize["currency"] = o.Currency
+	return json.Marshal(toSerialize)
+}
+
+func (o Amount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>"
+	} else {
+		ret += fmt.Sprintf("Currency:%v", *o.Currency)
+	}
+
+	return fmt.Sprintf("Amount{%s}", ret)
+}
+
+func (o Amount) Clone() *Amount {
+	ret := Amount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	return &ret
+}
+
+// AppPrepayRequest
+type AppPrepayRequest struct {
+	// 机构应用ID，机构申请的公众号、小程序或移动应用appid
+	SpAppid *string `json:"sp_appid"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户应用ID，子商户申请的公众号、小程序或移动应用appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description"`
+	// 商户订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 交易结束时间，遵循rfc3339标准格式
+	TimeExpire *time.Time `json:"time_expire,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 通知地址，异步接收微信支付结果通知的回调地址
+	NotifyUrl *string `json:"notify_url"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// MCC码，子商户所属行业的商户类别码
+	MerchantCategoryCode *string `json:"merchant_category_code"`
+	// 订单金额
+	Amount *Amount `json:"amount"`
+	// 支付场景描述
+	SceneInfo *SceneInfo `json:"scene_info,omitempty"`
+}
+
+func (o AppPrepayRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid == nil {
+		return nil, fmt.Errorf("field `SpAppid` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["sp_appid"] = o.SpAppid
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TimeExpire != nil {
+		toSerialize["time_expire"] = o.TimeExpire.Format(time.RFC3339)
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode == nil {
+		return nil, fmt.Errorf("field `MerchantCategoryCode` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["merchant_category_code"] = o.MerchantCategoryCode
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in AppPrepayRequest")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.SceneInfo != nil {
+		toSerialize["scene_info"] = o.SceneInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AppPrepayRequest) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TimeExpire == nil {
+		ret += "TimeExpire:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeExpire:%v, ", *o.TimeExpire)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	if o.MerchantCategoryCode == nil {
+		ret += "MerchantCategoryCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantCategoryCode:%v, ", *o.MerchantCategoryCode)
+	}
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("SceneInfo:%v", o.SceneInfo)
+
+	return fmt.Sprintf("AppPrepayRequest{%s}", ret)
+}
+
+func (o AppPrepayRequest) Clone() *AppPrepayRequest {
+	ret := AppPrepayRequest{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TimeExpire != nil {
+		ret.TimeExpire = new(time.Time)
+		*ret.TimeExpire = *o.TimeExpire
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode != nil {
+		ret.MerchantCategoryCode = new(string)
+		*ret.MerchantCategoryCode = *o.MerchantCategoryCode
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.SceneInfo != nil {
+		ret.SceneInfo = o.SceneInfo.Clone()
+	}
+
+	return &ret
+}
+
+// CloseOrderRequest
+type CloseOrderRequest struct {
+	OutTradeNo *string `json:"out_trade_no"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o CloseOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseOrderRequest) String() string {
+	var ret string
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("CloseOrderRequest{%s}", ret)
+}
+
+func (o CloseOrderRequest) Clone() *CloseOrderRequest {
+	ret := CloseOrderRequest{}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// CloseRequest
+type CloseRequest struct {
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o CloseRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in CloseRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CloseRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseRequest) String() string {
+	var ret string
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("CloseRequest{%s}", ret)
+}
+
+func (o CloseRequest) Clone() *CloseRequest {
+	ret := CloseRequest{}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// ExchangeRate 标价币种与用户支付币种的汇率
+type ExchangeRate struct {
+	// 汇率类型，SETTLEMENT_RATE：结算汇率
+	Type *string `json:"type,omitempty"`
+	// 汇率值，为实际汇率乘以10^8
+	Rate *int64 `json:"rate,omitempty"`
+}
+
+func (o ExchangeRate) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Type != nil {
+		toSerialize["type"] = o.Type
+	}
+
+	if o.Rate != nil {
+		toSerialize["rate"] = o.Rate
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ExchangeRate) String() string {
+	var ret string
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Rate == nil {
+		ret += "Rate:<nil>"
+	} else {
+		ret += fmt.Sprintf("Rate:%v", *o.Rate)
+	}
+
+	return fmt.Sprintf("ExchangeRate{%s}", ret)
+}
+
+func (o ExchangeRate) Clone() *ExchangeRate {
+	ret := ExchangeRate{}
+
+	if o.Type != nil {
+		ret.Type = new(string)
+		*ret.Type = *o.Type
+	}
+
+	if o.Rate != nil {
+		ret.Rate = new(int64)
+		*ret.Rate = *o.Rate
+	}
+
+	return &ret
+}
+
+// HasType 应答中是否返回了 type，o 为 nil 时返回 false
+func (o *ExchangeRate) HasType() bool {
+	return o != nil && o.Type != nil
+}
+
+// HasRate 应答中是否返回了 rate，o 为 nil 时返回 false
+func (o *ExchangeRate) HasRate() bool {
+	return o != nil && o.Rate != nil
+}
+
+// H5Info H5场景信息
+type H5Info struct {
+	// 场景类型，如 Wap、IOS、Android
+	Type *string `json:"type"`
+}
+
+func (o H5Info) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in H5Info")
+	}
+	toSerialize["type"] = o.Type
+	return json.Marshal(toSerialize)
+}
+
+func (o H5Info) String() string {
+	var ret string
+	if o.Type == nil {
+		ret += "Type:<nil>"
+	} else {
+		ret += fmt.Sprintf("Type:%v", *o.Type)
+	}
+
+	return fmt.Sprintf("H5Info{%s}", ret)
+}
+
+func (o H5Info) Clone() *H5Info {
+	ret := H5Info{}
+
+	if o.Type != nil {
+		ret.Type = new(string)
+		*ret.Type = *o.Type
+	}
+
+	return &ret
+}
+
+// H5PrepayRequest
+type H5PrepayRequest struct {
+	// 机构应用ID，机构申请的公众号、小程序或移动应用appid
+	SpAppid *string `json:"sp_appid"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户应用ID，子商户申请的公众号、小程序或移动应用appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description"`
+	// 商户订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 交易结束时间，遵循rfc3339标准格式
+	TimeExpire *time.Time `json:"time_expire,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 通知地址，异步接收微信支付结果通知的回调地址
+	NotifyUrl *string `json:"notify_url"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// MCC码，子商户所属行业的商户类别码
+	MerchantCategoryCode *string `json:"merchant_category_code"`
+	// 订单金额
+	Amount *Amount `json:"amount"`
+	// 支付场景描述
+	SceneInfo *H5SceneInfo `json:"scene_info"`
+}
+
+func (o H5PrepayRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid == nil {
+		return nil, fmt.Errorf("field `SpAppid` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["sp_appid"] = o.SpAppid
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TimeExpire != nil {
+		toSerialize["time_expire"] = o.TimeExpire.Format(time.RFC3339)
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode == nil {
+		return nil, fmt.Errorf("field `MerchantCategoryCode` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["merchant_category_code"] = o.MerchantCategoryCode
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.SceneInfo == nil {
+		return nil, fmt.Errorf("field `SceneInfo` is required and must be specified in H5PrepayRequest")
+	}
+	toSerialize["scene_info"] = o.SceneInfo
+	return json.Marshal(toSerialize)
+}
+
+func (o H5PrepayRequest) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TimeExpire == nil {
+		ret += "TimeExpire:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeExpire:%v, ", *o.TimeExpire)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	if o.MerchantCategoryCode == nil {
+		ret += "MerchantCategoryCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantCategoryCode:%v, ", *o.MerchantCategoryCode)
+	}
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("SceneInfo:%v", o.SceneInfo)
+
+	return fmt.Sprintf("H5PrepayRequest{%s}", ret)
+}
+
+func (o H5PrepayRequest) Clone() *H5PrepayRequest {
+	ret := H5PrepayRequest{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TimeExpire != nil {
+		ret.TimeExpire = new(time.Time)
+		*ret.TimeExpire = *o.TimeExpire
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode != nil {
+		ret.MerchantCategoryCode = new(string)
+		*ret.MerchantCategoryCode = *o.MerchantCategoryCode
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.SceneInfo != nil {
+		ret.SceneInfo = o.SceneInfo.Clone()
+	}
+
+	return &ret
+}
+
+// H5PrepayResponse
+type H5PrepayResponse struct {
+	// 支付跳转链接，有效期为5分钟
+	H5Url *string `json:"h5_url"`
+}
+
+func (o H5PrepayResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.H5Url == nil {
+		return nil, fmt.Errorf("field `H5Url` is required and must be specified in H5PrepayResponse")
+	}
+	toSerialize["h5_url"] = o.H5Url
+	return json.Marshal(toSerialize)
+}
+
+func (o H5PrepayResponse) String() string {
+	var ret string
+	if o.H5Url == nil {
+		ret += "H5Url:<nil>"
+	} else {
+		ret += fmt.Sprintf("H5Url:%v", *o.H5Url)
+	}
+
+	return fmt.Sprintf("H5PrepayResponse{%s}", ret)
+}
+
+func (o H5PrepayResponse) Clone() *H5PrepayResponse {
+	ret := H5PrepayResponse{}
+
+	if o.H5Url != nil {
+		ret.H5Url = new(string)
+		*ret.H5Url = *o.H5Url
+	}
+
+	return &ret
+}
+
+// H5SceneInfo H5支付场景描述
+type H5SceneInfo struct {
+	// 用户终端IP
+	PayerClientIp *string `json:"payer_client_ip"`
+	// 商户端设备号
+	DeviceId  *string    `json:"device_id,omitempty"`
+	StoreInfo *StoreInfo `json:"store_info,omitempty"`
+	H5Info    *H5Info    `json:"h5_info"`
+}
+
+func (o H5SceneInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PayerClientIp == nil {
+		return nil, fmt.Errorf("field `PayerClientIp` is required and must be specified in H5SceneInfo")
+	}
+	toSerialize["payer_client_ip"] = o.PayerClientIp
+
+	if o.DeviceId != nil {
+		toSerialize["device_id"] = o.DeviceId
+	}
+
+	if o.StoreInfo != nil {
+		toSerialize["store_info"] = o.StoreInfo
+	}
+
+	if o.H5Info == nil {
+		return nil, fmt.Errorf("field `H5Info` is required and must be specified in H5SceneInfo")
+	}
+	toSerialize["h5_info"] = o.H5Info
+	return json.Marshal(toSerialize)
+}
+
+func (o H5SceneInfo) String() string {
+	var ret string
+	if o.PayerClientIp == nil {
+		ret += "PayerClientIp:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerClientIp:%v, ", *o.PayerClientIp)
+	}
+
+	if o.DeviceId == nil {
+		ret += "DeviceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("DeviceId:%v, ", *o.DeviceId)
+	}
+
+	ret += fmt.Sprintf("StoreInfo:%v, ", o.StoreInfo)
+
+	ret += fmt.Sprintf("H5Info:%v", o.H5Info)
+
+	return fmt.Sprintf("H5SceneInfo{%s}", ret)
+}
+
+func (o H5SceneInfo) Clone() *H5SceneInfo {
+	ret := H5SceneInfo{}
+
+	if o.PayerClientIp != nil {
+		ret.PayerClientIp = new(string)
+		*ret.PayerClientIp = *o.PayerClientIp
+	}
+
+	if o.DeviceId != nil {
+		ret.DeviceId = new(string)
+		*ret.DeviceId = *o.DeviceId
+	}
+
+	if o.StoreInfo != nil {
+		ret.StoreInfo = o.StoreInfo.Clone()
+	}
+
+	if o.H5Info != nil {
+		ret.H5Info = o.H5Info.Clone()
+	}
+
+	return &ret
+}
+
+// JsapiPrepayRequest
+type JsapiPrepayRequest struct {
+	// 机构应用ID，机构申请的公众号、小程序或移动应用appid
+	SpAppid *string `json:"sp_appid"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户应用ID，子商户申请的公众号、小程序或移动应用appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description"`
+	// 商户订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 交易结束时间，遵循rfc3339标准格式
+	TimeExpire *time.Time `json:"time_expire,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 通知地址，异步接收微信支付结果通知的回调地址
+	NotifyUrl *string `json:"notify_url"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// MCC码，子商户所属行业的商户类别码
+	MerchantCategoryCode *string `json:"merchant_category_code"`
+	// 订单金额
+	Amount *Amount `json:"amount"`
+	// 支付者信息
+	Payer *Payer `json:"payer"`
+	// 支付场景描述
+	SceneInfo *SceneInfo `json:"scene_info,omitempty"`
+}
+
+func (o JsapiPrepayRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid == nil {
+		return nil, fmt.Errorf("field `SpAppid` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["sp_appid"] = o.SpAppid
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TimeExpire != nil {
+		toSerialize["time_expire"] = o.TimeExpire.Format(time.RFC3339)
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode == nil {
+		return nil, fmt.Errorf("field `MerchantCategoryCode` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["merchant_category_code"] = o.MerchantCategoryCode
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Payer == nil {
+		return nil, fmt.Errorf("field `Payer` is required and must be specified in JsapiPrepayRequest")
+	}
+	toSerialize["payer"] = o.Payer
+
+	if o.SceneInfo != nil {
+		toSerialize["scene_info"] = o.SceneInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o JsapiPrepayRequest) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TimeExpire == nil {
+		ret += "TimeExpire:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeExpire:%v, ", *o.TimeExpire)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	if o.MerchantCategoryCode == nil {
+		ret += "MerchantCategoryCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantCategoryCode:%v, ", *o.MerchantCategoryCode)
+	}
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("Payer:%v, ", o.Payer)
+
+	ret += fmt.Sprintf("SceneInfo:%v", o.SceneInfo)
+
+	return fmt.Sprintf("JsapiPrepayRequest{%s}", ret)
+}
+
+func (o JsapiPrepayRequest) Clone() *JsapiPrepayRequest {
+	ret := JsapiPrepayRequest{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TimeExpire != nil {
+		ret.TimeExpire = new(time.Time)
+		*ret.TimeExpire = *o.TimeExpire
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode != nil {
+		ret.MerchantCategoryCode = new(string)
+		*ret.MerchantCategoryCode = *o.MerchantCategoryCode
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.Payer != nil {
+		ret.Payer = o.Payer.Clone()
+	}
+
+	if o.SceneInfo != nil {
+		ret.SceneInfo = o.SceneInfo.Clone()
+	}
+
+	return &ret
+}
+
+// NativePrepayRequest
+type NativePrepayRequest struct {
+	// 机构应用ID，机构申请的公众号、小程序或移动应用appid
+	SpAppid *string `json:"sp_appid"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户应用ID，子商户申请的公众号、小程序或移动应用appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description"`
+	// 商户订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 交易结束时间，遵循rfc3339标准格式
+	TimeExpire *time.Time `json:"time_expire,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 通知地址，异步接收微信支付结果通知的回调地址
+	NotifyUrl *string `json:"notify_url"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// MCC码，子商户所属行业的商户类别码
+	MerchantCategoryCode *string `json:"merchant_category_code"`
+	// 订单金额
+	Amount *Amount `json:"amount"`
+	// 支付场景描述
+	SceneInfo *SceneInfo `json:"scene_info,omitempty"`
+}
+
+func (o NativePrepayRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid == nil {
+		return nil, fmt.Errorf("field `SpAppid` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["sp_appid"] = o.SpAppid
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TimeExpire != nil {
+		toSerialize["time_expire"] = o.TimeExpire.Format(time.RFC3339)
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode == nil {
+		return nil, fmt.Errorf("field `MerchantCategoryCode` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["merchant_category_code"] = o.MerchantCategoryCode
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in NativePrepayRequest")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.SceneInfo != nil {
+		toSerialize["scene_info"] = o.SceneInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o NativePrepayRequest) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TimeExpire == nil {
+		ret += "TimeExpire:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeExpire:%v, ", *o.TimeExpire)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	if o.MerchantCategoryCode == nil {
+		ret += "MerchantCategoryCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantCategoryCode:%v, ", *o.MerchantCategoryCode)
+	}
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("SceneInfo:%v", o.SceneInfo)
+
+	return fmt.Sprintf("NativePrepayRequest{%s}", ret)
+}
+
+func (o NativePrepayRequest) Clone() *NativePrepayRequest {
+	ret := NativePrepayRequest{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TimeExpire != nil {
+		ret.TimeExpire = new(time.Time)
+		*ret.TimeExpire = *o.TimeExpire
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.MerchantCategoryCode != nil {
+		ret.MerchantCategoryCode = new(string)
+		*ret.MerchantCategoryCode = *o.MerchantCategoryCode
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.SceneInfo != nil {
+		ret.SceneInfo = o.SceneInfo.Clone()
+	}
+
+	return &ret
+}
+
+// NativePrepayResponse
+type NativePrepayResponse struct {
+	// 二维码链接，有效期为2小时
+	CodeUrl *string `json:"code_url"`
+}
+
+func (o NativePrepayResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CodeUrl == nil {
+		return nil, fmt.Errorf("field `CodeUrl` is required and must be specified in NativePrepayResponse")
+	}
+	toSerialize["code_url"] = o.CodeUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o NativePrepayResponse) String() string {
+	var ret string
+	if o.CodeUrl == nil {
+		ret += "CodeUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("CodeUrl:%v", *o.CodeUrl)
+	}
+
+	return fmt.Sprintf("NativePrepayResponse{%s}", ret)
+}
+
+func (o NativePrepayResponse) Clone() *NativePrepayResponse {
+	ret := NativePrepayResponse{}
+
+	if o.CodeUrl != nil {
+		ret.CodeUrl = new(string)
+		*ret.CodeUrl = *o.CodeUrl
+	}
+
+	return &ret
+}
+
+// Payer 支付者信息
+type Payer struct {
+	// 用户在机构sp_appid下的唯一标识
+	SpOpenid *string `json:"sp_openid,omitempty"`
+	// 用户在子商户sub_appid下的唯一标识，下单时sp_openid与sub_openid二选一
+	SubOpenid *string `json:"sub_openid,omitempty"`
+}
+
+func (o Payer) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpOpenid != nil {
+		toSerialize["sp_openid"] = o.SpOpenid
+	}
+
+	if o.SubOpenid != nil {
+		toSerialize["sub_openid"] = o.SubOpenid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Payer) String() string {
+	var ret string
+	if o.SpOpenid == nil {
+		ret += "SpOpenid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpOpenid:%v, ", *o.SpOpenid)
+	}
+
+	if o.SubOpenid == nil {
+		ret += "SubOpenid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubOpenid:%v", *o.SubOpenid)
+	}
+
+	return fmt.Sprintf("Payer{%s}", ret)
+}
+
+func (o Payer) Clone() *Payer {
+	ret := Payer{}
+
+	if o.SpOpenid != nil {
+		ret.SpOpenid = new(string)
+		*ret.SpOpenid = *o.SpOpenid
+	}
+
+	if o.SubOpenid != nil {
+		ret.SubOpenid = new(string)
+		*ret.SubOpenid = *o.SubOpenid
+	}
+
+	return &ret
+}
+
+// HasSpOpenid 应答中是否返回了 sp_openid，o 为 nil 时返回 false
+func (o *Payer) HasSpOpenid() bool {
+	return o != nil && o.SpOpenid != nil
+}
+
+// HasSubOpenid 应答中是否返回了 sub_openid，o 为 nil 时返回 false
+func (o *Payer) HasSubOpenid() bool {
+	return o != nil && o.SubOpenid != nil
+}
+
+// PrepayIdResponse
+type PrepayIdResponse struct {
+	// 预支付交易会话标识，有效期为2小时
+	PrepayId *string `json:"prepay_id"`
+}
+
+func (o PrepayIdResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PrepayId == nil {
+		return nil, fmt.Errorf("field `PrepayId` is required and must be specified in PrepayIdResponse")
+	}
+	toSerialize["prepay_id"] = o.PrepayId
+	return json.Marshal(toSerialize)
+}
+
+func (o PrepayIdResponse) String() string {
+	var ret string
+	if o.PrepayId == nil {
+		ret += "PrepayId:<nil>"
+	} else {
+		ret += fmt.Sprintf("PrepayId:%v", *o.PrepayId)
+	}
+
+	return fmt.Sprintf("PrepayIdResponse{%s}", ret)
+}
+
+func (o PrepayIdResponse) Clone() *PrepayIdResponse {
+	ret := PrepayIdResponse{}
+
+	if o.PrepayId != nil {
+		ret.PrepayId = new(string)
+		*ret.PrepayId = *o.PrepayId
+	}
+
+	return &ret
+}
+
+// QueryOrderByIdRequest
+type QueryOrderByIdRequest struct {
+	TransactionId *string `json:"transaction_id"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryOrderByIdRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in QueryOrderByIdRequest")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in QueryOrderByIdRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryOrderByIdRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryOrderByIdRequest) String() string {
+	var ret string
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryOrderByIdRequest{%s}", ret)
+}
+
+func (o QueryOrderByIdRequest) Clone() *QueryOrderByIdRequest {
+	ret := QueryOrderByIdRequest{}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// QueryOrderByOutTradeNoRequest
+type QueryOrderByOutTradeNoRequest struct {
+	OutTradeNo *string `json:"out_trade_no"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryOrderByOutTradeNoRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in QueryOrderByOutTradeNoRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in QueryOrderByOutTradeNoRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryOrderByOutTradeNoRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryOrderByOutTradeNoRequest) String() string {
+	var ret string
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryOrderByOutTradeNoRequest{%s}", ret)
+}
+
+func (o QueryOrderByOutTradeNoRequest) Clone() *QueryOrderByOutTradeNoRequest {
+	ret := QueryOrderByOutTradeNoRequest{}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// SceneInfo 支付场景描述
+type SceneInfo struct {
+	// 用户终端IP
+	PayerClientIp *string `json:"payer_client_ip,omitempty"`
+	// 商户端设备号
+	DeviceId  *string    `json:"device_id,omitempty"`
+	StoreInfo *StoreInfo `json:"store_info,omitempty"`
+}
+
+func (o SceneInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PayerClientIp != nil {
+		toSerialize["payer_client_ip"] = o.PayerClientIp
+	}
+
+	if o.DeviceId != nil {
+		toSerialize["device_id"] = o.DeviceId
+	}
+
+	if o.StoreInfo != nil {
+		toSerialize["store_info"] = o.StoreInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SceneInfo) String() string {
+	var ret string
+	if o.PayerClientIp == nil {
+		ret += "PayerClientIp:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerClientIp:%v, ", *o.PayerClientIp)
+	}
+
+	if o.DeviceId == nil {
+		ret += "DeviceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("DeviceId:%v, ", *o.DeviceId)
+	}
+
+	ret += fmt.Sprintf("StoreInfo:%v", o.StoreInfo)
+
+	return fmt.Sprintf("SceneInfo{%s}", ret)
+}
+
+func (o SceneInfo) Clone() *SceneInfo {
+	ret := SceneInfo{}
+
+	if o.PayerClientIp != nil {
+		ret.PayerClientIp = new(string)
+		*ret.PayerClientIp = *o.PayerClientIp
+	}
+
+	if o.DeviceId != nil {
+		ret.DeviceId = new(string)
+		*ret.DeviceId = *o.DeviceId
+	}
+
+	if o.StoreInfo != nil {
+		ret.StoreInfo = o.StoreInfo.Clone()
+	}
+
+	return &ret
+}
+
+// StoreInfo 商户门店信息
+type StoreInfo struct {
+	// 商户侧门店编号
+	Id *string `json:"id"`
+	// 商户侧门店名称
+	Name *string `json:"name,omitempty"`
+	// 详细的商户门店地址
+	Address *string `json:"address,omitempty"`
+}
+
+func (o StoreInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Id == nil {
+		return nil, fmt.Errorf("field `Id` is required and must be specified in StoreInfo")
+	}
+	toSerialize["id"] = o.Id
+
+	if o.Name != nil {
+		toSerialize["name"] = o.Name
+	}
+
+	if o.Address != nil {
+		toSerialize["address"] = o.Address
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o StoreInfo) String() string {
+	var ret string
+	if o.Id == nil {
+		ret += "Id:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Id:%v, ", *o.Id)
+	}
+
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.Address == nil {
+		ret += "Address:<nil>"
+	} else {
+		ret += fmt.Sprintf("Address:%v", *o.Address)
+	}
+
+	return fmt.Sprintf("StoreInfo{%s}", ret)
+}
+
+func (o StoreInfo) Clone() *StoreInfo {
+	ret := StoreInfo{}
+
+	if o.Id != nil {
+		ret.Id = new(string)
+		*ret.Id = *o.Id
+	}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.Address != nil {
+		ret.Address = new(string)
+		*ret.Address = *o.Address
+	}
+
+	return &ret
+}
+
+// Transaction
+type Transaction struct {
+	// 机构应用ID
+	SpAppid *string `json:"sp_appid,omitempty"`
+	// 机构商户号
+	SpMchid *string `json:"sp_mchid,omitempty"`
+	// 子商户应用ID
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户订单号
+	OutTradeNo *string `json:"out_trade_no,omitempty"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id,omitempty"`
+	// 附加数据
+	Attach *string `json:"attach,omitempty"`
+	// 交易类型，JSAPI、NATIVE、APP、MWEB
+	TradeType *string `json:"trade_type,omitempty"`
+	// 交易状态，SUCCESS：支付成功，REFUND：转入退款，NOTPAY：未支付，CLOSED：已关闭，REVOKED：已撤销，USERPAYING：用户支付中，PAYERROR：支付失败
+	TradeState *string `json:"trade_state,omitempty"`
+	// 交易状态描述
+	TradeStateDesc *string `json:"trade_state_desc,omitempty"`
+	// 付款银行
+	BankType *string `json:"bank_type,omitempty"`
+	// 支付完成时间，遵循rfc3339标准格式
+	SuccessTime *time.Time `json:"success_time,omitempty"`
+	// 支付者信息
+	Payer *Payer `json:"payer,omitempty"`
+	// 订单金额
+	Amount *TransactionAmount `json:"amount,omitempty"`
+}
+
+func (o Transaction) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid != nil {
+		toSerialize["sp_appid"] = o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		toSerialize["sp_mchid"] = o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.OutTradeNo != nil {
+		toSerialize["out_trade_no"] = o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		toSerialize["transaction_id"] = o.TransactionId
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.TradeType != nil {
+		toSerialize["trade_type"] = o.TradeType
+	}
+
+	if o.TradeState != nil {
+		toSerialize["trade_state"] = o.TradeState
+	}
+
+	if o.TradeStateDesc != nil {
+		toSerialize["trade_state_desc"] = o.TradeStateDesc
+	}
+
+	if o.BankType != nil {
+		toSerialize["bank_type"] = o.BankType
+	}
+
+	if o.SuccessTime != nil {
+		toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+	}
+
+	if o.Payer != nil {
+		toSerialize["payer"] = o.Payer
+	}
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Transaction) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.TradeType == nil {
+		ret += "TradeType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeType:%v, ", *o.TradeType)
+	}
+
+	if o.TradeState == nil {
+		ret += "TradeState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeState:%v, ", *o.TradeState)
+	}
+
+	if o.TradeStateDesc == nil {
+		ret += "TradeStateDesc:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeStateDesc:%v, ", *o.TradeStateDesc)
+	}
+
+	if o.BankType == nil {
+		ret += "BankType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankType:%v, ", *o.BankType)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	ret += fmt.Sprintf("Payer:%v, ", o.Payer)
+
+	ret += fmt.Sprintf("Amount:%v", o.Amount)
+
+	return fmt.Sprintf("Transaction{%s}", ret)
+}
+
+func (o Transaction) Clone() *Transaction {
+	ret := Transaction{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.TradeType != nil {
+		ret.TradeType = new(string)
+		*ret.TradeType = *o.TradeType
+	}
+
+	if o.TradeState != nil {
+		ret.TradeState = new(string)
+		*ret.TradeState = *o.TradeState
+	}
+
+	if o.TradeStateDesc != nil {
+		ret.TradeStateDesc = new(string)
+		*ret.TradeStateDesc = *o.TradeStateDesc
+	}
+
+	if o.BankType != nil {
+		ret.BankType = new(string)
+		*ret.BankType = *o.BankType
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.Payer != nil {
+		ret.Payer = o.Payer.Clone()
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	return &ret
+}
+
+// HasSpAppid 应答中是否返回了 sp_appid，o 为 nil 时返回 false
+func (o *Transaction) HasSpAppid() bool {
+	return o != nil && o.SpAppid != nil
+}
+
+// HasSpMchid 应答中是否返回了 sp_mchid，o 为 nil 时返回 false
+func (o *Transaction) HasSpMchid() bool {
+	return o != nil && o.SpMchid != nil
+}
+
+// HasSubAppid 应答中是否返回了 sub_appid，o 为 nil 时返回 false
+func (o *Transaction) HasSubAppid() bool {
+	return o != nil && o.SubAppid != nil
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *Transaction) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasOutTradeNo 应答中是否返回了 out_trade_no，o 为 nil 时返回 false
+func (o *Transaction) HasOutTradeNo() bool {
+	return o != nil && o.OutTradeNo != nil
+}
+
+// HasTransactionId 应答中是否返回了 transaction_id，o 为 nil 时返回 false
+func (o *Transaction) HasTransactionId() bool {
+	return o != nil && o.TransactionId != nil
+}
+
+// HasAttach 应答中是否返回了 attach，o 为 nil 时返回 false
+func (o *Transaction) HasAttach() bool {
+	return o != nil && o.Attach != nil
+}
+
+// HasTradeType 应答中是否返回了 trade_type，o 为 nil 时返回 false
+func (o *Transaction) HasTradeType() bool {
+	return o != nil && o.TradeType != nil
+}
+
+// HasTradeState 应答中是否返回了 trade_state，o 为 nil 时返回 false
+func (o *Transaction) HasTradeState() bool {
+	return o != nil && o.TradeState != nil
+}
+
+// HasTradeStateDesc 应答中是否返回了 trade_state_desc，o 为 nil 时返回 false
+func (o *Transaction) HasTradeStateDesc() bool {
+	return o != nil && o.TradeStateDesc != nil
+}
+
+// HasBankType 应答中是否返回了 bank_type，o 为 nil 时返回 false
+func (o *Transaction) HasBankType() bool {
+	return o != nil && o.BankType != nil
+}
+
+// HasSuccessTime 应答中是否返回了 success_time，o 为 nil 时返回 false
+func (o *Transaction) HasSuccessTime() bool {
+	return o != nil && o.SuccessTime != nil
+}
+
+// HasPayer 应答中是否返回了 payer，o 为 nil 时返回 false
+func (o *Transaction) HasPayer() bool {
+	return o != nil && o.Payer != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *Transaction) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// TransactionAmount 订单金额，包含标价币种、用户支付币种与汇率
+type TransactionAmount struct {
+	// 订单金额，单位为标价币种的最小货币单位
+	Total *int64 `json:"total,omitempty"`
+	// 标价币种
+	Currency *string `json:"currency,omitempty"`
+	// 用户支付金额，单位为用户支付币种的最小货币单位
+	PayerTotal *int64 `json:"payer_total,omitempty"`
+	// 用户支付币种
+	PayerCurrency *string       `json:"payer_currency,omitempty"`
+	ExchangeRate  *ExchangeRate `json:"exchange_rate,omitempty"`
+}
+
+func (o TransactionAmount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total != nil {
+		toSerialize["total"] = o.Total
+	}
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+
+	if o.PayerTotal != nil {
+		toSerialize["payer_total"] = o.PayerTotal
+	}
+
+	if o.PayerCurrency != nil {
+		toSerialize["payer_currency"] = o.PayerCurrency
+	}
+
+	if o.ExchangeRate != nil {
+		toSerialize["exchange_rate"] = o.ExchangeRate
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TransactionAmount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Currency:%v, ", *o.Currency)
+	}
+
+	if o.PayerTotal == nil {
+		ret += "PayerTotal:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerTotal:%v, ", *o.PayerTotal)
+	}
+
+	if o.PayerCurrency == nil {
+		ret += "PayerCurrency:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerCurrency:%v, ", *o.PayerCurrency)
+	}
+
+	ret += fmt.Sprintf("ExchangeRate:%v", o.ExchangeRate)
+
+	return fmt.Sprintf("TransactionAmount{%s}", ret)
+}
+
+func (o TransactionAmount) Clone() *TransactionAmount {
+	ret := TransactionAmount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	if o.PayerTotal != nil {
+		ret.PayerTotal = new(int64)
+		*ret.PayerTotal = *o.PayerTotal
+	}
+
+	if o.PayerCurrency != nil {
+		ret.PayerCurrency = new(string)
+		*ret.PayerCurrency = *o.PayerCurrency
+	}
+
+	if o.ExchangeRate != nil {
+		ret.ExchangeRate = o.ExchangeRate.Clone()
+	}
+
+	return &ret
+}
+
+// HasTotal 应答中是否返回了 total，o 为 nil 时返回 false
+func (o *TransactionAmount) HasTotal() bool {
+	return o != nil && o.Total != nil
+}
+
+// HasCurrency 应答中是否返回了 currency，o 为 nil 时返回 false
+func (o *TransactionAmount) HasCurrency() bool {
+	return o != nil && o.Currency != nil
+}
+
+// HasPayerTotal 应答中是否返回了 payer_total，o 为 nil 时返回 false
+func (o *TransactionAmount) HasPayerTotal() bool {
+	return o != nil && o.PayerTotal != nil
+}
+
+// HasPayerCurrency 应答中是否返回了 payer_currency，o 为 nil 时返回 false
+func (o *TransactionAmount) HasPayerCurrency() bool {
+	return o != nil && o.PayerCurrency != nil
+}
+
+// HasExchangeRate 应答中是否返回了 exchange_rate，o 为 nil 时返回 false
+func (o *TransactionAmount) HasExchangeRate() bool {
+	return o != nil && o.ExchangeRate != nil
+}
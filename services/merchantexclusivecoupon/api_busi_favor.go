@@ -0,0 +1,139 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家券API
+//
+// 创建商家券批次、修改批次预算、预存自定义券code与设置事件通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantexclusivecoupon
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type BusiFavorApiService services.Service
+
+// CreateBusifavorStock 创建商家券
+//
+// # 应用场景
+// 商户可以通过该接口创建商家券。创建成功后批次即可发放，商户可通过券code码方式指定券code的生成方式。
+//
+// 注意：
+// 1、券的使用规则 coupon_use_rule 与发放规则 stock_send_rule 为必填的嵌套结构，可使用 NewCreateBusifavorStockRequestBuilder 构造请求，避免遗漏
+// 2、商户请求单号 out_request_no 相同时视为同一次创建，可使用相同的参数重试
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|批次配置不符合规则，如可用时间、发放规则有误|请根据错误信息修改批次配置|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *BusiFavorApiService) CreateBusifavorStock(ctx context.Context, req CreateBusifavorStockBody) (resp *CreateBusifavorStockResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/busifavor/stocks"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract CreateBusifavorStockResponse from Http Response
+	resp = new(CreateBusifavorStockResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// ModifyBudget 修改批次预算
+//
+// # 应用场景
+// 商户可以通过该接口修改商家券批次的最大发放个数与单天最大发放个数，以增加或减少批次预算。
+//
+// 注意：
+// 1、target_max_coupons 与 target_max_coupons_by_day 二选一，并同时传入对应的当前值 current_max_coupons 或 current_max_coupons_by_day；当前值与批次实际预算不一致时修改失败
+// 2、修改预算请求单据号 modify_budget_request_no 相同时视为同一次修改，可使用相同的参数重试
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|当前预算与批次实际预算不一致，或目标预算低于已发放数量|请查询批次详情后使用最新的预算重新修改|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *BusiFavorApiService) ModifyBudget(ctx context.Context, req ModifyBudgetRequest) (resp *ModifyBudgetResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPatch
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.StockId == nil {
+		return nil, nil, fmt.Errorf("field `StockId` is required and must be specified in ModifyBudgetRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/busifavor/stocks/{stock_id}/budget"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"stock_id"+"}", neturl.PathEscape(core.ParameterToString(*req.StockId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &ModifyBudgetBody{
+		TargetMaxCoupons:       req.TargetMaxCoupons,
+		CurrentMaxCoupons:      req.CurrentMaxCoupons,
+		TargetMaxCouponsByDay:  req.TargetMaxCouponsByDay,
+		CurrentMaxCouponsByDay: req.CurrentMaxCouponsByDay,
+		ModifyBudgetRequestNo:  req.ModifyBudgetRequestNo,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ModifyBudgetResponse from Http Response
+	resp = new(ModifyBudgetResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家券API
+//
+// 创建商家券批次、修改批次预算、预存自定义券code与设置事件通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantexclusivecoupon_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantexclusivecoupon"
+)
+
+func ExampleBusiFavorApiService_CreateBusifavorStock() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantexclusivecoupon.BusiFavorApiService{Client: client}
+	resp, result, err := svc.CreateBusifavorStock(ctx,
+		merchantexclusivecoupon.CreateBusifavorStockBody{
+			BelongMerchant: core.String("10000022"),
+			Comment:        core.String("xxx店使用"),
+			CouponCodeMode: merchantexclusivecoupon.COUPONCODEMODE_WECHATPAY_MODE.Ptr(),
+			CouponUseRule: &merchantexclusivecoupon.CouponUseRule{
+				CouponAvailableTime: &merchantexclusivecoupon.FavorAvailableTime{
+					AvailableBeginTime:       core.Time(time.Now()),
+					AvailableDayAfterReceive: core.Int64(3),
+					AvailableEndTime:         core.Time(time.Now()),
+					WaitDaysAfterReceive:     core.Int64(7),
+				},
+				DiscountCoupon: &merchantexclusivecoupon.DiscountMsg{
+					DiscountPercent:    core.Int64(88),
+					TransactionMinimum: core.Int64(100),
+				},
+				ExchangeCoupon: &merchantexclusivecoupon.ExchangeMsg{
+					ExchangePrice:      core.Int64(100),
+					TransactionMinimum: core.Int64(100),
+				},
+				FixedNormalCoupon: &merchantexclusivecoupon.FixedValueStockMsg{
+					DiscountAmount:     core.Int64(5),
+					TransactionMinimum: core.Int64(100),
+				},
+				MiniProgramsAppid: core.String("wx23232232323"),
+				MiniProgramsPath:  core.String("/path/index/index"),
+				UseMethod:         merchantexclusivecoupon.COUPONUSEMETHOD_OFF_LINE.Ptr(),
+			},
+			DisplayPatternInfo: &merchantexclusivecoupon.DisplayPatternInfo{
+				BackgroundColor: core.String("Color020"),
+				CouponImageUrl:  core.String("https://qpic.cn/xxx"),
+				Description:     core.String("xxx门店可用"),
+				MerchantLogoUrl: core.String("https://xxx"),
+				MerchantName:    core.String("微信支付"),
+			},
+			GoodsName: core.String("xxx商品使用"),
+			NotifyConfig: &merchantexclusivecoupon.NotifyConfig{
+				NotifyAppid: core.String("wx23232232323"),
+			},
+			OutRequestNo: core.String("100002322019090134234sfdf"),
+			StockName:    core.String("8月1日活动券"),
+			StockSendRule: &merchantexclusivecoupon.StockSendRule{
+				MaxAmount:          core.Int64(100000),
+				MaxAmountByDay:     core.Int64(1000),
+				MaxCoupons:         core.Int64(100),
+				MaxCouponsByDay:    core.Int64(100),
+				MaxCouponsPerUser:  core.Int64(5),
+				NaturalPersonLimit: core.Bool(false),
+				PreventApiAbuse:    core.Bool(false),
+				Shareable:          core.Bool(false),
+				Transferable:       core.Bool(false),
+			},
+			StockType: merchantexclusivecoupon.BUSIFAVORSTOCKTYPE_NORMAL.Ptr(),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleBusiFavorApiService_ModifyBudget() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantexclusivecoupon.BusiFavorApiService{Client: client}
+	resp, result, err := svc.ModifyBudget(ctx,
+		merchantexclusivecoupon.ModifyBudgetRequest{
+			CurrentMaxCoupons:      core.Int64(500),
+			CurrentMaxCouponsByDay: core.Int64(300),
+			ModifyBudgetRequestNo:  core.String("1002600620019090123143254436"),
+			StockId:                core.String("98065001"),
+			TargetMaxCoupons:       core.Int64(3000),
+			TargetMaxCouponsByDay:  core.Int64(500),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
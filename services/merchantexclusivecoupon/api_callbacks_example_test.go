@@ -0,0 +1,55 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家券API
+//
+// 创建商家券批次、修改批次预算、预存自定义券code与设置事件通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantexclusivecoupon_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantexclusivecoupon"
+)
+
+func ExampleCallbacksApiService_QueryCallbacks() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantexclusivecoupon.CallbacksApiService{Client: client}
+	resp, result, err := svc.QueryCallbacks(ctx,
+		merchantexclusivecoupon.QueryCallbacksRequest{
+			Mchid: core.String("10000098"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleCallbacksApiService_SetCallbacks() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantexclusivecoupon.CallbacksApiService{Client: client}
+	resp, result, err := svc.SetCallbacks(ctx,
+		merchantexclusivecoupon.SetCallbacksBody{
+			Mchid:     core.String("10000098"),
+			NotifyUrl: core.String("https://pay.weixin.qq.com"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
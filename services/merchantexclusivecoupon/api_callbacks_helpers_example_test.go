@@ -0,0 +1,31 @@
+package merchantexclusivecoupon_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantexclusivecoupon"
+)
+
+func ExampleCallbacksApiService_SetVerifiedCallbacks() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantexclusivecoupon.CallbacksApiService{Client: client}
+	resp, _, err := svc.SetVerifiedCallbacks(ctx, nil, merchantexclusivecoupon.SetCallbacksBody{
+		Mchid:     core.String("10000098"),
+		NotifyUrl: core.String("https://pay.example.com/notify/busifavor"),
+	})
+	if errors.Is(err, notify.ErrProbeAccepted) {
+		// TODO: 通知地址没有验证通知的签名，修复后再切换
+		return
+	}
+
+	// TODO: 处理返回结果
+	_, _ = resp, err
+}
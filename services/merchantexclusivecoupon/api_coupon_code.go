@@ -0,0 +1,143 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家券API
+//
+// 创建商家券批次、修改批次预算、预存自定义券code与设置事件通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantexclusivecoupon
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type CouponCodeApiService services.Service
+
+// QueryCouponCodeUpload 查询预存code上传结果
+//
+// # 应用场景
+// 上传预存code的结果未知（如网络超时）时，商户可以使用上传请求单号查询该次上传的结果。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|批次不存在|批次号有误，或上传请求单号对应的上传记录不存在|请确认批次号与上传请求单号|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CouponCodeApiService) QueryCouponCodeUpload(ctx context.Context, req QueryCouponCodeUploadRequest) (resp *UploadCouponCodesResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.StockId == nil {
+		return nil, nil, fmt.Errorf("field `StockId` is required and must be specified in QueryCouponCodeUploadRequest")
+	}
+	if req.UploadRequestNo == nil {
+		return nil, nil, fmt.Errorf("field `UploadRequestNo` is required and must be specified in QueryCouponCodeUploadRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/busifavor/stocks/{stock_id}/couponcodes/{upload_request_no}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"stock_id"+"}", neturl.PathEscape(core.ParameterToString(*req.StockId, "")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"upload_request_no"+"}", neturl.PathEscape(core.ParameterToString(*req.UploadRequestNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract UploadCouponCodesResponse from Http Response
+	resp = new(UploadCouponCodesResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// UploadCouponCodes 上传预存code
+//
+// # 应用场景
+// 商家券的券code码方式为 MERCHANT_UPLOAD（商户上传自定义code）时，商户需预先上传券code，用户领券时从中分配。
+//
+// 注意：
+// 1、每次最多上传200个券code，券code只能包含数字与大小写字母，长度为1~32个字符
+// 2、上传请求单号相同时视为同一次上传，可使用相同的参数重试
+// 3、需要上传大量券code时可使用 merchantexclusivecoupon.CodeUploader 自动分批、去重与重试
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|批次不存在|批次号有误，或上传请求单号对应的上传记录不存在|请确认批次号与上传请求单号|
+// |INVALID_REQUEST|无效请求|批次的券code码方式不是商户上传自定义code，或券code数量超过批次发放总数|请确认批次配置|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CouponCodeApiService) UploadCouponCodes(ctx context.Context, req UploadCouponCodesRequest) (resp *UploadCouponCodesResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.StockId == nil {
+		return nil, nil, fmt.Errorf("field `StockId` is required and must be specified in UploadCouponCodesRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/busifavor/stocks/{stock_id}/couponcodes"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"stock_id"+"}", neturl.PathEscape(core.ParameterToString(*req.StockId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &UploadCouponCodesBody{
+		CouponCodeList:  req.CouponCodeList,
+		UploadRequestNo: req.UploadRequestNo,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract UploadCouponCodesResponse from Http Response
+	resp = new(UploadCouponCodesResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
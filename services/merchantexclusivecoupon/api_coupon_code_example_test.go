@@ -0,0 +1,57 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家券API
+//
+// 创建商家券批次、修改批次预算、预存自定义券code与设置事件通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantexclusivecoupon_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantexclusivecoupon"
+)
+
+func ExampleCouponCodeApiService_QueryCouponCodeUpload() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantexclusivecoupon.CouponCodeApiService{Client: client}
+	resp, result, err := svc.QueryCouponCodeUpload(ctx,
+		merchantexclusivecoupon.QueryCouponCodeUploadRequest{
+			StockId:         core.String("98065001"),
+			UploadRequestNo: core.String("100002322019090134234sfdf"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleCouponCodeApiService_UploadCouponCodes() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantexclusivecoupon.CouponCodeApiService{Client: client}
+	resp, result, err := svc.UploadCouponCodes(ctx,
+		merchantexclusivecoupon.UploadCouponCodesRequest{
+			CouponCodeList:  []string{"ABC9588200"},
+			StockId:         core.String("98065001"),
+			UploadRequestNo: core.String("100002322019090134234sfdf"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
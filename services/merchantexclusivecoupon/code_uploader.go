@@ -0,0 +1,211 @@
+package merchantexclusivecoupon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const (
+	// MaxCouponCodesPerUpload 上传预存code每次最多上传的券code数量
+	MaxCouponCodesPerUpload = 200
+	// MaxCouponCodeLength 券code的最大长度
+	MaxCouponCodeLength = 32
+)
+
+const (
+	defaultCodeUploadMaxAttempts   = 3
+	defaultCodeUploadRetryInterval = time.Second
+)
+
+// GenerateUploadRequestNo 生成上传请求单号，格式为 CU + 14 位时间 + 16 位随机字符
+func GenerateUploadRequestNo() (string, error) {
+	nonce, err := utils.GenerateNonce()
+	if err != nil {
+		return "", err
+	}
+	return "CU" + time.Now().Format("20060102150405") + nonce[:16], nil
+}
+
+// IsValidCouponCode 判断券code是否符合要求：只包含数字与大小写字母，长度为 1~MaxCouponCodeLength 个字符
+func IsValidCouponCode(code string) bool {
+	if code == "" || len(code) > MaxCouponCodeLength {
+		return false
+	}
+	for _, c := range code {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// CodeUploadReport 分批上传券code的汇总结果
+type CodeUploadReport struct {
+	// StockId 批次号
+	StockId string
+	// UploadRequestNos 各批次使用的上传请求单号，上传结果未知时可使用 CouponCodeApiService.QueryCouponCodeUpload 查询
+	UploadRequestNos []string
+	// TotalCount 去重并校验后提交上传的券code数量
+	TotalCount int64
+	// SuccessCount 上传成功的券code数量
+	SuccessCount int64
+	// FailCodes 微信支付返回的上传失败的券code及原因
+	FailCodes []CouponCodeFail
+	// ExistCodes 此前已上传过的券code
+	ExistCodes []string
+	// DuplicateCodes 重复的券code，包括本地去重时发现的与微信支付返回的
+	DuplicateCodes []string
+	// InvalidCodes 本地校验未通过（见 IsValidCouponCode）而未上传的券code
+	InvalidCodes []string
+}
+
+func (r *CodeUploadReport) add(resp *UploadCouponCodesResponse) {
+	if resp.SuccessCount != nil {
+		r.SuccessCount += *resp.SuccessCount
+	}
+	r.FailCodes = append(r.FailCodes, resp.FailCodes...)
+	r.ExistCodes = append(r.ExistCodes, resp.ExistCodes...)
+	r.DuplicateCodes = append(r.DuplicateCodes, resp.DuplicateCodes...)
+}
+
+// CodeUploader 券code分批上传器
+//
+// 券code在本地去重、校验后按每批 MaxCouponCodesPerUpload 个分批上传，第 i 批（从 1 开始）的上传请求单号为
+// {uploadRequestNo}_{i}。上传预存code以上传请求单号保证幂等，因此结果未知（网络异常、SYSTEM_ERROR）或遇到
+// 频率限制（FREQUENCY_LIMITED）时，CodeUploader 按指数退避使用相同的参数重试。
+//
+// 重试次数用尽后仍失败时，使用相同的 uploadRequestNo 与券code列表再次调用 Upload 即可继续上传，已成功的批次不会重复上传。
+type CodeUploader struct {
+	svc           CouponCodeApiService
+	batchSize     int
+	maxAttempts   int
+	retryInterval time.Duration
+}
+
+// CodeUploaderOption CodeUploader 的配置项
+type CodeUploaderOption func(u *CodeUploader)
+
+// WithCodeBatchSize 设置每批上传的券code数量，范围为 [1, MaxCouponCodesPerUpload]，默认为 MaxCouponCodesPerUpload
+func WithCodeBatchSize(size int) CodeUploaderOption {
+	return func(u *CodeUploader) {
+		if size > 0 && size <= MaxCouponCodesPerUpload {
+			u.batchSize = size
+		}
+	}
+}
+
+// WithCodeUploadRetry 设置每批的最大尝试次数与首次重试间隔，默认为 3 次、1s
+func WithCodeUploadRetry(maxAttempts int, interval time.Duration) CodeUploaderOption {
+	return func(u *CodeUploader) {
+		if maxAttempts > 0 {
+			u.maxAttempts = maxAttempts
+		}
+		if interval >= 0 {
+			u.retryInterval = interval
+		}
+	}
+}
+
+// NewCodeUploader 创建 CodeUploader
+func NewCodeUploader(client *core.Client, opts ...CodeUploaderOption) *CodeUploader {
+	u := &CodeUploader{
+		svc:           CouponCodeApiService{Client: client},
+		batchSize:     MaxCouponCodesPerUpload,
+		maxAttempts:   defaultCodeUploadMaxAttempts,
+		retryInterval: defaultCodeUploadRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// Upload 将 codes 分批上传到批次 stockID，uploadRequestNo 为空时自动生成
+//
+// 某一批上传失败时停止上传，返回已上传批次的汇总结果与错误。需要在失败后继续上传时，请指定 uploadRequestNo
+// 并将其与券code列表一同持久化，而不要依赖自动生成的单号。
+func (u *CodeUploader) Upload(
+	ctx context.Context, stockID, uploadRequestNo string, codes []string,
+) (*CodeUploadReport, error) {
+	if uploadRequestNo == "" {
+		var err error
+		if uploadRequestNo, err = GenerateUploadRequestNo(); err != nil {
+			return nil, fmt.Errorf("generate upload_request_no err: %v", err)
+		}
+	}
+
+	report := &CodeUploadReport{StockId: stockID}
+	valid := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		switch {
+		case !IsValidCouponCode(code):
+			report.InvalidCodes = append(report.InvalidCodes, code)
+		case seen[code]:
+			report.DuplicateCodes = append(report.DuplicateCodes, code)
+		default:
+			seen[code] = true
+			valid = append(valid, code)
+		}
+	}
+	report.TotalCount = int64(len(valid))
+
+	for i, batch := 0, 1; i < len(valid); i, batch = i+u.batchSize, batch+1 {
+		end := i + u.batchSize
+		if end > len(valid) {
+			end = len(valid)
+		}
+		req := UploadCouponCodesRequest{
+			StockId:         core.String(stockID),
+			CouponCodeList:  valid[i:end],
+			UploadRequestNo: core.String(fmt.Sprintf("%s_%d", uploadRequestNo, batch)),
+		}
+		report.UploadRequestNos = append(report.UploadRequestNos, *req.UploadRequestNo)
+
+		resp, err := u.upload(ctx, req)
+		if err != nil {
+			return report, fmt.Errorf("upload coupon codes %s err:%w", *req.UploadRequestNo, err)
+		}
+		report.add(resp)
+	}
+	return report, nil
+}
+
+// upload 上传一批券code，在结果未知或遇到频率限制时按指数退避重试
+func (u *CodeUploader) upload(ctx context.Context, req UploadCouponCodesRequest) (*UploadCouponCodesResponse, error) {
+	interval := u.retryInterval
+	for attempt := 1; ; attempt++ {
+		resp, _, err := u.svc.UploadCouponCodes(ctx, req)
+		if err == nil || !isRetryable(err) || attempt >= u.maxAttempts {
+			return resp, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("%w, last err: %v", ctx.Err(), err)
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+// isRetryable 错误码为 SYSTEM_ERROR 或 FREQUENCY_LIMITED 的 *core.APIError，以及发送请求时的网络异常可以使用相同的参数重试
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == "SYSTEM_ERROR" || apiErr.Code == "FREQUENCY_LIMITED"
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
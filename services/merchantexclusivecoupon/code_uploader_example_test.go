@@ -0,0 +1,36 @@
+package merchantexclusivecoupon_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantexclusivecoupon"
+)
+
+func ExampleCodeUploader_Upload() {
+	var (
+		ctx    context.Context
+		client *core.Client
+		codes  []string
+	)
+	// 假设已获得初始化后的 core.Client，codes 为待上传的券code
+
+	uploader := merchantexclusivecoupon.NewCodeUploader(client)
+	// 指定上传请求单号并与券code列表一同持久化，失败后使用相同的参数再次调用即可继续上传
+	report, err := uploader.Upload(ctx, "98065001", "UPLOAD20210601001", codes)
+	if err != nil {
+		// TODO: 稍后使用相同的参数重试
+		return
+	}
+	fmt.Printf("uploaded %d/%d, exist %d, duplicate %d, invalid %d\n", report.SuccessCount, report.TotalCount,
+		len(report.ExistCodes), len(report.DuplicateCodes), len(report.InvalidCodes))
+}
+
+func ExampleIsValidCouponCode() {
+	fmt.Println(merchantexclusivecoupon.IsValidCouponCode("ABC9588200"))
+	fmt.Println(merchantexclusivecoupon.IsValidCouponCode("ABC-9588200"))
+	// Output:
+	// true
+	// false
+}
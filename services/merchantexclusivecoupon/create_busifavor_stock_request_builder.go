@@ -0,0 +1,186 @@
+package merchantexclusivecoupon
+
+import (
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// BusifavorCoupon 商家券批次的优惠规则，同时决定批次类型 stock_type
+//
+// 只能通过 NewFixedNormalCoupon、NewDiscountCoupon、NewExchangeCoupon 创建，
+// 从而保证批次类型与 coupon_use_rule 中对应的优惠规则始终一致。
+type BusifavorCoupon struct {
+	stockType BusifavorStockType
+	apply     func(rule *CouponUseRule)
+}
+
+// NewFixedNormalCoupon 固定面额满减券：满 transactionMinimum 分减 discountAmount 分
+func NewFixedNormalCoupon(discountAmount, transactionMinimum int64) BusifavorCoupon {
+	return BusifavorCoupon{
+		stockType: BUSIFAVORSTOCKTYPE_NORMAL,
+		apply: func(rule *CouponUseRule) {
+			rule.FixedNormalCoupon = &FixedValueStockMsg{
+				DiscountAmount:     core.Int64(discountAmount),
+				TransactionMinimum: core.Int64(transactionMinimum),
+			}
+		},
+	}
+}
+
+// NewDiscountCoupon 折扣券：满 transactionMinimum 分打 discountPercent 折，例如 88 为八八折
+func NewDiscountCoupon(discountPercent, transactionMinimum int64) BusifavorCoupon {
+	return BusifavorCoupon{
+		stockType: BUSIFAVORSTOCKTYPE_DISCOUNT,
+		apply: func(rule *CouponUseRule) {
+			rule.DiscountCoupon = &DiscountMsg{
+				DiscountPercent:    core.Int64(discountPercent),
+				TransactionMinimum: core.Int64(transactionMinimum),
+			}
+		},
+	}
+}
+
+// NewExchangeCoupon 换购券：满 transactionMinimum 分以 exchangePrice 分换购
+func NewExchangeCoupon(exchangePrice, transactionMinimum int64) BusifavorCoupon {
+	return BusifavorCoupon{
+		stockType: BUSIFAVORSTOCKTYPE_EXCHANGE,
+		apply: func(rule *CouponUseRule) {
+			rule.ExchangeCoupon = &ExchangeMsg{
+				ExchangePrice:      core.Int64(exchangePrice),
+				TransactionMinimum: core.Int64(transactionMinimum),
+			}
+		},
+	}
+}
+
+// CreateBusifavorStockRequestBuilder 创建商家券请求 CreateBusifavorStockBody 构造器
+//
+// 所有必填参数均需在 NewCreateBusifavorStockRequestBuilder 中传入，可选参数通过链式调用设置，
+// 嵌套结构（如 coupon_use_rule、stock_send_rule）中的必填参数同样体现在构造函数签名中，
+// 从而在编译期避免遗漏必填参数。
+type CreateBusifavorStockRequestBuilder struct {
+	req CreateBusifavorStockBody
+}
+
+// NewCreateBusifavorStockRequestBuilder 使用创建商家券的全部必填参数初始化 CreateBusifavorStockRequestBuilder
+//
+// coupon 同时决定批次类型与对应的优惠规则；availableBeginTime、availableEndTime 为券可核销时间；
+// maxCoupons、maxCouponsPerUser 分别为批次最大发放个数与用户最大可领个数。
+func NewCreateBusifavorStockRequestBuilder(
+	stockName, belongMerchant, goodsName, outRequestNo string, coupon BusifavorCoupon,
+	couponCodeMode CouponCodeMode, useMethod CouponUseMethod, availableBeginTime, availableEndTime time.Time,
+	maxCoupons, maxCouponsPerUser int64,
+) *CreateBusifavorStockRequestBuilder {
+	useRule := &CouponUseRule{
+		CouponAvailableTime: &FavorAvailableTime{
+			AvailableBeginTime: core.Time(availableBeginTime),
+			AvailableEndTime:   core.Time(availableEndTime),
+		},
+		UseMethod: useMethod.Ptr(),
+	}
+	if coupon.apply != nil {
+		coupon.apply(useRule)
+	}
+
+	req := CreateBusifavorStockBody{
+		StockName:      core.String(stockName),
+		BelongMerchant: core.String(belongMerchant),
+		GoodsName:      core.String(goodsName),
+		CouponUseRule:  useRule,
+		StockSendRule: &StockSendRule{
+			MaxCoupons:        core.Int64(maxCoupons),
+			MaxCouponsPerUser: core.Int64(maxCouponsPerUser),
+		},
+		OutRequestNo:   core.String(outRequestNo),
+		CouponCodeMode: couponCodeMode.Ptr(),
+	}
+	if coupon.stockType != "" {
+		req.StockType = coupon.stockType.Ptr()
+	}
+	return &CreateBusifavorStockRequestBuilder{req: req}
+}
+
+// WithComment 设置批次备注
+func (b *CreateBusifavorStockRequestBuilder) WithComment(comment string) *CreateBusifavorStockRequestBuilder {
+	b.req.Comment = core.String(comment)
+	return b
+}
+
+// WithMiniPrograms 设置核销小程序，核销方式为 MINI_PROGRAMS 时必须设置
+func (b *CreateBusifavorStockRequestBuilder) WithMiniPrograms(appid, path string) *CreateBusifavorStockRequestBuilder {
+	b.req.CouponUseRule.MiniProgramsAppid = core.String(appid)
+	b.req.CouponUseRule.MiniProgramsPath = core.String(path)
+	return b
+}
+
+// WithAvailableDayAfterReceive 设置券领取后 N 天内有效
+func (b *CreateBusifavorStockRequestBuilder) WithAvailableDayAfterReceive(days int64) *CreateBusifavorStockRequestBuilder {
+	b.req.CouponUseRule.CouponAvailableTime.AvailableDayAfterReceive = core.Int64(days)
+	return b
+}
+
+// WithWaitDaysAfterReceive 设置券领取后 N 天开始生效
+func (b *CreateBusifavorStockRequestBuilder) WithWaitDaysAfterReceive(days int64) *CreateBusifavorStockRequestBuilder {
+	b.req.CouponUseRule.CouponAvailableTime.WaitDaysAfterReceive = core.Int64(days)
+	return b
+}
+
+// WithMaxAmount 设置批次总预算，单位为分
+func (b *CreateBusifavorStockRequestBuilder) WithMaxAmount(maxAmount int64) *CreateBusifavorStockRequestBuilder {
+	b.req.StockSendRule.MaxAmount = core.Int64(maxAmount)
+	return b
+}
+
+// WithMaxAmountByDay 设置单天发放上限金额，单位为分
+func (b *CreateBusifavorStockRequestBuilder) WithMaxAmountByDay(maxAmountByDay int64) *CreateBusifavorStockRequestBuilder {
+	b.req.StockSendRule.MaxAmountByDay = core.Int64(maxAmountByDay)
+	return b
+}
+
+// WithMaxCouponsByDay 设置单天发放上限个数
+func (b *CreateBusifavorStockRequestBuilder) WithMaxCouponsByDay(maxCouponsByDay int64) *CreateBusifavorStockRequestBuilder {
+	b.req.StockSendRule.MaxCouponsByDay = core.Int64(maxCouponsByDay)
+	return b
+}
+
+// WithNaturalPersonLimit 设置是否开启一个自然人限制领取
+func (b *CreateBusifavorStockRequestBuilder) WithNaturalPersonLimit(limit bool) *CreateBusifavorStockRequestBuilder {
+	b.req.StockSendRule.NaturalPersonLimit = core.Bool(limit)
+	return b
+}
+
+// WithPreventApiAbuse 设置是否开启防刷拦截
+func (b *CreateBusifavorStockRequestBuilder) WithPreventApiAbuse(prevent bool) *CreateBusifavorStockRequestBuilder {
+	b.req.StockSendRule.PreventApiAbuse = core.Bool(prevent)
+	return b
+}
+
+// WithTransferable 设置是否允许转赠
+func (b *CreateBusifavorStockRequestBuilder) WithTransferable(transferable bool) *CreateBusifavorStockRequestBuilder {
+	b.req.StockSendRule.Transferable = core.Bool(transferable)
+	return b
+}
+
+// WithShareable 设置是否允许分享领券链接
+func (b *CreateBusifavorStockRequestBuilder) WithShareable(shareable bool) *CreateBusifavorStockRequestBuilder {
+	b.req.StockSendRule.Shareable = core.Bool(shareable)
+	return b
+}
+
+// WithDisplayPatternInfo 设置样式信息
+func (b *CreateBusifavorStockRequestBuilder) WithDisplayPatternInfo(info DisplayPatternInfo) *CreateBusifavorStockRequestBuilder {
+	b.req.DisplayPatternInfo = info.Clone()
+	return b
+}
+
+// WithNotifyAppid 设置用于接收事件通知的公众号或小程序appid
+func (b *CreateBusifavorStockRequestBuilder) WithNotifyAppid(notifyAppid string) *CreateBusifavorStockRequestBuilder {
+	b.req.NotifyConfig = &NotifyConfig{NotifyAppid: core.String(notifyAppid)}
+	return b
+}
+
+// Build 生成 CreateBusifavorStockBody，每次调用均返回一份独立的副本
+func (b *CreateBusifavorStockRequestBuilder) Build() CreateBusifavorStockBody {
+	return *b.req.Clone()
+}
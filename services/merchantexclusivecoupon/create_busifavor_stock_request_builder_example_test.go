@@ -0,0 +1,35 @@
+package merchantexclusivecoupon_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantexclusivecoupon"
+)
+
+func ExampleNewCreateBusifavorStockRequestBuilder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	begin := time.Now().Add(time.Hour)
+	req := merchantexclusivecoupon.NewCreateBusifavorStockRequestBuilder(
+		"8月1日活动券", "10000022", "xxx商品使用", "100002322019090134234sfdf",
+		merchantexclusivecoupon.NewFixedNormalCoupon(5, 100),
+		merchantexclusivecoupon.COUPONCODEMODE_WECHATPAY_MODE, merchantexclusivecoupon.COUPONUSEMETHOD_OFF_LINE,
+		begin, begin.AddDate(0, 1, 0), 100, 5,
+	).
+		WithComment("xxx店使用").
+		WithMaxCouponsByDay(50).
+		WithNotifyAppid("wx23232232323").
+		Build()
+
+	svc := merchantexclusivecoupon.BusiFavorApiService{Client: client}
+	resp, result, err := svc.CreateBusifavorStock(ctx, req)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,137 @@
+package merchantexclusivecoupon_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantexclusivecoupon"
+)
+
+func TestCreateBusifavorStockRequestBuilder_Build(t *testing.T) {
+	begin := time.Date(2021, 8, 1, 0, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	end := begin.AddDate(0, 1, 0)
+	newBuilder := func(coupon merchantexclusivecoupon.BusifavorCoupon) *merchantexclusivecoupon.CreateBusifavorStockRequestBuilder {
+		return merchantexclusivecoupon.NewCreateBusifavorStockRequestBuilder(
+			"8月1日活动券", "10000022", "xxx商品使用", "100002322019090134234sfdf", coupon,
+			merchantexclusivecoupon.COUPONCODEMODE_MERCHANT_UPLOAD, merchantexclusivecoupon.COUPONUSEMETHOD_MINI_PROGRAMS,
+			begin, end, 100, 5,
+		)
+	}
+
+	tests := []struct {
+		name      string
+		coupon    merchantexclusivecoupon.BusifavorCoupon
+		stockType merchantexclusivecoupon.BusifavorStockType
+		check     func(t *testing.T, rule *merchantexclusivecoupon.CouponUseRule)
+	}{
+		{
+			name:      "fixed normal coupon",
+			coupon:    merchantexclusivecoupon.NewFixedNormalCoupon(5, 100),
+			stockType: merchantexclusivecoupon.BUSIFAVORSTOCKTYPE_NORMAL,
+			check: func(t *testing.T, rule *merchantexclusivecoupon.CouponUseRule) {
+				assert.Equal(t, int64(5), *rule.FixedNormalCoupon.DiscountAmount)
+				assert.Equal(t, int64(100), *rule.FixedNormalCoupon.TransactionMinimum)
+				assert.Nil(t, rule.DiscountCoupon)
+				assert.Nil(t, rule.ExchangeCoupon)
+			},
+		},
+		{
+			name:      "discount coupon",
+			coupon:    merchantexclusivecoupon.NewDiscountCoupon(88, 200),
+			stockType: merchantexclusivecoupon.BUSIFAVORSTOCKTYPE_DISCOUNT,
+			check: func(t *testing.T, rule *merchantexclusivecoupon.CouponUseRule) {
+				assert.Equal(t, int64(88), *rule.DiscountCoupon.DiscountPercent)
+				assert.Equal(t, int64(200), *rule.DiscountCoupon.TransactionMinimum)
+				assert.Nil(t, rule.FixedNormalCoupon)
+				assert.Nil(t, rule.ExchangeCoupon)
+			},
+		},
+		{
+			name:      "exchange coupon",
+			coupon:    merchantexclusivecoupon.NewExchangeCoupon(100, 300),
+			stockType: merchantexclusivecoupon.BUSIFAVORSTOCKTYPE_EXCHANGE,
+			check: func(t *testing.T, rule *merchantexclusivecoupon.CouponUseRule) {
+				assert.Equal(t, int64(100), *rule.ExchangeCoupon.ExchangePrice)
+				assert.Equal(t, int64(300), *rule.ExchangeCoupon.TransactionMinimum)
+				assert.Nil(t, rule.FixedNormalCoupon)
+				assert.Nil(t, rule.DiscountCoupon)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newBuilder(tt.coupon).Build()
+			assert.Equal(t, tt.stockType, *req.StockType)
+			assert.Equal(t, merchantexclusivecoupon.COUPONCODEMODE_MERCHANT_UPLOAD, *req.CouponCodeMode)
+			assert.Equal(t, merchantexclusivecoupon.COUPONUSEMETHOD_MINI_PROGRAMS, *req.CouponUseRule.UseMethod)
+			assert.True(t, begin.Equal(*req.CouponUseRule.CouponAvailableTime.AvailableBeginTime))
+			assert.True(t, end.Equal(*req.CouponUseRule.CouponAvailableTime.AvailableEndTime))
+			assert.Equal(t, int64(100), *req.StockSendRule.MaxCoupons)
+			assert.Equal(t, int64(5), *req.StockSendRule.MaxCouponsPerUser)
+			tt.check(t, req.CouponUseRule)
+
+			// 仅设置必填参数即可通过 MarshalJSON 的必填校验
+			_, err := json.Marshal(req)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateBusifavorStockRequestBuilder_Optional(t *testing.T) {
+	begin := time.Now()
+	b := merchantexclusivecoupon.NewCreateBusifavorStockRequestBuilder(
+		"8月1日活动券", "10000022", "xxx商品使用", "100002322019090134234sfdf",
+		merchantexclusivecoupon.NewFixedNormalCoupon(5, 100),
+		merchantexclusivecoupon.COUPONCODEMODE_WECHATPAY_MODE, merchantexclusivecoupon.COUPONUSEMETHOD_OFF_LINE,
+		begin, begin.Add(time.Hour), 100, 5,
+	)
+
+	req := b.Build()
+	assert.Nil(t, req.Comment)
+	assert.Nil(t, req.DisplayPatternInfo)
+	assert.Nil(t, req.NotifyConfig)
+	assert.Nil(t, req.CouponUseRule.MiniProgramsAppid)
+	assert.Nil(t, req.StockSendRule.MaxAmount)
+
+	info := merchantexclusivecoupon.DisplayPatternInfo{MerchantName: core.String("微信支付")}
+	req = b.
+		WithComment("xxx店使用").
+		WithMiniPrograms("wx23232232323", "/path/index/index").
+		WithAvailableDayAfterReceive(3).
+		WithWaitDaysAfterReceive(7).
+		WithMaxAmount(100000).
+		WithMaxAmountByDay(1000).
+		WithMaxCouponsByDay(50).
+		WithNaturalPersonLimit(true).
+		WithPreventApiAbuse(true).
+		WithTransferable(true).
+		WithShareable(true).
+		WithDisplayPatternInfo(info).
+		WithNotifyAppid("wx23232232323").
+		Build()
+	assert.Equal(t, "xxx店使用", *req.Comment)
+	assert.Equal(t, "wx23232232323", *req.CouponUseRule.MiniProgramsAppid)
+	assert.Equal(t, "/path/index/index", *req.CouponUseRule.MiniProgramsPath)
+	assert.Equal(t, int64(3), *req.CouponUseRule.CouponAvailableTime.AvailableDayAfterReceive)
+	assert.Equal(t, int64(7), *req.CouponUseRule.CouponAvailableTime.WaitDaysAfterReceive)
+	assert.Equal(t, int64(100000), *req.StockSendRule.MaxAmount)
+	assert.Equal(t, int64(1000), *req.StockSendRule.MaxAmountByDay)
+	assert.Equal(t, int64(50), *req.StockSendRule.MaxCouponsByDay)
+	assert.True(t, *req.StockSendRule.NaturalPersonLimit)
+	assert.True(t, *req.StockSendRule.PreventApiAbuse)
+	assert.True(t, *req.StockSendRule.Transferable)
+	assert.True(t, *req.StockSendRule.Shareable)
+	assert.Equal(t, "微信支付", *req.DisplayPatternInfo.MerchantName)
+	assert.Equal(t, "wx23232232323", *req.NotifyConfig.NotifyAppid)
+
+	// 修改样式信息原值不影响已设置的内容，Build 的结果之间互不影响
+	*info.MerchantName = "changed"
+	assert.Equal(t, "微信支付", *req.DisplayPatternInfo.MerchantName)
+	*req.StockSendRule.MaxCoupons = 1
+	assert.Equal(t, int64(100), *b.Build().StockSendRule.MaxCoupons)
+}
@@ -0,0 +1,1977 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家券API
+//
+// 创建商家券批次、修改批次预算、预存自定义券code与设置事件通知地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantexclusivecoupon
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// BusifavorStockType * `NORMAL` - 固定面额满减券批次 * `DISCOUNT` - 折扣券批次 * `EXCHANGE` - 换购券批次
+type BusifavorStockType string
+
+func (e BusifavorStockType) Ptr() *BusifavorStockType {
+	return &e
+}
+
+// Enums of BusifavorStockType
+const (
+	BUSIFAVORSTOCKTYPE_NORMAL   BusifavorStockType = "NORMAL"
+	BUSIFAVORSTOCKTYPE_DISCOUNT BusifavorStockType = "DISCOUNT"
+	BUSIFAVORSTOCKTYPE_EXCHANGE BusifavorStockType = "EXCHANGE"
+)
+
+func (v *BusifavorStockType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := BusifavorStockType(value)
+	for _, existing := range []BusifavorStockType{"NORMAL", "DISCOUNT", "EXCHANGE"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid BusifavorStockType", value)
+}
+
+// Callback
+type Callback struct {
+	// 商户号
+	Mchid *string `json:"mchid,omitempty"`
+	// 通知地址
+	NotifyUrl *string `json:"notify_url"`
+	// 修改时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+func (o Callback) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid != nil {
+		toSerialize["mchid"] = o.Mchid
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in Callback")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.UpdateTime != nil {
+		toSerialize["update_time"] = o.UpdateTime.Format(time.RFC3339)
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Callback) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.UpdateTime == nil {
+		ret += "UpdateTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("UpdateTime:%v", *o.UpdateTime)
+	}
+
+	return fmt.Sprintf("Callback{%s}", ret)
+}
+
+func (o Callback) Clone() *Callback {
+	ret := Callback{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.UpdateTime != nil {
+		ret.UpdateTime = new(time.Time)
+		*ret.UpdateTime = *o.UpdateTime
+	}
+
+	return &ret
+}
+
+// HasMchid 应答中是否返回了 mchid，o 为 nil 时返回 false
+func (o *Callback) HasMchid() bool {
+	return o != nil && o.Mchid != nil
+}
+
+// HasUpdateTime 应答中是否返回了 update_time，o 为 nil 时返回 false
+func (o *Callback) HasUpdateTime() bool {
+	return o != nil && o.UpdateTime != nil
+}
+
+// CouponCodeFail
+type CouponCodeFail struct {
+	// 上传失败的券code
+	CouponCode *string `json:"coupon_code"`
+	// 上传失败错误码
+	Code *string `json:"code"`
+	// 上传失败错误信息
+	Message *string `json:"message"`
+}
+
+func (o CouponCodeFail) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CouponCode == nil {
+		return nil, fmt.Errorf("field `CouponCode` is required and must be specified in CouponCodeFail")
+	}
+	toSerialize["coupon_code"] = o.CouponCode
+
+	if o.Code == nil {
+		return nil, fmt.Errorf("field `Code` is required and must be specified in CouponCodeFail")
+	}
+	toSerialize["code"] = o.Code
+
+	if o.Message == nil {
+		return nil, fmt.Errorf("field `Message` is required and must be specified in CouponCodeFail")
+	}
+	toSerialize["message"] = o.Message
+	return json.Marshal(toSerialize)
+}
+
+func (o CouponCodeFail) String() string {
+	var ret string
+	if o.CouponCode == nil {
+		ret += "CouponCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CouponCode:%v, ", *o.CouponCode)
+	}
+
+	if o.Code == nil {
+		ret += "Code:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Code:%v, ", *o.Code)
+	}
+
+	if o.Message == nil {
+		ret += "Message:<nil>"
+	} else {
+		ret += fmt.Sprintf("Message:%v", *o.Message)
+	}
+
+	return fmt.Sprintf("CouponCodeFail{%s}", ret)
+}
+
+func (o CouponCodeFail) Clone() *CouponCodeFail {
+	ret := CouponCodeFail{}
+
+	if o.CouponCode != nil {
+		ret.CouponCode = new(string)
+		*ret.CouponCode = *o.CouponCode
+	}
+
+	if o.Code != nil {
+		ret.Code = new(string)
+		*ret.Code = *o.Code
+	}
+
+	if o.Message != nil {
+		ret.Message = new(string)
+		*ret.Message = *o.Message
+	}
+
+	return &ret
+}
+
+// CouponCodeMode * `WECHATPAY_MODE` - 系统分配券code * `MERCHANT_API` - 商户发放时接口指定券code * `MERCHANT_UPLOAD` - 商户上传自定义code，发券时系统随机选取上传的券code
+type CouponCodeMode string
+
+func (e CouponCodeMode) Ptr() *CouponCodeMode {
+	return &e
+}
+
+// Enums of CouponCodeMode
+const (
+	COUPONCODEMODE_WECHATPAY_MODE  CouponCodeMode = "WECHATPAY_MODE"
+	COUPONCODEMODE_MERCHANT_API    CouponCodeMode = "MERCHANT_API"
+	COUPONCODEMODE_MERCHANT_UPLOAD CouponCodeMode = "MERCHANT_UPLOAD"
+)
+
+func (v *CouponCodeMode) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := CouponCodeMode(value)
+	for _, existing := range []CouponCodeMode{"WECHATPAY_MODE", "MERCHANT_API", "MERCHANT_UPLOAD"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid CouponCodeMode", value)
+}
+
+// CouponUseMethod * `OFF_LINE` - 线下滴码核销，点击券后出示二维码或条码 * `MINI_PROGRAMS` - 线上小程序核销 * `SELF_CONSUME` - 用户自助核销 * `PAYMENT_CODE` - 付款码核销
+type CouponUseMethod string
+
+func (e CouponUseMethod) Ptr() *CouponUseMethod {
+	return &e
+}
+
+// Enums of CouponUseMethod
+const (
+	COUPONUSEMETHOD_OFF_LINE      CouponUseMethod = "OFF_LINE"
+	COUPONUSEMETHOD_MINI_PROGRAMS CouponUseMethod = "MINI_PROGRAMS"
+	COUPONUSEMETHOD_SELF_CONSUME  CouponUseMethod = "SELF_CONSUME"
+	COUPONUSEMETHOD_PAYMENT_CODE  CouponUseMethod = "PAYMENT_CODE"
+)
+
+func (v *CouponUseMethod) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := CouponUseMethod(value)
+	for _, existing := range []CouponUseMethod{"OFF_LINE", "MINI_PROGRAMS", "SELF_CONSUME", "PAYMENT_CODE"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid CouponUseMethod", value)
+}
+
+// CouponUseRule
+type CouponUseRule struct {
+	// 券可核销时间
+	CouponAvailableTime *FavorAvailableTime `json:"coupon_available_time"`
+	// 固定面额满减券使用规则，批次类型为 NORMAL 时必填
+	FixedNormalCoupon *FixedValueStockMsg `json:"fixed_normal_coupon,omitempty"`
+	// 折扣券使用规则，批次类型为 DISCOUNT 时必填
+	DiscountCoupon *DiscountMsg `json:"discount_coupon,omitempty"`
+	// 换购券使用规则，批次类型为 EXCHANGE 时必填
+	ExchangeCoupon *ExchangeMsg `json:"exchange_coupon,omitempty"`
+	// 核销方式
+	UseMethod *CouponUseMethod `json:"use_method"`
+	// 小程序appid，核销方式为 MINI_PROGRAMS 时必填
+	MiniProgramsAppid *string `json:"mini_programs_appid,omitempty"`
+	// 小程序path，核销方式为 MINI_PROGRAMS 时必填
+	MiniProgramsPath *string `json:"mini_programs_path,omitempty"`
+}
+
+func (o CouponUseRule) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CouponAvailableTime == nil {
+		return nil, fmt.Errorf("field `CouponAvailableTime` is required and must be specified in CouponUseRule")
+	}
+	toSerialize["coupon_available_time"] = o.CouponAvailableTime
+
+	if o.FixedNormalCoupon != nil {
+		toSerialize["fixed_normal_coupon"] = o.FixedNormalCoupon
+	}
+
+	if o.DiscountCoupon != nil {
+		toSerialize["discount_coupon"] = o.DiscountCoupon
+	}
+
+	if o.ExchangeCoupon != nil {
+		toSerialize["exchange_coupon"] = o.ExchangeCoupon
+	}
+
+	if o.UseMethod == nil {
+		return nil, fmt.Errorf("field `UseMethod` is required and must be specified in CouponUseRule")
+	}
+	toSerialize["use_method"] = o.UseMethod
+
+	if o.MiniProgramsAppid != nil {
+		toSerialize["mini_programs_appid"] = o.MiniProgramsAppid
+	}
+
+	if o.MiniProgramsPath != nil {
+		toSerialize["mini_programs_path"] = o.MiniProgramsPath
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CouponUseRule) String() string {
+	var ret string
+	ret += fmt.Sprintf("CouponAvailableTime:%v, ", o.CouponAvailableTime)
+
+	ret += fmt.Sprintf("FixedNormalCoupon:%v, ", o.FixedNormalCoupon)
+
+	ret += fmt.Sprintf("DiscountCoupon:%v, ", o.DiscountCoupon)
+
+	ret += fmt.Sprintf("ExchangeCoupon:%v, ", o.ExchangeCoupon)
+
+	if o.UseMethod == nil {
+		ret += "UseMethod:<nil>, "
+	} else {
+		ret += fmt.Sprintf("UseMethod:%v, ", *o.UseMethod)
+	}
+
+	if o.MiniProgramsAppid == nil {
+		ret += "MiniProgramsAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MiniProgramsAppid:%v, ", *o.MiniProgramsAppid)
+	}
+
+	if o.MiniProgramsPath == nil {
+		ret += "MiniProgramsPath:<nil>"
+	} else {
+		ret += fmt.Sprintf("MiniProgramsPath:%v", *o.MiniProgramsPath)
+	}
+
+	return fmt.Sprintf("CouponUseRule{%s}", ret)
+}
+
+func (o CouponUseRule) Clone() *CouponUseRule {
+	ret := CouponUseRule{}
+
+	if o.CouponAvailableTime != nil {
+		ret.CouponAvailableTime = o.CouponAvailableTime.Clone()
+	}
+
+	if o.FixedNormalCoupon != nil {
+		ret.FixedNormalCoupon = o.FixedNormalCoupon.Clone()
+	}
+
+	if o.DiscountCoupon != nil {
+		ret.DiscountCoupon = o.DiscountCoupon.Clone()
+	}
+
+	if o.ExchangeCoupon != nil {
+		ret.ExchangeCoupon = o.ExchangeCoupon.Clone()
+	}
+
+	if o.UseMethod != nil {
+		ret.UseMethod = new(CouponUseMethod)
+		*ret.UseMethod = *o.UseMethod
+	}
+
+	if o.MiniProgramsAppid != nil {
+		ret.MiniProgramsAppid = new(string)
+		*ret.MiniProgramsAppid = *o.MiniProgramsAppid
+	}
+
+	if o.MiniProgramsPath != nil {
+		ret.MiniProgramsPath = new(string)
+		*ret.MiniProgramsPath = *o.MiniProgramsPath
+	}
+
+	return &ret
+}
+
+// CreateBusifavorStockBody
+type CreateBusifavorStockBody struct {
+	// 商家券批次名称，最多9个中文汉字
+	StockName *string `json:"stock_name"`
+	// 批次归属商户号
+	BelongMerchant *string `json:"belong_merchant"`
+	// 批次备注，仅配置商户可见
+	Comment *string `json:"comment,omitempty"`
+	// 适用商品范围，填写批次适用的商品或门店
+	GoodsName *string `json:"goods_name"`
+	// 批次类型
+	StockType *BusifavorStockType `json:"stock_type"`
+	// 核销规则
+	CouponUseRule *CouponUseRule `json:"coupon_use_rule"`
+	// 发放规则
+	StockSendRule *StockSendRule `json:"stock_send_rule"`
+	// 商户请求单号，商户创建批次的凭据号，需保持唯一性
+	OutRequestNo *string `json:"out_request_no"`
+	// 样式信息
+	DisplayPatternInfo *DisplayPatternInfo `json:"display_pattern_info,omitempty"`
+	// 券code码方式
+	CouponCodeMode *CouponCodeMode `json:"coupon_code_mode"`
+	// 事件通知配置
+	NotifyConfig *NotifyConfig `json:"notify_config,omitempty"`
+}
+
+func (o CreateBusifavorStockBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockName == nil {
+		return nil, fmt.Errorf("field `StockName` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["stock_name"] = o.StockName
+
+	if o.BelongMerchant == nil {
+		return nil, fmt.Errorf("field `BelongMerchant` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["belong_merchant"] = o.BelongMerchant
+
+	if o.Comment != nil {
+		toSerialize["comment"] = o.Comment
+	}
+
+	if o.GoodsName == nil {
+		return nil, fmt.Errorf("field `GoodsName` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["goods_name"] = o.GoodsName
+
+	if o.StockType == nil {
+		return nil, fmt.Errorf("field `StockType` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["stock_type"] = o.StockType
+
+	if o.CouponUseRule == nil {
+		return nil, fmt.Errorf("field `CouponUseRule` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["coupon_use_rule"] = o.CouponUseRule
+
+	if o.StockSendRule == nil {
+		return nil, fmt.Errorf("field `StockSendRule` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["stock_send_rule"] = o.StockSendRule
+
+	if o.OutRequestNo == nil {
+		return nil, fmt.Errorf("field `OutRequestNo` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["out_request_no"] = o.OutRequestNo
+
+	if o.DisplayPatternInfo != nil {
+		toSerialize["display_pattern_info"] = o.DisplayPatternInfo
+	}
+
+	if o.CouponCodeMode == nil {
+		return nil, fmt.Errorf("field `CouponCodeMode` is required and must be specified in CreateBusifavorStockBody")
+	}
+	toSerialize["coupon_code_mode"] = o.CouponCodeMode
+
+	if o.NotifyConfig != nil {
+		toSerialize["notify_config"] = o.NotifyConfig
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateBusifavorStockBody) String() string {
+	var ret string
+	if o.StockName == nil {
+		ret += "StockName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockName:%v, ", *o.StockName)
+	}
+
+	if o.BelongMerchant == nil {
+		ret += "BelongMerchant:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BelongMerchant:%v, ", *o.BelongMerchant)
+	}
+
+	if o.Comment == nil {
+		ret += "Comment:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Comment:%v, ", *o.Comment)
+	}
+
+	if o.GoodsName == nil {
+		ret += "GoodsName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsName:%v, ", *o.GoodsName)
+	}
+
+	if o.StockType == nil {
+		ret += "StockType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockType:%v, ", *o.StockType)
+	}
+
+	ret += fmt.Sprintf("CouponUseRule:%v, ", o.CouponUseRule)
+
+	ret += fmt.Sprintf("StockSendRule:%v, ", o.StockSendRule)
+
+	if o.OutRequestNo == nil {
+		ret += "OutRequestNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRequestNo:%v, ", *o.OutRequestNo)
+	}
+
+	ret += fmt.Sprintf("DisplayPatternInfo:%v, ", o.DisplayPatternInfo)
+
+	if o.CouponCodeMode == nil {
+		ret += "CouponCodeMode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CouponCodeMode:%v, ", *o.CouponCodeMode)
+	}
+
+	ret += fmt.Sprintf("NotifyConfig:%v", o.NotifyConfig)
+
+	return fmt.Sprintf("CreateBusifavorStockBody{%s}", ret)
+}
+
+func (o CreateBusifavorStockBody) Clone() *CreateBusifavorStockBody {
+	ret := CreateBusifavorStockBody{}
+
+	if o.StockName != nil {
+		ret.StockName = new(string)
+		*ret.StockName = *o.StockName
+	}
+
+	if o.BelongMerchant != nil {
+		ret.BelongMerchant = new(string)
+		*ret.BelongMerchant = *o.BelongMerchant
+	}
+
+	if o.Comment != nil {
+		ret.Comment = new(string)
+		*ret.Comment = *o.Comment
+	}
+
+	if o.GoodsName != nil {
+		ret.GoodsName = new(string)
+		*ret.GoodsName = *o.GoodsName
+	}
+
+	if o.StockType != nil {
+		ret.StockType = new(BusifavorStockType)
+		*ret.StockType = *o.StockType
+	}
+
+	if o.CouponUseRule != nil {
+		ret.CouponUseRule = o.CouponUseRule.Clone()
+	}
+
+	if o.StockSendRule != nil {
+		ret.StockSendRule = o.StockSendRule.Clone()
+	}
+
+	if o.OutRequestNo != nil {
+		ret.OutRequestNo = new(string)
+		*ret.OutRequestNo = *o.OutRequestNo
+	}
+
+	if o.DisplayPatternInfo != nil {
+		ret.DisplayPatternInfo = o.DisplayPatternInfo.Clone()
+	}
+
+	if o.CouponCodeMode != nil {
+		ret.CouponCodeMode = new(CouponCodeMode)
+		*ret.CouponCodeMode = *o.CouponCodeMode
+	}
+
+	if o.NotifyConfig != nil {
+		ret.NotifyConfig = o.NotifyConfig.Clone()
+	}
+
+	return &ret
+}
+
+// CreateBusifavorStockResponse
+type CreateBusifavorStockResponse struct {
+	// 批次号，微信为每个商家券批次分配的唯一ID
+	StockId *string `json:"stock_id"`
+	// 创建时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	CreateTime *time.Time `json:"create_time"`
+}
+
+func (o CreateBusifavorStockResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in CreateBusifavorStockResponse")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.CreateTime == nil {
+		return nil, fmt.Errorf("field `CreateTime` is required and must be specified in CreateBusifavorStockResponse")
+	}
+	toSerialize["create_time"] = o.CreateTime.Format(time.RFC3339)
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateBusifavorStockResponse) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.CreateTime == nil {
+		ret += "CreateTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("CreateTime:%v", *o.CreateTime)
+	}
+
+	return fmt.Sprintf("CreateBusifavorStockResponse{%s}", ret)
+}
+
+func (o CreateBusifavorStockResponse) Clone() *CreateBusifavorStockResponse {
+	ret := CreateBusifavorStockResponse{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.CreateTime != nil {
+		ret.CreateTime = new(time.Time)
+		*ret.CreateTime = *o.CreateTime
+	}
+
+	return &ret
+}
+
+// DiscountMsg
+type DiscountMsg struct {
+	// 折扣百分比，例如 88 为八八折
+	DiscountPercent *int64 `json:"discount_percent"`
+	// 消费门槛，单位为分
+	TransactionMinimum *int64 `json:"transaction_minimum"`
+}
+
+func (o DiscountMsg) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.DiscountPercent == nil {
+		return nil, fmt.Errorf("field `DiscountPercent` is required and must be specified in DiscountMsg")
+	}
+	toSerialize["discount_percent"] = o.DiscountPercent
+
+	if o.TransactionMinimum == nil {
+		return nil, fmt.Errorf("field `TransactionMinimum` is required and must be specified in DiscountMsg")
+	}
+	toSerialize["transaction_minimum"] = o.TransactionMinimum
+	return json.Marshal(toSerialize)
+}
+
+func (o DiscountMsg) String() string {
+	var ret string
+	if o.DiscountPercent == nil {
+		ret += "DiscountPercent:<nil>, "
+	} else {
+		ret += fmt.Sprintf("DiscountPercent:%v, ", *o.DiscountPercent)
+	}
+
+	if o.TransactionMinimum == nil {
+		ret += "TransactionMinimum:<nil>"
+	} else {
+		ret += fmt.Sprintf("TransactionMinimum:%v", *o.TransactionMinimum)
+	}
+
+	return fmt.Sprintf("DiscountMsg{%s}", ret)
+}
+
+func (o DiscountMsg) Clone() *DiscountMsg {
+	ret := DiscountMsg{}
+
+	if o.DiscountPercent != nil {
+		ret.DiscountPercent = new(int64)
+		*ret.DiscountPercent = *o.DiscountPercent
+	}
+
+	if o.TransactionMinimum != nil {
+		ret.TransactionMinimum = new(int64)
+		*ret.TransactionMinimum = *o.TransactionMinimum
+	}
+
+	return &ret
+}
+
+// DisplayPatternInfo
+type DisplayPatternInfo struct {
+	// 使用须知
+	Description *string `json:"description,omitempty"`
+	// 商户logo的URL地址，需通过营销图片上传接口获取
+	MerchantLogoUrl *string `json:"merchant_logo_url,omitempty"`
+	// 商户名称
+	MerchantName *string `json:"merchant_name,omitempty"`
+	// 背景颜色
+	BackgroundColor *string `json:"background_color,omitempty"`
+	// 券详情图片的URL地址，需通过营销图片上传接口获取
+	CouponImageUrl *string `json:"coupon_image_url,omitempty"`
+}
+
+func (o DisplayPatternInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Description != nil {
+		toSerialize["description"] = o.Description
+	}
+
+	if o.MerchantLogoUrl != nil {
+		toSerialize["merchant_logo_url"] = o.MerchantLogoUrl
+	}
+
+	if o.MerchantName != nil {
+		toSerialize["merchant_name"] = o.MerchantName
+	}
+
+	if o.BackgroundColor != nil {
+		toSerialize["background_color"] = o.BackgroundColor
+	}
+
+	if o.CouponImageUrl != nil {
+		toSerialize["coupon_image_url"] = o.CouponImageUrl
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o DisplayPatternInfo) String() string {
+	var ret string
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.MerchantLogoUrl == nil {
+		ret += "MerchantLogoUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantLogoUrl:%v, ", *o.MerchantLogoUrl)
+	}
+
+	if o.MerchantName == nil {
+		ret += "MerchantName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MerchantName:%v, ", *o.MerchantName)
+	}
+
+	if o.BackgroundColor == nil {
+		ret += "BackgroundColor:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BackgroundColor:%v, ", *o.BackgroundColor)
+	}
+
+	if o.CouponImageUrl == nil {
+		ret += "CouponImageUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("CouponImageUrl:%v", *o.CouponImageUrl)
+	}
+
+	return fmt.Sprintf("DisplayPatternInfo{%s}", ret)
+}
+
+func (o DisplayPatternInfo) Clone() *DisplayPatternInfo {
+	ret := DisplayPatternInfo{}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.MerchantLogoUrl != nil {
+		ret.MerchantLogoUrl = new(string)
+		*ret.MerchantLogoUrl = *o.MerchantLogoUrl
+	}
+
+	if o.MerchantName != nil {
+		ret.MerchantName = new(string)
+		*ret.MerchantName = *o.MerchantName
+	}
+
+	if o.BackgroundColor != nil {
+		ret.BackgroundColor = new(string)
+		*ret.BackgroundColor = *o.BackgroundColor
+	}
+
+	if o.CouponImageUrl != nil {
+		ret.CouponImageUrl = new(string)
+		*ret.CouponImageUrl = *o.CouponImageUrl
+	}
+
+	return &ret
+}
+
+// ExchangeMsg
+type ExchangeMsg struct {
+	// 单品换购价，单位为分
+	ExchangePrice *int64 `json:"exchange_price"`
+	// 消费门槛，单位为分
+	TransactionMinimum *int64 `json:"transaction_minimum"`
+}
+
+func (o ExchangeMsg) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ExchangePrice == nil {
+		return nil, fmt.Errorf("field `ExchangePrice` is required and must be specified in ExchangeMsg")
+	}
+	toSerialize["exchange_price"] = o.ExchangePrice
+
+	if o.TransactionMinimum == nil {
+		return nil, fmt.Errorf("field `TransactionMinimum` is required and must be specified in ExchangeMsg")
+	}
+	toSerialize["transaction_minimum"] = o.TransactionMinimum
+	return json.Marshal(toSerialize)
+}
+
+func (o ExchangeMsg) String() string {
+	var ret string
+	if o.ExchangePrice == nil {
+		ret += "ExchangePrice:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ExchangePrice:%v, ", *o.ExchangePrice)
+	}
+
+	if o.TransactionMinimum == nil {
+		ret += "TransactionMinimum:<nil>"
+	} else {
+		ret += fmt.Sprintf("TransactionMinimum:%v", *o.TransactionMinimum)
+	}
+
+	return fmt.Sprintf("ExchangeMsg{%s}", ret)
+}
+
+func (o ExchangeMsg) Clone() *ExchangeMsg {
+	ret := ExchangeMsg{}
+
+	if o.ExchangePrice != nil {
+		ret.ExchangePrice = new(int64)
+		*ret.ExchangePrice = *o.ExchangePrice
+	}
+
+	if o.TransactionMinimum != nil {
+		ret.TransactionMinimum = new(int64)
+		*ret.TransactionMinimum = *o.TransactionMinimum
+	}
+
+	return &ret
+}
+
+// FavorAvailableTime
+type FavorAvailableTime struct {
+	// 批次开始时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	AvailableBeginTime *time.Time `json:"available_begin_time"`
+	// 批次结束时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	AvailableEndTime *time.Time `json:"available_end_time"`
+	// 生效后N天内有效，与 wait_days_after_receive 一同使用时表示领取后第 wait_days_after_receive 天生效
+	AvailableDayAfterReceive *int64 `json:"available_day_after_receive,omitempty"`
+	// 领取后N天开始生效
+	WaitDaysAfterReceive *int64 `json:"wait_days_after_receive,omitempty"`
+}
+
+func (o FavorAvailableTime) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.AvailableBeginTime == nil {
+		return nil, fmt.Errorf("field `AvailableBeginTime` is required and must be specified in FavorAvailableTime")
+	}
+	toSerialize["available_begin_time"] = o.AvailableBeginTime.Format(time.RFC3339)
+
+	if o.AvailableEndTime == nil {
+		return nil, fmt.Errorf("field `AvailableEndTime` is required and must be specified in FavorAvailableTime")
+	}
+	toSerialize["available_end_time"] = o.AvailableEndTime.Format(time.RFC3339)
+
+	if o.AvailableDayAfterReceive != nil {
+		toSerialize["available_day_after_receive"] = o.AvailableDayAfterReceive
+	}
+
+	if o.WaitDaysAfterReceive != nil {
+		toSerialize["wait_days_after_receive"] = o.WaitDaysAfterReceive
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o FavorAvailableTime) String() string {
+	var ret string
+	if o.AvailableBeginTime == nil {
+		ret += "AvailableBeginTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AvailableBeginTime:%v, ", *o.AvailableBeginTime)
+	}
+
+	if o.AvailableEndTime == nil {
+		ret += "AvailableEndTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AvailableEndTime:%v, ", *o.AvailableEndTime)
+	}
+
+	if o.AvailableDayAfterReceive == nil {
+		ret += "AvailableDayAfterReceive:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AvailableDayAfterReceive:%v, ", *o.AvailableDayAfterReceive)
+	}
+
+	if o.WaitDaysAfterReceive == nil {
+		ret += "WaitDaysAfterReceive:<nil>"
+	} else {
+		ret += fmt.Sprintf("WaitDaysAfterReceive:%v", *o.WaitDaysAfterReceive)
+	}
+
+	return fmt.Sprintf("FavorAvailableTime{%s}", ret)
+}
+
+func (o FavorAvailableTime) Clone() *FavorAvailableTime {
+	ret := FavorAvailableTime{}
+
+	if o.AvailableBeginTime != nil {
+		ret.AvailableBeginTime = new(time.Time)
+		*ret.AvailableBeginTime = *o.AvailableBeginTime
+	}
+
+	if o.AvailableEndTime != nil {
+		ret.AvailableEndTime = new(time.Time)
+		*ret.AvailableEndTime = *o.AvailableEndTime
+	}
+
+	if o.AvailableDayAfterReceive != nil {
+		ret.AvailableDayAfterReceive = new(int64)
+		*ret.AvailableDayAfterReceive = *o.AvailableDayAfterReceive
+	}
+
+	if o.WaitDaysAfterReceive != nil {
+		ret.WaitDaysAfterReceive = new(int64)
+		*ret.WaitDaysAfterReceive = *o.WaitDaysAfterReceive
+	}
+
+	return &ret
+}
+
+// FixedValueStockMsg
+type FixedValueStockMsg struct {
+	// 优惠金额，单位为分
+	DiscountAmount *int64 `json:"discount_amount"`
+	// 消费门槛，单位为分
+	TransactionMinimum *int64 `json:"transaction_minimum"`
+}
+
+func (o FixedValueStockMsg) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.DiscountAmount == nil {
+		return nil, fmt.Errorf("field `DiscountAmount` is required and must be specified in FixedValueStockMsg")
+	}
+	toSerialize["discount_amount"] = o.DiscountAmount
+
+	if o.TransactionMinimum == nil {
+		return nil, fmt.Errorf("field `TransactionMinimum` is required and must be specified in FixedValueStockMsg")
+	}
+	toSerialize["transaction_minimum"] = o.TransactionMinimum
+	return json.Marshal(toSerialize)
+}
+
+func (o FixedValueStockMsg) String() string {
+	var ret string
+	if o.DiscountAmount == nil {
+		ret += "DiscountAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("DiscountAmount:%v, ", *o.DiscountAmount)
+	}
+
+	if o.TransactionMinimum == nil {
+		ret += "TransactionMinimum:<nil>"
+	} else {
+		ret += fmt.Sprintf("TransactionMinimum:%v", *o.TransactionMinimum)
+	}
+
+	return fmt.Sprintf("FixedValueStockMsg{%s}", ret)
+}
+
+func (o FixedValueStockMsg) Clone() *FixedValueStockMsg {
+	ret := FixedValueStockMsg{}
+
+	if o.DiscountAmount != nil {
+		ret.DiscountAmount = new(int64)
+		*ret.DiscountAmount = *o.DiscountAmount
+	}
+
+	if o.TransactionMinimum != nil {
+		ret.TransactionMinimum = new(int64)
+		*ret.TransactionMinimum = *o.TransactionMinimum
+	}
+
+	return &ret
+}
+
+// ModifyBudgetBody
+type ModifyBudgetBody struct {
+	// 目标批次最大发放个数，与 target_max_coupons_by_day 二选一
+	TargetMaxCoupons *int64 `json:"target_max_coupons,omitempty"`
+	// 当前批次最大发放个数，设置 target_max_coupons 时必填
+	CurrentMaxCoupons *int64 `json:"current_max_coupons,omitempty"`
+	// 目标单天最大发放个数，与 target_max_coupons 二选一
+	TargetMaxCouponsByDay *int64 `json:"target_max_coupons_by_day,omitempty"`
+	// 当前单天最大发放个数，设置 target_max_coupons_by_day 时必填
+	CurrentMaxCouponsByDay *int64 `json:"current_max_coupons_by_day,omitempty"`
+	// 修改预算请求单据号，商户修改预算的凭据号，需保持唯一性
+	ModifyBudgetRequestNo *string `json:"modify_budget_request_no"`
+}
+
+func (o ModifyBudgetBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TargetMaxCoupons != nil {
+		toSerialize["target_max_coupons"] = o.TargetMaxCoupons
+	}
+
+	if o.CurrentMaxCoupons != nil {
+		toSerialize["current_max_coupons"] = o.CurrentMaxCoupons
+	}
+
+	if o.TargetMaxCouponsByDay != nil {
+		toSerialize["target_max_coupons_by_day"] = o.TargetMaxCouponsByDay
+	}
+
+	if o.CurrentMaxCouponsByDay != nil {
+		toSerialize["current_max_coupons_by_day"] = o.CurrentMaxCouponsByDay
+	}
+
+	if o.ModifyBudgetRequestNo == nil {
+		return nil, fmt.Errorf("field `ModifyBudgetRequestNo` is required and must be specified in ModifyBudgetBody")
+	}
+	toSerialize["modify_budget_request_no"] = o.ModifyBudgetRequestNo
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifyBudgetBody) String() string {
+	var ret string
+	if o.TargetMaxCoupons == nil {
+		ret += "TargetMaxCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TargetMaxCoupons:%v, ", *o.TargetMaxCoupons)
+	}
+
+	if o.CurrentMaxCoupons == nil {
+		ret += "CurrentMaxCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CurrentMaxCoupons:%v, ", *o.CurrentMaxCoupons)
+	}
+
+	if o.TargetMaxCouponsByDay == nil {
+		ret += "TargetMaxCouponsByDay:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TargetMaxCouponsByDay:%v, ", *o.TargetMaxCouponsByDay)
+	}
+
+	if o.CurrentMaxCouponsByDay == nil {
+		ret += "CurrentMaxCouponsByDay:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CurrentMaxCouponsByDay:%v, ", *o.CurrentMaxCouponsByDay)
+	}
+
+	if o.ModifyBudgetRequestNo == nil {
+		ret += "ModifyBudgetRequestNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("ModifyBudgetRequestNo:%v", *o.ModifyBudgetRequestNo)
+	}
+
+	return fmt.Sprintf("ModifyBudgetBody{%s}", ret)
+}
+
+func (o ModifyBudgetBody) Clone() *ModifyBudgetBody {
+	ret := ModifyBudgetBody{}
+
+	if o.TargetMaxCoupons != nil {
+		ret.TargetMaxCoupons = new(int64)
+		*ret.TargetMaxCoupons = *o.TargetMaxCoupons
+	}
+
+	if o.CurrentMaxCoupons != nil {
+		ret.CurrentMaxCoupons = new(int64)
+		*ret.CurrentMaxCoupons = *o.CurrentMaxCoupons
+	}
+
+	if o.TargetMaxCouponsByDay != nil {
+		ret.TargetMaxCouponsByDay = new(int64)
+		*ret.TargetMaxCouponsByDay = *o.TargetMaxCouponsByDay
+	}
+
+	if o.CurrentMaxCouponsByDay != nil {
+		ret.CurrentMaxCouponsByDay = new(int64)
+		*ret.CurrentMaxCouponsByDay = *o.CurrentMaxCouponsByDay
+	}
+
+	if o.ModifyBudgetRequestNo != nil {
+		ret.ModifyBudgetRequestNo = new(string)
+		*ret.ModifyBudgetRequestNo = *o.ModifyBudgetRequestNo
+	}
+
+	return &ret
+}
+
+// ModifyBudgetRequest
+type ModifyBudgetRequest struct {
+	// 批次号
+	StockId *string `json:"stock_id"`
+	// 目标批次最大发放个数，与 target_max_coupons_by_day 二选一
+	TargetMaxCoupons *int64 `json:"target_max_coupons,omitempty"`
+	// 当前批次最大发放个数，设置 target_max_coupons 时必填
+	CurrentMaxCoupons *int64 `json:"current_max_coupons,omitempty"`
+	// 目标单天最大发放个数，与 target_max_coupons 二选一
+	TargetMaxCouponsByDay *int64 `json:"target_max_coupons_by_day,omitempty"`
+	// 当前单天最大发放个数，设置 target_max_coupons_by_day 时必填
+	CurrentMaxCouponsByDay *int64 `json:"current_max_coupons_by_day,omitempty"`
+	// 修改预算请求单据号，商户修改预算的凭据号，需保持唯一性
+	ModifyBudgetRequestNo *string `json:"modify_budget_request_no"`
+}
+
+func (o ModifyBudgetRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in ModifyBudgetRequest")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.TargetMaxCoupons != nil {
+		toSerialize["target_max_coupons"] = o.TargetMaxCoupons
+	}
+
+	if o.CurrentMaxCoupons != nil {
+		toSerialize["current_max_coupons"] = o.CurrentMaxCoupons
+	}
+
+	if o.TargetMaxCouponsByDay != nil {
+		toSerialize["target_max_coupons_by_day"] = o.TargetMaxCouponsByDay
+	}
+
+	if o.CurrentMaxCouponsByDay != nil {
+		toSerialize["current_max_coupons_by_day"] = o.CurrentMaxCouponsByDay
+	}
+
+	if o.ModifyBudgetRequestNo == nil {
+		return nil, fmt.Errorf("field `ModifyBudgetRequestNo` is required and must be specified in ModifyBudgetRequest")
+	}
+	toSerialize["modify_budget_request_no"] = o.ModifyBudgetRequestNo
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifyBudgetRequest) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.TargetMaxCoupons == nil {
+		ret += "TargetMaxCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TargetMaxCoupons:%v, ", *o.TargetMaxCoupons)
+	}
+
+	if o.CurrentMaxCoupons == nil {
+		ret += "CurrentMaxCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CurrentMaxCoupons:%v, ", *o.CurrentMaxCoupons)
+	}
+
+	if o.TargetMaxCouponsByDay == nil {
+		ret += "TargetMaxCouponsByDay:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TargetMaxCouponsByDay:%v, ", *o.TargetMaxCouponsByDay)
+	}
+
+	if o.CurrentMaxCouponsByDay == nil {
+		ret += "CurrentMaxCouponsByDay:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CurrentMaxCouponsByDay:%v, ", *o.CurrentMaxCouponsByDay)
+	}
+
+	if o.ModifyBudgetRequestNo == nil {
+		ret += "ModifyBudgetRequestNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("ModifyBudgetRequestNo:%v", *o.ModifyBudgetRequestNo)
+	}
+
+	return fmt.Sprintf("ModifyBudgetRequest{%s}", ret)
+}
+
+func (o ModifyBudgetRequest) Clone() *ModifyBudgetRequest {
+	ret := ModifyBudgetRequest{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.TargetMaxCoupons != nil {
+		ret.TargetMaxCoupons = new(int64)
+		*ret.TargetMaxCoupons = *o.TargetMaxCoupons
+	}
+
+	if o.CurrentMaxCoupons != nil {
+		ret.CurrentMaxCoupons = new(int64)
+		*ret.CurrentMaxCoupons = *o.CurrentMaxCoupons
+	}
+
+	if o.TargetMaxCouponsByDay != nil {
+		ret.TargetMaxCouponsByDay = new(int64)
+		*ret.TargetMaxCouponsByDay = *o.TargetMaxCouponsByDay
+	}
+
+	if o.CurrentMaxCouponsByDay != nil {
+		ret.CurrentMaxCouponsByDay = new(int64)
+		*ret.CurrentMaxCouponsByDay = *o.CurrentMaxCouponsByDay
+	}
+
+	if o.ModifyBudgetRequestNo != nil {
+		ret.ModifyBudgetRequestNo = new(string)
+		*ret.ModifyBudgetRequestNo = *o.ModifyBudgetRequestNo
+	}
+
+	return &ret
+}
+
+// ModifyBudgetResponse
+type ModifyBudgetResponse struct {
+	// 批次当前最大发放个数
+	MaxCoupons *int64 `json:"max_coupons,omitempty"`
+	// 当前单天最大发放个数
+	MaxCouponsByDay *int64 `json:"max_coupons_by_day,omitempty"`
+}
+
+func (o ModifyBudgetResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.MaxCoupons != nil {
+		toSerialize["max_coupons"] = o.MaxCoupons
+	}
+
+	if o.MaxCouponsByDay != nil {
+		toSerialize["max_coupons_by_day"] = o.MaxCouponsByDay
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifyBudgetResponse) String() string {
+	var ret string
+	if o.MaxCoupons == nil {
+		ret += "MaxCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxCoupons:%v, ", *o.MaxCoupons)
+	}
+
+	if o.MaxCouponsByDay == nil {
+		ret += "MaxCouponsByDay:<nil>"
+	} else {
+		ret += fmt.Sprintf("MaxCouponsByDay:%v", *o.MaxCouponsByDay)
+	}
+
+	return fmt.Sprintf("ModifyBudgetResponse{%s}", ret)
+}
+
+func (o ModifyBudgetResponse) Clone() *ModifyBudgetResponse {
+	ret := ModifyBudgetResponse{}
+
+	if o.MaxCoupons != nil {
+		ret.MaxCoupons = new(int64)
+		*ret.MaxCoupons = *o.MaxCoupons
+	}
+
+	if o.MaxCouponsByDay != nil {
+		ret.MaxCouponsByDay = new(int64)
+		*ret.MaxCouponsByDay = *o.MaxCouponsByDay
+	}
+
+	return &ret
+}
+
+// HasMaxCoupons 应答中是否返回了 max_coupons，o 为 nil 时返回 false
+func (o *ModifyBudgetResponse) HasMaxCoupons() bool {
+	return o != nil && o.MaxCoupons != nil
+}
+
+// HasMaxCouponsByDay 应答中是否返回了 max_coupons_by_day，o 为 nil 时返回 false
+func (o *ModifyBudgetResponse) HasMaxCouponsByDay() bool {
+	return o != nil && o.MaxCouponsByDay != nil
+}
+
+// NotifyConfig
+type NotifyConfig struct {
+	// 用于接收事件通知的公众号或小程序appid
+	NotifyAppid *string `json:"notify_appid,omitempty"`
+}
+
+func (o NotifyConfig) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.NotifyAppid != nil {
+		toSerialize["notify_appid"] = o.NotifyAppid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o NotifyConfig) String() string {
+	var ret string
+	if o.NotifyAppid == nil {
+		ret += "NotifyAppid:<nil>"
+	} else {
+		ret += fmt.Sprintf("NotifyAppid:%v", *o.NotifyAppid)
+	}
+
+	return fmt.Sprintf("NotifyConfig{%s}", ret)
+}
+
+func (o NotifyConfig) Clone() *NotifyConfig {
+	ret := NotifyConfig{}
+
+	if o.NotifyAppid != nil {
+		ret.NotifyAppid = new(string)
+		*ret.NotifyAppid = *o.NotifyAppid
+	}
+
+	return &ret
+}
+
+// QueryCallbacksRequest
+type QueryCallbacksRequest struct {
+	// 商户号
+	Mchid *string `json:"mchid"`
+}
+
+func (o QueryCallbacksRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in QueryCallbacksRequest")
+	}
+	toSerialize["mchid"] = o.Mchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryCallbacksRequest) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("Mchid:%v", *o.Mchid)
+	}
+
+	return fmt.Sprintf("QueryCallbacksRequest{%s}", ret)
+}
+
+func (o QueryCallbacksRequest) Clone() *QueryCallbacksRequest {
+	ret := QueryCallbacksRequest{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	return &ret
+}
+
+// QueryCouponCodeUploadRequest
+type QueryCouponCodeUploadRequest struct {
+	// 批次号，微信为每个商家券批次分配的唯一ID
+	StockId *string `json:"stock_id"`
+	// 上传请求单号，与上传预存code时使用的上传请求单号一致
+	UploadRequestNo *string `json:"upload_request_no"`
+}
+
+func (o QueryCouponCodeUploadRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in QueryCouponCodeUploadRequest")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.UploadRequestNo == nil {
+		return nil, fmt.Errorf("field `UploadRequestNo` is required and must be specified in QueryCouponCodeUploadRequest")
+	}
+	toSerialize["upload_request_no"] = o.UploadRequestNo
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryCouponCodeUploadRequest) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.UploadRequestNo == nil {
+		ret += "UploadRequestNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("UploadRequestNo:%v", *o.UploadRequestNo)
+	}
+
+	return fmt.Sprintf("QueryCouponCodeUploadRequest{%s}", ret)
+}
+
+func (o QueryCouponCodeUploadRequest) Clone() *QueryCouponCodeUploadRequest {
+	ret := QueryCouponCodeUploadRequest{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.UploadRequestNo != nil {
+		ret.UploadRequestNo = new(string)
+		*ret.UploadRequestNo = *o.UploadRequestNo
+	}
+
+	return &ret
+}
+
+// SetCallbacksBody
+type SetCallbacksBody struct {
+	// 商户号，不填默认为调用方商户号
+	Mchid *string `json:"mchid,omitempty"`
+	// 通知地址，用于接收商家券事件通知的url，需为不带查询参数的https地址
+	NotifyUrl *string `json:"notify_url"`
+}
+
+func (o SetCallbacksBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid != nil {
+		toSerialize["mchid"] = o.Mchid
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in SetCallbacksBody")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o SetCallbacksBody) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v", *o.NotifyUrl)
+	}
+
+	return fmt.Sprintf("SetCallbacksBody{%s}", ret)
+}
+
+func (o SetCallbacksBody) Clone() *SetCallbacksBody {
+	ret := SetCallbacksBody{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	return &ret
+}
+
+// StockSendRule
+type StockSendRule struct {
+	// 批次总预算，单位为分，仅在发券时由微信支付出资等场景使用
+	MaxAmount *int64 `json:"max_amount,omitempty"`
+	// 批次最大发放个数
+	MaxCoupons *int64 `json:"max_coupons"`
+	// 用户最大可领个数
+	MaxCouponsPerUser *int64 `json:"max_coupons_per_user"`
+	// 单天发放上限金额，单位为分
+	MaxAmountByDay *int64 `json:"max_amount_by_day,omitempty"`
+	// 单天发放上限个数
+	MaxCouponsByDay *int64 `json:"max_coupons_by_day,omitempty"`
+	// 是否开启一个自然人限制领取
+	NaturalPersonLimit *bool `json:"natural_person_limit,omitempty"`
+	// 是否开启防刷拦截
+	PreventApiAbuse *bool `json:"prevent_api_abuse,omitempty"`
+	// 是否允许转赠
+	Transferable *bool `json:"transferable,omitempty"`
+	// 是否允许分享领券链接
+	Shareable *bool `json:"shareable,omitempty"`
+}
+
+func (o StockSendRule) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.MaxAmount != nil {
+		toSerialize["max_amount"] = o.MaxAmount
+	}
+
+	if o.MaxCoupons == nil {
+		return nil, fmt.Errorf("field `MaxCoupons` is required and must be specified in StockSendRule")
+	}
+	toSerialize["max_coupons"] = o.MaxCoupons
+
+	if o.MaxCouponsPerUser == nil {
+		return nil, fmt.Errorf("field `MaxCouponsPerUser` is required and must be specified in StockSendRule")
+	}
+	toSerialize["max_coupons_per_user"] = o.MaxCouponsPerUser
+
+	if o.MaxAmountByDay != nil {
+		toSerialize["max_amount_by_day"] = o.MaxAmountByDay
+	}
+
+	if o.MaxCouponsByDay != nil {
+		toSerialize["max_coupons_by_day"] = o.MaxCouponsByDay
+	}
+
+	if o.NaturalPersonLimit != nil {
+		toSerialize["natural_person_limit"] = o.NaturalPersonLimit
+	}
+
+	if o.PreventApiAbuse != nil {
+		toSerialize["prevent_api_abuse"] = o.PreventApiAbuse
+	}
+
+	if o.Transferable != nil {
+		toSerialize["transferable"] = o.Transferable
+	}
+
+	if o.Shareable != nil {
+		toSerialize["shareable"] = o.Shareable
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o StockSendRule) String() string {
+	var ret string
+	if o.MaxAmount == nil {
+		ret += "MaxAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxAmount:%v, ", *o.MaxAmount)
+	}
+
+	if o.MaxCoupons == nil {
+		ret += "MaxCoupons:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxCoupons:%v, ", *o.MaxCoupons)
+	}
+
+	if o.MaxCouponsPerUser == nil {
+		ret += "MaxCouponsPerUser:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxCouponsPerUser:%v, ", *o.MaxCouponsPerUser)
+	}
+
+	if o.MaxAmountByDay == nil {
+		ret += "MaxAmountByDay:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxAmountByDay:%v, ", *o.MaxAmountByDay)
+	}
+
+	if o.MaxCouponsByDay == nil {
+		ret += "MaxCouponsByDay:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MaxCouponsByDay:%v, ", *o.MaxCouponsByDay)
+	}
+
+	if o.NaturalPersonLimit == nil {
+		ret += "NaturalPersonLimit:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NaturalPersonLimit:%v, ", *o.NaturalPersonLimit)
+	}
+
+	if o.PreventApiAbuse == nil {
+		ret += "PreventApiAbuse:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PreventApiAbuse:%v, ", *o.PreventApiAbuse)
+	}
+
+	if o.Transferable == nil {
+		ret += "Transferable:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Transferable:%v, ", *o.Transferable)
+	}
+
+	if o.Shareable == nil {
+		ret += "Shareable:<nil>"
+	} else {
+		ret += fmt.Sprintf("Shareable:%v", *o.Shareable)
+	}
+
+	return fmt.Sprintf("StockSendRule{%s}", ret)
+}
+
+func (o StockSendRule) Clone() *StockSendRule {
+	ret := StockSendRule{}
+
+	if o.MaxAmount != nil {
+		ret.MaxAmount = new(int64)
+		*ret.MaxAmount = *o.MaxAmount
+	}
+
+	if o.MaxCoupons != nil {
+		ret.MaxCoupons = new(int64)
+		*ret.MaxCoupons = *o.MaxCoupons
+	}
+
+	if o.MaxCouponsPerUser != nil {
+		ret.MaxCouponsPerUser = new(int64)
+		*ret.MaxCouponsPerUser = *o.MaxCouponsPerUser
+	}
+
+	if o.MaxAmountByDay != nil {
+		ret.MaxAmountByDay = new(int64)
+		*ret.MaxAmountByDay = *o.MaxAmountByDay
+	}
+
+	if o.MaxCouponsByDay != nil {
+		ret.MaxCouponsByDay = new(int64)
+		*ret.MaxCouponsByDay = *o.MaxCouponsByDay
+	}
+
+	if o.NaturalPersonLimit != nil {
+		ret.NaturalPersonLimit = new(bool)
+		*ret.NaturalPersonLimit = *o.NaturalPersonLimit
+	}
+
+	if o.PreventApiAbuse != nil {
+		ret.PreventApiAbuse = new(bool)
+		*ret.PreventApiAbuse = *o.PreventApiAbuse
+	}
+
+	if o.Transferable != nil {
+		ret.Transferable = new(bool)
+		*ret.Transferable = *o.Transferable
+	}
+
+	if o.Shareable != nil {
+		ret.Shareable = new(bool)
+		*ret.Shareable = *o.Shareable
+	}
+
+	return &ret
+}
+
+// UploadCouponCodesBody
+type UploadCouponCodesBody struct {
+	// 券code列表，每次最多200个
+	CouponCodeList []string `json:"coupon_code_list"`
+	// 上传请求单号，商户上传code的凭据号，商户侧需保持唯一性
+	UploadRequestNo *string `json:"upload_request_no"`
+}
+
+func (o UploadCouponCodesBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CouponCodeList == nil {
+		return nil, fmt.Errorf("field `CouponCodeList` is required and must be specified in UploadCouponCodesBody")
+	}
+	toSerialize["coupon_code_list"] = o.CouponCodeList
+
+	if o.UploadRequestNo == nil {
+		return nil, fmt.Errorf("field `UploadRequestNo` is required and must be specified in UploadCouponCodesBody")
+	}
+	toSerialize["upload_request_no"] = o.UploadRequestNo
+	return json.Marshal(toSerialize)
+}
+
+func (o UploadCouponCodesBody) String() string {
+	var ret string
+	ret += fmt.Sprintf("CouponCodeList:%v, ", o.CouponCodeList)
+
+	if o.UploadRequestNo == nil {
+		ret += "UploadRequestNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("UploadRequestNo:%v", *o.UploadRequestNo)
+	}
+
+	return fmt.Sprintf("UploadCouponCodesBody{%s}", ret)
+}
+
+func (o UploadCouponCodesBody) Clone() *UploadCouponCodesBody {
+	ret := UploadCouponCodesBody{}
+
+	if o.CouponCodeList != nil {
+		ret.CouponCodeList = make([]string, len(o.CouponCodeList))
+		for i, item := range o.CouponCodeList {
+			ret.CouponCodeList[i] = item
+		}
+	}
+
+	if o.UploadRequestNo != nil {
+		ret.UploadRequestNo = new(string)
+		*ret.UploadRequestNo = *o.UploadRequestNo
+	}
+
+	return &ret
+}
+
+// UploadCouponCodesRequest
+type UploadCouponCodesRequest struct {
+	// 批次号，微信为每个商家券批次分配的唯一ID
+	StockId *string `json:"stock_id"`
+	// 券code列表，每次最多200个
+	CouponCodeList []string `json:"coupon_code_list"`
+	// 上传请求单号，商户上传code的凭据号，商户侧需保持唯一性
+	UploadRequestNo *string `json:"upload_request_no"`
+}
+
+func (o UploadCouponCodesRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in UploadCouponCodesRequest")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.CouponCodeList == nil {
+		return nil, fmt.Errorf("field `CouponCodeList` is required and must be specified in UploadCouponCodesRequest")
+	}
+	toSerialize["coupon_code_list"] = o.CouponCodeList
+
+	if o.UploadRequestNo == nil {
+		return nil, fmt.Errorf("field `UploadRequestNo` is required and must be specified in UploadCouponCodesRequest")
+	}
+	toSerialize["upload_request_no"] = o.UploadRequestNo
+	return json.Marshal(toSerialize)
+}
+
+func (o UploadCouponCodesRequest) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	ret += fmt.Sprintf("CouponCodeList:%v, ", o.CouponCodeList)
+
+	if o.UploadRequestNo == nil {
+		ret += "UploadRequestNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("UploadRequestNo:%v", *o.UploadRequestNo)
+	}
+
+	return fmt.Sprintf("UploadCouponCodesRequest{%s}", ret)
+}
+
+func (o UploadCouponCodesRequest) Clone() *UploadCouponCodesRequest {
+	ret := UploadCouponCodesRequest{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.CouponCodeList != nil {
+		ret.CouponCodeList = make([]string, len(o.CouponCodeList))
+		for i, item := range o.CouponCodeList {
+			ret.CouponCodeList[i] = item
+		}
+	}
+
+	if o.UploadRequestNo != nil {
+		ret.UploadRequestNo = new(string)
+		*ret.UploadRequestNo = *o.UploadRequestNo
+	}
+
+	return &ret
+}
+
+// UploadCouponCodesResponse
+type UploadCouponCodesResponse struct {
+	// 批次号
+	StockId *string `json:"stock_id"`
+	// 去重后上传code总数
+	TotalCount *int64 `json:"total_count"`
+	// 上传成功code个数
+	SuccessCount *int64 `json:"success_count"`
+	// 上传成功的code列表
+	SuccessCodes []string `json:"success_codes,omitempty"`
+	// 上传成功时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	SuccessTime *time.Time `json:"success_time"`
+	// 上传失败code个数
+	FailCount *int64 `json:"fail_count,omitempty"`
+	// 上传失败的code及原因
+	FailCodes []CouponCodeFail `json:"fail_codes,omitempty"`
+	// 已存在的code列表，此前已上传过的code
+	ExistCodes []string `json:"exist_codes,omitempty"`
+	// 本次请求中重复的code列表
+	DuplicateCodes []string `json:"duplicate_codes,omitempty"`
+}
+
+func (o UploadCouponCodesResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in UploadCouponCodesResponse")
+	}
+	toSerialize["stock_id"] = o.StockId
+
+	if o.TotalCount == nil {
+		return nil, fmt.Errorf("field `TotalCount` is required and must be specified in UploadCouponCodesResponse")
+	}
+	toSerialize["total_count"] = o.TotalCount
+
+	if o.SuccessCount == nil {
+		return nil, fmt.Errorf("field `SuccessCount` is required and must be specified in UploadCouponCodesResponse")
+	}
+	toSerialize["success_count"] = o.SuccessCount
+
+	if o.SuccessCodes != nil {
+		toSerialize["success_codes"] = o.SuccessCodes
+	}
+
+	if o.SuccessTime == nil {
+		return nil, fmt.Errorf("field `SuccessTime` is required and must be specified in UploadCouponCodesResponse")
+	}
+	toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+
+	if o.FailCount != nil {
+		toSerialize["fail_count"] = o.FailCount
+	}
+
+	if o.FailCodes != nil {
+		toSerialize["fail_codes"] = o.FailCodes
+	}
+
+	if o.ExistCodes != nil {
+		toSerialize["exist_codes"] = o.ExistCodes
+	}
+
+	if o.DuplicateCodes != nil {
+		toSerialize["duplicate_codes"] = o.DuplicateCodes
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o UploadCouponCodesResponse) String() string {
+	var ret string
+	if o.StockId == nil {
+		ret += "StockId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StockId:%v, ", *o.StockId)
+	}
+
+	if o.TotalCount == nil {
+		ret += "TotalCount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalCount:%v, ", *o.TotalCount)
+	}
+
+	if o.SuccessCount == nil {
+		ret += "SuccessCount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessCount:%v, ", *o.SuccessCount)
+	}
+
+	ret += fmt.Sprintf("SuccessCodes:%v, ", o.SuccessCodes)
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	if o.FailCount == nil {
+		ret += "FailCount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FailCount:%v, ", *o.FailCount)
+	}
+
+	ret += fmt.Sprintf("FailCodes:%v, ", o.FailCodes)
+
+	ret += fmt.Sprintf("ExistCodes:%v, ", o.ExistCodes)
+
+	ret += fmt.Sprintf("DuplicateCodes:%v", o.DuplicateCodes)
+
+	return fmt.Sprintf("UploadCouponCodesResponse{%s}", ret)
+}
+
+func (o UploadCouponCodesResponse) Clone() *UploadCouponCodesResponse {
+	ret := UploadCouponCodesResponse{}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	if o.TotalCount != nil {
+		ret.TotalCount = new(int64)
+		*ret.TotalCount = *o.TotalCount
+	}
+
+	if o.SuccessCount != nil {
+		ret.SuccessCount = new(int64)
+		*ret.SuccessCount = *o.SuccessCount
+	}
+
+	if o.SuccessCodes != nil {
+		ret.SuccessCodes = make([]string, len(o.SuccessCodes))
+		for i, item := range o.SuccessCodes {
+			ret.SuccessCodes[i] = item
+		}
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.FailCount != nil {
+		ret.FailCount = new(int64)
+		*ret.FailCount = *o.FailCount
+	}
+
+	if o.FailCodes != nil {
+		ret.FailCodes = make([]CouponCodeFail, len(o.FailCodes))
+		for i, item := range o.FailCodes {
+			ret.FailCodes[i] = *item.Clone()
+		}
+	}
+
+	if o.ExistCodes != nil {
+		ret.ExistCodes = make([]string, len(o.ExistCodes))
+		for i, item := range o.ExistCodes {
+			ret.ExistCodes[i] = item
+		}
+	}
+
+	if o.DuplicateCodes != nil {
+		ret.DuplicateCodes = make([]string, len(o.DuplicateCodes))
+		for i, item := range o.DuplicateCodes {
+			ret.DuplicateCodes[i] = item
+		}
+	}
+
+	return &ret
+}
+
+// HasSuccessCodes 应答中是否返回了 success_codes，o 为 nil 时返回 false
+func (o *UploadCouponCodesResponse) HasSuccessCodes() bool {
+	return o != nil && o.SuccessCodes != nil
+}
+
+// HasFailCount 应答中是否返回了 fail_count，o 为 nil 时返回 false
+func (o *UploadCouponCodesResponse) HasFailCount() bool {
+	return o != nil && o.FailCount != nil
+}
+
+// HasFailCodes 应答中是否返回了 fail_codes，o 为 nil 时返回 false
+func (o *UploadCouponCodesResponse) HasFailCodes() bool {
+	return o != nil && o.FailCodes != nil
+}
+
+// HasExistCodes 应答中是否返回了 exist_codes，o 为 nil 时返回 false
+func (o *UploadCouponCodesResponse) HasExistCodes() bool {
+	return o != nil && o.ExistCodes != nil
+}
+
+// HasDuplicateCodes 应答中是否返回了 duplicate_codes，o 为 nil 时返回 false
+func (o *UploadCouponCodesResponse) HasDuplicateCodes() bool {
+	return o != nil && o.DuplicateCodes != nil
+}
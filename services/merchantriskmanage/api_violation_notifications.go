@@ -0,0 +1,203 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商户违规通知回调API
+//
+// 服务商创建、查询、修改与删除商户平台处置通知回调地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantriskmanage
+
+import (
+	"context"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ViolationNotificationsApiService services.Service
+
+// CreateViolationNotification 创建商户违规通知回调地址
+//
+// # 应用场景
+// 服务商可以通过该接口设置接收商户违规通知的回调地址，每个服务商只能设置一个回调地址。
+//
+// 注意：
+// 1、回调地址必须为https协议的URL，且不能携带参数
+// 2、设置回调地址后，服务商名下的子商户被处罚、拦截或处罚状态变化时，微信支付会向该地址发送违规通知，通知类型以VIOLATION.开头
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|回调地址已存在|请使用修改商户违规通知回调地址接口|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ViolationNotificationsApiService) CreateViolationNotification(ctx context.Context, req NotifyUrlBody) (resp *NotifyUrlEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/merchant-risk-manage/violation-notifications"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract NotifyUrlEntity from Http Response
+	resp = new(NotifyUrlEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// DeleteViolationNotification 删除商户违规通知回调地址
+//
+// # 应用场景
+// 服务商可以通过该接口删除已设置的商户违规通知回调地址，删除后将不再接收商户违规通知。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|回调地址不存在|尚未设置回调地址|请确认是否已删除|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ViolationNotificationsApiService) DeleteViolationNotification(ctx context.Context) (result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodDelete
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/merchant-risk-manage/violation-notifications"
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// QueryViolationNotification 查询商户违规通知回调地址
+//
+// # 应用场景
+// 服务商可以通过该接口查询已设置的商户违规通知回调地址。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|回调地址不存在|尚未设置回调地址|请先调用创建商户违规通知回调地址接口|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ViolationNotificationsApiService) QueryViolationNotification(ctx context.Context) (resp *NotifyUrlEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/merchant-risk-manage/violation-notifications"
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract NotifyUrlEntity from Http Response
+	resp = new(NotifyUrlEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// UpdateViolationNotification 修改商户违规通知回调地址
+//
+// # 应用场景
+// 服务商可以通过该接口修改已设置的商户违规通知回调地址。
+//
+// 注意：
+// 1、回调地址必须为https协议的URL，且不能携带参数
+// 2、设置回调地址后，服务商名下的子商户被处罚、拦截或处罚状态变化时，微信支付会向该地址发送违规通知，通知类型以VIOLATION.开头
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|回调地址不存在|尚未设置回调地址|请先调用创建商户违规通知回调地址接口|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ViolationNotificationsApiService) UpdateViolationNotification(ctx context.Context, req NotifyUrlBody) (resp *NotifyUrlEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPut
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/merchant-risk-manage/violation-notifications"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract NotifyUrlEntity from Http Response
+	resp = new(NotifyUrlEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商户违规通知回调API
+//
+// 服务商创建、查询、修改与删除商户平台处置通知回调地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantriskmanage_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantriskmanage"
+)
+
+func ExampleViolationNotificationsApiService_CreateViolationNotification() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantriskmanage.ViolationNotificationsApiService{Client: client}
+	resp, result, err := svc.CreateViolationNotification(ctx,
+		merchantriskmanage.NotifyUrlBody{
+			NotifyUrl: core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleViolationNotificationsApiService_DeleteViolationNotification() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantriskmanage.ViolationNotificationsApiService{Client: client}
+	result, err := svc.DeleteViolationNotification(ctx)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleViolationNotificationsApiService_QueryViolationNotification() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantriskmanage.ViolationNotificationsApiService{Client: client}
+	resp, result, err := svc.QueryViolationNotification(ctx)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleViolationNotificationsApiService_UpdateViolationNotification() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantriskmanage.ViolationNotificationsApiService{Client: client}
+	resp, result, err := svc.UpdateViolationNotification(ctx,
+		merchantriskmanage.NotifyUrlBody{
+			NotifyUrl: core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
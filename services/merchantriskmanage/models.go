@@ -0,0 +1,115 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商户违规通知回调API
+//
+// 服务商创建、查询、修改与删除商户平台处置通知回调地址的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantriskmanage
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// NotifyUrlBody
+type NotifyUrlBody struct {
+	// 商户违规通知回调地址，必须为https协议的URL，且不能携带参数
+	NotifyUrl *string `json:"notify_url"`
+}
+
+func (o NotifyUrlBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in NotifyUrlBody")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o NotifyUrlBody) String() string {
+	var ret string
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v", *o.NotifyUrl)
+	}
+
+	return fmt.Sprintf("NotifyUrlBody{%s}", ret)
+}
+
+func (o NotifyUrlBody) Clone() *NotifyUrlBody {
+	ret := NotifyUrlBody{}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	return &ret
+}
+
+// NotifyUrlEntity
+type NotifyUrlEntity struct {
+	// 商户违规通知回调地址，必须为https协议的URL，且不能携带参数
+	NotifyUrl *string `json:"notify_url"`
+	// 回调地址的更新时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+func (o NotifyUrlEntity) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in NotifyUrlEntity")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.UpdateTime != nil {
+		toSerialize["update_time"] = o.UpdateTime.Format(time.RFC3339)
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o NotifyUrlEntity) String() string {
+	var ret string
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.UpdateTime == nil {
+		ret += "UpdateTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("UpdateTime:%v", *o.UpdateTime)
+	}
+
+	return fmt.Sprintf("NotifyUrlEntity{%s}", ret)
+}
+
+func (o NotifyUrlEntity) Clone() *NotifyUrlEntity {
+	ret := NotifyUrlEntity{}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.UpdateTime != nil {
+		ret.UpdateTime = new(time.Time)
+		*ret.UpdateTime = *o.UpdateTime
+	}
+
+	return &ret
+}
+
+// HasUpdateTime 应答中是否返回了 update_time，o 为 nil 时返回 false
+func (o *NotifyUrlEntity) HasUpdateTime() bool {
+	return o != nil && o.UpdateTime != nil
+}
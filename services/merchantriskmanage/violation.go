@@ -0,0 +1,60 @@
+package merchantriskmanage
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// 商户违规通知的通知类型（event_type）
+const (
+	// EventTypePrefix 所有商户违规通知类型的公共前缀
+	EventTypePrefix = "VIOLATION."
+	// EventTypePunish 商户被处罚
+	EventTypePunish = "VIOLATION.PUNISH"
+	// EventTypeIntercept 商户被拦截
+	EventTypeIntercept = "VIOLATION.INTERCEPT"
+	// EventTypeAppeal 商户申诉结果
+	EventTypeAppeal = "VIOLATION.APPEAL"
+)
+
+// Violation 商户违规通知解密后的内容
+type Violation struct {
+	// SubMchid 被处置的子商户号
+	SubMchid string `json:"sub_mchid"`
+	// CompanyName 子商户的公司名称
+	CompanyName string `json:"company_name"`
+	// RecordId 处置记录ID，同一处置事件的处罚、拦截与申诉结果通知使用相同的记录ID
+	RecordId string `json:"record_id"`
+	// PunishPlan 处罚方案，如 关闭支付权限
+	PunishPlan string `json:"punish_plan"`
+	// PunishTime 处罚时间
+	PunishTime *time.Time `json:"punish_time,omitempty"`
+	// PunishDescription 处罚方案描述
+	PunishDescription string `json:"punish_description"`
+	// RiskType 风险类型，如 套现
+	RiskType string `json:"risk_type"`
+	// RiskDescription 风险描述
+	RiskDescription string `json:"risk_description"`
+}
+
+// ViolationHandleFunc 商户违规通知处理函数，返回值的含义同 notify.HandleFunc
+type ViolationHandleFunc func(ctx context.Context, req *notify.Request, violation *Violation) error
+
+// HandleViolation 将 fn 包装为 notify.HandleFunc：解析通知内容后调用 fn，内容无法解析时以 notify.Reject 应答
+func HandleViolation(fn ViolationHandleFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		violation := new(Violation)
+		if err := req.UnmarshalContent(violation); err != nil {
+			return notify.Reject(err)
+		}
+		return fn(ctx, req, violation)
+	}
+}
+
+// RouteViolations 在 router 上注册处理所有商户违规通知（通知类型以 EventTypePrefix 开头）的 fn，
+// 处理函数可以通过 req.EventType 区分处罚、拦截与申诉结果通知
+func RouteViolations(router *notify.Router, fn ViolationHandleFunc) {
+	router.HandlePrefix(EventTypePrefix, HandleViolation(fn))
+}
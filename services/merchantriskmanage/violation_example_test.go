@@ -0,0 +1,36 @@
+package merchantriskmanage_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantriskmanage"
+)
+
+func ExampleRouteViolations() {
+	router := notify.NewRouter()
+	merchantriskmanage.RouteViolations(router,
+		func(ctx context.Context, req *notify.Request, violation *merchantriskmanage.Violation) error {
+			switch req.EventType {
+			case merchantriskmanage.EventTypePunish:
+				// 通知合规团队处理被处罚的子商户
+				fmt.Printf("%s punished: %s (%s)\n", violation.SubMchid, violation.PunishPlan, violation.RiskType)
+			case merchantriskmanage.EventTypeIntercept:
+				fmt.Printf("%s intercepted: %s\n", violation.SubMchid, violation.RiskType)
+			}
+			return nil
+		},
+	)
+
+	// 实际使用时，将 router.Dispatch 作为 notify.Handler.HTTPHandler 的处理函数，由其完成验签与解密
+	_ = router.Dispatch(context.Background(), &notify.Request{
+		EventType: merchantriskmanage.EventTypePunish,
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"sub_mchid":"1900000109","company_name":"示例公司","record_id":"200201820200101080076610000",` +
+				`"punish_plan":"关闭支付权限","punish_time":"2020-01-01T08:00:00+08:00","risk_type":"套现"}`,
+		},
+	})
+	// Output:
+	// 1900000109 punished: 关闭支付权限 (套现)
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 消费者投诉2.0API
+//
+// 商户处理消费者投诉的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantservice
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ComplaintsApiService services.Service
+
+// ListComplaints 查询投诉单列表
+//
+// # 应用场景
+// 商户可以通过该接口查询指定时间段内的投诉单列表。
+//
+// 注意：
+// 1、开始日期与结束日期之间的间隔不能超过30天
+// 2、需要遍历全部投诉单时可以使用 MerchantService.IterateComplaints 自动翻页
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ComplaintsApiService) ListComplaints(ctx context.Context, req ListComplaintsRequest) (resp *QueryComplaintsResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/merchant-service/complaints-v2"
+	// Make sure All Required Params are properly set
+	if req.BeginDate == nil {
+		return nil, nil, fmt.Errorf("field `BeginDate` is required and must be specified in ListComplaintsRequest")
+	}
+	if req.EndDate == nil {
+		return nil, nil, fmt.Errorf("field `EndDate` is required and must be specified in ListComplaintsRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.Limit != nil {
+		localVarQueryParams.Add("limit", core.ParameterToString(*req.Limit, ""))
+	}
+	if req.Offset != nil {
+		localVarQueryParams.Add("offset", core.ParameterToString(*req.Offset, ""))
+	}
+	localVarQueryParams.Add("begin_date", core.ParameterToString(*req.BeginDate, ""))
+	localVarQueryParams.Add("end_date", core.ParameterToString(*req.EndDate, ""))
+	if req.ComplaintedMchid != nil {
+		localVarQueryParams.Add("complainted_mchid", core.ParameterToString(*req.ComplaintedMchid, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract QueryComplaintsResponse from Http Response
+	resp = new(QueryComplaintsResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// ResponseComplaint 回复用户
+//
+// # 应用场景
+// 商户可以通过该接口回复用户的投诉，回复内容与凭证图片将展示在用户的投诉详情中。
+//
+// 注意：
+// 1、回复凭证图片需先通过 MerchantService.UploadComplaintImage 上传（商户上传反馈图片API），使用返回的 media_id
+// 2、每次回复最多上传4张凭证图片
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|投诉单不存在|投诉单号有误|请确认投诉单号后重试|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ComplaintsApiService) ResponseComplaint(ctx context.Context, req ResponseComplaintRequest) (result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.ComplaintId == nil {
+		return nil, fmt.Errorf("field `ComplaintId` is required and must be specified in ResponseComplaintRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/merchant-service/complaints-v2/{complaint_id}/response"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"complaint_id"+"}", neturl.PathEscape(core.ParameterToString(*req.ComplaintId, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &ResponseComplaintBody{
+		ComplaintedMchid: req.ComplaintedMchid,
+		ResponseContent:  req.ResponseContent,
+		ResponseImages:   req.ResponseImages,
+		JumpUrl:          req.JumpUrl,
+		JumpUrlText:      req.JumpUrlText,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
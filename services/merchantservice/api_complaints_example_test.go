@@ -0,0 +1,63 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 消费者投诉2.0API
+//
+// 商户处理消费者投诉的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantservice_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantservice"
+)
+
+func ExampleComplaintsApiService_ListComplaints() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantservice.ComplaintsApiService{Client: client}
+	resp, result, err := svc.ListComplaints(ctx,
+		merchantservice.ListComplaintsRequest{
+			BeginDate:        core.String("2019-01-01"),
+			ComplaintedMchid: core.String("1900012181"),
+			EndDate:          core.String("2019-01-01"),
+			Limit:            core.Int64(5),
+			Offset:           core.Int64(10),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleComplaintsApiService_ResponseComplaint() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantservice.ComplaintsApiService{Client: client}
+	result, err := svc.ResponseComplaint(ctx,
+		merchantservice.ResponseComplaintRequest{
+			ComplaintId:      core.String("200201820200101080076610000"),
+			ComplaintedMchid: core.String("1900012181"),
+			JumpUrl:          core.String("https://www.xxxx.com/notify"),
+			JumpUrlText:      core.String("查看订单详情"),
+			ResponseContent:  core.String("已与用户沟通解决"),
+			ResponseImages:   []string{"file23578_21798531.jpg"},
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
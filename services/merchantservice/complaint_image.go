@@ -0,0 +1,102 @@
+package merchantservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/fileuploader"
+)
+
+const (
+	// MaxComplaintImageSize 回复凭证图片的最大字节数
+	MaxComplaintImageSize = 2 * 1024 * 1024
+	// MaxComplaintResponseImages 每次回复用户最多附带的凭证图片数量
+	MaxComplaintResponseImages = 4
+)
+
+// ComplaintImage 待上传的回复凭证图片
+type ComplaintImage struct {
+	// Reader 图片内容
+	Reader io.Reader
+	// Filename 图片文件名，扩展名需与图片格式一致，如 evidence.jpg
+	Filename string
+	// ContentType 图片的 MIME 类型，仅支持 image/jpeg（或 image/jpg）、image/png 与 image/bmp
+	ContentType string
+}
+
+// UploadedComplaintImage 已上传的回复凭证图片
+type UploadedComplaintImage struct {
+	// MediaId 微信支付返回的媒体文件标识，用于 ResponseComplaintRequest.ResponseImages
+	MediaId string
+	// SHA256 图片内容的 SHA256 摘要（十六进制），下载该图片时可用于 WithExpectedSHA256
+	SHA256 string
+}
+
+// UploadComplaintImage 通过商户上传反馈图片API上传回复用户时附带的凭证图片
+//
+// 上传前检查图片格式（jpg、png、bmp）与大小（不超过 2M），避免无效的上传请求。
+// opts 可以设置上传进度回调与失败重传，见 fileuploader.WithProgress 与 fileuploader.WithRetry。
+func (s *MerchantService) UploadComplaintImage(
+	ctx context.Context, image ComplaintImage, opts ...fileuploader.UploadOption,
+) (*UploadedComplaintImage, *core.APIResult, error) {
+	if !isComplaintImageType(image.ContentType) {
+		return nil, nil, fmt.Errorf("complaint image %s: unsupported content type %q, only jpg, png and bmp are allowed",
+			image.Filename, image.ContentType)
+	}
+	if image.Reader == nil {
+		return nil, nil, fmt.Errorf("complaint image %s: reader is required", image.Filename)
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(image.Reader, MaxComplaintImageSize+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read complaint image %s err:%v", image.Filename, err)
+	}
+	if len(content) > MaxComplaintImageSize {
+		return nil, nil, fmt.Errorf("complaint image %s exceeds %d bytes", image.Filename, MaxComplaintImageSize)
+	}
+
+	uploader := fileuploader.MchBizUploader{Client: s.Client}
+	resp, result, err := uploader.Upload(ctx, bytes.NewReader(content), image.Filename, image.ContentType, opts...)
+	if err != nil {
+		return nil, result, err
+	}
+	if resp.MediaId == nil || *resp.MediaId == "" {
+		return nil, result, fmt.Errorf("upload complaint image %s: no media_id in response", image.Filename)
+	}
+	digest := sha256.Sum256(content)
+	return &UploadedComplaintImage{MediaId: *resp.MediaId, SHA256: hex.EncodeToString(digest[:])}, result, nil
+}
+
+// UploadComplaintImages 依次上传多张回复凭证图片，返回可直接用于 ResponseComplaintRequest.ResponseImages 的 media_id 列表
+//
+// 图片数量不能超过 MaxComplaintResponseImages。任意一张图片上传失败时返回错误，已上传的图片无需处理。
+func (s *MerchantService) UploadComplaintImages(
+	ctx context.Context, images []ComplaintImage, opts ...fileuploader.UploadOption,
+) ([]string, error) {
+	if len(images) > MaxComplaintResponseImages {
+		return nil, fmt.Errorf("at most %d complaint images are allowed, got %d", MaxComplaintResponseImages, len(images))
+	}
+	mediaIDs := make([]string, 0, len(images))
+	for _, image := range images {
+		uploaded, _, err := s.UploadComplaintImage(ctx, image, opts...)
+		if err != nil {
+			return nil, err
+		}
+		mediaIDs = append(mediaIDs, uploaded.MediaId)
+	}
+	return mediaIDs, nil
+}
+
+func isComplaintImageType(contentType string) bool {
+	switch strings.ToLower(strings.TrimSpace(contentType)) {
+	case "image/jpeg", "image/jpg", "image/png", "image/bmp":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package merchantservice_test
+
+import (
+	"context"
+	"os"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantservice"
+)
+
+func ExampleMerchantService_UploadComplaintImages() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	file, err := os.Open("evidence.jpg")
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	svc := merchantservice.MerchantService{Client: client}
+	mediaIDs, err := svc.UploadComplaintImages(ctx, []merchantservice.ComplaintImage{
+		{Reader: file, Filename: "evidence.jpg", ContentType: "image/jpeg"},
+	})
+	if err != nil {
+		return
+	}
+
+	// 回复用户时附带已上传的凭证图片
+	api := merchantservice.ComplaintsApiService{Client: client}
+	result, err := api.ResponseComplaint(ctx,
+		merchantservice.ResponseComplaintRequest{
+			ComplaintId:      core.String("200201820200101080076610000"),
+			ComplaintedMchid: core.String("1900012181"),
+			ResponseContent:  core.String("已与用户沟通解决"),
+			ResponseImages:   mediaIDs,
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
@@ -0,0 +1,165 @@
+package merchantservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+const (
+	// MaxComplaintQueryDays 查询投诉单列表时开始日期与结束日期的最大间隔天数
+	MaxComplaintQueryDays = 30
+	// MaxComplaintPageSize 查询投诉单列表时的最大分页大小，也是 ComplaintFilter 默认使用的分页大小
+	MaxComplaintPageSize = 50
+)
+
+const complaintDateLayout = "2006-01-02"
+
+// ComplaintFilter 查询投诉单列表的筛选条件
+//
+// 日期按 BeginDate、EndDate 自身的时区取年月日，请使用东八区时间，如 time.Date(2021, 6, 1, 0, 0, 0, 0, loc)。
+type ComplaintFilter struct {
+	// BeginDate 投诉发生的开始日期，必填
+	BeginDate time.Time
+	// EndDate 投诉发生的结束日期，必填，与 BeginDate 间隔不能超过 MaxComplaintQueryDays 天
+	EndDate time.Time
+	// States 需要的投诉单状态，为空时不按状态筛选
+	//
+	// 查询投诉单列表API不支持按状态查询，IterateComplaints 在本地跳过其他状态的投诉单
+	States []ComplaintState
+	// ComplaintedMchid 被诉商户号，服务商、渠道商查询子商户的投诉单时填写
+	ComplaintedMchid string
+	// PageSize 每页的投诉单数量，范围 [1, MaxComplaintPageSize]，为 0 时使用 MaxComplaintPageSize
+	PageSize int64
+}
+
+// Validate 检查筛选条件是否满足查询投诉单列表API的要求
+func (f ComplaintFilter) Validate() error {
+	if f.BeginDate.IsZero() || f.EndDate.IsZero() {
+		return fmt.Errorf("complaint filter: begin date and end date are required")
+	}
+	begin, end := civilDate(f.BeginDate), civilDate(f.EndDate)
+	if end.Before(begin) {
+		return fmt.Errorf("complaint filter: end date %s is before begin date %s",
+			end.Format(complaintDateLayout), begin.Format(complaintDateLayout))
+	}
+	if days := int(end.Sub(begin).Hours() / 24); days > MaxComplaintQueryDays {
+		return fmt.Errorf("complaint filter: date range of %d days exceeds %d days", days, MaxComplaintQueryDays)
+	}
+	if f.PageSize < 0 || f.PageSize > MaxComplaintPageSize {
+		return fmt.Errorf("complaint filter: page size %d is out of range [1, %d]", f.PageSize, MaxComplaintPageSize)
+	}
+	for _, state := range f.States {
+		if state != COMPLAINTSTATE_PENDING && state != COMPLAINTSTATE_PROCESSING && state != COMPLAINTSTATE_PROCESSED {
+			return fmt.Errorf("complaint filter: %s is not a valid ComplaintState", state)
+		}
+	}
+	return nil
+}
+
+// Request 校验筛选条件，并构造从 offset 开始查询一页投诉单的请求
+func (f ComplaintFilter) Request(offset int64) (ListComplaintsRequest, error) {
+	if err := f.Validate(); err != nil {
+		return ListComplaintsRequest{}, err
+	}
+	req := ListComplaintsRequest{
+		Limit:     core.Int64(f.pageSize()),
+		Offset:    core.Int64(offset),
+		BeginDate: core.String(f.BeginDate.Format(complaintDateLayout)),
+		EndDate:   core.String(f.EndDate.Format(complaintDateLayout)),
+	}
+	if f.ComplaintedMchid != "" {
+		req.ComplaintedMchid = core.String(f.ComplaintedMchid)
+	}
+	return req, nil
+}
+
+func (f ComplaintFilter) pageSize() int64 {
+	if f.PageSize == 0 {
+		return MaxComplaintPageSize
+	}
+	return f.PageSize
+}
+
+func (f ComplaintFilter) match(complaint *ComplaintInfo) bool {
+	if len(f.States) == 0 {
+		return true
+	}
+	if complaint.ComplaintState == nil {
+		return false
+	}
+	for _, state := range f.States {
+		if state == *complaint.ComplaintState {
+			return true
+		}
+	}
+	return false
+}
+
+// civilDate 返回 t 在其自身时区下的日期，用于计算相差的天数
+func civilDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// ComplaintIterator 按页查询并遍历满足筛选条件的投诉单
+type ComplaintIterator struct {
+	api    ComplaintsApiService
+	filter ComplaintFilter
+	offset int64
+	page   []ComplaintInfo
+	index  int
+	done   bool
+}
+
+// IterateComplaints 创建遍历投诉单列表的 ComplaintIterator，筛选条件不合法时返回错误
+//
+// 迭代器在当前页遍历完毕后才请求下一页，可以在遍历过程的任意位置停止。
+func (s *MerchantService) IterateComplaints(filter ComplaintFilter) (*ComplaintIterator, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return &ComplaintIterator{api: ComplaintsApiService{Client: s.Client}, filter: filter}, nil
+}
+
+// Next 返回下一个满足筛选条件的投诉单，遍历结束时返回 io.EOF
+//
+// 请求失败时返回错误，再次调用 Next 会重新请求失败的那一页。
+func (it *ComplaintIterator) Next(ctx context.Context) (*ComplaintInfo, error) {
+	for {
+		for it.index < len(it.page) {
+			complaint := &it.page[it.index]
+			it.index++
+			if it.filter.match(complaint) {
+				return complaint, nil
+			}
+		}
+		if it.done {
+			return nil, io.EOF
+		}
+		if err := it.fetch(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (it *ComplaintIterator) fetch(ctx context.Context) error {
+	req, err := it.filter.Request(it.offset)
+	if err != nil {
+		return err
+	}
+	resp, _, err := it.api.ListComplaints(ctx, req)
+	if err != nil {
+		return fmt.Errorf("list complaints from offset %d err:%w", it.offset, err)
+	}
+
+	it.page, it.index = resp.Data, 0
+	it.offset += int64(len(resp.Data))
+	if int64(len(resp.Data)) < *req.Limit || (resp.TotalCount != nil && it.offset >= *resp.TotalCount) {
+		it.done = true
+	}
+	return nil
+}
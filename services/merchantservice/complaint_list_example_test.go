@@ -0,0 +1,58 @@
+package merchantservice_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantservice"
+)
+
+func ExampleMerchantService_IterateComplaints() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	loc := time.FixedZone("CST", 8*3600)
+	svc := merchantservice.MerchantService{Client: client}
+	it, err := svc.IterateComplaints(merchantservice.ComplaintFilter{
+		BeginDate: time.Date(2021, 6, 1, 0, 0, 0, 0, loc),
+		EndDate:   time.Date(2021, 6, 30, 0, 0, 0, 0, loc),
+		States:    []merchantservice.ComplaintState{merchantservice.COMPLAINTSTATE_PENDING},
+	})
+	if err != nil {
+		return
+	}
+
+	for {
+		complaint, err := it.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// TODO: 处理请求失败，再次调用 Next 会重试当前页
+			return
+		}
+		// TODO: 处理待处理的投诉单
+		_ = complaint
+	}
+}
+
+func ExampleComplaintFilter_Validate() {
+	loc := time.FixedZone("CST", 8*3600)
+	filter := merchantservice.ComplaintFilter{
+		BeginDate: time.Date(2021, 6, 1, 0, 0, 0, 0, loc),
+		EndDate:   time.Date(2021, 7, 1, 23, 59, 59, 0, loc),
+	}
+	fmt.Println(filter.Validate())
+
+	filter.EndDate = time.Date(2021, 7, 2, 0, 0, 0, 0, loc)
+	fmt.Println(filter.Validate())
+	// Output:
+	// <nil>
+	// complaint filter: date range of 31 days exceeds 30 days
+}
@@ -0,0 +1,268 @@
+package merchantservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantservice"
+)
+
+var cst = time.FixedZone("CST", 8*3600)
+
+func complaintDate(month time.Month, day int) time.Time {
+	return time.Date(2021, month, day, 0, 0, 0, 0, cst)
+}
+
+// fakeComplaintServer 按 limit 与 offset 分页应答 states 对应的投诉单，failures 不为 0 时应答系统错误并减 1
+type fakeComplaintServer struct {
+	lock      sync.Mutex
+	states    []merchantservice.ComplaintState
+	omitTotal bool
+	failures  int
+	queries   []url.Values
+}
+
+func (s *fakeComplaintServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	query := r.URL.Query()
+	s.queries = append(s.queries, query)
+	if s.failures > 0 {
+		s.failures--
+		clienttest.WriteError(w, http.StatusInternalServerError, "SYSTEM_ERROR", "系统错误")
+		return
+	}
+
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	var data []map[string]interface{}
+	for i := offset; i < len(s.states) && i < offset+limit; i++ {
+		data = append(data, map[string]interface{}{
+			"complaint_id":    fmt.Sprintf("2002%04d", i),
+			"complaint_time":  "2021-06-01T10:00:00+08:00",
+			"complaint_state": s.states[i],
+		})
+	}
+	resp := map[string]interface{}{"data": data, "limit": limit, "offset": offset}
+	if !s.omitTotal {
+		resp["total_count"] = len(s.states)
+	}
+	clienttest.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (s *fakeComplaintServer) requests() []url.Values {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]url.Values(nil), s.queries...)
+}
+
+func collectComplaints(ctx context.Context, it *merchantservice.ComplaintIterator) ([]string, error) {
+	var ids []string
+	for {
+		complaint, err := it.Next(ctx)
+		if err == io.EOF {
+			return ids, nil
+		}
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, *complaint.ComplaintId)
+	}
+}
+
+func TestComplaintFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  merchantservice.ComplaintFilter
+		wantErr bool
+	}{
+		{name: "valid", filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 30)}},
+		{name: "same day", filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 1)}},
+		{name: "max range", filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(7, 1)}},
+		{name: "range too long", filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(7, 2)}, wantErr: true},
+		// 按日期而不是时刻计算间隔
+		{
+			name:   "max range ignores time of day",
+			filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1).Add(23 * time.Hour), EndDate: complaintDate(7, 1)},
+		},
+		{name: "end before begin", filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 2), EndDate: complaintDate(6, 1)}, wantErr: true},
+		{name: "missing begin date", filter: merchantservice.ComplaintFilter{EndDate: complaintDate(6, 1)}, wantErr: true},
+		{name: "missing end date", filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1)}, wantErr: true},
+		{
+			name:   "max page size",
+			filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 1), PageSize: merchantservice.MaxComplaintPageSize},
+		},
+		{
+			name:    "page size too large",
+			filter:  merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 1), PageSize: merchantservice.MaxComplaintPageSize + 1},
+			wantErr: true,
+		},
+		{name: "negative page size", filter: merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 1), PageSize: -1}, wantErr: true},
+		{
+			name: "valid states",
+			filter: merchantservice.ComplaintFilter{
+				BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 1),
+				States: []merchantservice.ComplaintState{merchantservice.COMPLAINTSTATE_PENDING, merchantservice.COMPLAINTSTATE_PROCESSED},
+			},
+		},
+		{
+			name:    "invalid state",
+			filter:  merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 1), States: []merchantservice.ComplaintState{"CLOSED"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestComplaintFilter_Request(t *testing.T) {
+	filter := merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 30)}
+	req, err := filter.Request(100)
+	require.NoError(t, err)
+	assert.Equal(t, merchantservice.ListComplaintsRequest{
+		Limit:     core.Int64(merchantservice.MaxComplaintPageSize),
+		Offset:    core.Int64(100),
+		BeginDate: core.String("2021-06-01"),
+		EndDate:   core.String("2021-06-30"),
+	}, req)
+
+	filter.PageSize = 10
+	filter.ComplaintedMchid = "1900012181"
+	req, err = filter.Request(0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), *req.Limit)
+	assert.Equal(t, "1900012181", *req.ComplaintedMchid)
+
+	filter.EndDate = time.Time{}
+	_, err = filter.Request(0)
+	assert.Error(t, err)
+}
+
+func TestIterateComplaints(t *testing.T) {
+	pending, processing, processed := merchantservice.COMPLAINTSTATE_PENDING, merchantservice.COMPLAINTSTATE_PROCESSING, merchantservice.COMPLAINTSTATE_PROCESSED
+	tests := []struct {
+		name      string
+		states    []merchantservice.ComplaintState
+		omitTotal bool
+		filter    []merchantservice.ComplaintState
+		want      []string
+		offsets   []string
+	}{
+		{
+			name:    "stop at total count",
+			states:  []merchantservice.ComplaintState{pending, processing, processed, pending},
+			want:    []string{"20020000", "20020001", "20020002", "20020003"},
+			offsets: []string{"0", "2"},
+		},
+		{
+			// 未返回投诉总条数时，查询到不满一页的结果为止
+			name:      "stop at short page",
+			states:    []merchantservice.ComplaintState{pending, processing, processed, pending},
+			omitTotal: true,
+			want:      []string{"20020000", "20020001", "20020002", "20020003"},
+			offsets:   []string{"0", "2", "4"},
+		},
+		{
+			name:    "filter by state",
+			states:  []merchantservice.ComplaintState{pending, processing, processed, pending, processed},
+			filter:  []merchantservice.ComplaintState{pending, processing},
+			want:    []string{"20020000", "20020001", "20020003"},
+			offsets: []string{"0", "2", "4"},
+		},
+		{
+			name:    "no matched complaint",
+			states:  []merchantservice.ComplaintState{processed, processed, processed},
+			filter:  []merchantservice.ComplaintState{pending},
+			offsets: []string{"0", "2"},
+		},
+		{name: "empty", offsets: []string{"0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeComplaintServer{states: tt.states, omitTotal: tt.omitTotal}
+			svc := newTestMerchantService(t, server)
+			it, err := svc.IterateComplaints(merchantservice.ComplaintFilter{
+				BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 30), States: tt.filter, PageSize: 2,
+			})
+			require.NoError(t, err)
+
+			ids, err := collectComplaints(context.Background(), it)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids)
+
+			var offsets []string
+			for _, query := range server.requests() {
+				assert.Equal(t, "2021-06-01", query.Get("begin_date"))
+				assert.Equal(t, "2021-06-30", query.Get("end_date"))
+				assert.Equal(t, "2", query.Get("limit"))
+				offsets = append(offsets, query.Get("offset"))
+			}
+			assert.Equal(t, tt.offsets, offsets)
+
+			// 遍历结束后不再请求
+			_, err = it.Next(context.Background())
+			assert.Equal(t, io.EOF, err)
+			assert.Len(t, server.requests(), len(tt.offsets))
+		})
+	}
+}
+
+func TestIterateComplaints_RetryFailedPage(t *testing.T) {
+	pending := merchantservice.COMPLAINTSTATE_PENDING
+	server := &fakeComplaintServer{states: []merchantservice.ComplaintState{pending, pending, pending}}
+	svc := newTestMerchantService(t, server)
+	it, err := svc.IterateComplaints(merchantservice.ComplaintFilter{
+		BeginDate: complaintDate(6, 1), EndDate: complaintDate(6, 30), PageSize: 2,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, want := range []string{"20020000", "20020001"} {
+		complaint, err := it.Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, *complaint.ComplaintId)
+	}
+
+	// 请求第二页失败，再次调用 Next 时重新请求该页
+	server.lock.Lock()
+	server.failures = 1
+	server.lock.Unlock()
+	_, err = it.Next(ctx)
+	require.Error(t, err)
+	assert.True(t, core.IsAPIError(errors.Unwrap(err), "SYSTEM_ERROR"), "%v", err)
+	assert.Contains(t, err.Error(), "offset 2")
+
+	ids, err := collectComplaints(ctx, it)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20020002"}, ids)
+	assert.Len(t, server.requests(), 3)
+}
+
+func TestIterateComplaints_InvalidFilter(t *testing.T) {
+	svc := newTestMerchantService(t, &fakeComplaintServer{})
+	it, err := svc.IterateComplaints(merchantservice.ComplaintFilter{BeginDate: complaintDate(6, 1)})
+	assert.Error(t, err)
+	assert.Nil(t, it)
+}
@@ -0,0 +1,129 @@
+// Package merchantservice 微信支付 API v3 Go SDK 消费者投诉服务
+package merchantservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+// complaintMediaPathPrefix 投诉图片下载地址的路径前缀
+const complaintMediaPathPrefix = "/v3/merchant-service/images/"
+
+// MerchantService 消费者投诉API
+//
+// 接口文档地址：https://pay.weixin.qq.com/wiki/doc/apiv3/apis/chapter10_2_11.shtml
+type MerchantService services.Service
+
+type mediaOptions struct {
+	sha256 string
+}
+
+// MediaOption 投诉图片下载的配置项
+type MediaOption func(o *mediaOptions)
+
+// WithExpectedSHA256 设置投诉图片内容的 SHA256 摘要（十六进制），读取完毕时校验，不一致时 Read 返回错误
+//
+// 微信支付不会返回投诉图片的摘要，本选项适用于已知摘要的图片，如商户自己上传的回复凭证图片（上传时 meta 中的 sha256）
+func WithExpectedSHA256(sha256Hex string) MediaOption {
+	return func(o *mediaOptions) {
+		o.sha256 = strings.ToLower(sha256Hex)
+	}
+}
+
+// DownloadComplaintMedia 下载投诉详情、协商历史中的图片，mediaURL 为应答中 complaint_media_list 的 media_url
+//
+// 投诉图片需要使用商户身份签名的 GET 请求下载，且签名需要包含 media_url 中的完整路径与查询参数，
+// 因此无法直接在浏览器中打开。为避免将商户签名发送到其他地址，mediaURL 必须是微信支付 API 域名下的投诉图片地址。
+//
+// 返回的包体为图片内容，调用方读取完毕后需要关闭。应答的 Content-Type 不是图片（如网关返回的错误页面）时返回错误；
+// 读取到包体末尾时会校验实际长度与 Content-Length 是否一致，以及 WithExpectedSHA256 指定的摘要，不一致时 Read 返回错误。
+func (s *MerchantService) DownloadComplaintMedia(
+	ctx context.Context, mediaURL string, opts ...MediaOption,
+) (io.ReadCloser, error) {
+	o := mediaOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if err := checkComplaintMediaURL(mediaURL); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Client.Download(ctx, mediaURL)
+	if err != nil {
+		return nil, err
+	}
+	resp := result.Response
+
+	contentType := resp.Header.Get(consts.ContentType)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "image/") {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected content type of complaint media: %q", contentType)
+	}
+
+	return &mediaBody{
+		ReadCloser:    resp.Body,
+		contentLength: resp.ContentLength,
+		expected:      o.sha256,
+		hash:          sha256.New(),
+	}, nil
+}
+
+// checkComplaintMediaURL 检查下载地址是否为微信支付 API 域名下的投诉图片地址
+func checkComplaintMediaURL(mediaURL string) error {
+	u, err := url.Parse(mediaURL)
+	if err != nil {
+		return fmt.Errorf("parse complaint media url err:%v", err)
+	}
+
+	origin := u.Scheme + "://" + u.Host
+	if origin != consts.WechatPayAPIServer && origin != consts.WechatPayAPIServerBackup {
+		return fmt.Errorf("complaint media url must be under %s, got %s", consts.WechatPayAPIServer, origin)
+	}
+	// 路径中包含 .. 等片段时，规范化后可能指向投诉图片以外的接口
+	if path.Clean(u.Path) != u.Path ||
+		!strings.HasPrefix(u.Path, complaintMediaPathPrefix) || len(u.Path) == len(complaintMediaPathPrefix) {
+		return fmt.Errorf("invalid complaint media url path: %s", u.Path)
+	}
+	return nil
+}
+
+// mediaBody 在读取到末尾时校验图片长度与摘要
+type mediaBody struct {
+	io.ReadCloser
+	contentLength int64
+	expected      string
+
+	hash hash.Hash
+	read int64
+}
+
+func (b *mediaBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	_, _ = b.hash.Write(p[:n])
+	if err == io.EOF {
+		if b.contentLength >= 0 && b.read != b.contentLength {
+			return n, fmt.Errorf("complaint media truncated: read %d bytes, expected %d: %w",
+				b.read, b.contentLength, io.ErrUnexpectedEOF)
+		}
+		if b.expected != "" {
+			if actual := hex.EncodeToString(b.hash.Sum(nil)); actual != b.expected {
+				return n, fmt.Errorf("complaint media sha256 mismatch, expected %s, got %s", b.expected, actual)
+			}
+		}
+	}
+	return n, err
+}
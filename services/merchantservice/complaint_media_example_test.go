@@ -0,0 +1,40 @@
+package merchantservice_test
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantservice"
+)
+
+func ExampleMerchantService_DownloadComplaintMedia() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := merchantservice.MerchantService{Client: client}
+	// media_url 来自投诉详情应答中的 complaint_media_list
+	body, err := svc.DownloadComplaintMedia(
+		ctx, "https://api.mch.weixin.qq.com/v3/merchant-service/images/xxxxx",
+	)
+	if err != nil {
+		return
+	}
+	defer body.Close()
+
+	file, err := os.Create("complaint.jpg")
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	// 读取到末尾时校验图片长度，校验失败时 io.Copy 返回错误
+	_, err = io.Copy(file, body)
+
+	// TODO: 处理返回结果
+	_ = err
+}
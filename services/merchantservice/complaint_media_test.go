@@ -0,0 +1,170 @@
+package merchantservice_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/merchantservice"
+)
+
+const testMediaURL = "https://api.mch.weixin.qq.com/v3/merchant-service/images/ChsyMDIxMDcwMTEwMDAwMDAwMDAx?sign=abc"
+
+var testMedia = []byte("\xff\xd8\xff\xe0 fake jpeg content")
+
+func testMediaSHA256() string {
+	sum := sha256.Sum256(testMedia)
+	return hex.EncodeToString(sum[:])
+}
+
+// mediaHandler 以 contentType 应答 body，contentLength 不为空时覆盖 Content-Length
+type mediaHandler struct {
+	contentType   string
+	contentLength string
+	body          []byte
+	calls         int32
+}
+
+func (h *mediaHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	atomic.AddInt32(&h.calls, 1)
+	if h.contentType != "" {
+		w.Header().Set("Content-Type", h.contentType)
+	}
+	contentLength := h.contentLength
+	if contentLength == "" {
+		contentLength = strconv.Itoa(len(h.body))
+	}
+	w.Header().Set("Content-Length", contentLength)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(h.body)
+}
+
+func newTestMerchantService(t *testing.T, handler http.Handler) *merchantservice.MerchantService {
+	client, err := clienttest.NewHandlerClient(handler)
+	require.NoError(t, err)
+	return &merchantservice.MerchantService{Client: client}
+}
+
+func TestDownloadComplaintMedia(t *testing.T) {
+	var requested string
+	handler := &mediaHandler{contentType: "image/jpeg", body: testMedia}
+	svc := newTestMerchantService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.String()
+		handler.ServeHTTP(w, r)
+	}))
+
+	for _, opts := range [][]merchantservice.MediaOption{
+		nil,
+		{merchantservice.WithExpectedSHA256(testMediaSHA256())},
+		// 摘要不区分大小写
+		{merchantservice.WithExpectedSHA256(strings.ToUpper(testMediaSHA256()))},
+	} {
+		body, err := svc.DownloadComplaintMedia(context.Background(), testMediaURL, opts...)
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, testMedia, content)
+		assert.NoError(t, body.Close())
+	}
+	// 请求包含完整的路径与查询参数
+	assert.Equal(t, testMediaURL, requested)
+}
+
+func TestDownloadComplaintMedia_BackupServer(t *testing.T) {
+	svc := newTestMerchantService(t, &mediaHandler{contentType: "image/png", body: testMedia})
+	body, err := svc.DownloadComplaintMedia(
+		context.Background(), "https://api2.mch.weixin.qq.com/v3/merchant-service/images/abc",
+	)
+	require.NoError(t, err)
+	_ = body.Close()
+}
+
+func TestDownloadComplaintMedia_RejectURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		mediaURL string
+	}{
+		{name: "other host", mediaURL: "https://evil.example.com/v3/merchant-service/images/abc"},
+		{name: "similar host", mediaURL: "https://api.mch.weixin.qq.com.example.com/v3/merchant-service/images/abc"},
+		{name: "userinfo", mediaURL: "https://api.mch.weixin.qq.com@evil.example.com/v3/merchant-service/images/abc"},
+		{name: "http scheme", mediaURL: "http://api.mch.weixin.qq.com/v3/merchant-service/images/abc"},
+		{name: "explicit port", mediaURL: "https://api.mch.weixin.qq.com:8443/v3/merchant-service/images/abc"},
+		{name: "other api", mediaURL: "https://api.mch.weixin.qq.com/v3/pay/transactions/id/4200000001"},
+		{name: "path prefix only", mediaURL: "https://api.mch.weixin.qq.com/v3/merchant-service/images/"},
+		{name: "path traversal", mediaURL: "https://api.mch.weixin.qq.com/v3/merchant-service/images/../../pay/transactions"},
+		{name: "escaped path traversal", mediaURL: "https://api.mch.weixin.qq.com/v3/merchant-service/images/%2e%2e/complaints"},
+		{name: "relative url", mediaURL: "/v3/merchant-service/images/abc"},
+		{name: "invalid url", mediaURL: "https://api.mch.weixin.qq.com/%zz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &mediaHandler{contentType: "image/jpeg", body: testMedia}
+			svc := newTestMerchantService(t, handler)
+
+			body, err := svc.DownloadComplaintMedia(context.Background(), tt.mediaURL)
+			assert.Error(t, err)
+			assert.Nil(t, body)
+			// 不会将商户签名发送到非投诉图片地址
+			assert.Equal(t, int32(0), atomic.LoadInt32(&handler.calls))
+		})
+	}
+}
+
+func TestDownloadComplaintMedia_RejectContentType(t *testing.T) {
+	for _, contentType := range []string{"", "text/html; charset=utf-8", "application/json", "image", "invalid;;"} {
+		t.Run(contentType, func(t *testing.T) {
+			svc := newTestMerchantService(t, &mediaHandler{contentType: contentType, body: []byte("<html></html>")})
+			body, err := svc.DownloadComplaintMedia(context.Background(), testMediaURL)
+			assert.Error(t, err)
+			assert.Nil(t, body)
+		})
+	}
+}
+
+func TestDownloadComplaintMedia_APIError(t *testing.T) {
+	svc := newTestMerchantService(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		clienttest.WriteError(w, http.StatusNotFound, "RESOURCE_NOT_EXISTS", "图片不存在")
+	}))
+	body, err := svc.DownloadComplaintMedia(context.Background(), testMediaURL)
+	assert.Error(t, err)
+	assert.Nil(t, body)
+}
+
+func TestDownloadComplaintMedia_Truncated(t *testing.T) {
+	svc := newTestMerchantService(t, &mediaHandler{
+		contentType: "image/jpeg", contentLength: strconv.Itoa(len(testMedia) + 10), body: testMedia,
+	})
+	body, err := svc.DownloadComplaintMedia(context.Background(), testMediaURL)
+	require.NoError(t, err)
+	defer body.Close()
+
+	_, err = io.Copy(ioutil.Discard, body)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+}
+
+func TestDownloadComplaintMedia_SHA256Mismatch(t *testing.T) {
+	svc := newTestMerchantService(t, &mediaHandler{contentType: "image/jpeg", body: testMedia})
+	sum := sha256.Sum256([]byte("other content"))
+	body, err := svc.DownloadComplaintMedia(
+		context.Background(), testMediaURL, merchantservice.WithExpectedSHA256(hex.EncodeToString(sum[:])),
+	)
+	require.NoError(t, err)
+	defer body.Close()
+
+	_, err = io.Copy(ioutil.Discard, body)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sha256 mismatch")
+}
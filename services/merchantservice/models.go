@@ -0,0 +1,869 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 消费者投诉2.0API
+//
+// 商户处理消费者投诉的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package merchantservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ComplaintInfo
+type ComplaintInfo struct {
+	// 投诉单号
+	ComplaintId *string `json:"complaint_id"`
+	// 投诉时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	ComplaintTime *time.Time `json:"complaint_time"`
+	// 投诉详情，用户描述的投诉详情
+	ComplaintDetail *string `json:"complaint_detail"`
+	// 投诉单状态
+	ComplaintState *ComplaintState `json:"complaint_state"`
+	// 投诉人联系方式，该字段已做加密处理
+	PayerPhone *string `json:"payer_phone,omitempty"`
+	// 投诉单关联订单信息
+	ComplaintOrderInfo []ComplaintOrderInfo `json:"complaint_order_info,omitempty"`
+	// 投诉资料列表，用户上传的投诉相关资料，包括图片凭证等
+	ComplaintMediaList []ComplaintMedia `json:"complaint_media_list,omitempty"`
+	// 问题描述，用户发起投诉前选择的faq标题
+	ProblemDescription *string `json:"problem_description,omitempty"`
+	// 投诉单是否已全额退款
+	ComplaintFullRefunded *bool `json:"complaint_full_refunded"`
+	// 是否有待回复的用户留言
+	IncomingUserResponse *bool `json:"incoming_user_response"`
+	// 用户投诉次数，用户首次发起投诉记为1次，用户每有一次继续投诉就加1
+	UserComplaintTimes *int64 `json:"user_complaint_times"`
+}
+
+func (o ComplaintInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ComplaintId == nil {
+		return nil, fmt.Errorf("field `ComplaintId` is required and must be specified in ComplaintInfo")
+	}
+	toSerialize["complaint_id"] = o.ComplaintId
+
+	if o.ComplaintTime == nil {
+		return nil, fmt.Errorf("field `ComplaintTime` is required and must be specified in ComplaintInfo")
+	}
+	toSerialize["complaint_time"] = o.ComplaintTime.Format(time.RFC3339)
+
+	if o.ComplaintDetail == nil {
+		return nil, fmt.Errorf("field `ComplaintDetail` is required and must be specified in ComplaintInfo")
+	}
+	toSerialize["complaint_detail"] = o.ComplaintDetail
+
+	if o.ComplaintState == nil {
+		return nil, fmt.Errorf("field `ComplaintState` is required and must be specified in ComplaintInfo")
+	}
+	toSerialize["complaint_state"] = o.ComplaintState
+
+	if o.PayerPhone != nil {
+		toSerialize["payer_phone"] = o.PayerPhone
+	}
+
+	if o.ComplaintOrderInfo != nil {
+		toSerialize["complaint_order_info"] = o.ComplaintOrderInfo
+	}
+
+	if o.ComplaintMediaList != nil {
+		toSerialize["complaint_media_list"] = o.ComplaintMediaList
+	}
+
+	if o.ProblemDescription != nil {
+		toSerialize["problem_description"] = o.ProblemDescription
+	}
+
+	if o.ComplaintFullRefunded == nil {
+		return nil, fmt.Errorf("field `ComplaintFullRefunded` is required and must be specified in ComplaintInfo")
+	}
+	toSerialize["complaint_full_refunded"] = o.ComplaintFullRefunded
+
+	if o.IncomingUserResponse == nil {
+		return nil, fmt.Errorf("field `IncomingUserResponse` is required and must be specified in ComplaintInfo")
+	}
+	toSerialize["incoming_user_response"] = o.IncomingUserResponse
+
+	if o.UserComplaintTimes == nil {
+		return nil, fmt.Errorf("field `UserComplaintTimes` is required and must be specified in ComplaintInfo")
+	}
+	toSerialize["user_complaint_times"] = o.UserComplaintTimes
+	return json.Marshal(toSerialize)
+}
+
+func (o ComplaintInfo) String() string {
+	var ret string
+	if o.ComplaintId == nil {
+		ret += "ComplaintId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintId:%v, ", *o.ComplaintId)
+	}
+
+	if o.ComplaintTime == nil {
+		ret += "ComplaintTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintTime:%v, ", *o.ComplaintTime)
+	}
+
+	if o.ComplaintDetail == nil {
+		ret += "ComplaintDetail:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintDetail:%v, ", *o.ComplaintDetail)
+	}
+
+	if o.ComplaintState == nil {
+		ret += "ComplaintState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintState:%v, ", *o.ComplaintState)
+	}
+
+	if o.PayerPhone == nil {
+		ret += "PayerPhone:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerPhone:%v, ", *o.PayerPhone)
+	}
+
+	ret += fmt.Sprintf("ComplaintOrderInfo:%v, ", o.ComplaintOrderInfo)
+
+	ret += fmt.Sprintf("ComplaintMediaList:%v, ", o.ComplaintMediaList)
+
+	if o.ProblemDescription == nil {
+		ret += "ProblemDescription:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ProblemDescription:%v, ", *o.ProblemDescription)
+	}
+
+	if o.ComplaintFullRefunded == nil {
+		ret += "ComplaintFullRefunded:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintFullRefunded:%v, ", *o.ComplaintFullRefunded)
+	}
+
+	if o.IncomingUserResponse == nil {
+		ret += "IncomingUserResponse:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IncomingUserResponse:%v, ", *o.IncomingUserResponse)
+	}
+
+	if o.UserComplaintTimes == nil {
+		ret += "UserComplaintTimes:<nil>"
+	} else {
+		ret += fmt.Sprintf("UserComplaintTimes:%v", *o.UserComplaintTimes)
+	}
+
+	return fmt.Sprintf("ComplaintInfo{%s}", ret)
+}
+
+func (o ComplaintInfo) Clone() *ComplaintInfo {
+	ret := ComplaintInfo{}
+
+	if o.ComplaintId != nil {
+		ret.ComplaintId = new(string)
+		*ret.ComplaintId = *o.ComplaintId
+	}
+
+	if o.ComplaintTime != nil {
+		ret.ComplaintTime = new(time.Time)
+		*ret.ComplaintTime = *o.ComplaintTime
+	}
+
+	if o.ComplaintDetail != nil {
+		ret.ComplaintDetail = new(string)
+		*ret.ComplaintDetail = *o.ComplaintDetail
+	}
+
+	if o.ComplaintState != nil {
+		ret.ComplaintState = new(ComplaintState)
+		*ret.ComplaintState = *o.ComplaintState
+	}
+
+	if o.PayerPhone != nil {
+		ret.PayerPhone = new(string)
+		*ret.PayerPhone = *o.PayerPhone
+	}
+
+	if o.ComplaintOrderInfo != nil {
+		ret.ComplaintOrderInfo = make([]ComplaintOrderInfo, len(o.ComplaintOrderInfo))
+		for i, item := range o.ComplaintOrderInfo {
+			ret.ComplaintOrderInfo[i] = *item.Clone()
+		}
+	}
+
+	if o.ComplaintMediaList != nil {
+		ret.ComplaintMediaList = make([]ComplaintMedia, len(o.ComplaintMediaList))
+		for i, item := range o.ComplaintMediaList {
+			ret.ComplaintMediaList[i] = *item.Clone()
+		}
+	}
+
+	if o.ProblemDescription != nil {
+		ret.ProblemDescription = new(string)
+		*ret.ProblemDescription = *o.ProblemDescription
+	}
+
+	if o.ComplaintFullRefunded != nil {
+		ret.ComplaintFullRefunded = new(bool)
+		*ret.ComplaintFullRefunded = *o.ComplaintFullRefunded
+	}
+
+	if o.IncomingUserResponse != nil {
+		ret.IncomingUserResponse = new(bool)
+		*ret.IncomingUserResponse = *o.IncomingUserResponse
+	}
+
+	if o.UserComplaintTimes != nil {
+		ret.UserComplaintTimes = new(int64)
+		*ret.UserComplaintTimes = *o.UserComplaintTimes
+	}
+
+	return &ret
+}
+
+// HasPayerPhone 应答中是否返回了 payer_phone，o 为 nil 时返回 false
+func (o *ComplaintInfo) HasPayerPhone() bool {
+	return o != nil && o.PayerPhone != nil
+}
+
+// HasComplaintOrderInfo 应答中是否返回了 complaint_order_info，o 为 nil 时返回 false
+func (o *ComplaintInfo) HasComplaintOrderInfo() bool {
+	return o != nil && o.ComplaintOrderInfo != nil
+}
+
+// HasComplaintMediaList 应答中是否返回了 complaint_media_list，o 为 nil 时返回 false
+func (o *ComplaintInfo) HasComplaintMediaList() bool {
+	return o != nil && o.ComplaintMediaList != nil
+}
+
+// HasProblemDescription 应答中是否返回了 problem_description，o 为 nil 时返回 false
+func (o *ComplaintInfo) HasProblemDescription() bool {
+	return o != nil && o.ProblemDescription != nil
+}
+
+// ComplaintMedia
+type ComplaintMedia struct {
+	// 媒体文件业务类型
+	MediaType *ComplaintMediaType `json:"media_type"`
+	// 媒体文件请求url，可使用 MerchantService.DownloadComplaintMedia 下载
+	MediaUrl []string `json:"media_url"`
+}
+
+func (o ComplaintMedia) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.MediaType == nil {
+		return nil, fmt.Errorf("field `MediaType` is required and must be specified in ComplaintMedia")
+	}
+	toSerialize["media_type"] = o.MediaType
+
+	if o.MediaUrl == nil {
+		return nil, fmt.Errorf("field `MediaUrl` is required and must be specified in ComplaintMedia")
+	}
+	toSerialize["media_url"] = o.MediaUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o ComplaintMedia) String() string {
+	var ret string
+	if o.MediaType == nil {
+		ret += "MediaType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("MediaType:%v, ", *o.MediaType)
+	}
+
+	ret += fmt.Sprintf("MediaUrl:%v", o.MediaUrl)
+
+	return fmt.Sprintf("ComplaintMedia{%s}", ret)
+}
+
+func (o ComplaintMedia) Clone() *ComplaintMedia {
+	ret := ComplaintMedia{}
+
+	if o.MediaType != nil {
+		ret.MediaType = new(ComplaintMediaType)
+		*ret.MediaType = *o.MediaType
+	}
+
+	if o.MediaUrl != nil {
+		ret.MediaUrl = make([]string, len(o.MediaUrl))
+		for i, item := range o.MediaUrl {
+			ret.MediaUrl[i] = item
+		}
+	}
+
+	return &ret
+}
+
+// ComplaintMediaType * `USER_COMPLAINT_IMAGE` - 消费者投诉时提交的图片 * `OPERATION_IMAGE` - 商户、消费者、微信支付客服在协商解决投诉时上传的图片凭证
+type ComplaintMediaType string
+
+func (e ComplaintMediaType) Ptr() *ComplaintMediaType {
+	return &e
+}
+
+// Enums of ComplaintMediaType
+const (
+	COMPLAINTMEDIATYPE_USER_COMPLAINT_IMAGE ComplaintMediaType = "USER_COMPLAINT_IMAGE"
+	COMPLAINTMEDIATYPE_OPERATION_IMAGE      ComplaintMediaType = "OPERATION_IMAGE"
+)
+
+func (v *ComplaintMediaType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ComplaintMediaType(value)
+	for _, existing := range []ComplaintMediaType{"USER_COMPLAINT_IMAGE", "OPERATION_IMAGE"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ComplaintMediaType", value)
+}
+
+// ComplaintOrderInfo
+type ComplaintOrderInfo struct {
+	// 微信订单号，投诉单关联的微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户订单号，投诉单关联的商户订单号
+	OutTradeNo *string `json:"out_trade_no"`
+	// 订单金额，订单金额，单位（分）
+	Amount *int64 `json:"amount"`
+}
+
+func (o ComplaintOrderInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in ComplaintOrderInfo")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in ComplaintOrderInfo")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in ComplaintOrderInfo")
+	}
+	toSerialize["amount"] = o.Amount
+	return json.Marshal(toSerialize)
+}
+
+func (o ComplaintOrderInfo) String() string {
+	var ret string
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>"
+	} else {
+		ret += fmt.Sprintf("Amount:%v", *o.Amount)
+	}
+
+	return fmt.Sprintf("ComplaintOrderInfo{%s}", ret)
+}
+
+func (o ComplaintOrderInfo) Clone() *ComplaintOrderInfo {
+	ret := ComplaintOrderInfo{}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	return &ret
+}
+
+// ComplaintState * `PENDING` - 待处理 * `PROCESSING` - 处理中 * `PROCESSED` - 已处理完成
+type ComplaintState string
+
+func (e ComplaintState) Ptr() *ComplaintState {
+	return &e
+}
+
+// Enums of ComplaintState
+const (
+	COMPLAINTSTATE_PENDING    ComplaintState = "PENDING"
+	COMPLAINTSTATE_PROCESSING ComplaintState = "PROCESSING"
+	COMPLAINTSTATE_PROCESSED  ComplaintState = "PROCESSED"
+)
+
+func (v *ComplaintState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ComplaintState(value)
+	for _, existing := range []ComplaintState{"PENDING", "PROCESSING", "PROCESSED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ComplaintState", value)
+}
+
+// ListComplaintsRequest
+type ListComplaintsRequest struct {
+	// 分页大小，设置该次请求返回的最大投诉条数，范围【1,50】
+	Limit *int64 `json:"limit,omitempty"`
+	// 分页开始位置，该次请求的分页开始位置，从0开始计数
+	Offset *int64 `json:"offset,omitempty"`
+	// 开始日期，投诉发生的开始日期，格式为yyyy-MM-DD
+	BeginDate *string `json:"begin_date"`
+	// 结束日期，投诉发生的结束日期，格式为yyyy-MM-DD，开始日期与结束日期间隔不能超过30天
+	EndDate *string `json:"end_date"`
+	// 被诉商户号，服务商、渠道商可使用该参数查询其子商户的投诉单
+	ComplaintedMchid *string `json:"complainted_mchid,omitempty"`
+}
+
+func (o ListComplaintsRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Limit != nil {
+		toSerialize["limit"] = o.Limit
+	}
+
+	if o.Offset != nil {
+		toSerialize["offset"] = o.Offset
+	}
+
+	if o.BeginDate == nil {
+		return nil, fmt.Errorf("field `BeginDate` is required and must be specified in ListComplaintsRequest")
+	}
+	toSerialize["begin_date"] = o.BeginDate
+
+	if o.EndDate == nil {
+		return nil, fmt.Errorf("field `EndDate` is required and must be specified in ListComplaintsRequest")
+	}
+	toSerialize["end_date"] = o.EndDate
+
+	if o.ComplaintedMchid != nil {
+		toSerialize["complainted_mchid"] = o.ComplaintedMchid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ListComplaintsRequest) String() string {
+	var ret string
+	if o.Limit == nil {
+		ret += "Limit:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Limit:%v, ", *o.Limit)
+	}
+
+	if o.Offset == nil {
+		ret += "Offset:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Offset:%v, ", *o.Offset)
+	}
+
+	if o.BeginDate == nil {
+		ret += "BeginDate:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BeginDate:%v, ", *o.BeginDate)
+	}
+
+	if o.EndDate == nil {
+		ret += "EndDate:<nil>, "
+	} else {
+		ret += fmt.Sprintf("EndDate:%v, ", *o.EndDate)
+	}
+
+	if o.ComplaintedMchid == nil {
+		ret += "ComplaintedMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("ComplaintedMchid:%v", *o.ComplaintedMchid)
+	}
+
+	return fmt.Sprintf("ListComplaintsRequest{%s}", ret)
+}
+
+func (o ListComplaintsRequest) Clone() *ListComplaintsRequest {
+	ret := ListComplaintsRequest{}
+
+	if o.Limit != nil {
+		ret.Limit = new(int64)
+		*ret.Limit = *o.Limit
+	}
+
+	if o.Offset != nil {
+		ret.Offset = new(int64)
+		*ret.Offset = *o.Offset
+	}
+
+	if o.BeginDate != nil {
+		ret.BeginDate = new(string)
+		*ret.BeginDate = *o.BeginDate
+	}
+
+	if o.EndDate != nil {
+		ret.EndDate = new(string)
+		*ret.EndDate = *o.EndDate
+	}
+
+	if o.ComplaintedMchid != nil {
+		ret.ComplaintedMchid = new(string)
+		*ret.ComplaintedMchid = *o.ComplaintedMchid
+	}
+
+	return &ret
+}
+
+// QueryComplaintsResponse
+type QueryComplaintsResponse struct {
+	// 用户投诉信息详情
+	Data []ComplaintInfo `json:"data,omitempty"`
+	// 分页大小，设置该次请求返回的最大投诉条数
+	Limit *int64 `json:"limit"`
+	// 分页开始位置，该次请求的分页开始位置，从0开始计数
+	Offset *int64 `json:"offset"`
+	// 投诉总条数，投诉单总数
+	TotalCount *int64 `json:"total_count,omitempty"`
+}
+
+func (o QueryComplaintsResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Data != nil {
+		toSerialize["data"] = o.Data
+	}
+
+	if o.Limit == nil {
+		return nil, fmt.Errorf("field `Limit` is required and must be specified in QueryComplaintsResponse")
+	}
+	toSerialize["limit"] = o.Limit
+
+	if o.Offset == nil {
+		return nil, fmt.Errorf("field `Offset` is required and must be specified in QueryComplaintsResponse")
+	}
+	toSerialize["offset"] = o.Offset
+
+	if o.TotalCount != nil {
+		toSerialize["total_count"] = o.TotalCount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryComplaintsResponse) String() string {
+	var ret string
+	ret += fmt.Sprintf("Data:%v, ", o.Data)
+
+	if o.Limit == nil {
+		ret += "Limit:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Limit:%v, ", *o.Limit)
+	}
+
+	if o.Offset == nil {
+		ret += "Offset:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Offset:%v, ", *o.Offset)
+	}
+
+	if o.TotalCount == nil {
+		ret += "TotalCount:<nil>"
+	} else {
+		ret += fmt.Sprintf("TotalCount:%v", *o.TotalCount)
+	}
+
+	return fmt.Sprintf("QueryComplaintsResponse{%s}", ret)
+}
+
+func (o QueryComplaintsResponse) Clone() *QueryComplaintsResponse {
+	ret := QueryComplaintsResponse{}
+
+	if o.Data != nil {
+		ret.Data = make([]ComplaintInfo, len(o.Data))
+		for i, item := range o.Data {
+			ret.Data[i] = *item.Clone()
+		}
+	}
+
+	if o.Limit != nil {
+		ret.Limit = new(int64)
+		*ret.Limit = *o.Limit
+	}
+
+	if o.Offset != nil {
+		ret.Offset = new(int64)
+		*ret.Offset = *o.Offset
+	}
+
+	if o.TotalCount != nil {
+		ret.TotalCount = new(int64)
+		*ret.TotalCount = *o.TotalCount
+	}
+
+	return &ret
+}
+
+// HasData 应答中是否返回了 data，o 为 nil 时返回 false
+func (o *QueryComplaintsResponse) HasData() bool {
+	return o != nil && o.Data != nil
+}
+
+// HasTotalCount 应答中是否返回了 total_count，o 为 nil 时返回 false
+func (o *QueryComplaintsResponse) HasTotalCount() bool {
+	return o != nil && o.TotalCount != nil
+}
+
+// ResponseComplaintBody
+type ResponseComplaintBody struct {
+	// 被诉商户号，投诉单对应的被诉商户号
+	ComplaintedMchid *string `json:"complainted_mchid"`
+	// 回复内容，具体的投诉处理方案，限制200个字符以内
+	ResponseContent *string `json:"response_content"`
+	// 回复图片，传入调用商户上传反馈图片接口返回的media_id，最多上传4张图片凭证
+	ResponseImages []string `json:"response_images,omitempty"`
+	// 跳转链接，商户可在回复中附加跳转链接，引导用户跳转至商户客诉处理页面，链接需满足https格式
+	JumpUrl *string `json:"jump_url,omitempty"`
+	// 跳转链接文案，设置了跳转链接时必传，用于展示给用户的文案，限制10个字符以内
+	JumpUrlText *string `json:"jump_url_text,omitempty"`
+}
+
+func (o ResponseComplaintBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ComplaintedMchid == nil {
+		return nil, fmt.Errorf("field `ComplaintedMchid` is required and must be specified in ResponseComplaintBody")
+	}
+	toSerialize["complainted_mchid"] = o.ComplaintedMchid
+
+	if o.ResponseContent == nil {
+		return nil, fmt.Errorf("field `ResponseContent` is required and must be specified in ResponseComplaintBody")
+	}
+	toSerialize["response_content"] = o.ResponseContent
+
+	if o.ResponseImages != nil {
+		toSerialize["response_images"] = o.ResponseImages
+	}
+
+	if o.JumpUrl != nil {
+		toSerialize["jump_url"] = o.JumpUrl
+	}
+
+	if o.JumpUrlText != nil {
+		toSerialize["jump_url_text"] = o.JumpUrlText
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ResponseComplaintBody) String() string {
+	var ret string
+	if o.ComplaintedMchid == nil {
+		ret += "ComplaintedMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintedMchid:%v, ", *o.ComplaintedMchid)
+	}
+
+	if o.ResponseContent == nil {
+		ret += "ResponseContent:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ResponseContent:%v, ", *o.ResponseContent)
+	}
+
+	ret += fmt.Sprintf("ResponseImages:%v, ", o.ResponseImages)
+
+	if o.JumpUrl == nil {
+		ret += "JumpUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("JumpUrl:%v, ", *o.JumpUrl)
+	}
+
+	if o.JumpUrlText == nil {
+		ret += "JumpUrlText:<nil>"
+	} else {
+		ret += fmt.Sprintf("JumpUrlText:%v", *o.JumpUrlText)
+	}
+
+	return fmt.Sprintf("ResponseComplaintBody{%s}", ret)
+}
+
+func (o ResponseComplaintBody) Clone() *ResponseComplaintBody {
+	ret := ResponseComplaintBody{}
+
+	if o.ComplaintedMchid != nil {
+		ret.ComplaintedMchid = new(string)
+		*ret.ComplaintedMchid = *o.ComplaintedMchid
+	}
+
+	if o.ResponseContent != nil {
+		ret.ResponseContent = new(string)
+		*ret.ResponseContent = *o.ResponseContent
+	}
+
+	if o.ResponseImages != nil {
+		ret.ResponseImages = make([]string, len(o.ResponseImages))
+		for i, item := range o.ResponseImages {
+			ret.ResponseImages[i] = item
+		}
+	}
+
+	if o.JumpUrl != nil {
+		ret.JumpUrl = new(string)
+		*ret.JumpUrl = *o.JumpUrl
+	}
+
+	if o.JumpUrlText != nil {
+		ret.JumpUrlText = new(string)
+		*ret.JumpUrlText = *o.JumpUrlText
+	}
+
+	return &ret
+}
+
+// ResponseComplaintRequest
+type ResponseComplaintRequest struct {
+	// 投诉单对应的投诉单号
+	ComplaintId *string `json:"complaint_id"`
+	// 被诉商户号，投诉单对应的被诉商户号
+	ComplaintedMchid *string `json:"complainted_mchid"`
+	// 回复内容，具体的投诉处理方案，限制200个字符以内
+	ResponseContent *string `json:"response_content"`
+	// 回复图片，传入调用商户上传反馈图片接口返回的media_id，最多上传4张图片凭证
+	ResponseImages []string `json:"response_images,omitempty"`
+	// 跳转链接，商户可在回复中附加跳转链接，引导用户跳转至商户客诉处理页面，链接需满足https格式
+	JumpUrl *string `json:"jump_url,omitempty"`
+	// 跳转链接文案，设置了跳转链接时必传，用于展示给用户的文案，限制10个字符以内
+	JumpUrlText *string `json:"jump_url_text,omitempty"`
+}
+
+func (o ResponseComplaintRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ComplaintId == nil {
+		return nil, fmt.Errorf("field `ComplaintId` is required and must be specified in ResponseComplaintRequest")
+	}
+	toSerialize["complaint_id"] = o.ComplaintId
+
+	if o.ComplaintedMchid == nil {
+		return nil, fmt.Errorf("field `ComplaintedMchid` is required and must be specified in ResponseComplaintRequest")
+	}
+	toSerialize["complainted_mchid"] = o.ComplaintedMchid
+
+	if o.ResponseContent == nil {
+		return nil, fmt.Errorf("field `ResponseContent` is required and must be specified in ResponseComplaintRequest")
+	}
+	toSerialize["response_content"] = o.ResponseContent
+
+	if o.ResponseImages != nil {
+		toSerialize["response_images"] = o.ResponseImages
+	}
+
+	if o.JumpUrl != nil {
+		toSerialize["jump_url"] = o.JumpUrl
+	}
+
+	if o.JumpUrlText != nil {
+		toSerialize["jump_url_text"] = o.JumpUrlText
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ResponseComplaintRequest) String() string {
+	var ret string
+	if o.ComplaintId == nil {
+		ret += "ComplaintId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintId:%v, ", *o.ComplaintId)
+	}
+
+	if o.ComplaintedMchid == nil {
+		ret += "ComplaintedMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ComplaintedMchid:%v, ", *o.ComplaintedMchid)
+	}
+
+	if o.ResponseContent == nil {
+		ret += "ResponseContent:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ResponseContent:%v, ", *o.ResponseContent)
+	}
+
+	ret += fmt.Sprintf("ResponseImages:%v, ", o.ResponseImages)
+
+	if o.JumpUrl == nil {
+		ret += "JumpUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("JumpUrl:%v, ", *o.JumpUrl)
+	}
+
+	if o.JumpUrlText == nil {
+		ret += "JumpUrlText:<nil>"
+	} else {
+		ret += fmt.Sprintf("JumpUrlText:%v", *o.JumpUrlText)
+	}
+
+	return fmt.Sprintf("ResponseComplaintRequest{%s}", ret)
+}
+
+func (o ResponseComplaintRequest) Clone() *ResponseComplaintRequest {
+	ret := ResponseComplaintRequest{}
+
+	if o.ComplaintId != nil {
+		ret.ComplaintId = new(string)
+		*ret.ComplaintId = *o.ComplaintId
+	}
+
+	if o.ComplaintedMchid != nil {
+		ret.ComplaintedMchid = new(string)
+		*ret.ComplaintedMchid = *o.ComplaintedMchid
+	}
+
+	if o.ResponseContent != nil {
+		ret.ResponseContent = new(string)
+		*ret.ResponseContent = *o.ResponseContent
+	}
+
+	if o.ResponseImages != nil {
+		ret.ResponseImages = make([]string, len(o.ResponseImages))
+		for i, item := range o.ResponseImages {
+			ret.ResponseImages[i] = item
+		}
+	}
+
+	if o.JumpUrl != nil {
+		ret.JumpUrl = new(string)
+		*ret.JumpUrl = *o.JumpUrl
+	}
+
+	if o.JumpUrlText != nil {
+		ret.JumpUrlText = new(string)
+		*ret.JumpUrlText = *o.JumpUrlText
+	}
+
+	return &ret
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 服务商APP支付
+//
+// 服务商模式下的APP支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package app_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/app"
+)
+
+func ExampleAppApiService_CloseOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := app.AppApiService{Client: client}
+	result, err := svc.CloseOrder(ctx,
+		app.CloseOrderRequest{
+			OutTradeNo: core.String("1217752501201407033233368018"),
+			SpMchid:    core.String("1230000109"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleAppApiService_Prepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := app.AppApiService{Client: client}
+	resp, result, err := svc.Prepay(ctx,
+		app.PrepayRequest{
+			Amount: &app.Amount{
+				Currency: core.String("CNY"),
+				Total:    core.Int64(100),
+			},
+			Attach:      core.String("自定义数据"),
+			Description: core.String("Image形象店-深圳腾大-QQ公仔"),
+			GoodsTag:    core.String("WXG"),
+			NotifyUrl:   core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+			OutTradeNo:  core.String("1217752501201407033233368018"),
+			SceneInfo: &app.SceneInfo{
+				DeviceId:      core.String("013467007045764"),
+				PayerClientIp: core.String("14.23.150.211"),
+				StoreInfo: &app.StoreInfo{
+					Address:  core.String("广东省深圳市南山区科技中一道10000号"),
+					AreaCode: core.String("440305"),
+					Id:       core.String("0001"),
+					Name:     core.String("腾讯大厦分店"),
+				},
+			},
+			SettleInfo: &app.SettleInfo{
+				ProfitSharing: core.Bool(false),
+			},
+			SpAppid:    core.String("wx8888888888888888"),
+			SpMchid:    core.String("1230000109"),
+			SubAppid:   core.String("wxd678efh567hg6999"),
+			SubMchid:   core.String("1900000109"),
+			TimeExpire: core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 服务商H5支付
+//
+// 服务商模式下的H5支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package h5_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/h5"
+)
+
+func ExampleH5ApiService_CloseOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := h5.H5ApiService{Client: client}
+	result, err := svc.CloseOrder(ctx,
+		h5.CloseOrderRequest{
+			OutTradeNo: core.String("1217752501201407033233368018"),
+			SpMchid:    core.String("1230000109"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleH5ApiService_Prepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := h5.H5ApiService{Client: client}
+	resp, result, err := svc.Prepay(ctx,
+		h5.PrepayRequest{
+			Amount: &h5.Amount{
+				Currency: core.String("CNY"),
+				Total:    core.Int64(100),
+			},
+			Attach:      core.String("自定义数据"),
+			Description: core.String("Image形象店-深圳腾大-QQ公仔"),
+			GoodsTag:    core.String("WXG"),
+			NotifyUrl:   core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+			OutTradeNo:  core.String("1217752501201407033233368018"),
+			SceneInfo: &h5.SceneInfo{
+				DeviceId: core.String("013467007045764"),
+				H5Info: &h5.H5Info{
+					AppName:     core.String("王者荣耀"),
+					AppUrl:      core.String("https://pay.qq.com"),
+					BundleId:    core.String("com.tencent.wzryiOS"),
+					PackageName: core.String("com.tencent.tmgp.sgame"),
+					Type:        core.String("iOS"),
+				},
+				PayerClientIp: core.String("14.23.150.211"),
+				StoreInfo: &h5.StoreInfo{
+					Address:  core.String("广东省深圳市南山区科技中一道10000号"),
+					AreaCode: core.String("440305"),
+					Id:       core.String("0001"),
+					Name:     core.String("腾讯大厦分店"),
+				},
+			},
+			SettleInfo: &h5.SettleInfo{
+				ProfitSharing: core.Bool(false),
+			},
+			SpAppid:    core.String("wx8888888888888888"),
+			SpMchid:    core.String("1230000109"),
+			SubAppid:   core.String("wxd678efh567hg6999"),
+			SubMchid:   core.String("1900000109"),
+			TimeExpire: core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
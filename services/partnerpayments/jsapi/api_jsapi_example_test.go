@@ -0,0 +1,97 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 服务商JSAPI支付
+//
+// 服务商模式下的JSAPI支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package jsapi_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/jsapi"
+)
+
+func ExampleJsapiApiService_CloseOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := jsapi.JsapiApiService{Client: client}
+	result, err := svc.CloseOrder(ctx,
+		jsapi.CloseOrderRequest{
+			OutTradeNo: core.String("1217752501201407033233368018"),
+			SpMchid:    core.String("1230000109"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleJsapiApiService_Prepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := jsapi.JsapiApiService{Client: client}
+	resp, result, err := svc.Prepay(ctx,
+		jsapi.PrepayRequest{
+			Amount: &jsapi.Amount{
+				Currency: core.String("CNY"),
+				Total:    core.Int64(100),
+			},
+			Attach:      core.String("自定义数据"),
+			Description: core.String("Image形象店-深圳腾大-QQ公仔"),
+			GoodsTag:    core.String("WXG"),
+			NotifyUrl:   core.String("https://www.weixin.qq.com/wxpay/pay.php"),
+			OutTradeNo:  core.String("1217752501201407033233368018"),
+			Payer: &jsapi.Payer{
+				SpOpenid:  core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"),
+				SubOpenid: core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"),
+			},
+			SettleInfo: &jsapi.SettleInfo{
+				ProfitSharing: core.Bool(false),
+			},
+			SpAppid:    core.String("wx8888888888888888"),
+			SpMchid:    core.String("1230000109"),
+			SubAppid:   core.String("wxd678efh567hg6999"),
+			SubMchid:   core.String("1900000109"),
+			TimeExpire: core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleJsapiApiService_QueryOrderByOutTradeNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := jsapi.JsapiApiService{Client: client}
+	resp, result, err := svc.QueryOrderByOutTradeNo(ctx,
+		jsapi.QueryOrderByOutTradeNoRequest{
+			OutTradeNo: core.String("1217752501201407033233368018"),
+			SpMchid:    core.String("1230000109"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,55 @@
+package jsapi
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+// OrderStatus 使用商户订单号查询特约商户的 JSAPI 订单，并返回归一化的订单支付状态
+//
+// 支付者的 sp_openid 映射为 PayerOpenid，sub_openid 映射为 PayerSubOpenid。
+func (a *JsapiApiService) OrderStatus(ctx context.Context, spMchid, subMchid, outTradeNo string) (*payments.PaymentStatus, error) {
+	resp, _, err := a.QueryOrderByOutTradeNo(ctx, QueryOrderByOutTradeNoRequest{
+		OutTradeNo: core.String(outTradeNo),
+		SpMchid:    core.String(spMchid),
+		SubMchid:   core.String(subMchid),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newPaymentStatus(resp), nil
+}
+
+func newPaymentStatus(transaction *Transaction) *payments.PaymentStatus {
+	if transaction == nil {
+		return payments.NewPaymentStatus(nil)
+	}
+
+	normalized := &payments.Transaction{
+		OutTradeNo:    transaction.OutTradeNo,
+		TransactionId: transaction.TransactionId,
+		TradeState:    transaction.TradeState,
+		SuccessTime:   transaction.SuccessTime,
+	}
+	if amount := transaction.Amount; amount != nil {
+		normalized.Amount = &payments.TransactionAmount{
+			Total:         amount.Total,
+			PayerTotal:    amount.PayerTotal,
+			Currency:      amount.Currency,
+			PayerCurrency: amount.PayerCurrency,
+		}
+	}
+
+	status := payments.NewPaymentStatus(normalized)
+	if payer := transaction.Payer; payer != nil {
+		if payer.SpOpenid != nil {
+			status.PayerOpenid = *payer.SpOpenid
+		}
+		if payer.SubOpenid != nil {
+			status.PayerSubOpenid = *payer.SubOpenid
+		}
+	}
+	return status
+}
@@ -0,0 +1,95 @@
+package jsapi_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/jsapi"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+func TestJsapiApiService_OrderStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		payer         map[string]interface{}
+		tradeState    string
+		wantState     payments.PaymentState
+		wantPaid      int64
+		wantOpenid    string
+		wantSubOpenid string
+	}{
+		{
+			name:          "paid with sp_openid and sub_openid",
+			payer:         map[string]interface{}{"sp_openid": "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o", "sub_openid": "oUpF8uMEb4qRXf22hE3X68TekukE"},
+			tradeState:    "SUCCESS",
+			wantState:     payments.PaymentStatePaid,
+			wantPaid:      90,
+			wantOpenid:    "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o",
+			wantSubOpenid: "oUpF8uMEb4qRXf22hE3X68TekukE",
+		},
+		{
+			name:       "refunded with sp_openid only",
+			payer:      map[string]interface{}{"sp_openid": "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"},
+			tradeState: "REFUND",
+			wantState:  payments.PaymentStateRefunded,
+			wantPaid:   90,
+			wantOpenid: "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o",
+		},
+		{
+			name:       "not paid",
+			tradeState: "NOTPAY",
+			wantState:  payments.PaymentStatePending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query map[string]string
+			client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v3/pay/partner/transactions/out-trade-no/1217752501201407033233368018", r.URL.Path)
+				query = map[string]string{"sp_mchid": r.URL.Query().Get("sp_mchid"), "sub_mchid": r.URL.Query().Get("sub_mchid")}
+				body := map[string]interface{}{
+					"sp_mchid":     "1230000109",
+					"sub_mchid":    "1900000109",
+					"out_trade_no": "1217752501201407033233368018",
+					"trade_state":  tt.tradeState,
+					"amount":       map[string]interface{}{"total": 100, "payer_total": 90, "currency": "CNY"},
+				}
+				if tt.payer != nil {
+					body["payer"] = tt.payer
+				}
+				clienttest.WriteJSON(w, http.StatusOK, body)
+			}))
+			require.NoError(t, err)
+
+			svc := jsapi.JsapiApiService{Client: client}
+			status, err := svc.OrderStatus(context.Background(), "1230000109", "1900000109", "1217752501201407033233368018")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"sp_mchid": "1230000109", "sub_mchid": "1900000109"}, query)
+
+			assert.Equal(t, tt.wantState, status.State)
+			assert.Equal(t, tt.tradeState, status.TradeState)
+			assert.Equal(t, int64(100), status.Total)
+			assert.Equal(t, tt.wantPaid, status.PaidAmount)
+			assert.Equal(t, tt.wantOpenid, status.PayerOpenid)
+			assert.Equal(t, tt.wantSubOpenid, status.PayerSubOpenid)
+		})
+	}
+}
+
+func TestJsapiApiService_OrderStatusError(t *testing.T) {
+	client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clienttest.WriteError(w, http.StatusNotFound, "ORDER_NOT_EXIST", "订单不存在")
+	}))
+	require.NoError(t, err)
+
+	svc := jsapi.JsapiApiService{Client: client}
+	status, err := svc.OrderStatus(context.Background(), "1230000109", "1900000109", "1217752501201407033233368018")
+	assert.True(t, core.IsAPIError(err, "ORDER_NOT_EXIST"))
+	assert.Nil(t, status)
+}
@@ -0,0 +1,54 @@
+package jsapi
+
+import (
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// PayerIdentity 支付者身份：用户的 openid 及签发该 openid 的 appid
+//
+// 同一用户在服务商与特约商户的公众号或小程序下的 openid 不同，下单时需按签发 openid 的 appid
+// 填写 payer.sp_openid 或 payer.sub_openid，可使用 PrepayRequest.SetPayer 自动选择。
+type PayerIdentity struct {
+	// Appid 签发 Openid 的公众号或小程序 appid
+	Appid string
+	// Openid 用户在 Appid 下的唯一标识
+	Openid string
+}
+
+// SetPayer 根据 identity 的 appid 填写支付者：appid 为 SpAppid 时填写 sp_openid，为 SubAppid 时填写 sub_openid
+//
+// 需先设置 SpAppid（以及使用特约商户 appid 的 openid 时的 SubAppid）。appid 与两者均不匹配时返回错误，请求不做修改。
+func (r *PrepayRequest) SetPayer(identity PayerIdentity) error {
+	if identity.Openid == "" {
+		return fmt.Errorf("payer identity: openid is required")
+	}
+	if identity.Appid == "" {
+		return fmt.Errorf("payer identity: appid is required")
+	}
+	if r.SpAppid == nil || *r.SpAppid == "" {
+		return fmt.Errorf("field `SpAppid` is required and must be specified in PrepayRequest before SetPayer")
+	}
+
+	switch {
+	case identity.Appid == *r.SpAppid:
+		r.Payer = &Payer{SpOpenid: core.String(identity.Openid)}
+	case r.SubAppid != nil && identity.Appid == *r.SubAppid:
+		r.Payer = &Payer{SubOpenid: core.String(identity.Openid)}
+	default:
+		return fmt.Errorf("payer identity: appid %s matches neither sp_appid nor sub_appid", identity.Appid)
+	}
+	return nil
+}
+
+// PayerAppid 返回拉起支付时应使用的 appid：支付者使用 sub_openid 标识时为 SubAppid，否则为 SpAppid
+func (r *PrepayRequest) PayerAppid() string {
+	if r.Payer != nil && r.Payer.SubOpenid != nil && r.SubAppid != nil {
+		return *r.SubAppid
+	}
+	if r.SpAppid != nil {
+		return *r.SpAppid
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package jsapi_test
+
+import (
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/jsapi"
+)
+
+func ExamplePrepayRequest_SetPayer() {
+	req := jsapi.PrepayRequest{
+		SpAppid:  core.String("wx8888888888888888"),
+		SpMchid:  core.String("1230000109"),
+		SubAppid: core.String("wxd678efh567hg6999"),
+		SubMchid: core.String("1900000109"),
+	}
+
+	// 用户在特约商户小程序中登录，openid 由 sub_appid 签发
+	err := req.SetPayer(jsapi.PayerIdentity{Appid: "wxd678efh567hg6999", Openid: "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"})
+	fmt.Println(err, *req.Payer.SubOpenid, req.PayerAppid())
+
+	err = req.SetPayer(jsapi.PayerIdentity{Appid: "wx0000000000000000", Openid: "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"})
+	fmt.Println(err)
+	// Output:
+	// <nil> oUpF8uMuAJO_M2pxb1Q9zNjWeS6o wxd678efh567hg6999
+	// payer identity: appid wx0000000000000000 matches neither sp_appid nor sub_appid
+}
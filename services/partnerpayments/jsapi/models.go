@@ -0,0 +1,1073 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 服务商JSAPI支付
+//
+// 服务商模式下的JSAPI支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package jsapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Amount
+type Amount struct {
+	// 总金额，订单总金额，单位为分
+	Total *int64 `json:"total"`
+	// 货币类型，CNY：人民币，境内商户号仅支持人民币
+	Currency *string `json:"currency,omitempty"`
+}
+
+func (o Amount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in Amount")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Amount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>"
+	} else {
+		ret += fmt.Sprintf("Currency:%v", *o.Currency)
+	}
+
+	return fmt.Sprintf("Amount{%s}", ret)
+}
+
+func (o Amount) Clone() *Amount {
+	ret := Amount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	return &ret
+}
+
+// CloseOrderRequest
+type CloseOrderRequest struct {
+	// 商户系统内部订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 服务商户号，服务商的商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号，特约商户的商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o CloseOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseOrderRequest) String() string {
+	var ret string
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("CloseOrderRequest{%s}", ret)
+}
+
+func (o CloseOrderRequest) Clone() *CloseOrderRequest {
+	ret := CloseOrderRequest{}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// CloseRequest
+type CloseRequest struct {
+	// 服务商户号，服务商的商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号，特约商户的商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o CloseRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in CloseRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CloseRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseRequest) String() string {
+	var ret string
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("CloseRequest{%s}", ret)
+}
+
+func (o CloseRequest) Clone() *CloseRequest {
+	ret := CloseRequest{}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// Payer
+type Payer struct {
+	// 用户服务标识，用户在服务商 sp_appid 下的唯一标识，与 sub_openid 二选一
+	SpOpenid *string `json:"sp_openid,omitempty"`
+	// 用户子标识，用户在特约商户 sub_appid 下的唯一标识，与 sp_openid 二选一
+	SubOpenid *string `json:"sub_openid,omitempty"`
+}
+
+func (o Payer) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpOpenid != nil {
+		toSerialize["sp_openid"] = o.SpOpenid
+	}
+
+	if o.SubOpenid != nil {
+		toSerialize["sub_openid"] = o.SubOpenid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Payer) String() string {
+	var ret string
+	if o.SpOpenid == nil {
+		ret += "SpOpenid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpOpenid:%v, ", *o.SpOpenid)
+	}
+
+	if o.SubOpenid == nil {
+		ret += "SubOpenid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubOpenid:%v", *o.SubOpenid)
+	}
+
+	return fmt.Sprintf("Payer{%s}", ret)
+}
+
+func (o Payer) Clone() *Payer {
+	ret := Payer{}
+
+	if o.SpOpenid != nil {
+		ret.SpOpenid = new(string)
+		*ret.SpOpenid = *o.SpOpenid
+	}
+
+	if o.SubOpenid != nil {
+		ret.SubOpenid = new(string)
+		*ret.SubOpenid = *o.SubOpenid
+	}
+
+	return &ret
+}
+
+// HasSpOpenid 应答中是否返回了 sp_openid，o 为 nil 时返回 false
+func (o *Payer) HasSpOpenid() bool {
+	return o != nil && o.SpOpenid != nil
+}
+
+// HasSubOpenid 应答中是否返回了 sub_openid，o 为 nil 时返回 false
+func (o *Payer) HasSubOpenid() bool {
+	return o != nil && o.SubOpenid != nil
+}
+
+// PrepayRequest
+type PrepayRequest struct {
+	// 服务商应用ID，服务商申请的公众号或小程序appid
+	SpAppid *string `json:"sp_appid"`
+	// 服务商户号，服务商的商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户应用ID，特约商户申请的公众号或小程序appid，传入 sub_openid 时必填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号，特约商户的商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description"`
+	// 商户订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 交易结束时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	TimeExpire *time.Time `json:"time_expire,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 通知地址，异步接收微信支付结果通知的回调地址
+	NotifyUrl *string `json:"notify_url"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// 结算信息
+	SettleInfo *SettleInfo `json:"settle_info,omitempty"`
+	// 订单金额
+	Amount *Amount `json:"amount"`
+	// 支付者
+	Payer *Payer `json:"payer"`
+}
+
+func (o PrepayRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid == nil {
+		return nil, fmt.Errorf("field `SpAppid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["sp_appid"] = o.SpAppid
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TimeExpire != nil {
+		toSerialize["time_expire"] = o.TimeExpire.Format(time.RFC3339)
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.SettleInfo != nil {
+		toSerialize["settle_info"] = o.SettleInfo
+	}
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Payer == nil {
+		return nil, fmt.Errorf("field `Payer` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["payer"] = o.Payer
+	return json.Marshal(toSerialize)
+}
+
+func (o PrepayRequest) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TimeExpire == nil {
+		ret += "TimeExpire:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeExpire:%v, ", *o.TimeExpire)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	ret += fmt.Sprintf("SettleInfo:%v, ", o.SettleInfo)
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("Payer:%v", o.Payer)
+
+	return fmt.Sprintf("PrepayRequest{%s}", ret)
+}
+
+func (o PrepayRequest) Clone() *PrepayRequest {
+	ret := PrepayRequest{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TimeExpire != nil {
+		ret.TimeExpire = new(time.Time)
+		*ret.TimeExpire = *o.TimeExpire
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.SettleInfo != nil {
+		ret.SettleInfo = o.SettleInfo.Clone()
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.Payer != nil {
+		ret.Payer = o.Payer.Clone()
+	}
+
+	return &ret
+}
+
+// PrepayResponse
+type PrepayResponse struct {
+	// 预支付交易会话标识，有效期为2小时
+	PrepayId *string `json:"prepay_id"`
+}
+
+func (o PrepayResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PrepayId == nil {
+		return nil, fmt.Errorf("field `PrepayId` is required and must be specified in PrepayResponse")
+	}
+	toSerialize["prepay_id"] = o.PrepayId
+	return json.Marshal(toSerialize)
+}
+
+func (o PrepayResponse) String() string {
+	var ret string
+	if o.PrepayId == nil {
+		ret += "PrepayId:<nil>"
+	} else {
+		ret += fmt.Sprintf("PrepayId:%v", *o.PrepayId)
+	}
+
+	return fmt.Sprintf("PrepayResponse{%s}", ret)
+}
+
+func (o PrepayResponse) Clone() *PrepayResponse {
+	ret := PrepayResponse{}
+
+	if o.PrepayId != nil {
+		ret.PrepayId = new(string)
+		*ret.PrepayId = *o.PrepayId
+	}
+
+	return &ret
+}
+
+// QueryOrderByOutTradeNoRequest
+type QueryOrderByOutTradeNoRequest struct {
+	// 商户系统内部订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 服务商户号，由微信支付生成并下发
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户的商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryOrderByOutTradeNoRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in QueryOrderByOutTradeNoRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in QueryOrderByOutTradeNoRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryOrderByOutTradeNoRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryOrderByOutTradeNoRequest) String() string {
+	var ret string
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryOrderByOutTradeNoRequest{%s}", ret)
+}
+
+func (o QueryOrderByOutTradeNoRequest) Clone() *QueryOrderByOutTradeNoRequest {
+	ret := QueryOrderByOutTradeNoRequest{}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// SettleInfo
+type SettleInfo struct {
+	// 是否指定分账
+	ProfitSharing *bool `json:"profit_sharing,omitempty"`
+}
+
+func (o SettleInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ProfitSharing != nil {
+		toSerialize["profit_sharing"] = o.ProfitSharing
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SettleInfo) String() string {
+	var ret string
+	if o.ProfitSharing == nil {
+		ret += "ProfitSharing:<nil>"
+	} else {
+		ret += fmt.Sprintf("ProfitSharing:%v", *o.ProfitSharing)
+	}
+
+	return fmt.Sprintf("SettleInfo{%s}", ret)
+}
+
+func (o SettleInfo) Clone() *SettleInfo {
+	ret := SettleInfo{}
+
+	if o.ProfitSharing != nil {
+		ret.ProfitSharing = new(bool)
+		*ret.ProfitSharing = *o.ProfitSharing
+	}
+
+	return &ret
+}
+
+// Transaction
+type Transaction struct {
+	// 服务商申请的公众号或移动应用appid
+	SpAppid *string `json:"sp_appid,omitempty"`
+	// 服务商户号，由微信支付生成并下发
+	SpMchid *string `json:"sp_mchid,omitempty"`
+	// 子商户申请的公众号或移动应用appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户的商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 商户系统内部订单号
+	OutTradeNo *string `json:"out_trade_no,omitempty"`
+	// 微信支付系统生成的订单号
+	TransactionId *string `json:"transaction_id,omitempty"`
+	// 交易类型，JSAPI：公众号支付，NATIVE：扫码支付，APP：APP支付，MICROPAY：付款码支付，MWEB：H5支付，FACEPAY：刷脸支付
+	TradeType *string `json:"trade_type,omitempty"`
+	// 交易状态，SUCCESS：支付成功，REFUND：转入退款，NOTPAY：未支付，CLOSED：已关闭，REVOKED：已撤销（付款码支付），USERPAYING：用户支付中（付款码支付），PAYERROR：支付失败
+	TradeState *string `json:"trade_state,omitempty"`
+	// 交易状态描述
+	TradeStateDesc *string `json:"trade_state_desc,omitempty"`
+	// 银行类型，采用字符串类型的银行标识
+	BankType *string `json:"bank_type,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 支付完成时间，遵循rfc3339标准格式
+	SuccessTime *string            `json:"success_time,omitempty"`
+	Payer       *Payer             `json:"payer,omitempty"`
+	Amount      *TransactionAmount `json:"amount,omitempty"`
+}
+
+func (o Transaction) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid != nil {
+		toSerialize["sp_appid"] = o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		toSerialize["sp_mchid"] = o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.OutTradeNo != nil {
+		toSerialize["out_trade_no"] = o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		toSerialize["transaction_id"] = o.TransactionId
+	}
+
+	if o.TradeType != nil {
+		toSerialize["trade_type"] = o.TradeType
+	}
+
+	if o.TradeState != nil {
+		toSerialize["trade_state"] = o.TradeState
+	}
+
+	if o.TradeStateDesc != nil {
+		toSerialize["trade_state_desc"] = o.TradeStateDesc
+	}
+
+	if o.BankType != nil {
+		toSerialize["bank_type"] = o.BankType
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.SuccessTime != nil {
+		toSerialize["success_time"] = o.SuccessTime
+	}
+
+	if o.Payer != nil {
+		toSerialize["payer"] = o.Payer
+	}
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Transaction) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.TradeType == nil {
+		ret += "TradeType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeType:%v, ", *o.TradeType)
+	}
+
+	if o.TradeState == nil {
+		ret += "TradeState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeState:%v, ", *o.TradeState)
+	}
+
+	if o.TradeStateDesc == nil {
+		ret += "TradeStateDesc:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeStateDesc:%v, ", *o.TradeStateDesc)
+	}
+
+	if o.BankType == nil {
+		ret += "BankType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankType:%v, ", *o.BankType)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	ret += fmt.Sprintf("Payer:%v, ", o.Payer)
+
+	ret += fmt.Sprintf("Amount:%v", o.Amount)
+
+	return fmt.Sprintf("Transaction{%s}", ret)
+}
+
+func (o Transaction) Clone() *Transaction {
+	ret := Transaction{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.TradeType != nil {
+		ret.TradeType = new(string)
+		*ret.TradeType = *o.TradeType
+	}
+
+	if o.TradeState != nil {
+		ret.TradeState = new(string)
+		*ret.TradeState = *o.TradeState
+	}
+
+	if o.TradeStateDesc != nil {
+		ret.TradeStateDesc = new(string)
+		*ret.TradeStateDesc = *o.TradeStateDesc
+	}
+
+	if o.BankType != nil {
+		ret.BankType = new(string)
+		*ret.BankType = *o.BankType
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(string)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.Payer != nil {
+		ret.Payer = o.Payer.Clone()
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	return &ret
+}
+
+// HasSpAppid 应答中是否返回了 sp_appid，o 为 nil 时返回 false
+func (o *Transaction) HasSpAppid() bool {
+	return o != nil && o.SpAppid != nil
+}
+
+// HasSpMchid 应答中是否返回了 sp_mchid，o 为 nil 时返回 false
+func (o *Transaction) HasSpMchid() bool {
+	return o != nil && o.SpMchid != nil
+}
+
+// HasSubAppid 应答中是否返回了 sub_appid，o 为 nil 时返回 false
+func (o *Transaction) HasSubAppid() bool {
+	return o != nil && o.SubAppid != nil
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *Transaction) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasOutTradeNo 应答中是否返回了 out_trade_no，o 为 nil 时返回 false
+func (o *Transaction) HasOutTradeNo() bool {
+	return o != nil && o.OutTradeNo != nil
+}
+
+// HasTransactionId 应答中是否返回了 transaction_id，o 为 nil 时返回 false
+func (o *Transaction) HasTransactionId() bool {
+	return o != nil && o.TransactionId != nil
+}
+
+// HasTradeType 应答中是否返回了 trade_type，o 为 nil 时返回 false
+func (o *Transaction) HasTradeType() bool {
+	return o != nil && o.TradeType != nil
+}
+
+// HasTradeState 应答中是否返回了 trade_state，o 为 nil 时返回 false
+func (o *Transaction) HasTradeState() bool {
+	return o != nil && o.TradeState != nil
+}
+
+// HasTradeStateDesc 应答中是否返回了 trade_state_desc，o 为 nil 时返回 false
+func (o *Transaction) HasTradeStateDesc() bool {
+	return o != nil && o.TradeStateDesc != nil
+}
+
+// HasBankType 应答中是否返回了 bank_type，o 为 nil 时返回 false
+func (o *Transaction) HasBankType() bool {
+	return o != nil && o.BankType != nil
+}
+
+// HasAttach 应答中是否返回了 attach，o 为 nil 时返回 false
+func (o *Transaction) HasAttach() bool {
+	return o != nil && o.Attach != nil
+}
+
+// HasSuccessTime 应答中是否返回了 success_time，o 为 nil 时返回 false
+func (o *Transaction) HasSuccessTime() bool {
+	return o != nil && o.SuccessTime != nil
+}
+
+// HasPayer 应答中是否返回了 payer，o 为 nil 时返回 false
+func (o *Transaction) HasPayer() bool {
+	return o != nil && o.Payer != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *Transaction) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// TransactionAmount
+type TransactionAmount struct {
+	// 订单总金额，单位为分
+	Total *int64 `json:"total,omitempty"`
+	// 用户支付金额，单位为分
+	PayerTotal *int64 `json:"payer_total,omitempty"`
+	// 货币类型，CNY：人民币，境内商户号仅支持人民币
+	Currency *string `json:"currency,omitempty"`
+	// 用户支付币种
+	PayerCurrency *string `json:"payer_currency,omitempty"`
+}
+
+func (o TransactionAmount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total != nil {
+		toSerialize["total"] = o.Total
+	}
+
+	if o.PayerTotal != nil {
+		toSerialize["payer_total"] = o.PayerTotal
+	}
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+
+	if o.PayerCurrency != nil {
+		toSerialize["payer_currency"] = o.PayerCurrency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TransactionAmount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.PayerTotal == nil {
+		ret += "PayerTotal:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerTotal:%v, ", *o.PayerTotal)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Currency:%v, ", *o.Currency)
+	}
+
+	if o.PayerCurrency == nil {
+		ret += "PayerCurrency:<nil>"
+	} else {
+		ret += fmt.Sprintf("PayerCurrency:%v", *o.PayerCurrency)
+	}
+
+	return fmt.Sprintf("TransactionAmount{%s}", ret)
+}
+
+func (o TransactionAmount) Clone() *TransactionAmount {
+	ret := TransactionAmount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.PayerTotal != nil {
+		ret.PayerTotal = new(int64)
+		*ret.PayerTotal = *o.PayerTotal
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	if o.PayerCurrency != nil {
+		ret.PayerCurrency = new(string)
+		*ret.PayerCurrency = *o.PayerCurrency
+	}
+
+	return &ret
+}
+
+// HasTotal 应答中是否返回了 total，o 为 nil 时返回 false
+func (o *TransactionAmount) HasTotal() bool {
+	return o != nil && o.Total != nil
+}
+
+// HasPayerTotal 应答中是否返回了 payer_total，o 为 nil 时返回 false
+func (o *TransactionAmount) HasPayerTotal() bool {
+	return o != nil && o.PayerTotal != nil
+}
+
+// HasCurrency 应答中是否返回了 currency，o 为 nil 时返回 false
+func (o *TransactionAmount) HasCurrency() bool {
+	return o != nil && o.Currency != nil
+}
+
+// HasPayerCurrency 应答中是否返回了 payer_currency，o 为 nil 时返回 false
+func (o *TransactionAmount) HasPayerCurrency() bool {
+	return o != nil && o.PayerCurrency != nil
+}
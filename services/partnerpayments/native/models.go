@@ -0,0 +1,665 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 服务商Native支付
+//
+// 服务商模式下的Native支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package native
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Amount
+type Amount struct {
+	// 总金额，订单总金额，单位为分
+	Total *int64 `json:"total"`
+	// 货币类型，CNY：人民币，境内商户号仅支持人民币
+	Currency *string `json:"currency,omitempty"`
+}
+
+func (o Amount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in Amount")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Amount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>"
+	} else {
+		ret += fmt.Sprintf("Currency:%v", *o.Currency)
+	}
+
+	return fmt.Sprintf("Amount{%s}", ret)
+}
+
+func (o Amount) Clone() *Amount {
+	ret := Amount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	return &ret
+}
+
+// CloseOrderRequest
+type CloseOrderRequest struct {
+	// 商户系统内部订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 服务商户号，服务商的商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号，特约商户的商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o CloseOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CloseOrderRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseOrderRequest) String() string {
+	var ret string
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("CloseOrderRequest{%s}", ret)
+}
+
+func (o CloseOrderRequest) Clone() *CloseOrderRequest {
+	ret := CloseOrderRequest{}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// CloseRequest
+type CloseRequest struct {
+	// 服务商户号，服务商的商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号，特约商户的商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o CloseRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in CloseRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CloseRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseRequest) String() string {
+	var ret string
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("CloseRequest{%s}", ret)
+}
+
+func (o CloseRequest) Clone() *CloseRequest {
+	ret := CloseRequest{}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// PrepayRequest
+type PrepayRequest struct {
+	// 服务商应用ID，服务商申请的公众号、小程序或移动应用appid
+	SpAppid *string `json:"sp_appid"`
+	// 服务商户号，服务商的商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户应用ID，特约商户申请的公众号、小程序或移动应用appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号，特约商户的商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description"`
+	// 商户订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 交易结束时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	TimeExpire *time.Time `json:"time_expire,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 通知地址，异步接收微信支付结果通知的回调地址
+	NotifyUrl *string `json:"notify_url"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// 结算信息
+	SettleInfo *SettleInfo `json:"settle_info,omitempty"`
+	// 订单金额
+	Amount *Amount `json:"amount"`
+	// 支付场景描述
+	SceneInfo *SceneInfo `json:"scene_info,omitempty"`
+}
+
+func (o PrepayRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpAppid == nil {
+		return nil, fmt.Errorf("field `SpAppid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["sp_appid"] = o.SpAppid
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TimeExpire != nil {
+		toSerialize["time_expire"] = o.TimeExpire.Format(time.RFC3339)
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.SettleInfo != nil {
+		toSerialize["settle_info"] = o.SettleInfo
+	}
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.SceneInfo != nil {
+		toSerialize["scene_info"] = o.SceneInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o PrepayRequest) String() string {
+	var ret string
+	if o.SpAppid == nil {
+		ret += "SpAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpAppid:%v, ", *o.SpAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TimeExpire == nil {
+		ret += "TimeExpire:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeExpire:%v, ", *o.TimeExpire)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	ret += fmt.Sprintf("SettleInfo:%v, ", o.SettleInfo)
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("SceneInfo:%v", o.SceneInfo)
+
+	return fmt.Sprintf("PrepayRequest{%s}", ret)
+}
+
+func (o PrepayRequest) Clone() *PrepayRequest {
+	ret := PrepayRequest{}
+
+	if o.SpAppid != nil {
+		ret.SpAppid = new(string)
+		*ret.SpAppid = *o.SpAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TimeExpire != nil {
+		ret.TimeExpire = new(time.Time)
+		*ret.TimeExpire = *o.TimeExpire
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.SettleInfo != nil {
+		ret.SettleInfo = o.SettleInfo.Clone()
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.SceneInfo != nil {
+		ret.SceneInfo = o.SceneInfo.Clone()
+	}
+
+	return &ret
+}
+
+// PrepayResponse
+type PrepayResponse struct {
+	// 二维码链接，有效期为2小时
+	CodeUrl *string `json:"code_url"`
+}
+
+func (o PrepayResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CodeUrl == nil {
+		return nil, fmt.Errorf("field `CodeUrl` is required and must be specified in PrepayResponse")
+	}
+	toSerialize["code_url"] = o.CodeUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o PrepayResponse) String() string {
+	var ret string
+	if o.CodeUrl == nil {
+		ret += "CodeUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("CodeUrl:%v", *o.CodeUrl)
+	}
+
+	return fmt.Sprintf("PrepayResponse{%s}", ret)
+}
+
+func (o PrepayResponse) Clone() *PrepayResponse {
+	ret := PrepayResponse{}
+
+	if o.CodeUrl != nil {
+		ret.CodeUrl = new(string)
+		*ret.CodeUrl = *o.CodeUrl
+	}
+
+	return &ret
+}
+
+// SceneInfo 支付场景描述
+type SceneInfo struct {
+	// 用户终端IP
+	PayerClientIp *string `json:"payer_client_ip"`
+	// 商户端设备号
+	DeviceId  *string    `json:"device_id,omitempty"`
+	StoreInfo *StoreInfo `json:"store_info,omitempty"`
+}
+
+func (o SceneInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PayerClientIp == nil {
+		return nil, fmt.Errorf("field `PayerClientIp` is required and must be specified in SceneInfo")
+	}
+	toSerialize["payer_client_ip"] = o.PayerClientIp
+
+	if o.DeviceId != nil {
+		toSerialize["device_id"] = o.DeviceId
+	}
+
+	if o.StoreInfo != nil {
+		toSerialize["store_info"] = o.StoreInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SceneInfo) String() string {
+	var ret string
+	if o.PayerClientIp == nil {
+		ret += "PayerClientIp:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerClientIp:%v, ", *o.PayerClientIp)
+	}
+
+	if o.DeviceId == nil {
+		ret += "DeviceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("DeviceId:%v, ", *o.DeviceId)
+	}
+
+	ret += fmt.Sprintf("StoreInfo:%v", o.StoreInfo)
+
+	return fmt.Sprintf("SceneInfo{%s}", ret)
+}
+
+func (o SceneInfo) Clone() *SceneInfo {
+	ret := SceneInfo{}
+
+	if o.PayerClientIp != nil {
+		ret.PayerClientIp = new(string)
+		*ret.PayerClientIp = *o.PayerClientIp
+	}
+
+	if o.DeviceId != nil {
+		ret.DeviceId = new(string)
+		*ret.DeviceId = *o.DeviceId
+	}
+
+	if o.StoreInfo != nil {
+		ret.StoreInfo = o.StoreInfo.Clone()
+	}
+
+	return &ret
+}
+
+// SettleInfo
+type SettleInfo struct {
+	// 是否指定分账
+	ProfitSharing *bool `json:"profit_sharing,omitempty"`
+}
+
+func (o SettleInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ProfitSharing != nil {
+		toSerialize["profit_sharing"] = o.ProfitSharing
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SettleInfo) String() string {
+	var ret string
+	if o.ProfitSharing == nil {
+		ret += "ProfitSharing:<nil>"
+	} else {
+		ret += fmt.Sprintf("ProfitSharing:%v", *o.ProfitSharing)
+	}
+
+	return fmt.Sprintf("SettleInfo{%s}", ret)
+}
+
+func (o SettleInfo) Clone() *SettleInfo {
+	ret := SettleInfo{}
+
+	if o.ProfitSharing != nil {
+		ret.ProfitSharing = new(bool)
+		*ret.ProfitSharing = *o.ProfitSharing
+	}
+
+	return &ret
+}
+
+// StoreInfo 商户门店信息
+type StoreInfo struct {
+	// 商户侧门店编号
+	Id *string `json:"id"`
+	// 商户侧门店名称
+	Name *string `json:"name,omitempty"`
+	// 地区编码，详细请见微信支付提供的文档
+	AreaCode *string `json:"area_code,omitempty"`
+	// 详细的商户门店地址
+	Address *string `json:"address,omitempty"`
+}
+
+func (o StoreInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Id == nil {
+		return nil, fmt.Errorf("field `Id` is required and must be specified in StoreInfo")
+	}
+	toSerialize["id"] = o.Id
+
+	if o.Name != nil {
+		toSerialize["name"] = o.Name
+	}
+
+	if o.AreaCode != nil {
+		toSerialize["area_code"] = o.AreaCode
+	}
+
+	if o.Address != nil {
+		toSerialize["address"] = o.Address
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o StoreInfo) String() string {
+	var ret string
+	if o.Id == nil {
+		ret += "Id:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Id:%v, ", *o.Id)
+	}
+
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.AreaCode == nil {
+		ret += "AreaCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AreaCode:%v, ", *o.AreaCode)
+	}
+
+	if o.Address == nil {
+		ret += "Address:<nil>"
+	} else {
+		ret += fmt.Sprintf("Address:%v", *o.Address)
+	}
+
+	return fmt.Sprintf("StoreInfo{%s}", ret)
+}
+
+func (o StoreInfo) Clone() *StoreInfo {
+	ret := StoreInfo{}
+
+	if o.Id != nil {
+		ret.Id = new(string)
+		*ret.Id = *o.Id
+	}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.AreaCode != nil {
+		ret.AreaCode = new(string)
+		*ret.AreaCode = *o.AreaCode
+	}
+
+	if o.Address != nil {
+		ret.Address = new(string)
+		*ret.Address = *o.Address
+	}
+
+	return &ret
+}
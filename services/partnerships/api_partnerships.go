@@ -0,0 +1,198 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 委托营销API
+//
+// 委托营销API，用于商户与合作方建立、终止与查询营销合作关系
+//
+// API version: 0.1.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package partnerships
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type PartnershipsApiService services.Service
+
+// BuildPartnership 建立合作关系
+//
+// # 应用场景
+// 商户可以通过该接口与合作方（其他商户或公众账号）建立委托营销合作关系，授权合作方使用指定的营销批次。
+//
+// 注意：
+// 1、请求需通过Idempotency-Key请求头传递业务请求幂等值，重试时请使用相同的幂等值，可使用 core.WithIdempotencyKey 由 SDK 生成
+// 2、同一合作方与同一批次的合作关系已建立时，将返回已建立的合作关系
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|合作方或授权批次不合法|请检查合作方与授权批次信息|
+// |NO_AUTH|无权限|商户无权限操作该批次|请确认批次的创建商户|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *PartnershipsApiService) BuildPartnership(ctx context.Context, req BuildPartnershipRequest) (resp *BuildPartnershipResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/partnerships/build"
+	// Make sure All Required Params are properly set
+
+	// Setup Header Params
+	if req.IdempotencyKey != nil {
+		localVarHeaderParams.Set("Idempotency-Key", core.ParameterToString(*req.IdempotencyKey, ""))
+	}
+
+	// Setup Body Params
+	localVarPostBody = &BuildPartnershipBody{
+		Partner:        req.Partner,
+		AuthorizedData: req.AuthorizedData,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract BuildPartnershipResponse from Http Response
+	resp = new(BuildPartnershipResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// ListPartnerships 查询合作关系列表
+//
+// # 应用场景
+// 商户可以通过该接口查询已建立的委托营销合作关系，结果按分页方式返回。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *PartnershipsApiService) ListPartnerships(ctx context.Context, req ListPartnershipsRequest) (resp *ListPartnershipsResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/partnerships"
+	// Make sure All Required Params are properly set
+	if req.AuthorizedData == nil {
+		return nil, nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in ListPartnershipsRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.Partner != nil {
+		localVarQueryParams.Add("partner", core.ParameterToString(*req.Partner, ""))
+	}
+	localVarQueryParams.Add("authorized_data", core.ParameterToString(*req.AuthorizedData, ""))
+	if req.Limit != nil {
+		localVarQueryParams.Add("limit", core.ParameterToString(*req.Limit, ""))
+	}
+	if req.Offset != nil {
+		localVarQueryParams.Add("offset", core.ParameterToString(*req.Offset, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ListPartnershipsResponse from Http Response
+	resp = new(ListPartnershipsResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// TerminatePartnership 终止合作关系
+//
+// # 应用场景
+// 商户可以通过该接口终止与合作方已建立的委托营销合作关系，终止后合作方将无法继续使用授权的营销批次。
+//
+// 注意：
+// 1、请求需通过Idempotency-Key请求头传递业务请求幂等值，重试时请使用相同的幂等值，可使用 core.WithIdempotencyKey 由 SDK 生成
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|合作关系不存在|合作关系不存在或已终止|请确认合作方与授权批次信息|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *PartnershipsApiService) TerminatePartnership(ctx context.Context, req TerminatePartnershipRequest) (resp *TerminatePartnershipResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/marketing/partnerships/terminate"
+	// Make sure All Required Params are properly set
+
+	// Setup Header Params
+	if req.IdempotencyKey != nil {
+		localVarHeaderParams.Set("Idempotency-Key", core.ParameterToString(*req.IdempotencyKey, ""))
+	}
+
+	// Setup Body Params
+	localVarPostBody = &TerminatePartnershipBody{
+		Partner:        req.Partner,
+		AuthorizedData: req.AuthorizedData,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract TerminatePartnershipResponse from Http Response
+	resp = new(TerminatePartnershipResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 委托营销API
+//
+// 委托营销API，用于商户与合作方建立、终止与查询营销合作关系
+//
+// API version: 0.1.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package partnerships_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/partnerships"
+)
+
+func ExamplePartnershipsApiService_BuildPartnership() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := partnerships.PartnershipsApiService{Client: client}
+	resp, result, err := svc.BuildPartnership(ctx,
+		partnerships.BuildPartnershipRequest{
+			AuthorizedData: &partnerships.AuthorizedData{
+				BusinessType: partnerships.AUTHTYPE_FAVOR_STOCK.Ptr(),
+				StockId:      core.String("2433405"),
+			},
+			IdempotencyKey: core.String("12345"),
+			Partner: &partnerships.Partner{
+				Appid:      core.String("wx4e1916a585d1f4e9"),
+				MerchantId: core.String("2480029552"),
+				Type:       partnerships.PARTNERTYPE_APPID.Ptr(),
+			},
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExamplePartnershipsApiService_ListPartnerships() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := partnerships.PartnershipsApiService{Client: client}
+	resp, result, err := svc.ListPartnerships(ctx,
+		partnerships.ListPartnershipsRequest{
+			AuthorizedData: core.String("{\"business_type\":\"FAVOR_STOCK\"}"),
+			Limit:          core.Int64(10),
+			Offset:         core.Int64(0),
+			Partner:        core.String("{\"type\":\"APPID\",\"appid\":\"wx4e1916a585d1f4e9\"}"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExamplePartnershipsApiService_TerminatePartnership() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := partnerships.PartnershipsApiService{Client: client}
+	resp, result, err := svc.TerminatePartnership(ctx,
+		partnerships.TerminatePartnershipRequest{
+			AuthorizedData: &partnerships.AuthorizedData{
+				BusinessType: partnerships.AUTHTYPE_FAVOR_STOCK.Ptr(),
+				StockId:      core.String("2433405"),
+			},
+			IdempotencyKey: core.String("12345"),
+			Partner: &partnerships.Partner{
+				Appid:      core.String("wx4e1916a585d1f4e9"),
+				MerchantId: core.String("2480029552"),
+				Type:       partnerships.PARTNERTYPE_APPID.Ptr(),
+			},
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
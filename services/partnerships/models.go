@@ -0,0 +1,954 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 委托营销API
+//
+// 委托营销API，用于商户与合作方建立、终止与查询营销合作关系
+//
+// API version: 0.1.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package partnerships
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AuthType * `FAVOR_STOCK` - 代金券批次 * `BUSIFAVOR_STOCK` - 商家券批次
+type AuthType string
+
+func (e AuthType) Ptr() *AuthType {
+	return &e
+}
+
+// Enums of AuthType
+const (
+	AUTHTYPE_FAVOR_STOCK     AuthType = "FAVOR_STOCK"
+	AUTHTYPE_BUSIFAVOR_STOCK AuthType = "BUSIFAVOR_STOCK"
+)
+
+func (v *AuthType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := AuthType(value)
+	for _, existing := range []AuthType{"FAVOR_STOCK", "BUSIFAVOR_STOCK"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid AuthType", value)
+}
+
+// AuthorizedData
+type AuthorizedData struct {
+	// 授权业务类别
+	BusinessType *AuthType `json:"business_type"`
+	// 授权的营销批次号
+	StockId *string `json:"stock_id"`
+}
+
+func (o AuthorizedData) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.BusinessType == nil {
+		return nil, fmt.Errorf("field `BusinessType` is required and must be specified in AuthorizedData")
+	}
+	toSerialize["business_type"] = o.BusinessType
+
+	if o.StockId == nil {
+		return nil, fmt.Errorf("field `StockId` is required and must be specified in AuthorizedData")
+	}
+	toSerialize["stock_id"] = o.StockId
+	return json.Marshal(toSerialize)
+}
+
+func (o AuthorizedData) String() string {
+	var ret string
+	if o.BusinessType == nil {
+		ret += "BusinessType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BusinessType:%v, ", *o.BusinessType)
+	}
+
+	if o.StockId == nil {
+		ret += "StockId:<nil>"
+	} else {
+		ret += fmt.Sprintf("StockId:%v", *o.StockId)
+	}
+
+	return fmt.Sprintf("AuthorizedData{%s}", ret)
+}
+
+func (o AuthorizedData) Clone() *AuthorizedData {
+	ret := AuthorizedData{}
+
+	if o.BusinessType != nil {
+		ret.BusinessType = new(AuthType)
+		*ret.BusinessType = *o.BusinessType
+	}
+
+	if o.StockId != nil {
+		ret.StockId = new(string)
+		*ret.StockId = *o.StockId
+	}
+
+	return &ret
+}
+
+// BuildPartnershipBody
+type BuildPartnershipBody struct {
+	// 合作方信息
+	Partner *Partner `json:"partner"`
+	// 被授权数据
+	AuthorizedData *AuthorizedData `json:"authorized_data"`
+}
+
+func (o BuildPartnershipBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Partner == nil {
+		return nil, fmt.Errorf("field `Partner` is required and must be specified in BuildPartnershipBody")
+	}
+	toSerialize["partner"] = o.Partner
+
+	if o.AuthorizedData == nil {
+		return nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in BuildPartnershipBody")
+	}
+	toSerialize["authorized_data"] = o.AuthorizedData
+	return json.Marshal(toSerialize)
+}
+
+func (o BuildPartnershipBody) String() string {
+	var ret string
+	ret += fmt.Sprintf("Partner:%v, ", o.Partner)
+
+	ret += fmt.Sprintf("AuthorizedData:%v", o.AuthorizedData)
+
+	return fmt.Sprintf("BuildPartnershipBody{%s}", ret)
+}
+
+func (o BuildPartnershipBody) Clone() *BuildPartnershipBody {
+	ret := BuildPartnershipBody{}
+
+	if o.Partner != nil {
+		ret.Partner = o.Partner.Clone()
+	}
+
+	if o.AuthorizedData != nil {
+		ret.AuthorizedData = o.AuthorizedData.Clone()
+	}
+
+	return &ret
+}
+
+// BuildPartnershipRequest
+type BuildPartnershipRequest struct {
+	// 业务请求幂等值，商户需保证同一合作关系的请求使用唯一的幂等值。请求失败重试时，请使用相同的幂等值。不传时使用 core.WithIdempotencyKey 为 ctx 设置的幂等值
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+	// 合作方信息
+	Partner *Partner `json:"partner"`
+	// 被授权数据
+	AuthorizedData *AuthorizedData `json:"authorized_data"`
+}
+
+func (o BuildPartnershipRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.IdempotencyKey != nil {
+		toSerialize["Idempotency-Key"] = o.IdempotencyKey
+	}
+
+	if o.Partner == nil {
+		return nil, fmt.Errorf("field `Partner` is required and must be specified in BuildPartnershipRequest")
+	}
+	toSerialize["partner"] = o.Partner
+
+	if o.AuthorizedData == nil {
+		return nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in BuildPartnershipRequest")
+	}
+	toSerialize["authorized_data"] = o.AuthorizedData
+	return json.Marshal(toSerialize)
+}
+
+func (o BuildPartnershipRequest) String() string {
+	var ret string
+	if o.IdempotencyKey == nil {
+		ret += "IdempotencyKey:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IdempotencyKey:%v, ", *o.IdempotencyKey)
+	}
+
+	ret += fmt.Sprintf("Partner:%v, ", o.Partner)
+
+	ret += fmt.Sprintf("AuthorizedData:%v", o.AuthorizedData)
+
+	return fmt.Sprintf("BuildPartnershipRequest{%s}", ret)
+}
+
+func (o BuildPartnershipRequest) Clone() *BuildPartnershipRequest {
+	ret := BuildPartnershipRequest{}
+
+	if o.IdempotencyKey != nil {
+		ret.IdempotencyKey = new(string)
+		*ret.IdempotencyKey = *o.IdempotencyKey
+	}
+
+	if o.Partner != nil {
+		ret.Partner = o.Partner.Clone()
+	}
+
+	if o.AuthorizedData != nil {
+		ret.AuthorizedData = o.AuthorizedData.Clone()
+	}
+
+	return &ret
+}
+
+// BuildPartnershipResponse
+type BuildPartnershipResponse struct {
+	// 合作方信息
+	Partner *Partner `json:"partner"`
+	// 被授权数据
+	AuthorizedData *AuthorizedData `json:"authorized_data"`
+	// 合作状态
+	State *PartnershipState `json:"state"`
+	// 建立合作关系的时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	BuildTime *time.Time `json:"build_time"`
+	// 创建时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	CreateTime *time.Time `json:"create_time"`
+	// 更新时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	UpdateTime *time.Time `json:"update_time"`
+}
+
+func (o BuildPartnershipResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Partner == nil {
+		return nil, fmt.Errorf("field `Partner` is required and must be specified in BuildPartnershipResponse")
+	}
+	toSerialize["partner"] = o.Partner
+
+	if o.AuthorizedData == nil {
+		return nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in BuildPartnershipResponse")
+	}
+	toSerialize["authorized_data"] = o.AuthorizedData
+
+	if o.State == nil {
+		return nil, fmt.Errorf("field `State` is required and must be specified in BuildPartnershipResponse")
+	}
+	toSerialize["state"] = o.State
+
+	if o.BuildTime == nil {
+		return nil, fmt.Errorf("field `BuildTime` is required and must be specified in BuildPartnershipResponse")
+	}
+	toSerialize["build_time"] = o.BuildTime.Format(time.RFC3339)
+
+	if o.CreateTime == nil {
+		return nil, fmt.Errorf("field `CreateTime` is required and must be specified in BuildPartnershipResponse")
+	}
+	toSerialize["create_time"] = o.CreateTime.Format(time.RFC3339)
+
+	if o.UpdateTime == nil {
+		return nil, fmt.Errorf("field `UpdateTime` is required and must be specified in BuildPartnershipResponse")
+	}
+	toSerialize["update_time"] = o.UpdateTime.Format(time.RFC3339)
+	return json.Marshal(toSerialize)
+}
+
+func (o BuildPartnershipResponse) String() string {
+	var ret string
+	ret += fmt.Sprintf("Partner:%v, ", o.Partner)
+
+	ret += fmt.Sprintf("AuthorizedData:%v, ", o.AuthorizedData)
+
+	if o.State == nil {
+		ret += "State:<nil>, "
+	} else {
+		ret += fmt.Sprintf("State:%v, ", *o.State)
+	}
+
+	if o.BuildTime == nil {
+		ret += "BuildTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BuildTime:%v, ", *o.BuildTime)
+	}
+
+	if o.CreateTime == nil {
+		ret += "CreateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CreateTime:%v, ", *o.CreateTime)
+	}
+
+	if o.UpdateTime == nil {
+		ret += "UpdateTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("UpdateTime:%v", *o.UpdateTime)
+	}
+
+	return fmt.Sprintf("BuildPartnershipResponse{%s}", ret)
+}
+
+func (o BuildPartnershipResponse) Clone() *BuildPartnershipResponse {
+	ret := BuildPartnershipResponse{}
+
+	if o.Partner != nil {
+		ret.Partner = o.Partner.Clone()
+	}
+
+	if o.AuthorizedData != nil {
+		ret.AuthorizedData = o.AuthorizedData.Clone()
+	}
+
+	if o.State != nil {
+		ret.State = new(PartnershipState)
+		*ret.State = *o.State
+	}
+
+	if o.BuildTime != nil {
+		ret.BuildTime = new(time.Time)
+		*ret.BuildTime = *o.BuildTime
+	}
+
+	if o.CreateTime != nil {
+		ret.CreateTime = new(time.Time)
+		*ret.CreateTime = *o.CreateTime
+	}
+
+	if o.UpdateTime != nil {
+		ret.UpdateTime = new(time.Time)
+		*ret.UpdateTime = *o.UpdateTime
+	}
+
+	return &ret
+}
+
+// ListPartnershipsRequest
+type ListPartnershipsRequest struct {
+	// 合作方信息，JSON格式，如{"type":"APPID","appid":"wx4e1916a585d1f4e9"}
+	Partner *string `json:"partner,omitempty"`
+	// 被授权数据，JSON格式，如{"business_type":"FAVOR_STOCK"}
+	AuthorizedData *string `json:"authorized_data"`
+	// 分页大小，最大50，不传默认为10
+	Limit *int64 `json:"limit,omitempty"`
+	// 分页页码，从0开始，不传默认为0
+	Offset *int64 `json:"offset,omitempty"`
+}
+
+func (o ListPartnershipsRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Partner != nil {
+		toSerialize["partner"] = o.Partner
+	}
+
+	if o.AuthorizedData == nil {
+		return nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in ListPartnershipsRequest")
+	}
+	toSerialize["authorized_data"] = o.AuthorizedData
+
+	if o.Limit != nil {
+		toSerialize["limit"] = o.Limit
+	}
+
+	if o.Offset != nil {
+		toSerialize["offset"] = o.Offset
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ListPartnershipsRequest) String() string {
+	var ret string
+	if o.Partner == nil {
+		ret += "Partner:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Partner:%v, ", *o.Partner)
+	}
+
+	if o.AuthorizedData == nil {
+		ret += "AuthorizedData:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AuthorizedData:%v, ", *o.AuthorizedData)
+	}
+
+	if o.Limit == nil {
+		ret += "Limit:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Limit:%v, ", *o.Limit)
+	}
+
+	if o.Offset == nil {
+		ret += "Offset:<nil>"
+	} else {
+		ret += fmt.Sprintf("Offset:%v", *o.Offset)
+	}
+
+	return fmt.Sprintf("ListPartnershipsRequest{%s}", ret)
+}
+
+func (o ListPartnershipsRequest) Clone() *ListPartnershipsRequest {
+	ret := ListPartnershipsRequest{}
+
+	if o.Partner != nil {
+		ret.Partner = new(string)
+		*ret.Partner = *o.Partner
+	}
+
+	if o.AuthorizedData != nil {
+		ret.AuthorizedData = new(string)
+		*ret.AuthorizedData = *o.AuthorizedData
+	}
+
+	if o.Limit != nil {
+		ret.Limit = new(int64)
+		*ret.Limit = *o.Limit
+	}
+
+	if o.Offset != nil {
+		ret.Offset = new(int64)
+		*ret.Offset = *o.Offset
+	}
+
+	return &ret
+}
+
+// ListPartnershipsResponse
+type ListPartnershipsResponse struct {
+	// 合作关系列表
+	Data []Partnership `json:"data,omitempty"`
+	// 分页大小
+	Limit *int64 `json:"limit"`
+	// 分页页码
+	Offset *int64 `json:"offset"`
+	// 合作关系总数
+	TotalCount *int64 `json:"total_count"`
+}
+
+func (o ListPartnershipsResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Data != nil {
+		toSerialize["data"] = o.Data
+	}
+
+	if o.Limit == nil {
+		return nil, fmt.Errorf("field `Limit` is required and must be specified in ListPartnershipsResponse")
+	}
+	toSerialize["limit"] = o.Limit
+
+	if o.Offset == nil {
+		return nil, fmt.Errorf("field `Offset` is required and must be specified in ListPartnershipsResponse")
+	}
+	toSerialize["offset"] = o.Offset
+
+	if o.TotalCount == nil {
+		return nil, fmt.Errorf("field `TotalCount` is required and must be specified in ListPartnershipsResponse")
+	}
+	toSerialize["total_count"] = o.TotalCount
+	return json.Marshal(toSerialize)
+}
+
+func (o ListPartnershipsResponse) String() string {
+	var ret string
+	ret += fmt.Sprintf("Data:%v, ", o.Data)
+
+	if o.Limit == nil {
+		ret += "Limit:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Limit:%v, ", *o.Limit)
+	}
+
+	if o.Offset == nil {
+		ret += "Offset:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Offset:%v, ", *o.Offset)
+	}
+
+	if o.TotalCount == nil {
+		ret += "TotalCount:<nil>"
+	} else {
+		ret += fmt.Sprintf("TotalCount:%v", *o.TotalCount)
+	}
+
+	return fmt.Sprintf("ListPartnershipsResponse{%s}", ret)
+}
+
+func (o ListPartnershipsResponse) Clone() *ListPartnershipsResponse {
+	ret := ListPartnershipsResponse{}
+
+	if o.Data != nil {
+		ret.Data = make([]Partnership, len(o.Data))
+		for i, item := range o.Data {
+			ret.Data[i] = *item.Clone()
+		}
+	}
+
+	if o.Limit != nil {
+		ret.Limit = new(int64)
+		*ret.Limit = *o.Limit
+	}
+
+	if o.Offset != nil {
+		ret.Offset = new(int64)
+		*ret.Offset = *o.Offset
+	}
+
+	if o.TotalCount != nil {
+		ret.TotalCount = new(int64)
+		*ret.TotalCount = *o.TotalCount
+	}
+
+	return &ret
+}
+
+// HasData 应答中是否返回了 data，o 为 nil 时返回 false
+func (o *ListPartnershipsResponse) HasData() bool {
+	return o != nil && o.Data != nil
+}
+
+// Partner
+type Partner struct {
+	// 合作方类别
+	Type *PartnerType `json:"type"`
+	// 合作方为APPID类型时必填，合作方的公众账号ID
+	Appid *string `json:"appid,omitempty"`
+	// 合作方为MERCHANT类型时必填，合作方的商户号
+	MerchantId *string `json:"merchant_id,omitempty"`
+}
+
+func (o Partner) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in Partner")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Appid != nil {
+		toSerialize["appid"] = o.Appid
+	}
+
+	if o.MerchantId != nil {
+		toSerialize["merchant_id"] = o.MerchantId
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Partner) String() string {
+	var ret string
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.MerchantId == nil {
+		ret += "MerchantId:<nil>"
+	} else {
+		ret += fmt.Sprintf("MerchantId:%v", *o.MerchantId)
+	}
+
+	return fmt.Sprintf("Partner{%s}", ret)
+}
+
+func (o Partner) Clone() *Partner {
+	ret := Partner{}
+
+	if o.Type != nil {
+		ret.Type = new(PartnerType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.MerchantId != nil {
+		ret.MerchantId = new(string)
+		*ret.MerchantId = *o.MerchantId
+	}
+
+	return &ret
+}
+
+// HasAppid 应答中是否返回了 appid，o 为 nil 时返回 false
+func (o *Partner) HasAppid() bool {
+	return o != nil && o.Appid != nil
+}
+
+// HasMerchantId 应答中是否返回了 merchant_id，o 为 nil 时返回 false
+func (o *Partner) HasMerchantId() bool {
+	return o != nil && o.MerchantId != nil
+}
+
+// PartnerType * `APPID` - 公众账号ID * `MERCHANT` - 商户号
+type PartnerType string
+
+func (e PartnerType) Ptr() *PartnerType {
+	return &e
+}
+
+// Enums of PartnerType
+const (
+	PARTNERTYPE_APPID    PartnerType = "APPID"
+	PARTNERTYPE_MERCHANT PartnerType = "MERCHANT"
+)
+
+func (v *PartnerType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := PartnerType(value)
+	for _, existing := range []PartnerType{"APPID", "MERCHANT"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid PartnerType", value)
+}
+
+// Partnership
+type Partnership struct {
+	// 合作方信息
+	Partner *Partner `json:"partner"`
+	// 被授权数据
+	AuthorizedData *AuthorizedData `json:"authorized_data"`
+	// 合作状态
+	State *PartnershipState `json:"state"`
+	// 建立合作关系的时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	BuildTime *time.Time `json:"build_time,omitempty"`
+	// 终止合作关系的时间，合作状态为TERMINATED时返回，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	TerminatedTime *time.Time `json:"terminated_time,omitempty"`
+	// 创建时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+func (o Partnership) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Partner == nil {
+		return nil, fmt.Errorf("field `Partner` is required and must be specified in Partnership")
+	}
+	toSerialize["partner"] = o.Partner
+
+	if o.AuthorizedData == nil {
+		return nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in Partnership")
+	}
+	toSerialize["authorized_data"] = o.AuthorizedData
+
+	if o.State == nil {
+		return nil, fmt.Errorf("field `State` is required and must be specified in Partnership")
+	}
+	toSerialize["state"] = o.State
+
+	if o.BuildTime != nil {
+		toSerialize["build_time"] = o.BuildTime.Format(time.RFC3339)
+	}
+
+	if o.TerminatedTime != nil {
+		toSerialize["terminated_time"] = o.TerminatedTime.Format(time.RFC3339)
+	}
+
+	if o.CreateTime != nil {
+		toSerialize["create_time"] = o.CreateTime.Format(time.RFC3339)
+	}
+
+	if o.UpdateTime != nil {
+		toSerialize["update_time"] = o.UpdateTime.Format(time.RFC3339)
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Partnership) String() string {
+	var ret string
+	ret += fmt.Sprintf("Partner:%v, ", o.Partner)
+
+	ret += fmt.Sprintf("AuthorizedData:%v, ", o.AuthorizedData)
+
+	if o.State == nil {
+		ret += "State:<nil>, "
+	} else {
+		ret += fmt.Sprintf("State:%v, ", *o.State)
+	}
+
+	if o.BuildTime == nil {
+		ret += "BuildTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BuildTime:%v, ", *o.BuildTime)
+	}
+
+	if o.TerminatedTime == nil {
+		ret += "TerminatedTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TerminatedTime:%v, ", *o.TerminatedTime)
+	}
+
+	if o.CreateTime == nil {
+		ret += "CreateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CreateTime:%v, ", *o.CreateTime)
+	}
+
+	if o.UpdateTime == nil {
+		ret += "UpdateTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("UpdateTime:%v", *o.UpdateTime)
+	}
+
+	return fmt.Sprintf("Partnership{%s}", ret)
+}
+
+func (o Partnership) Clone() *Partnership {
+	ret := Partnership{}
+
+	if o.Partner != nil {
+		ret.Partner = o.Partner.Clone()
+	}
+
+	if o.AuthorizedData != nil {
+		ret.AuthorizedData = o.AuthorizedData.Clone()
+	}
+
+	if o.State != nil {
+		ret.State = new(PartnershipState)
+		*ret.State = *o.State
+	}
+
+	if o.BuildTime != nil {
+		ret.BuildTime = new(time.Time)
+		*ret.BuildTime = *o.BuildTime
+	}
+
+	if o.TerminatedTime != nil {
+		ret.TerminatedTime = new(time.Time)
+		*ret.TerminatedTime = *o.TerminatedTime
+	}
+
+	if o.CreateTime != nil {
+		ret.CreateTime = new(time.Time)
+		*ret.CreateTime = *o.CreateTime
+	}
+
+	if o.UpdateTime != nil {
+		ret.UpdateTime = new(time.Time)
+		*ret.UpdateTime = *o.UpdateTime
+	}
+
+	return &ret
+}
+
+// HasBuildTime 应答中是否返回了 build_time，o 为 nil 时返回 false
+func (o *Partnership) HasBuildTime() bool {
+	return o != nil && o.BuildTime != nil
+}
+
+// HasTerminatedTime 应答中是否返回了 terminated_time，o 为 nil 时返回 false
+func (o *Partnership) HasTerminatedTime() bool {
+	return o != nil && o.TerminatedTime != nil
+}
+
+// HasCreateTime 应答中是否返回了 create_time，o 为 nil 时返回 false
+func (o *Partnership) HasCreateTime() bool {
+	return o != nil && o.CreateTime != nil
+}
+
+// HasUpdateTime 应答中是否返回了 update_time，o 为 nil 时返回 false
+func (o *Partnership) HasUpdateTime() bool {
+	return o != nil && o.UpdateTime != nil
+}
+
+// PartnershipState * `ESTABLISHED` - 已建立 * `TERMINATED` - 已终止
+type PartnershipState string
+
+func (e PartnershipState) Ptr() *PartnershipState {
+	return &e
+}
+
+// Enums of PartnershipState
+const (
+	PARTNERSHIPSTATE_ESTABLISHED PartnershipState = "ESTABLISHED"
+	PARTNERSHIPSTATE_TERMINATED  PartnershipState = "TERMINATED"
+)
+
+func (v *PartnershipState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := PartnershipState(value)
+	for _, existing := range []PartnershipState{"ESTABLISHED", "TERMINATED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid PartnershipState", value)
+}
+
+// TerminatePartnershipBody
+type TerminatePartnershipBody struct {
+	// 合作方信息
+	Partner *Partner `json:"partner"`
+	// 被授权数据
+	AuthorizedData *AuthorizedData `json:"authorized_data"`
+}
+
+func (o TerminatePartnershipBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Partner == nil {
+		return nil, fmt.Errorf("field `Partner` is required and must be specified in TerminatePartnershipBody")
+	}
+	toSerialize["partner"] = o.Partner
+
+	if o.AuthorizedData == nil {
+		return nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in TerminatePartnershipBody")
+	}
+	toSerialize["authorized_data"] = o.AuthorizedData
+	return json.Marshal(toSerialize)
+}
+
+func (o TerminatePartnershipBody) String() string {
+	var ret string
+	ret += fmt.Sprintf("Partner:%v, ", o.Partner)
+
+	ret += fmt.Sprintf("AuthorizedData:%v", o.AuthorizedData)
+
+	return fmt.Sprintf("TerminatePartnershipBody{%s}", ret)
+}
+
+func (o TerminatePartnershipBody) Clone() *TerminatePartnershipBody {
+	ret := TerminatePartnershipBody{}
+
+	if o.Partner != nil {
+		ret.Partner = o.Partner.Clone()
+	}
+
+	if o.AuthorizedData != nil {
+		ret.AuthorizedData = o.AuthorizedData.Clone()
+	}
+
+	return &ret
+}
+
+// TerminatePartnershipRequest
+type TerminatePartnershipRequest struct {
+	// 业务请求幂等值，商户需保证同一合作关系的请求使用唯一的幂等值。请求失败重试时，请使用相同的幂等值。不传时使用 core.WithIdempotencyKey 为 ctx 设置的幂等值
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+	// 合作方信息
+	Partner *Partner `json:"partner"`
+	// 被授权数据
+	AuthorizedData *AuthorizedData `json:"authorized_data"`
+}
+
+func (o TerminatePartnershipRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.IdempotencyKey != nil {
+		toSerialize["Idempotency-Key"] = o.IdempotencyKey
+	}
+
+	if o.Partner == nil {
+		return nil, fmt.Errorf("field `Partner` is required and must be specified in TerminatePartnershipRequest")
+	}
+	toSerialize["partner"] = o.Partner
+
+	if o.AuthorizedData == nil {
+		return nil, fmt.Errorf("field `AuthorizedData` is required and must be specified in TerminatePartnershipRequest")
+	}
+	toSerialize["authorized_data"] = o.AuthorizedData
+	return json.Marshal(toSerialize)
+}
+
+func (o TerminatePartnershipRequest) String() string {
+	var ret string
+	if o.IdempotencyKey == nil {
+		ret += "IdempotencyKey:<nil>, "
+	} else {
+		ret += fmt.Sprintf("IdempotencyKey:%v, ", *o.IdempotencyKey)
+	}
+
+	ret += fmt.Sprintf("Partner:%v, ", o.Partner)
+
+	ret += fmt.Sprintf("AuthorizedData:%v", o.AuthorizedData)
+
+	return fmt.Sprintf("TerminatePartnershipRequest{%s}", ret)
+}
+
+func (o TerminatePartnershipRequest) Clone() *TerminatePartnershipRequest {
+	ret := TerminatePartnershipRequest{}
+
+	if o.IdempotencyKey != nil {
+		ret.IdempotencyKey = new(string)
+		*ret.IdempotencyKey = *o.IdempotencyKey
+	}
+
+	if o.Partner != nil {
+		ret.Partner = o.Partner.Clone()
+	}
+
+	if o.AuthorizedData != nil {
+		ret.AuthorizedData = o.AuthorizedData.Clone()
+	}
+
+	return &ret
+}
+
+// TerminatePartnershipResponse
+type TerminatePartnershipResponse struct {
+	// 终止合作关系的时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	TerminatedTime *time.Time `json:"terminated_time"`
+}
+
+func (o TerminatePartnershipResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TerminatedTime == nil {
+		return nil, fmt.Errorf("field `TerminatedTime` is required and must be specified in TerminatePartnershipResponse")
+	}
+	toSerialize["terminated_time"] = o.TerminatedTime.Format(time.RFC3339)
+	return json.Marshal(toSerialize)
+}
+
+func (o TerminatePartnershipResponse) String() string {
+	var ret string
+	if o.TerminatedTime == nil {
+		ret += "TerminatedTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("TerminatedTime:%v", *o.TerminatedTime)
+	}
+
+	return fmt.Sprintf("TerminatePartnershipResponse{%s}", ret)
+}
+
+func (o TerminatePartnershipResponse) Clone() *TerminatePartnershipResponse {
+	ret := TerminatePartnershipResponse{}
+
+	if o.TerminatedTime != nil {
+		ret.TerminatedTime = new(time.Time)
+		*ret.TerminatedTime = *o.TerminatedTime
+	}
+
+	return &ret
+}
@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/wechatpay-apiv3/wechatpay-go/core"
-	"github.com/wechatpay-apiv3/wechatpay-go/utils"
 )
 
 // PrepayWithRequestPaymentResponse 预下单ID，并包含了调起支付的请求参数
@@ -38,8 +36,8 @@ func (a *AppApiService) PrepayWithRequestPayment(
 
 	resp = new(PrepayWithRequestPaymentResponse)
 	resp.PrepayId = prepayResp.PrepayId
-	resp.TimeStamp = core.String(strconv.FormatInt(time.Now().Unix(), 10))
-	nonce, err := utils.GenerateNonce()
+	resp.TimeStamp = core.String(strconv.FormatInt(a.Client.Now(ctx).Unix(), 10))
+	nonce, err := a.Client.GenerateNonce(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("generate request for payment err:%s", err.Error())
 	}
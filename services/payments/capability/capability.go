@@ -0,0 +1,344 @@
+// Package capability 探测（特约）商户已开通的交易类型
+package capability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	partnerapp "github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/app"
+	partnerh5 "github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/h5"
+	partnerjsapi "github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/jsapi"
+	partnernative "github.com/wechatpay-apiv3/wechatpay-go/services/partnerpayments/native"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/app"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/h5"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/jsapi"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// TradeType 交易类型，即支付产品
+type TradeType string
+
+// 交易类型
+const (
+	TradeTypeJSAPI  TradeType = "JSAPI"  // JSAPI支付（含小程序支付）
+	TradeTypeNative TradeType = "NATIVE" // Native支付
+	TradeTypeApp    TradeType = "APP"    // APP支付
+	TradeTypeH5     TradeType = "MWEB"   // H5支付
+)
+
+var allTradeTypes = []TradeType{TradeTypeJSAPI, TradeTypeNative, TradeTypeApp, TradeTypeH5}
+
+// Status 交易类型的开通状态
+type Status string
+
+// 交易类型的开通状态
+const (
+	StatusEnabled  Status = "ENABLED"  // 已开通
+	StatusDisabled Status = "DISABLED" // 未开通，下单返回 NO_AUTH
+	StatusUnknown  Status = "UNKNOWN"  // 未能判断，原因见 Capability.Err
+)
+
+const (
+	probeDescription           = "capability probe"
+	probeAmount          int64 = 1
+	defaultProbeClientIp       = "127.0.0.1"
+	defaultCloseAttempts       = 3
+	defaultCloseInterval       = time.Second
+)
+
+// ErrProbeOrdersNotAllowed 未设置 ProbeRequest.AllowProbeOrders，拒绝创建探测订单
+var ErrProbeOrdersNotAllowed = errors.New("probing creates real orders, set `AllowProbeOrders` to confirm")
+
+// Capability 单个交易类型的探测结果
+type Capability struct {
+	TradeType TradeType
+	Status    Status
+	// ProbeOutTradeNo 探测订单的商户订单号，开通时该订单已被关闭
+	ProbeOutTradeNo string
+	// Err 未能判断开通状态的原因，或探测订单重试后仍关闭失败的错误
+	Err error
+}
+
+// Set 商户各交易类型的开通状态
+type Set map[TradeType]*Capability
+
+// Enabled 判断交易类型是否已开通，未探测或未能判断时返回 false
+func (s Set) Enabled(tradeType TradeType) bool {
+	c, ok := s[tradeType]
+	return ok && c.Status == StatusEnabled
+}
+
+// EnabledTradeTypes 返回已开通的交易类型，按 JSAPI、NATIVE、APP、MWEB 的顺序排列
+func (s Set) EnabledTradeTypes() []TradeType {
+	var ret []TradeType
+	for _, tradeType := range allTradeTypes {
+		if s.Enabled(tradeType) {
+			ret = append(ret, tradeType)
+		}
+	}
+	return ret
+}
+
+// ProbeRequest Probe 的参数
+type ProbeRequest struct {
+	// AllowProbeOrders 【必填】确认允许为每个交易类型创建一笔 1 分的真实订单，未设置为 true 时 Probe 返回 ErrProbeOrdersNotAllowed
+	AllowProbeOrders bool
+	// Appid 【必填】直连商户的 appid，服务商模式下为服务商的 sp_appid
+	Appid string
+	// Mchid 【必填】直连商户号，服务商模式下为服务商商户号 sp_mchid
+	Mchid string
+	// SubMchid 【可选】特约商户号，不为空时按服务商模式探测特约商户的开通状态
+	SubMchid string
+	// SubAppid 【可选】特约商户的 sub_appid
+	SubAppid string
+	// NotifyUrl 【必填】探测订单的通知地址，探测订单会被立即关闭，不会产生支付通知
+	NotifyUrl string
+	// Openid 【可选】JSAPI 下单所需的用户 openid（服务商模式下设置了 SubAppid 时为 sub_openid），为空时不探测 JSAPI
+	Openid string
+	// PayerClientIp 【可选】H5 下单所需的用户终端 IP，为空时使用 127.0.0.1
+	PayerClientIp string
+	// TradeTypes 【可选】需要探测的交易类型，为空时探测全部交易类型
+	TradeTypes []TradeType
+	// CloseMaxAttempts 【可选】关闭探测订单的最大尝试次数，默认为 3 次
+	CloseMaxAttempts int
+	// CloseRetryInterval 【可选】关闭探测订单失败后的首次重试间隔，之后按指数退避，默认为 1s
+	CloseRetryInterval time.Duration
+}
+
+// Probe 探测（特约）商户已开通的交易类型，用于进件后按开通状态展示支付方式，避免在下单时才发现未开通
+//
+// 微信支付没有查询产品开通状态的接口，Probe 对每个交易类型下一笔 1 分的真实探测订单并立即关闭，
+// 因此需要设置 ProbeRequest.AllowProbeOrders 明确同意：下单成功为已开通，返回 NO_AUTH 为未开通，其余错误为未能判断。
+// 探测订单的商户订单号以 PROBE 开头；关单遇到系统错误、频率限制或网络错误时按指数退避重试，
+// 仍失败时记录在 Capability.Err 中，请自行关闭。请在进件完成等低频场景调用，不要在每次支付前调用。
+func Probe(ctx context.Context, client *core.Client, req ProbeRequest) (Set, error) {
+	if !req.AllowProbeOrders {
+		return nil, ErrProbeOrdersNotAllowed
+	}
+	if req.Appid == "" || req.Mchid == "" || req.NotifyUrl == "" {
+		return nil, fmt.Errorf("field `Appid`, `Mchid` and `NotifyUrl` are required in ProbeRequest")
+	}
+	tradeTypes := req.TradeTypes
+	if len(tradeTypes) == 0 {
+		tradeTypes = allTradeTypes
+	}
+	for _, tradeType := range tradeTypes {
+		if !isSupported(tradeType) {
+			return nil, fmt.Errorf("unsupported trade type %s", tradeType)
+		}
+	}
+	if req.CloseMaxAttempts <= 0 {
+		req.CloseMaxAttempts = defaultCloseAttempts
+	}
+	if req.CloseRetryInterval <= 0 {
+		req.CloseRetryInterval = defaultCloseInterval
+	}
+
+	set := make(Set, len(tradeTypes))
+	for _, tradeType := range tradeTypes {
+		set[tradeType] = req.probe(ctx, client, tradeType)
+	}
+	return set, nil
+}
+
+func isSupported(tradeType TradeType) bool {
+	for _, t := range allTradeTypes {
+		if t == tradeType {
+			return true
+		}
+	}
+	return false
+}
+
+func (req *ProbeRequest) probe(ctx context.Context, client *core.Client, tradeType TradeType) *Capability {
+	c := &Capability{TradeType: tradeType, Status: StatusUnknown}
+	if tradeType == TradeTypeJSAPI && req.Openid == "" {
+		c.Err = fmt.Errorf("field `Openid` is required to probe %s", tradeType)
+		return c
+	}
+	nonce, err := utils.GenerateNonce()
+	if err != nil {
+		c.Err = err
+		return c
+	}
+	c.ProbeOutTradeNo = "PROBE" + nonce[:20]
+
+	if err = req.prepay(ctx, client, tradeType, c.ProbeOutTradeNo); err != nil {
+		if core.IsAPIError(err, "NO_AUTH") {
+			c.Status = StatusDisabled
+		}
+		c.Err = err
+		return c
+	}
+
+	c.Status = StatusEnabled
+	if err = req.closeWithRetry(ctx, client, c.ProbeOutTradeNo); err != nil {
+		c.Err = fmt.Errorf("close probe order %s err: %w", c.ProbeOutTradeNo, err)
+	}
+	return c
+}
+
+func (req *ProbeRequest) isPartner() bool {
+	return req.SubMchid != ""
+}
+
+func (req *ProbeRequest) payerClientIp() string {
+	if req.PayerClientIp == "" {
+		return defaultProbeClientIp
+	}
+	return req.PayerClientIp
+}
+
+// prepay 使用对应交易类型的下单请求创建探测订单
+func (req *ProbeRequest) prepay(ctx context.Context, client *core.Client, tradeType TradeType, outTradeNo string) error {
+	if req.isPartner() {
+		return req.partnerPrepay(ctx, client, tradeType, outTradeNo)
+	}
+
+	var err error
+	switch tradeType {
+	case TradeTypeJSAPI:
+		_, _, err = (&jsapi.JsapiApiService{Client: client}).Prepay(ctx, jsapi.NewPrepayRequestBuilder(
+			req.Appid, req.Mchid, probeDescription, outTradeNo, req.NotifyUrl, probeAmount, req.Openid,
+		).Build())
+	case TradeTypeNative:
+		_, _, err = (&native.NativeApiService{Client: client}).Prepay(ctx, native.NewPrepayRequestBuilder(
+			req.Appid, req.Mchid, probeDescription, outTradeNo, req.NotifyUrl, probeAmount,
+		).Build())
+	case TradeTypeApp:
+		_, _, err = (&app.AppApiService{Client: client}).Prepay(ctx, app.NewPrepayRequestBuilder(
+			req.Appid, req.Mchid, probeDescription, outTradeNo, req.NotifyUrl, probeAmount,
+		).Build())
+	case TradeTypeH5:
+		_, _, err = (&h5.H5ApiService{Client: client}).Prepay(ctx, h5.NewPrepayRequestBuilder(
+			req.Appid, req.Mchid, probeDescription, outTradeNo, req.NotifyUrl, probeAmount, req.payerClientIp(), "Wap",
+		).Build())
+	}
+	return err
+}
+
+func (req *ProbeRequest) partnerPrepay(
+	ctx context.Context, client *core.Client, tradeType TradeType, outTradeNo string,
+) error {
+	var subAppid *string
+	if req.SubAppid != "" {
+		subAppid = core.String(req.SubAppid)
+	}
+
+	var err error
+	switch tradeType {
+	case TradeTypeJSAPI:
+		payer := &partnerjsapi.Payer{SpOpenid: core.String(req.Openid)}
+		if subAppid != nil {
+			payer = &partnerjsapi.Payer{SubOpenid: core.String(req.Openid)}
+		}
+		_, _, err = (&partnerjsapi.JsapiApiService{Client: client}).Prepay(ctx, partnerjsapi.PrepayRequest{
+			SpAppid:     core.String(req.Appid),
+			SpMchid:     core.String(req.Mchid),
+			SubAppid:    subAppid,
+			SubMchid:    core.String(req.SubMchid),
+			Description: core.String(probeDescription),
+			OutTradeNo:  core.String(outTradeNo),
+			NotifyUrl:   core.String(req.NotifyUrl),
+			Amount:      &partnerjsapi.Amount{Total: core.Int64(probeAmount)},
+			Payer:       payer,
+		})
+	case TradeTypeNative:
+		_, _, err = (&partnernative.NativeApiService{Client: client}).Prepay(ctx, partnernative.PrepayRequest{
+			SpAppid:     core.String(req.Appid),
+			SpMchid:     core.String(req.Mchid),
+			SubAppid:    subAppid,
+			SubMchid:    core.String(req.SubMchid),
+			Description: core.String(probeDescription),
+			OutTradeNo:  core.String(outTradeNo),
+			NotifyUrl:   core.String(req.NotifyUrl),
+			Amount:      &partnernative.Amount{Total: core.Int64(probeAmount)},
+		})
+	case TradeTypeApp:
+		_, _, err = (&partnerapp.AppApiService{Client: client}).Prepay(ctx, partnerapp.PrepayRequest{
+			SpAppid:     core.String(req.Appid),
+			SpMchid:     core.String(req.Mchid),
+			SubAppid:    subAppid,
+			SubMchid:    core.String(req.SubMchid),
+			Description: core.String(probeDescription),
+			OutTradeNo:  core.String(outTradeNo),
+			NotifyUrl:   core.String(req.NotifyUrl),
+			Amount:      &partnerapp.Amount{Total: core.Int64(probeAmount)},
+		})
+	case TradeTypeH5:
+		_, _, err = (&partnerh5.H5ApiService{Client: client}).Prepay(ctx, partnerh5.PrepayRequest{
+			SpAppid:     core.String(req.Appid),
+			SpMchid:     core.String(req.Mchid),
+			SubAppid:    subAppid,
+			SubMchid:    core.String(req.SubMchid),
+			Description: core.String(probeDescription),
+			OutTradeNo:  core.String(outTradeNo),
+			NotifyUrl:   core.String(req.NotifyUrl),
+			Amount:      &partnerh5.Amount{Total: core.Int64(probeAmount)},
+			SceneInfo: &partnerh5.SceneInfo{
+				PayerClientIp: core.String(req.payerClientIp()),
+				H5Info:        &partnerh5.H5Info{Type: core.String("Wap")},
+			},
+		})
+	}
+	return err
+}
+
+// closeOrder 关闭探测订单，直连商户与服务商模式下的关单接口分别与交易类型无关
+func (req *ProbeRequest) closeOrder(ctx context.Context, client *core.Client, outTradeNo string) error {
+	var err error
+	if req.isPartner() {
+		_, err = (&partnerjsapi.JsapiApiService{Client: client}).CloseOrder(ctx, partnerjsapi.CloseOrderRequest{
+			OutTradeNo: core.String(outTradeNo),
+			SpMchid:    core.String(req.Mchid),
+			SubMchid:   core.String(req.SubMchid),
+		})
+	} else {
+		_, err = (&native.NativeApiService{Client: client}).CloseOrder(ctx, native.CloseOrderRequest{
+			OutTradeNo: core.String(outTradeNo),
+			Mchid:      core.String(req.Mchid),
+		})
+	}
+	return err
+}
+
+// closeWithRetry 关闭探测订单，可重试的错误按指数退避重试
+func (req *ProbeRequest) closeWithRetry(ctx context.Context, client *core.Client, outTradeNo string) (err error) {
+	interval := req.CloseRetryInterval
+	for attempt := 1; ; attempt++ {
+		err = req.closeOrder(ctx, client, outTradeNo)
+		if err == nil || !isRetryableCloseError(err) || attempt >= req.CloseMaxAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w, last err: %v", ctx.Err(), err)
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+// isRetryableCloseError 错误码为 SYSTEM_ERROR、FREQUENCY_LIMITED 或 HTTP 状态码为 5XX 的 *core.APIError，
+// 以及发送请求时的网络异常可以重试关单。ctx 结束时不重试
+func isRetryableCloseError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError ||
+			apiErr.Code == "SYSTEM_ERROR" || apiErr.Code == "FREQUENCY_LIMITED"
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
@@ -0,0 +1,42 @@
+package capability_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/capability"
+)
+
+func ExampleProbe() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	// 特约商户进件完成后，探测其已开通的交易类型。每个交易类型会创建一笔 1 分的探测订单并立即关闭
+	capabilities, err := capability.Probe(ctx, client, capability.ProbeRequest{
+		AllowProbeOrders: true,
+		Appid:            "wxd678efh567hg6787",
+		Mchid:            "1230000109",
+		SubMchid:         "1900000109",
+		NotifyUrl:        "https://www.weixin.qq.com/wxpay/pay.php",
+		TradeTypes: []capability.TradeType{
+			capability.TradeTypeNative, capability.TradeTypeApp, capability.TradeTypeH5,
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	for _, c := range capabilities {
+		if c.Err != nil {
+			// 未能判断开通状态，或探测订单未能关闭，需要人工处理
+			log.Printf("probe %s order %s err:%v", c.TradeType, c.ProbeOutTradeNo, c.Err)
+		}
+	}
+	if capabilities.Enabled(capability.TradeTypeH5) {
+		// TODO: 展示 H5 支付
+	}
+}
@@ -0,0 +1,296 @@
+package capability_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/capability"
+)
+
+const (
+	testAppid     = "wxd678efh567hg6787"
+	testMchid     = "1230000109"
+	testNotifyUrl = "https://www.weixin.qq.com/wxpay/pay.php"
+	testOpenid    = "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"
+)
+
+// capabilityServer 模拟下单与关单接口，disabled 中的交易类型下单返回 NO_AUTH，broken 中的交易类型下单时网络错误，
+// 关单依次使用 closeStatuses 中的状态码应答，用完后应答成功
+type capabilityServer struct {
+	disabled map[string]bool
+	broken   map[string]bool
+
+	lock          sync.Mutex
+	prepays       map[string]map[string]interface{}
+	prepayPaths   []string
+	closes        []string
+	closeStatuses []int
+}
+
+func (s *capabilityServer) client(t *testing.T) *core.Client {
+	handler := clienttest.HandlerTransport(http.HandlerFunc(s.serveHTTP))
+	client, err := clienttest.NewClient(clienttest.RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if s.broken[lastSegment(req.URL.Path)] {
+			return nil, errors.New("connection reset")
+		}
+		return handler.RoundTrip(req)
+	}))
+	require.NoError(t, err)
+	return client
+}
+
+func (s *capabilityServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	body, _ := ioutil.ReadAll(r.Body)
+	if strings.HasSuffix(r.URL.Path, "/close") {
+		s.closes = append(s.closes, r.URL.Path)
+		status := http.StatusNoContent
+		if len(s.closeStatuses) > 0 {
+			status, s.closeStatuses = s.closeStatuses[0], s.closeStatuses[1:]
+		}
+		switch status {
+		case http.StatusNoContent:
+			w.WriteHeader(status)
+		case http.StatusTooManyRequests:
+			clienttest.WriteError(w, status, "FREQUENCY_LIMITED", "频率超限")
+		case http.StatusBadRequest:
+			clienttest.WriteError(w, status, "PARAM_ERROR", "参数错误")
+		default:
+			clienttest.WriteError(w, status, "SYSTEM_ERROR", "系统错误")
+		}
+		return
+	}
+
+	tradeType := lastSegment(r.URL.Path)
+	var prepay map[string]interface{}
+	_ = json.Unmarshal(body, &prepay)
+	if s.prepays == nil {
+		s.prepays = make(map[string]map[string]interface{})
+	}
+	s.prepays[tradeType] = prepay
+	s.prepayPaths = append(s.prepayPaths, r.URL.Path)
+	if s.disabled[tradeType] {
+		clienttest.WriteError(w, http.StatusForbidden, "NO_AUTH", "商户无权限")
+		return
+	}
+	clienttest.WriteJSON(w, http.StatusOK, map[string]string{"prepay_id": "wx201410272009395522657a690389285100"})
+}
+
+func (s *capabilityServer) closed() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.closes...)
+}
+
+func lastSegment(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+func probeRequest() capability.ProbeRequest {
+	return capability.ProbeRequest{
+		AllowProbeOrders:   true,
+		Appid:              testAppid,
+		Mchid:              testMchid,
+		NotifyUrl:          testNotifyUrl,
+		Openid:             testOpenid,
+		CloseRetryInterval: time.Millisecond,
+	}
+}
+
+func TestProbe(t *testing.T) {
+	server := &capabilityServer{
+		disabled: map[string]bool{"app": true},
+		broken:   map[string]bool{"h5": true},
+	}
+	set, err := capability.Probe(context.Background(), server.client(t), probeRequest())
+	require.NoError(t, err)
+	require.Len(t, set, 4)
+
+	// 已开通：下单成功，探测订单被关闭
+	for _, tradeType := range []capability.TradeType{capability.TradeTypeJSAPI, capability.TradeTypeNative} {
+		c := set[tradeType]
+		assert.Equal(t, capability.StatusEnabled, c.Status, tradeType)
+		assert.NoError(t, c.Err, tradeType)
+		assert.True(t, strings.HasPrefix(c.ProbeOutTradeNo, "PROBE"), c.ProbeOutTradeNo)
+		assert.Contains(t, server.closed(), "/v3/pay/transactions/out-trade-no/"+c.ProbeOutTradeNo+"/close")
+	}
+	assert.Len(t, server.closed(), 2)
+	assert.Equal(t, map[string]interface{}{"openid": testOpenid}, server.prepays["jsapi"]["payer"])
+	assert.Equal(t, testMchid, server.prepays["native"]["mchid"])
+	assert.Equal(t, map[string]interface{}{"total": float64(1)}, server.prepays["native"]["amount"])
+
+	// 未开通：下单返回 NO_AUTH
+	app := set[capability.TradeTypeApp]
+	assert.Equal(t, capability.StatusDisabled, app.Status)
+	assert.True(t, core.IsAPIError(app.Err, "NO_AUTH"))
+
+	// 网络错误：未能判断
+	h5 := set[capability.TradeTypeH5]
+	assert.Equal(t, capability.StatusUnknown, h5.Status)
+	require.Error(t, h5.Err)
+	assert.Contains(t, h5.Err.Error(), "connection reset")
+
+	assert.Equal(t, []capability.TradeType{capability.TradeTypeJSAPI, capability.TradeTypeNative}, set.EnabledTradeTypes())
+	assert.False(t, set.Enabled(capability.TradeTypeH5))
+}
+
+func TestProbe_Partner(t *testing.T) {
+	server := &capabilityServer{}
+	req := probeRequest()
+	req.Appid = "wx8888888888888888"
+	req.SubMchid = "1900000109"
+	req.SubAppid = "wxd678efh567hg6999"
+	set, err := capability.Probe(context.Background(), server.client(t), req)
+	require.NoError(t, err)
+	require.Len(t, set, 4)
+	assert.Len(t, set.EnabledTradeTypes(), 4)
+
+	assert.ElementsMatch(t, []string{
+		"/v3/pay/partner/transactions/jsapi",
+		"/v3/pay/partner/transactions/native",
+		"/v3/pay/partner/transactions/app",
+		"/v3/pay/partner/transactions/h5",
+	}, server.prepayPaths)
+	for _, c := range set {
+		assert.Contains(t, server.closed(), "/v3/pay/partner/transactions/out-trade-no/"+c.ProbeOutTradeNo+"/close")
+	}
+
+	jsapi := server.prepays["jsapi"]
+	assert.Equal(t, "wx8888888888888888", jsapi["sp_appid"])
+	assert.Equal(t, testMchid, jsapi["sp_mchid"])
+	assert.Equal(t, "1900000109", jsapi["sub_mchid"])
+	assert.Equal(t, "wxd678efh567hg6999", jsapi["sub_appid"])
+	assert.Equal(t, map[string]interface{}{"sub_openid": testOpenid}, jsapi["payer"])
+	assert.Equal(t, map[string]interface{}{
+		"payer_client_ip": "127.0.0.1",
+		"h5_info":         map[string]interface{}{"type": "Wap"},
+	}, server.prepays["h5"]["scene_info"])
+}
+
+func TestProbe_PartnerSpOpenid(t *testing.T) {
+	server := &capabilityServer{}
+	req := probeRequest()
+	req.SubMchid = "1900000109"
+	req.TradeTypes = []capability.TradeType{capability.TradeTypeJSAPI}
+	_, err := capability.Probe(context.Background(), server.client(t), req)
+	require.NoError(t, err)
+
+	// 未设置 sub_appid 时，openid 为服务商 appid 下的 sp_openid
+	jsapi := server.prepays["jsapi"]
+	assert.NotContains(t, jsapi, "sub_appid")
+	assert.Equal(t, map[string]interface{}{"sp_openid": testOpenid}, jsapi["payer"])
+}
+
+func TestProbe_CloseRetry(t *testing.T) {
+	server := &capabilityServer{closeStatuses: []int{http.StatusInternalServerError, http.StatusTooManyRequests}}
+	req := probeRequest()
+	req.TradeTypes = []capability.TradeType{capability.TradeTypeNative}
+	set, err := capability.Probe(context.Background(), server.client(t), req)
+	require.NoError(t, err)
+
+	c := set[capability.TradeTypeNative]
+	assert.Equal(t, capability.StatusEnabled, c.Status)
+	assert.NoError(t, c.Err)
+	assert.Len(t, server.closed(), 3)
+}
+
+func TestProbe_CloseRetryExhausted(t *testing.T) {
+	server := &capabilityServer{closeStatuses: []int{
+		http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError,
+	}}
+	req := probeRequest()
+	req.SubMchid = "1900000109"
+	req.TradeTypes = []capability.TradeType{capability.TradeTypeJSAPI}
+	req.CloseMaxAttempts = 2
+	set, err := capability.Probe(context.Background(), server.client(t), req)
+	require.NoError(t, err)
+
+	// 关单失败时仍为已开通，错误记录在 Err 中
+	c := set[capability.TradeTypeJSAPI]
+	assert.Equal(t, capability.StatusEnabled, c.Status)
+	require.Error(t, c.Err)
+	assert.Contains(t, c.Err.Error(), "close probe order "+c.ProbeOutTradeNo)
+	var apiErr *core.APIError
+	require.True(t, errors.As(c.Err, &apiErr))
+	assert.Equal(t, "SYSTEM_ERROR", apiErr.Code)
+	assert.Len(t, server.closed(), 2)
+}
+
+func TestProbe_CloseNotRetryable(t *testing.T) {
+	server := &capabilityServer{closeStatuses: []int{http.StatusBadRequest}}
+	req := probeRequest()
+	req.TradeTypes = []capability.TradeType{capability.TradeTypeApp}
+	set, err := capability.Probe(context.Background(), server.client(t), req)
+	require.NoError(t, err)
+
+	c := set[capability.TradeTypeApp]
+	var apiErr *core.APIError
+	require.True(t, errors.As(c.Err, &apiErr))
+	assert.Equal(t, "PARAM_ERROR", apiErr.Code)
+	assert.Len(t, server.closed(), 1)
+}
+
+func TestProbe_CloseContextCanceled(t *testing.T) {
+	server := &capabilityServer{closeStatuses: []int{http.StatusInternalServerError}}
+	req := probeRequest()
+	req.TradeTypes = []capability.TradeType{capability.TradeTypeNative}
+	req.CloseRetryInterval = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	set, err := capability.Probe(ctx, server.client(t), req)
+	require.NoError(t, err)
+
+	// 等待重试时取消，不再关单
+	c := set[capability.TradeTypeNative]
+	assert.True(t, errors.Is(c.Err, context.DeadlineExceeded))
+	assert.Contains(t, c.Err.Error(), "SYSTEM_ERROR")
+	assert.Len(t, server.closed(), 1)
+}
+
+func TestProbe_NotAllowed(t *testing.T) {
+	server := &capabilityServer{}
+	req := probeRequest()
+	req.AllowProbeOrders = false
+
+	_, err := capability.Probe(context.Background(), server.client(t), req)
+	assert.True(t, errors.Is(err, capability.ErrProbeOrdersNotAllowed))
+	assert.Empty(t, server.prepayPaths)
+}
+
+func TestProbe_InvalidRequest(t *testing.T) {
+	server := &capabilityServer{}
+	client := server.client(t)
+	ctx := context.Background()
+
+	_, err := capability.Probe(ctx, client, capability.ProbeRequest{AllowProbeOrders: true, Appid: testAppid})
+	assert.Error(t, err)
+
+	req := probeRequest()
+	req.TradeTypes = []capability.TradeType{"MICROPAY"}
+	_, err = capability.Probe(ctx, client, req)
+	assert.Error(t, err)
+
+	// 未提供 openid 时不探测 JSAPI
+	req = probeRequest()
+	req.Openid = ""
+	req.TradeTypes = []capability.TradeType{capability.TradeTypeJSAPI}
+	set, err := capability.Probe(ctx, client, req)
+	require.NoError(t, err)
+	assert.Equal(t, capability.StatusUnknown, set[capability.TradeTypeJSAPI].Status)
+	assert.Error(t, set[capability.TradeTypeJSAPI].Err)
+	assert.Empty(t, server.prepays)
+}
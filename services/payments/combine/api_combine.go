@@ -0,0 +1,241 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 合单支付
+//
+// 合单支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package combine
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type CombineApiService services.Service
+
+// AppPrepay 合单APP下单
+//
+// # 应用场景
+// 使用合单支付接口，用户只输入一次密码，即可完成多个订单的支付。目前最多一次可支持50笔订单进行合单支付。本接口用于APP场景，返回的预支付交易会话标识 prepay_id 用于调起支付。
+//
+// 注意：
+// 1、子单的必填参数较多，可使用 NewAppPrepayRequestBuilder 构造请求，避免遗漏
+// 2、合单支付订单只能使用合单关单接口 CloseCombineOrder 关单
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |INVALID_REQUEST|参数错误|参数格式有误或者未按规则上传|订单重入时，要求参数值与原请求一致，请确认参数问题|
+// |APPID_MCHID_NOT_MATCH|appid和mch_id不匹配|appid和mch_id不匹配|请确认appid和mch_id是否匹配|
+// |ORDERPAID|订单已支付|订单已支付，无法重复下单|请确认订单状态|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CombineApiService) AppPrepay(ctx context.Context, req PrepayRequest) (resp *PrepayResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/combine-transactions/app"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract PrepayResponse from Http Response
+	resp = new(PrepayResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// CloseCombineOrder 合单关单
+//
+// # 应用场景
+// 合单支付订单只能使用此合单关单接口进行关单，商户可以在关单时指定需要关闭的子单。
+//
+// 注意：
+// 1、关单没有时间限制，建议在订单生成后间隔几分钟（最短5分钟）再调用关单接口，避免出现订单状态同步不及时导致关单失败
+// 2、sub_orders中至少需要指定一笔子单，最多指定10笔子单，子单需属于该合单
+// 3、已支付的子单不能关闭
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |ORDER_CLOSED|订单已关闭|当前订单已关闭|订单已关闭，无需重复关闭|
+// |ORDERPAID|订单已支付|订单已支付，无法关闭|请确认子单状态|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CombineApiService) CloseCombineOrder(ctx context.Context, req CloseCombineOrderRequest) (result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.CombineOutTradeNo == nil {
+		return nil, fmt.Errorf("field `CombineOutTradeNo` is required and must be specified in CloseCombineOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/combine-transactions/out-trade-no/{combine_out_trade_no}/close"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"combine_out_trade_no"+"}", neturl.PathEscape(core.ParameterToString(*req.CombineOutTradeNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &CloseCombineOrderBody{
+		CombineAppid: req.CombineAppid,
+		SubOrders:    req.SubOrders,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// JsapiPrepay 合单JSAPI下单
+//
+// # 应用场景
+// 使用合单支付接口，用户只输入一次密码，即可完成多个订单的支付。目前最多一次可支持50笔订单进行合单支付。本接口用于公众号、小程序场景，返回的预支付交易会话标识 prepay_id 用于调起支付。
+//
+// 注意：
+// 1、合单JSAPI下单必须传入 combine_payer_info.openid；子单的必填参数较多，可使用 NewJsapiPrepayRequestBuilder 构造请求，避免遗漏
+// 2、合单支付订单只能使用合单关单接口 CloseCombineOrder 关单
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |INVALID_REQUEST|参数错误|参数格式有误或者未按规则上传|订单重入时，要求参数值与原请求一致，请确认参数问题|
+// |APPID_MCHID_NOT_MATCH|appid和mch_id不匹配|appid和mch_id不匹配|请确认appid和mch_id是否匹配|
+// |ORDERPAID|订单已支付|订单已支付，无法重复下单|请确认订单状态|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CombineApiService) JsapiPrepay(ctx context.Context, req PrepayRequest) (resp *PrepayResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/combine-transactions/jsapi"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract PrepayResponse from Http Response
+	resp = new(PrepayResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryCombineOrder 合单查询订单
+//
+// # 应用场景
+// 商户可以通过该接口使用合单支付总订单号查询合单订单及各子单的支付状态。
+//
+// 注意：
+// 1、各子单的交易状态可能不同，例如部分子单已被关闭
+// 2、可使用 OrderStatus 查询并得到各子单归一化的 payments.PaymentStatus
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |ORDER_NOT_EXIST|订单不存在|订单不存在|请检查订单是否发起过交易|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *CombineApiService) QueryCombineOrder(ctx context.Context, req QueryCombineOrderRequest) (resp *CombineTransaction, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.CombineOutTradeNo == nil {
+		return nil, nil, fmt.Errorf("field `CombineOutTradeNo` is required and must be specified in QueryCombineOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/combine-transactions/out-trade-no/{combine_out_trade_no}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"combine_out_trade_no"+"}", neturl.PathEscape(core.ParameterToString(*req.CombineOutTradeNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract CombineTransaction from Http Response
+	resp = new(CombineTransaction)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
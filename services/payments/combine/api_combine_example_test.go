@@ -0,0 +1,154 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 合单支付
+//
+// 合单支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package combine_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/combine"
+)
+
+func ExampleCombineApiService_AppPrepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := combine.CombineApiService{Client: client}
+	resp, result, err := svc.AppPrepay(ctx,
+		combine.PrepayRequest{
+			CombineAppid:      core.String("wxd678efh567hg6787"),
+			CombineMchid:      core.String("1900000109"),
+			CombineOutTradeNo: core.String("P20150806125346"),
+			CombinePayerInfo: &combine.CombinePayerInfo{
+				Openid: core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"),
+			},
+			NotifyUrl: core.String("https://yourapp.com/notify"),
+			SceneInfo: &combine.SceneInfo{
+				DeviceId:      core.String("POS1:1"),
+				PayerClientIp: core.String("14.17.22.32"),
+			},
+			SubOrders: []combine.SubOrder{combine.SubOrder{
+				Amount: &combine.Amount{
+					Currency:    core.String("CNY"),
+					TotalAmount: core.Int64(10),
+				},
+				Attach:      core.String("深圳分店"),
+				Description: core.String("腾讯充值中心-QQ会员充值"),
+				GoodsTag:    core.String("WXG"),
+				Mchid:       core.String("1900000109"),
+				OutTradeNo:  core.String("20150806125346"),
+				SettleInfo: &combine.SettleInfo{
+					ProfitSharing: core.Bool(false),
+					SubsidyAmount: core.Int64(10),
+				},
+				SubMchid: core.String("1900000109"),
+			}},
+			TimeExpire: core.Time(time.Now()),
+			TimeStart:  core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleCombineApiService_CloseCombineOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := combine.CombineApiService{Client: client}
+	result, err := svc.CloseCombineOrder(ctx,
+		combine.CloseCombineOrderRequest{
+			CombineAppid:      core.String("wxd678efh567hg6787"),
+			CombineOutTradeNo: core.String("P20150806125346"),
+			SubOrders: []combine.CloseSubOrder{combine.CloseSubOrder{
+				Mchid:      core.String("1900000109"),
+				OutTradeNo: core.String("20150806125346"),
+				SubAppid:   core.String("wxd678efh567hg6999"),
+				SubMchid:   core.String("1900000109"),
+			}},
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleCombineApiService_JsapiPrepay() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := combine.CombineApiService{Client: client}
+	resp, result, err := svc.JsapiPrepay(ctx,
+		combine.PrepayRequest{
+			CombineAppid:      core.String("wxd678efh567hg6787"),
+			CombineMchid:      core.String("1900000109"),
+			CombineOutTradeNo: core.String("P20150806125346"),
+			CombinePayerInfo: &combine.CombinePayerInfo{
+				Openid: core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"),
+			},
+			NotifyUrl: core.String("https://yourapp.com/notify"),
+			SceneInfo: &combine.SceneInfo{
+				DeviceId:      core.String("POS1:1"),
+				PayerClientIp: core.String("14.17.22.32"),
+			},
+			SubOrders: []combine.SubOrder{combine.SubOrder{
+				Amount: &combine.Amount{
+					Currency:    core.String("CNY"),
+					TotalAmount: core.Int64(10),
+				},
+				Attach:      core.String("深圳分店"),
+				Description: core.String("腾讯充值中心-QQ会员充值"),
+				GoodsTag:    core.String("WXG"),
+				Mchid:       core.String("1900000109"),
+				OutTradeNo:  core.String("20150806125346"),
+				SettleInfo: &combine.SettleInfo{
+					ProfitSharing: core.Bool(false),
+					SubsidyAmount: core.Int64(10),
+				},
+				SubMchid: core.String("1900000109"),
+			}},
+			TimeExpire: core.Time(time.Now()),
+			TimeStart:  core.Time(time.Now()),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleCombineApiService_QueryCombineOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := combine.CombineApiService{Client: client}
+	resp, result, err := svc.QueryCombineOrder(ctx,
+		combine.QueryCombineOrderRequest{
+			CombineOutTradeNo: core.String("P20150806125346"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,53 @@
+package combine
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+// OrderStatus 使用合单支付总订单号查询合单订单，并返回各子单归一化的订单支付状态，顺序与应答中的子单一致
+//
+// 各子单的交易状态可能不同，例如部分子单已被关闭；PayerOpenid 为支付者在 combine_appid 下的 openid。
+func (a *CombineApiService) OrderStatus(ctx context.Context, combineOutTradeNo string) ([]*payments.PaymentStatus, error) {
+	resp, _, err := a.QueryCombineOrder(ctx, QueryCombineOrderRequest{
+		CombineOutTradeNo: core.String(combineOutTradeNo),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newSubOrderPaymentStatuses(resp), nil
+}
+
+func newSubOrderPaymentStatuses(transaction *CombineTransaction) []*payments.PaymentStatus {
+	if transaction == nil {
+		return nil
+	}
+
+	var payer *payments.TransactionPayer
+	if transaction.CombinePayerInfo != nil && transaction.CombinePayerInfo.Openid != nil {
+		payer = &payments.TransactionPayer{Openid: transaction.CombinePayerInfo.Openid}
+	}
+
+	statuses := make([]*payments.PaymentStatus, 0, len(transaction.SubOrders))
+	for _, subOrder := range transaction.SubOrders {
+		normalized := &payments.Transaction{
+			OutTradeNo:    subOrder.OutTradeNo,
+			TransactionId: subOrder.TransactionId,
+			TradeState:    subOrder.TradeState,
+			SuccessTime:   subOrder.SuccessTime,
+			Payer:         payer,
+		}
+		if amount := subOrder.Amount; amount != nil {
+			normalized.Amount = &payments.TransactionAmount{
+				Total:         amount.TotalAmount,
+				PayerTotal:    amount.PayerAmount,
+				Currency:      amount.Currency,
+				PayerCurrency: amount.PayerCurrency,
+			}
+		}
+		statuses = append(statuses, payments.NewPaymentStatus(normalized))
+	}
+	return statuses
+}
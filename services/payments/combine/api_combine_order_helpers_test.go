@@ -0,0 +1,79 @@
+package combine_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/combine"
+)
+
+func TestCombineApiService_OrderStatus(t *testing.T) {
+	var path string
+	client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"combine_appid":        "wxd678efh567hg6787",
+			"combine_mchid":        "1900000100",
+			"combine_out_trade_no": "P20150806125346",
+			"combine_payer_info":   map[string]interface{}{"openid": "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"},
+			"sub_orders": []interface{}{
+				map[string]interface{}{
+					"mchid":          "1900000109",
+					"out_trade_no":   "20150806125346",
+					"transaction_id": "1009660380201506130728806387",
+					"trade_state":    "SUCCESS",
+					"success_time":   "2018-06-08T10:34:56+08:00",
+					"amount":         map[string]interface{}{"total_amount": 10, "payer_amount": 9, "currency": "CNY"},
+				},
+				map[string]interface{}{
+					"mchid":        "1900000109",
+					"out_trade_no": "20150806125347",
+					"trade_state":  "CLOSED",
+					"amount":       map[string]interface{}{"total_amount": 20, "currency": "CNY"},
+				},
+			},
+		})
+	}))
+	require.NoError(t, err)
+
+	svc := combine.CombineApiService{Client: client}
+	statuses, err := svc.OrderStatus(context.Background(), "P20150806125346")
+	require.NoError(t, err)
+	assert.Equal(t, "/v3/combine-transactions/out-trade-no/P20150806125346", path)
+	require.Len(t, statuses, 2)
+
+	// 各子单分别归一化，支付者为合单发起方 appid 下的 openid
+	assert.Equal(t, &payments.PaymentStatus{
+		State:         payments.PaymentStatePaid,
+		TradeState:    "SUCCESS",
+		OutTradeNo:    "20150806125346",
+		TransactionId: "1009660380201506130728806387",
+		Total:         10,
+		PaidAmount:    9,
+		PayerOpenid:   "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o",
+		SuccessTime:   "2018-06-08T10:34:56+08:00",
+	}, statuses[0])
+	assert.Equal(t, payments.PaymentStateClosed, statuses[1].State)
+	assert.Equal(t, "20150806125347", statuses[1].OutTradeNo)
+	assert.Equal(t, int64(20), statuses[1].Total)
+	assert.Equal(t, int64(0), statuses[1].PaidAmount)
+}
+
+func TestCombineApiService_OrderStatusError(t *testing.T) {
+	client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clienttest.WriteError(w, http.StatusNotFound, "ORDER_NOT_EXIST", "订单不存在")
+	}))
+	require.NoError(t, err)
+
+	svc := combine.CombineApiService{Client: client}
+	statuses, err := svc.OrderStatus(context.Background(), "P20150806125346")
+	assert.True(t, core.IsAPIError(err, "ORDER_NOT_EXIST"))
+	assert.Nil(t, statuses)
+}
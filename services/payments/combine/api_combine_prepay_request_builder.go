@@ -0,0 +1,101 @@
+package combine
+
+import (
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// NewSubOrder 使用子单的全部必填参数创建 SubOrder，币种为人民币（CNY）
+//
+// 可选字段（如 goods_tag、settle_info、服务商模式下的 sub_mchid）可在返回值上直接设置。
+func NewSubOrder(mchid, outTradeNo, description, attach string, totalAmount int64) SubOrder {
+	return SubOrder{
+		Mchid:       core.String(mchid),
+		Attach:      core.String(attach),
+		Amount:      &Amount{TotalAmount: core.Int64(totalAmount), Currency: core.String("CNY")},
+		OutTradeNo:  core.String(outTradeNo),
+		Description: core.String(description),
+	}
+}
+
+// PrepayRequestBuilder 合单下单请求 PrepayRequest 构造器
+//
+// 所有必填参数均需在 NewJsapiPrepayRequestBuilder 或 NewAppPrepayRequestBuilder 中传入，可选参数通过链式调用设置，
+// 子单中的必填参数体现在 NewSubOrder 的签名中，且构造函数至少需要传入一笔子单，从而在编译期避免遗漏必填参数。
+type PrepayRequestBuilder struct {
+	req PrepayRequest
+}
+
+// NewJsapiPrepayRequestBuilder 使用合单JSAPI下单的全部必填参数初始化 PrepayRequestBuilder
+//
+// openid 为用户在合单发起方 combineAppid 下的唯一标识，请求通过 CombineApiService.JsapiPrepay 发送。
+func NewJsapiPrepayRequestBuilder(
+	combineAppid, combineMchid, combineOutTradeNo, notifyUrl, openid string, subOrder SubOrder, moreSubOrders ...SubOrder,
+) *PrepayRequestBuilder {
+	b := newPrepayRequestBuilder(combineAppid, combineMchid, combineOutTradeNo, notifyUrl, subOrder, moreSubOrders)
+	b.req.CombinePayerInfo = &CombinePayerInfo{Openid: core.String(openid)}
+	return b
+}
+
+// NewAppPrepayRequestBuilder 使用合单APP下单的全部必填参数初始化 PrepayRequestBuilder
+//
+// 请求通过 CombineApiService.AppPrepay 发送。
+func NewAppPrepayRequestBuilder(
+	combineAppid, combineMchid, combineOutTradeNo, notifyUrl string, subOrder SubOrder, moreSubOrders ...SubOrder,
+) *PrepayRequestBuilder {
+	return newPrepayRequestBuilder(combineAppid, combineMchid, combineOutTradeNo, notifyUrl, subOrder, moreSubOrders)
+}
+
+func newPrepayRequestBuilder(
+	combineAppid, combineMchid, combineOutTradeNo, notifyUrl string, subOrder SubOrder, moreSubOrders []SubOrder,
+) *PrepayRequestBuilder {
+	subOrders := make([]SubOrder, 0, 1+len(moreSubOrders))
+	for _, o := range append([]SubOrder{subOrder}, moreSubOrders...) {
+		subOrders = append(subOrders, *o.Clone())
+	}
+	return &PrepayRequestBuilder{
+		req: PrepayRequest{
+			CombineAppid:      core.String(combineAppid),
+			CombineMchid:      core.String(combineMchid),
+			CombineOutTradeNo: core.String(combineOutTradeNo),
+			SubOrders:         subOrders,
+			NotifyUrl:         core.String(notifyUrl),
+		},
+	}
+}
+
+// AddSubOrder 追加子单，子单应通过 NewSubOrder 创建
+func (b *PrepayRequestBuilder) AddSubOrder(subOrders ...SubOrder) *PrepayRequestBuilder {
+	for _, o := range subOrders {
+		b.req.SubOrders = append(b.req.SubOrders, *o.Clone())
+	}
+	return b
+}
+
+// WithSceneInfo 设置支付场景描述，payerClientIp 为必填的用户终端IP，deviceId 为空时不设置
+func (b *PrepayRequestBuilder) WithSceneInfo(payerClientIp, deviceId string) *PrepayRequestBuilder {
+	sceneInfo := &SceneInfo{PayerClientIp: core.String(payerClientIp)}
+	if deviceId != "" {
+		sceneInfo.DeviceId = core.String(deviceId)
+	}
+	b.req.SceneInfo = sceneInfo
+	return b
+}
+
+// WithTimeStart 设置订单生成时间
+func (b *PrepayRequestBuilder) WithTimeStart(timeStart time.Time) *PrepayRequestBuilder {
+	b.req.TimeStart = core.Time(timeStart)
+	return b
+}
+
+// WithTimeExpire 设置订单失效时间
+func (b *PrepayRequestBuilder) WithTimeExpire(timeExpire time.Time) *PrepayRequestBuilder {
+	b.req.TimeExpire = core.Time(timeExpire)
+	return b
+}
+
+// Build 生成 PrepayRequest，每次调用均返回一份独立的副本
+func (b *PrepayRequestBuilder) Build() PrepayRequest {
+	return *b.req.Clone()
+}
@@ -0,0 +1,35 @@
+package combine_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/combine"
+)
+
+func ExampleNewJsapiPrepayRequestBuilder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	subOrder := combine.NewSubOrder("1900000109", "20150806125346", "腾讯充值中心-QQ会员充值", "深圳分店", 10)
+	subOrder.SettleInfo = &combine.SettleInfo{ProfitSharing: core.Bool(true)}
+
+	req := combine.NewJsapiPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1900000109", "P20150806125346", "https://yourapp.com/notify",
+		"oUpF8uMuAJO_M2pxb1Q9zNjWeS6o", subOrder,
+		combine.NewSubOrder("1900000109", "20150806125347", "腾讯充值中心-QQ会员充值", "深圳分店", 20),
+	).
+		WithTimeExpire(time.Now().Add(30*time.Minute)).
+		WithSceneInfo("14.17.22.32", "POS1:1").
+		Build()
+
+	svc := combine.CombineApiService{Client: client}
+	resp, result, err := svc.JsapiPrepay(ctx, req)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,50 @@
+package combine_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/combine"
+)
+
+func TestPrepayRequestBuilder_Build(t *testing.T) {
+	first := combine.NewSubOrder("1900000109", "20150806125346", "腾讯充值中心-QQ会员充值", "深圳分店", 10)
+	second := combine.NewSubOrder("1900000110", "20150806125347", "腾讯充值中心-QQ会员充值", "广州分店", 20)
+	second.GoodsTag = core.String("WXG")
+
+	req := combine.NewJsapiPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1900000100", "P20150806125346", "https://yourapp.com/notify",
+		"oUpF8uMuAJO_M2pxb1Q9zNjWeS6o", first, second,
+	).Build()
+	assert.Equal(t, "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o", *req.CombinePayerInfo.Openid)
+	require.Len(t, req.SubOrders, 2)
+	assert.Equal(t, "CNY", *req.SubOrders[0].Amount.Currency)
+	assert.Equal(t, int64(20), *req.SubOrders[1].Amount.TotalAmount)
+	assert.Equal(t, "WXG", *req.SubOrders[1].GoodsTag)
+	// 未设置支付场景描述时不生成 scene_info
+	assert.Nil(t, req.SceneInfo)
+	_, err := req.MarshalJSON()
+	require.NoError(t, err)
+
+	// 子单在传入时复制，之后修改原值不影响构造器
+	*first.Attach = "modified"
+	builder := combine.NewAppPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1900000100", "P20150806125347", "https://yourapp.com/notify", second,
+	).
+		AddSubOrder(combine.NewSubOrder("1900000111", "20150806125348", "腾讯充值中心-QQ会员充值", "深圳分店", 30)).
+		WithSceneInfo("14.17.22.32", "")
+	req = builder.Build()
+	assert.Equal(t, "深圳分店", *req.SubOrders[1].Attach)
+	assert.Nil(t, req.CombinePayerInfo)
+	assert.Equal(t, "14.17.22.32", *req.SceneInfo.PayerClientIp)
+	assert.Nil(t, req.SceneInfo.DeviceId)
+	_, err = req.MarshalJSON()
+	require.NoError(t, err)
+
+	// 每次 Build 返回独立的副本
+	*req.SubOrders[0].OutTradeNo = "modified"
+	assert.Equal(t, "20150806125347", *builder.Build().SubOrders[0].OutTradeNo)
+}
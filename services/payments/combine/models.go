@@ -0,0 +1,1349 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 合单支付
+//
+// 合单支付API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package combine
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Amount
+type Amount struct {
+	// 子单金额，单位为分
+	TotalAmount *int64 `json:"total_amount"`
+	// 符合ISO 4217标准的三位字母代码，境内商户号仅支持人民币（CNY）
+	Currency *string `json:"currency"`
+}
+
+func (o Amount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TotalAmount == nil {
+		return nil, fmt.Errorf("field `TotalAmount` is required and must be specified in Amount")
+	}
+	toSerialize["total_amount"] = o.TotalAmount
+
+	if o.Currency == nil {
+		return nil, fmt.Errorf("field `Currency` is required and must be specified in Amount")
+	}
+	toSerialize["currency"] = o.Currency
+	return json.Marshal(toSerialize)
+}
+
+func (o Amount) String() string {
+	var ret string
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>"
+	} else {
+		ret += fmt.Sprintf("Currency:%v", *o.Currency)
+	}
+
+	return fmt.Sprintf("Amount{%s}", ret)
+}
+
+func (o Amount) Clone() *Amount {
+	ret := Amount{}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	return &ret
+}
+
+// CloseCombineOrderBody
+type CloseCombineOrderBody struct {
+	// 合单发起方的appid
+	CombineAppid *string `json:"combine_appid"`
+	// 子单信息，至少指定一笔、最多指定10笔需要关闭的子单
+	SubOrders []CloseSubOrder `json:"sub_orders"`
+}
+
+func (o CloseCombineOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CombineAppid == nil {
+		return nil, fmt.Errorf("field `CombineAppid` is required and must be specified in CloseCombineOrderBody")
+	}
+	toSerialize["combine_appid"] = o.CombineAppid
+
+	if o.SubOrders == nil {
+		return nil, fmt.Errorf("field `SubOrders` is required and must be specified in CloseCombineOrderBody")
+	}
+	if len(o.SubOrders) < 1 {
+		return nil, fmt.Errorf("field `SubOrders` must contain at least 1 items in CloseCombineOrderBody")
+	}
+	toSerialize["sub_orders"] = o.SubOrders
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseCombineOrderBody) String() string {
+	var ret string
+	if o.CombineAppid == nil {
+		ret += "CombineAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineAppid:%v, ", *o.CombineAppid)
+	}
+
+	ret += fmt.Sprintf("SubOrders:%v", o.SubOrders)
+
+	return fmt.Sprintf("CloseCombineOrderBody{%s}", ret)
+}
+
+func (o CloseCombineOrderBody) Clone() *CloseCombineOrderBody {
+	ret := CloseCombineOrderBody{}
+
+	if o.CombineAppid != nil {
+		ret.CombineAppid = new(string)
+		*ret.CombineAppid = *o.CombineAppid
+	}
+
+	if o.SubOrders != nil {
+		ret.SubOrders = make([]CloseSubOrder, len(o.SubOrders))
+		for i, item := range o.SubOrders {
+			ret.SubOrders[i] = *item.Clone()
+		}
+	}
+
+	return &ret
+}
+
+// CloseCombineOrderRequest
+type CloseCombineOrderRequest struct {
+	// 合单支付总订单号，要求32个字符内，只能是数字、大小写字母_-|*@ ，且在同一个商户号下唯一
+	CombineOutTradeNo *string `json:"combine_out_trade_no"`
+	// 合单发起方的appid
+	CombineAppid *string `json:"combine_appid"`
+	// 子单信息，至少指定一笔、最多指定10笔需要关闭的子单
+	SubOrders []CloseSubOrder `json:"sub_orders"`
+}
+
+func (o CloseCombineOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CombineOutTradeNo == nil {
+		return nil, fmt.Errorf("field `CombineOutTradeNo` is required and must be specified in CloseCombineOrderRequest")
+	}
+	toSerialize["combine_out_trade_no"] = o.CombineOutTradeNo
+
+	if o.CombineAppid == nil {
+		return nil, fmt.Errorf("field `CombineAppid` is required and must be specified in CloseCombineOrderRequest")
+	}
+	toSerialize["combine_appid"] = o.CombineAppid
+
+	if o.SubOrders == nil {
+		return nil, fmt.Errorf("field `SubOrders` is required and must be specified in CloseCombineOrderRequest")
+	}
+	if len(o.SubOrders) < 1 {
+		return nil, fmt.Errorf("field `SubOrders` must contain at least 1 items in CloseCombineOrderRequest")
+	}
+	toSerialize["sub_orders"] = o.SubOrders
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseCombineOrderRequest) String() string {
+	var ret string
+	if o.CombineOutTradeNo == nil {
+		ret += "CombineOutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineOutTradeNo:%v, ", *o.CombineOutTradeNo)
+	}
+
+	if o.CombineAppid == nil {
+		ret += "CombineAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineAppid:%v, ", *o.CombineAppid)
+	}
+
+	ret += fmt.Sprintf("SubOrders:%v", o.SubOrders)
+
+	return fmt.Sprintf("CloseCombineOrderRequest{%s}", ret)
+}
+
+func (o CloseCombineOrderRequest) Clone() *CloseCombineOrderRequest {
+	ret := CloseCombineOrderRequest{}
+
+	if o.CombineOutTradeNo != nil {
+		ret.CombineOutTradeNo = new(string)
+		*ret.CombineOutTradeNo = *o.CombineOutTradeNo
+	}
+
+	if o.CombineAppid != nil {
+		ret.CombineAppid = new(string)
+		*ret.CombineAppid = *o.CombineAppid
+	}
+
+	if o.SubOrders != nil {
+		ret.SubOrders = make([]CloseSubOrder, len(o.SubOrders))
+		for i, item := range o.SubOrders {
+			ret.SubOrders[i] = *item.Clone()
+		}
+	}
+
+	return &ret
+}
+
+// CloseSubOrder
+type CloseSubOrder struct {
+	// 子单发起方商户号，必须与发起方appid有绑定关系。服务商模式下为服务商商户号
+	Mchid *string `json:"mchid"`
+	// 商户系统内部订单号，要求32个字符内，只能是数字、大小写字母_-|*@ ，且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 二级商户（或子商户）的商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 子商户申请的公众号或移动应用appid，服务商模式下选填
+	SubAppid *string `json:"sub_appid,omitempty"`
+}
+
+func (o CloseSubOrder) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in CloseSubOrder")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in CloseSubOrder")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CloseSubOrder) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v", *o.SubAppid)
+	}
+
+	return fmt.Sprintf("CloseSubOrder{%s}", ret)
+}
+
+func (o CloseSubOrder) Clone() *CloseSubOrder {
+	ret := CloseSubOrder{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	return &ret
+}
+
+// CombinePayerInfo
+type CombinePayerInfo struct {
+	// 用户在合单发起方appid下的唯一标识，合单JSAPI下单时必填
+	Openid *string `json:"openid,omitempty"`
+}
+
+func (o CombinePayerInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Openid != nil {
+		toSerialize["openid"] = o.Openid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CombinePayerInfo) String() string {
+	var ret string
+	if o.Openid == nil {
+		ret += "Openid:<nil>"
+	} else {
+		ret += fmt.Sprintf("Openid:%v", *o.Openid)
+	}
+
+	return fmt.Sprintf("CombinePayerInfo{%s}", ret)
+}
+
+func (o CombinePayerInfo) Clone() *CombinePayerInfo {
+	ret := CombinePayerInfo{}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	return &ret
+}
+
+// HasOpenid 应答中是否返回了 openid，o 为 nil 时返回 false
+func (o *CombinePayerInfo) HasOpenid() bool {
+	return o != nil && o.Openid != nil
+}
+
+// CombineTransaction
+type CombineTransaction struct {
+	// 合单发起方的appid
+	CombineAppid *string `json:"combine_appid,omitempty"`
+	// 合单发起方商户号，服务商模式下为服务商商户号
+	CombineMchid *string `json:"combine_mchid,omitempty"`
+	// 合单支付总订单号
+	CombineOutTradeNo *string    `json:"combine_out_trade_no,omitempty"`
+	SceneInfo         *SceneInfo `json:"scene_info,omitempty"`
+	// 子单信息
+	SubOrders        []SubOrderTransaction `json:"sub_orders,omitempty"`
+	CombinePayerInfo *CombinePayerInfo     `json:"combine_payer_info,omitempty"`
+}
+
+func (o CombineTransaction) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CombineAppid != nil {
+		toSerialize["combine_appid"] = o.CombineAppid
+	}
+
+	if o.CombineMchid != nil {
+		toSerialize["combine_mchid"] = o.CombineMchid
+	}
+
+	if o.CombineOutTradeNo != nil {
+		toSerialize["combine_out_trade_no"] = o.CombineOutTradeNo
+	}
+
+	if o.SceneInfo != nil {
+		toSerialize["scene_info"] = o.SceneInfo
+	}
+
+	if o.SubOrders != nil {
+		toSerialize["sub_orders"] = o.SubOrders
+	}
+
+	if o.CombinePayerInfo != nil {
+		toSerialize["combine_payer_info"] = o.CombinePayerInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CombineTransaction) String() string {
+	var ret string
+	if o.CombineAppid == nil {
+		ret += "CombineAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineAppid:%v, ", *o.CombineAppid)
+	}
+
+	if o.CombineMchid == nil {
+		ret += "CombineMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineMchid:%v, ", *o.CombineMchid)
+	}
+
+	if o.CombineOutTradeNo == nil {
+		ret += "CombineOutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineOutTradeNo:%v, ", *o.CombineOutTradeNo)
+	}
+
+	ret += fmt.Sprintf("SceneInfo:%v, ", o.SceneInfo)
+
+	ret += fmt.Sprintf("SubOrders:%v, ", o.SubOrders)
+
+	ret += fmt.Sprintf("CombinePayerInfo:%v", o.CombinePayerInfo)
+
+	return fmt.Sprintf("CombineTransaction{%s}", ret)
+}
+
+func (o CombineTransaction) Clone() *CombineTransaction {
+	ret := CombineTransaction{}
+
+	if o.CombineAppid != nil {
+		ret.CombineAppid = new(string)
+		*ret.CombineAppid = *o.CombineAppid
+	}
+
+	if o.CombineMchid != nil {
+		ret.CombineMchid = new(string)
+		*ret.CombineMchid = *o.CombineMchid
+	}
+
+	if o.CombineOutTradeNo != nil {
+		ret.CombineOutTradeNo = new(string)
+		*ret.CombineOutTradeNo = *o.CombineOutTradeNo
+	}
+
+	if o.SceneInfo != nil {
+		ret.SceneInfo = o.SceneInfo.Clone()
+	}
+
+	if o.SubOrders != nil {
+		ret.SubOrders = make([]SubOrderTransaction, len(o.SubOrders))
+		for i, item := range o.SubOrders {
+			ret.SubOrders[i] = *item.Clone()
+		}
+	}
+
+	if o.CombinePayerInfo != nil {
+		ret.CombinePayerInfo = o.CombinePayerInfo.Clone()
+	}
+
+	return &ret
+}
+
+// HasCombineAppid 应答中是否返回了 combine_appid，o 为 nil 时返回 false
+func (o *CombineTransaction) HasCombineAppid() bool {
+	return o != nil && o.CombineAppid != nil
+}
+
+// HasCombineMchid 应答中是否返回了 combine_mchid，o 为 nil 时返回 false
+func (o *CombineTransaction) HasCombineMchid() bool {
+	return o != nil && o.CombineMchid != nil
+}
+
+// HasCombineOutTradeNo 应答中是否返回了 combine_out_trade_no，o 为 nil 时返回 false
+func (o *CombineTransaction) HasCombineOutTradeNo() bool {
+	return o != nil && o.CombineOutTradeNo != nil
+}
+
+// HasSceneInfo 应答中是否返回了 scene_info，o 为 nil 时返回 false
+func (o *CombineTransaction) HasSceneInfo() bool {
+	return o != nil && o.SceneInfo != nil
+}
+
+// HasSubOrders 应答中是否返回了 sub_orders，o 为 nil 时返回 false
+func (o *CombineTransaction) HasSubOrders() bool {
+	return o != nil && o.SubOrders != nil
+}
+
+// HasCombinePayerInfo 应答中是否返回了 combine_payer_info，o 为 nil 时返回 false
+func (o *CombineTransaction) HasCombinePayerInfo() bool {
+	return o != nil && o.CombinePayerInfo != nil
+}
+
+// PrepayRequest
+type PrepayRequest struct {
+	// 合单发起方的appid
+	CombineAppid *string `json:"combine_appid"`
+	// 合单发起方商户号，服务商模式下为服务商商户号
+	CombineMchid *string `json:"combine_mchid"`
+	// 合单支付总订单号，要求32个字符内，只能是数字、大小写字母_-|*@ ，且在同一个商户号下唯一
+	CombineOutTradeNo *string    `json:"combine_out_trade_no"`
+	SceneInfo         *SceneInfo `json:"scene_info,omitempty"`
+	// 子单信息，最多支持50笔子单
+	SubOrders        []SubOrder        `json:"sub_orders"`
+	CombinePayerInfo *CombinePayerInfo `json:"combine_payer_info,omitempty"`
+	// 订单生成时间
+	TimeStart *time.Time `json:"time_start,omitempty"`
+	// 订单失效时间
+	TimeExpire *time.Time `json:"time_expire,omitempty"`
+	// 接收微信支付异步通知回调地址，通知url必须为直接可访问的URL，不能携带参数
+	NotifyUrl *string `json:"notify_url"`
+}
+
+func (o PrepayRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CombineAppid == nil {
+		return nil, fmt.Errorf("field `CombineAppid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["combine_appid"] = o.CombineAppid
+
+	if o.CombineMchid == nil {
+		return nil, fmt.Errorf("field `CombineMchid` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["combine_mchid"] = o.CombineMchid
+
+	if o.CombineOutTradeNo == nil {
+		return nil, fmt.Errorf("field `CombineOutTradeNo` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["combine_out_trade_no"] = o.CombineOutTradeNo
+
+	if o.SceneInfo != nil {
+		toSerialize["scene_info"] = o.SceneInfo
+	}
+
+	if o.SubOrders == nil {
+		return nil, fmt.Errorf("field `SubOrders` is required and must be specified in PrepayRequest")
+	}
+	if len(o.SubOrders) < 1 {
+		return nil, fmt.Errorf("field `SubOrders` must contain at least 1 items in PrepayRequest")
+	}
+	toSerialize["sub_orders"] = o.SubOrders
+
+	if o.CombinePayerInfo != nil {
+		toSerialize["combine_payer_info"] = o.CombinePayerInfo
+	}
+
+	if o.TimeStart != nil {
+		toSerialize["time_start"] = o.TimeStart.Format(time.RFC3339)
+	}
+
+	if o.TimeExpire != nil {
+		toSerialize["time_expire"] = o.TimeExpire.Format(time.RFC3339)
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in PrepayRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+	return json.Marshal(toSerialize)
+}
+
+func (o PrepayRequest) String() string {
+	var ret string
+	if o.CombineAppid == nil {
+		ret += "CombineAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineAppid:%v, ", *o.CombineAppid)
+	}
+
+	if o.CombineMchid == nil {
+		ret += "CombineMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineMchid:%v, ", *o.CombineMchid)
+	}
+
+	if o.CombineOutTradeNo == nil {
+		ret += "CombineOutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CombineOutTradeNo:%v, ", *o.CombineOutTradeNo)
+	}
+
+	ret += fmt.Sprintf("SceneInfo:%v, ", o.SceneInfo)
+
+	ret += fmt.Sprintf("SubOrders:%v, ", o.SubOrders)
+
+	ret += fmt.Sprintf("CombinePayerInfo:%v, ", o.CombinePayerInfo)
+
+	if o.TimeStart == nil {
+		ret += "TimeStart:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeStart:%v, ", *o.TimeStart)
+	}
+
+	if o.TimeExpire == nil {
+		ret += "TimeExpire:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TimeExpire:%v, ", *o.TimeExpire)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>"
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v", *o.NotifyUrl)
+	}
+
+	return fmt.Sprintf("PrepayRequest{%s}", ret)
+}
+
+func (o PrepayRequest) Clone() *PrepayRequest {
+	ret := PrepayRequest{}
+
+	if o.CombineAppid != nil {
+		ret.CombineAppid = new(string)
+		*ret.CombineAppid = *o.CombineAppid
+	}
+
+	if o.CombineMchid != nil {
+		ret.CombineMchid = new(string)
+		*ret.CombineMchid = *o.CombineMchid
+	}
+
+	if o.CombineOutTradeNo != nil {
+		ret.CombineOutTradeNo = new(string)
+		*ret.CombineOutTradeNo = *o.CombineOutTradeNo
+	}
+
+	if o.SceneInfo != nil {
+		ret.SceneInfo = o.SceneInfo.Clone()
+	}
+
+	if o.SubOrders != nil {
+		ret.SubOrders = make([]SubOrder, len(o.SubOrders))
+		for i, item := range o.SubOrders {
+			ret.SubOrders[i] = *item.Clone()
+		}
+	}
+
+	if o.CombinePayerInfo != nil {
+		ret.CombinePayerInfo = o.CombinePayerInfo.Clone()
+	}
+
+	if o.TimeStart != nil {
+		ret.TimeStart = new(time.Time)
+		*ret.TimeStart = *o.TimeStart
+	}
+
+	if o.TimeExpire != nil {
+		ret.TimeExpire = new(time.Time)
+		*ret.TimeExpire = *o.TimeExpire
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	return &ret
+}
+
+// PrepayResponse
+type PrepayResponse struct {
+	// 预支付交易会话标识，用于后续接口调用中使用，该值有效期为2小时
+	PrepayId *string `json:"prepay_id,omitempty"`
+}
+
+func (o PrepayResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PrepayId != nil {
+		toSerialize["prepay_id"] = o.PrepayId
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o PrepayResponse) String() string {
+	var ret string
+	if o.PrepayId == nil {
+		ret += "PrepayId:<nil>"
+	} else {
+		ret += fmt.Sprintf("PrepayId:%v", *o.PrepayId)
+	}
+
+	return fmt.Sprintf("PrepayResponse{%s}", ret)
+}
+
+func (o PrepayResponse) Clone() *PrepayResponse {
+	ret := PrepayResponse{}
+
+	if o.PrepayId != nil {
+		ret.PrepayId = new(string)
+		*ret.PrepayId = *o.PrepayId
+	}
+
+	return &ret
+}
+
+// HasPrepayId 应答中是否返回了 prepay_id，o 为 nil 时返回 false
+func (o *PrepayResponse) HasPrepayId() bool {
+	return o != nil && o.PrepayId != nil
+}
+
+// QueryCombineOrderRequest
+type QueryCombineOrderRequest struct {
+	// 合单支付总订单号，要求32个字符内，只能是数字、大小写字母_-|*@ ，且在同一个商户号下唯一
+	CombineOutTradeNo *string `json:"combine_out_trade_no"`
+}
+
+func (o QueryCombineOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.CombineOutTradeNo == nil {
+		return nil, fmt.Errorf("field `CombineOutTradeNo` is required and must be specified in QueryCombineOrderRequest")
+	}
+	toSerialize["combine_out_trade_no"] = o.CombineOutTradeNo
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryCombineOrderRequest) String() string {
+	var ret string
+	if o.CombineOutTradeNo == nil {
+		ret += "CombineOutTradeNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("CombineOutTradeNo:%v", *o.CombineOutTradeNo)
+	}
+
+	return fmt.Sprintf("QueryCombineOrderRequest{%s}", ret)
+}
+
+func (o QueryCombineOrderRequest) Clone() *QueryCombineOrderRequest {
+	ret := QueryCombineOrderRequest{}
+
+	if o.CombineOutTradeNo != nil {
+		ret.CombineOutTradeNo = new(string)
+		*ret.CombineOutTradeNo = *o.CombineOutTradeNo
+	}
+
+	return &ret
+}
+
+// SceneInfo
+type SceneInfo struct {
+	// 商户端设备号
+	DeviceId *string `json:"device_id,omitempty"`
+	// 用户终端IP
+	PayerClientIp *string `json:"payer_client_ip"`
+}
+
+func (o SceneInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.DeviceId != nil {
+		toSerialize["device_id"] = o.DeviceId
+	}
+
+	if o.PayerClientIp == nil {
+		return nil, fmt.Errorf("field `PayerClientIp` is required and must be specified in SceneInfo")
+	}
+	toSerialize["payer_client_ip"] = o.PayerClientIp
+	return json.Marshal(toSerialize)
+}
+
+func (o SceneInfo) String() string {
+	var ret string
+	if o.DeviceId == nil {
+		ret += "DeviceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("DeviceId:%v, ", *o.DeviceId)
+	}
+
+	if o.PayerClientIp == nil {
+		ret += "PayerClientIp:<nil>"
+	} else {
+		ret += fmt.Sprintf("PayerClientIp:%v", *o.PayerClientIp)
+	}
+
+	return fmt.Sprintf("SceneInfo{%s}", ret)
+}
+
+func (o SceneInfo) Clone() *SceneInfo {
+	ret := SceneInfo{}
+
+	if o.DeviceId != nil {
+		ret.DeviceId = new(string)
+		*ret.DeviceId = *o.DeviceId
+	}
+
+	if o.PayerClientIp != nil {
+		ret.PayerClientIp = new(string)
+		*ret.PayerClientIp = *o.PayerClientIp
+	}
+
+	return &ret
+}
+
+// HasDeviceId 应答中是否返回了 device_id，o 为 nil 时返回 false
+func (o *SceneInfo) HasDeviceId() bool {
+	return o != nil && o.DeviceId != nil
+}
+
+// SettleInfo
+type SettleInfo struct {
+	// 是否指定分账
+	ProfitSharing *bool `json:"profit_sharing,omitempty"`
+	// 补差金额，单位为分，仅电商平台的补差场景使用
+	SubsidyAmount *int64 `json:"subsidy_amount,omitempty"`
+}
+
+func (o SettleInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ProfitSharing != nil {
+		toSerialize["profit_sharing"] = o.ProfitSharing
+	}
+
+	if o.SubsidyAmount != nil {
+		toSerialize["subsidy_amount"] = o.SubsidyAmount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SettleInfo) String() string {
+	var ret string
+	if o.ProfitSharing == nil {
+		ret += "ProfitSharing:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ProfitSharing:%v, ", *o.ProfitSharing)
+	}
+
+	if o.SubsidyAmount == nil {
+		ret += "SubsidyAmount:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubsidyAmount:%v", *o.SubsidyAmount)
+	}
+
+	return fmt.Sprintf("SettleInfo{%s}", ret)
+}
+
+func (o SettleInfo) Clone() *SettleInfo {
+	ret := SettleInfo{}
+
+	if o.ProfitSharing != nil {
+		ret.ProfitSharing = new(bool)
+		*ret.ProfitSharing = *o.ProfitSharing
+	}
+
+	if o.SubsidyAmount != nil {
+		ret.SubsidyAmount = new(int64)
+		*ret.SubsidyAmount = *o.SubsidyAmount
+	}
+
+	return &ret
+}
+
+// SubOrder
+type SubOrder struct {
+	// 子单发起方商户号，必须与发起方appid有绑定关系。服务商模式下为服务商商户号
+	Mchid *string `json:"mchid"`
+	// 附加数据，在查询API和支付通知中原样返回，可作为自定义参数使用
+	Attach *string `json:"attach"`
+	Amount *Amount `json:"amount"`
+	// 商户系统内部订单号，要求32个字符内，只能是数字、大小写字母_-|*@ ，且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 二级商户（或子商户）的商户号，服务商模式下必填
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// 商品描述
+	Description *string     `json:"description"`
+	SettleInfo  *SettleInfo `json:"settle_info,omitempty"`
+}
+
+func (o SubOrder) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in SubOrder")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.Attach == nil {
+		return nil, fmt.Errorf("field `Attach` is required and must be specified in SubOrder")
+	}
+	toSerialize["attach"] = o.Attach
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in SubOrder")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in SubOrder")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in SubOrder")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.SettleInfo != nil {
+		toSerialize["settle_info"] = o.SettleInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SubOrder) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	ret += fmt.Sprintf("SettleInfo:%v", o.SettleInfo)
+
+	return fmt.Sprintf("SubOrder{%s}", ret)
+}
+
+func (o SubOrder) Clone() *SubOrder {
+	ret := SubOrder{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.SettleInfo != nil {
+		ret.SettleInfo = o.SettleInfo.Clone()
+	}
+
+	return &ret
+}
+
+// SubOrderAmount
+type SubOrderAmount struct {
+	// 子单金额，单位为分
+	TotalAmount *int64 `json:"total_amount,omitempty"`
+	// 货币类型，CNY：人民币，境内商户号仅支持人民币
+	Currency *string `json:"currency,omitempty"`
+	// 子单用户实际支付金额，单位为分
+	PayerAmount *int64 `json:"payer_amount,omitempty"`
+	// 用户支付币种
+	PayerCurrency *string `json:"payer_currency,omitempty"`
+}
+
+func (o SubOrderAmount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TotalAmount != nil {
+		toSerialize["total_amount"] = o.TotalAmount
+	}
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+
+	if o.PayerAmount != nil {
+		toSerialize["payer_amount"] = o.PayerAmount
+	}
+
+	if o.PayerCurrency != nil {
+		toSerialize["payer_currency"] = o.PayerCurrency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SubOrderAmount) String() string {
+	var ret string
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Currency:%v, ", *o.Currency)
+	}
+
+	if o.PayerAmount == nil {
+		ret += "PayerAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerAmount:%v, ", *o.PayerAmount)
+	}
+
+	if o.PayerCurrency == nil {
+		ret += "PayerCurrency:<nil>"
+	} else {
+		ret += fmt.Sprintf("PayerCurrency:%v", *o.PayerCurrency)
+	}
+
+	return fmt.Sprintf("SubOrderAmount{%s}", ret)
+}
+
+func (o SubOrderAmount) Clone() *SubOrderAmount {
+	ret := SubOrderAmount{}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	if o.PayerAmount != nil {
+		ret.PayerAmount = new(int64)
+		*ret.PayerAmount = *o.PayerAmount
+	}
+
+	if o.PayerCurrency != nil {
+		ret.PayerCurrency = new(string)
+		*ret.PayerCurrency = *o.PayerCurrency
+	}
+
+	return &ret
+}
+
+// HasTotalAmount 应答中是否返回了 total_amount，o 为 nil 时返回 false
+func (o *SubOrderAmount) HasTotalAmount() bool {
+	return o != nil && o.TotalAmount != nil
+}
+
+// HasCurrency 应答中是否返回了 currency，o 为 nil 时返回 false
+func (o *SubOrderAmount) HasCurrency() bool {
+	return o != nil && o.Currency != nil
+}
+
+// HasPayerAmount 应答中是否返回了 payer_amount，o 为 nil 时返回 false
+func (o *SubOrderAmount) HasPayerAmount() bool {
+	return o != nil && o.PayerAmount != nil
+}
+
+// HasPayerCurrency 应答中是否返回了 payer_currency，o 为 nil 时返回 false
+func (o *SubOrderAmount) HasPayerCurrency() bool {
+	return o != nil && o.PayerCurrency != nil
+}
+
+// SubOrderTransaction
+type SubOrderTransaction struct {
+	// 子单发起方商户号，服务商模式下为服务商商户号
+	Mchid *string `json:"mchid,omitempty"`
+	// 二级商户（或子商户）的商户号，服务商模式下返回
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 子商户申请的公众号或移动应用appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 交易类型，JSAPI：公众号支付，NATIVE：扫码支付，APP：APP支付，MWEB：H5支付
+	TradeType *string `json:"trade_type,omitempty"`
+	// 交易状态，SUCCESS：支付成功，REFUND：转入退款，NOTPAY：未支付，CLOSED：已关闭，PAYERROR：支付失败
+	TradeState *string `json:"trade_state,omitempty"`
+	// 银行类型，采用字符串类型的银行标识
+	BankType *string `json:"bank_type,omitempty"`
+	// 附加数据，在查询API和支付通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 支付完成时间，遵循rfc3339标准格式
+	SuccessTime *string `json:"success_time,omitempty"`
+	// 微信支付系统生成的子单订单号
+	TransactionId *string `json:"transaction_id,omitempty"`
+	// 商户系统内部订单号
+	OutTradeNo *string         `json:"out_trade_no,omitempty"`
+	Amount     *SubOrderAmount `json:"amount,omitempty"`
+}
+
+func (o SubOrderTransaction) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid != nil {
+		toSerialize["mchid"] = o.Mchid
+	}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.TradeType != nil {
+		toSerialize["trade_type"] = o.TradeType
+	}
+
+	if o.TradeState != nil {
+		toSerialize["trade_state"] = o.TradeState
+	}
+
+	if o.BankType != nil {
+		toSerialize["bank_type"] = o.BankType
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.SuccessTime != nil {
+		toSerialize["success_time"] = o.SuccessTime
+	}
+
+	if o.TransactionId != nil {
+		toSerialize["transaction_id"] = o.TransactionId
+	}
+
+	if o.OutTradeNo != nil {
+		toSerialize["out_trade_no"] = o.OutTradeNo
+	}
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SubOrderTransaction) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.TradeType == nil {
+		ret += "TradeType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeType:%v, ", *o.TradeType)
+	}
+
+	if o.TradeState == nil {
+		ret += "TradeState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeState:%v, ", *o.TradeState)
+	}
+
+	if o.BankType == nil {
+		ret += "BankType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankType:%v, ", *o.BankType)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	ret += fmt.Sprintf("Amount:%v", o.Amount)
+
+	return fmt.Sprintf("SubOrderTransaction{%s}", ret)
+}
+
+func (o SubOrderTransaction) Clone() *SubOrderTransaction {
+	ret := SubOrderTransaction{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.TradeType != nil {
+		ret.TradeType = new(string)
+		*ret.TradeType = *o.TradeType
+	}
+
+	if o.TradeState != nil {
+		ret.TradeState = new(string)
+		*ret.TradeState = *o.TradeState
+	}
+
+	if o.BankType != nil {
+		ret.BankType = new(string)
+		*ret.BankType = *o.BankType
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(string)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	return &ret
+}
+
+// HasMchid 应答中是否返回了 mchid，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasMchid() bool {
+	return o != nil && o.Mchid != nil
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasSubAppid 应答中是否返回了 sub_appid，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasSubAppid() bool {
+	return o != nil && o.SubAppid != nil
+}
+
+// HasTradeType 应答中是否返回了 trade_type，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasTradeType() bool {
+	return o != nil && o.TradeType != nil
+}
+
+// HasTradeState 应答中是否返回了 trade_state，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasTradeState() bool {
+	return o != nil && o.TradeState != nil
+}
+
+// HasBankType 应答中是否返回了 bank_type，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasBankType() bool {
+	return o != nil && o.BankType != nil
+}
+
+// HasAttach 应答中是否返回了 attach，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasAttach() bool {
+	return o != nil && o.Attach != nil
+}
+
+// HasSuccessTime 应答中是否返回了 success_time，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasSuccessTime() bool {
+	return o != nil && o.SuccessTime != nil
+}
+
+// HasTransactionId 应答中是否返回了 transaction_id，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasTransactionId() bool {
+	return o != nil && o.TransactionId != nil
+}
+
+// HasOutTradeNo 应答中是否返回了 out_trade_no，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasOutTradeNo() bool {
+	return o != nil && o.OutTradeNo != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *SubOrderTransaction) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
@@ -0,0 +1,35 @@
+package h5
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+// OrderQuerier 返回使用商户订单号查询 H5 订单的 payments.OrderQuerier，可用于 payments.WaitForOrder
+func (a *H5ApiService) OrderQuerier(mchid string) payments.OrderQuerier {
+	return func(ctx context.Context, outTradeNo string) (*payments.Transaction, error) {
+		resp, _, err := a.QueryOrderByOutTradeNo(ctx, QueryOrderByOutTradeNoRequest{
+			OutTradeNo: core.String(outTradeNo),
+			Mchid:      core.String(mchid),
+		})
+		return resp, err
+	}
+}
+
+// OrderCloser 返回使用商户订单号关闭 H5 订单的 payments.OrderCloser，可用于 payments.ScheduleCloseOrder
+func (a *H5ApiService) OrderCloser(mchid string) payments.OrderCloser {
+	return func(ctx context.Context, outTradeNo string) error {
+		_, err := a.CloseOrder(ctx, CloseOrderRequest{
+			OutTradeNo: core.String(outTradeNo),
+			Mchid:      core.String(mchid),
+		})
+		return err
+	}
+}
+
+// OrderStatus 使用商户订单号查询 H5 订单，并返回归一化的订单支付状态
+func (a *H5ApiService) OrderStatus(ctx context.Context, mchid, outTradeNo string) (*payments.PaymentStatus, error) {
+	return payments.QueryPaymentStatus(ctx, a.OrderQuerier(mchid), outTradeNo)
+}
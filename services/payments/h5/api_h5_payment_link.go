@@ -0,0 +1,41 @@
+package h5
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+// PrepayLink H5 下单并返回可分发的支付链接，链接的失效时间为 h5_url 的有效期与 req.TimeExpire 中较早的一个
+//
+// shortener 不为 nil 时，使用其生成短链接或 URL Scheme；生成失败时返回错误以及已创建的订单对应的支付链接。
+func (a *H5ApiService) PrepayLink(
+	ctx context.Context, req PrepayRequest, shortener payments.LinkShortener,
+) (*payments.PaymentLink, *core.APIResult, error) {
+	now := auth.Now(ctx)
+	resp, result, err := a.Prepay(ctx, req)
+	if err != nil {
+		return nil, result, err
+	}
+	if resp.H5Url == nil {
+		return nil, result, fmt.Errorf("h5_url is missing in prepay response")
+	}
+
+	var outTradeNo string
+	if req.OutTradeNo != nil {
+		outTradeNo = *req.OutTradeNo
+	}
+	link, err := payments.NewPaymentLink(payments.PaymentLinkH5, outTradeNo, *resp.H5Url, now, req.TimeExpire)
+	if err != nil {
+		return nil, result, err
+	}
+	if shortener != nil {
+		if err = link.Shorten(ctx, shortener, auth.Now(ctx)); err != nil {
+			return link, result, err
+		}
+	}
+	return link, result, nil
+}
@@ -0,0 +1,139 @@
+package h5
+
+import (
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// PrepayRequestBuilder H5 下单请求 PrepayRequest 构造器
+//
+// 所有必填参数均需在 NewPrepayRequestBuilder 中传入，可选参数通过链式调用设置，
+// 嵌套结构（如 scene_info、settle_info）中的必填参数同样体现在对应的设置方法签名中，
+// 从而在编译期避免遗漏必填参数。
+type PrepayRequestBuilder struct {
+	req PrepayRequest
+}
+
+// NewPrepayRequestBuilder 使用 H5 下单的全部必填参数初始化 PrepayRequestBuilder
+func NewPrepayRequestBuilder(
+	appid, mchid, description, outTradeNo, notifyUrl string, total int64, payerClientIp, h5Type string,
+) *PrepayRequestBuilder {
+	return &PrepayRequestBuilder{
+		req: PrepayRequest{
+			Appid:       core.String(appid),
+			Mchid:       core.String(mchid),
+			Description: core.String(description),
+			OutTradeNo:  core.String(outTradeNo),
+			NotifyUrl:   core.String(notifyUrl),
+			Amount:      &Amount{Total: core.Int64(total)},
+			SceneInfo: &SceneInfo{
+				PayerClientIp: core.String(payerClientIp),
+				H5Info:        &H5Info{Type: core.String(h5Type)},
+			},
+		},
+	}
+}
+
+// WithCurrency 设置订单货币类型，境内商户号仅支持人民币（CNY）
+func (b *PrepayRequestBuilder) WithCurrency(currency string) *PrepayRequestBuilder {
+	b.req.Amount.Currency = core.String(currency)
+	return b
+}
+
+// WithTimeExpire 设置订单失效时间
+func (b *PrepayRequestBuilder) WithTimeExpire(timeExpire time.Time) *PrepayRequestBuilder {
+	b.req.TimeExpire = core.Time(timeExpire)
+	return b
+}
+
+// WithAttach 设置附加数据
+func (b *PrepayRequestBuilder) WithAttach(attach string) *PrepayRequestBuilder {
+	b.req.Attach = core.String(attach)
+	return b
+}
+
+// WithGoodsTag 设置商品标记
+func (b *PrepayRequestBuilder) WithGoodsTag(goodsTag string) *PrepayRequestBuilder {
+	b.req.GoodsTag = core.String(goodsTag)
+	return b
+}
+
+// WithLimitPay 设置指定支付方式
+func (b *PrepayRequestBuilder) WithLimitPay(limitPay ...string) *PrepayRequestBuilder {
+	b.req.LimitPay = append(b.req.LimitPay, limitPay...)
+	return b
+}
+
+// WithSupportFapiao 设置是否在支付成功消息和支付详情页中展示开票入口
+func (b *PrepayRequestBuilder) WithSupportFapiao(supportFapiao bool) *PrepayRequestBuilder {
+	b.req.SupportFapiao = core.Bool(supportFapiao)
+	return b
+}
+
+// WithCostPrice 设置订单原价（优惠功能）
+func (b *PrepayRequestBuilder) WithCostPrice(costPrice int64) *PrepayRequestBuilder {
+	b.detail().CostPrice = core.Int64(costPrice)
+	return b
+}
+
+// WithInvoiceId 设置商家小票ID（优惠功能）
+func (b *PrepayRequestBuilder) WithInvoiceId(invoiceId string) *PrepayRequestBuilder {
+	b.detail().InvoiceId = core.String(invoiceId)
+	return b
+}
+
+// AddGoodsDetail 添加单品列表信息（优惠功能）
+func (b *PrepayRequestBuilder) AddGoodsDetail(goodsDetail ...GoodsDetail) *PrepayRequestBuilder {
+	detail := b.detail()
+	detail.GoodsDetail = append(detail.GoodsDetail, goodsDetail...)
+	return b
+}
+
+// WithDeviceId 设置支付场景描述中的商户端设备号
+func (b *PrepayRequestBuilder) WithDeviceId(deviceId string) *PrepayRequestBuilder {
+	b.req.SceneInfo.DeviceId = core.String(deviceId)
+	return b
+}
+
+// WithStoreInfo 设置支付场景描述中的商户门店信息
+func (b *PrepayRequestBuilder) WithStoreInfo(store StoreInfo) *PrepayRequestBuilder {
+	b.req.SceneInfo.StoreInfo = store.Clone()
+	return b
+}
+
+// WithH5AppInfo 设置 H5 场景信息中的应用名称、网站URL、iOS平台BundleID、Android平台PackageName，参数为空时不设置
+func (b *PrepayRequestBuilder) WithH5AppInfo(appName, appUrl, bundleId, packageName string) *PrepayRequestBuilder {
+	h5Info := b.req.SceneInfo.H5Info
+	if appName != "" {
+		h5Info.AppName = core.String(appName)
+	}
+	if appUrl != "" {
+		h5Info.AppUrl = core.String(appUrl)
+	}
+	if bundleId != "" {
+		h5Info.BundleId = core.String(bundleId)
+	}
+	if packageName != "" {
+		h5Info.PackageName = core.String(packageName)
+	}
+	return b
+}
+
+// WithProfitSharing 设置结算信息中的是否指定分账
+func (b *PrepayRequestBuilder) WithProfitSharing(profitSharing bool) *PrepayRequestBuilder {
+	b.req.SettleInfo = &SettleInfo{ProfitSharing: core.Bool(profitSharing)}
+	return b
+}
+
+// Build 生成 PrepayRequest，每次调用均返回一份独立的副本
+func (b *PrepayRequestBuilder) Build() PrepayRequest {
+	return *b.req.Clone()
+}
+
+func (b *PrepayRequestBuilder) detail() *Detail {
+	if b.req.Detail == nil {
+		b.req.Detail = &Detail{}
+	}
+	return b.req.Detail
+}
@@ -0,0 +1,52 @@
+package h5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/h5"
+)
+
+func TestPrepayRequestBuilder_Build(t *testing.T) {
+	builder := h5.NewPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1230000109", "Image形象店-深圳腾大-QQ公仔", "1217752501201407033233368018",
+		"https://www.weixin.qq.com/wxpay/pay.php", 100, "14.23.150.211", "iOS",
+	).
+		WithCurrency("CNY").
+		WithH5AppInfo("王者荣耀", "", "com.tencent.wzryiOS", "").
+		WithStoreInfo(h5.StoreInfo{Id: core.String("0001")}).
+		AddGoodsDetail(h5.GoodsDetail{
+			MerchantGoodsId: core.String("1246464644"),
+			Quantity:        core.Int64(1),
+			UnitPrice:       core.Int64(100),
+		})
+	req := builder.Build()
+
+	assert.Equal(t, "wxd678efh567hg6787", *req.Appid)
+	assert.Equal(t, int64(100), *req.Amount.Total)
+	assert.Equal(t, "CNY", *req.Amount.Currency)
+	assert.Equal(t, "14.23.150.211", *req.SceneInfo.PayerClientIp)
+	assert.Equal(t, "0001", *req.SceneInfo.StoreInfo.Id)
+	assert.Equal(t, "iOS", *req.SceneInfo.H5Info.Type)
+	assert.Equal(t, "王者荣耀", *req.SceneInfo.H5Info.AppName)
+	assert.Equal(t, "com.tencent.wzryiOS", *req.SceneInfo.H5Info.BundleId)
+	// 参数为空时不设置
+	assert.Nil(t, req.SceneInfo.H5Info.AppUrl)
+	assert.Nil(t, req.SceneInfo.H5Info.PackageName)
+	assert.Len(t, req.Detail.GoodsDetail, 1)
+	assert.Nil(t, req.SettleInfo)
+
+	// 构造器生成的请求包含全部必填字段
+	_, err := req.MarshalJSON()
+	require.NoError(t, err)
+
+	// 每次 Build 返回独立的副本
+	*req.SceneInfo.H5Info.Type = "Android"
+	again := builder.WithAttach("自定义数据说明").Build()
+	assert.Equal(t, "iOS", *again.SceneInfo.H5Info.Type)
+	assert.Equal(t, "自定义数据说明", *again.Attach)
+	assert.Nil(t, req.Attach)
+}
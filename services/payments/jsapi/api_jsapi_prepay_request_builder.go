@@ -0,0 +1,119 @@
+package jsapi
+
+import (
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// PrepayRequestBuilder JSAPI 下单请求 PrepayRequest 构造器
+//
+// 所有必填参数均需在 NewPrepayRequestBuilder 中传入，可选参数通过链式调用设置，
+// 嵌套结构（如 scene_info、settle_info）中的必填参数同样体现在对应的设置方法签名中，
+// 从而在编译期避免遗漏必填参数。
+type PrepayRequestBuilder struct {
+	req PrepayRequest
+}
+
+// NewPrepayRequestBuilder 使用 JSAPI 下单的全部必填参数初始化 PrepayRequestBuilder
+func NewPrepayRequestBuilder(
+	appid, mchid, description, outTradeNo, notifyUrl string, total int64, openid string,
+) *PrepayRequestBuilder {
+	return &PrepayRequestBuilder{
+		req: PrepayRequest{
+			Appid:       core.String(appid),
+			Mchid:       core.String(mchid),
+			Description: core.String(description),
+			OutTradeNo:  core.String(outTradeNo),
+			NotifyUrl:   core.String(notifyUrl),
+			Amount:      &Amount{Total: core.Int64(total)},
+			Payer:       &Payer{Openid: core.String(openid)},
+		},
+	}
+}
+
+// WithCurrency 设置订单货币类型，境内商户号仅支持人民币（CNY）
+func (b *PrepayRequestBuilder) WithCurrency(currency string) *PrepayRequestBuilder {
+	b.req.Amount.Currency = core.String(currency)
+	return b
+}
+
+// WithTimeExpire 设置订单失效时间
+func (b *PrepayRequestBuilder) WithTimeExpire(timeExpire time.Time) *PrepayRequestBuilder {
+	b.req.TimeExpire = core.Time(timeExpire)
+	return b
+}
+
+// WithAttach 设置附加数据
+func (b *PrepayRequestBuilder) WithAttach(attach string) *PrepayRequestBuilder {
+	b.req.Attach = core.String(attach)
+	return b
+}
+
+// WithGoodsTag 设置商品标记
+func (b *PrepayRequestBuilder) WithGoodsTag(goodsTag string) *PrepayRequestBuilder {
+	b.req.GoodsTag = core.String(goodsTag)
+	return b
+}
+
+// WithLimitPay 设置指定支付方式
+func (b *PrepayRequestBuilder) WithLimitPay(limitPay ...string) *PrepayRequestBuilder {
+	b.req.LimitPay = append(b.req.LimitPay, limitPay...)
+	return b
+}
+
+// WithSupportFapiao 设置是否在支付成功消息和支付详情页中展示开票入口
+func (b *PrepayRequestBuilder) WithSupportFapiao(supportFapiao bool) *PrepayRequestBuilder {
+	b.req.SupportFapiao = core.Bool(supportFapiao)
+	return b
+}
+
+// WithCostPrice 设置订单原价（优惠功能）
+func (b *PrepayRequestBuilder) WithCostPrice(costPrice int64) *PrepayRequestBuilder {
+	b.detail().CostPrice = core.Int64(costPrice)
+	return b
+}
+
+// WithInvoiceId 设置商家小票ID（优惠功能）
+func (b *PrepayRequestBuilder) WithInvoiceId(invoiceId string) *PrepayRequestBuilder {
+	b.detail().InvoiceId = core.String(invoiceId)
+	return b
+}
+
+// AddGoodsDetail 添加单品列表信息（优惠功能）
+func (b *PrepayRequestBuilder) AddGoodsDetail(goodsDetail ...GoodsDetail) *PrepayRequestBuilder {
+	detail := b.detail()
+	detail.GoodsDetail = append(detail.GoodsDetail, goodsDetail...)
+	return b
+}
+
+// WithSceneInfo 设置支付场景描述，payerClientIp 为必填的用户终端IP，deviceId 为空时不设置，store 可为 nil
+func (b *PrepayRequestBuilder) WithSceneInfo(payerClientIp, deviceId string, store *StoreInfo) *PrepayRequestBuilder {
+	sceneInfo := &SceneInfo{PayerClientIp: core.String(payerClientIp)}
+	if deviceId != "" {
+		sceneInfo.DeviceId = core.String(deviceId)
+	}
+	if store != nil {
+		sceneInfo.StoreInfo = store.Clone()
+	}
+	b.req.SceneInfo = sceneInfo
+	return b
+}
+
+// WithProfitSharing 设置结算信息中的是否指定分账
+func (b *PrepayRequestBuilder) WithProfitSharing(profitSharing bool) *PrepayRequestBuilder {
+	b.req.SettleInfo = &SettleInfo{ProfitSharing: core.Bool(profitSharing)}
+	return b
+}
+
+// Build 生成 PrepayRequest，每次调用均返回一份独立的副本
+func (b *PrepayRequestBuilder) Build() PrepayRequest {
+	return *b.req.Clone()
+}
+
+func (b *PrepayRequestBuilder) detail() *Detail {
+	if b.req.Detail == nil {
+		b.req.Detail = &Detail{}
+	}
+	return b.req.Detail
+}
@@ -0,0 +1,33 @@
+package jsapi_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/jsapi"
+)
+
+func ExampleNewPrepayRequestBuilder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	req := jsapi.NewPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1230000109", "Image形象店-深圳腾大-QQ公仔", "1217752501201407033233368018",
+		"https://www.weixin.qq.com/wxpay/pay.php", 100, "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o",
+	).
+		WithTimeExpire(time.Now().Add(30*time.Minute)).
+		WithAttach("自定义数据说明").
+		WithSceneInfo("14.23.150.211", "013467007045764", &jsapi.StoreInfo{Id: core.String("0001")}).
+		WithProfitSharing(false).
+		Build()
+
+	svc := jsapi.JsapiApiService{Client: client}
+	resp, result, err := svc.Prepay(ctx, req)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,38 @@
+package jsapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/jsapi"
+)
+
+func TestPrepayRequestBuilder_Build(t *testing.T) {
+	newBuilder := func() *jsapi.PrepayRequestBuilder {
+		return jsapi.NewPrepayRequestBuilder(
+			"wxd678efh567hg6787", "1230000109", "Image形象店-深圳腾大-QQ公仔", "1217752501201407033233368018",
+			"https://www.weixin.qq.com/wxpay/pay.php", 100, "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o",
+		)
+	}
+
+	req := newBuilder().Build()
+	assert.Equal(t, "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o", *req.Payer.Openid)
+	// 未设置支付场景描述时不生成 scene_info
+	assert.Nil(t, req.SceneInfo)
+	_, err := req.MarshalJSON()
+	require.NoError(t, err)
+
+	req = newBuilder().
+		WithSceneInfo("14.23.150.211", "", &jsapi.StoreInfo{Id: core.String("0001")}).
+		WithProfitSharing(true).
+		Build()
+	assert.Equal(t, "14.23.150.211", *req.SceneInfo.PayerClientIp)
+	assert.Nil(t, req.SceneInfo.DeviceId)
+	assert.Equal(t, "0001", *req.SceneInfo.StoreInfo.Id)
+	assert.True(t, *req.SettleInfo.ProfitSharing)
+	_, err = req.MarshalJSON()
+	require.NoError(t, err)
+}
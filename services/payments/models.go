@@ -21,9 +21,9 @@ type PromotionDetail struct {
 	CouponId *string `json:"coupon_id,omitempty"`
 	// 优惠名称
 	Name *string `json:"name,omitempty"`
-	// GLOBAL：全场代金券；SINGLE：单品优惠
+	// GLOBAL：全场代金券；SINGLE：单品优惠，见 PromotionScopeGlobal、PromotionScopeSingle
 	Scope *string `json:"scope,omitempty"`
-	// CASH：充值；NOCASH：预充值。
+	// CASH：充值型代金券；NOCASH：免充值型优惠券，见 PromotionTypeCash、PromotionTypeNoCash
 	Type *string `json:"type,omitempty"`
 	// 优惠券面额
 	Amount *int64 `json:"amount,omitempty"`
@@ -0,0 +1,33 @@
+package native_test
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+)
+
+func ExampleNativeApiService_PrepayLink() {
+	var (
+		ctx       context.Context
+		client    *core.Client
+		shortener payments.LinkShortener // 如调用自建的短链接服务
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := native.NativeApiService{Client: client}
+	req := native.NewPrepayRequestBuilder("wxd678efh567hg6787", "1230000109", "Image形象店-深圳腾大-QQ公仔",
+		"1217752501201407033233368018", "https://www.weixin.qq.com/wxpay/pay.php", 100).
+		WithTimeExpire(time.Now().Add(30 * time.Minute)).
+		Build()
+	link, _, err := svc.PrepayLink(ctx, req, shortener)
+	if err != nil {
+		log.Printf("prepay link err:%s", err)
+		return
+	}
+	// TODO: 通过短信发送 link.DistributionURL()，并告知用户链接将于 link.ExpireAt 失效
+	log.Printf("pay at %s before %s", link.DistributionURL(), link.ExpireAt.Format(time.RFC3339))
+}
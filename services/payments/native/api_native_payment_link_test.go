@@ -0,0 +1,108 @@
+package native_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+)
+
+const testCodeURL = "weixin://wxpay/bizpayurl/up?pr=NwY5Mz9&groupid=00"
+
+var testNow = time.Date(2018, 6, 8, 10, 0, 0, 0, time.FixedZone("CST", 8*3600))
+
+func newTestNativeService(t *testing.T, handler http.HandlerFunc) *native.NativeApiService {
+	client, err := clienttest.NewHandlerClient(handler)
+	require.NoError(t, err)
+	return &native.NativeApiService{Client: client}
+}
+
+func prepayRequest(timeExpire *time.Time) native.PrepayRequest {
+	builder := native.NewPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1230000109", "Image形象店-深圳腾大-QQ公仔", "1217752501201407033233368018",
+		"https://www.weixin.qq.com/wxpay/pay.php", 100,
+	)
+	if timeExpire != nil {
+		builder = builder.WithTimeExpire(*timeExpire)
+	}
+	return builder.Build()
+}
+
+func TestNativeApiService_PrepayLink(t *testing.T) {
+	svc := newTestNativeService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/pay/transactions/native", r.URL.Path)
+		clienttest.WriteJSON(w, http.StatusOK, map[string]string{"code_url": testCodeURL})
+	})
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return testNow }))
+
+	link, _, err := svc.PrepayLink(ctx, prepayRequest(nil), nil)
+	require.NoError(t, err)
+	assert.Equal(t, payments.PaymentLinkNative, link.Type)
+	assert.Equal(t, "1217752501201407033233368018", link.OutTradeNo)
+	assert.Equal(t, testCodeURL, link.DistributionURL())
+	// 失效时间以 ctx 中的时钟计算
+	assert.True(t, testNow.Add(payments.NativeCodeURLValidity).Equal(link.ExpireAt), link.ExpireAt)
+
+	timeExpire := testNow.Add(30 * time.Minute)
+	link, _, err = svc.PrepayLink(ctx, prepayRequest(&timeExpire), payments.LinkShortenerFunc(
+		func(_ context.Context, l *payments.PaymentLink) (string, error) {
+			assert.True(t, timeExpire.Equal(l.ExpireAt), l.ExpireAt)
+			return "https://s.example.com/abc", nil
+		},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, "https://s.example.com/abc", link.DistributionURL())
+	assert.Equal(t, testCodeURL, link.URL)
+}
+
+func TestNativeApiService_PrepayLinkShortenFailed(t *testing.T) {
+	svc := newTestNativeService(t, func(w http.ResponseWriter, _ *http.Request) {
+		clienttest.WriteJSON(w, http.StatusOK, map[string]string{"code_url": testCodeURL})
+	})
+
+	shortenErr := errors.New("shortener unavailable")
+	link, result, err := svc.PrepayLink(context.Background(), prepayRequest(nil), payments.LinkShortenerFunc(
+		func(context.Context, *payments.PaymentLink) (string, error) {
+			return "", shortenErr
+		},
+	))
+	assert.True(t, errors.Is(err, shortenErr))
+	// 订单已创建，仍返回原始的支付链接
+	require.NotNil(t, link)
+	assert.Equal(t, testCodeURL, link.DistributionURL())
+	assert.Equal(t, http.StatusOK, result.Response.StatusCode)
+}
+
+func TestNativeApiService_PrepayLinkError(t *testing.T) {
+	called := false
+	shortener := payments.LinkShortenerFunc(func(context.Context, *payments.PaymentLink) (string, error) {
+		called = true
+		return "https://s.example.com/abc", nil
+	})
+
+	svc := newTestNativeService(t, func(w http.ResponseWriter, _ *http.Request) {
+		clienttest.WriteError(w, http.StatusBadRequest, "ORDER_PAID", "订单已支付")
+	})
+	link, _, err := svc.PrepayLink(context.Background(), prepayRequest(nil), shortener)
+	assert.True(t, core.IsAPIError(err, "ORDER_PAID"))
+	assert.Nil(t, link)
+
+	// 应答中缺少 code_url
+	svc = newTestNativeService(t, func(w http.ResponseWriter, _ *http.Request) {
+		clienttest.WriteJSON(w, http.StatusOK, map[string]string{})
+	})
+	link, _, err = svc.PrepayLink(context.Background(), prepayRequest(nil), shortener)
+	assert.Error(t, err)
+	assert.Nil(t, link)
+	assert.False(t, called)
+}
@@ -0,0 +1,93 @@
+package native_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+)
+
+func newTestPrepayRequestBuilder() *native.PrepayRequestBuilder {
+	return native.NewPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1230000109", "Image形象店-深圳腾大-QQ公仔", "1217752501201407033233368018",
+		"https://www.weixin.qq.com/wxpay/pay.php", 100,
+	)
+}
+
+func TestPrepayRequestBuilder_BuildRequiredOnly(t *testing.T) {
+	req := newTestPrepayRequestBuilder().Build()
+
+	assert.Equal(t, "wxd678efh567hg6787", *req.Appid)
+	assert.Equal(t, "1230000109", *req.Mchid)
+	assert.Equal(t, "Image形象店-深圳腾大-QQ公仔", *req.Description)
+	assert.Equal(t, "1217752501201407033233368018", *req.OutTradeNo)
+	assert.Equal(t, "https://www.weixin.qq.com/wxpay/pay.php", *req.NotifyUrl)
+	assert.Equal(t, int64(100), *req.Amount.Total)
+	// 未设置的可选字段不生成
+	assert.Nil(t, req.Amount.Currency)
+	assert.Nil(t, req.SceneInfo)
+	assert.Nil(t, req.SettleInfo)
+	assert.Nil(t, req.Detail)
+
+	// 仅通过构造函数设置的字段即可通过 MarshalJSON 的必填校验
+	_, err := req.MarshalJSON()
+	require.NoError(t, err)
+}
+
+func TestPrepayRequestBuilder_RequiredFields(t *testing.T) {
+	// 构造函数覆盖了 MarshalJSON 校验的全部必填字段：逐个清除后均无法通过校验
+	tests := map[string]func(req *native.PrepayRequest){
+		"appid":        func(req *native.PrepayRequest) { req.Appid = nil },
+		"mchid":        func(req *native.PrepayRequest) { req.Mchid = nil },
+		"description":  func(req *native.PrepayRequest) { req.Description = nil },
+		"out_trade_no": func(req *native.PrepayRequest) { req.OutTradeNo = nil },
+		"notify_url":   func(req *native.PrepayRequest) { req.NotifyUrl = nil },
+		"amount":       func(req *native.PrepayRequest) { req.Amount = nil },
+		"amount.total": func(req *native.PrepayRequest) { req.Amount.Total = nil },
+	}
+	for name, unset := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := newTestPrepayRequestBuilder().Build()
+			unset(&req)
+			_, err := req.MarshalJSON()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPrepayRequestBuilder_Build(t *testing.T) {
+	builder := newTestPrepayRequestBuilder().
+		WithCurrency("CNY").
+		WithSceneInfo("14.23.150.211", "", &native.StoreInfo{Id: core.String("0001")}).
+		WithProfitSharing(true).
+		WithLimitPay("no_balance").
+		WithCostPrice(608800).
+		AddGoodsDetail(native.GoodsDetail{
+			MerchantGoodsId: core.String("1246464644"),
+			Quantity:        core.Int64(1),
+			UnitPrice:       core.Int64(100),
+		})
+	req := builder.Build()
+
+	assert.Equal(t, "CNY", *req.Amount.Currency)
+	assert.Equal(t, "14.23.150.211", *req.SceneInfo.PayerClientIp)
+	// 参数为空时不设置
+	assert.Nil(t, req.SceneInfo.DeviceId)
+	assert.Equal(t, "0001", *req.SceneInfo.StoreInfo.Id)
+	assert.True(t, *req.SettleInfo.ProfitSharing)
+	assert.Equal(t, []string{"no_balance"}, req.LimitPay)
+	assert.Equal(t, int64(608800), *req.Detail.CostPrice)
+	assert.Len(t, req.Detail.GoodsDetail, 1)
+	_, err := req.MarshalJSON()
+	require.NoError(t, err)
+
+	// 每次 Build 返回独立的副本
+	*req.SceneInfo.PayerClientIp = "127.0.0.1"
+	again := builder.WithAttach("自定义数据说明").Build()
+	assert.Equal(t, "14.23.150.211", *again.SceneInfo.PayerClientIp)
+	assert.Equal(t, "自定义数据说明", *again.Attach)
+	assert.Nil(t, req.Attach)
+}
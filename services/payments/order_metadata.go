@@ -0,0 +1,66 @@
+package payments
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// 下单请求中附加字段的长度限制，单位为字节
+const (
+	// MaxAttachLength 附加数据 attach 的最大长度
+	MaxAttachLength = 128
+	// MaxGoodsTagLength 订单优惠标记 goods_tag 的最大长度
+	MaxGoodsTagLength = 32
+)
+
+// FieldLengthError 下单请求的字段超过长度限制
+type FieldLengthError struct {
+	// Field 字段名，如 attach
+	Field string
+	// Length 字段的实际长度，单位为字节
+	Length int
+	// Limit 字段的最大长度，单位为字节
+	Limit int
+}
+
+func (e *FieldLengthError) Error() string {
+	return fmt.Sprintf("%s is %d bytes, exceeds the limit of %d bytes", e.Field, e.Length, e.Limit)
+}
+
+// EncodeAttach 将 v 编码为 JSON 作为下单请求的附加数据 attach，编码结果超过 MaxAttachLength 时返回 *FieldLengthError
+//
+// 附加数据在查询订单与支付成功通知中原样返回，可以使用 DecodeAttach 解析。请勿在附加数据中存放敏感信息。
+func EncodeAttach(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encode attach err:%v", err)
+	}
+	if len(data) > MaxAttachLength {
+		return "", &FieldLengthError{Field: "attach", Length: len(data), Limit: MaxAttachLength}
+	}
+	return string(data), nil
+}
+
+// DecodeAttach 将订单中由 EncodeAttach 编码的附加数据解析到 v，attach 为 nil 或空字符串时不修改 v
+func DecodeAttach(attach *string, v interface{}) error {
+	if attach == nil || *attach == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(*attach), v); err != nil {
+		return fmt.Errorf("decode attach err:%v", err)
+	}
+	return nil
+}
+
+// ValidateGoodsTag 校验订单优惠标记 goods_tag，需与代金券批次中设置的订单优惠标记一致，才能使用该批次的代金券
+//
+// goodsTag 为空或超过 MaxGoodsTagLength 时返回错误，后者为 *FieldLengthError
+func ValidateGoodsTag(goodsTag string) error {
+	if goodsTag == "" {
+		return fmt.Errorf("goods_tag is empty")
+	}
+	if len(goodsTag) > MaxGoodsTagLength {
+		return &FieldLengthError{Field: "goods_tag", Length: len(goodsTag), Limit: MaxGoodsTagLength}
+	}
+	return nil
+}
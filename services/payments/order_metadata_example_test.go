@@ -0,0 +1,42 @@
+package payments_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+func ExampleEncodeAttach() {
+	type orderMeta struct {
+		Channel string `json:"c"`
+		ShopID  int64  `json:"s"`
+	}
+
+	attach, err := payments.EncodeAttach(orderMeta{Channel: "sms", ShopID: 1024})
+	fmt.Println(attach, err)
+
+	// 查询订单或收到支付成功通知后，解析 Transaction.Attach
+	transaction := payments.Transaction{Attach: core.String(attach)}
+	var meta orderMeta
+	err = payments.DecodeAttach(transaction.Attach, &meta)
+	fmt.Println(meta.Channel, meta.ShopID, err)
+
+	_, err = payments.EncodeAttach(orderMeta{Channel: strings.Repeat("x", 128)})
+	var lengthErr *payments.FieldLengthError
+	fmt.Println(errors.As(err, &lengthErr), err)
+	// Output:
+	// {"c":"sms","s":1024} <nil>
+	// sms 1024 <nil>
+	// true attach is 142 bytes, exceeds the limit of 128 bytes
+}
+
+func ExampleValidateGoodsTag() {
+	fmt.Println(payments.ValidateGoodsTag("WXG"))
+	fmt.Println(payments.ValidateGoodsTag(strings.Repeat("WXG", 11)))
+	// Output:
+	// <nil>
+	// goods_tag is 33 bytes, exceeds the limit of 32 bytes
+}
@@ -0,0 +1,91 @@
+package payments_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+type testAttach struct {
+	Channel string `json:"c"`
+	ShopID  int64  `json:"s,omitempty"`
+}
+
+func TestEncodeAttach(t *testing.T) {
+	// {"c":""} 占 8 字节
+	limit := strings.Repeat("x", payments.MaxAttachLength-8)
+
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    string
+		wantLen int
+	}{
+		{name: "struct", v: testAttach{Channel: "sms", ShopID: 1024}, want: `{"c":"sms","s":1024}`},
+		{name: "string", v: "sms", want: `"sms"`},
+		{name: "nil", v: nil, want: "null"},
+		{name: "at limit", v: testAttach{Channel: limit}, want: `{"c":"` + limit + `"}`},
+		{name: "over limit", v: testAttach{Channel: limit + "x"}, wantLen: payments.MaxAttachLength + 1},
+		// 长度按 UTF-8 编码的字节数计算
+		{name: "multibyte over limit", v: testAttach{Channel: strings.Repeat("短", 41)}, wantLen: 131},
+		{name: "multibyte", v: testAttach{Channel: strings.Repeat("短", 39)}, want: `{"c":"` + strings.Repeat("短", 39) + `"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attach, err := payments.EncodeAttach(tt.v)
+			if tt.wantLen == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, attach)
+				assert.True(t, len(attach) <= payments.MaxAttachLength)
+				return
+			}
+			var lengthErr *payments.FieldLengthError
+			require.True(t, errors.As(err, &lengthErr), "%v", err)
+			assert.Equal(t, "attach", lengthErr.Field)
+			assert.Equal(t, payments.MaxAttachLength, lengthErr.Limit)
+			assert.Equal(t, tt.wantLen, lengthErr.Length)
+			assert.Empty(t, attach)
+		})
+	}
+
+	_, err := payments.EncodeAttach(func() {})
+	var lengthErr *payments.FieldLengthError
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &lengthErr))
+}
+
+func TestDecodeAttach(t *testing.T) {
+	attach, err := payments.EncodeAttach(testAttach{Channel: "sms", ShopID: 1024})
+	require.NoError(t, err)
+
+	var meta testAttach
+	require.NoError(t, payments.DecodeAttach(core.String(attach), &meta))
+	assert.Equal(t, testAttach{Channel: "sms", ShopID: 1024}, meta)
+
+	// attach 为空时不修改 v
+	meta = testAttach{Channel: "kept"}
+	require.NoError(t, payments.DecodeAttach(nil, &meta))
+	require.NoError(t, payments.DecodeAttach(core.String(""), &meta))
+	assert.Equal(t, testAttach{Channel: "kept"}, meta)
+
+	// 非 EncodeAttach 编码的附加数据
+	assert.Error(t, payments.DecodeAttach(core.String("channel=sms"), &meta))
+	assert.Error(t, payments.DecodeAttach(core.String(`{"c":1}`), &meta))
+}
+
+func TestValidateGoodsTag(t *testing.T) {
+	assert.NoError(t, payments.ValidateGoodsTag("WXG"))
+	assert.NoError(t, payments.ValidateGoodsTag(strings.Repeat("x", payments.MaxGoodsTagLength)))
+	assert.Error(t, payments.ValidateGoodsTag(""))
+
+	err := payments.ValidateGoodsTag(strings.Repeat("x", payments.MaxGoodsTagLength+1))
+	var lengthErr *payments.FieldLengthError
+	require.True(t, errors.As(err, &lengthErr), "%v", err)
+	assert.Equal(t, &payments.FieldLengthError{Field: "goods_tag", Length: 33, Limit: 32}, lengthErr)
+}
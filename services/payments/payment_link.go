@@ -0,0 +1,124 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// 支付链接的有效期
+const (
+	// NativeCodeURLValidity Native 下单返回的 code_url 的有效期
+	NativeCodeURLValidity = 2 * time.Hour
+	// H5URLValidity H5 下单返回的 h5_url 的有效期
+	H5URLValidity = 5 * time.Minute
+)
+
+// PaymentLinkType 支付链接类型
+type PaymentLinkType string
+
+// 支付链接类型
+const (
+	PaymentLinkNative PaymentLinkType = "NATIVE" // Native 下单返回的二维码链接 code_url
+	PaymentLinkH5     PaymentLinkType = "H5"     // H5 下单返回的支付跳转链接 h5_url
+)
+
+// PaymentLink 可用于短信、消息推送等渠道分发的支付链接
+type PaymentLink struct {
+	// Type 支付链接类型
+	Type PaymentLinkType
+	// OutTradeNo 商户订单号
+	OutTradeNo string
+	// URL 下单返回的原始链接
+	URL string
+	// ShortURL LinkShortener 生成的短链接或 URL Scheme，未生成时为空
+	ShortURL string
+	// ExpireAt 链接的失效时间，为链接有效期与订单失效时间（time_expire）中较早的一个
+	ExpireAt time.Time
+}
+
+// NewPaymentLink 使用下单返回的链接创建 PaymentLink
+//
+// createTime 为下单时间，timeExpire 为下单请求中的订单失效时间（可为 nil）
+func NewPaymentLink(
+	linkType PaymentLinkType, outTradeNo, rawURL string, createTime time.Time, timeExpire *time.Time,
+) (*PaymentLink, error) {
+	var validity time.Duration
+	switch linkType {
+	case PaymentLinkNative:
+		validity = NativeCodeURLValidity
+	case PaymentLinkH5:
+		validity = H5URLValidity
+	default:
+		return nil, fmt.Errorf("unsupported payment link type %q", linkType)
+	}
+	if u, err := url.Parse(rawURL); err != nil || u.Scheme == "" {
+		return nil, fmt.Errorf("invalid %s payment link %q", linkType, rawURL)
+	}
+
+	expireAt := createTime.Add(validity)
+	if timeExpire != nil && timeExpire.Before(expireAt) {
+		expireAt = *timeExpire
+	}
+	return &PaymentLink{Type: linkType, OutTradeNo: outTradeNo, URL: rawURL, ExpireAt: expireAt}, nil
+}
+
+// DistributionURL 返回用于分发的链接，已生成短链接时返回短链接，否则返回原始链接
+func (l *PaymentLink) DistributionURL() string {
+	if l.ShortURL != "" {
+		return l.ShortURL
+	}
+	return l.URL
+}
+
+// Expired 判断链接在 now 时是否已失效
+func (l *PaymentLink) Expired(now time.Time) bool {
+	return !now.Before(l.ExpireAt)
+}
+
+// WithRedirectURL 返回在 h5_url 后追加支付完成后的跳转地址 redirect_url 的副本，仅适用于 H5 支付链接
+func (l *PaymentLink) WithRedirectURL(redirectURL string) (*PaymentLink, error) {
+	if l.Type != PaymentLinkH5 {
+		return nil, fmt.Errorf("redirect url is only supported by %s payment link", PaymentLinkH5)
+	}
+	u, err := url.Parse(l.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s payment link %q", l.Type, l.URL)
+	}
+	query := u.Query()
+	query.Set("redirect_url", redirectURL)
+	u.RawQuery = query.Encode()
+
+	link := *l
+	link.URL, link.ShortURL = u.String(), ""
+	return &link, nil
+}
+
+// LinkShortener 将支付链接转换为短链接或 URL Scheme，如调用自建的短链接服务或小程序的 URL Link 接口
+//
+// 实现应以 link.ExpireAt 作为生成的链接的失效时间，避免用户打开已失效的支付链接
+type LinkShortener interface {
+	Shorten(ctx context.Context, link *PaymentLink) (string, error)
+}
+
+// LinkShortenerFunc 使用函数实现 LinkShortener
+type LinkShortenerFunc func(ctx context.Context, link *PaymentLink) (string, error)
+
+// Shorten 调用 f
+func (f LinkShortenerFunc) Shorten(ctx context.Context, link *PaymentLink) (string, error) {
+	return f(ctx, link)
+}
+
+// Shorten 使用 shortener 生成短链接并设置 ShortURL，链接已失效时返回错误
+func (l *PaymentLink) Shorten(ctx context.Context, shortener LinkShortener, now time.Time) error {
+	if l.Expired(now) {
+		return fmt.Errorf("payment link of %s expired at %s", l.OutTradeNo, l.ExpireAt.Format(time.RFC3339))
+	}
+	shortURL, err := shortener.Shorten(ctx, l)
+	if err != nil {
+		return fmt.Errorf("shorten payment link of %s err:%w", l.OutTradeNo, err)
+	}
+	l.ShortURL = shortURL
+	return nil
+}
@@ -0,0 +1,48 @@
+package payments_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+func ExamplePaymentLink_Shorten() {
+	createTime := time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC)
+	timeExpire := createTime.Add(30 * time.Minute)
+	link, err := payments.NewPaymentLink(payments.PaymentLinkNative, "1217752501201407033233368018",
+		"weixin://wxpay/bizpayurl/up?pr=NwY5Mz9&groupid=00", createTime, &timeExpire)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(link.ExpireAt.Format(time.RFC3339))
+
+	// 使用自建的短链接服务，短链接的失效时间与支付链接一致
+	shortener := payments.LinkShortenerFunc(func(ctx context.Context, link *payments.PaymentLink) (string, error) {
+		return "https://s.example.com/p/Ab3dE", nil
+	})
+	err = link.Shorten(context.Background(), shortener, createTime.Add(time.Minute))
+	fmt.Println(link.DistributionURL(), err)
+
+	err = link.Shorten(context.Background(), shortener, timeExpire)
+	fmt.Println(err)
+	// Output:
+	// 2021-07-01T10:30:00Z
+	// https://s.example.com/p/Ab3dE <nil>
+	// payment link of 1217752501201407033233368018 expired at 2021-07-01T10:30:00Z
+}
+
+func ExamplePaymentLink_WithRedirectURL() {
+	createTime := time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC)
+	link, _ := payments.NewPaymentLink(payments.PaymentLinkH5, "1217752501201407033233368018",
+		"https://wx.tenpay.com/cgi-bin/mmpayweb-bin/checkmweb?prepay_id=wx2016121516420242444321ca0631331346&package=1405458241",
+		createTime, nil)
+	link, err := link.WithRedirectURL("https://www.example.com/orders/1217752501201407033233368018")
+	fmt.Println(link.URL, err)
+	fmt.Println(link.ExpireAt.Format(time.RFC3339))
+	// Output:
+	// https://wx.tenpay.com/cgi-bin/mmpayweb-bin/checkmweb?package=1405458241&prepay_id=wx2016121516420242444321ca0631331346&redirect_url=https%3A%2F%2Fwww.example.com%2Forders%2F1217752501201407033233368018 <nil>
+	// 2021-07-01T10:05:00Z
+}
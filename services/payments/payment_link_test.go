@@ -0,0 +1,132 @@
+package payments_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+const (
+	testCodeURL = "weixin://wxpay/bizpayurl/up?pr=NwY5Mz9&groupid=00"
+	testH5URL   = "https://wx.tenpay.com/cgi-bin/mmpayweb-bin/checkmweb?prepay_id=wx2016121516420242444321ca0631331346&package=1405458241"
+)
+
+var testCreateTime = time.Date(2018, 6, 8, 10, 0, 0, 0, time.FixedZone("CST", 8*3600))
+
+func TestNewPaymentLink(t *testing.T) {
+	early := testCreateTime.Add(time.Minute)
+	late := testCreateTime.Add(24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		linkType   payments.PaymentLinkType
+		timeExpire *time.Time
+		want       time.Time
+	}{
+		{name: "native", linkType: payments.PaymentLinkNative, want: testCreateTime.Add(payments.NativeCodeURLValidity)},
+		{name: "h5", linkType: payments.PaymentLinkH5, want: testCreateTime.Add(payments.H5URLValidity)},
+		// 订单失效时间早于链接有效期时，以订单失效时间为准
+		{name: "native expires with order", linkType: payments.PaymentLinkNative, timeExpire: &early, want: early},
+		{name: "h5 expires with order", linkType: payments.PaymentLinkH5, timeExpire: &early, want: early},
+		{name: "order expires later", linkType: payments.PaymentLinkNative, timeExpire: &late, want: testCreateTime.Add(payments.NativeCodeURLValidity)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawURL := testCodeURL
+			if tt.linkType == payments.PaymentLinkH5 {
+				rawURL = testH5URL
+			}
+			link, err := payments.NewPaymentLink(tt.linkType, "1217752501201407033233368018", rawURL, testCreateTime, tt.timeExpire)
+			require.NoError(t, err)
+			assert.Equal(t, tt.linkType, link.Type)
+			assert.Equal(t, "1217752501201407033233368018", link.OutTradeNo)
+			assert.Equal(t, rawURL, link.URL)
+			assert.Equal(t, rawURL, link.DistributionURL())
+			assert.True(t, tt.want.Equal(link.ExpireAt), link.ExpireAt)
+
+			assert.False(t, link.Expired(tt.want.Add(-time.Second)))
+			assert.True(t, link.Expired(tt.want))
+		})
+	}
+}
+
+func TestNewPaymentLink_Invalid(t *testing.T) {
+	for _, tt := range []struct {
+		linkType payments.PaymentLinkType
+		rawURL   string
+	}{
+		{linkType: "JSAPI", rawURL: testCodeURL},
+		{linkType: payments.PaymentLinkNative, rawURL: ""},
+		{linkType: payments.PaymentLinkNative, rawURL: "bizpayurl/up?pr=NwY5Mz9"},
+		{linkType: payments.PaymentLinkH5, rawURL: "://wx.tenpay.com"},
+	} {
+		_, err := payments.NewPaymentLink(tt.linkType, "1217752501201407033233368018", tt.rawURL, testCreateTime, nil)
+		assert.Error(t, err, "%s %s", tt.linkType, tt.rawURL)
+	}
+}
+
+func TestPaymentLink_WithRedirectURL(t *testing.T) {
+	link, err := payments.NewPaymentLink(payments.PaymentLinkH5, "1217752501201407033233368018", testH5URL, testCreateTime, nil)
+	require.NoError(t, err)
+	link.ShortURL = "https://s.example.com/abc"
+
+	redirected, err := link.WithRedirectURL("https://www.example.com/result?order=1217752501201407033233368018")
+	require.NoError(t, err)
+	u, err := url.Parse(redirected.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.example.com/result?order=1217752501201407033233368018", u.Query().Get("redirect_url"))
+	// 保留原有的查询参数
+	assert.Equal(t, "wx2016121516420242444321ca0631331346", u.Query().Get("prepay_id"))
+	assert.Equal(t, "1405458241", u.Query().Get("package"))
+	// 短链接指向原始链接，需要重新生成
+	assert.Empty(t, redirected.ShortURL)
+	assert.Equal(t, link.ExpireAt, redirected.ExpireAt)
+
+	// 原链接不变
+	assert.Equal(t, testH5URL, link.URL)
+	assert.Equal(t, "https://s.example.com/abc", link.ShortURL)
+
+	native, err := payments.NewPaymentLink(payments.PaymentLinkNative, "1217752501201407033233368018", testCodeURL, testCreateTime, nil)
+	require.NoError(t, err)
+	_, err = native.WithRedirectURL("https://www.example.com/result")
+	assert.Error(t, err)
+}
+
+func TestPaymentLink_Shorten(t *testing.T) {
+	ctx := context.Background()
+	link, err := payments.NewPaymentLink(payments.PaymentLinkNative, "1217752501201407033233368018", testCodeURL, testCreateTime, nil)
+	require.NoError(t, err)
+
+	var shortened *payments.PaymentLink
+	shortener := payments.LinkShortenerFunc(func(_ context.Context, l *payments.PaymentLink) (string, error) {
+		shortened = l
+		return "https://s.example.com/abc", nil
+	})
+	require.NoError(t, link.Shorten(ctx, shortener, testCreateTime.Add(time.Minute)))
+	assert.Same(t, link, shortened)
+	assert.Equal(t, "https://s.example.com/abc", link.ShortURL)
+	assert.Equal(t, "https://s.example.com/abc", link.DistributionURL())
+
+	// 已失效的链接不生成短链接
+	expired, _ := payments.NewPaymentLink(payments.PaymentLinkNative, "1217752501201407033233368018", testCodeURL, testCreateTime, nil)
+	shortened = nil
+	err = expired.Shorten(ctx, shortener, expired.ExpireAt)
+	assert.Error(t, err)
+	assert.Nil(t, shortened)
+	assert.Empty(t, expired.ShortURL)
+
+	shortenErr := errors.New("shortener unavailable")
+	failing, _ := payments.NewPaymentLink(payments.PaymentLinkNative, "1217752501201407033233368018", testCodeURL, testCreateTime, nil)
+	err = failing.Shorten(ctx, payments.LinkShortenerFunc(func(context.Context, *payments.PaymentLink) (string, error) {
+		return "", shortenErr
+	}), testCreateTime)
+	assert.True(t, errors.Is(err, shortenErr))
+	assert.Empty(t, failing.ShortURL)
+}
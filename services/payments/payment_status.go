@@ -0,0 +1,115 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+)
+
+// PaymentState 归一化后的订单支付状态，屏蔽不同支付方式的交易状态差异
+type PaymentState string
+
+// 归一化后的订单支付状态
+const (
+	PaymentStatePending  PaymentState = "PENDING"  // 等待支付：NOTPAY、USERPAYING、ACCEPT
+	PaymentStatePaid     PaymentState = "PAID"     // 已支付：SUCCESS
+	PaymentStateRefunded PaymentState = "REFUNDED" // 已支付且转入退款：REFUND
+	PaymentStateClosed   PaymentState = "CLOSED"   // 未支付且不能再支付：CLOSED、REVOKED
+	PaymentStateFailed   PaymentState = "FAILED"   // 支付失败：PAYERROR
+	PaymentStateUnknown  PaymentState = "UNKNOWN"  // 应答中没有交易状态，或为 SDK 尚未识别的交易状态
+)
+
+// NormalizeTradeState 将交易状态（trade_state）映射为归一化的订单支付状态
+func NormalizeTradeState(tradeState string) PaymentState {
+	switch tradeState {
+	case TradeStateNotPay, TradeStateUserPaying, TradeStateAccept:
+		return PaymentStatePending
+	case TradeStateSuccess:
+		return PaymentStatePaid
+	case TradeStateRefund:
+		return PaymentStateRefunded
+	case TradeStateClosed, TradeStateRevoked:
+		return PaymentStateClosed
+	case TradeStatePayError:
+		return PaymentStateFailed
+	}
+	return PaymentStateUnknown
+}
+
+// PaymentStatus 订单支付状态，由查询订单的应答归一化得到
+type PaymentStatus struct {
+	// State 归一化后的订单支付状态
+	State PaymentState
+	// TradeState 应答中原始的交易状态
+	TradeState string
+	// OutTradeNo 商户订单号
+	OutTradeNo string
+	// TransactionId 微信支付订单号，未支付的订单可能为空
+	TransactionId string
+	// Total 订单总金额，单位为分
+	Total int64
+	// PaidAmount 用户实际支付的金额，单位为分，订单未支付时为 0
+	PaidAmount int64
+	// PayerOpenid 支付者在 appid 下的 openid，服务商订单为 sp_appid、合单订单为 combine_appid，订单未支付时可能为空
+	PayerOpenid string
+	// PayerSubOpenid 支付者在特约商户 sub_appid 下的 openid，仅下单时传入了 sub_appid 的服务商订单有值
+	PayerSubOpenid string
+	// SuccessTime 支付完成时间，订单未支付时为空
+	SuccessTime string
+}
+
+// Paid 订单是否已支付，已支付后转入退款的订单也视为已支付
+func (s *PaymentStatus) Paid() bool {
+	return s.State == PaymentStatePaid || s.State == PaymentStateRefunded
+}
+
+// Terminal 订单支付状态是否为终态，终态的订单状态不会再发生变化
+func (s *PaymentStatus) Terminal() bool {
+	return IsTerminalTradeState(s.TradeState)
+}
+
+// NewPaymentStatus 使用查询订单的应答创建 PaymentStatus
+func NewPaymentStatus(transaction *Transaction) *PaymentStatus {
+	status := &PaymentStatus{State: PaymentStateUnknown}
+	if transaction == nil {
+		return status
+	}
+	if transaction.TradeState != nil {
+		status.TradeState = *transaction.TradeState
+		status.State = NormalizeTradeState(status.TradeState)
+	}
+	if transaction.OutTradeNo != nil {
+		status.OutTradeNo = *transaction.OutTradeNo
+	}
+	if transaction.TransactionId != nil {
+		status.TransactionId = *transaction.TransactionId
+	}
+	if transaction.SuccessTime != nil {
+		status.SuccessTime = *transaction.SuccessTime
+	}
+	if transaction.Payer != nil && transaction.Payer.Openid != nil {
+		status.PayerOpenid = *transaction.Payer.Openid
+	}
+	if amount := transaction.Amount; amount != nil {
+		if amount.Total != nil {
+			status.Total = *amount.Total
+		}
+		if amount.PayerTotal != nil && status.Paid() {
+			status.PaidAmount = *amount.PayerTotal
+		}
+	}
+	return status
+}
+
+// QueryPaymentStatus 使用 querier 查询商户订单号为 outTradeNo 的订单，并返回归一化的订单支付状态
+//
+// 各支付方式服务的 OrderStatus 方法即基于该函数实现
+func QueryPaymentStatus(ctx context.Context, querier OrderQuerier, outTradeNo string) (*PaymentStatus, error) {
+	if querier == nil {
+		return nil, fmt.Errorf("querier is required and must be specified")
+	}
+	transaction, err := querier(ctx, outTradeNo)
+	if err != nil {
+		return nil, err
+	}
+	return NewPaymentStatus(transaction), nil
+}
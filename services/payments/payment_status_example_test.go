@@ -0,0 +1,45 @@
+package payments_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/jsapi"
+)
+
+func ExamplePaymentStatus() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := jsapi.JsapiApiService{Client: client}
+	status, err := svc.OrderStatus(ctx, "1230000109", "1217752501201407033233368018")
+	if err != nil {
+		return
+	}
+
+	switch status.State {
+	case payments.PaymentStatePaid:
+		// TODO: 订单已支付，status.PaidAmount 为用户实际支付的金额
+	case payments.PaymentStatePending:
+		// TODO: 等待用户支付
+	default:
+		// TODO: 订单已关闭、支付失败或已转入退款
+	}
+}
+
+func ExampleNewPaymentStatus() {
+	status := payments.NewPaymentStatus(&payments.Transaction{
+		OutTradeNo: core.String("1217752501201407033233368018"),
+		TradeState: core.String(payments.TradeStateRefund),
+		Amount:     &payments.TransactionAmount{Total: core.Int64(100), PayerTotal: core.Int64(90)},
+		Payer:      &payments.TransactionPayer{Openid: core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o")},
+	})
+	fmt.Println(status.State, status.Paid(), status.PaidAmount, status.PayerOpenid)
+	// Output:
+	// REFUNDED true 90 oUpF8uMuAJO_M2pxb1Q9zNjWeS6o
+}
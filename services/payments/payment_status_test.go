@@ -0,0 +1,103 @@
+package payments_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+func TestNormalizeTradeState(t *testing.T) {
+	tests := []struct {
+		tradeState string
+		want       payments.PaymentState
+		terminal   bool
+	}{
+		{tradeState: payments.TradeStateNotPay, want: payments.PaymentStatePending},
+		{tradeState: payments.TradeStateUserPaying, want: payments.PaymentStatePending},
+		{tradeState: payments.TradeStateAccept, want: payments.PaymentStatePending},
+		{tradeState: payments.TradeStateSuccess, want: payments.PaymentStatePaid, terminal: true},
+		{tradeState: payments.TradeStateRefund, want: payments.PaymentStateRefunded, terminal: true},
+		{tradeState: payments.TradeStateClosed, want: payments.PaymentStateClosed, terminal: true},
+		{tradeState: payments.TradeStateRevoked, want: payments.PaymentStateClosed, terminal: true},
+		{tradeState: payments.TradeStatePayError, want: payments.PaymentStateFailed, terminal: true},
+		{tradeState: "", want: payments.PaymentStateUnknown},
+		// SDK 尚未识别的交易状态
+		{tradeState: "FROZEN", want: payments.PaymentStateUnknown, terminal: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tradeState, func(t *testing.T) {
+			assert.Equal(t, tt.want, payments.NormalizeTradeState(tt.tradeState))
+
+			status := payments.NewPaymentStatus(&payments.Transaction{TradeState: core.String(tt.tradeState)})
+			assert.Equal(t, tt.want, status.State)
+			assert.Equal(t, tt.tradeState, status.TradeState)
+			assert.Equal(t, tt.terminal, status.Terminal())
+			assert.Equal(t, tt.want == payments.PaymentStatePaid || tt.want == payments.PaymentStateRefunded, status.Paid())
+		})
+	}
+}
+
+func TestNewPaymentStatus(t *testing.T) {
+	transaction := &payments.Transaction{
+		OutTradeNo:    core.String("1217752501201407033233368018"),
+		TransactionId: core.String("1217752501201407033233368018"),
+		TradeState:    core.String(payments.TradeStateSuccess),
+		SuccessTime:   core.String("2018-06-08T10:34:56+08:00"),
+		Amount:        &payments.TransactionAmount{Total: core.Int64(100), PayerTotal: core.Int64(90)},
+		Payer:         &payments.TransactionPayer{Openid: core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o")},
+	}
+	assert.Equal(t, &payments.PaymentStatus{
+		State:         payments.PaymentStatePaid,
+		TradeState:    payments.TradeStateSuccess,
+		OutTradeNo:    "1217752501201407033233368018",
+		TransactionId: "1217752501201407033233368018",
+		Total:         100,
+		PaidAmount:    90,
+		PayerOpenid:   "oUpF8uMuAJO_M2pxb1Q9zNjWeS6o",
+		SuccessTime:   "2018-06-08T10:34:56+08:00",
+	}, payments.NewPaymentStatus(transaction))
+
+	// 未支付的订单不计实际支付金额
+	transaction.TradeState = core.String(payments.TradeStateClosed)
+	status := payments.NewPaymentStatus(transaction)
+	assert.Equal(t, payments.PaymentStateClosed, status.State)
+	assert.Equal(t, int64(100), status.Total)
+	assert.Zero(t, status.PaidAmount)
+
+	// 应答中缺少的字段保持零值
+	assert.Equal(t, &payments.PaymentStatus{State: payments.PaymentStateUnknown}, payments.NewPaymentStatus(&payments.Transaction{}))
+	assert.Equal(t, &payments.PaymentStatus{State: payments.PaymentStateUnknown}, payments.NewPaymentStatus(nil))
+	status = payments.NewPaymentStatus(&payments.Transaction{
+		TradeState: core.String(payments.TradeStateSuccess),
+		Amount:     &payments.TransactionAmount{},
+		Payer:      &payments.TransactionPayer{},
+	})
+	assert.Equal(t, &payments.PaymentStatus{State: payments.PaymentStatePaid, TradeState: payments.TradeStateSuccess}, status)
+}
+
+func TestQueryPaymentStatus(t *testing.T) {
+	ctx := context.Background()
+	status, err := payments.QueryPaymentStatus(ctx, func(_ context.Context, outTradeNo string) (*payments.Transaction, error) {
+		return &payments.Transaction{OutTradeNo: core.String(outTradeNo), TradeState: core.String(payments.TradeStateRefund)}, nil
+	}, "1217752501201407033233368018")
+	require.NoError(t, err)
+	assert.Equal(t, "1217752501201407033233368018", status.OutTradeNo)
+	assert.Equal(t, payments.PaymentStateRefunded, status.State)
+
+	queryErr := &core.APIError{Code: "ORDER_NOT_EXIST"}
+	status, err = payments.QueryPaymentStatus(ctx, func(context.Context, string) (*payments.Transaction, error) {
+		return nil, queryErr
+	}, "1217752501201407033233368018")
+	assert.True(t, errors.Is(err, queryErr), "%v", err)
+	assert.Nil(t, status)
+
+	status, err = payments.QueryPaymentStatus(ctx, nil, "1217752501201407033233368018")
+	assert.Error(t, err)
+	assert.Nil(t, status)
+}
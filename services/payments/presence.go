@@ -0,0 +1,154 @@
+package payments
+
+// 以下方法用于区分应答中未返回的字段与零值，如未使用代金券的订单不返回 promotion_detail，未支付的订单不返回 amount.payer_total。
+// 未返回的字段为 nil，接收者为 nil 时同样返回 false，可以链式调用，如 transaction.Amount.HasPayerTotal()。
+
+// HasCouponId 应答中是否返回了 coupon_id，o 为 nil 时返回 false
+func (o *PromotionDetail) HasCouponId() bool {
+	return o != nil && o.CouponId != nil
+}
+
+// HasName 应答中是否返回了 name，o 为 nil 时返回 false
+func (o *PromotionDetail) HasName() bool {
+	return o != nil && o.Name != nil
+}
+
+// HasScope 应答中是否返回了 scope，o 为 nil 时返回 false
+func (o *PromotionDetail) HasScope() bool {
+	return o != nil && o.Scope != nil
+}
+
+// HasType 应答中是否返回了 type，o 为 nil 时返回 false
+func (o *PromotionDetail) HasType() bool {
+	return o != nil && o.Type != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *PromotionDetail) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// HasStockId 应答中是否返回了 stock_id，o 为 nil 时返回 false
+func (o *PromotionDetail) HasStockId() bool {
+	return o != nil && o.StockId != nil
+}
+
+// HasWechatpayContribute 应答中是否返回了 wechatpay_contribute，o 为 nil 时返回 false
+func (o *PromotionDetail) HasWechatpayContribute() bool {
+	return o != nil && o.WechatpayContribute != nil
+}
+
+// HasMerchantContribute 应答中是否返回了 merchant_contribute，o 为 nil 时返回 false
+func (o *PromotionDetail) HasMerchantContribute() bool {
+	return o != nil && o.MerchantContribute != nil
+}
+
+// HasOtherContribute 应答中是否返回了 other_contribute，o 为 nil 时返回 false
+func (o *PromotionDetail) HasOtherContribute() bool {
+	return o != nil && o.OtherContribute != nil
+}
+
+// HasCurrency 应答中是否返回了 currency，o 为 nil 时返回 false
+func (o *PromotionDetail) HasCurrency() bool {
+	return o != nil && o.Currency != nil
+}
+
+// HasGoodsDetail 应答中是否返回了 goods_detail，o 为 nil 时返回 false
+func (o *PromotionDetail) HasGoodsDetail() bool {
+	return o != nil && o.GoodsDetail != nil
+}
+
+// HasGoodsRemark 应答中是否返回了 goods_remark，o 为 nil 时返回 false
+func (o *PromotionGoodsDetail) HasGoodsRemark() bool {
+	return o != nil && o.GoodsRemark != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *Transaction) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// HasAppid 应答中是否返回了 appid，o 为 nil 时返回 false
+func (o *Transaction) HasAppid() bool {
+	return o != nil && o.Appid != nil
+}
+
+// HasAttach 应答中是否返回了 attach，o 为 nil 时返回 false
+func (o *Transaction) HasAttach() bool {
+	return o != nil && o.Attach != nil
+}
+
+// HasBankType 应答中是否返回了 bank_type，o 为 nil 时返回 false
+func (o *Transaction) HasBankType() bool {
+	return o != nil && o.BankType != nil
+}
+
+// HasMchid 应答中是否返回了 mchid，o 为 nil 时返回 false
+func (o *Transaction) HasMchid() bool {
+	return o != nil && o.Mchid != nil
+}
+
+// HasOutTradeNo 应答中是否返回了 out_trade_no，o 为 nil 时返回 false
+func (o *Transaction) HasOutTradeNo() bool {
+	return o != nil && o.OutTradeNo != nil
+}
+
+// HasPayer 应答中是否返回了 payer，o 为 nil 时返回 false
+func (o *Transaction) HasPayer() bool {
+	return o != nil && o.Payer != nil
+}
+
+// HasPromotionDetail 应答中是否返回了 promotion_detail，o 为 nil 时返回 false
+func (o *Transaction) HasPromotionDetail() bool {
+	return o != nil && o.PromotionDetail != nil
+}
+
+// HasSuccessTime 应答中是否返回了 success_time，o 为 nil 时返回 false
+func (o *Transaction) HasSuccessTime() bool {
+	return o != nil && o.SuccessTime != nil
+}
+
+// HasTradeState 应答中是否返回了 trade_state，o 为 nil 时返回 false
+func (o *Transaction) HasTradeState() bool {
+	return o != nil && o.TradeState != nil
+}
+
+// HasTradeStateDesc 应答中是否返回了 trade_state_desc，o 为 nil 时返回 false
+func (o *Transaction) HasTradeStateDesc() bool {
+	return o != nil && o.TradeStateDesc != nil
+}
+
+// HasTradeType 应答中是否返回了 trade_type，o 为 nil 时返回 false
+func (o *Transaction) HasTradeType() bool {
+	return o != nil && o.TradeType != nil
+}
+
+// HasTransactionId 应答中是否返回了 transaction_id，o 为 nil 时返回 false
+func (o *Transaction) HasTransactionId() bool {
+	return o != nil && o.TransactionId != nil
+}
+
+// HasCurrency 应答中是否返回了 currency，o 为 nil 时返回 false
+func (o *TransactionAmount) HasCurrency() bool {
+	return o != nil && o.Currency != nil
+}
+
+// HasPayerCurrency 应答中是否返回了 payer_currency，o 为 nil 时返回 false
+func (o *TransactionAmount) HasPayerCurrency() bool {
+	return o != nil && o.PayerCurrency != nil
+}
+
+// HasPayerTotal 应答中是否返回了 payer_total，o 为 nil 时返回 false
+func (o *TransactionAmount) HasPayerTotal() bool {
+	return o != nil && o.PayerTotal != nil
+}
+
+// HasTotal 应答中是否返回了 total，o 为 nil 时返回 false
+func (o *TransactionAmount) HasTotal() bool {
+	return o != nil && o.Total != nil
+}
+
+// HasOpenid 应答中是否返回了 openid，o 为 nil 时返回 false
+func (o *TransactionPayer) HasOpenid() bool {
+	return o != nil && o.Openid != nil
+}
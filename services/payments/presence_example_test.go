@@ -0,0 +1,24 @@
+package payments_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+func ExampleTransaction_HasPromotionDetail() {
+	var transaction payments.Transaction
+	_ = json.Unmarshal([]byte(`{"trade_state":"NOTPAY","amount":{"total":100,"currency":"CNY"}}`), &transaction)
+
+	fmt.Println(transaction.HasPromotionDetail(), transaction.HasPayer())
+	fmt.Println(transaction.Amount.HasTotal(), transaction.Amount.HasPayerTotal())
+
+	// 接收者为 nil 时返回 false
+	var empty payments.Transaction
+	fmt.Println(empty.Amount.HasPayerTotal())
+	// Output:
+	// false false
+	// true false
+	// false
+}
@@ -0,0 +1,83 @@
+package payments
+
+// 优惠范围（PromotionDetail.Scope）
+const (
+	PromotionScopeGlobal = "GLOBAL" // 全场代金券
+	PromotionScopeSingle = "SINGLE" // 单品优惠
+)
+
+// 优惠类型（PromotionDetail.Type）
+const (
+	PromotionTypeCash   = "CASH"   // 充值型代金券，出资方预先充值，优惠金额参与结算
+	PromotionTypeNoCash = "NOCASH" // 免充值型优惠券，优惠金额不参与结算
+)
+
+// PromotionSummary 订单优惠金额汇总，单位为分
+//
+// 可用于收入确认：商户出资部分减少商户收入，微信出资与其他出资方出资部分由出资方承担
+type PromotionSummary struct {
+	// Total 用户享受的优惠总金额，即各优惠 amount 之和
+	Total int64
+	// WechatpayContribute 微信出资金额
+	WechatpayContribute int64
+	// MerchantContribute 商户出资金额
+	MerchantContribute int64
+	// OtherContribute 其他出资方出资金额
+	OtherContribute int64
+	// Cash 充值型代金券（CASH）的优惠金额
+	Cash int64
+	// NoCash 免充值型优惠券（NOCASH）的优惠金额
+	NoCash int64
+	// GoodsDiscount 单品优惠按商品编码汇总的优惠金额，没有单品优惠时为 nil
+	GoodsDiscount map[string]int64
+}
+
+// Unattributed 未拆分到出资方的优惠金额，即 Total 与各出资方金额之和的差额，应答完整时为 0
+func (s PromotionSummary) Unattributed() int64 {
+	return s.Total - s.WechatpayContribute - s.MerchantContribute - s.OtherContribute
+}
+
+// SummarizePromotions 汇总 details 的优惠金额，应答中未返回的金额按 0 计算
+func SummarizePromotions(details []PromotionDetail) PromotionSummary {
+	var s PromotionSummary
+	for _, detail := range details {
+		amount := int64Value(detail.Amount)
+		s.Total += amount
+		s.WechatpayContribute += int64Value(detail.WechatpayContribute)
+		s.MerchantContribute += int64Value(detail.MerchantContribute)
+		s.OtherContribute += int64Value(detail.OtherContribute)
+		if detail.Type != nil {
+			switch *detail.Type {
+			case PromotionTypeCash:
+				s.Cash += amount
+			case PromotionTypeNoCash:
+				s.NoCash += amount
+			}
+		}
+		for _, goods := range detail.GoodsDetail {
+			if goods.GoodsId == nil {
+				continue
+			}
+			if s.GoodsDiscount == nil {
+				s.GoodsDiscount = make(map[string]int64)
+			}
+			s.GoodsDiscount[*goods.GoodsId] += int64Value(goods.DiscountAmount)
+		}
+	}
+	return s
+}
+
+// PromotionSummary 汇总订单的优惠金额，o 为 nil 或订单未使用优惠时返回零值
+func (o *Transaction) PromotionSummary() PromotionSummary {
+	if o == nil {
+		return PromotionSummary{}
+	}
+	return SummarizePromotions(o.PromotionDetail)
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
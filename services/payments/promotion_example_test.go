@@ -0,0 +1,39 @@
+package payments_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+func ExampleTransaction_PromotionSummary() {
+	var transaction payments.Transaction
+	_ = json.Unmarshal([]byte(`{
+		"trade_state": "SUCCESS",
+		"amount": {"total": 1000, "payer_total": 700, "currency": "CNY"},
+		"promotion_detail": [
+			{
+				"coupon_id": "109519", "scope": "GLOBAL", "type": "CASH", "amount": 200,
+				"wechatpay_contribute": 150, "merchant_contribute": 50, "other_contribute": 0
+			},
+			{
+				"coupon_id": "109520", "scope": "SINGLE", "type": "NOCASH", "amount": 100,
+				"wechatpay_contribute": 0, "merchant_contribute": 100, "other_contribute": 0,
+				"goods_detail": [
+					{"goods_id": "M1006", "quantity": 1, "unit_price": 500, "discount_amount": 100}
+				]
+			}
+		]
+	}`), &transaction)
+
+	summary := transaction.PromotionSummary()
+	fmt.Println("total:", summary.Total, "cash:", summary.Cash, "nocash:", summary.NoCash)
+	fmt.Println("wechatpay:", summary.WechatpayContribute, "merchant:", summary.MerchantContribute,
+		"unattributed:", summary.Unattributed())
+	fmt.Println("goods M1006:", summary.GoodsDiscount["M1006"])
+	// Output:
+	// total: 300 cash: 200 nocash: 100
+	// wechatpay: 150 merchant: 150 unattributed: 0
+	// goods M1006: 100
+}
@@ -0,0 +1,93 @@
+package payments_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+func TestSummarizePromotions(t *testing.T) {
+	details := []payments.PromotionDetail{
+		{
+			CouponId:            core.String("109519"),
+			Scope:               core.String(payments.PromotionScopeGlobal),
+			Type:                core.String(payments.PromotionTypeCash),
+			Amount:              core.Int64(100),
+			WechatpayContribute: core.Int64(60),
+			MerchantContribute:  core.Int64(30),
+			OtherContribute:     core.Int64(10),
+		},
+		{
+			CouponId:           core.String("109520"),
+			Scope:              core.String(payments.PromotionScopeSingle),
+			Type:               core.String(payments.PromotionTypeNoCash),
+			Amount:             core.Int64(50),
+			MerchantContribute: core.Int64(50),
+			GoodsDetail: []payments.PromotionGoodsDetail{
+				{GoodsId: core.String("M1006"), Quantity: core.Int64(1), UnitPrice: core.Int64(100), DiscountAmount: core.Int64(20)},
+				{GoodsId: core.String("M1007"), Quantity: core.Int64(1), UnitPrice: core.Int64(100), DiscountAmount: core.Int64(30)},
+			},
+		},
+		{
+			CouponId:           core.String("109521"),
+			Scope:              core.String(payments.PromotionScopeSingle),
+			Type:               core.String(payments.PromotionTypeNoCash),
+			Amount:             core.Int64(10),
+			MerchantContribute: core.Int64(10),
+			GoodsDetail: []payments.PromotionGoodsDetail{
+				{GoodsId: core.String("M1006"), Quantity: core.Int64(1), UnitPrice: core.Int64(100), DiscountAmount: core.Int64(10)},
+			},
+		},
+	}
+	summary := payments.SummarizePromotions(details)
+	assert.Equal(t, payments.PromotionSummary{
+		Total:               160,
+		WechatpayContribute: 60,
+		MerchantContribute:  90,
+		OtherContribute:     10,
+		Cash:                100,
+		NoCash:              60,
+		// 同一商品的单品优惠累加
+		GoodsDiscount: map[string]int64{"M1006": 30, "M1007": 30},
+	}, summary)
+	assert.Zero(t, summary.Unattributed())
+}
+
+func TestSummarizePromotions_MissingFields(t *testing.T) {
+	// 应答中未返回的金额按 0 计算，未拆分出资方的金额计入 Unattributed
+	summary := payments.SummarizePromotions([]payments.PromotionDetail{
+		{Amount: core.Int64(100), WechatpayContribute: core.Int64(40)},
+		{Type: core.String("UNKNOWN"), Amount: core.Int64(20)},
+		{GoodsDetail: []payments.PromotionGoodsDetail{{DiscountAmount: core.Int64(10)}, {GoodsId: core.String("M1006")}}},
+	})
+	assert.Equal(t, payments.PromotionSummary{
+		Total:               120,
+		WechatpayContribute: 40,
+		GoodsDiscount:       map[string]int64{"M1006": 0},
+	}, summary)
+	assert.Equal(t, int64(80), summary.Unattributed())
+
+	assert.Equal(t, payments.PromotionSummary{}, payments.SummarizePromotions(nil))
+	assert.Nil(t, payments.SummarizePromotions([]payments.PromotionDetail{{Amount: core.Int64(1)}}).GoodsDiscount)
+}
+
+func TestTransaction_PromotionSummary(t *testing.T) {
+	var transaction payments.Transaction
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"out_trade_no": "1217752501201407033233368018",
+		"trade_state": "SUCCESS",
+		"promotion_detail": [
+			{"coupon_id": "109519", "scope": "GLOBAL", "type": "CASH", "amount": 100, "wechatpay_contribute": 0, "merchant_contribute": 100, "other_contribute": 0}
+		]
+	}`), &transaction))
+	assert.Equal(t, payments.PromotionSummary{Total: 100, MerchantContribute: 100, Cash: 100}, transaction.PromotionSummary())
+
+	var nilTransaction *payments.Transaction
+	assert.Equal(t, payments.PromotionSummary{}, nilTransaction.PromotionSummary())
+	assert.Equal(t, payments.PromotionSummary{}, (&payments.Transaction{}).PromotionSummary())
+}
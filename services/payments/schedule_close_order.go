@@ -0,0 +1,141 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+)
+
+// OrderCloser 根据商户订单号关闭订单，可使用各支付方式服务的 OrderCloser 方法创建
+type OrderCloser func(ctx context.Context, outTradeNo string) error
+
+const defaultCloseTimeout = 10 * time.Second
+
+// AutoCloseOptions ScheduleCloseOrder 的配置项
+type AutoCloseOptions struct {
+	// Closer 【必填】订单关闭方法
+	Closer OrderCloser
+	// Querier 【可选】订单查询方法。设置后将在关单前查询订单，交易状态为终态（如已支付）时不再关单
+	Querier OrderQuerier
+	// CloseTimeout 关单（包括关单前查询）的超时时间，默认为 10s
+	CloseTimeout time.Duration
+}
+
+// ScheduledClose 已计划的关单任务
+type ScheduledClose struct {
+	outTradeNo string
+	opts       AutoCloseOptions
+	cancelCh   chan struct{}
+	done       chan struct{}
+
+	lock      sync.Mutex
+	started   bool
+	cancelled bool
+	closed    bool
+	err       error
+}
+
+// ScheduleCloseOrder 计划在订单失效时间 timeExpire 到达，或 ctx 结束（如商户侧订单已作废）时关闭订单，避免商户侧已作废的订单被用户支付
+//
+// 收到支付成功通知后，请调用 ScheduledClose.Cancel 取消关单。关单请求使用独立的 context 发起，不受 ctx 结束的影响。
+// 距失效时间的等待时长以 ctx 中的时钟（见 auth.Now）计算。
+func ScheduleCloseOrder(
+	ctx context.Context, outTradeNo string, timeExpire time.Time, opts AutoCloseOptions,
+) (*ScheduledClose, error) {
+	if opts.Closer == nil {
+		return nil, fmt.Errorf("field `Closer` is required and must be specified in AutoCloseOptions")
+	}
+	if timeExpire.IsZero() {
+		return nil, fmt.Errorf("timeExpire is required and must be specified")
+	}
+	if opts.CloseTimeout <= 0 {
+		opts.CloseTimeout = defaultCloseTimeout
+	}
+
+	s := &ScheduledClose{
+		outTradeNo: outTradeNo,
+		opts:       opts,
+		cancelCh:   make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	go s.run(ctx, timeExpire.Sub(auth.Now(ctx)))
+	return s, nil
+}
+
+func (s *ScheduledClose) run(ctx context.Context, delay time.Duration) {
+	defer close(s.done)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	case <-s.cancelCh:
+		return
+	}
+
+	s.lock.Lock()
+	if s.cancelled {
+		s.lock.Unlock()
+		return
+	}
+	s.started = true
+	s.lock.Unlock()
+
+	closed, err := s.closeOrder()
+
+	s.lock.Lock()
+	s.closed, s.err = closed, err
+	s.lock.Unlock()
+}
+
+func (s *ScheduledClose) closeOrder() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.opts.CloseTimeout)
+	defer cancel()
+
+	if s.opts.Querier != nil {
+		transaction, err := s.opts.Querier(ctx, s.outTradeNo)
+		if err == nil && transaction.TradeState != nil && IsTerminalTradeState(*transaction.TradeState) {
+			return false, nil
+		}
+		// 查询失败时仍尝试关单，已支付的订单关单会失败，不会造成资金问题
+	}
+
+	if err := s.opts.Closer(ctx, s.outTradeNo); err != nil {
+		if core.IsAPIError(err, "ORDER_CLOSED") {
+			return true, nil
+		}
+		return false, fmt.Errorf("close order %s err: %w", s.outTradeNo, err)
+	}
+	return true, nil
+}
+
+// Cancel 取消关单，返回 false 表示关单已经开始或已取消
+func (s *ScheduledClose) Cancel() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.started || s.cancelled {
+		return false
+	}
+	s.cancelled = true
+	close(s.cancelCh)
+	return true
+}
+
+// Done 关单任务结束（关单完成、无需关单或已取消）时关闭的 channel
+func (s *ScheduledClose) Done() <-chan struct{} {
+	return s.done
+}
+
+// Result 返回关单结果：closed 表示订单是否已被关闭，err 为关单失败的原因。关单任务结束前调用时均返回零值
+func (s *ScheduledClose) Result() (closed bool, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.closed, s.err
+}
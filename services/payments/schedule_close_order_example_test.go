@@ -0,0 +1,108 @@
+package payments_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+)
+
+func ExampleScheduleCloseOrder() {
+	var client *core.Client
+	// 假设已获得初始化后的 core.Client
+
+	svc := native.NativeApiService{Client: client}
+	timeExpire := time.Now().Add(15 * time.Minute)
+	req := native.NewPrepayRequestBuilder(
+		"wxd678efh567hg6787", "1230000109", "Image形象店-深圳腾大-QQ公仔", "1217752501201407033233368018",
+		"https://www.weixin.qq.com/wxpay/pay.php", 100,
+	).WithTimeExpire(timeExpire).Build()
+	if _, _, err := svc.Prepay(context.Background(), req); err != nil {
+		return
+	}
+
+	scheduled, err := payments.ScheduleCloseOrder(context.Background(), *req.OutTradeNo, timeExpire,
+		payments.AutoCloseOptions{
+			Closer:  svc.OrderCloser("1230000109"),
+			Querier: svc.OrderQuerier("1230000109"),
+		})
+	if err != nil {
+		return
+	}
+
+	// 收到支付成功通知后取消关单
+	scheduled.Cancel()
+}
+
+func ExampleScheduleCloseOrder_expired() {
+	closer := func(ctx context.Context, outTradeNo string) error {
+		fmt.Println("close", outTradeNo)
+		return nil
+	}
+
+	scheduled, err := payments.ScheduleCloseOrder(context.Background(), "1217752501201407033233368018",
+		time.Now().Add(time.Millisecond), payments.AutoCloseOptions{Closer: closer})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	<-scheduled.Done()
+	fmt.Println(scheduled.Result())
+	// Output:
+	// close 1217752501201407033233368018
+	// true <nil>
+}
+
+func ExampleScheduledClose_Cancel() {
+	closer := func(ctx context.Context, outTradeNo string) error {
+		fmt.Println("close", outTradeNo)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduled, err := payments.ScheduleCloseOrder(ctx, "1217752501201407033233368018",
+		time.Now().Add(time.Hour), payments.AutoCloseOptions{Closer: closer})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// 用户已支付，取消关单
+	fmt.Println(scheduled.Cancel())
+	<-scheduled.Done()
+	fmt.Println(scheduled.Result())
+	// Output:
+	// true
+	// false <nil>
+}
+
+func ExampleScheduleCloseOrder_paid() {
+	closer := func(ctx context.Context, outTradeNo string) error {
+		fmt.Println("close", outTradeNo)
+		return nil
+	}
+	querier := func(ctx context.Context, outTradeNo string) (*payments.Transaction, error) {
+		return &payments.Transaction{OutTradeNo: core.String(outTradeNo), TradeState: core.String("SUCCESS")}, nil
+	}
+
+	// 商户侧订单作废时取消 ctx，立即关单；关单前查询到订单已支付，不再关单
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduled, err := payments.ScheduleCloseOrder(ctx, "1217752501201407033233368018",
+		time.Now().Add(time.Hour), payments.AutoCloseOptions{Closer: closer, Querier: querier})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	cancel()
+
+	<-scheduled.Done()
+	fmt.Println(scheduled.Result())
+	// Output:
+	// false <nil>
+}
@@ -0,0 +1,163 @@
+package payments_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+const testOutTradeNo = "1217752501201407033233368018"
+
+var testTimeExpire = time.Date(2018, 6, 8, 10, 34, 56, 0, time.FixedZone("CST", 8*3600))
+
+// clockAt 返回时钟冻结在 timeExpire 之后 offset 的 ctx，offset 为负数时表示订单尚未失效
+func clockAt(ctx context.Context, offset time.Duration) context.Context {
+	return auth.WithClock(ctx, auth.ClockFunc(func() time.Time { return testTimeExpire.Add(offset) }))
+}
+
+// fakeCloser 记录关单请求，以 err 应答
+type fakeCloser struct {
+	lock     sync.Mutex
+	err      error
+	closed   []string
+	deadline time.Duration
+	ctxErr   error
+}
+
+func (c *fakeCloser) close(ctx context.Context, outTradeNo string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.closed = append(c.closed, outTradeNo)
+	if deadline, ok := ctx.Deadline(); ok {
+		c.deadline = time.Until(deadline)
+	}
+	c.ctxErr = ctx.Err()
+	return c.err
+}
+
+func (c *fakeCloser) calls() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]string(nil), c.closed...)
+}
+
+func waitDone(t *testing.T, scheduled *payments.ScheduledClose) {
+	select {
+	case <-scheduled.Done():
+	case <-time.After(time.Second):
+		require.FailNow(t, "scheduled close not done")
+	}
+}
+
+func TestScheduleCloseOrder_Expired(t *testing.T) {
+	tests := []struct {
+		name       string
+		closeErr   error
+		querier    *scriptedQuerier
+		wantClosed bool
+		wantErr    bool
+		wantCalls  int
+	}{
+		{name: "close", wantClosed: true, wantCalls: 1},
+		{name: "order already closed", closeErr: &core.APIError{StatusCode: http.StatusBadRequest, Code: "ORDER_CLOSED"}, wantClosed: true, wantCalls: 1},
+		{name: "close failed", closeErr: &core.APIError{StatusCode: http.StatusBadRequest, Code: "ORDER_PAID"}, wantErr: true, wantCalls: 1},
+		{name: "order not paid", querier: &scriptedQuerier{results: []interface{}{payments.TradeStateNotPay}}, wantClosed: true, wantCalls: 1},
+		// 订单已支付时不再关单
+		{name: "order paid", querier: &scriptedQuerier{results: []interface{}{payments.TradeStateSuccess}}},
+		// 查询失败时仍然关单
+		{
+			name:       "query failed",
+			querier:    &scriptedQuerier{results: []interface{}{&core.APIError{StatusCode: http.StatusInternalServerError, Code: "SYSTEM_ERROR"}}},
+			wantClosed: true,
+			wantCalls:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closer := &fakeCloser{err: tt.closeErr}
+			opts := payments.AutoCloseOptions{Closer: closer.close}
+			if tt.querier != nil {
+				opts.Querier = tt.querier.query
+			}
+
+			// 时钟已过失效时间，立即关单
+			scheduled, err := payments.ScheduleCloseOrder(clockAt(context.Background(), time.Second), testOutTradeNo, testTimeExpire, opts)
+			require.NoError(t, err)
+			waitDone(t, scheduled)
+
+			closed, err := scheduled.Result()
+			assert.Equal(t, tt.wantClosed, closed)
+			if tt.wantErr {
+				assert.True(t, core.IsAPIError(errors.Unwrap(err), "ORDER_PAID"), "%v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, closer.calls(), tt.wantCalls)
+			// 关单已开始或结束后无法取消
+			assert.False(t, scheduled.Cancel())
+		})
+	}
+}
+
+func TestScheduleCloseOrder_Cancel(t *testing.T) {
+	closer := &fakeCloser{}
+	scheduled, err := payments.ScheduleCloseOrder(
+		clockAt(context.Background(), -time.Hour), testOutTradeNo, testTimeExpire, payments.AutoCloseOptions{Closer: closer.close},
+	)
+	require.NoError(t, err)
+
+	select {
+	case <-scheduled.Done():
+		require.FailNow(t, "scheduled close done before timeExpire")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	assert.True(t, scheduled.Cancel())
+	assert.False(t, scheduled.Cancel())
+	waitDone(t, scheduled)
+
+	closed, err := scheduled.Result()
+	assert.False(t, closed)
+	assert.NoError(t, err)
+	assert.Empty(t, closer.calls())
+}
+
+func TestScheduleCloseOrder_ContextDone(t *testing.T) {
+	closer := &fakeCloser{}
+	ctx, cancel := context.WithCancel(clockAt(context.Background(), -time.Hour))
+	scheduled, err := payments.ScheduleCloseOrder(
+		ctx, testOutTradeNo, testTimeExpire,
+		payments.AutoCloseOptions{Closer: closer.close, CloseTimeout: time.Minute},
+	)
+	require.NoError(t, err)
+
+	// ctx 结束时（如商户侧订单已作废）提前关单
+	cancel()
+	waitDone(t, scheduled)
+
+	closed, err := scheduled.Result()
+	assert.True(t, closed)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testOutTradeNo}, closer.calls())
+	// 关单请求不受 ctx 结束的影响，并使用 CloseTimeout 作为超时时间
+	assert.NoError(t, closer.ctxErr)
+	assert.True(t, closer.deadline > 50*time.Second && closer.deadline <= time.Minute, closer.deadline)
+}
+
+func TestScheduleCloseOrder_InvalidOptions(t *testing.T) {
+	closer := &fakeCloser{}
+	_, err := payments.ScheduleCloseOrder(context.Background(), testOutTradeNo, testTimeExpire, payments.AutoCloseOptions{})
+	assert.Error(t, err)
+	_, err = payments.ScheduleCloseOrder(context.Background(), testOutTradeNo, time.Time{}, payments.AutoCloseOptions{Closer: closer.close})
+	assert.Error(t, err)
+}
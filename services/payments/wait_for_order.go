@@ -0,0 +1,152 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// 交易状态
+const (
+	TradeStateSuccess    = "SUCCESS"    // 支付成功
+	TradeStateRefund     = "REFUND"     // 转入退款
+	TradeStateNotPay     = "NOTPAY"     // 未支付
+	TradeStateClosed     = "CLOSED"     // 已关闭
+	TradeStateRevoked    = "REVOKED"    // 已撤销（付款码支付）
+	TradeStateUserPaying = "USERPAYING" // 用户支付中（付款码支付）
+	TradeStatePayError   = "PAYERROR"   // 支付失败
+	TradeStateAccept     = "ACCEPT"     // 已接收，等待扣款
+)
+
+// IsTerminalTradeState 判断交易状态是否为终态，终态的订单状态不会再发生变化
+func IsTerminalTradeState(state string) bool {
+	switch state {
+	case TradeStateNotPay, TradeStateUserPaying, TradeStateAccept:
+		return false
+	}
+	return state != ""
+}
+
+// OrderQuerier 根据商户订单号查询订单，可使用各支付方式服务的 OrderQuerier 方法创建
+type OrderQuerier func(ctx context.Context, outTradeNo string) (*Transaction, error)
+
+const (
+	defaultWaitInitialInterval = 2 * time.Second
+	defaultWaitMaxInterval     = 30 * time.Second
+	defaultWaitMultiplier      = 1.5
+)
+
+// WaitOrderOptions WaitForOrder 的配置项
+type WaitOrderOptions struct {
+	// Querier 【必填】订单查询方法
+	Querier OrderQuerier
+	// InitialInterval 首次查询前的等待时间，默认为 2s
+	InitialInterval time.Duration
+	// MaxInterval 两次查询间的最长等待时间，默认为 30s
+	MaxInterval time.Duration
+	// Multiplier 每次查询后等待时间的增长倍数，默认为 1.5
+	Multiplier float64
+	// States 【可选】每次查询到新的交易状态时，将订单发送到该 channel。WaitForOrder 不会关闭该 channel
+	States chan<- *Transaction
+}
+
+// WaitForOrder 按退避间隔轮询订单，直至交易状态变为终态或 ctx 结束，常用于 Native/H5 下单后等待用户支付
+//
+// 交易状态变为终态时返回最后一次查询到的订单。ctx 结束时返回 ctx 的错误，以及最后一次查询到的订单（可能为 nil）。
+// 查询请求失败时会继续轮询，但请求参数、签名等不可重试的错误将直接返回。
+func WaitForOrder(ctx context.Context, outTradeNo string, opts WaitOrderOptions) (*Transaction, error) {
+	if opts.Querier == nil {
+		return nil, fmt.Errorf("field `Querier` is required and must be specified in WaitOrderOptions")
+	}
+	interval := opts.InitialInterval
+	if interval <= 0 {
+		interval = defaultWaitInitialInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultWaitMaxInterval
+	}
+	multiplier := opts.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultWaitMultiplier
+	}
+
+	var (
+		last      *Transaction
+		lastState string
+		lastErr   error
+	)
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, waitError(ctx.Err(), lastErr)
+		case <-timer.C:
+		}
+
+		transaction, err := opts.Querier(ctx, outTradeNo)
+		if err != nil {
+			if !isRetryableQueryError(err) {
+				return last, err
+			}
+			lastErr = err
+		} else {
+			last, lastErr = transaction, nil
+			state := ""
+			if transaction.TradeState != nil {
+				state = *transaction.TradeState
+			}
+			if state != lastState {
+				lastState = state
+				if err = sendState(ctx, opts.States, transaction); err != nil {
+					return last, err
+				}
+			}
+			if IsTerminalTradeState(state) {
+				return last, nil
+			}
+		}
+
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+func sendState(ctx context.Context, states chan<- *Transaction, transaction *Transaction) error {
+	if states == nil {
+		return nil
+	}
+	select {
+	case states <- transaction:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isRetryableQueryError 订单不存在（下单后立即查询时可能出现）、频率限制及系统错误可以继续轮询
+func isRetryableQueryError(err error) bool {
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	switch apiErr.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+		return false
+	}
+	return true
+}
+
+func waitError(ctxErr, lastErr error) error {
+	if lastErr != nil {
+		return fmt.Errorf("%w, last query err: %v", ctxErr, lastErr)
+	}
+	return ctxErr
+}
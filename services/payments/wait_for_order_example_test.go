@@ -0,0 +1,66 @@
+package payments_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments/native"
+)
+
+func ExampleWaitForOrder() {
+	var client *core.Client
+	// 假设已获得初始化后的 core.Client
+
+	svc := native.NativeApiService{Client: client}
+	// 最多等待 5 分钟
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	states := make(chan *payments.Transaction, 8)
+	go func() {
+		for transaction := range states {
+			fmt.Println(*transaction.TradeState)
+		}
+	}()
+
+	transaction, err := payments.WaitForOrder(ctx, "1217752501201407033233368018", payments.WaitOrderOptions{
+		Querier: svc.OrderQuerier("1230000109"),
+		States:  states,
+	})
+	close(states)
+
+	// TODO: 处理返回结果
+	_, _ = transaction, err
+}
+
+func ExampleWaitForOrder_states() {
+	// 模拟用户扫码后的订单状态变化
+	tradeStates := []string{"NOTPAY", "NOTPAY", "USERPAYING", "SUCCESS"}
+	querier := func(ctx context.Context, outTradeNo string) (*payments.Transaction, error) {
+		state := tradeStates[0]
+		tradeStates = tradeStates[1:]
+		return &payments.Transaction{OutTradeNo: core.String(outTradeNo), TradeState: core.String(state)}, nil
+	}
+
+	states := make(chan *payments.Transaction, len(tradeStates))
+	transaction, err := payments.WaitForOrder(context.Background(), "1217752501201407033233368018",
+		payments.WaitOrderOptions{Querier: querier, InitialInterval: time.Millisecond, States: states})
+	close(states)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for state := range states {
+		fmt.Println(*state.TradeState)
+	}
+	fmt.Println(*transaction.OutTradeNo, *transaction.TradeState)
+	// Output:
+	// NOTPAY
+	// USERPAYING
+	// SUCCESS
+	// 1217752501201407033233368018 SUCCESS
+}
@@ -0,0 +1,133 @@
+package payments_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+// scriptedQuerier 按顺序返回 results 中的订单或错误，用完后重复最后一个
+type scriptedQuerier struct {
+	lock    sync.Mutex
+	results []interface{}
+	calls   int
+}
+
+func (q *scriptedQuerier) query(_ context.Context, outTradeNo string) (*payments.Transaction, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	result := q.results[len(q.results)-1]
+	if q.calls < len(q.results) {
+		result = q.results[q.calls]
+	}
+	q.calls++
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	return &payments.Transaction{OutTradeNo: core.String(outTradeNo), TradeState: core.String(result.(string))}, nil
+}
+
+func (q *scriptedQuerier) options(states chan<- *payments.Transaction) payments.WaitOrderOptions {
+	return payments.WaitOrderOptions{
+		Querier:         q.query,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		States:          states,
+	}
+}
+
+func TestWaitForOrder(t *testing.T) {
+	querier := &scriptedQuerier{results: []interface{}{
+		&core.APIError{StatusCode: http.StatusNotFound, Code: "ORDER_NOT_EXIST"},
+		payments.TradeStateNotPay,
+		payments.TradeStateNotPay,
+		&core.APIError{StatusCode: http.StatusTooManyRequests, Code: "FREQUENCY_LIMITED"},
+		payments.TradeStateUserPaying,
+		payments.TradeStateSuccess,
+	}}
+	states := make(chan *payments.Transaction, 10)
+
+	transaction, err := payments.WaitForOrder(context.Background(), "1217752501201407033233368018", querier.options(states))
+	require.NoError(t, err)
+	assert.Equal(t, payments.TradeStateSuccess, *transaction.TradeState)
+	assert.Equal(t, 6, querier.calls)
+
+	// 仅在交易状态变化时发送
+	close(states)
+	var sent []string
+	for s := range states {
+		sent = append(sent, *s.TradeState)
+	}
+	assert.Equal(t, []string{payments.TradeStateNotPay, payments.TradeStateUserPaying, payments.TradeStateSuccess}, sent)
+}
+
+func TestWaitForOrder_NonRetryableError(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden} {
+		querier := &scriptedQuerier{results: []interface{}{
+			payments.TradeStateNotPay,
+			&core.APIError{StatusCode: status, Code: "PARAM_ERROR"},
+			payments.TradeStateSuccess,
+		}}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		start := time.Now()
+		transaction, err := payments.WaitForOrder(ctx, "1217752501201407033233368018", querier.options(nil))
+		cancel()
+
+		assert.True(t, core.IsAPIError(err, "PARAM_ERROR"), "status %d", status)
+		assert.False(t, errors.Is(err, context.DeadlineExceeded), "status %d", status)
+		assert.Less(t, int64(time.Since(start)), int64(500*time.Millisecond), "status %d", status)
+		assert.Equal(t, 2, querier.calls, "status %d", status)
+		// 返回出错前最后一次查询到的订单
+		require.NotNil(t, transaction)
+		assert.Equal(t, payments.TradeStateNotPay, *transaction.TradeState)
+	}
+}
+
+func TestWaitForOrder_ContextDone(t *testing.T) {
+	queryErr := errors.New("connection reset")
+	querier := &scriptedQuerier{results: []interface{}{payments.TradeStateNotPay, queryErr}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	transaction, err := payments.WaitForOrder(ctx, "1217752501201407033233368018", querier.options(nil))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "last query err: connection reset")
+	require.NotNil(t, transaction)
+	assert.Equal(t, payments.TradeStateNotPay, *transaction.TradeState)
+
+	// 最后一次查询成功时仅返回 ctx 的错误
+	querier = &scriptedQuerier{results: []interface{}{payments.TradeStateNotPay}}
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	_, err = payments.WaitForOrder(ctx, "1217752501201407033233368018", querier.options(nil))
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaitForOrder_UnreadStates(t *testing.T) {
+	querier := &scriptedQuerier{results: []interface{}{payments.TradeStateNotPay, payments.TradeStateSuccess}}
+	// 无缓冲且无人读取的 channel：发送阻塞直至 ctx 结束，不会一直等待
+	states := make(chan *payments.Transaction)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	transaction, err := payments.WaitForOrder(ctx, "1217752501201407033233368018", querier.options(states))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.NotNil(t, transaction)
+	assert.Equal(t, payments.TradeStateNotPay, *transaction.TradeState)
+	assert.Equal(t, 1, querier.calls)
+}
+
+func TestWaitForOrder_MissingQuerier(t *testing.T) {
+	_, err := payments.WaitForOrder(context.Background(), "1217752501201407033233368018", payments.WaitOrderOptions{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,458 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 微信支付分服务订单API
+//
+// 微信支付分（公共API）服务订单相关接口，适用于先享后付、先付后享等场景
+//
+// API version: 1.2.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package payscore
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ServiceOrderApiService services.Service
+
+// CancelServiceOrder 取消支付分订单
+//
+// # 应用场景
+// 微信支付分订单创建之后，由于某些原因导致订单不能正常支付时，可使用此接口取消订单。
+//
+// 注意：
+// 1、订单状态为CREATED，或状态为DOING且状态说明为USER_CONFIRM（用户已确认、商户未完结）时可以取消
+// 2、商户完结订单后不能取消
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_ORDER_STATE|订单状态不正确|服务订单的当前状态不允许该操作|请查询服务订单状态后再操作|
+// |ORDER_NOT_EXIST|订单不存在|服务订单不存在|请确认商户服务订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServiceOrderApiService) CancelServiceOrder(ctx context.Context, req CancelServiceOrderRequest) (resp *CancelServiceOrderResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutOrderNo == nil {
+		return nil, nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CancelServiceOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/payscore/serviceorder/{out_order_no}/cancel"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_order_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutOrderNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &CancelServiceOrderBody{
+		Appid:     req.Appid,
+		ServiceId: req.ServiceId,
+		Reason:    req.Reason,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract CancelServiceOrderResponse from Http Response
+	resp = new(CancelServiceOrderResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// CompleteServiceOrder 完结支付分订单
+//
+// # 应用场景
+// 用户使用服务完成后，商户可通过此接口完结订单，完结后微信支付分将自动从用户的支付方式中扣除订单总金额。
+//
+// 注意：
+// 1、订单状态为DOING且状态说明为USER_CONFIRM时可以完结，完结后状态说明变为MCH_COMPLETE
+// 2、完结后订单总金额不能超过创建订单时的风险金额
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_ORDER_STATE|订单状态不正确|服务订单的当前状态不允许该操作|请查询服务订单状态后再操作|
+// |ORDER_NOT_EXIST|订单不存在|服务订单不存在|请确认商户服务订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServiceOrderApiService) CompleteServiceOrder(ctx context.Context, req CompleteServiceOrderRequest) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutOrderNo == nil {
+		return nil, nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CompleteServiceOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/payscore/serviceorder/{out_order_no}/complete"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_order_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutOrderNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &CompleteServiceOrderBody{
+		Appid:         req.Appid,
+		ServiceId:     req.ServiceId,
+		PostPayments:  req.PostPayments,
+		PostDiscounts: req.PostDiscounts,
+		TotalAmount:   req.TotalAmount,
+		TimeRange:     req.TimeRange,
+		Location:      req.Location,
+		ProfitSharing: req.ProfitSharing,
+		GoodsTag:      req.GoodsTag,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ServiceOrderEntity from Http Response
+	resp = new(ServiceOrderEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// CreateServiceOrder 创建支付分订单
+//
+// # 应用场景
+// 用户申请使用服务时，商户可通过此接口申请创建微信支付分订单。
+//
+// 注意：
+// 1、需要用户确认模式（need_user_confirm为true）下，创建成功后订单状态为CREATED，用户确认后变为DOING
+// 2、同一商户服务订单号重复请求时，微信支付将返回首次创建的订单
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |USER_NOT_EXIST|用户不存在|用户未开通微信支付分或不满足使用条件|请引导用户开通微信支付分或使用其他服务方式|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServiceOrderApiService) CreateServiceOrder(ctx context.Context, req CreateServiceOrderRequest) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/payscore/serviceorder"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ServiceOrderEntity from Http Response
+	resp = new(ServiceOrderEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// ModifyServiceOrder 修改订单金额
+//
+// # 应用场景
+// 商户完结订单后，用户支付成功前，可以通过该接口修改订单总金额，修改后的金额不能超过完结时的金额。
+//
+// 注意：
+// 1、订单状态为DOING且状态说明为MCH_COMPLETE，且用户尚未支付时可以修改
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_ORDER_STATE|订单状态不正确|服务订单的当前状态不允许该操作|请查询服务订单状态后再操作|
+// |ORDER_NOT_EXIST|订单不存在|服务订单不存在|请确认商户服务订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServiceOrderApiService) ModifyServiceOrder(ctx context.Context, req ModifyServiceOrderRequest) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutOrderNo == nil {
+		return nil, nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in ModifyServiceOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/payscore/serviceorder/{out_order_no}/modify"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_order_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutOrderNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &ModifyServiceOrderBody{
+		Appid:         req.Appid,
+		ServiceId:     req.ServiceId,
+		PostPayments:  req.PostPayments,
+		PostDiscounts: req.PostDiscounts,
+		TotalAmount:   req.TotalAmount,
+		Reason:        req.Reason,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ServiceOrderEntity from Http Response
+	resp = new(ServiceOrderEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// PayServiceOrder 商户发起催收扣款
+//
+// # 应用场景
+// 商户完结订单后，如果用户支付失败（如余额不足），可通过该接口发起催收扣款。
+//
+// 注意：
+// 1、订单状态为DOING、状态说明为MCH_COMPLETE且收款状态为USER_PAYING时可以发起
+// 2、扣款结果将通过支付成功回调通知，或通过查询订单获取
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_ORDER_STATE|订单状态不正确|服务订单的当前状态不允许该操作|请查询服务订单状态后再操作|
+// |ORDER_NOT_EXIST|订单不存在|服务订单不存在|请确认商户服务订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServiceOrderApiService) PayServiceOrder(ctx context.Context, req PayServiceOrderRequest) (resp *PayServiceOrderResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutOrderNo == nil {
+		return nil, nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in PayServiceOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/payscore/serviceorder/{out_order_no}/pay"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_order_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutOrderNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &PayServiceOrderBody{
+		Appid:     req.Appid,
+		ServiceId: req.ServiceId,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract PayServiceOrderResponse from Http Response
+	resp = new(PayServiceOrderResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryServiceOrder 查询支付分订单
+//
+// # 应用场景
+// 用于查询单笔微信支付分订单的详细信息，out_order_no与query_id必须填写其中一个。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |ORDER_NOT_EXIST|订单不存在|服务订单不存在|请确认商户服务订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServiceOrderApiService) QueryServiceOrder(ctx context.Context, req QueryServiceOrderRequest) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/payscore/serviceorder"
+	// Make sure All Required Params are properly set
+	if req.ServiceId == nil {
+		return nil, nil, fmt.Errorf("field `ServiceId` is required and must be specified in QueryServiceOrderRequest")
+	}
+	if req.Appid == nil {
+		return nil, nil, fmt.Errorf("field `Appid` is required and must be specified in QueryServiceOrderRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.OutOrderNo != nil {
+		localVarQueryParams.Add("out_order_no", core.ParameterToString(*req.OutOrderNo, ""))
+	}
+	if req.QueryId != nil {
+		localVarQueryParams.Add("query_id", core.ParameterToString(*req.QueryId, ""))
+	}
+	localVarQueryParams.Add("service_id", core.ParameterToString(*req.ServiceId, ""))
+	localVarQueryParams.Add("appid", core.ParameterToString(*req.Appid, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ServiceOrderEntity from Http Response
+	resp = new(ServiceOrderEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// SyncServiceOrder 同步服务订单信息
+//
+// # 应用场景
+// 由于一些原因（如用户通过其他方式向商户付款），微信支付分订单需要同步已收款状态时，可使用此接口。
+//
+// 注意：
+// 1、订单状态为DOING、状态说明为MCH_COMPLETE且用户尚未支付时可以同步
+// 2、同步后订单状态变为DONE
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_ORDER_STATE|订单状态不正确|服务订单的当前状态不允许该操作|请查询服务订单状态后再操作|
+// |ORDER_NOT_EXIST|订单不存在|服务订单不存在|请确认商户服务订单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServiceOrderApiService) SyncServiceOrder(ctx context.Context, req SyncServiceOrderRequest) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutOrderNo == nil {
+		return nil, nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in SyncServiceOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/payscore/serviceorder/{out_order_no}/sync"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_order_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutOrderNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &SyncServiceOrderBody{
+		Appid:     req.Appid,
+		ServiceId: req.ServiceId,
+		Type:      req.Type,
+		Detail:    req.Detail,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ServiceOrderEntity from Http Response
+	resp = new(ServiceOrderEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 微信支付分服务订单API
+//
+// 微信支付分（公共API）服务订单相关接口，适用于先享后付、先付后享等场景
+//
+// API version: 1.2.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package payscore_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payscore"
+)
+
+func ExampleServiceOrderApiService_CancelServiceOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := payscore.ServiceOrderApiService{Client: client}
+	resp, result, err := svc.CancelServiceOrder(ctx,
+		payscore.CancelServiceOrderRequest{
+			Appid:      core.String("wxd678efh567hg6787"),
+			OutOrderNo: core.String("1234323JKHDFE1243252"),
+			Reason:     core.String("用户投诉"),
+			ServiceId:  core.String("500001"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleServiceOrderApiService_CompleteServiceOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := payscore.ServiceOrderApiService{Client: client}
+	resp, result, err := svc.CompleteServiceOrder(ctx,
+		payscore.CompleteServiceOrderRequest{
+			Appid:    core.String("wxd678efh567hg6787"),
+			GoodsTag: core.String("goods_tag"),
+			Location: &payscore.Location{
+				EndLocation:   core.String("嗨客时尚主题展餐厅"),
+				StartLocation: core.String("嗨客时尚主题展餐厅"),
+			},
+			OutOrderNo: core.String("1234323JKHDFE1243252"),
+			PostDiscounts: []payscore.ServiceOrderCoupon{payscore.ServiceOrderCoupon{
+				Amount:      core.Int64(100),
+				Count:       core.Int64(2),
+				Description: core.String("不与其他优惠叠加"),
+				Name:        core.String("满20减1元"),
+			}},
+			PostPayments: []payscore.Payment{payscore.Payment{
+				Amount:      core.Int64(40000),
+				Count:       core.Int64(4),
+				Description: core.String("就餐人均100元"),
+				Name:        core.String("就餐费用"),
+			}},
+			ProfitSharing: core.Bool(false),
+			ServiceId:     core.String("500001"),
+			TimeRange: &payscore.TimeRange{
+				EndTime:         core.String("20091225121010"),
+				EndTimeRemark:   core.String("备注2"),
+				StartTime:       core.String("20091225091010"),
+				StartTimeRemark: core.String("备注1"),
+			},
+			TotalAmount: core.Int64(50000),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleServiceOrderApiService_CreateServiceOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := payscore.ServiceOrderApiService{Client: client}
+	resp, result, err := svc.CreateServiceOrder(ctx,
+		payscore.CreateServiceOrderRequest{
+			Appid:  core.String("wxd678efh567hg6787"),
+			Attach: core.String("Easdfowealsdkjfnlaksjdlfkwqoi&wl3l2sald"),
+			Location: &payscore.Location{
+				EndLocation:   core.String("嗨客时尚主题展餐厅"),
+				StartLocation: core.String("嗨客时尚主题展餐厅"),
+			},
+			NeedUserConfirm: core.Bool(true),
+			NotifyUrl:       core.String("https://api.test.com"),
+			Openid:          core.String("oUpF8uMuAJO_M2pxb1Q9zNjWeS6o"),
+			OutOrderNo:      core.String("1234323JKHDFE1243252"),
+			PostDiscounts: []payscore.ServiceOrderCoupon{payscore.ServiceOrderCoupon{
+				Amount:      core.Int64(100),
+				Count:       core.Int64(2),
+				Description: core.String("不与其他优惠叠加"),
+				Name:        core.String("满20减1元"),
+			}},
+			PostPayments: []payscore.Payment{payscore.Payment{
+				Amount:      core.Int64(40000),
+				Count:       core.Int64(4),
+				Description: core.String("就餐人均100元"),
+				Name:        core.String("就餐费用"),
+			}},
+			RiskFund: &payscore.RiskFund{
+				Amount:      core.Int64(10000),
+				Description: core.String("就餐的预估费用"),
+				Name:        payscore.RISKFUNDTYPE_DEPOSIT.Ptr(),
+			},
+			ServiceId:           core.String("500001"),
+			ServiceIntroduction: core.String("某某酒店"),
+			TimeRange: &payscore.TimeRange{
+				EndTime:         core.String("20091225121010"),
+				EndTimeRemark:   core.String("备注2"),
+				StartTime:       core.String("20091225091010"),
+				StartTimeRemark: core.String("备注1"),
+			},
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleServiceOrderApiService_ModifyServiceOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := payscore.ServiceOrderApiService{Client: client}
+	resp, result, err := svc.ModifyServiceOrder(ctx,
+		payscore.ModifyServiceOrderRequest{
+			Appid:      core.String("wxd678efh567hg6787"),
+			OutOrderNo: core.String("1234323JKHDFE1243252"),
+			PostDiscounts: []payscore.ServiceOrderCoupon{payscore.ServiceOrderCoupon{
+				Amount:      core.Int64(100),
+				Count:       core.Int64(2),
+				Description: core.String("不与其他优惠叠加"),
+				Name:        core.String("满20减1元"),
+			}},
+			PostPayments: []payscore.Payment{payscore.Payment{
+				Amount:      core.Int64(40000),
+				Count:       core.Int64(4),
+				Description: core.String("就餐人均100元"),
+				Name:        core.String("就餐费用"),
+			}},
+			Reason:      core.String("用户投诉"),
+			ServiceId:   core.String("500001"),
+			TotalAmount: core.Int64(50000),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleServiceOrderApiService_PayServiceOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := payscore.ServiceOrderApiService{Client: client}
+	resp, result, err := svc.PayServiceOrder(ctx,
+		payscore.PayServiceOrderRequest{
+			Appid:      core.String("wxd678efh567hg6787"),
+			OutOrderNo: core.String("1234323JKHDFE1243252"),
+			ServiceId:  core.String("500001"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleServiceOrderApiService_QueryServiceOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := payscore.ServiceOrderApiService{Client: client}
+	resp, result, err := svc.QueryServiceOrder(ctx,
+		payscore.QueryServiceOrderRequest{
+			Appid:      core.String("wxd678efh567hg6787"),
+			OutOrderNo: core.String("1234323JKHDFE1243252"),
+			QueryId:    core.String("15646546545165651651"),
+			ServiceId:  core.String("500001"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleServiceOrderApiService_SyncServiceOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := payscore.ServiceOrderApiService{Client: client}
+	resp, result, err := svc.SyncServiceOrder(ctx,
+		payscore.SyncServiceOrderRequest{
+			Appid: core.String("wxd678efh567hg6787"),
+			Detail: &payscore.SyncDetail{
+				PaidTime: core.String("20091225091210"),
+			},
+			OutOrderNo: core.String("1234323JKHDFE1243252"),
+			ServiceId:  core.String("500001"),
+			Type:       payscore.SYNCTYPE_ORDER_PAID.Ptr(),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
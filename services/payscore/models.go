@@ -0,0 +1,2997 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 微信支付分服务订单API
+//
+// 微信支付分（公共API）服务订单相关接口，适用于先享后付、先付后享等场景
+//
+// API version: 1.2.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package payscore
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CancelServiceOrderBody
+type CancelServiceOrderBody struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 取消服务订单的原因，不超过30个字符
+	Reason *string `json:"reason"`
+}
+
+func (o CancelServiceOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CancelServiceOrderBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in CancelServiceOrderBody")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.Reason == nil {
+		return nil, fmt.Errorf("field `Reason` is required and must be specified in CancelServiceOrderBody")
+	}
+	toSerialize["reason"] = o.Reason
+	return json.Marshal(toSerialize)
+}
+
+func (o CancelServiceOrderBody) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.Reason == nil {
+		ret += "Reason:<nil>"
+	} else {
+		ret += fmt.Sprintf("Reason:%v", *o.Reason)
+	}
+
+	return fmt.Sprintf("CancelServiceOrderBody{%s}", ret)
+}
+
+func (o CancelServiceOrderBody) Clone() *CancelServiceOrderBody {
+	ret := CancelServiceOrderBody{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.Reason != nil {
+		ret.Reason = new(string)
+		*ret.Reason = *o.Reason
+	}
+
+	return &ret
+}
+
+// CancelServiceOrderRequest
+type CancelServiceOrderRequest struct {
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 取消服务订单的原因，不超过30个字符
+	Reason *string `json:"reason"`
+}
+
+func (o CancelServiceOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CancelServiceOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CancelServiceOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in CancelServiceOrderRequest")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.Reason == nil {
+		return nil, fmt.Errorf("field `Reason` is required and must be specified in CancelServiceOrderRequest")
+	}
+	toSerialize["reason"] = o.Reason
+	return json.Marshal(toSerialize)
+}
+
+func (o CancelServiceOrderRequest) String() string {
+	var ret string
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.Reason == nil {
+		ret += "Reason:<nil>"
+	} else {
+		ret += fmt.Sprintf("Reason:%v", *o.Reason)
+	}
+
+	return fmt.Sprintf("CancelServiceOrderRequest{%s}", ret)
+}
+
+func (o CancelServiceOrderRequest) Clone() *CancelServiceOrderRequest {
+	ret := CancelServiceOrderRequest{}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.Reason != nil {
+		ret.Reason = new(string)
+		*ret.Reason = *o.Reason
+	}
+
+	return &ret
+}
+
+// CancelServiceOrderResponse
+type CancelServiceOrderResponse struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 微信支付分配的商户号
+	Mchid *string `json:"mchid"`
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 微信支付服务订单号
+	OrderId *string `json:"order_id"`
+}
+
+func (o CancelServiceOrderResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CancelServiceOrderResponse")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in CancelServiceOrderResponse")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CancelServiceOrderResponse")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in CancelServiceOrderResponse")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.OrderId == nil {
+		return nil, fmt.Errorf("field `OrderId` is required and must be specified in CancelServiceOrderResponse")
+	}
+	toSerialize["order_id"] = o.OrderId
+	return json.Marshal(toSerialize)
+}
+
+func (o CancelServiceOrderResponse) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>"
+	} else {
+		ret += fmt.Sprintf("OrderId:%v", *o.OrderId)
+	}
+
+	return fmt.Sprintf("CancelServiceOrderResponse{%s}", ret)
+}
+
+func (o CancelServiceOrderResponse) Clone() *CancelServiceOrderResponse {
+	ret := CancelServiceOrderResponse{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	return &ret
+}
+
+// Collection
+type Collection struct {
+	// 收款状态
+	State *CollectionState `json:"state,omitempty"`
+	// 总收款金额，单位为分
+	TotalAmount *int64 `json:"total_amount,omitempty"`
+	// 待收金额，单位为分
+	PayingAmount *int64 `json:"paying_amount,omitempty"`
+	// 已收金额，单位为分
+	PaidAmount *int64 `json:"paid_amount,omitempty"`
+	// 收款明细列表
+	Details []CollectionDetail `json:"details,omitempty"`
+}
+
+func (o Collection) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.State != nil {
+		toSerialize["state"] = o.State
+	}
+
+	if o.TotalAmount != nil {
+		toSerialize["total_amount"] = o.TotalAmount
+	}
+
+	if o.PayingAmount != nil {
+		toSerialize["paying_amount"] = o.PayingAmount
+	}
+
+	if o.PaidAmount != nil {
+		toSerialize["paid_amount"] = o.PaidAmount
+	}
+
+	if o.Details != nil {
+		toSerialize["details"] = o.Details
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Collection) String() string {
+	var ret string
+	if o.State == nil {
+		ret += "State:<nil>, "
+	} else {
+		ret += fmt.Sprintf("State:%v, ", *o.State)
+	}
+
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	if o.PayingAmount == nil {
+		ret += "PayingAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayingAmount:%v, ", *o.PayingAmount)
+	}
+
+	if o.PaidAmount == nil {
+		ret += "PaidAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PaidAmount:%v, ", *o.PaidAmount)
+	}
+
+	ret += fmt.Sprintf("Details:%v", o.Details)
+
+	return fmt.Sprintf("Collection{%s}", ret)
+}
+
+func (o Collection) Clone() *Collection {
+	ret := Collection{}
+
+	if o.State != nil {
+		ret.State = new(CollectionState)
+		*ret.State = *o.State
+	}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.PayingAmount != nil {
+		ret.PayingAmount = new(int64)
+		*ret.PayingAmount = *o.PayingAmount
+	}
+
+	if o.PaidAmount != nil {
+		ret.PaidAmount = new(int64)
+		*ret.PaidAmount = *o.PaidAmount
+	}
+
+	if o.Details != nil {
+		ret.Details = make([]CollectionDetail, len(o.Details))
+		for i, item := range o.Details {
+			ret.Details[i] = *item.Clone()
+		}
+	}
+
+	return &ret
+}
+
+// HasState 应答中是否返回了 state，o 为 nil 时返回 false
+func (o *Collection) HasState() bool {
+	return o != nil && o.State != nil
+}
+
+// HasTotalAmount 应答中是否返回了 total_amount，o 为 nil 时返回 false
+func (o *Collection) HasTotalAmount() bool {
+	return o != nil && o.TotalAmount != nil
+}
+
+// HasPayingAmount 应答中是否返回了 paying_amount，o 为 nil 时返回 false
+func (o *Collection) HasPayingAmount() bool {
+	return o != nil && o.PayingAmount != nil
+}
+
+// HasPaidAmount 应答中是否返回了 paid_amount，o 为 nil 时返回 false
+func (o *Collection) HasPaidAmount() bool {
+	return o != nil && o.PaidAmount != nil
+}
+
+// HasDetails 应答中是否返回了 details，o 为 nil 时返回 false
+func (o *Collection) HasDetails() bool {
+	return o != nil && o.Details != nil
+}
+
+// CollectionDetail
+type CollectionDetail struct {
+	// 收款序号
+	Seq *int64 `json:"seq,omitempty"`
+	// 单笔收款金额，单位为分
+	Amount *int64 `json:"amount,omitempty"`
+	// 收款成功渠道
+	PaidType *PaidType `json:"paid_type,omitempty"`
+	// 支付成功时间，格式为yyyyMMddHHmmss
+	PaidTime *string `json:"paid_time,omitempty"`
+	// 微信支付交易单号，收款渠道为NEWTON时返回
+	TransactionId *string `json:"transaction_id,omitempty"`
+}
+
+func (o CollectionDetail) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Seq != nil {
+		toSerialize["seq"] = o.Seq
+	}
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+
+	if o.PaidType != nil {
+		toSerialize["paid_type"] = o.PaidType
+	}
+
+	if o.PaidTime != nil {
+		toSerialize["paid_time"] = o.PaidTime
+	}
+
+	if o.TransactionId != nil {
+		toSerialize["transaction_id"] = o.TransactionId
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CollectionDetail) String() string {
+	var ret string
+	if o.Seq == nil {
+		ret += "Seq:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Seq:%v, ", *o.Seq)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.PaidType == nil {
+		ret += "PaidType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PaidType:%v, ", *o.PaidType)
+	}
+
+	if o.PaidTime == nil {
+		ret += "PaidTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PaidTime:%v, ", *o.PaidTime)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>"
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v", *o.TransactionId)
+	}
+
+	return fmt.Sprintf("CollectionDetail{%s}", ret)
+}
+
+func (o CollectionDetail) Clone() *CollectionDetail {
+	ret := CollectionDetail{}
+
+	if o.Seq != nil {
+		ret.Seq = new(int64)
+		*ret.Seq = *o.Seq
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.PaidType != nil {
+		ret.PaidType = new(PaidType)
+		*ret.PaidType = *o.PaidType
+	}
+
+	if o.PaidTime != nil {
+		ret.PaidTime = new(string)
+		*ret.PaidTime = *o.PaidTime
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	return &ret
+}
+
+// HasSeq 应答中是否返回了 seq，o 为 nil 时返回 false
+func (o *CollectionDetail) HasSeq() bool {
+	return o != nil && o.Seq != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *CollectionDetail) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// HasPaidType 应答中是否返回了 paid_type，o 为 nil 时返回 false
+func (o *CollectionDetail) HasPaidType() bool {
+	return o != nil && o.PaidType != nil
+}
+
+// HasPaidTime 应答中是否返回了 paid_time，o 为 nil 时返回 false
+func (o *CollectionDetail) HasPaidTime() bool {
+	return o != nil && o.PaidTime != nil
+}
+
+// HasTransactionId 应答中是否返回了 transaction_id，o 为 nil 时返回 false
+func (o *CollectionDetail) HasTransactionId() bool {
+	return o != nil && o.TransactionId != nil
+}
+
+// CollectionState * `USER_PAYING` - 待支付 * `USER_PAID` - 已支付
+type CollectionState string
+
+func (e CollectionState) Ptr() *CollectionState {
+	return &e
+}
+
+// Enums of CollectionState
+const (
+	COLLECTIONSTATE_USER_PAYING CollectionState = "USER_PAYING"
+	COLLECTIONSTATE_USER_PAID   CollectionState = "USER_PAID"
+)
+
+func (v *CollectionState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := CollectionState(value)
+	for _, existing := range []CollectionState{"USER_PAYING", "USER_PAID"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid CollectionState", value)
+}
+
+// CompleteServiceOrderBody
+type CompleteServiceOrderBody struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 后付费项目列表
+	PostPayments []Payment `json:"post_payments"`
+	// 后付费商户优惠列表
+	PostDiscounts []ServiceOrderCoupon `json:"post_discounts,omitempty"`
+	// 总金额，单位为分，不能超过订单风险金额
+	TotalAmount *int64 `json:"total_amount"`
+	// 服务时间段，完结时结束时间必填
+	TimeRange *TimeRange `json:"time_range,omitempty"`
+	// 服务位置
+	Location *Location `json:"location,omitempty"`
+	// 是否需要分账，默认为false
+	ProfitSharing *bool `json:"profit_sharing,omitempty"`
+	// 订单优惠标记，代金券或立减金优惠的参数
+	GoodsTag *string `json:"goods_tag,omitempty"`
+}
+
+func (o CompleteServiceOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CompleteServiceOrderBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in CompleteServiceOrderBody")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.PostPayments == nil {
+		return nil, fmt.Errorf("field `PostPayments` is required and must be specified in CompleteServiceOrderBody")
+	}
+	toSerialize["post_payments"] = o.PostPayments
+
+	if o.PostDiscounts != nil {
+		toSerialize["post_discounts"] = o.PostDiscounts
+	}
+
+	if o.TotalAmount == nil {
+		return nil, fmt.Errorf("field `TotalAmount` is required and must be specified in CompleteServiceOrderBody")
+	}
+	toSerialize["total_amount"] = o.TotalAmount
+
+	if o.TimeRange != nil {
+		toSerialize["time_range"] = o.TimeRange
+	}
+
+	if o.Location != nil {
+		toSerialize["location"] = o.Location
+	}
+
+	if o.ProfitSharing != nil {
+		toSerialize["profit_sharing"] = o.ProfitSharing
+	}
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CompleteServiceOrderBody) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	ret += fmt.Sprintf("PostPayments:%v, ", o.PostPayments)
+
+	ret += fmt.Sprintf("PostDiscounts:%v, ", o.PostDiscounts)
+
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	ret += fmt.Sprintf("TimeRange:%v, ", o.TimeRange)
+
+	ret += fmt.Sprintf("Location:%v, ", o.Location)
+
+	if o.ProfitSharing == nil {
+		ret += "ProfitSharing:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ProfitSharing:%v, ", *o.ProfitSharing)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>"
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v", *o.GoodsTag)
+	}
+
+	return fmt.Sprintf("CompleteServiceOrderBody{%s}", ret)
+}
+
+func (o CompleteServiceOrderBody) Clone() *CompleteServiceOrderBody {
+	ret := CompleteServiceOrderBody{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.PostPayments != nil {
+		ret.PostPayments = make([]Payment, len(o.PostPayments))
+		for i, item := range o.PostPayments {
+			ret.PostPayments[i] = *item.Clone()
+		}
+	}
+
+	if o.PostDiscounts != nil {
+		ret.PostDiscounts = make([]ServiceOrderCoupon, len(o.PostDiscounts))
+		for i, item := range o.PostDiscounts {
+			ret.PostDiscounts[i] = *item.Clone()
+		}
+	}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.TimeRange != nil {
+		ret.TimeRange = o.TimeRange.Clone()
+	}
+
+	if o.Location != nil {
+		ret.Location = o.Location.Clone()
+	}
+
+	if o.ProfitSharing != nil {
+		ret.ProfitSharing = new(bool)
+		*ret.ProfitSharing = *o.ProfitSharing
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	return &ret
+}
+
+// CompleteServiceOrderRequest
+type CompleteServiceOrderRequest struct {
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 后付费项目列表
+	PostPayments []Payment `json:"post_payments"`
+	// 后付费商户优惠列表
+	PostDiscounts []ServiceOrderCoupon `json:"post_discounts,omitempty"`
+	// 总金额，单位为分，不能超过订单风险金额
+	TotalAmount *int64 `json:"total_amount"`
+	// 服务时间段，完结时结束时间必填
+	TimeRange *TimeRange `json:"time_range,omitempty"`
+	// 服务位置
+	Location *Location `json:"location,omitempty"`
+	// 是否需要分账，默认为false
+	ProfitSharing *bool `json:"profit_sharing,omitempty"`
+	// 订单优惠标记，代金券或立减金优惠的参数
+	GoodsTag *string `json:"goods_tag,omitempty"`
+}
+
+func (o CompleteServiceOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CompleteServiceOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CompleteServiceOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in CompleteServiceOrderRequest")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.PostPayments == nil {
+		return nil, fmt.Errorf("field `PostPayments` is required and must be specified in CompleteServiceOrderRequest")
+	}
+	toSerialize["post_payments"] = o.PostPayments
+
+	if o.PostDiscounts != nil {
+		toSerialize["post_discounts"] = o.PostDiscounts
+	}
+
+	if o.TotalAmount == nil {
+		return nil, fmt.Errorf("field `TotalAmount` is required and must be specified in CompleteServiceOrderRequest")
+	}
+	toSerialize["total_amount"] = o.TotalAmount
+
+	if o.TimeRange != nil {
+		toSerialize["time_range"] = o.TimeRange
+	}
+
+	if o.Location != nil {
+		toSerialize["location"] = o.Location
+	}
+
+	if o.ProfitSharing != nil {
+		toSerialize["profit_sharing"] = o.ProfitSharing
+	}
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CompleteServiceOrderRequest) String() string {
+	var ret string
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	ret += fmt.Sprintf("PostPayments:%v, ", o.PostPayments)
+
+	ret += fmt.Sprintf("PostDiscounts:%v, ", o.PostDiscounts)
+
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	ret += fmt.Sprintf("TimeRange:%v, ", o.TimeRange)
+
+	ret += fmt.Sprintf("Location:%v, ", o.Location)
+
+	if o.ProfitSharing == nil {
+		ret += "ProfitSharing:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ProfitSharing:%v, ", *o.ProfitSharing)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>"
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v", *o.GoodsTag)
+	}
+
+	return fmt.Sprintf("CompleteServiceOrderRequest{%s}", ret)
+}
+
+func (o CompleteServiceOrderRequest) Clone() *CompleteServiceOrderRequest {
+	ret := CompleteServiceOrderRequest{}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.PostPayments != nil {
+		ret.PostPayments = make([]Payment, len(o.PostPayments))
+		for i, item := range o.PostPayments {
+			ret.PostPayments[i] = *item.Clone()
+		}
+	}
+
+	if o.PostDiscounts != nil {
+		ret.PostDiscounts = make([]ServiceOrderCoupon, len(o.PostDiscounts))
+		for i, item := range o.PostDiscounts {
+			ret.PostDiscounts[i] = *item.Clone()
+		}
+	}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.TimeRange != nil {
+		ret.TimeRange = o.TimeRange.Clone()
+	}
+
+	if o.Location != nil {
+		ret.Location = o.Location.Clone()
+	}
+
+	if o.ProfitSharing != nil {
+		ret.ProfitSharing = new(bool)
+		*ret.ProfitSharing = *o.ProfitSharing
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	return &ret
+}
+
+// CreateServiceOrderRequest
+type CreateServiceOrderRequest struct {
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 服务信息，用于介绍本订单所提供的服务，不超过20个字符
+	ServiceIntroduction *string `json:"service_introduction"`
+	// 后付费项目列表，最多包含100条付费项目
+	PostPayments []Payment `json:"post_payments,omitempty"`
+	// 后付费商户优惠列表，最多包含30条优惠
+	PostDiscounts []ServiceOrderCoupon `json:"post_discounts,omitempty"`
+	// 服务时间段
+	TimeRange *TimeRange `json:"time_range"`
+	// 服务位置
+	Location *Location `json:"location,omitempty"`
+	// 订单风险金信息
+	RiskFund *RiskFund `json:"risk_fund"`
+	// 商户数据包，可存放本订单所需信息，需要先urlencode后传入，总长度不大于256字符
+	Attach *string `json:"attach,omitempty"`
+	// 商户接收用户确认订单和付款成功回调通知的地址
+	NotifyUrl *string `json:"notify_url"`
+	// 微信用户在商户对应appid下的唯一标识，免确认订单时必填
+	Openid *string `json:"openid,omitempty"`
+	// 是否需要用户确认，false表示免确认订单，true表示需确认订单，默认为true
+	NeedUserConfirm *bool `json:"need_user_confirm,omitempty"`
+}
+
+func (o CreateServiceOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CreateServiceOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CreateServiceOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in CreateServiceOrderRequest")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.ServiceIntroduction == nil {
+		return nil, fmt.Errorf("field `ServiceIntroduction` is required and must be specified in CreateServiceOrderRequest")
+	}
+	toSerialize["service_introduction"] = o.ServiceIntroduction
+
+	if o.PostPayments != nil {
+		toSerialize["post_payments"] = o.PostPayments
+	}
+
+	if o.PostDiscounts != nil {
+		toSerialize["post_discounts"] = o.PostDiscounts
+	}
+
+	if o.TimeRange == nil {
+		return nil, fmt.Errorf("field `TimeRange` is required and must be specified in CreateServiceOrderRequest")
+	}
+	toSerialize["time_range"] = o.TimeRange
+
+	if o.Location != nil {
+		toSerialize["location"] = o.Location
+	}
+
+	if o.RiskFund == nil {
+		return nil, fmt.Errorf("field `RiskFund` is required and must be specified in CreateServiceOrderRequest")
+	}
+	toSerialize["risk_fund"] = o.RiskFund
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in CreateServiceOrderRequest")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.Openid != nil {
+		toSerialize["openid"] = o.Openid
+	}
+
+	if o.NeedUserConfirm != nil {
+		toSerialize["need_user_confirm"] = o.NeedUserConfirm
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateServiceOrderRequest) String() string {
+	var ret string
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.ServiceIntroduction == nil {
+		ret += "ServiceIntroduction:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceIntroduction:%v, ", *o.ServiceIntroduction)
+	}
+
+	ret += fmt.Sprintf("PostPayments:%v, ", o.PostPayments)
+
+	ret += fmt.Sprintf("PostDiscounts:%v, ", o.PostDiscounts)
+
+	ret += fmt.Sprintf("TimeRange:%v, ", o.TimeRange)
+
+	ret += fmt.Sprintf("Location:%v, ", o.Location)
+
+	ret += fmt.Sprintf("RiskFund:%v, ", o.RiskFund)
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.NeedUserConfirm == nil {
+		ret += "NeedUserConfirm:<nil>"
+	} else {
+		ret += fmt.Sprintf("NeedUserConfirm:%v", *o.NeedUserConfirm)
+	}
+
+	return fmt.Sprintf("CreateServiceOrderRequest{%s}", ret)
+}
+
+func (o CreateServiceOrderRequest) Clone() *CreateServiceOrderRequest {
+	ret := CreateServiceOrderRequest{}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.ServiceIntroduction != nil {
+		ret.ServiceIntroduction = new(string)
+		*ret.ServiceIntroduction = *o.ServiceIntroduction
+	}
+
+	if o.PostPayments != nil {
+		ret.PostPayments = make([]Payment, len(o.PostPayments))
+		for i, item := range o.PostPayments {
+			ret.PostPayments[i] = *item.Clone()
+		}
+	}
+
+	if o.PostDiscounts != nil {
+		ret.PostDiscounts = make([]ServiceOrderCoupon, len(o.PostDiscounts))
+		for i, item := range o.PostDiscounts {
+			ret.PostDiscounts[i] = *item.Clone()
+		}
+	}
+
+	if o.TimeRange != nil {
+		ret.TimeRange = o.TimeRange.Clone()
+	}
+
+	if o.Location != nil {
+		ret.Location = o.Location.Clone()
+	}
+
+	if o.RiskFund != nil {
+		ret.RiskFund = o.RiskFund.Clone()
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.NeedUserConfirm != nil {
+		ret.NeedUserConfirm = new(bool)
+		*ret.NeedUserConfirm = *o.NeedUserConfirm
+	}
+
+	return &ret
+}
+
+// Location
+type Location struct {
+	// 服务开始地点
+	StartLocation *string `json:"start_location,omitempty"`
+	// 服务结束地点
+	EndLocation *string `json:"end_location,omitempty"`
+}
+
+func (o Location) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StartLocation != nil {
+		toSerialize["start_location"] = o.StartLocation
+	}
+
+	if o.EndLocation != nil {
+		toSerialize["end_location"] = o.EndLocation
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Location) String() string {
+	var ret string
+	if o.StartLocation == nil {
+		ret += "StartLocation:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StartLocation:%v, ", *o.StartLocation)
+	}
+
+	if o.EndLocation == nil {
+		ret += "EndLocation:<nil>"
+	} else {
+		ret += fmt.Sprintf("EndLocation:%v", *o.EndLocation)
+	}
+
+	return fmt.Sprintf("Location{%s}", ret)
+}
+
+func (o Location) Clone() *Location {
+	ret := Location{}
+
+	if o.StartLocation != nil {
+		ret.StartLocation = new(string)
+		*ret.StartLocation = *o.StartLocation
+	}
+
+	if o.EndLocation != nil {
+		ret.EndLocation = new(string)
+		*ret.EndLocation = *o.EndLocation
+	}
+
+	return &ret
+}
+
+// HasStartLocation 应答中是否返回了 start_location，o 为 nil 时返回 false
+func (o *Location) HasStartLocation() bool {
+	return o != nil && o.StartLocation != nil
+}
+
+// HasEndLocation 应答中是否返回了 end_location，o 为 nil 时返回 false
+func (o *Location) HasEndLocation() bool {
+	return o != nil && o.EndLocation != nil
+}
+
+// ModifyServiceOrderBody
+type ModifyServiceOrderBody struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 后付费项目列表
+	PostPayments []Payment `json:"post_payments"`
+	// 后付费商户优惠列表
+	PostDiscounts []ServiceOrderCoupon `json:"post_discounts,omitempty"`
+	// 总金额，单位为分，不能超过完结订单时的总金额
+	TotalAmount *int64 `json:"total_amount"`
+	// 修改订单金额的原因，不超过50个字符
+	Reason *string `json:"reason"`
+}
+
+func (o ModifyServiceOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in ModifyServiceOrderBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in ModifyServiceOrderBody")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.PostPayments == nil {
+		return nil, fmt.Errorf("field `PostPayments` is required and must be specified in ModifyServiceOrderBody")
+	}
+	toSerialize["post_payments"] = o.PostPayments
+
+	if o.PostDiscounts != nil {
+		toSerialize["post_discounts"] = o.PostDiscounts
+	}
+
+	if o.TotalAmount == nil {
+		return nil, fmt.Errorf("field `TotalAmount` is required and must be specified in ModifyServiceOrderBody")
+	}
+	toSerialize["total_amount"] = o.TotalAmount
+
+	if o.Reason == nil {
+		return nil, fmt.Errorf("field `Reason` is required and must be specified in ModifyServiceOrderBody")
+	}
+	toSerialize["reason"] = o.Reason
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifyServiceOrderBody) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	ret += fmt.Sprintf("PostPayments:%v, ", o.PostPayments)
+
+	ret += fmt.Sprintf("PostDiscounts:%v, ", o.PostDiscounts)
+
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	if o.Reason == nil {
+		ret += "Reason:<nil>"
+	} else {
+		ret += fmt.Sprintf("Reason:%v", *o.Reason)
+	}
+
+	return fmt.Sprintf("ModifyServiceOrderBody{%s}", ret)
+}
+
+func (o ModifyServiceOrderBody) Clone() *ModifyServiceOrderBody {
+	ret := ModifyServiceOrderBody{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.PostPayments != nil {
+		ret.PostPayments = make([]Payment, len(o.PostPayments))
+		for i, item := range o.PostPayments {
+			ret.PostPayments[i] = *item.Clone()
+		}
+	}
+
+	if o.PostDiscounts != nil {
+		ret.PostDiscounts = make([]ServiceOrderCoupon, len(o.PostDiscounts))
+		for i, item := range o.PostDiscounts {
+			ret.PostDiscounts[i] = *item.Clone()
+		}
+	}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.Reason != nil {
+		ret.Reason = new(string)
+		*ret.Reason = *o.Reason
+	}
+
+	return &ret
+}
+
+// ModifyServiceOrderRequest
+type ModifyServiceOrderRequest struct {
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 后付费项目列表
+	PostPayments []Payment `json:"post_payments"`
+	// 后付费商户优惠列表
+	PostDiscounts []ServiceOrderCoupon `json:"post_discounts,omitempty"`
+	// 总金额，单位为分，不能超过完结订单时的总金额
+	TotalAmount *int64 `json:"total_amount"`
+	// 修改订单金额的原因，不超过50个字符
+	Reason *string `json:"reason"`
+}
+
+func (o ModifyServiceOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in ModifyServiceOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in ModifyServiceOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in ModifyServiceOrderRequest")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.PostPayments == nil {
+		return nil, fmt.Errorf("field `PostPayments` is required and must be specified in ModifyServiceOrderRequest")
+	}
+	toSerialize["post_payments"] = o.PostPayments
+
+	if o.PostDiscounts != nil {
+		toSerialize["post_discounts"] = o.PostDiscounts
+	}
+
+	if o.TotalAmount == nil {
+		return nil, fmt.Errorf("field `TotalAmount` is required and must be specified in ModifyServiceOrderRequest")
+	}
+	toSerialize["total_amount"] = o.TotalAmount
+
+	if o.Reason == nil {
+		return nil, fmt.Errorf("field `Reason` is required and must be specified in ModifyServiceOrderRequest")
+	}
+	toSerialize["reason"] = o.Reason
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifyServiceOrderRequest) String() string {
+	var ret string
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	ret += fmt.Sprintf("PostPayments:%v, ", o.PostPayments)
+
+	ret += fmt.Sprintf("PostDiscounts:%v, ", o.PostDiscounts)
+
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	if o.Reason == nil {
+		ret += "Reason:<nil>"
+	} else {
+		ret += fmt.Sprintf("Reason:%v", *o.Reason)
+	}
+
+	return fmt.Sprintf("ModifyServiceOrderRequest{%s}", ret)
+}
+
+func (o ModifyServiceOrderRequest) Clone() *ModifyServiceOrderRequest {
+	ret := ModifyServiceOrderRequest{}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.PostPayments != nil {
+		ret.PostPayments = make([]Payment, len(o.PostPayments))
+		for i, item := range o.PostPayments {
+			ret.PostPayments[i] = *item.Clone()
+		}
+	}
+
+	if o.PostDiscounts != nil {
+		ret.PostDiscounts = make([]ServiceOrderCoupon, len(o.PostDiscounts))
+		for i, item := range o.PostDiscounts {
+			ret.PostDiscounts[i] = *item.Clone()
+		}
+	}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.Reason != nil {
+		ret.Reason = new(string)
+		*ret.Reason = *o.Reason
+	}
+
+	return &ret
+}
+
+// PaidType * `NEWTON` - 微信支付分 * `MCH` - 商户渠道
+type PaidType string
+
+func (e PaidType) Ptr() *PaidType {
+	return &e
+}
+
+// Enums of PaidType
+const (
+	PAIDTYPE_NEWTON PaidType = "NEWTON"
+	PAIDTYPE_MCH    PaidType = "MCH"
+)
+
+func (v *PaidType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := PaidType(value)
+	for _, existing := range []PaidType{"NEWTON", "MCH"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid PaidType", value)
+}
+
+// PayServiceOrderBody
+type PayServiceOrderBody struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+}
+
+func (o PayServiceOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in PayServiceOrderBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in PayServiceOrderBody")
+	}
+	toSerialize["service_id"] = o.ServiceId
+	return json.Marshal(toSerialize)
+}
+
+func (o PayServiceOrderBody) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>"
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v", *o.ServiceId)
+	}
+
+	return fmt.Sprintf("PayServiceOrderBody{%s}", ret)
+}
+
+func (o PayServiceOrderBody) Clone() *PayServiceOrderBody {
+	ret := PayServiceOrderBody{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	return &ret
+}
+
+// PayServiceOrderRequest
+type PayServiceOrderRequest struct {
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+}
+
+func (o PayServiceOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in PayServiceOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in PayServiceOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in PayServiceOrderRequest")
+	}
+	toSerialize["service_id"] = o.ServiceId
+	return json.Marshal(toSerialize)
+}
+
+func (o PayServiceOrderRequest) String() string {
+	var ret string
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>"
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v", *o.ServiceId)
+	}
+
+	return fmt.Sprintf("PayServiceOrderRequest{%s}", ret)
+}
+
+func (o PayServiceOrderRequest) Clone() *PayServiceOrderRequest {
+	ret := PayServiceOrderRequest{}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	return &ret
+}
+
+// PayServiceOrderResponse
+type PayServiceOrderResponse struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 微信支付分配的商户号
+	Mchid *string `json:"mchid"`
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 微信支付服务订单号
+	OrderId *string `json:"order_id"`
+}
+
+func (o PayServiceOrderResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in PayServiceOrderResponse")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in PayServiceOrderResponse")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in PayServiceOrderResponse")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in PayServiceOrderResponse")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.OrderId == nil {
+		return nil, fmt.Errorf("field `OrderId` is required and must be specified in PayServiceOrderResponse")
+	}
+	toSerialize["order_id"] = o.OrderId
+	return json.Marshal(toSerialize)
+}
+
+func (o PayServiceOrderResponse) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>"
+	} else {
+		ret += fmt.Sprintf("OrderId:%v", *o.OrderId)
+	}
+
+	return fmt.Sprintf("PayServiceOrderResponse{%s}", ret)
+}
+
+func (o PayServiceOrderResponse) Clone() *PayServiceOrderResponse {
+	ret := PayServiceOrderResponse{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	return &ret
+}
+
+// Payment
+type Payment struct {
+	// 付费项目名称
+	Name *string `json:"name"`
+	// 付费项目金额，单位为分
+	Amount *int64 `json:"amount,omitempty"`
+	// 计费说明
+	Description *string `json:"description,omitempty"`
+	// 付费数量
+	Count *int64 `json:"count,omitempty"`
+}
+
+func (o Payment) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Name == nil {
+		return nil, fmt.Errorf("field `Name` is required and must be specified in Payment")
+	}
+	toSerialize["name"] = o.Name
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+
+	if o.Description != nil {
+		toSerialize["description"] = o.Description
+	}
+
+	if o.Count != nil {
+		toSerialize["count"] = o.Count
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Payment) String() string {
+	var ret string
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.Count == nil {
+		ret += "Count:<nil>"
+	} else {
+		ret += fmt.Sprintf("Count:%v", *o.Count)
+	}
+
+	return fmt.Sprintf("Payment{%s}", ret)
+}
+
+func (o Payment) Clone() *Payment {
+	ret := Payment{}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.Count != nil {
+		ret.Count = new(int64)
+		*ret.Count = *o.Count
+	}
+
+	return &ret
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *Payment) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// HasDescription 应答中是否返回了 description，o 为 nil 时返回 false
+func (o *Payment) HasDescription() bool {
+	return o != nil && o.Description != nil
+}
+
+// HasCount 应答中是否返回了 count，o 为 nil 时返回 false
+func (o *Payment) HasCount() bool {
+	return o != nil && o.Count != nil
+}
+
+// QueryServiceOrderRequest
+type QueryServiceOrderRequest struct {
+	// 商户系统内部服务订单号，与query_id不能同时为空
+	OutOrderNo *string `json:"out_order_no,omitempty"`
+	// 单据查询ID，与out_order_no不能同时为空
+	QueryId *string `json:"query_id,omitempty"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+}
+
+func (o QueryServiceOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutOrderNo != nil {
+		toSerialize["out_order_no"] = o.OutOrderNo
+	}
+
+	if o.QueryId != nil {
+		toSerialize["query_id"] = o.QueryId
+	}
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in QueryServiceOrderRequest")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in QueryServiceOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryServiceOrderRequest) String() string {
+	var ret string
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.QueryId == nil {
+		ret += "QueryId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("QueryId:%v, ", *o.QueryId)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>"
+	} else {
+		ret += fmt.Sprintf("Appid:%v", *o.Appid)
+	}
+
+	return fmt.Sprintf("QueryServiceOrderRequest{%s}", ret)
+}
+
+func (o QueryServiceOrderRequest) Clone() *QueryServiceOrderRequest {
+	ret := QueryServiceOrderRequest{}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.QueryId != nil {
+		ret.QueryId = new(string)
+		*ret.QueryId = *o.QueryId
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	return &ret
+}
+
+// RiskFund
+type RiskFund struct {
+	// 风险金名称
+	Name *RiskFundType `json:"name"`
+	// 风险金额，单位为分，不能超过服务ID的风险金额上限
+	Amount *int64 `json:"amount"`
+	// 风险说明
+	Description *string `json:"description,omitempty"`
+}
+
+func (o RiskFund) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Name == nil {
+		return nil, fmt.Errorf("field `Name` is required and must be specified in RiskFund")
+	}
+	toSerialize["name"] = o.Name
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in RiskFund")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Description != nil {
+		toSerialize["description"] = o.Description
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o RiskFund) String() string {
+	var ret string
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("RiskFund{%s}", ret)
+}
+
+func (o RiskFund) Clone() *RiskFund {
+	ret := RiskFund{}
+
+	if o.Name != nil {
+		ret.Name = new(RiskFundType)
+		*ret.Name = *o.Name
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
+
+// HasDescription 应答中是否返回了 description，o 为 nil 时返回 false
+func (o *RiskFund) HasDescription() bool {
+	return o != nil && o.Description != nil
+}
+
+// RiskFundType * `DEPOSIT` - 押金 * `ADVANCE` - 预付款 * `CASH_DEPOSIT` - 保证金 * `ESTIMATE_ORDER_COST` - 预估订单费用
+type RiskFundType string
+
+func (e RiskFundType) Ptr() *RiskFundType {
+	return &e
+}
+
+// Enums of RiskFundType
+const (
+	RISKFUNDTYPE_DEPOSIT             RiskFundType = "DEPOSIT"
+	RISKFUNDTYPE_ADVANCE             RiskFundType = "ADVANCE"
+	RISKFUNDTYPE_CASH_DEPOSIT        RiskFundType = "CASH_DEPOSIT"
+	RISKFUNDTYPE_ESTIMATE_ORDER_COST RiskFundType = "ESTIMATE_ORDER_COST"
+)
+
+func (v *RiskFundType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := RiskFundType(value)
+	for _, existing := range []RiskFundType{"DEPOSIT", "ADVANCE", "CASH_DEPOSIT", "ESTIMATE_ORDER_COST"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid RiskFundType", value)
+}
+
+// ServiceOrderCoupon
+type ServiceOrderCoupon struct {
+	// 优惠名称
+	Name *string `json:"name"`
+	// 优惠使用条件说明
+	Description *string `json:"description,omitempty"`
+	// 优惠金额，单位为分
+	Amount *int64 `json:"amount,omitempty"`
+	// 优惠数量
+	Count *int64 `json:"count,omitempty"`
+}
+
+func (o ServiceOrderCoupon) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Name == nil {
+		return nil, fmt.Errorf("field `Name` is required and must be specified in ServiceOrderCoupon")
+	}
+	toSerialize["name"] = o.Name
+
+	if o.Description != nil {
+		toSerialize["description"] = o.Description
+	}
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+
+	if o.Count != nil {
+		toSerialize["count"] = o.Count
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ServiceOrderCoupon) String() string {
+	var ret string
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Count == nil {
+		ret += "Count:<nil>"
+	} else {
+		ret += fmt.Sprintf("Count:%v", *o.Count)
+	}
+
+	return fmt.Sprintf("ServiceOrderCoupon{%s}", ret)
+}
+
+func (o ServiceOrderCoupon) Clone() *ServiceOrderCoupon {
+	ret := ServiceOrderCoupon{}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Count != nil {
+		ret.Count = new(int64)
+		*ret.Count = *o.Count
+	}
+
+	return &ret
+}
+
+// HasDescription 应答中是否返回了 description，o 为 nil 时返回 false
+func (o *ServiceOrderCoupon) HasDescription() bool {
+	return o != nil && o.Description != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *ServiceOrderCoupon) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// HasCount 应答中是否返回了 count，o 为 nil 时返回 false
+func (o *ServiceOrderCoupon) HasCount() bool {
+	return o != nil && o.Count != nil
+}
+
+// ServiceOrderEntity
+type ServiceOrderEntity struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 微信支付分配的商户号
+	Mchid *string `json:"mchid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 服务信息
+	ServiceIntroduction *string `json:"service_introduction,omitempty"`
+	// 服务订单状态
+	State *ServiceOrderState `json:"state"`
+	// 订单状态说明，服务订单状态为DOING时返回
+	StateDescription *StateDescription `json:"state_description,omitempty"`
+	// 商户收款总金额，单位为分，等于后付费项目金额之和减去商户优惠金额之和
+	TotalAmount *int64 `json:"total_amount,omitempty"`
+	// 后付费项目列表
+	PostPayments []Payment `json:"post_payments,omitempty"`
+	// 后付费商户优惠列表
+	PostDiscounts []ServiceOrderCoupon `json:"post_discounts,omitempty"`
+	// 订单风险金信息
+	RiskFund *RiskFund `json:"risk_fund,omitempty"`
+	// 服务时间段
+	TimeRange *TimeRange `json:"time_range,omitempty"`
+	// 服务位置
+	Location *Location `json:"location,omitempty"`
+	// 商户数据包
+	Attach *string `json:"attach,omitempty"`
+	// 商户接收用户确认订单和付款成功回调通知的地址
+	NotifyUrl *string `json:"notify_url,omitempty"`
+	// 微信支付服务订单号，每个微信支付服务订单号与商户号下对应的商户服务订单号一一对应
+	OrderId *string `json:"order_id"`
+	// 用于跳转到微信侧小程序订单数据，跳转到微信侧小程序传入，创建订单时返回
+	Package *string `json:"package,omitempty"`
+	// 是否需要收款，订单完结时返回
+	NeedCollection *bool `json:"need_collection,omitempty"`
+	// 收款信息，订单完结后返回
+	Collection *Collection `json:"collection,omitempty"`
+	// 微信用户在商户对应appid下的唯一标识
+	Openid *string `json:"openid,omitempty"`
+}
+
+func (o ServiceOrderEntity) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in ServiceOrderEntity")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in ServiceOrderEntity")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in ServiceOrderEntity")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in ServiceOrderEntity")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.ServiceIntroduction != nil {
+		toSerialize["service_introduction"] = o.ServiceIntroduction
+	}
+
+	if o.State == nil {
+		return nil, fmt.Errorf("field `State` is required and must be specified in ServiceOrderEntity")
+	}
+	toSerialize["state"] = o.State
+
+	if o.StateDescription != nil {
+		toSerialize["state_description"] = o.StateDescription
+	}
+
+	if o.TotalAmount != nil {
+		toSerialize["total_amount"] = o.TotalAmount
+	}
+
+	if o.PostPayments != nil {
+		toSerialize["post_payments"] = o.PostPayments
+	}
+
+	if o.PostDiscounts != nil {
+		toSerialize["post_discounts"] = o.PostDiscounts
+	}
+
+	if o.RiskFund != nil {
+		toSerialize["risk_fund"] = o.RiskFund
+	}
+
+	if o.TimeRange != nil {
+		toSerialize["time_range"] = o.TimeRange
+	}
+
+	if o.Location != nil {
+		toSerialize["location"] = o.Location
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		toSerialize["notify_url"] = o.NotifyUrl
+	}
+
+	if o.OrderId == nil {
+		return nil, fmt.Errorf("field `OrderId` is required and must be specified in ServiceOrderEntity")
+	}
+	toSerialize["order_id"] = o.OrderId
+
+	if o.Package != nil {
+		toSerialize["package"] = o.Package
+	}
+
+	if o.NeedCollection != nil {
+		toSerialize["need_collection"] = o.NeedCollection
+	}
+
+	if o.Collection != nil {
+		toSerialize["collection"] = o.Collection
+	}
+
+	if o.Openid != nil {
+		toSerialize["openid"] = o.Openid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ServiceOrderEntity) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.ServiceIntroduction == nil {
+		ret += "ServiceIntroduction:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceIntroduction:%v, ", *o.ServiceIntroduction)
+	}
+
+	if o.State == nil {
+		ret += "State:<nil>, "
+	} else {
+		ret += fmt.Sprintf("State:%v, ", *o.State)
+	}
+
+	if o.StateDescription == nil {
+		ret += "StateDescription:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StateDescription:%v, ", *o.StateDescription)
+	}
+
+	if o.TotalAmount == nil {
+		ret += "TotalAmount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TotalAmount:%v, ", *o.TotalAmount)
+	}
+
+	ret += fmt.Sprintf("PostPayments:%v, ", o.PostPayments)
+
+	ret += fmt.Sprintf("PostDiscounts:%v, ", o.PostDiscounts)
+
+	ret += fmt.Sprintf("RiskFund:%v, ", o.RiskFund)
+
+	ret += fmt.Sprintf("TimeRange:%v, ", o.TimeRange)
+
+	ret += fmt.Sprintf("Location:%v, ", o.Location)
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OrderId:%v, ", *o.OrderId)
+	}
+
+	if o.Package == nil {
+		ret += "Package:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Package:%v, ", *o.Package)
+	}
+
+	if o.NeedCollection == nil {
+		ret += "NeedCollection:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NeedCollection:%v, ", *o.NeedCollection)
+	}
+
+	ret += fmt.Sprintf("Collection:%v, ", o.Collection)
+
+	if o.Openid == nil {
+		ret += "Openid:<nil>"
+	} else {
+		ret += fmt.Sprintf("Openid:%v", *o.Openid)
+	}
+
+	return fmt.Sprintf("ServiceOrderEntity{%s}", ret)
+}
+
+func (o ServiceOrderEntity) Clone() *ServiceOrderEntity {
+	ret := ServiceOrderEntity{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.ServiceIntroduction != nil {
+		ret.ServiceIntroduction = new(string)
+		*ret.ServiceIntroduction = *o.ServiceIntroduction
+	}
+
+	if o.State != nil {
+		ret.State = new(ServiceOrderState)
+		*ret.State = *o.State
+	}
+
+	if o.StateDescription != nil {
+		ret.StateDescription = new(StateDescription)
+		*ret.StateDescription = *o.StateDescription
+	}
+
+	if o.TotalAmount != nil {
+		ret.TotalAmount = new(int64)
+		*ret.TotalAmount = *o.TotalAmount
+	}
+
+	if o.PostPayments != nil {
+		ret.PostPayments = make([]Payment, len(o.PostPayments))
+		for i, item := range o.PostPayments {
+			ret.PostPayments[i] = *item.Clone()
+		}
+	}
+
+	if o.PostDiscounts != nil {
+		ret.PostDiscounts = make([]ServiceOrderCoupon, len(o.PostDiscounts))
+		for i, item := range o.PostDiscounts {
+			ret.PostDiscounts[i] = *item.Clone()
+		}
+	}
+
+	if o.RiskFund != nil {
+		ret.RiskFund = o.RiskFund.Clone()
+	}
+
+	if o.TimeRange != nil {
+		ret.TimeRange = o.TimeRange.Clone()
+	}
+
+	if o.Location != nil {
+		ret.Location = o.Location.Clone()
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	if o.Package != nil {
+		ret.Package = new(string)
+		*ret.Package = *o.Package
+	}
+
+	if o.NeedCollection != nil {
+		ret.NeedCollection = new(bool)
+		*ret.NeedCollection = *o.NeedCollection
+	}
+
+	if o.Collection != nil {
+		ret.Collection = o.Collection.Clone()
+	}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	return &ret
+}
+
+// HasServiceIntroduction 应答中是否返回了 service_introduction，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasServiceIntroduction() bool {
+	return o != nil && o.ServiceIntroduction != nil
+}
+
+// HasStateDescription 应答中是否返回了 state_description，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasStateDescription() bool {
+	return o != nil && o.StateDescription != nil
+}
+
+// HasTotalAmount 应答中是否返回了 total_amount，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasTotalAmount() bool {
+	return o != nil && o.TotalAmount != nil
+}
+
+// HasPostPayments 应答中是否返回了 post_payments，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasPostPayments() bool {
+	return o != nil && o.PostPayments != nil
+}
+
+// HasPostDiscounts 应答中是否返回了 post_discounts，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasPostDiscounts() bool {
+	return o != nil && o.PostDiscounts != nil
+}
+
+// HasRiskFund 应答中是否返回了 risk_fund，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasRiskFund() bool {
+	return o != nil && o.RiskFund != nil
+}
+
+// HasTimeRange 应答中是否返回了 time_range，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasTimeRange() bool {
+	return o != nil && o.TimeRange != nil
+}
+
+// HasLocation 应答中是否返回了 location，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasLocation() bool {
+	return o != nil && o.Location != nil
+}
+
+// HasAttach 应答中是否返回了 attach，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasAttach() bool {
+	return o != nil && o.Attach != nil
+}
+
+// HasNotifyUrl 应答中是否返回了 notify_url，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasNotifyUrl() bool {
+	return o != nil && o.NotifyUrl != nil
+}
+
+// HasPackage 应答中是否返回了 package，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasPackage() bool {
+	return o != nil && o.Package != nil
+}
+
+// HasNeedCollection 应答中是否返回了 need_collection，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasNeedCollection() bool {
+	return o != nil && o.NeedCollection != nil
+}
+
+// HasCollection 应答中是否返回了 collection，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasCollection() bool {
+	return o != nil && o.Collection != nil
+}
+
+// HasOpenid 应答中是否返回了 openid，o 为 nil 时返回 false
+func (o *ServiceOrderEntity) HasOpenid() bool {
+	return o != nil && o.Openid != nil
+}
+
+// ServiceOrderState * `CREATED` - 商户已创建服务订单 * `DOING` - 服务订单进行中 * `DONE` - 服务订单完成 * `REVOKED` - 商户取消服务订单 * `EXPIRED` - 服务订单已失效
+type ServiceOrderState string
+
+func (e ServiceOrderState) Ptr() *ServiceOrderState {
+	return &e
+}
+
+// Enums of ServiceOrderState
+const (
+	SERVICEORDERSTATE_CREATED ServiceOrderState = "CREATED"
+	SERVICEORDERSTATE_DOING   ServiceOrderState = "DOING"
+	SERVICEORDERSTATE_DONE    ServiceOrderState = "DONE"
+	SERVICEORDERSTATE_REVOKED ServiceOrderState = "REVOKED"
+	SERVICEORDERSTATE_EXPIRED ServiceOrderState = "EXPIRED"
+)
+
+func (v *ServiceOrderState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ServiceOrderState(value)
+	for _, existing := range []ServiceOrderState{"CREATED", "DOING", "DONE", "REVOKED", "EXPIRED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ServiceOrderState", value)
+}
+
+// StateDescription * `USER_CONFIRM` - 用户确认 * `MCH_COMPLETE` - 商户完结
+type StateDescription string
+
+func (e StateDescription) Ptr() *StateDescription {
+	return &e
+}
+
+// Enums of StateDescription
+const (
+	STATEDESCRIPTION_USER_CONFIRM StateDescription = "USER_CONFIRM"
+	STATEDESCRIPTION_MCH_COMPLETE StateDescription = "MCH_COMPLETE"
+)
+
+func (v *StateDescription) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := StateDescription(value)
+	for _, existing := range []StateDescription{"USER_CONFIRM", "MCH_COMPLETE"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid StateDescription", value)
+}
+
+// SyncDetail
+type SyncDetail struct {
+	// 收款成功时间，格式为yyyyMMddHHmmss
+	PaidTime *string `json:"paid_time"`
+}
+
+func (o SyncDetail) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PaidTime == nil {
+		return nil, fmt.Errorf("field `PaidTime` is required and must be specified in SyncDetail")
+	}
+	toSerialize["paid_time"] = o.PaidTime
+	return json.Marshal(toSerialize)
+}
+
+func (o SyncDetail) String() string {
+	var ret string
+	if o.PaidTime == nil {
+		ret += "PaidTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("PaidTime:%v", *o.PaidTime)
+	}
+
+	return fmt.Sprintf("SyncDetail{%s}", ret)
+}
+
+func (o SyncDetail) Clone() *SyncDetail {
+	ret := SyncDetail{}
+
+	if o.PaidTime != nil {
+		ret.PaidTime = new(string)
+		*ret.PaidTime = *o.PaidTime
+	}
+
+	return &ret
+}
+
+// SyncServiceOrderBody
+type SyncServiceOrderBody struct {
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 场景类型
+	Type *SyncType `json:"type"`
+	// 场景内容
+	Detail *SyncDetail `json:"detail"`
+}
+
+func (o SyncServiceOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in SyncServiceOrderBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in SyncServiceOrderBody")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in SyncServiceOrderBody")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Detail == nil {
+		return nil, fmt.Errorf("field `Detail` is required and must be specified in SyncServiceOrderBody")
+	}
+	toSerialize["detail"] = o.Detail
+	return json.Marshal(toSerialize)
+}
+
+func (o SyncServiceOrderBody) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	ret += fmt.Sprintf("Detail:%v", o.Detail)
+
+	return fmt.Sprintf("SyncServiceOrderBody{%s}", ret)
+}
+
+func (o SyncServiceOrderBody) Clone() *SyncServiceOrderBody {
+	ret := SyncServiceOrderBody{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.Type != nil {
+		ret.Type = new(SyncType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Detail != nil {
+		ret.Detail = o.Detail.Clone()
+	}
+
+	return &ret
+}
+
+// SyncServiceOrderRequest
+type SyncServiceOrderRequest struct {
+	// 商户系统内部服务订单号（不是交易单号），要求此参数只能由数字、大小写字母_-|*组成，且在同一个商户号下唯一
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信公众平台分配的与传入的商户号建立了支付绑定关系的appid
+	Appid *string `json:"appid"`
+	// 该服务ID由微信支付分配，商户需要在申请开通服务时提交
+	ServiceId *string `json:"service_id"`
+	// 场景类型
+	Type *SyncType `json:"type"`
+	// 场景内容
+	Detail *SyncDetail `json:"detail"`
+}
+
+func (o SyncServiceOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in SyncServiceOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in SyncServiceOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.ServiceId == nil {
+		return nil, fmt.Errorf("field `ServiceId` is required and must be specified in SyncServiceOrderRequest")
+	}
+	toSerialize["service_id"] = o.ServiceId
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in SyncServiceOrderRequest")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Detail == nil {
+		return nil, fmt.Errorf("field `Detail` is required and must be specified in SyncServiceOrderRequest")
+	}
+	toSerialize["detail"] = o.Detail
+	return json.Marshal(toSerialize)
+}
+
+func (o SyncServiceOrderRequest) String() string {
+	var ret string
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.ServiceId == nil {
+		ret += "ServiceId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceId:%v, ", *o.ServiceId)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	ret += fmt.Sprintf("Detail:%v", o.Detail)
+
+	return fmt.Sprintf("SyncServiceOrderRequest{%s}", ret)
+}
+
+func (o SyncServiceOrderRequest) Clone() *SyncServiceOrderRequest {
+	ret := SyncServiceOrderRequest{}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.ServiceId != nil {
+		ret.ServiceId = new(string)
+		*ret.ServiceId = *o.ServiceId
+	}
+
+	if o.Type != nil {
+		ret.Type = new(SyncType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Detail != nil {
+		ret.Detail = o.Detail.Clone()
+	}
+
+	return &ret
+}
+
+// SyncType * `Order_Paid` - 订单已收款
+type SyncType string
+
+func (e SyncType) Ptr() *SyncType {
+	return &e
+}
+
+// Enums of SyncType
+const (
+	SYNCTYPE_ORDER_PAID SyncType = "Order_Paid"
+)
+
+func (v *SyncType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := SyncType(value)
+	for _, existing := range []SyncType{"Order_Paid"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid SyncType", value)
+}
+
+// TimeRange
+type TimeRange struct {
+	// 服务开始时间，支持两种格式：yyyyMMddHHmmss与yyyyMMdd，传入yyyyMMdd时默认为当天00:00:00
+	StartTime *string `json:"start_time"`
+	// 服务开始时间备注说明
+	StartTimeRemark *string `json:"start_time_remark,omitempty"`
+	// 预计服务结束时间，支持两种格式：yyyyMMddHHmmss与yyyyMMdd，传入yyyyMMdd时默认为当天00:00:00
+	EndTime *string `json:"end_time,omitempty"`
+	// 预计服务结束时间备注说明
+	EndTimeRemark *string `json:"end_time_remark,omitempty"`
+}
+
+func (o TimeRange) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.StartTime == nil {
+		return nil, fmt.Errorf("field `StartTime` is required and must be specified in TimeRange")
+	}
+	toSerialize["start_time"] = o.StartTime
+
+	if o.StartTimeRemark != nil {
+		toSerialize["start_time_remark"] = o.StartTimeRemark
+	}
+
+	if o.EndTime != nil {
+		toSerialize["end_time"] = o.EndTime
+	}
+
+	if o.EndTimeRemark != nil {
+		toSerialize["end_time_remark"] = o.EndTimeRemark
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TimeRange) String() string {
+	var ret string
+	if o.StartTime == nil {
+		ret += "StartTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StartTime:%v, ", *o.StartTime)
+	}
+
+	if o.StartTimeRemark == nil {
+		ret += "StartTimeRemark:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StartTimeRemark:%v, ", *o.StartTimeRemark)
+	}
+
+	if o.EndTime == nil {
+		ret += "EndTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("EndTime:%v, ", *o.EndTime)
+	}
+
+	if o.EndTimeRemark == nil {
+		ret += "EndTimeRemark:<nil>"
+	} else {
+		ret += fmt.Sprintf("EndTimeRemark:%v", *o.EndTimeRemark)
+	}
+
+	return fmt.Sprintf("TimeRange{%s}", ret)
+}
+
+func (o TimeRange) Clone() *TimeRange {
+	ret := TimeRange{}
+
+	if o.StartTime != nil {
+		ret.StartTime = new(string)
+		*ret.StartTime = *o.StartTime
+	}
+
+	if o.StartTimeRemark != nil {
+		ret.StartTimeRemark = new(string)
+		*ret.StartTimeRemark = *o.StartTimeRemark
+	}
+
+	if o.EndTime != nil {
+		ret.EndTime = new(string)
+		*ret.EndTime = *o.EndTime
+	}
+
+	if o.EndTimeRemark != nil {
+		ret.EndTimeRemark = new(string)
+		*ret.EndTimeRemark = *o.EndTimeRemark
+	}
+
+	return &ret
+}
+
+// HasStartTimeRemark 应答中是否返回了 start_time_remark，o 为 nil 时返回 false
+func (o *TimeRange) HasStartTimeRemark() bool {
+	return o != nil && o.StartTimeRemark != nil
+}
+
+// HasEndTime 应答中是否返回了 end_time，o 为 nil 时返回 false
+func (o *TimeRange) HasEndTime() bool {
+	return o != nil && o.EndTime != nil
+}
+
+// HasEndTimeRemark 应答中是否返回了 end_time_remark，o 为 nil 时返回 false
+func (o *TimeRange) HasEndTimeRemark() bool {
+	return o != nil && o.EndTimeRemark != nil
+}
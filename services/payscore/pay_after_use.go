@@ -0,0 +1,283 @@
+package payscore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// OrderStage 先享后付服务订单所处的阶段，由订单状态、状态说明与收款状态共同决定
+type OrderStage int
+
+// OrderStage 可能枚举
+const (
+	OrderStageUnknown         OrderStage = iota // 未知
+	OrderStageCreated                           // 已创建，等待用户确认，可取消
+	OrderStageInService                         // 用户已确认，服务进行中，可完结或取消
+	OrderStageAwaitingPayment                   // 商户已完结，等待用户支付，可修改金额、催收扣款或同步收款
+	OrderStagePaid                              // 已收款
+	OrderStageRevoked                           // 已取消
+	OrderStageExpired                           // 已失效
+)
+
+func (s OrderStage) String() string {
+	switch s {
+	case OrderStageCreated:
+		return "created"
+	case OrderStageInService:
+		return "in-service"
+	case OrderStageAwaitingPayment:
+		return "awaiting-payment"
+	case OrderStagePaid:
+		return "paid"
+	case OrderStageRevoked:
+		return "revoked"
+	case OrderStageExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// StageOf 返回服务订单所处的阶段
+func StageOf(order *ServiceOrderEntity) OrderStage {
+	if order == nil || order.State == nil {
+		return OrderStageUnknown
+	}
+	switch *order.State {
+	case SERVICEORDERSTATE_CREATED:
+		return OrderStageCreated
+	case SERVICEORDERSTATE_DOING:
+		if order.StateDescription == nil || *order.StateDescription != STATEDESCRIPTION_MCH_COMPLETE {
+			return OrderStageInService
+		}
+		if order.Collection != nil && order.Collection.State != nil &&
+			*order.Collection.State == COLLECTIONSTATE_USER_PAID {
+			return OrderStagePaid
+		}
+		return OrderStageAwaitingPayment
+	case SERVICEORDERSTATE_DONE:
+		return OrderStagePaid
+	case SERVICEORDERSTATE_REVOKED:
+		return OrderStageRevoked
+	case SERVICEORDERSTATE_EXPIRED:
+		return OrderStageExpired
+	default:
+		return OrderStageUnknown
+	}
+}
+
+// StateError 服务订单的当前阶段不允许该操作
+type StateError struct {
+	// OutOrderNo 商户服务订单号
+	OutOrderNo string
+	// Operation 被拒绝的操作，如 complete
+	Operation string
+	// Stage 服务订单当前所处的阶段
+	Stage OrderStage
+	// Allowed 允许该操作的阶段
+	Allowed []OrderStage
+}
+
+func (e *StateError) Error() string {
+	return fmt.Sprintf("cannot %s service order %s in stage %s, allowed stages: %v",
+		e.Operation, e.OutOrderNo, e.Stage, e.Allowed)
+}
+
+// syncPaidTimeFormat 同步收款时间的格式，使用北京时间
+const syncPaidTimeFormat = "20060102150405"
+
+var beijing = time.FixedZone("CST", 8*60*60)
+
+// PayAfterUse 先享后付服务订单编排器
+//
+// 先享后付订单需要按 创建 → 用户确认 → 完结 → 收款（自动扣款、催收扣款或同步商户渠道收款）的顺序推进，
+// 在错误的阶段完结、修改金额或取消订单会导致订单无法收款。PayAfterUse 在完结、修改、催收、同步与取消前查询订单，
+// 在本地校验订单阶段与金额，不满足条件时返回 *StateError 或参数错误，不会发起请求。
+//
+// 查询与操作之间订单状态仍可能变化（如用户恰好完成支付），此时微信支付会以 INVALID_ORDER_STATE 拒绝请求。
+type PayAfterUse struct {
+	orders    ServiceOrderApiService
+	appid     string
+	serviceID string
+}
+
+// NewPayAfterUse 使用公众账号ID与服务ID创建 PayAfterUse
+func NewPayAfterUse(client *core.Client, appid, serviceID string) *PayAfterUse {
+	return &PayAfterUse{
+		orders:    ServiceOrderApiService{Client: client},
+		appid:     appid,
+		serviceID: serviceID,
+	}
+}
+
+// Create 创建服务订单，req 中未设置的 Appid 与 ServiceId 使用 PayAfterUse 的配置
+func (p *PayAfterUse) Create(
+	ctx context.Context, req CreateServiceOrderRequest,
+) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	req = *req.Clone()
+	p.fill(&req.Appid, &req.ServiceId)
+	return p.orders.CreateServiceOrder(ctx, req)
+}
+
+// Query 使用商户服务订单号查询服务订单
+func (p *PayAfterUse) Query(ctx context.Context, outOrderNo string) (*ServiceOrderEntity, *core.APIResult, error) {
+	return p.orders.QueryServiceOrder(ctx, QueryServiceOrderRequest{
+		OutOrderNo: core.String(outOrderNo),
+		ServiceId:  core.String(p.serviceID),
+		Appid:      core.String(p.appid),
+	})
+}
+
+// Complete 完结服务订单，订单需处于 OrderStageInService 阶段
+//
+// 完结前校验 TotalAmount 等于后付费项目金额之和减去商户优惠金额之和，且不超过订单的风险金额。
+func (p *PayAfterUse) Complete(
+	ctx context.Context, req CompleteServiceOrderRequest,
+) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	if req.TotalAmount == nil {
+		return nil, nil, fmt.Errorf("field `TotalAmount` is required and must be specified in CompleteServiceOrderRequest")
+	}
+	if err = checkTotalAmount(*req.TotalAmount, req.PostPayments, req.PostDiscounts); err != nil {
+		return nil, nil, err
+	}
+
+	order, err := p.check(ctx, req.OutOrderNo, "complete", OrderStageInService)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order.RiskFund != nil && order.RiskFund.Amount != nil && *req.TotalAmount > *order.RiskFund.Amount {
+		return nil, nil, fmt.Errorf("total amount %d of service order %s exceeds the risk fund amount %d",
+			*req.TotalAmount, *req.OutOrderNo, *order.RiskFund.Amount)
+	}
+
+	req = *req.Clone()
+	p.fill(&req.Appid, &req.ServiceId)
+	return p.orders.CompleteServiceOrder(ctx, req)
+}
+
+// Modify 修改订单金额，订单需处于 OrderStageAwaitingPayment 阶段，且修改后的金额不能超过当前金额
+func (p *PayAfterUse) Modify(
+	ctx context.Context, req ModifyServiceOrderRequest,
+) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	if req.TotalAmount == nil {
+		return nil, nil, fmt.Errorf("field `TotalAmount` is required and must be specified in ModifyServiceOrderRequest")
+	}
+	if err = checkTotalAmount(*req.TotalAmount, req.PostPayments, req.PostDiscounts); err != nil {
+		return nil, nil, err
+	}
+
+	order, err := p.check(ctx, req.OutOrderNo, "modify", OrderStageAwaitingPayment)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order.TotalAmount != nil && *req.TotalAmount > *order.TotalAmount {
+		return nil, nil, fmt.Errorf("total amount %d of service order %s exceeds the completed amount %d",
+			*req.TotalAmount, *req.OutOrderNo, *order.TotalAmount)
+	}
+
+	req = *req.Clone()
+	p.fill(&req.Appid, &req.ServiceId)
+	return p.orders.ModifyServiceOrder(ctx, req)
+}
+
+// Pay 发起催收扣款，订单需处于 OrderStageAwaitingPayment 阶段
+func (p *PayAfterUse) Pay(
+	ctx context.Context, outOrderNo string,
+) (resp *PayServiceOrderResponse, result *core.APIResult, err error) {
+	if _, err = p.check(ctx, core.String(outOrderNo), "pay", OrderStageAwaitingPayment); err != nil {
+		return nil, nil, err
+	}
+	return p.orders.PayServiceOrder(ctx, PayServiceOrderRequest{
+		OutOrderNo: core.String(outOrderNo),
+		Appid:      core.String(p.appid),
+		ServiceId:  core.String(p.serviceID),
+	})
+}
+
+// SyncPaid 同步用户已通过商户渠道付款，订单需处于 OrderStageAwaitingPayment 阶段，同步后订单完成
+func (p *PayAfterUse) SyncPaid(
+	ctx context.Context, outOrderNo string, paidTime time.Time,
+) (resp *ServiceOrderEntity, result *core.APIResult, err error) {
+	if _, err = p.check(ctx, core.String(outOrderNo), "sync", OrderStageAwaitingPayment); err != nil {
+		return nil, nil, err
+	}
+	return p.orders.SyncServiceOrder(ctx, SyncServiceOrderRequest{
+		OutOrderNo: core.String(outOrderNo),
+		Appid:      core.String(p.appid),
+		ServiceId:  core.String(p.serviceID),
+		Type:       SYNCTYPE_ORDER_PAID.Ptr(),
+		Detail:     &SyncDetail{PaidTime: core.String(paidTime.In(beijing).Format(syncPaidTimeFormat))},
+	})
+}
+
+// Cancel 取消服务订单，订单需处于 OrderStageCreated 或 OrderStageInService 阶段。商户完结订单后不能取消
+func (p *PayAfterUse) Cancel(
+	ctx context.Context, outOrderNo, reason string,
+) (resp *CancelServiceOrderResponse, result *core.APIResult, err error) {
+	if _, err = p.check(ctx, core.String(outOrderNo), "cancel", OrderStageCreated, OrderStageInService); err != nil {
+		return nil, nil, err
+	}
+	return p.orders.CancelServiceOrder(ctx, CancelServiceOrderRequest{
+		OutOrderNo: core.String(outOrderNo),
+		Appid:      core.String(p.appid),
+		ServiceId:  core.String(p.serviceID),
+		Reason:     core.String(reason),
+	})
+}
+
+// check 查询服务订单，并校验订单处于允许操作的阶段
+func (p *PayAfterUse) check(
+	ctx context.Context, outOrderNo *string, operation string, allowed ...OrderStage,
+) (*ServiceOrderEntity, error) {
+	if outOrderNo == nil || *outOrderNo == "" {
+		return nil, fmt.Errorf("out order no is required to %s service order", operation)
+	}
+
+	order, _, err := p.Query(ctx, *outOrderNo)
+	if err != nil {
+		return nil, fmt.Errorf("query service order %s err: %w", *outOrderNo, err)
+	}
+
+	stage := StageOf(order)
+	for _, s := range allowed {
+		if stage == s {
+			return order, nil
+		}
+	}
+	return nil, &StateError{OutOrderNo: *outOrderNo, Operation: operation, Stage: stage, Allowed: allowed}
+}
+
+// fill 为请求设置未指定的 Appid 与 ServiceId
+func (p *PayAfterUse) fill(appid, serviceID **string) {
+	if *appid == nil {
+		*appid = core.String(p.appid)
+	}
+	if *serviceID == nil {
+		*serviceID = core.String(p.serviceID)
+	}
+}
+
+// checkTotalAmount 校验总金额等于后付费项目金额之和减去商户优惠金额之和
+func checkTotalAmount(totalAmount int64, payments []Payment, discounts []ServiceOrderCoupon) error {
+	if totalAmount < 0 {
+		return fmt.Errorf("total amount %d must not be negative", totalAmount)
+	}
+	var expected int64
+	for _, payment := range payments {
+		if payment.Amount != nil {
+			expected += *payment.Amount
+		}
+	}
+	for _, discount := range discounts {
+		if discount.Amount != nil {
+			expected -= *discount.Amount
+		}
+	}
+	if totalAmount != expected {
+		return fmt.Errorf("total amount %d does not equal post payments minus post discounts %d", totalAmount, expected)
+	}
+	return nil
+}
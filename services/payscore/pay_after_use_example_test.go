@@ -0,0 +1,60 @@
+package payscore_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payscore"
+)
+
+func ExamplePayAfterUse_Complete() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	flow := payscore.NewPayAfterUse(client, "wxd678efh567hg6787", "500001")
+	resp, result, err := flow.Complete(ctx,
+		payscore.CompleteServiceOrderRequest{
+			OutOrderNo: core.String("1234323JKHDFE1243252"),
+			PostPayments: []payscore.Payment{{
+				Name:   core.String("就餐费用"),
+				Amount: core.Int64(40000),
+			}},
+			PostDiscounts: []payscore.ServiceOrderCoupon{{
+				Name:   core.String("满20减1元"),
+				Amount: core.Int64(100),
+			}},
+			TotalAmount: core.Int64(39900),
+		},
+	)
+
+	var stateErr *payscore.StateError
+	if errors.As(err, &stateErr) {
+		// 订单尚未被用户确认，或已完结、取消
+		log.Printf("service order is %s", stateErr.Stage)
+		return
+	}
+
+	// TODO: 处理返回结果
+	_, _ = resp, result
+}
+
+func ExampleStageOf() {
+	order := &payscore.ServiceOrderEntity{
+		State:            payscore.SERVICEORDERSTATE_DOING.Ptr(),
+		StateDescription: payscore.STATEDESCRIPTION_MCH_COMPLETE.Ptr(),
+		Collection:       &payscore.Collection{State: payscore.COLLECTIONSTATE_USER_PAYING.Ptr()},
+	}
+	fmt.Println(payscore.StageOf(order))
+
+	order.Collection.State = payscore.COLLECTIONSTATE_USER_PAID.Ptr()
+	fmt.Println(payscore.StageOf(order))
+	// Output:
+	// awaiting-payment
+	// paid
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 微信支付分账API
+//
+// 微信支付分账API
+//
+// API version: 0.0.3
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package profitsharing
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type OrdersApiService services.Service
+
+// CreateOrder 请求分账
+//
+// # 应用场景
+// 微信订单支付成功后，商户发起分账请求，将结算后的资金分到分账接收方。
+//
+// 注意：
+// 1、对同一笔订单最多能发起50次分账请求，每次请求最多分给50个接收方
+// 2、累计分账金额不能超过订单金额乘以商户的最大分账比例，超过时将返回RATIO_EXCEED错误
+// 3、分账接收方的姓名属于敏感信息，需使用微信支付平台证书加密，并通过Wechatpay-Serial请求头传递加密所用的平台证书序列号
+// 4、此接口采用异步处理模式，即在接收到商户请求后，会先受理请求再异步处理，最终的分账结果可通过查询分账接口获取
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NOT_ENOUGH|余额不足|分账金额超过订单的剩余待分金额|请检查分账金额|
+// |RATIO_EXCEED|超过分账比例|累计分账金额超过最大分账比例|请检查分账金额或调整最大分账比例|
+// |ORDER_NOT_READY|订单处理中|订单资金尚未结算|请稍后重试|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *OrdersApiService) CreateOrder(ctx context.Context, req CreateOrderRequest) (resp *OrdersEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/profitsharing/orders"
+	// Make sure All Required Params are properly set
+
+	// Setup Header Params
+	if req.WechatpaySerial != nil {
+		localVarHeaderParams.Set("Wechatpay-Serial", core.ParameterToString(*req.WechatpaySerial, ""))
+	}
+
+	// Setup Body Params
+	localVarPostBody = &CreateOrderBody{
+		SubMchid:        req.SubMchid,
+		Appid:           req.Appid,
+		SubAppid:        req.SubAppid,
+		TransactionId:   req.TransactionId,
+		OutOrderNo:      req.OutOrderNo,
+		Receivers:       req.Receivers,
+		UnfreezeUnsplit: req.UnfreezeUnsplit,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract OrdersEntity from Http Response
+	resp = new(OrdersEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryOrder 查询分账结果
+//
+// # 应用场景
+// 发起分账请求后，可调用此接口查询分账结果。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|记录不存在|分账单不存在|请确认商户分账单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *OrdersApiService) QueryOrder(ctx context.Context, req QueryOrderRequest) (resp *OrdersEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutOrderNo == nil {
+		return nil, nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in QueryOrderRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/profitsharing/orders/{out_order_no}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_order_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutOrderNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.TransactionId == nil {
+		return nil, nil, fmt.Errorf("field `TransactionId` is required and must be specified in QueryOrderRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	if req.SubMchid != nil {
+		localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	}
+	localVarQueryParams.Add("transaction_id", core.ParameterToString(*req.TransactionId, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract OrdersEntity from Http Response
+	resp = new(OrdersEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// UnfreezeOrder 解冻剩余资金
+//
+// # 应用场景
+// 不需要进行分账的订单，可直接调用本接口将订单的金额全部解冻给本商户。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *OrdersApiService) UnfreezeOrder(ctx context.Context, req UnfreezeOrderRequest) (resp *OrdersEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/profitsharing/orders/unfreeze"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract OrdersEntity from Http Response
+	resp = new(OrdersEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
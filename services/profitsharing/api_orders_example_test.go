@@ -0,0 +1,90 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 微信支付分账API
+//
+// 微信支付分账API
+//
+// API version: 0.0.3
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package profitsharing_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/profitsharing"
+)
+
+func ExampleOrdersApiService_CreateOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := profitsharing.OrdersApiService{Client: client}
+	resp, result, err := svc.CreateOrder(ctx,
+		profitsharing.CreateOrderRequest{
+			Appid:      core.String("wx8888888888888888"),
+			OutOrderNo: core.String("P20150806125346"),
+			Receivers: []profitsharing.CreateOrderReceiver{profitsharing.CreateOrderReceiver{
+				Account:     core.String("86693852"),
+				Amount:      core.Int64(888),
+				Description: core.String("分给商户A"),
+				Name:        core.String("hu89ohu89ohu89o"),
+				Type:        core.String("MERCHANT_ID"),
+			}},
+			SubAppid:        core.String("wx8888888888888889"),
+			SubMchid:        core.String("1900000109"),
+			TransactionId:   core.String("4208450740201411110007820472"),
+			UnfreezeUnsplit: core.Bool(true),
+			WechatpaySerial: core.String("5157F09EFDC096DE15EBE81A47057A7232F1B8E1"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleOrdersApiService_QueryOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := profitsharing.OrdersApiService{Client: client}
+	resp, result, err := svc.QueryOrder(ctx,
+		profitsharing.QueryOrderRequest{
+			OutOrderNo:    core.String("P20150806125346"),
+			SubMchid:      core.String("1900000109"),
+			TransactionId: core.String("4208450740201411110007820472"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleOrdersApiService_UnfreezeOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := profitsharing.OrdersApiService{Client: client}
+	resp, result, err := svc.UnfreezeOrder(ctx,
+		profitsharing.UnfreezeOrderRequest{
+			Description:   core.String("解冻全部剩余资金"),
+			OutOrderNo:    core.String("P20150806125346"),
+			SubMchid:      core.String("1900000109"),
+			TransactionId: core.String("4208450740201411110007820472"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 微信支付分账API
+//
+// 微信支付分账API
+//
+// API version: 0.0.3
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package profitsharing
+
+import (
+	"context"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ReceiversApiService services.Service
+
+// AddReceiver 添加分账接收方
+//
+// # 应用场景
+// 商户发起添加分账接收方请求，建立分账接收方列表。后续可通过发起分账请求，将分账方商户结算后的资金，分到该分账接收方。
+//
+// 注意：
+// 1、分账接收方的姓名属于敏感信息，需使用微信支付平台证书加密，并通过Wechatpay-Serial请求头传递加密所用的平台证书序列号
+// 2、接收方类型为个人时，姓名可选填，填写时需与openid对应的实名信息一致
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|分账接收方已存在或不合法|请确认分账接收方信息|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ReceiversApiService) AddReceiver(ctx context.Context, req AddReceiverRequest) (resp *AddReceiverResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/profitsharing/receivers/add"
+	// Make sure All Required Params are properly set
+
+	// Setup Header Params
+	if req.WechatpaySerial != nil {
+		localVarHeaderParams.Set("Wechatpay-Serial", core.ParameterToString(*req.WechatpaySerial, ""))
+	}
+
+	// Setup Body Params
+	localVarPostBody = &AddReceiverBody{
+		SubMchid:       req.SubMchid,
+		Appid:          req.Appid,
+		SubAppid:       req.SubAppid,
+		Type:           req.Type,
+		Account:        req.Account,
+		Name:           req.Name,
+		RelationType:   req.RelationType,
+		CustomRelation: req.CustomRelation,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract AddReceiverResponse from Http Response
+	resp = new(AddReceiverResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// DeleteReceiver 删除分账接收方
+//
+// # 应用场景
+// 商户发起删除分账接收方请求。删除后，不支持将分账方商户结算后的资金，分到该分账接收方。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ReceiversApiService) DeleteReceiver(ctx context.Context, req DeleteReceiverRequest) (resp *DeleteReceiverResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/profitsharing/receivers/delete"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract DeleteReceiverResponse from Http Response
+	resp = new(DeleteReceiverResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,1928 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 微信支付分账API
+//
+// 微信支付分账API
+//
+// API version: 0.0.3
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package profitsharing
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AddReceiverBody
+type AddReceiverBody struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信分配的公众账号ID
+	Appid *string `json:"appid"`
+	// 子商户的公众账号ID，分账接收方类型包含PERSONAL_SUB_OPENID时必填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 分账接收方类型
+	Type *ReceiverType `json:"type"`
+	// 类型是MERCHANT_ID时，是商户号；类型是PERSONAL_OPENID时，是个人openid；类型是PERSONAL_SUB_OPENID时，是个人sub_openid
+	Account *string `json:"account"`
+	// 分账个人接收方姓名。在接收方类型为个人的时可选填，若有值，会检查与 name 是否实名匹配，不匹配会拒绝分账请求；接收方类型为MERCHANT_ID时，是商户全称（必传），当商户是小微商户或个体户时，是开户人姓名。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	Name *string `json:"name,omitempty" encryption:"EM_APIV3"`
+	// 子商户与接收方的关系
+	RelationType *ReceiverRelationType `json:"relation_type"`
+	// 子商户与接收方具体的关系，本字段最多10个字。当字段relation_type的值为CUSTOM时，本字段必填
+	CustomRelation *string `json:"custom_relation,omitempty"`
+}
+
+func (o AddReceiverBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in AddReceiverBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in AddReceiverBody")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Account == nil {
+		return nil, fmt.Errorf("field `Account` is required and must be specified in AddReceiverBody")
+	}
+	toSerialize["account"] = o.Account
+
+	if o.Name != nil {
+		toSerialize["name"] = o.Name
+	}
+
+	if o.RelationType == nil {
+		return nil, fmt.Errorf("field `RelationType` is required and must be specified in AddReceiverBody")
+	}
+	toSerialize["relation_type"] = o.RelationType
+
+	if o.CustomRelation != nil {
+		toSerialize["custom_relation"] = o.CustomRelation
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AddReceiverBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Account == nil {
+		ret += "Account:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Account:%v, ", *o.Account)
+	}
+
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.RelationType == nil {
+		ret += "RelationType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RelationType:%v, ", *o.RelationType)
+	}
+
+	if o.CustomRelation == nil {
+		ret += "CustomRelation:<nil>"
+	} else {
+		ret += fmt.Sprintf("CustomRelation:%v", *o.CustomRelation)
+	}
+
+	return fmt.Sprintf("AddReceiverBody{%s}", ret)
+}
+
+func (o AddReceiverBody) Clone() *AddReceiverBody {
+	ret := AddReceiverBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.Type != nil {
+		ret.Type = new(ReceiverType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Account != nil {
+		ret.Account = new(string)
+		*ret.Account = *o.Account
+	}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.RelationType != nil {
+		ret.RelationType = new(ReceiverRelationType)
+		*ret.RelationType = *o.RelationType
+	}
+
+	if o.CustomRelation != nil {
+		ret.CustomRelation = new(string)
+		*ret.CustomRelation = *o.CustomRelation
+	}
+
+	return &ret
+}
+
+// AddReceiverRequest
+type AddReceiverRequest struct {
+	// 请求包体中存在敏感信息加密字段时，需传递用于加密的微信支付平台证书序列号
+	WechatpaySerial *string `json:"Wechatpay-Serial,omitempty"`
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信分配的公众账号ID
+	Appid *string `json:"appid"`
+	// 子商户的公众账号ID，分账接收方类型包含PERSONAL_SUB_OPENID时必填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 分账接收方类型
+	Type *ReceiverType `json:"type"`
+	// 类型是MERCHANT_ID时，是商户号；类型是PERSONAL_OPENID时，是个人openid；类型是PERSONAL_SUB_OPENID时，是个人sub_openid
+	Account *string `json:"account"`
+	// 分账个人接收方姓名。在接收方类型为个人的时可选填，若有值，会检查与 name 是否实名匹配，不匹配会拒绝分账请求；接收方类型为MERCHANT_ID时，是商户全称（必传），当商户是小微商户或个体户时，是开户人姓名。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	Name *string `json:"name,omitempty" encryption:"EM_APIV3"`
+	// 子商户与接收方的关系
+	RelationType *ReceiverRelationType `json:"relation_type"`
+	// 子商户与接收方具体的关系，本字段最多10个字。当字段relation_type的值为CUSTOM时，本字段必填
+	CustomRelation *string `json:"custom_relation,omitempty"`
+}
+
+func (o AddReceiverRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.WechatpaySerial != nil {
+		toSerialize["Wechatpay-Serial"] = o.WechatpaySerial
+	}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in AddReceiverRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in AddReceiverRequest")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Account == nil {
+		return nil, fmt.Errorf("field `Account` is required and must be specified in AddReceiverRequest")
+	}
+	toSerialize["account"] = o.Account
+
+	if o.Name != nil {
+		toSerialize["name"] = o.Name
+	}
+
+	if o.RelationType == nil {
+		return nil, fmt.Errorf("field `RelationType` is required and must be specified in AddReceiverRequest")
+	}
+	toSerialize["relation_type"] = o.RelationType
+
+	if o.CustomRelation != nil {
+		toSerialize["custom_relation"] = o.CustomRelation
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AddReceiverRequest) String() string {
+	var ret string
+	if o.WechatpaySerial == nil {
+		ret += "WechatpaySerial:<nil>, "
+	} else {
+		ret += fmt.Sprintf("WechatpaySerial:%v, ", *o.WechatpaySerial)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Account == nil {
+		ret += "Account:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Account:%v, ", *o.Account)
+	}
+
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.RelationType == nil {
+		ret += "RelationType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RelationType:%v, ", *o.RelationType)
+	}
+
+	if o.CustomRelation == nil {
+		ret += "CustomRelation:<nil>"
+	} else {
+		ret += fmt.Sprintf("CustomRelation:%v", *o.CustomRelation)
+	}
+
+	return fmt.Sprintf("AddReceiverRequest{%s}", ret)
+}
+
+func (o AddReceiverRequest) Clone() *AddReceiverRequest {
+	ret := AddReceiverRequest{}
+
+	if o.WechatpaySerial != nil {
+		ret.WechatpaySerial = new(string)
+		*ret.WechatpaySerial = *o.WechatpaySerial
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.Type != nil {
+		ret.Type = new(ReceiverType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Account != nil {
+		ret.Account = new(string)
+		*ret.Account = *o.Account
+	}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.RelationType != nil {
+		ret.RelationType = new(ReceiverRelationType)
+		*ret.RelationType = *o.RelationType
+	}
+
+	if o.CustomRelation != nil {
+		ret.CustomRelation = new(string)
+		*ret.CustomRelation = *o.CustomRelation
+	}
+
+	return &ret
+}
+
+// AddReceiverResponse
+type AddReceiverResponse struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 分账接收方类型
+	Type *ReceiverType `json:"type"`
+	// 类型是MERCHANT_ID时，是商户号；类型是PERSONAL_OPENID时，是个人openid；类型是PERSONAL_SUB_OPENID时，是个人sub_openid
+	Account *string `json:"account"`
+	// 分账个人接收方姓名。在接收方类型为个人的时可选填，若有值，会检查与 name 是否实名匹配，不匹配会拒绝分账请求；接收方类型为MERCHANT_ID时，是商户全称（必传），当商户是小微商户或个体户时，是开户人姓名。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	Name *string `json:"name,omitempty" encryption:"EM_APIV3"`
+	// 子商户与接收方的关系
+	RelationType *ReceiverRelationType `json:"relation_type"`
+	// 子商户与接收方具体的关系
+	CustomRelation *string `json:"custom_relation,omitempty"`
+}
+
+func (o AddReceiverResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in AddReceiverResponse")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Account == nil {
+		return nil, fmt.Errorf("field `Account` is required and must be specified in AddReceiverResponse")
+	}
+	toSerialize["account"] = o.Account
+
+	if o.Name != nil {
+		toSerialize["name"] = o.Name
+	}
+
+	if o.RelationType == nil {
+		return nil, fmt.Errorf("field `RelationType` is required and must be specified in AddReceiverResponse")
+	}
+	toSerialize["relation_type"] = o.RelationType
+
+	if o.CustomRelation != nil {
+		toSerialize["custom_relation"] = o.CustomRelation
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AddReceiverResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Account == nil {
+		ret += "Account:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Account:%v, ", *o.Account)
+	}
+
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.RelationType == nil {
+		ret += "RelationType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RelationType:%v, ", *o.RelationType)
+	}
+
+	if o.CustomRelation == nil {
+		ret += "CustomRelation:<nil>"
+	} else {
+		ret += fmt.Sprintf("CustomRelation:%v", *o.CustomRelation)
+	}
+
+	return fmt.Sprintf("AddReceiverResponse{%s}", ret)
+}
+
+func (o AddReceiverResponse) Clone() *AddReceiverResponse {
+	ret := AddReceiverResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Type != nil {
+		ret.Type = new(ReceiverType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Account != nil {
+		ret.Account = new(string)
+		*ret.Account = *o.Account
+	}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.RelationType != nil {
+		ret.RelationType = new(ReceiverRelationType)
+		*ret.RelationType = *o.RelationType
+	}
+
+	if o.CustomRelation != nil {
+		ret.CustomRelation = new(string)
+		*ret.CustomRelation = *o.CustomRelation
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *AddReceiverResponse) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasName 应答中是否返回了 name，o 为 nil 时返回 false
+func (o *AddReceiverResponse) HasName() bool {
+	return o != nil && o.Name != nil
+}
+
+// HasCustomRelation 应答中是否返回了 custom_relation，o 为 nil 时返回 false
+func (o *AddReceiverResponse) HasCustomRelation() bool {
+	return o != nil && o.CustomRelation != nil
+}
+
+// CreateOrderBody
+type CreateOrderBody struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信分配的公众账号ID
+	Appid *string `json:"appid"`
+	// 子商户的公众账号ID，分账接收方类型包含PERSONAL_SUB_OPENID时必填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户系统内部的分账单号，在商户系统内部唯一，同一分账单号多次请求等同一次。只能是数字、大小写字母_-|*@
+	OutOrderNo *string `json:"out_order_no"`
+	// 分账接收方列表，可以设置出资商户作为分账接受方，最多可有50个分账接收方
+	Receivers []CreateOrderReceiver `json:"receivers"`
+	// 1、如果为true，该笔订单剩余未分账的金额会解冻回分账方商户；2、如果为false，该笔订单剩余未分账的金额不会解冻回分账方商户，可以对该笔订单再次进行分账
+	UnfreezeUnsplit *bool `json:"unfreeze_unsplit"`
+}
+
+func (o CreateOrderBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CreateOrderBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in CreateOrderBody")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CreateOrderBody")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Receivers == nil {
+		return nil, fmt.Errorf("field `Receivers` is required and must be specified in CreateOrderBody")
+	}
+	toSerialize["receivers"] = o.Receivers
+
+	if o.UnfreezeUnsplit == nil {
+		return nil, fmt.Errorf("field `UnfreezeUnsplit` is required and must be specified in CreateOrderBody")
+	}
+	toSerialize["unfreeze_unsplit"] = o.UnfreezeUnsplit
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateOrderBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	ret += fmt.Sprintf("Receivers:%v, ", o.Receivers)
+
+	if o.UnfreezeUnsplit == nil {
+		ret += "UnfreezeUnsplit:<nil>"
+	} else {
+		ret += fmt.Sprintf("UnfreezeUnsplit:%v", *o.UnfreezeUnsplit)
+	}
+
+	return fmt.Sprintf("CreateOrderBody{%s}", ret)
+}
+
+func (o CreateOrderBody) Clone() *CreateOrderBody {
+	ret := CreateOrderBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Receivers != nil {
+		ret.Receivers = make([]CreateOrderReceiver, len(o.Receivers))
+		for i, item := range o.Receivers {
+			ret.Receivers[i] = *item.Clone()
+		}
+	}
+
+	if o.UnfreezeUnsplit != nil {
+		ret.UnfreezeUnsplit = new(bool)
+		*ret.UnfreezeUnsplit = *o.UnfreezeUnsplit
+	}
+
+	return &ret
+}
+
+// CreateOrderReceiver
+type CreateOrderReceiver struct {
+	// 1、MERCHANT_ID：商户号；2、PERSONAL_OPENID：个人openid（由父商户APPID转换得到）；3、PERSONAL_SUB_OPENID：个人sub_openid（由子商户APPID转换得到）
+	Type *string `json:"type"`
+	// 类型是MERCHANT_ID时，是商户号；类型是PERSONAL_OPENID时，是个人openid；类型是PERSONAL_SUB_OPENID时，是个人sub_openid
+	Account *string `json:"account"`
+	// 分账个人接收方姓名。在接收方类型为个人的时可选填，若有值，会检查与 name 是否实名匹配，不匹配会拒绝分账请求；接收方类型为MERCHANT_ID时，是商户全称（必传），当商户是小微商户或个体户时，是开户人姓名。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	Name *string `json:"name,omitempty" encryption:"EM_APIV3"`
+	// 分账金额，单位为分，只能为整数，不能超过原订单支付金额及最大分账比例金额
+	Amount *int64 `json:"amount"`
+	// 分账的原因描述，分账账单中需要体现
+	Description *string `json:"description"`
+}
+
+func (o CreateOrderReceiver) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in CreateOrderReceiver")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Account == nil {
+		return nil, fmt.Errorf("field `Account` is required and must be specified in CreateOrderReceiver")
+	}
+	toSerialize["account"] = o.Account
+
+	if o.Name != nil {
+		toSerialize["name"] = o.Name
+	}
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in CreateOrderReceiver")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in CreateOrderReceiver")
+	}
+	toSerialize["description"] = o.Description
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateOrderReceiver) String() string {
+	var ret string
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Account == nil {
+		ret += "Account:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Account:%v, ", *o.Account)
+	}
+
+	if o.Name == nil {
+		ret += "Name:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Name:%v, ", *o.Name)
+	}
+
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("CreateOrderReceiver{%s}", ret)
+}
+
+func (o CreateOrderReceiver) Clone() *CreateOrderReceiver {
+	ret := CreateOrderReceiver{}
+
+	if o.Type != nil {
+		ret.Type = new(string)
+		*ret.Type = *o.Type
+	}
+
+	if o.Account != nil {
+		ret.Account = new(string)
+		*ret.Account = *o.Account
+	}
+
+	if o.Name != nil {
+		ret.Name = new(string)
+		*ret.Name = *o.Name
+	}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
+
+// CreateOrderRequest
+type CreateOrderRequest struct {
+	// 请求包体中存在敏感信息加密字段时，需传递用于加密的微信支付平台证书序列号
+	WechatpaySerial *string `json:"Wechatpay-Serial,omitempty"`
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信分配的公众账号ID
+	Appid *string `json:"appid"`
+	// 子商户的公众账号ID，分账接收方类型包含PERSONAL_SUB_OPENID时必填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户系统内部的分账单号，在商户系统内部唯一，同一分账单号多次请求等同一次。只能是数字、大小写字母_-|*@
+	OutOrderNo *string `json:"out_order_no"`
+	// 分账接收方列表，可以设置出资商户作为分账接受方，最多可有50个分账接收方
+	Receivers []CreateOrderReceiver `json:"receivers"`
+	// 1、如果为true，该笔订单剩余未分账的金额会解冻回分账方商户；2、如果为false，该笔订单剩余未分账的金额不会解冻回分账方商户，可以对该笔订单再次进行分账
+	UnfreezeUnsplit *bool `json:"unfreeze_unsplit"`
+}
+
+func (o CreateOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.WechatpaySerial != nil {
+		toSerialize["Wechatpay-Serial"] = o.WechatpaySerial
+	}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CreateOrderRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in CreateOrderRequest")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in CreateOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Receivers == nil {
+		return nil, fmt.Errorf("field `Receivers` is required and must be specified in CreateOrderRequest")
+	}
+	toSerialize["receivers"] = o.Receivers
+
+	if o.UnfreezeUnsplit == nil {
+		return nil, fmt.Errorf("field `UnfreezeUnsplit` is required and must be specified in CreateOrderRequest")
+	}
+	toSerialize["unfreeze_unsplit"] = o.UnfreezeUnsplit
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateOrderRequest) String() string {
+	var ret string
+	if o.WechatpaySerial == nil {
+		ret += "WechatpaySerial:<nil>, "
+	} else {
+		ret += fmt.Sprintf("WechatpaySerial:%v, ", *o.WechatpaySerial)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	ret += fmt.Sprintf("Receivers:%v, ", o.Receivers)
+
+	if o.UnfreezeUnsplit == nil {
+		ret += "UnfreezeUnsplit:<nil>"
+	} else {
+		ret += fmt.Sprintf("UnfreezeUnsplit:%v", *o.UnfreezeUnsplit)
+	}
+
+	return fmt.Sprintf("CreateOrderRequest{%s}", ret)
+}
+
+func (o CreateOrderRequest) Clone() *CreateOrderRequest {
+	ret := CreateOrderRequest{}
+
+	if o.WechatpaySerial != nil {
+		ret.WechatpaySerial = new(string)
+		*ret.WechatpaySerial = *o.WechatpaySerial
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Receivers != nil {
+		ret.Receivers = make([]CreateOrderReceiver, len(o.Receivers))
+		for i, item := range o.Receivers {
+			ret.Receivers[i] = *item.Clone()
+		}
+	}
+
+	if o.UnfreezeUnsplit != nil {
+		ret.UnfreezeUnsplit = new(bool)
+		*ret.UnfreezeUnsplit = *o.UnfreezeUnsplit
+	}
+
+	return &ret
+}
+
+// DeleteReceiverRequest
+type DeleteReceiverRequest struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信分配的公众账号ID
+	Appid *string `json:"appid"`
+	// 子商户的公众账号ID，分账接收方类型包含PERSONAL_SUB_OPENID时必填
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 分账接收方类型
+	Type *ReceiverType `json:"type"`
+	// 类型是MERCHANT_ID时，是商户号；类型是PERSONAL_OPENID时，是个人openid；类型是PERSONAL_SUB_OPENID时，是个人sub_openid
+	Account *string `json:"account"`
+}
+
+func (o DeleteReceiverRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in DeleteReceiverRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in DeleteReceiverRequest")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Account == nil {
+		return nil, fmt.Errorf("field `Account` is required and must be specified in DeleteReceiverRequest")
+	}
+	toSerialize["account"] = o.Account
+	return json.Marshal(toSerialize)
+}
+
+func (o DeleteReceiverRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Account == nil {
+		ret += "Account:<nil>"
+	} else {
+		ret += fmt.Sprintf("Account:%v", *o.Account)
+	}
+
+	return fmt.Sprintf("DeleteReceiverRequest{%s}", ret)
+}
+
+func (o DeleteReceiverRequest) Clone() *DeleteReceiverRequest {
+	ret := DeleteReceiverRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.Type != nil {
+		ret.Type = new(ReceiverType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Account != nil {
+		ret.Account = new(string)
+		*ret.Account = *o.Account
+	}
+
+	return &ret
+}
+
+// DeleteReceiverResponse
+type DeleteReceiverResponse struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 分账接收方类型
+	Type *ReceiverType `json:"type"`
+	// 类型是MERCHANT_ID时，是商户号；类型是PERSONAL_OPENID时，是个人openid；类型是PERSONAL_SUB_OPENID时，是个人sub_openid
+	Account *string `json:"account"`
+}
+
+func (o DeleteReceiverResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in DeleteReceiverResponse")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Account == nil {
+		return nil, fmt.Errorf("field `Account` is required and must be specified in DeleteReceiverResponse")
+	}
+	toSerialize["account"] = o.Account
+	return json.Marshal(toSerialize)
+}
+
+func (o DeleteReceiverResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Account == nil {
+		ret += "Account:<nil>"
+	} else {
+		ret += fmt.Sprintf("Account:%v", *o.Account)
+	}
+
+	return fmt.Sprintf("DeleteReceiverResponse{%s}", ret)
+}
+
+func (o DeleteReceiverResponse) Clone() *DeleteReceiverResponse {
+	ret := DeleteReceiverResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Type != nil {
+		ret.Type = new(ReceiverType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Account != nil {
+		ret.Account = new(string)
+		*ret.Account = *o.Account
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *DeleteReceiverResponse) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// DetailFailReason * `ACCOUNT_ABNORMAL` - 分账接收账户异常 * `NO_RELATION` - 分账关系已解除 * `RECEIVER_HIGH_RISK` - 高风险接收方 * `RECEIVER_REAL_NAME_NOT_VERIFIED` - 接收方未实名 * `NO_AUTH` - 分账权限已解除 * `RECEIVER_RECEIPT_LIMIT` - 接收方已达收款限额 * `PAYER_ACCOUNT_ABNORMAL` - 分出方账户异常
+type DetailFailReason string
+
+func (e DetailFailReason) Ptr() *DetailFailReason {
+	return &e
+}
+
+// Enums of DetailFailReason
+const (
+	DETAILFAILREASON_ACCOUNT_ABNORMAL                DetailFailReason = "ACCOUNT_ABNORMAL"
+	DETAILFAILREASON_NO_RELATION                     DetailFailReason = "NO_RELATION"
+	DETAILFAILREASON_RECEIVER_HIGH_RISK              DetailFailReason = "RECEIVER_HIGH_RISK"
+	DETAILFAILREASON_RECEIVER_REAL_NAME_NOT_VERIFIED DetailFailReason = "RECEIVER_REAL_NAME_NOT_VERIFIED"
+	DETAILFAILREASON_NO_AUTH                         DetailFailReason = "NO_AUTH"
+	DETAILFAILREASON_RECEIVER_RECEIPT_LIMIT          DetailFailReason = "RECEIVER_RECEIPT_LIMIT"
+	DETAILFAILREASON_PAYER_ACCOUNT_ABNORMAL          DetailFailReason = "PAYER_ACCOUNT_ABNORMAL"
+)
+
+func (v *DetailFailReason) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := DetailFailReason(value)
+	for _, existing := range []DetailFailReason{"ACCOUNT_ABNORMAL", "NO_RELATION", "RECEIVER_HIGH_RISK", "RECEIVER_REAL_NAME_NOT_VERIFIED", "NO_AUTH", "RECEIVER_RECEIPT_LIMIT", "PAYER_ACCOUNT_ABNORMAL"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid DetailFailReason", value)
+}
+
+// DetailStatus * `PENDING` - 待分账 * `SUCCESS` - 分账成功 * `CLOSED` - 已关闭
+type DetailStatus string
+
+func (e DetailStatus) Ptr() *DetailStatus {
+	return &e
+}
+
+// Enums of DetailStatus
+const (
+	DETAILSTATUS_PENDING DetailStatus = "PENDING"
+	DETAILSTATUS_SUCCESS DetailStatus = "SUCCESS"
+	DETAILSTATUS_CLOSED  DetailStatus = "CLOSED"
+)
+
+func (v *DetailStatus) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := DetailStatus(value)
+	for _, existing := range []DetailStatus{"PENDING", "SUCCESS", "CLOSED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid DetailStatus", value)
+}
+
+// OrderReceiverDetail
+type OrderReceiverDetail struct {
+	// 分账金额，单位为分
+	Amount *int64 `json:"amount"`
+	// 分账的原因描述，分账账单中需要体现
+	Description *string `json:"description"`
+	// 分账接收方类型
+	Type *ReceiverType `json:"type"`
+	// 类型是MERCHANT_ID时，是商户号；类型是PERSONAL_OPENID时，是个人openid；类型是PERSONAL_SUB_OPENID时，是个人sub_openid
+	Account *string `json:"account"`
+	// 分账结果
+	Result *DetailStatus `json:"result"`
+	// 分账失败原因，当分账结果result为CLOSED（已关闭）时，返回该字段
+	FailReason *DetailFailReason `json:"fail_reason,omitempty"`
+	// 分账创建时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	CreateTime *time.Time `json:"create_time"`
+	// 分账完成时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	FinishTime *time.Time `json:"finish_time"`
+	// 微信分账明细单号，每笔分账业务执行的明细单号，可与资金账单对账使用
+	DetailId *string `json:"detail_id"`
+}
+
+func (o OrderReceiverDetail) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.Type == nil {
+		return nil, fmt.Errorf("field `Type` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["type"] = o.Type
+
+	if o.Account == nil {
+		return nil, fmt.Errorf("field `Account` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["account"] = o.Account
+
+	if o.Result == nil {
+		return nil, fmt.Errorf("field `Result` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["result"] = o.Result
+
+	if o.FailReason != nil {
+		toSerialize["fail_reason"] = o.FailReason
+	}
+
+	if o.CreateTime == nil {
+		return nil, fmt.Errorf("field `CreateTime` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["create_time"] = o.CreateTime.Format(time.RFC3339)
+
+	if o.FinishTime == nil {
+		return nil, fmt.Errorf("field `FinishTime` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["finish_time"] = o.FinishTime.Format(time.RFC3339)
+
+	if o.DetailId == nil {
+		return nil, fmt.Errorf("field `DetailId` is required and must be specified in OrderReceiverDetail")
+	}
+	toSerialize["detail_id"] = o.DetailId
+	return json.Marshal(toSerialize)
+}
+
+func (o OrderReceiverDetail) String() string {
+	var ret string
+	if o.Amount == nil {
+		ret += "Amount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Amount:%v, ", *o.Amount)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.Type == nil {
+		ret += "Type:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Type:%v, ", *o.Type)
+	}
+
+	if o.Account == nil {
+		ret += "Account:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Account:%v, ", *o.Account)
+	}
+
+	if o.Result == nil {
+		ret += "Result:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Result:%v, ", *o.Result)
+	}
+
+	if o.FailReason == nil {
+		ret += "FailReason:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FailReason:%v, ", *o.FailReason)
+	}
+
+	if o.CreateTime == nil {
+		ret += "CreateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CreateTime:%v, ", *o.CreateTime)
+	}
+
+	if o.FinishTime == nil {
+		ret += "FinishTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FinishTime:%v, ", *o.FinishTime)
+	}
+
+	if o.DetailId == nil {
+		ret += "DetailId:<nil>"
+	} else {
+		ret += fmt.Sprintf("DetailId:%v", *o.DetailId)
+	}
+
+	return fmt.Sprintf("OrderReceiverDetail{%s}", ret)
+}
+
+func (o OrderReceiverDetail) Clone() *OrderReceiverDetail {
+	ret := OrderReceiverDetail{}
+
+	if o.Amount != nil {
+		ret.Amount = new(int64)
+		*ret.Amount = *o.Amount
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.Type != nil {
+		ret.Type = new(ReceiverType)
+		*ret.Type = *o.Type
+	}
+
+	if o.Account != nil {
+		ret.Account = new(string)
+		*ret.Account = *o.Account
+	}
+
+	if o.Result != nil {
+		ret.Result = new(DetailStatus)
+		*ret.Result = *o.Result
+	}
+
+	if o.FailReason != nil {
+		ret.FailReason = new(DetailFailReason)
+		*ret.FailReason = *o.FailReason
+	}
+
+	if o.CreateTime != nil {
+		ret.CreateTime = new(time.Time)
+		*ret.CreateTime = *o.CreateTime
+	}
+
+	if o.FinishTime != nil {
+		ret.FinishTime = new(time.Time)
+		*ret.FinishTime = *o.FinishTime
+	}
+
+	if o.DetailId != nil {
+		ret.DetailId = new(string)
+		*ret.DetailId = *o.DetailId
+	}
+
+	return &ret
+}
+
+// HasFailReason 应答中是否返回了 fail_reason，o 为 nil 时返回 false
+func (o *OrderReceiverDetail) HasFailReason() bool {
+	return o != nil && o.FailReason != nil
+}
+
+// OrderStatus * `PROCESSING` - 处理中 * `FINISHED` - 分账完成
+type OrderStatus string
+
+func (e OrderStatus) Ptr() *OrderStatus {
+	return &e
+}
+
+// Enums of OrderStatus
+const (
+	ORDERSTATUS_PROCESSING OrderStatus = "PROCESSING"
+	ORDERSTATUS_FINISHED   OrderStatus = "FINISHED"
+)
+
+func (v *OrderStatus) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := OrderStatus(value)
+	for _, existing := range []OrderStatus{"PROCESSING", "FINISHED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid OrderStatus", value)
+}
+
+// OrdersEntity
+type OrdersEntity struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户系统内部的分账单号，在商户系统内部唯一，同一分账单号多次请求等同一次。只能是数字、大小写字母_-|*@
+	OutOrderNo *string `json:"out_order_no"`
+	// 微信分账单号，微信系统返回的唯一标识
+	OrderId *string `json:"order_id"`
+	// 分账单状态（每个接收方的分账结果请查看receivers中的result字段）
+	State *OrderStatus `json:"state"`
+	// 分账接收方列表
+	Receivers []OrderReceiverDetail `json:"receivers,omitempty"`
+}
+
+func (o OrdersEntity) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in OrdersEntity")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in OrdersEntity")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.OrderId == nil {
+		return nil, fmt.Errorf("field `OrderId` is required and must be specified in OrdersEntity")
+	}
+	toSerialize["order_id"] = o.OrderId
+
+	if o.State == nil {
+		return nil, fmt.Errorf("field `State` is required and must be specified in OrdersEntity")
+	}
+	toSerialize["state"] = o.State
+
+	if o.Receivers != nil {
+		toSerialize["receivers"] = o.Receivers
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o OrdersEntity) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.OrderId == nil {
+		ret += "OrderId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OrderId:%v, ", *o.OrderId)
+	}
+
+	if o.State == nil {
+		ret += "State:<nil>, "
+	} else {
+		ret += fmt.Sprintf("State:%v, ", *o.State)
+	}
+
+	ret += fmt.Sprintf("Receivers:%v", o.Receivers)
+
+	return fmt.Sprintf("OrdersEntity{%s}", ret)
+}
+
+func (o OrdersEntity) Clone() *OrdersEntity {
+	ret := OrdersEntity{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.OrderId != nil {
+		ret.OrderId = new(string)
+		*ret.OrderId = *o.OrderId
+	}
+
+	if o.State != nil {
+		ret.State = new(OrderStatus)
+		*ret.State = *o.State
+	}
+
+	if o.Receivers != nil {
+		ret.Receivers = make([]OrderReceiverDetail, len(o.Receivers))
+		for i, item := range o.Receivers {
+			ret.Receivers[i] = *item.Clone()
+		}
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *OrdersEntity) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasReceivers 应答中是否返回了 receivers，o 为 nil 时返回 false
+func (o *OrdersEntity) HasReceivers() bool {
+	return o != nil && o.Receivers != nil
+}
+
+// QueryMerchantRatioRequest
+type QueryMerchantRatioRequest struct {
+	// 微信支付分配的子商户号，即分账的出资商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryMerchantRatioRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryMerchantRatioRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryMerchantRatioRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryMerchantRatioRequest{%s}", ret)
+}
+
+func (o QueryMerchantRatioRequest) Clone() *QueryMerchantRatioRequest {
+	ret := QueryMerchantRatioRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// QueryMerchantRatioResponse
+type QueryMerchantRatioResponse struct {
+	// 参考请求参数
+	SubMchid *string `json:"sub_mchid"`
+	// 子商户允许服务商分账的最大比例，单位万分比，比如 2000表示20%
+	MaxRatio *int64 `json:"max_ratio"`
+}
+
+func (o QueryMerchantRatioResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryMerchantRatioResponse")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.MaxRatio == nil {
+		return nil, fmt.Errorf("field `MaxRatio` is required and must be specified in QueryMerchantRatioResponse")
+	}
+	toSerialize["max_ratio"] = o.MaxRatio
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryMerchantRatioResponse) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.MaxRatio == nil {
+		ret += "MaxRatio:<nil>"
+	} else {
+		ret += fmt.Sprintf("MaxRatio:%v", *o.MaxRatio)
+	}
+
+	return fmt.Sprintf("QueryMerchantRatioResponse{%s}", ret)
+}
+
+func (o QueryMerchantRatioResponse) Clone() *QueryMerchantRatioResponse {
+	ret := QueryMerchantRatioResponse{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.MaxRatio != nil {
+		ret.MaxRatio = new(int64)
+		*ret.MaxRatio = *o.MaxRatio
+	}
+
+	return &ret
+}
+
+// QueryOrderAmountRequest
+type QueryOrderAmountRequest struct {
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+}
+
+func (o QueryOrderAmountRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in QueryOrderAmountRequest")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryOrderAmountRequest) String() string {
+	var ret string
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>"
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v", *o.TransactionId)
+	}
+
+	return fmt.Sprintf("QueryOrderAmountRequest{%s}", ret)
+}
+
+func (o QueryOrderAmountRequest) Clone() *QueryOrderAmountRequest {
+	ret := QueryOrderAmountRequest{}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	return &ret
+}
+
+// QueryOrderAmountResponse
+type QueryOrderAmountResponse struct {
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 订单剩余待分金额，整数，单元为分
+	UnsplitAmount *int64 `json:"unsplit_amount"`
+}
+
+func (o QueryOrderAmountResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in QueryOrderAmountResponse")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.UnsplitAmount == nil {
+		return nil, fmt.Errorf("field `UnsplitAmount` is required and must be specified in QueryOrderAmountResponse")
+	}
+	toSerialize["unsplit_amount"] = o.UnsplitAmount
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryOrderAmountResponse) String() string {
+	var ret string
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.UnsplitAmount == nil {
+		ret += "UnsplitAmount:<nil>"
+	} else {
+		ret += fmt.Sprintf("UnsplitAmount:%v", *o.UnsplitAmount)
+	}
+
+	return fmt.Sprintf("QueryOrderAmountResponse{%s}", ret)
+}
+
+func (o QueryOrderAmountResponse) Clone() *QueryOrderAmountResponse {
+	ret := QueryOrderAmountResponse{}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.UnsplitAmount != nil {
+		ret.UnsplitAmount = new(int64)
+		*ret.UnsplitAmount = *o.UnsplitAmount
+	}
+
+	return &ret
+}
+
+// QueryOrderRequest
+type QueryOrderRequest struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户系统内部的分账单号，在商户系统内部唯一，同一分账单号多次请求等同一次。只能是数字、大小写字母_-|*@
+	OutOrderNo *string `json:"out_order_no"`
+}
+
+func (o QueryOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in QueryOrderRequest")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in QueryOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryOrderRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v", *o.OutOrderNo)
+	}
+
+	return fmt.Sprintf("QueryOrderRequest{%s}", ret)
+}
+
+func (o QueryOrderRequest) Clone() *QueryOrderRequest {
+	ret := QueryOrderRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	return &ret
+}
+
+// ReceiverRelationType * `SERVICE_PROVIDER` - 服务商 * `STORE` - 门店 * `STAFF` - 员工 * `STORE_OWNER` - 店主 * `PARTNER` - 合作伙伴 * `HEADQUARTER` - 总部 * `BRAND` - 品牌方 * `DISTRIBUTOR` - 分销商 * `USER` - 用户 * `SUPPLIER` - 供应商 * `CUSTOM` - 自定义
+type ReceiverRelationType string
+
+func (e ReceiverRelationType) Ptr() *ReceiverRelationType {
+	return &e
+}
+
+// Enums of ReceiverRelationType
+const (
+	RECEIVERRELATIONTYPE_SERVICE_PROVIDER ReceiverRelationType = "SERVICE_PROVIDER"
+	RECEIVERRELATIONTYPE_STORE            ReceiverRelationType = "STORE"
+	RECEIVERRELATIONTYPE_STAFF            ReceiverRelationType = "STAFF"
+	RECEIVERRELATIONTYPE_STORE_OWNER      ReceiverRelationType = "STORE_OWNER"
+	RECEIVERRELATIONTYPE_PARTNER          ReceiverRelationType = "PARTNER"
+	RECEIVERRELATIONTYPE_HEADQUARTER      ReceiverRelationType = "HEADQUARTER"
+	RECEIVERRELATIONTYPE_BRAND            ReceiverRelationType = "BRAND"
+	RECEIVERRELATIONTYPE_DISTRIBUTOR      ReceiverRelationType = "DISTRIBUTOR"
+	RECEIVERRELATIONTYPE_USER             ReceiverRelationType = "USER"
+	RECEIVERRELATIONTYPE_SUPPLIER         ReceiverRelationType = "SUPPLIER"
+	RECEIVERRELATIONTYPE_CUSTOM           ReceiverRelationType = "CUSTOM"
+)
+
+func (v *ReceiverRelationType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ReceiverRelationType(value)
+	for _, existing := range []ReceiverRelationType{"SERVICE_PROVIDER", "STORE", "STAFF", "STORE_OWNER", "PARTNER", "HEADQUARTER", "BRAND", "DISTRIBUTOR", "USER", "SUPPLIER", "CUSTOM"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ReceiverRelationType", value)
+}
+
+// ReceiverType * `MERCHANT_ID` - 商户号 * `PERSONAL_OPENID` - 个人openid（由父商户APPID转换得到） * `PERSONAL_SUB_OPENID` - 个人sub_openid（由子商户APPID转换得到）
+type ReceiverType string
+
+func (e ReceiverType) Ptr() *ReceiverType {
+	return &e
+}
+
+// Enums of ReceiverType
+const (
+	RECEIVERTYPE_MERCHANT_ID         ReceiverType = "MERCHANT_ID"
+	RECEIVERTYPE_PERSONAL_OPENID     ReceiverType = "PERSONAL_OPENID"
+	RECEIVERTYPE_PERSONAL_SUB_OPENID ReceiverType = "PERSONAL_SUB_OPENID"
+)
+
+func (v *ReceiverType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ReceiverType(value)
+	for _, existing := range []ReceiverType{"MERCHANT_ID", "PERSONAL_OPENID", "PERSONAL_SUB_OPENID"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ReceiverType", value)
+}
+
+// UnfreezeOrderRequest
+type UnfreezeOrderRequest struct {
+	// 微信支付分配的子商户号，即分账的出资商户号。服务商模式下必须传递此参数
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户系统内部的分账单号，在商户系统内部唯一，同一分账单号多次请求等同一次。只能是数字、大小写字母_-|*@
+	OutOrderNo *string `json:"out_order_no"`
+	// 分账的原因描述，分账账单中需要体现
+	Description *string `json:"description"`
+}
+
+func (o UnfreezeOrderRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in UnfreezeOrderRequest")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutOrderNo == nil {
+		return nil, fmt.Errorf("field `OutOrderNo` is required and must be specified in UnfreezeOrderRequest")
+	}
+	toSerialize["out_order_no"] = o.OutOrderNo
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in UnfreezeOrderRequest")
+	}
+	toSerialize["description"] = o.Description
+	return json.Marshal(toSerialize)
+}
+
+func (o UnfreezeOrderRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutOrderNo == nil {
+		ret += "OutOrderNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutOrderNo:%v, ", *o.OutOrderNo)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>"
+	} else {
+		ret += fmt.Sprintf("Description:%v", *o.Description)
+	}
+
+	return fmt.Sprintf("UnfreezeOrderRequest{%s}", ret)
+}
+
+func (o UnfreezeOrderRequest) Clone() *UnfreezeOrderRequest {
+	ret := UnfreezeOrderRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutOrderNo != nil {
+		ret.OutOrderNo = new(string)
+		*ret.OutOrderNo = *o.OutOrderNo
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	return &ret
+}
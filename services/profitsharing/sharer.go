@@ -0,0 +1,222 @@
+package profitsharing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+const (
+	// DefaultMaxRatio 直连商户的最大分账比例，单位万分比，即 30%
+	DefaultMaxRatio int64 = 3000
+	// ratioBase 分账比例的基数，分账比例单位为万分比
+	ratioBase int64 = 10000
+)
+
+// RatioExceededError 累计分账金额超过最大分账比例
+type RatioExceededError struct {
+	// TransactionId 微信支付订单号
+	TransactionId string
+	// TotalAmount 订单金额
+	TotalAmount int64
+	// MaxRatio 最大分账比例，单位万分比
+	MaxRatio int64
+	// SharedAmount 已分账（或已解冻）的金额
+	SharedAmount int64
+	// RequestedAmount 本次请求的分账金额
+	RequestedAmount int64
+}
+
+// AllowedAmount 按最大分账比例计算，本次最多可分账的金额
+func (e *RatioExceededError) AllowedAmount() int64 {
+	allowed := e.TotalAmount*e.MaxRatio/ratioBase - e.SharedAmount
+	if allowed < 0 {
+		return 0
+	}
+	return allowed
+}
+
+func (e *RatioExceededError) Error() string {
+	return fmt.Sprintf(
+		"profit sharing amount %d of transaction %s exceeds the allowed amount %d "+
+			"(max ratio %d/%d of total %d, already shared %d)",
+		e.RequestedAmount, e.TransactionId, e.AllowedAmount(), e.MaxRatio, ratioBase, e.TotalAmount, e.SharedAmount,
+	)
+}
+
+// Sharer 分账请求编排器
+//
+// 添加分账接收方与请求分账时，自动使用 Client 的 cipher 加密接收方姓名并设置 Wechatpay-Serial 请求头；
+// 请求分账前查询订单剩余待分金额与最大分账比例，在本地校验分账金额，避免请求被微信支付以 RATIO_EXCEED 等错误拒绝。
+type Sharer struct {
+	client          *core.Client
+	receivers       ReceiversApiService
+	orders          OrdersApiService
+	transactions    TransactionsApiService
+	merchants       MerchantsApiService
+	defaultMaxRatio int64
+}
+
+// SharerOption Sharer 的配置项
+type SharerOption func(s *Sharer)
+
+// WithDefaultMaxRatio 设置未指定子商户号（直连商户）时使用的最大分账比例，单位万分比，默认为 DefaultMaxRatio
+func WithDefaultMaxRatio(maxRatio int64) SharerOption {
+	return func(s *Sharer) {
+		if maxRatio > 0 && maxRatio <= ratioBase {
+			s.defaultMaxRatio = maxRatio
+		}
+	}
+}
+
+// NewSharer 创建 Sharer
+func NewSharer(client *core.Client, opts ...SharerOption) *Sharer {
+	s := &Sharer{
+		client:          client,
+		receivers:       ReceiversApiService{Client: client},
+		orders:          OrdersApiService{Client: client},
+		transactions:    TransactionsApiService{Client: client},
+		merchants:       MerchantsApiService{Client: client},
+		defaultMaxRatio: DefaultMaxRatio,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// AddReceiver 添加分账接收方。req 中的 Name 为明文时将被加密，应答中的 Name 将被解密
+func (s *Sharer) AddReceiver(
+	ctx context.Context, req AddReceiverRequest,
+) (resp *AddReceiverResponse, result *core.APIResult, err error) {
+	req = *req.Clone()
+	if req.WechatpaySerial == nil && req.Name != nil && *req.Name != "" {
+		if req.WechatpaySerial, err = s.encrypt(ctx, &req); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	resp, result, err = s.receivers.AddReceiver(ctx, req)
+	if err != nil {
+		return nil, result, err
+	}
+	if err = s.client.DecryptResponse(ctx, resp); err != nil {
+		return nil, result, fmt.Errorf("decrypt response err: %v", err)
+	}
+	return resp, result, nil
+}
+
+// CreateOrder 请求分账
+//
+// totalAmount 为订单金额（单位为分），用于按最大分账比例校验分账金额，校验不通过时返回 *RatioExceededError，不会发起请求。
+// req 中分账接收方的 Name 为明文时将被加密。
+func (s *Sharer) CreateOrder(
+	ctx context.Context, req CreateOrderRequest, totalAmount int64,
+) (resp *OrdersEntity, result *core.APIResult, err error) {
+	if err = s.CheckRatio(ctx, req, totalAmount); err != nil {
+		return nil, nil, err
+	}
+
+	req = *req.Clone()
+	if req.WechatpaySerial == nil && hasReceiverName(req.Receivers) {
+		if req.WechatpaySerial, err = s.encrypt(ctx, &req); err != nil {
+			return nil, nil, err
+		}
+	}
+	return s.orders.CreateOrder(ctx, req)
+}
+
+// CheckRatio 查询订单剩余待分金额与最大分账比例，校验 req 的分账金额
+//
+// 指定了子商户号时查询子商户设置的最大分账比例，否则使用 WithDefaultMaxRatio 设置的比例。
+// totalAmount 小于剩余待分金额，或分账金额超过剩余待分金额、最大分账比例时返回错误，最后一种情况为 *RatioExceededError。
+func (s *Sharer) CheckRatio(ctx context.Context, req CreateOrderRequest, totalAmount int64) error {
+	if req.TransactionId == nil || *req.TransactionId == "" {
+		return fmt.Errorf("field `TransactionId` is required and must be specified in CreateOrderRequest")
+	}
+	if totalAmount <= 0 {
+		return fmt.Errorf("total amount %d must be positive", totalAmount)
+	}
+	var requested int64
+	for i, receiver := range req.Receivers {
+		if receiver.Amount == nil || *receiver.Amount <= 0 {
+			return fmt.Errorf("amount of receiver %d must be positive", i)
+		}
+		requested += *receiver.Amount
+	}
+
+	amount, _, err := s.transactions.QueryOrderAmount(ctx, QueryOrderAmountRequest{TransactionId: req.TransactionId})
+	if err != nil {
+		return fmt.Errorf("query unsplit amount of transaction %s err: %w", *req.TransactionId, err)
+	}
+	if amount.UnsplitAmount == nil {
+		return fmt.Errorf("query unsplit amount of transaction %s err: unsplit_amount is missing in response", *req.TransactionId)
+	}
+	unsplit := *amount.UnsplitAmount
+	if totalAmount < unsplit {
+		// 订单金额小于剩余待分金额时无法得出已分账金额，通常是传入了错误的订单金额（如子单金额或以元为单位的金额）
+		return fmt.Errorf("total amount %d of transaction %s is less than the unsplit amount %d",
+			totalAmount, *req.TransactionId, unsplit)
+	}
+	if requested > unsplit {
+		return fmt.Errorf("profit sharing amount %d of transaction %s exceeds the unsplit amount %d",
+			requested, *req.TransactionId, unsplit)
+	}
+
+	subMchid := ""
+	if req.SubMchid != nil {
+		subMchid = *req.SubMchid
+	}
+	maxRatio, err := s.MaxRatio(ctx, subMchid)
+	if err != nil {
+		return err
+	}
+	ratioErr := &RatioExceededError{
+		TransactionId:   *req.TransactionId,
+		TotalAmount:     totalAmount,
+		MaxRatio:        maxRatio,
+		SharedAmount:    totalAmount - unsplit,
+		RequestedAmount: requested,
+	}
+	if requested > ratioErr.AllowedAmount() {
+		return ratioErr
+	}
+	return nil
+}
+
+// MaxRatio 返回最大分账比例，单位万分比。subMchid 为空时返回 WithDefaultMaxRatio 设置的比例
+func (s *Sharer) MaxRatio(ctx context.Context, subMchid string) (int64, error) {
+	if subMchid == "" {
+		return s.defaultMaxRatio, nil
+	}
+	resp, _, err := s.merchants.QueryMerchantRatio(ctx, QueryMerchantRatioRequest{SubMchid: core.String(subMchid)})
+	if err != nil {
+		return 0, fmt.Errorf("query max ratio of sub merchant %s err: %w", subMchid, err)
+	}
+	if resp.MaxRatio == nil {
+		return 0, fmt.Errorf("query max ratio of sub merchant %s err: max_ratio is missing in response", subMchid)
+	}
+	return *resp.MaxRatio, nil
+}
+
+// encrypt 加密请求中的敏感信息，返回加密所用的平台证书序列号。未设置 cipher 时返回错误，避免明文姓名被发送
+func (s *Sharer) encrypt(ctx context.Context, req interface{}) (*string, error) {
+	serial, err := s.client.EncryptRequest(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt receiver name err: %v", err)
+	}
+	if serial == "" {
+		return nil, fmt.Errorf("encrypt receiver name err: cipher is not configured in client")
+	}
+	return core.String(serial), nil
+}
+
+func hasReceiverName(receivers []CreateOrderReceiver) bool {
+	for _, receiver := range receivers {
+		if receiver.Name != nil && *receiver.Name != "" {
+			return true
+		}
+	}
+	return false
+}
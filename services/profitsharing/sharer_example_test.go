@@ -0,0 +1,62 @@
+package profitsharing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/profitsharing"
+)
+
+func ExampleSharer_CreateOrder() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client，并设置了敏感信息加解密所用的 cipher
+
+	sharer := profitsharing.NewSharer(client)
+	resp, result, err := sharer.CreateOrder(ctx,
+		profitsharing.CreateOrderRequest{
+			SubMchid:      core.String("1900000109"),
+			Appid:         core.String("wx8888888888888888"),
+			TransactionId: core.String("4208450740201411110007820472"),
+			OutOrderNo:    core.String("P20150806125346"),
+			Receivers: []profitsharing.CreateOrderReceiver{{
+				Type:        core.String("MERCHANT_ID"),
+				Account:     core.String("86693852"),
+				Name:        core.String("示例商户全称"), // 明文，将被自动加密
+				Amount:      core.Int64(888),
+				Description: core.String("分给商户A"),
+			}},
+			UnfreezeUnsplit: core.Bool(true),
+		},
+		10000, // 订单金额
+	)
+
+	var ratioErr *profitsharing.RatioExceededError
+	if errors.As(err, &ratioErr) {
+		log.Printf("at most %d can be shared", ratioErr.AllowedAmount())
+		return
+	}
+
+	// TODO: 处理返回结果
+	_, _ = resp, result
+}
+
+func ExampleRatioExceededError() {
+	err := &profitsharing.RatioExceededError{
+		TransactionId:   "4208450740201411110007820472",
+		TotalAmount:     10000,
+		MaxRatio:        2000,
+		SharedAmount:    1500,
+		RequestedAmount: 888,
+	}
+	fmt.Println(err.AllowedAmount())
+	fmt.Println(err)
+	// Output:
+	// 500
+	// profit sharing amount 888 of transaction 4208450740201411110007820472 exceeds the allowed amount 500 (max ratio 2000/10000 of total 10000, already shared 1500)
+}
@@ -0,0 +1,229 @@
+package profitsharing_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/profitsharing"
+)
+
+const testTransactionId = "4208450740201411110007820472"
+
+func createOrderRequest(subMchid string, amounts ...int64) profitsharing.CreateOrderRequest {
+	req := profitsharing.CreateOrderRequest{
+		Appid:           core.String("wx8888888888888888"),
+		TransactionId:   core.String(testTransactionId),
+		OutOrderNo:      core.String("P20150806125346"),
+		UnfreezeUnsplit: core.Bool(true),
+	}
+	if subMchid != "" {
+		req.SubMchid = core.String(subMchid)
+	}
+	for _, amount := range amounts {
+		req.Receivers = append(req.Receivers, profitsharing.CreateOrderReceiver{
+			Type:        core.String("MERCHANT_ID"),
+			Account:     core.String("86693852"),
+			Amount:      core.Int64(amount),
+			Description: core.String("分给商户A"),
+		})
+	}
+	return req
+}
+
+// fakeSharingServer 应答剩余待分金额查询、子商户最大分账比例查询与请求分账，并记录收到的请求
+type fakeSharingServer struct {
+	lock      sync.Mutex
+	unsplit   int64
+	maxRatios map[string]int64
+	requests  []string
+}
+
+func (s *fakeSharingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.lock.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v3/profitsharing/transactions/"):
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"transaction_id": testTransactionId,
+			"unsplit_amount": s.unsplit,
+		})
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v3/profitsharing/merchant-configs/"):
+		subMchid := strings.TrimPrefix(r.URL.Path, "/v3/profitsharing/merchant-configs/")
+		maxRatio, ok := s.maxRatios[subMchid]
+		if !ok {
+			clienttest.WriteError(w, http.StatusNotFound, "RESOURCE_NOT_EXISTS", "子商户未开通分账")
+			return
+		}
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{"sub_mchid": subMchid, "max_ratio": maxRatio})
+	case r.Method == http.MethodPost && r.URL.Path == "/v3/profitsharing/orders":
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		clienttest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"transaction_id": body["transaction_id"],
+			"out_order_no":   body["out_order_no"],
+			"order_id":       "3008450740201411110007820472",
+			"state":          "PROCESSING",
+		})
+	default:
+		clienttest.WriteError(w, http.StatusNotFound, "NOT_FOUND", "接口不存在")
+	}
+}
+
+func (s *fakeSharingServer) received() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func newTestSharer(
+	t *testing.T, server *fakeSharingServer, opts ...profitsharing.SharerOption,
+) *profitsharing.Sharer {
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	return profitsharing.NewSharer(client, opts...)
+}
+
+func TestSharer_CreateOrder(t *testing.T) {
+	server := &fakeSharingServer{unsplit: 10000}
+	sharer := newTestSharer(t, server)
+
+	resp, _, err := sharer.CreateOrder(context.Background(), createOrderRequest("", 1000, 2000), 10000)
+	require.NoError(t, err)
+	assert.Equal(t, "3008450740201411110007820472", *resp.OrderId)
+	assert.Equal(t, []string{
+		"GET /v3/profitsharing/transactions/" + testTransactionId + "/amounts",
+		"POST /v3/profitsharing/orders",
+	}, server.received())
+}
+
+func TestSharer_CreateOrderExceedsUnsplit(t *testing.T) {
+	server := &fakeSharingServer{unsplit: 500}
+	sharer := newTestSharer(t, server)
+
+	_, _, err := sharer.CreateOrder(context.Background(), createOrderRequest("", 300, 201), 1000)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds the unsplit amount 500")
+	var ratioErr *profitsharing.RatioExceededError
+	assert.False(t, errors.As(err, &ratioErr))
+	// 校验不通过时不会请求分账
+	assert.Len(t, server.received(), 1)
+}
+
+func TestSharer_CreateOrderRatioExceeded(t *testing.T) {
+	// 订单金额 10000，已分账 1500，默认最大分账比例 30%，本次最多可分 1500
+	server := &fakeSharingServer{unsplit: 8500}
+	sharer := newTestSharer(t, server)
+
+	_, _, err := sharer.CreateOrder(context.Background(), createOrderRequest("", 1000, 501), 10000)
+	require.Error(t, err)
+	var ratioErr *profitsharing.RatioExceededError
+	require.True(t, errors.As(err, &ratioErr))
+	assert.Equal(t, testTransactionId, ratioErr.TransactionId)
+	assert.Equal(t, int64(10000), ratioErr.TotalAmount)
+	assert.Equal(t, profitsharing.DefaultMaxRatio, ratioErr.MaxRatio)
+	assert.Equal(t, int64(1500), ratioErr.SharedAmount)
+	assert.Equal(t, int64(1501), ratioErr.RequestedAmount)
+	assert.Equal(t, int64(1500), ratioErr.AllowedAmount())
+	assert.Len(t, server.received(), 1)
+
+	// 恰好等于允许的金额时通过校验
+	_, _, err = sharer.CreateOrder(context.Background(), createOrderRequest("", 1000, 500), 10000)
+	require.NoError(t, err)
+}
+
+func TestSharer_CreateOrderWithDefaultMaxRatio(t *testing.T) {
+	server := &fakeSharingServer{unsplit: 10000}
+	sharer := newTestSharer(t, server, profitsharing.WithDefaultMaxRatio(1000))
+
+	err := sharer.CheckRatio(context.Background(), createOrderRequest("", 1001), 10000)
+	var ratioErr *profitsharing.RatioExceededError
+	require.True(t, errors.As(err, &ratioErr))
+	assert.Equal(t, int64(1000), ratioErr.AllowedAmount())
+}
+
+func TestSharer_CheckRatioSubMerchant(t *testing.T) {
+	server := &fakeSharingServer{unsplit: 10000, maxRatios: map[string]int64{"1900000109": 2000}}
+	sharer := newTestSharer(t, server)
+	ctx := context.Background()
+
+	// 子商户的最大分账比例为 20%，而不是默认的 30%
+	err := sharer.CheckRatio(ctx, createOrderRequest("1900000109", 2001), 10000)
+	var ratioErr *profitsharing.RatioExceededError
+	require.True(t, errors.As(err, &ratioErr))
+	assert.Equal(t, int64(2000), ratioErr.MaxRatio)
+	assert.Equal(t, int64(2000), ratioErr.AllowedAmount())
+	assert.Equal(t, []string{
+		"GET /v3/profitsharing/transactions/" + testTransactionId + "/amounts",
+		"GET /v3/profitsharing/merchant-configs/1900000109",
+	}, server.received())
+
+	require.NoError(t, sharer.CheckRatio(ctx, createOrderRequest("1900000109", 2000), 10000))
+}
+
+func TestSharer_MaxRatio(t *testing.T) {
+	server := &fakeSharingServer{maxRatios: map[string]int64{"1900000109": 2000}}
+	sharer := newTestSharer(t, server, profitsharing.WithDefaultMaxRatio(2500))
+	ctx := context.Background()
+
+	maxRatio, err := sharer.MaxRatio(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2500), maxRatio)
+	assert.Empty(t, server.received())
+
+	maxRatio, err = sharer.MaxRatio(ctx, "1900000109")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), maxRatio)
+
+	_, err = sharer.MaxRatio(ctx, "1900000110")
+	require.Error(t, err)
+	var apiErr *core.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "RESOURCE_NOT_EXISTS", apiErr.Code)
+}
+
+func TestSharer_CheckRatioTotalLessThanUnsplit(t *testing.T) {
+	server := &fakeSharingServer{unsplit: 10000}
+	sharer := newTestSharer(t, server)
+
+	// 订单金额小于剩余待分金额时，已分账金额为负数，不能据此计算允许的分账金额
+	err := sharer.CheckRatio(context.Background(), createOrderRequest("", 100), 1000)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "less than the unsplit amount 10000")
+	var ratioErr *profitsharing.RatioExceededError
+	assert.False(t, errors.As(err, &ratioErr))
+}
+
+func TestSharer_CheckRatioInvalidRequest(t *testing.T) {
+	server := &fakeSharingServer{unsplit: 10000}
+	sharer := newTestSharer(t, server)
+	ctx := context.Background()
+
+	noTransaction := createOrderRequest("", 100)
+	noTransaction.TransactionId = nil
+	for _, tt := range []struct {
+		name        string
+		req         profitsharing.CreateOrderRequest
+		totalAmount int64
+	}{
+		{name: "missing transaction id", req: noTransaction, totalAmount: 10000},
+		{name: "non-positive total amount", req: createOrderRequest("", 100), totalAmount: 0},
+		{name: "non-positive receiver amount", req: createOrderRequest("", 100, 0), totalAmount: 10000},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, sharer.CheckRatio(ctx, tt.req, tt.totalAmount))
+		})
+	}
+	assert.Empty(t, server.received())
+}
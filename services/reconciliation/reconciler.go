@@ -0,0 +1,222 @@
+// Package reconciliation 微信支付 API v3 Go SDK 交易对账
+//
+// Reconcile 将一天的交易账单与商户系统中同一天的订单逐笔核对，输出本地缺失、微信支付缺失、金额不一致与状态不一致的差异。
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/bill"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+)
+
+// DiffType 对账差异类型
+type DiffType int
+
+// DiffType 可能枚举
+const (
+	// DiffMissingLocally 账单中支付成功的订单在商户系统中不存在（掉单）
+	DiffMissingLocally DiffType = iota + 1
+	// DiffMissingRemotely 商户系统中已支付的订单在账单中不存在
+	DiffMissingRemotely
+	// DiffAmountMismatch 订单金额不一致
+	DiffAmountMismatch
+	// DiffStateMismatch 账单中支付成功的订单在商户系统中未处于已支付状态（如未收到支付成功通知）
+	DiffStateMismatch
+)
+
+func (t DiffType) String() string {
+	switch t {
+	case DiffMissingLocally:
+		return "missing-locally"
+	case DiffMissingRemotely:
+		return "missing-remotely"
+	case DiffAmountMismatch:
+		return "amount-mismatch"
+	case DiffStateMismatch:
+		return "state-mismatch"
+	default:
+		return fmt.Sprintf("DiffType(%d)", int(t))
+	}
+}
+
+// LocalOrder 商户系统中的订单
+type LocalOrder struct {
+	// SubMchid 特约商户号，服务商模式下必填，直连商户留空。不同特约商户的商户订单号可能相同，因此按特约商户号与商户订单号核对
+	SubMchid string
+	// OutTradeNo 商户订单号
+	OutTradeNo string
+	// Amount 订单金额，单位为分
+	Amount int64
+	// State 订单的交易状态，取值同 payments.Transaction 的 TradeState，如 payments.TradeStateSuccess。
+	// SUCCESS 与 REFUND 视为已支付
+	State string
+}
+
+// paid 判断订单在商户系统中是否已支付
+func (o *LocalOrder) paid() bool {
+	return o.State == payments.TradeStateSuccess || o.State == payments.TradeStateRefund
+}
+
+// LocalOrderIterator 遍历商户系统中的订单，通常按账单日期从数据库中分页读取
+type LocalOrderIterator interface {
+	// Next 返回下一笔订单，遍历结束时返回 io.EOF
+	Next(ctx context.Context) (*LocalOrder, error)
+}
+
+// SliceIterator 遍历内存中的订单
+type SliceIterator struct {
+	orders []LocalOrder
+	index  int
+}
+
+// NewSliceIterator 使用订单列表创建 SliceIterator
+func NewSliceIterator(orders []LocalOrder) *SliceIterator {
+	return &SliceIterator{orders: orders}
+}
+
+// Next 返回下一笔订单
+func (it *SliceIterator) Next(_ context.Context) (*LocalOrder, error) {
+	if it.index >= len(it.orders) {
+		return nil, io.EOF
+	}
+	order := &it.orders[it.index]
+	it.index++
+	return order, nil
+}
+
+// orderKey 对账时订单的唯一标识
+type orderKey struct {
+	subMchid   string
+	outTradeNo string
+}
+
+// remoteKey 返回账单记录的订单标识，直连商户账单的特约商户号为 0
+func remoteKey(record *bill.TradeBillRecord) orderKey {
+	subMchid := record.SubMchid
+	if subMchid == "0" {
+		subMchid = ""
+	}
+	return orderKey{subMchid: subMchid, outTradeNo: record.OutTradeNo}
+}
+
+// Diff 一笔对账差异
+type Diff struct {
+	// Type 差异类型
+	Type DiffType
+	// SubMchid 特约商户号，直连商户为空
+	SubMchid string
+	// OutTradeNo 商户订单号
+	OutTradeNo string
+	// Local 商户系统中的订单，DiffMissingLocally 时为 nil
+	Local *LocalOrder
+	// Remote 账单中的支付记录，DiffMissingRemotely 时为 nil
+	Remote *bill.TradeBillRecord
+}
+
+// Report 对账结果
+type Report struct {
+	// Diffs 对账差异，依次为商户系统订单的遍历顺序，以及账单中本地缺失的订单在账单中的顺序
+	Diffs []Diff
+	// Matched 核对一致的商户系统订单数，包括未支付且不在账单中的订单
+	Matched int
+	// RemoteCount 账单中支付成功的订单数
+	RemoteCount int
+	// LocalCount 商户系统中的订单数
+	LocalCount int
+	// Summary 账单的汇总信息，账单中不包含汇总信息时为 nil
+	Summary *bill.TradeBillSummary
+}
+
+// Reconcile 核对交易账单与商户系统中的订单
+//
+// tradeBill 为 bill_type 为 ALL 或 SUCCESS 的交易账单内容，其中支付成功（交易状态为 SUCCESS）的记录参与对账，
+// 退款记录可能对应其他日期的订单，不参与对账。local 应遍历账单日期当天下单的订单：商户系统中未支付的订单不在账单中时不视为差异。
+//
+// 服务商的账单包含多个特约商户的订单，不同特约商户的商户订单号可能相同，因此订单按特约商户号与商户订单号核对，
+// 服务商模式下 LocalOrder.SubMchid 必填。
+//
+// 订单金额优先使用账单中的订单金额，账单中不包含订单金额列时使用应结订单金额。
+func Reconcile(ctx context.Context, tradeBill io.Reader, local LocalOrderIterator) (*Report, error) {
+	reader := bill.NewTradeBillReader(tradeBill)
+	report := &Report{}
+
+	var remoteOrders []*bill.TradeBillRecord
+	remotes := make(map[orderKey]*bill.TradeBillRecord)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if record.TradeState != payments.TradeStateSuccess {
+			continue
+		}
+		key := remoteKey(record)
+		if _, ok := remotes[key]; ok {
+			return nil, fmt.Errorf("duplicated out trade no %s of sub mchid %s in trade bill", key.outTradeNo, key.subMchid)
+		}
+		remotes[key] = record
+		remoteOrders = append(remoteOrders, record)
+	}
+	report.RemoteCount = len(remoteOrders)
+	report.Summary = reader.Summary()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		order, err := local.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("iterate local orders err:%w", err)
+		}
+		report.LocalCount++
+
+		key := orderKey{subMchid: order.SubMchid, outTradeNo: order.OutTradeNo}
+		remote, ok := remotes[key]
+		if ok {
+			delete(remotes, key)
+		}
+		switch {
+		case !ok && order.paid():
+			report.add(DiffMissingRemotely, key, order, nil)
+		case !ok:
+			// 未支付的订单不在账单中
+			report.Matched++
+		case !order.paid():
+			report.add(DiffStateMismatch, key, order, remote)
+		case order.Amount != amountOf(remote):
+			report.add(DiffAmountMismatch, key, order, remote)
+		default:
+			report.Matched++
+		}
+	}
+
+	for _, remote := range remoteOrders {
+		if key := remoteKey(remote); remotes[key] != nil {
+			report.add(DiffMissingLocally, key, nil, remote)
+		}
+	}
+	return report, nil
+}
+
+func (r *Report) add(diffType DiffType, key orderKey, local *LocalOrder, remote *bill.TradeBillRecord) {
+	r.Diffs = append(r.Diffs, Diff{
+		Type: diffType, SubMchid: key.subMchid, OutTradeNo: key.outTradeNo, Local: local, Remote: remote,
+	})
+}
+
+// amountOf 返回账单记录的订单金额
+func amountOf(record *bill.TradeBillRecord) int64 {
+	if record.TotalAmount != 0 {
+		return record.TotalAmount
+	}
+	return record.SettlementTotalAmount
+}
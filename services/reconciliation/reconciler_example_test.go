@@ -0,0 +1,46 @@
+package reconciliation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/reconciliation"
+)
+
+const tradeBill = "交易时间,公众账号ID,商户号,特约商户号,设备号,微信订单号,商户订单号,用户标识,交易类型,交易状态,付款银行,货币种类,应结订单金额,代金券金额,微信退款单号,商户退款单号,退款金额,充值券退款金额,退款类型,退款状态,商品名称,商户数据包,手续费,费率,订单金额,申请退款金额,费率备注\n" +
+	"`2021-07-01 10:00:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200001,`ORDER1,`openid,`JSAPI,`SUCCESS,`OTHERS,`CNY,`10.00,`0.00,`0,`0,`0.00,`0.00,`,`,`商品,`,`0.06000,`0.60%,`10.00,`0.00,`\n" +
+	"`2021-07-01 10:01:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200002,`ORDER2,`openid,`JSAPI,`SUCCESS,`OTHERS,`CNY,`20.00,`0.00,`0,`0,`0.00,`0.00,`,`,`商品,`,`0.12000,`0.60%,`20.00,`0.00,`\n" +
+	"`2021-07-01 10:02:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200003,`ORDER3,`openid,`JSAPI,`SUCCESS,`OTHERS,`CNY,`30.00,`0.00,`0,`0,`0.00,`0.00,`,`,`商品,`,`0.18000,`0.60%,`30.00,`0.00,`\n" +
+	"`2021-07-01 10:03:00,`wxd678efh567hg6787,`1230000109,`0,`,`4200004,`ORDER4,`openid,`JSAPI,`SUCCESS,`OTHERS,`CNY,`40.00,`0.00,`0,`0,`0.00,`0.00,`,`,`商品,`,`0.24000,`0.60%,`40.00,`0.00,`\n" +
+	"总交易单数,应结订单总金额,退款总金额,充值券退款总金额,手续费总金额,订单总金额,申请退款总金额\n" +
+	"`4,`100.00,`0.00,`0.00,`0.60000,`100.00,`0.00\n"
+
+func ExampleReconcile() {
+	ctx := context.Background()
+	// 通常按账单日期从数据库中分页读取，这里使用内存中的订单
+	local := reconciliation.NewSliceIterator([]reconciliation.LocalOrder{
+		{OutTradeNo: "ORDER1", Amount: 1000, State: payments.TradeStateSuccess},
+		{OutTradeNo: "ORDER2", Amount: 2100, State: payments.TradeStateSuccess},
+		{OutTradeNo: "ORDER3", Amount: 3000, State: payments.TradeStateNotPay},
+		{OutTradeNo: "ORDER5", Amount: 5000, State: payments.TradeStateSuccess},
+		{OutTradeNo: "ORDER6", Amount: 6000, State: payments.TradeStateClosed},
+	})
+
+	report, err := reconciliation.Reconcile(ctx, strings.NewReader(tradeBill), local)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, diff := range report.Diffs {
+		fmt.Println(diff.Type, diff.OutTradeNo)
+	}
+	fmt.Println(report.Matched, report.LocalCount, report.RemoteCount)
+	// Output:
+	// amount-mismatch ORDER2
+	// state-mismatch ORDER3
+	// missing-remotely ORDER5
+	// missing-locally ORDER4
+	// 2 5 4
+}
@@ -0,0 +1,201 @@
+package reconciliation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/payments"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/reconciliation"
+)
+
+const tradeBillHeader = "交易时间,公众账号ID,商户号,特约商户号,设备号,微信订单号,商户订单号,用户标识,交易类型,交易状态,付款银行,货币种类,应结订单金额,代金券金额,微信退款单号,商户退款单号,退款金额,充值券退款金额,退款类型,退款状态,商品名称,商户数据包,手续费,费率,订单金额,申请退款金额,费率备注\n"
+
+// billRow 生成一行交易账单记录，amount 为以元为单位的订单金额
+func billRow(subMchid, outTradeNo, state, amount string) string {
+	return fmt.Sprintf("`2021-07-01 10:00:00,`wxd678efh567hg6787,`1230000109,`%s,`,`42%s,`%s,`openid,`JSAPI,`%s,"+
+		"`OTHERS,`CNY,`%s,`0.00,`0,`0,`0.00,`0.00,`,`,`商品,`,`0.06000,`0.60%%,`%s,`0.00,`\n",
+		subMchid, outTradeNo, outTradeNo, state, amount, amount)
+}
+
+func tradeBillOf(rows ...string) string {
+	return tradeBillHeader + strings.Join(rows, "")
+}
+
+type diffResult struct {
+	Type       reconciliation.DiffType
+	SubMchid   string
+	OutTradeNo string
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name    string
+		bill    string
+		local   []reconciliation.LocalOrder
+		diffs   []diffResult
+		matched int
+		remote  int
+	}{
+		{
+			name: "all matched",
+			bill: tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00"), billRow("0", "ORDER2", "SUCCESS", "20.00")),
+			local: []reconciliation.LocalOrder{
+				{OutTradeNo: "ORDER1", Amount: 1000, State: payments.TradeStateSuccess},
+				{OutTradeNo: "ORDER2", Amount: 2000, State: payments.TradeStateRefund},
+			},
+			matched: 2,
+			remote:  2,
+		},
+		{
+			name: "missing locally",
+			bill: tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00"), billRow("0", "ORDER2", "SUCCESS", "20.00")),
+			local: []reconciliation.LocalOrder{
+				{OutTradeNo: "ORDER1", Amount: 1000, State: payments.TradeStateSuccess},
+			},
+			diffs:   []diffResult{{Type: reconciliation.DiffMissingLocally, OutTradeNo: "ORDER2"}},
+			matched: 1,
+			remote:  2,
+		},
+		{
+			name: "missing remotely",
+			bill: tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00")),
+			local: []reconciliation.LocalOrder{
+				{OutTradeNo: "ORDER1", Amount: 1000, State: payments.TradeStateSuccess},
+				{OutTradeNo: "ORDER2", Amount: 2000, State: payments.TradeStateSuccess},
+				// 未支付且不在账单中的订单不是差异
+				{OutTradeNo: "ORDER3", Amount: 3000, State: payments.TradeStateNotPay},
+			},
+			diffs:   []diffResult{{Type: reconciliation.DiffMissingRemotely, OutTradeNo: "ORDER2"}},
+			matched: 2,
+			remote:  1,
+		},
+		{
+			name: "amount mismatch",
+			bill: tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00")),
+			local: []reconciliation.LocalOrder{
+				{OutTradeNo: "ORDER1", Amount: 1001, State: payments.TradeStateSuccess},
+			},
+			diffs:  []diffResult{{Type: reconciliation.DiffAmountMismatch, OutTradeNo: "ORDER1"}},
+			remote: 1,
+		},
+		{
+			name: "state mismatch",
+			bill: tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00")),
+			local: []reconciliation.LocalOrder{
+				{OutTradeNo: "ORDER1", Amount: 1000, State: payments.TradeStateNotPay},
+			},
+			diffs:  []diffResult{{Type: reconciliation.DiffStateMismatch, OutTradeNo: "ORDER1"}},
+			remote: 1,
+		},
+		{
+			name: "refund records are skipped",
+			bill: tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00"), billRow("0", "ORDER9", "REFUND", "90.00")),
+			local: []reconciliation.LocalOrder{
+				{OutTradeNo: "ORDER1", Amount: 1000, State: payments.TradeStateSuccess},
+			},
+			matched: 1,
+			remote:  1,
+		},
+		{
+			name: "same out trade no across sub merchants",
+			bill: tradeBillOf(
+				billRow("1900000101", "ORDER1", "SUCCESS", "10.00"),
+				billRow("1900000102", "ORDER1", "SUCCESS", "20.00"),
+				billRow("1900000103", "ORDER1", "SUCCESS", "30.00"),
+			),
+			local: []reconciliation.LocalOrder{
+				{SubMchid: "1900000101", OutTradeNo: "ORDER1", Amount: 1000, State: payments.TradeStateSuccess},
+				{SubMchid: "1900000102", OutTradeNo: "ORDER1", Amount: 2500, State: payments.TradeStateSuccess},
+				{SubMchid: "1900000104", OutTradeNo: "ORDER1", Amount: 4000, State: payments.TradeStateSuccess},
+			},
+			diffs: []diffResult{
+				{Type: reconciliation.DiffAmountMismatch, SubMchid: "1900000102", OutTradeNo: "ORDER1"},
+				{Type: reconciliation.DiffMissingRemotely, SubMchid: "1900000104", OutTradeNo: "ORDER1"},
+				{Type: reconciliation.DiffMissingLocally, SubMchid: "1900000103", OutTradeNo: "ORDER1"},
+			},
+			matched: 1,
+			remote:  3,
+		},
+		{
+			name:   "empty local orders",
+			bill:   tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00")),
+			diffs:  []diffResult{{Type: reconciliation.DiffMissingLocally, OutTradeNo: "ORDER1"}},
+			remote: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := reconciliation.Reconcile(
+				context.Background(), strings.NewReader(tt.bill), reconciliation.NewSliceIterator(tt.local),
+			)
+			require.NoError(t, err)
+
+			var diffs []diffResult
+			for _, diff := range report.Diffs {
+				diffs = append(diffs, diffResult{Type: diff.Type, SubMchid: diff.SubMchid, OutTradeNo: diff.OutTradeNo})
+				if diff.Type != reconciliation.DiffMissingLocally {
+					assert.NotNil(t, diff.Local)
+				}
+				if diff.Type != reconciliation.DiffMissingRemotely {
+					assert.NotNil(t, diff.Remote)
+				}
+			}
+			assert.Equal(t, tt.diffs, diffs)
+			assert.Equal(t, tt.matched, report.Matched)
+			assert.Equal(t, tt.remote, report.RemoteCount)
+			assert.Equal(t, len(tt.local), report.LocalCount)
+		})
+	}
+}
+
+func TestReconcile_DuplicatedOrder(t *testing.T) {
+	bill := tradeBillOf(billRow("1900000101", "ORDER1", "SUCCESS", "10.00"), billRow("1900000101", "ORDER1", "SUCCESS", "10.00"))
+	_, err := reconciliation.Reconcile(context.Background(), strings.NewReader(bill), reconciliation.NewSliceIterator(nil))
+	assert.Error(t, err)
+}
+
+type failingIterator struct{ err error }
+
+func (it failingIterator) Next(_ context.Context) (*reconciliation.LocalOrder, error) {
+	return nil, it.err
+}
+
+func TestReconcile_Errors(t *testing.T) {
+	bill := tradeBillOf(billRow("0", "ORDER1", "SUCCESS", "10.00"))
+
+	iterErr := errors.New("database unavailable")
+	_, err := reconciliation.Reconcile(context.Background(), strings.NewReader(bill), failingIterator{err: iterErr})
+	assert.True(t, errors.Is(err, iterErr))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = reconciliation.Reconcile(ctx, strings.NewReader(bill), reconciliation.NewSliceIterator(nil))
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	_, err = reconciliation.Reconcile(context.Background(), strings.NewReader(""), reconciliation.NewSliceIterator(nil))
+	assert.Error(t, err)
+}
+
+func TestDiffType_String(t *testing.T) {
+	assert.Equal(t, "missing-locally", reconciliation.DiffMissingLocally.String())
+	assert.Equal(t, "missing-remotely", reconciliation.DiffMissingRemotely.String())
+	assert.Equal(t, "amount-mismatch", reconciliation.DiffAmountMismatch.String())
+	assert.Equal(t, "state-mismatch", reconciliation.DiffStateMismatch.String())
+	assert.Equal(t, "DiffType(0)", reconciliation.DiffType(0).String())
+}
+
+func TestSliceIterator(t *testing.T) {
+	it := reconciliation.NewSliceIterator([]reconciliation.LocalOrder{{OutTradeNo: "ORDER1"}})
+	order, err := it.Next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ORDER1", order.OutTradeNo)
+	_, err = it.Next(context.Background())
+	assert.Equal(t, io.EOF, err)
+}
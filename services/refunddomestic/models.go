@@ -225,6 +225,11 @@ func (o Amount) Clone() *Amount {
 	return &ret
 }
 
+// HasFrom 应答中是否返回了 from，o 为 nil 时返回 false
+func (o *Amount) HasFrom() bool {
+	return o != nil && o.From != nil
+}
+
 // AmountReq
 type AmountReq struct {
 	// 退款金额，币种的最小单位，只能为整数，不能超过原订单支付金额。
@@ -729,6 +734,210 @@ func (o GoodsDetail) Clone() *GoodsDetail {
 	return &ret
 }
 
+// HasWechatpayGoodsId 应答中是否返回了 wechatpay_goods_id，o 为 nil 时返回 false
+func (o *GoodsDetail) HasWechatpayGoodsId() bool {
+	return o != nil && o.WechatpayGoodsId != nil
+}
+
+// HasGoodsName 应答中是否返回了 goods_name，o 为 nil 时返回 false
+func (o *GoodsDetail) HasGoodsName() bool {
+	return o != nil && o.GoodsName != nil
+}
+
+// PartnerRefundNotification 服务商退款结果通知解密后的内容
+type PartnerRefundNotification struct {
+	// 服务商户号，由微信支付生成并下发
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户的商户号，由微信支付生成并下发
+	SubMchid *string `json:"sub_mchid"`
+	// 原支付交易对应的商户订单号
+	OutTradeNo *string `json:"out_trade_no"`
+	// 微信支付交易订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户系统内部的退款单号
+	OutRefundNo *string `json:"out_refund_no"`
+	// 微信支付退款单号
+	RefundId *string `json:"refund_id"`
+	// 退款状态，通知类型为 REFUND.SUCCESS 时为 SUCCESS，REFUND.ABNORMAL 时为 ABNORMAL，REFUND.CLOSED 时为 CLOSED
+	RefundStatus *Status `json:"refund_status"`
+	// 退款成功时间，退款状态为 SUCCESS 时返回
+	SuccessTime *time.Time `json:"success_time,omitempty"`
+	// 退款入账账户，取当前退款单的退款入账方，如：招商银行信用卡0403、支付用户零钱
+	UserReceivedAccount *string `json:"user_received_account"`
+	// 金额信息
+	Amount *RefundNotifyAmount `json:"amount"`
+}
+
+func (o PartnerRefundNotification) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutRefundNo == nil {
+		return nil, fmt.Errorf("field `OutRefundNo` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["out_refund_no"] = o.OutRefundNo
+
+	if o.RefundId == nil {
+		return nil, fmt.Errorf("field `RefundId` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["refund_id"] = o.RefundId
+
+	if o.RefundStatus == nil {
+		return nil, fmt.Errorf("field `RefundStatus` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["refund_status"] = o.RefundStatus
+
+	if o.SuccessTime != nil {
+		toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+	}
+
+	if o.UserReceivedAccount == nil {
+		return nil, fmt.Errorf("field `UserReceivedAccount` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["user_received_account"] = o.UserReceivedAccount
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in PartnerRefundNotification")
+	}
+	toSerialize["amount"] = o.Amount
+	return json.Marshal(toSerialize)
+}
+
+func (o PartnerRefundNotification) String() string {
+	var ret string
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutRefundNo == nil {
+		ret += "OutRefundNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRefundNo:%v, ", *o.OutRefundNo)
+	}
+
+	if o.RefundId == nil {
+		ret += "RefundId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RefundId:%v, ", *o.RefundId)
+	}
+
+	if o.RefundStatus == nil {
+		ret += "RefundStatus:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RefundStatus:%v, ", *o.RefundStatus)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	if o.UserReceivedAccount == nil {
+		ret += "UserReceivedAccount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("UserReceivedAccount:%v, ", *o.UserReceivedAccount)
+	}
+
+	ret += fmt.Sprintf("Amount:%v", o.Amount)
+
+	return fmt.Sprintf("PartnerRefundNotification{%s}", ret)
+}
+
+func (o PartnerRefundNotification) Clone() *PartnerRefundNotification {
+	ret := PartnerRefundNotification{}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutRefundNo != nil {
+		ret.OutRefundNo = new(string)
+		*ret.OutRefundNo = *o.OutRefundNo
+	}
+
+	if o.RefundId != nil {
+		ret.RefundId = new(string)
+		*ret.RefundId = *o.RefundId
+	}
+
+	if o.RefundStatus != nil {
+		ret.RefundStatus = new(Status)
+		*ret.RefundStatus = *o.RefundStatus
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.UserReceivedAccount != nil {
+		ret.UserReceivedAccount = new(string)
+		*ret.UserReceivedAccount = *o.UserReceivedAccount
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	return &ret
+}
+
 // Promotion
 type Promotion struct {
 	// 券或者立减优惠id
@@ -854,6 +1063,11 @@ func (o Promotion) Clone() *Promotion {
 	return &ret
 }
 
+// HasGoodsDetail 应答中是否返回了 goods_detail，o 为 nil 时返回 false
+func (o *Promotion) HasGoodsDetail() bool {
+	return o != nil && o.GoodsDetail != nil
+}
+
 // QueryByOutRefundNoRequest
 type QueryByOutRefundNoRequest struct {
 	// 商户系统内部的退款单号，商户系统内部唯一，只能是数字、大小写字母_-|*@ ，同一退款单号多次请求只退一笔。
@@ -1135,6 +1349,289 @@ func (o Refund) Clone() *Refund {
 	return &ret
 }
 
+// HasSuccessTime 应答中是否返回了 success_time，o 为 nil 时返回 false
+func (o *Refund) HasSuccessTime() bool {
+	return o != nil && o.SuccessTime != nil
+}
+
+// HasFundsAccount 应答中是否返回了 funds_account，o 为 nil 时返回 false
+func (o *Refund) HasFundsAccount() bool {
+	return o != nil && o.FundsAccount != nil
+}
+
+// HasPromotionDetail 应答中是否返回了 promotion_detail，o 为 nil 时返回 false
+func (o *Refund) HasPromotionDetail() bool {
+	return o != nil && o.PromotionDetail != nil
+}
+
+// RefundNotification 直连商户退款结果通知解密后的内容
+type RefundNotification struct {
+	// 直连商户的商户号，由微信支付生成并下发
+	Mchid *string `json:"mchid"`
+	// 原支付交易对应的商户订单号
+	OutTradeNo *string `json:"out_trade_no"`
+	// 微信支付交易订单号
+	TransactionId *string `json:"transaction_id"`
+	// 商户系统内部的退款单号
+	OutRefundNo *string `json:"out_refund_no"`
+	// 微信支付退款单号
+	RefundId *string `json:"refund_id"`
+	// 退款状态，通知类型为 REFUND.SUCCESS 时为 SUCCESS，REFUND.ABNORMAL 时为 ABNORMAL，REFUND.CLOSED 时为 CLOSED
+	RefundStatus *Status `json:"refund_status"`
+	// 退款成功时间，退款状态为 SUCCESS 时返回
+	SuccessTime *time.Time `json:"success_time,omitempty"`
+	// 退款入账账户，取当前退款单的退款入账方，如：招商银行信用卡0403、支付用户零钱
+	UserReceivedAccount *string `json:"user_received_account"`
+	// 金额信息
+	Amount *RefundNotifyAmount `json:"amount"`
+}
+
+func (o RefundNotification) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Mchid == nil {
+		return nil, fmt.Errorf("field `Mchid` is required and must be specified in RefundNotification")
+	}
+	toSerialize["mchid"] = o.Mchid
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in RefundNotification")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TransactionId == nil {
+		return nil, fmt.Errorf("field `TransactionId` is required and must be specified in RefundNotification")
+	}
+	toSerialize["transaction_id"] = o.TransactionId
+
+	if o.OutRefundNo == nil {
+		return nil, fmt.Errorf("field `OutRefundNo` is required and must be specified in RefundNotification")
+	}
+	toSerialize["out_refund_no"] = o.OutRefundNo
+
+	if o.RefundId == nil {
+		return nil, fmt.Errorf("field `RefundId` is required and must be specified in RefundNotification")
+	}
+	toSerialize["refund_id"] = o.RefundId
+
+	if o.RefundStatus == nil {
+		return nil, fmt.Errorf("field `RefundStatus` is required and must be specified in RefundNotification")
+	}
+	toSerialize["refund_status"] = o.RefundStatus
+
+	if o.SuccessTime != nil {
+		toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+	}
+
+	if o.UserReceivedAccount == nil {
+		return nil, fmt.Errorf("field `UserReceivedAccount` is required and must be specified in RefundNotification")
+	}
+	toSerialize["user_received_account"] = o.UserReceivedAccount
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in RefundNotification")
+	}
+	toSerialize["amount"] = o.Amount
+	return json.Marshal(toSerialize)
+}
+
+func (o RefundNotification) String() string {
+	var ret string
+	if o.Mchid == nil {
+		ret += "Mchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Mchid:%v, ", *o.Mchid)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.OutRefundNo == nil {
+		ret += "OutRefundNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutRefundNo:%v, ", *o.OutRefundNo)
+	}
+
+	if o.RefundId == nil {
+		ret += "RefundId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RefundId:%v, ", *o.RefundId)
+	}
+
+	if o.RefundStatus == nil {
+		ret += "RefundStatus:<nil>, "
+	} else {
+		ret += fmt.Sprintf("RefundStatus:%v, ", *o.RefundStatus)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	if o.UserReceivedAccount == nil {
+		ret += "UserReceivedAccount:<nil>, "
+	} else {
+		ret += fmt.Sprintf("UserReceivedAccount:%v, ", *o.UserReceivedAccount)
+	}
+
+	ret += fmt.Sprintf("Amount:%v", o.Amount)
+
+	return fmt.Sprintf("RefundNotification{%s}", ret)
+}
+
+func (o RefundNotification) Clone() *RefundNotification {
+	ret := RefundNotification{}
+
+	if o.Mchid != nil {
+		ret.Mchid = new(string)
+		*ret.Mchid = *o.Mchid
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.OutRefundNo != nil {
+		ret.OutRefundNo = new(string)
+		*ret.OutRefundNo = *o.OutRefundNo
+	}
+
+	if o.RefundId != nil {
+		ret.RefundId = new(string)
+		*ret.RefundId = *o.RefundId
+	}
+
+	if o.RefundStatus != nil {
+		ret.RefundStatus = new(Status)
+		*ret.RefundStatus = *o.RefundStatus
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.UserReceivedAccount != nil {
+		ret.UserReceivedAccount = new(string)
+		*ret.UserReceivedAccount = *o.UserReceivedAccount
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	return &ret
+}
+
+// RefundNotifyAmount
+type RefundNotifyAmount struct {
+	// 订单总金额，单位为分
+	Total *int64 `json:"total"`
+	// 退款金额，单位为分
+	Refund *int64 `json:"refund"`
+	// 用户实际支付金额，单位为分
+	PayerTotal *int64 `json:"payer_total"`
+	// 退款给用户的金额，单位为分，不包含所有优惠券金额
+	PayerRefund *int64 `json:"payer_refund"`
+}
+
+func (o RefundNotifyAmount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in RefundNotifyAmount")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.Refund == nil {
+		return nil, fmt.Errorf("field `Refund` is required and must be specified in RefundNotifyAmount")
+	}
+	toSerialize["refund"] = o.Refund
+
+	if o.PayerTotal == nil {
+		return nil, fmt.Errorf("field `PayerTotal` is required and must be specified in RefundNotifyAmount")
+	}
+	toSerialize["payer_total"] = o.PayerTotal
+
+	if o.PayerRefund == nil {
+		return nil, fmt.Errorf("field `PayerRefund` is required and must be specified in RefundNotifyAmount")
+	}
+	toSerialize["payer_refund"] = o.PayerRefund
+	return json.Marshal(toSerialize)
+}
+
+func (o RefundNotifyAmount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.Refund == nil {
+		ret += "Refund:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Refund:%v, ", *o.Refund)
+	}
+
+	if o.PayerTotal == nil {
+		ret += "PayerTotal:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerTotal:%v, ", *o.PayerTotal)
+	}
+
+	if o.PayerRefund == nil {
+		ret += "PayerRefund:<nil>"
+	} else {
+		ret += fmt.Sprintf("PayerRefund:%v", *o.PayerRefund)
+	}
+
+	return fmt.Sprintf("RefundNotifyAmount{%s}", ret)
+}
+
+func (o RefundNotifyAmount) Clone() *RefundNotifyAmount {
+	ret := RefundNotifyAmount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.Refund != nil {
+		ret.Refund = new(int64)
+		*ret.Refund = *o.Refund
+	}
+
+	if o.PayerTotal != nil {
+		ret.PayerTotal = new(int64)
+		*ret.PayerTotal = *o.PayerTotal
+	}
+
+	if o.PayerRefund != nil {
+		ret.PayerRefund = new(int64)
+		*ret.PayerRefund = *o.PayerRefund
+	}
+
+	return &ret
+}
+
 // ReqFundsAccount * `AVAILABLE` - 可用余额, 仅对老资金流商户适用，指定从可用余额账户出资
 type ReqFundsAccount string
 
@@ -0,0 +1,47 @@
+package refunddomestic
+
+// PromotionRefundSummary 退款中优惠退款金额的汇总，单位为分
+type PromotionRefundSummary struct {
+	// Amount 退款涉及的优惠的原优惠金额之和
+	Amount int64
+	// Refund 优惠退款金额之和，退款金额减去该金额为退给用户的现金
+	Refund int64
+	// CouponRefund 充值型代金券（COUPON）的优惠退款金额
+	CouponRefund int64
+	// DiscountRefund 免充值型优惠券（DISCOUNT）的优惠退款金额
+	DiscountRefund int64
+}
+
+// SummarizePromotions 汇总 promotions 的优惠退款金额，应答中未返回的金额按 0 计算
+func SummarizePromotions(promotions []Promotion) PromotionRefundSummary {
+	var s PromotionRefundSummary
+	for _, promotion := range promotions {
+		refund := int64Value(promotion.RefundAmount)
+		s.Amount += int64Value(promotion.Amount)
+		s.Refund += refund
+		if promotion.Type != nil {
+			switch *promotion.Type {
+			case TYPE_COUPON:
+				s.CouponRefund += refund
+			case TYPE_DISCOUNT:
+				s.DiscountRefund += refund
+			}
+		}
+	}
+	return s
+}
+
+// PromotionSummary 汇总退款的优惠退款金额，o 为 nil 或退款不涉及优惠时返回零值
+func (o *Refund) PromotionSummary() PromotionRefundSummary {
+	if o == nil {
+		return PromotionRefundSummary{}
+	}
+	return SummarizePromotions(o.PromotionDetail)
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,25 @@
+package refunddomestic_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/services/refunddomestic"
+)
+
+func ExampleRefund_PromotionSummary() {
+	var refund refunddomestic.Refund
+	_ = json.Unmarshal([]byte(`{
+		"refund_id": "50000000382019052709732678859",
+		"status": "SUCCESS",
+		"promotion_detail": [
+			{"promotion_id": "109519", "scope": "GLOBAL", "type": "COUPON", "amount": 200, "refund_amount": 100},
+			{"promotion_id": "109520", "scope": "SINGLE", "type": "DISCOUNT", "amount": 100, "refund_amount": 100}
+		]
+	}`), &refund)
+
+	summary := refund.PromotionSummary()
+	fmt.Println("refund:", summary.Refund, "coupon:", summary.CouponRefund, "discount:", summary.DiscountRefund)
+	// Output:
+	// refund: 200 coupon: 100 discount: 100
+}
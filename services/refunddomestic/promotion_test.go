@@ -0,0 +1,47 @@
+package refunddomestic_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/refunddomestic"
+)
+
+func TestSummarizePromotions(t *testing.T) {
+	tests := []struct {
+		name       string
+		promotions []refunddomestic.Promotion
+		want       refunddomestic.PromotionRefundSummary
+	}{
+		{name: "empty"},
+		{
+			name: "coupon and discount",
+			promotions: []refunddomestic.Promotion{
+				{PromotionId: core.String("109519"), Type: refunddomestic.TYPE_COUPON.Ptr(), Amount: core.Int64(200), RefundAmount: core.Int64(100)},
+				{PromotionId: core.String("109520"), Type: refunddomestic.TYPE_DISCOUNT.Ptr(), Amount: core.Int64(100), RefundAmount: core.Int64(100)},
+				{PromotionId: core.String("109521"), Type: refunddomestic.TYPE_COUPON.Ptr(), Amount: core.Int64(50), RefundAmount: core.Int64(20)},
+			},
+			want: refunddomestic.PromotionRefundSummary{Amount: 350, Refund: 220, CouponRefund: 120, DiscountRefund: 100},
+		},
+		{
+			// 应答中未返回的金额按 0 计算，未返回类型的优惠只计入总额
+			name: "missing fields",
+			promotions: []refunddomestic.Promotion{
+				{PromotionId: core.String("109519"), Amount: core.Int64(200), RefundAmount: core.Int64(100)},
+				{PromotionId: core.String("109520"), Type: refunddomestic.TYPE_DISCOUNT.Ptr()},
+			},
+			want: refunddomestic.PromotionRefundSummary{Amount: 200, Refund: 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, refunddomestic.SummarizePromotions(tt.promotions))
+			assert.Equal(t, tt.want, (&refunddomestic.Refund{PromotionDetail: tt.promotions}).PromotionSummary())
+		})
+	}
+
+	var refund *refunddomestic.Refund
+	assert.Equal(t, refunddomestic.PromotionRefundSummary{}, refund.PromotionSummary())
+}
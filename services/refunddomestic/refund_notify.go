@@ -0,0 +1,59 @@
+package refunddomestic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// 退款结果通知的通知类型（event_type）
+const (
+	// EventTypePrefix 所有退款结果通知类型的公共前缀
+	EventTypePrefix = "REFUND."
+	// EventTypeRefundSuccess 退款成功
+	EventTypeRefundSuccess = "REFUND.SUCCESS"
+	// EventTypeRefundAbnormal 退款异常
+	EventTypeRefundAbnormal = "REFUND.ABNORMAL"
+	// EventTypeRefundClosed 退款关闭
+	EventTypeRefundClosed = "REFUND.CLOSED"
+)
+
+// RefundNotifyFunc 直连商户退款结果通知处理函数，返回值的含义同 notify.HandleFunc
+type RefundNotifyFunc func(ctx context.Context, req *notify.Request, content *RefundNotification) error
+
+// PartnerRefundNotifyFunc 服务商退款结果通知处理函数，返回值的含义同 notify.HandleFunc
+type PartnerRefundNotifyFunc func(ctx context.Context, req *notify.Request, content *PartnerRefundNotification) error
+
+// HandleRefundNotify 将 fn 包装为 notify.HandleFunc：将通知内容解析为直连商户的 RefundNotification 后调用 fn
+//
+// 通知内容无法解析，或不包含直连商户号 mchid（如服务商模式的通知）时以 notify.Reject 应答。
+func HandleRefundNotify(fn RefundNotifyFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		content := new(RefundNotification)
+		if err := req.UnmarshalContent(content); err != nil {
+			return notify.Reject(err)
+		}
+		if content.Mchid == nil || *content.Mchid == "" {
+			return notify.Reject(fmt.Errorf("refund notify %s has no mchid, use HandlePartnerRefundNotify for partner refunds", req.ID))
+		}
+		return fn(ctx, req, content)
+	}
+}
+
+// HandlePartnerRefundNotify 将 fn 包装为 notify.HandleFunc：将通知内容解析为服务商的 PartnerRefundNotification 后调用 fn
+//
+// 服务商模式的退款结果通知使用 sp_mchid 与 sub_mchid 代替直连商户的 mchid，请根据 SubMchid 将通知路由到对应的子商户。
+// 通知内容无法解析，或不包含子商户号 sub_mchid（如直连商户的通知）时以 notify.Reject 应答。
+func HandlePartnerRefundNotify(fn PartnerRefundNotifyFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		content := new(PartnerRefundNotification)
+		if err := req.UnmarshalContent(content); err != nil {
+			return notify.Reject(err)
+		}
+		if content.SubMchid == nil || *content.SubMchid == "" {
+			return notify.Reject(fmt.Errorf("refund notify %s has no sub_mchid, use HandleRefundNotify for direct merchant refunds", req.ID))
+		}
+		return fn(ctx, req, content)
+	}
+}
@@ -0,0 +1,47 @@
+package refunddomestic_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/refunddomestic"
+)
+
+func ExampleHandlePartnerRefundNotify() {
+	router := notify.NewRouter()
+	router.HandlePrefix(refunddomestic.EventTypePrefix, refunddomestic.HandlePartnerRefundNotify(
+		func(ctx context.Context, req *notify.Request, content *refunddomestic.PartnerRefundNotification) error {
+			// 根据子商户号找到对应的子商户，更新退款单状态
+			fmt.Println(*content.SubMchid, *content.OutRefundNo, *content.RefundStatus, *content.Amount.Refund)
+			return nil
+		},
+	))
+
+	// 实际使用时，将 router.Dispatch 作为 notify.Handler.HTTPHandler 的处理函数，由其完成验签与解密
+	err := router.Dispatch(context.Background(), &notify.Request{
+		ID:        "EV-2018022511223320873",
+		EventType: refunddomestic.EventTypeRefundSuccess,
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"sp_mchid":"1900000100","sub_mchid":"1900000109","out_trade_no":"1217752501201407033233368018",` +
+				`"transaction_id":"1217752501201407033233368018","out_refund_no":"1217752501201407033233368018",` +
+				`"refund_id":"1217752501201407033233368018","refund_status":"SUCCESS","success_time":"2018-06-08T10:34:56+08:00",` +
+				`"user_received_account":"招商银行信用卡0403","amount":{"total":999,"refund":999,"payer_total":999,"payer_refund":999}}`,
+		},
+	})
+	fmt.Println(err)
+
+	// 直连商户的退款结果通知不包含子商户号，被拒绝处理
+	err = router.Dispatch(context.Background(), &notify.Request{
+		ID:        "EV-2018022511223320874",
+		EventType: refunddomestic.EventTypeRefundSuccess,
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"mchid":"1900000100","out_refund_no":"1217752501201407033233368018","refund_status":"SUCCESS"}`,
+		},
+	})
+	fmt.Println(notify.IsReject(err))
+	// Output:
+	// 1900000109 1217752501201407033233368018 SUCCESS 999
+	// <nil>
+	// true
+}
@@ -0,0 +1,170 @@
+package refunddomestic_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/refunddomestic"
+)
+
+const (
+	directRefundNotify = `{"mchid":"1900000100","out_trade_no":"1217752501201407033233368018",` +
+		`"transaction_id":"1217752501201407033233368018","out_refund_no":"1217752501201407033233368018",` +
+		`"refund_id":"1217752501201407033233368018","refund_status":"SUCCESS","success_time":"2018-06-08T10:34:56+08:00",` +
+		`"user_received_account":"招商银行信用卡0403","amount":{"total":999,"refund":999,"payer_total":999,"payer_refund":999}}`
+	partnerRefundNotify = `{"sp_mchid":"1900000100","sub_mchid":"1900000109","out_trade_no":"1217752501201407033233368018",` +
+		`"transaction_id":"1217752501201407033233368018","out_refund_no":"1217752501201407033233368018",` +
+		`"refund_id":"1217752501201407033233368018","refund_status":"ABNORMAL",` +
+		`"user_received_account":"招商银行信用卡0403","amount":{"total":999,"refund":500,"payer_total":999,"payer_refund":500}}`
+)
+
+func refundNotifyRequest(plaintext string) *notify.Request {
+	return &notify.Request{
+		ID:        "EV-2018022511223320873",
+		EventType: refunddomestic.EventTypeRefundSuccess,
+		Resource:  &notify.EncryptedResource{Plaintext: plaintext},
+	}
+}
+
+func TestHandleRefundNotify(t *testing.T) {
+	var content *refunddomestic.RefundNotification
+	handle := refunddomestic.HandleRefundNotify(
+		func(_ context.Context, _ *notify.Request, c *refunddomestic.RefundNotification) error {
+			content = c
+			return nil
+		},
+	)
+
+	require.NoError(t, handle(context.Background(), refundNotifyRequest(directRefundNotify)))
+	require.NotNil(t, content)
+	assert.Equal(t, "1900000100", *content.Mchid)
+	assert.Equal(t, "1217752501201407033233368018", *content.OutRefundNo)
+	assert.Equal(t, refunddomestic.STATUS_SUCCESS, *content.RefundStatus)
+	assert.Equal(t, int64(999), *content.Amount.Refund)
+	require.NotNil(t, content.SuccessTime)
+	assert.True(t, time.Date(2018, 6, 8, 2, 34, 56, 0, time.UTC).Equal(*content.SuccessTime))
+}
+
+func TestHandlePartnerRefundNotify(t *testing.T) {
+	var content *refunddomestic.PartnerRefundNotification
+	handle := refunddomestic.HandlePartnerRefundNotify(
+		func(_ context.Context, _ *notify.Request, c *refunddomestic.PartnerRefundNotification) error {
+			content = c
+			return nil
+		},
+	)
+
+	require.NoError(t, handle(context.Background(), refundNotifyRequest(partnerRefundNotify)))
+	require.NotNil(t, content)
+	assert.Equal(t, "1900000100", *content.SpMchid)
+	assert.Equal(t, "1900000109", *content.SubMchid)
+	assert.Equal(t, refunddomestic.STATUS_ABNORMAL, *content.RefundStatus)
+	assert.Equal(t, int64(500), *content.Amount.Refund)
+	// 退款异常的通知不包含退款成功时间
+	assert.Nil(t, content.SuccessTime)
+}
+
+func TestHandleRefundNotify_Reject(t *testing.T) {
+	called := false
+	direct := refunddomestic.HandleRefundNotify(
+		func(context.Context, *notify.Request, *refunddomestic.RefundNotification) error {
+			called = true
+			return nil
+		},
+	)
+	partner := refunddomestic.HandlePartnerRefundNotify(
+		func(context.Context, *notify.Request, *refunddomestic.PartnerRefundNotification) error {
+			called = true
+			return nil
+		},
+	)
+
+	tests := []struct {
+		name   string
+		handle notify.HandleFunc
+		req    *notify.Request
+	}{
+		{name: "partner notify to direct handler", handle: direct, req: refundNotifyRequest(partnerRefundNotify)},
+		{name: "direct notify to partner handler", handle: partner, req: refundNotifyRequest(directRefundNotify)},
+		{name: "invalid content", handle: direct, req: refundNotifyRequest(`{"mchid":`)},
+		{name: "invalid partner content", handle: partner, req: refundNotifyRequest(`not json`)},
+		{name: "no resource", handle: partner, req: &notify.Request{ID: "EV-2018022511223320873"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			err := tt.handle(context.Background(), tt.req)
+			require.Error(t, err)
+			// 无法处理的通知以 notify.Reject 应答，不会调用处理函数
+			assert.True(t, notify.IsReject(err), "%v", err)
+			assert.False(t, called)
+		})
+	}
+}
+
+func TestHandleRefundNotify_HandlerError(t *testing.T) {
+	handlerErr := notify.Retry(errors.New("database unavailable"))
+	handle := refunddomestic.HandleRefundNotify(
+		func(context.Context, *notify.Request, *refunddomestic.RefundNotification) error {
+			return handlerErr
+		},
+	)
+	assert.Equal(t, handlerErr, handle(context.Background(), refundNotifyRequest(directRefundNotify)))
+}
+
+func TestTransactionKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     refunddomestic.CreateRequest
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "transaction id",
+			req:  refunddomestic.CreateRequest{TransactionId: core.String("4200000001"), OutTradeNo: core.String("ORDER1")},
+			want: "transaction_id:4200000001",
+		},
+		{
+			// 微信订单号全局唯一，不需要包含子商户号
+			name: "transaction id with sub mchid",
+			req:  refunddomestic.CreateRequest{SubMchid: core.String("1900000109"), TransactionId: core.String("4200000001")},
+			want: "transaction_id:4200000001",
+		},
+		{name: "out trade no", req: refunddomestic.CreateRequest{OutTradeNo: core.String("ORDER1")}, want: "out_trade_no:ORDER1"},
+		{
+			name: "out trade no with sub mchid",
+			req:  refunddomestic.CreateRequest{SubMchid: core.String("1900000109"), OutTradeNo: core.String("ORDER1")},
+			want: "sub_mchid:1900000109:out_trade_no:ORDER1",
+		},
+		{
+			name: "empty sub mchid",
+			req:  refunddomestic.CreateRequest{SubMchid: core.String(""), OutTradeNo: core.String("ORDER1")},
+			want: "out_trade_no:ORDER1",
+		},
+		{name: "empty transaction id", req: refunddomestic.CreateRequest{TransactionId: core.String("")}, wantErr: true},
+		{name: "no order", req: refunddomestic.CreateRequest{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := refunddomestic.TransactionKey(tt.req)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, key)
+		})
+	}
+
+	// 不同子商户的相同商户订单号分别计入台账
+	a, _ := refunddomestic.TransactionKey(refunddomestic.CreateRequest{SubMchid: core.String("1900000109"), OutTradeNo: core.String("ORDER1")})
+	b, _ := refunddomestic.TransactionKey(refunddomestic.CreateRequest{SubMchid: core.String("1900000110"), OutTradeNo: core.String("ORDER1")})
+	assert.NotEqual(t, a, b)
+}
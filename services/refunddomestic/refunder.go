@@ -0,0 +1,250 @@
+package refunddomestic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+// ErrRefundExceedsTotal 累计退款金额将超过原订单金额
+var ErrRefundExceedsTotal = errors.New("cumulative refund amount exceeds the transaction total")
+
+// RefundLedger 退款台账，按商户退款单号记录每笔交易的累计退款金额。可基于数据库等实现，以便在多个实例间共享
+type RefundLedger interface {
+	// Reserve 为交易的退款单预留退款金额。预留后的累计退款金额超过 total 时，不做预留并返回 ErrRefundExceedsTotal。
+	// 同一交易中 outRefundNo 已有预留时不做任何修改并返回 nil，以便使用同一商户退款单号重试
+	Reserve(ctx context.Context, transactionKey, outRefundNo string, amount, total int64) error
+	// Release 释放为交易的退款单预留的退款金额，在退款申请被拒绝或退款关闭时调用。outRefundNo 没有预留时不做任何修改
+	Release(ctx context.Context, transactionKey, outRefundNo string) error
+	// Refunded 返回交易的累计退款金额（包括已预留的金额）
+	Refunded(ctx context.Context, transactionKey string) (int64, error)
+}
+
+// MemoryRefundLedger 基于内存的退款台账，仅适用于单实例部署与测试
+//
+// 台账创建时为空，不包含进程启动前已经发生的退款，也不会在重启后保留。若交易在此前已有退款，
+// 请先通过查询单笔退款（RefundsApiService.QueryByOutRefundNo）等方式获取已退款金额，
+// 再调用 Reserve 将其计入台账，否则累计退款金额的校验将遗漏这部分金额。
+type MemoryRefundLedger struct {
+	// refunds 交易标识 -> 商户退款单号 -> 预留的退款金额
+	refunds map[string]map[string]int64
+	lock    sync.Mutex
+}
+
+// NewMemoryRefundLedger 创建基于内存的退款台账
+func NewMemoryRefundLedger() *MemoryRefundLedger {
+	return &MemoryRefundLedger{refunds: make(map[string]map[string]int64)}
+}
+
+// Reserve 为交易的退款单预留退款金额，退款单已有预留时直接返回
+func (l *MemoryRefundLedger) Reserve(_ context.Context, transactionKey, outRefundNo string, amount, total int64) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	refunds := l.refunds[transactionKey]
+	if _, ok := refunds[outRefundNo]; ok {
+		return nil
+	}
+	if sumRefunds(refunds)+amount > total {
+		return ErrRefundExceedsTotal
+	}
+	if refunds == nil {
+		refunds = make(map[string]int64)
+		l.refunds[transactionKey] = refunds
+	}
+	refunds[outRefundNo] = amount
+	return nil
+}
+
+// Release 释放为交易的退款单预留的退款金额
+func (l *MemoryRefundLedger) Release(_ context.Context, transactionKey, outRefundNo string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	refunds := l.refunds[transactionKey]
+	delete(refunds, outRefundNo)
+	if len(refunds) == 0 {
+		delete(l.refunds, transactionKey)
+	}
+	return nil
+}
+
+// Refunded 返回交易的累计退款金额
+func (l *MemoryRefundLedger) Refunded(_ context.Context, transactionKey string) (int64, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return sumRefunds(l.refunds[transactionKey]), nil
+}
+
+func sumRefunds(refunds map[string]int64) int64 {
+	var sum int64
+	for _, amount := range refunds {
+		sum += amount
+	}
+	return sum
+}
+
+// TransactionKey 返回退款申请在退款台账中对应的交易标识，优先使用微信订单号
+//
+// 服务商模式下不同子商户的商户订单号可能相同，因此设置了 SubMchid 时，使用商户订单号的交易标识包含子商户号。
+func TransactionKey(req CreateRequest) (string, error) {
+	if req.TransactionId != nil && *req.TransactionId != "" {
+		return "transaction_id:" + *req.TransactionId, nil
+	}
+	if req.OutTradeNo != nil && *req.OutTradeNo != "" {
+		if req.SubMchid != nil && *req.SubMchid != "" {
+			return "sub_mchid:" + *req.SubMchid + ":out_trade_no:" + *req.OutTradeNo, nil
+		}
+		return "out_trade_no:" + *req.OutTradeNo, nil
+	}
+	return "", fmt.Errorf("field `TransactionId` or `OutTradeNo` must be specified in CreateRequest")
+}
+
+// GenerateOutRefundNo 生成商户退款单号，格式为 RF + 14 位时间 + 16 位随机字符，时间取自 auth.Now(ctx)
+func GenerateOutRefundNo(ctx context.Context) (string, error) {
+	nonce, err := utils.GenerateNonce()
+	if err != nil {
+		return "", err
+	}
+	return "RF" + auth.Now(ctx).Format("20060102150405") + nonce[:16], nil
+}
+
+const (
+	defaultRefundMaxAttempts   = 3
+	defaultRefundRetryInterval = time.Second
+)
+
+// Refunder 退款申请编排器
+//
+// 在申请退款前通过 RefundLedger 校验累计退款金额不超过原订单金额，未指定商户退款单号时自动生成，
+// 并在遇到频率限制（FREQUENCY_LIMITED）时使用相同的商户退款单号按指数退避重试。
+type Refunder struct {
+	svc           RefundsApiService
+	ledger        RefundLedger
+	maxAttempts   int
+	retryInterval time.Duration
+}
+
+// RefunderOption Refunder 的配置项
+type RefunderOption func(r *Refunder)
+
+// WithRefundLedger 设置退款台账，默认使用 MemoryRefundLedger
+func WithRefundLedger(ledger RefundLedger) RefunderOption {
+	return func(r *Refunder) {
+		if ledger != nil {
+			r.ledger = ledger
+		}
+	}
+}
+
+// WithRefundRetry 设置遇到频率限制时的最大尝试次数与首次重试间隔，默认为 3 次、1s
+func WithRefundRetry(maxAttempts int, interval time.Duration) RefunderOption {
+	return func(r *Refunder) {
+		if maxAttempts > 0 {
+			r.maxAttempts = maxAttempts
+		}
+		if interval >= 0 {
+			r.retryInterval = interval
+		}
+	}
+}
+
+// NewRefunder 创建 Refunder
+func NewRefunder(client *core.Client, opts ...RefunderOption) *Refunder {
+	r := &Refunder{
+		svc:           RefundsApiService{Client: client},
+		ledger:        NewMemoryRefundLedger(),
+		maxAttempts:   defaultRefundMaxAttempts,
+		retryInterval: defaultRefundRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Ledger 返回 Refunder 使用的退款台账。收到退款关闭（REFUND.CLOSED）通知时，请以通知中的商户退款单号调用 Release 释放对应的退款金额
+func (r *Refunder) Ledger() RefundLedger {
+	return r.ledger
+}
+
+// Refund 申请退款
+//
+// req 中的 Amount.Refund 与 Amount.Total 必填，OutRefundNo 为空时自动生成。
+// 退款申请被微信支付以 4xx 应答明确拒绝时释放预留的退款金额；网络异常、5xx（如 SYSTEM_ERROR）等结果未知的情况下，
+// 退款可能已被受理，此时保留预留金额，请使用同一商户退款单号重新申请或查询退款结果。
+// 台账按商户退款单号预留金额，使用同一商户退款单号重新申请时不会重复预留。
+func (r *Refunder) Refund(ctx context.Context, req CreateRequest) (resp *Refund, result *core.APIResult, err error) {
+	if req.Amount == nil || req.Amount.Refund == nil || req.Amount.Total == nil {
+		return nil, nil, fmt.Errorf("field `Amount.Refund` and `Amount.Total` are required and must be specified in CreateRequest")
+	}
+	refund, total := *req.Amount.Refund, *req.Amount.Total
+	if refund <= 0 || refund > total {
+		return nil, nil, fmt.Errorf("refund amount %d must be positive and not greater than total %d", refund, total)
+	}
+
+	key, err := TransactionKey(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req = *req.Clone()
+	if req.OutRefundNo == nil || *req.OutRefundNo == "" {
+		outRefundNo, err := GenerateOutRefundNo(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("generate out_refund_no err: %v", err)
+		}
+		req.OutRefundNo = core.String(outRefundNo)
+	}
+
+	if err = r.ledger.Reserve(ctx, key, *req.OutRefundNo, refund, total); err != nil {
+		return nil, nil, fmt.Errorf("reserve refund amount for %s err: %w", key, err)
+	}
+
+	resp, result, err = r.create(ctx, req)
+	if err != nil {
+		if isRefundRejected(err) {
+			if releaseErr := r.ledger.Release(ctx, key, *req.OutRefundNo); releaseErr != nil {
+				return nil, result, fmt.Errorf("%w, release refund amount for %s err: %v", err, key, releaseErr)
+			}
+		}
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+func (r *Refunder) create(ctx context.Context, req CreateRequest) (resp *Refund, result *core.APIResult, err error) {
+	interval := r.retryInterval
+	for attempt := 1; ; attempt++ {
+		resp, result, err = r.svc.Create(ctx, req)
+		if err == nil || !core.IsAPIError(err, "FREQUENCY_LIMITED") || attempt >= r.maxAttempts {
+			return resp, result, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, result, fmt.Errorf("%v, last err: %w", ctx.Err(), err)
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+// isRefundRejected 判断退款申请是否被微信支付明确拒绝：仅 4xx 应答表示请求未被受理，5xx 应答时退款结果未知
+func isRefundRejected(err error) bool {
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode >= http.StatusBadRequest && apiErr.StatusCode < http.StatusInternalServerError
+}
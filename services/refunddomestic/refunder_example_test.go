@@ -0,0 +1,56 @@
+package refunddomestic_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/refunddomestic"
+)
+
+func ExampleRefunder_Refund() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	refunder := refunddomestic.NewRefunder(client)
+	resp, result, err := refunder.Refund(ctx,
+		refunddomestic.CreateRequest{
+			TransactionId: core.String("1217752501201407033233368018"),
+			Reason:        core.String("商品已售完"),
+			Amount: &refunddomestic.AmountReq{
+				Currency: core.String("CNY"),
+				Refund:   core.Int64(300),
+				Total:    core.Int64(888),
+			},
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleMemoryRefundLedger() {
+	ctx := context.Background()
+	ledger := refunddomestic.NewMemoryRefundLedger()
+	key, _ := refunddomestic.TransactionKey(refunddomestic.CreateRequest{
+		TransactionId: core.String("1217752501201407033233368018"),
+	})
+
+	fmt.Println(ledger.Reserve(ctx, key, "RF1", 500, 888))
+	// 累计退款金额将超过原订单金额
+	fmt.Println(ledger.Reserve(ctx, key, "RF2", 500, 888))
+	fmt.Println(ledger.Reserve(ctx, key, "RF2", 388, 888))
+
+	// 退款关闭后释放金额
+	fmt.Println(ledger.Release(ctx, key, "RF2"))
+	fmt.Println(ledger.Refunded(ctx, key))
+	// Output:
+	// <nil>
+	// cumulative refund amount exceeds the transaction total
+	// <nil>
+	// <nil>
+	// 500 <nil>
+}
@@ -0,0 +1,298 @@
+package refunddomestic_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/auth"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/refunddomestic"
+)
+
+const testTransactionKey = "transaction_id:1217752501201407033233368018"
+
+func refundRequest(refund, total int64) refunddomestic.CreateRequest {
+	return refunddomestic.CreateRequest{
+		TransactionId: core.String("1217752501201407033233368018"),
+		Amount: &refunddomestic.AmountReq{
+			Currency: core.String("CNY"),
+			Refund:   core.Int64(refund),
+			Total:    core.Int64(total),
+		},
+	}
+}
+
+// fakeRefundServer 依次使用 statuses 中的状态码应答退款申请，用完后应答成功
+type fakeRefundServer struct {
+	lock         sync.Mutex
+	statuses     []int
+	outRefundNos []string
+}
+
+func (s *fakeRefundServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]interface{}
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	s.lock.Lock()
+	outRefundNo, _ := body["out_refund_no"].(string)
+	s.outRefundNos = append(s.outRefundNos, outRefundNo)
+	status := http.StatusOK
+	if len(s.statuses) > 0 {
+		status, s.statuses = s.statuses[0], s.statuses[1:]
+	}
+	s.lock.Unlock()
+
+	switch status {
+	case http.StatusOK:
+		clienttest.WriteJSON(w, status, map[string]interface{}{
+			"refund_id":     "50000000382019052709732678859",
+			"out_refund_no": outRefundNo,
+			"status":        "PROCESSING",
+		})
+	case http.StatusTooManyRequests:
+		clienttest.WriteError(w, status, "FREQUENCY_LIMITED", "频率超限")
+	case http.StatusForbidden:
+		clienttest.WriteError(w, status, "NOT_ENOUGH", "余额不足")
+	default:
+		clienttest.WriteError(w, status, "SYSTEM_ERROR", "系统超时")
+	}
+}
+
+func (s *fakeRefundServer) requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.outRefundNos...)
+}
+
+func newTestRefunder(t *testing.T, server *fakeRefundServer, opts ...refunddomestic.RefunderOption) *refunddomestic.Refunder {
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	return refunddomestic.NewRefunder(client, append([]refunddomestic.RefunderOption{
+		refunddomestic.WithRefundRetry(3, time.Millisecond),
+	}, opts...)...)
+}
+
+func refunded(t *testing.T, refunder *refunddomestic.Refunder) int64 {
+	amount, err := refunder.Ledger().Refunded(context.Background(), testTransactionKey)
+	require.NoError(t, err)
+	return amount
+}
+
+func TestGenerateOutRefundNo(t *testing.T) {
+	now := time.Date(2021, 8, 1, 12, 30, 45, 0, time.Local)
+	ctx := auth.WithClock(context.Background(), auth.ClockFunc(func() time.Time { return now }))
+
+	outRefundNo, err := refunddomestic.GenerateOutRefundNo(ctx)
+	require.NoError(t, err)
+	assert.Len(t, outRefundNo, 32)
+	assert.True(t, strings.HasPrefix(outRefundNo, "RF20210801123045"), outRefundNo)
+
+	another, err := refunddomestic.GenerateOutRefundNo(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, outRefundNo, another)
+}
+
+func TestMemoryRefundLedger(t *testing.T) {
+	ctx := context.Background()
+	ledger := refunddomestic.NewMemoryRefundLedger()
+
+	require.NoError(t, ledger.Reserve(ctx, "a", "RF1", 500, 888))
+	assert.Equal(t, refunddomestic.ErrRefundExceedsTotal, ledger.Reserve(ctx, "a", "RF2", 389, 888))
+	require.NoError(t, ledger.Reserve(ctx, "a", "RF2", 388, 888))
+	// 不同交易的金额互不影响
+	require.NoError(t, ledger.Reserve(ctx, "b", "RF1", 888, 888))
+
+	amount, err := ledger.Refunded(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(888), amount)
+
+	// 同一退款单重复预留时不重复计入
+	require.NoError(t, ledger.Reserve(ctx, "a", "RF1", 500, 888))
+	amount, _ = ledger.Refunded(ctx, "a")
+	assert.Equal(t, int64(888), amount)
+
+	require.NoError(t, ledger.Release(ctx, "a", "RF2"))
+	amount, _ = ledger.Refunded(ctx, "a")
+	assert.Equal(t, int64(500), amount)
+
+	// 释放没有预留的退款单时不做修改
+	require.NoError(t, ledger.Release(ctx, "a", "RF9"))
+	amount, _ = ledger.Refunded(ctx, "a")
+	assert.Equal(t, int64(500), amount)
+
+	require.NoError(t, ledger.Release(ctx, "a", "RF1"))
+	amount, _ = ledger.Refunded(ctx, "a")
+	assert.Equal(t, int64(0), amount)
+	amount, _ = ledger.Refunded(ctx, "b")
+	assert.Equal(t, int64(888), amount)
+}
+
+func TestRefunder_Refund(t *testing.T) {
+	server := &fakeRefundServer{}
+	refunder := newTestRefunder(t, server)
+
+	resp, _, err := refunder.Refund(context.Background(), refundRequest(300, 888))
+	require.NoError(t, err)
+	assert.Equal(t, "50000000382019052709732678859", *resp.RefundId)
+	assert.Equal(t, int64(300), refunded(t, refunder))
+
+	// 未指定商户退款单号时自动生成
+	outRefundNos := server.requests()
+	require.Len(t, outRefundNos, 1)
+	assert.Len(t, outRefundNos[0], 32)
+	assert.Equal(t, outRefundNos[0], *resp.OutRefundNo)
+}
+
+func TestRefunder_RefundExceedsTotal(t *testing.T) {
+	server := &fakeRefundServer{}
+	refunder := newTestRefunder(t, server)
+	ctx := context.Background()
+
+	_, _, err := refunder.Refund(ctx, refundRequest(600, 888))
+	require.NoError(t, err)
+
+	_, _, err = refunder.Refund(ctx, refundRequest(300, 888))
+	assert.True(t, errors.Is(err, refunddomestic.ErrRefundExceedsTotal))
+	// 超额的退款申请不会发送到微信支付，也不改变台账
+	assert.Len(t, server.requests(), 1)
+	assert.Equal(t, int64(600), refunded(t, refunder))
+
+	_, _, err = refunder.Refund(ctx, refundRequest(288, 888))
+	require.NoError(t, err)
+	assert.Equal(t, int64(888), refunded(t, refunder))
+}
+
+func TestRefunder_RefundInvalidAmount(t *testing.T) {
+	server := &fakeRefundServer{}
+	refunder := newTestRefunder(t, server)
+
+	for _, req := range []refunddomestic.CreateRequest{
+		{TransactionId: core.String("1217752501201407033233368018")},
+		refundRequest(0, 888),
+		refundRequest(889, 888),
+		{Amount: &refunddomestic.AmountReq{Refund: core.Int64(1), Total: core.Int64(888)}},
+	} {
+		_, _, err := refunder.Refund(context.Background(), req)
+		assert.Error(t, err)
+	}
+	assert.Empty(t, server.requests())
+}
+
+func TestRefunder_RefundReleaseOnRejection(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		code     string
+		refunded int64
+	}{
+		{name: "4xx rejection releases reservation", statuses: []int{http.StatusForbidden}, code: "NOT_ENOUGH", refunded: 0},
+		{
+			name:     "frequency limited after retries releases reservation",
+			statuses: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			code:     "FREQUENCY_LIMITED",
+			refunded: 0,
+		},
+		{name: "5xx keeps reservation", statuses: []int{http.StatusInternalServerError}, code: "SYSTEM_ERROR", refunded: 300},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeRefundServer{statuses: tt.statuses}
+			refunder := newTestRefunder(t, server)
+
+			_, _, err := refunder.Refund(context.Background(), refundRequest(300, 888))
+			require.Error(t, err)
+			var apiErr *core.APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.code, apiErr.Code)
+			assert.Equal(t, tt.refunded, refunded(t, refunder))
+		})
+	}
+}
+
+func TestRefunder_RefundUnknownOutcomeBlocksOverRefund(t *testing.T) {
+	server := &fakeRefundServer{statuses: []int{http.StatusInternalServerError}}
+	refunder := newTestRefunder(t, server)
+	ctx := context.Background()
+
+	_, _, err := refunder.Refund(ctx, refundRequest(600, 888))
+	require.Error(t, err)
+
+	// 结果未知的退款可能已被受理，其金额仍计入台账
+	_, _, err = refunder.Refund(ctx, refundRequest(300, 888))
+	assert.True(t, errors.Is(err, refunddomestic.ErrRefundExceedsTotal))
+}
+
+func TestRefunder_RefundRetryAfterUnknownOutcome(t *testing.T) {
+	server := &fakeRefundServer{statuses: []int{http.StatusInternalServerError}}
+	refunder := newTestRefunder(t, server)
+	ctx := context.Background()
+
+	req := refundRequest(888, 888)
+	req.OutRefundNo = core.String("1217752501201407033233368018")
+	_, _, err := refunder.Refund(ctx, req)
+	require.Error(t, err)
+	assert.Equal(t, int64(888), refunded(t, refunder))
+
+	// 使用同一商户退款单号重新申请全额退款时不会重复预留
+	resp, _, err := refunder.Refund(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "1217752501201407033233368018", *resp.OutRefundNo)
+	assert.Equal(t, int64(888), refunded(t, refunder))
+	assert.Equal(t, []string{"1217752501201407033233368018", "1217752501201407033233368018"}, server.requests())
+}
+
+func TestRefunder_RefundRetry(t *testing.T) {
+	server := &fakeRefundServer{statuses: []int{http.StatusTooManyRequests, http.StatusTooManyRequests}}
+	refunder := newTestRefunder(t, server)
+
+	req := refundRequest(300, 888)
+	req.OutRefundNo = core.String("1217752501201407033233368018")
+	resp, _, err := refunder.Refund(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "1217752501201407033233368018", *resp.OutRefundNo)
+	assert.Equal(t, int64(300), refunded(t, refunder))
+
+	// 重试时使用相同的商户退款单号
+	assert.Equal(t, []string{
+		"1217752501201407033233368018", "1217752501201407033233368018", "1217752501201407033233368018",
+	}, server.requests())
+}
+
+func TestRefunder_RefundRetryNotForOtherErrors(t *testing.T) {
+	server := &fakeRefundServer{statuses: []int{http.StatusInternalServerError}}
+	refunder := newTestRefunder(t, server)
+
+	_, _, err := refunder.Refund(context.Background(), refundRequest(300, 888))
+	require.Error(t, err)
+	assert.Len(t, server.requests(), 1)
+}
+
+func TestRefunder_RefundContextCanceledDuringRetry(t *testing.T) {
+	server := &fakeRefundServer{statuses: []int{http.StatusTooManyRequests}}
+	refunder := newTestRefunder(t, server, refunddomestic.WithRefundRetry(3, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := refunder.Refund(ctx, refundRequest(300, 888))
+	require.Error(t, err)
+
+	// 等待重试时取消，与直接失败一样可以取得最后一次的 APIError，并释放预留金额
+	var apiErr *core.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "FREQUENCY_LIMITED", apiErr.Code)
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+	assert.Len(t, server.requests(), 1)
+	assert.Equal(t, int64(0), refunded(t, refunder))
+}
@@ -0,0 +1,60 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+// AccountManager 特约商户结算账户管理
+//
+// 修改结算账户时，自动使用 Client 的 cipher 加密银行账号与开户名称，并设置 Wechatpay-Serial 请求头。
+type AccountManager struct {
+	client       *core.Client
+	subMerchants SubMerchantsApiService
+}
+
+// NewAccountManager 创建 AccountManager
+func NewAccountManager(client *core.Client) *AccountManager {
+	return &AccountManager{client: client, subMerchants: SubMerchantsApiService{Client: client}}
+}
+
+// Modify 提交修改结算账户申请。req 中的 AccountNumber 与 AccountName 为明文时将被加密
+//
+// 已设置 WechatpaySerial 时视为调用方已自行加密，不再加密。未设置 cipher 时返回错误，避免明文银行账号被发送。
+func (m *AccountManager) Modify(
+	ctx context.Context, req ModifySettlementRequest,
+) (resp *ModifySettlementResponse, result *core.APIResult, err error) {
+	if req.AccountNumber == nil || *req.AccountNumber == "" {
+		return nil, nil, fmt.Errorf("field `AccountNumber` is required and must be specified in ModifySettlementRequest")
+	}
+
+	req = *req.Clone()
+	if req.WechatpaySerial == nil {
+		serial, err := m.client.EncryptRequest(ctx, &req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encrypt settlement account err: %v", err)
+		}
+		if serial == "" {
+			return nil, nil, fmt.Errorf("encrypt settlement account err: cipher is not configured in client")
+		}
+		req.WechatpaySerial = core.String(serial)
+	}
+	return m.subMerchants.ModifySettlement(ctx, req)
+}
+
+// GetApplication 查询结算账户修改申请的审核状态
+func (m *AccountManager) GetApplication(
+	ctx context.Context, subMchid, applicationNo string,
+) (resp *SettlementApplication, result *core.APIResult, err error) {
+	return m.subMerchants.GetSettlementApplication(ctx, GetSettlementApplicationRequest{
+		SubMchid:      core.String(subMchid),
+		ApplicationNo: core.String(applicationNo),
+	})
+}
+
+// Get 查询特约商户当前生效的结算账户
+func (m *AccountManager) Get(ctx context.Context, subMchid string) (resp *Settlement, result *core.APIResult, err error) {
+	return m.subMerchants.GetSettlement(ctx, GetSettlementRequest{SubMchid: core.String(subMchid)})
+}
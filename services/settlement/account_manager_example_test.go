@@ -0,0 +1,37 @@
+package settlement_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/settlement"
+)
+
+func ExampleAccountManager_Modify() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client，并设置了敏感信息加解密所用的 cipher
+
+	manager := settlement.NewAccountManager(client)
+	resp, result, err := manager.Modify(ctx,
+		settlement.ModifySettlementRequest{
+			SubMchid:        core.String("1900006491"),
+			ModifyMode:      settlement.MODIFYMODE_MODIFY_MODE_ASYNC.Ptr(),
+			AccountType:     settlement.ACCOUNTTYPE_ACCOUNT_TYPE_BUSINESS.Ptr(),
+			AccountBank:     core.String("工商银行"),
+			BankAddressCode: core.String("110000"),
+			AccountNumber:   core.String("6222000000000000000"), // 明文，将被自动加密
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	// 稍后查询审核结果
+	application, result, err := manager.GetApplication(ctx, "1900006491", *resp.ApplicationNo)
+
+	// TODO: 处理返回结果
+	_, _, _ = application, result, err
+}
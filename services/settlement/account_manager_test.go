@@ -0,0 +1,166 @@
+package settlement_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/decryptors"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/cipher/encryptors"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/option"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/settlement"
+	"github.com/wechatpay-apiv3/wechatpay-go/utils"
+)
+
+const testPublicKeyID = "PUB_KEY_ID_TEST_SETTLEMENT"
+
+// receivedRequest 模拟接口收到的请求
+type receivedRequest struct {
+	Method string
+	Path   string
+	Serial string
+	Body   map[string]interface{}
+}
+
+// fakeSettlementServer 模拟结算账户接口：settlements 为特约商户号对应的结算账户应答，
+// errors 为特约商户号对应的错误状态码，未配置的特约商户号返回 404
+type fakeSettlementServer struct {
+	settlements map[string]interface{}
+	errors      map[string]int
+
+	lock     sync.Mutex
+	requests []receivedRequest
+}
+
+func (s *fakeSettlementServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	received := receivedRequest{Method: r.Method, Path: r.URL.Path, Serial: r.Header.Get("Wechatpay-Serial")}
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&received.Body)
+	}
+	s.requests = append(s.requests, received)
+
+	segments := strings.Split(strings.TrimPrefix(r.URL.Path, "/v3/apply4sub/sub_merchants/"), "/")
+	subMchid := segments[0]
+	switch {
+	case s.errors[subMchid] != 0:
+		clienttest.WriteError(w, s.errors[subMchid], "PARAM_ERROR", "模拟错误")
+	case segments[1] == "modify-settlement":
+		clienttest.WriteJSON(w, http.StatusOK, map[string]string{"application_no": "102329389XXXX"})
+	case segments[1] == "application":
+		clienttest.WriteJSON(w, http.StatusOK, map[string]string{
+			"account_name":   "*张三",
+			"account_type":   "ACCOUNT_TYPE_PRIVATE",
+			"account_bank":   "工商银行",
+			"account_number": "62*************78",
+			"verify_result":  "AUDITING",
+		})
+	case s.settlements[subMchid] != nil:
+		clienttest.WriteJSON(w, http.StatusOK, s.settlements[subMchid])
+	default:
+		clienttest.WriteError(w, http.StatusNotFound, "RESOURCE_NOT_EXISTS", "特约商户不存在")
+	}
+}
+
+func newTestAccountManager(t *testing.T, server *fakeSettlementServer, opts ...core.ClientOption) *settlement.AccountManager {
+	client, err := clienttest.NewHandlerClient(server, opts...)
+	require.NoError(t, err)
+	return settlement.NewAccountManager(client)
+}
+
+func modifyRequest() settlement.ModifySettlementRequest {
+	return settlement.ModifySettlementRequest{
+		SubMchid:        core.String("1900006491"),
+		AccountType:     settlement.ACCOUNTTYPE_ACCOUNT_TYPE_PRIVATE.Ptr(),
+		AccountBank:     core.String("工商银行"),
+		BankAddressCode: core.String("110000"),
+		AccountNumber:   core.String("6222000000000000000"),
+		AccountName:     core.String("张三"),
+	}
+}
+
+func TestAccountManager_Modify(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := &fakeSettlementServer{}
+	manager := newTestAccountManager(t, server, option.WithWechatPayCipher(
+		encryptors.NewWechatPayPubKeyEncryptor(testPublicKeyID, &privateKey.PublicKey),
+		decryptors.NewWechatPayDecryptor(privateKey),
+	))
+
+	req := modifyRequest()
+	resp, _, err := manager.Modify(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "102329389XXXX", *resp.ApplicationNo)
+	// 调用方的 req 不被修改
+	assert.Equal(t, modifyRequest(), req)
+
+	require.Len(t, server.requests, 1)
+	received := server.requests[0]
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "/v3/apply4sub/sub_merchants/1900006491/modify-settlement", received.Path)
+	assert.Equal(t, testPublicKeyID, received.Serial)
+	assert.Equal(t, "工商银行", received.Body["account_bank"])
+	for field, plaintext := range map[string]string{"account_number": "6222000000000000000", "account_name": "张三"} {
+		ciphertext, ok := received.Body[field].(string)
+		require.True(t, ok, field)
+		assert.NotEqual(t, plaintext, ciphertext, field)
+		decrypted, err := utils.DecryptOAEP(ciphertext, privateKey)
+		require.NoError(t, err, field)
+		assert.Equal(t, plaintext, decrypted, field)
+	}
+}
+
+func TestAccountManager_ModifyEncrypted(t *testing.T) {
+	// 已设置 WechatpaySerial 时视为已加密，原样发送
+	server := &fakeSettlementServer{}
+	manager := newTestAccountManager(t, server)
+	req := modifyRequest()
+	req.WechatpaySerial = core.String(testPublicKeyID)
+	req.AccountNumber = core.String("ENCRYPTED_ACCOUNT_NUMBER")
+
+	_, _, err := manager.Modify(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, server.requests, 1)
+	assert.Equal(t, testPublicKeyID, server.requests[0].Serial)
+	assert.Equal(t, "ENCRYPTED_ACCOUNT_NUMBER", server.requests[0].Body["account_number"])
+}
+
+func TestAccountManager_ModifyInvalid(t *testing.T) {
+	server := &fakeSettlementServer{}
+	manager := newTestAccountManager(t, server)
+
+	// 未设置 cipher 时不发送明文银行账号
+	_, _, err := manager.Modify(context.Background(), modifyRequest())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cipher is not configured in client")
+
+	req := modifyRequest()
+	req.AccountNumber = core.String("")
+	_, _, err = manager.Modify(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "field `AccountNumber` is required")
+
+	assert.Empty(t, server.requests)
+}
+
+func TestAccountManager_GetApplication(t *testing.T) {
+	server := &fakeSettlementServer{}
+	manager := newTestAccountManager(t, server)
+
+	application, _, err := manager.GetApplication(context.Background(), "1900006491", "102329389XXXX")
+	require.NoError(t, err)
+	assert.Equal(t, settlement.APPLICATIONVERIFYRESULT_AUDITING, *application.VerifyResult)
+	assert.Equal(t, "/v3/apply4sub/sub_merchants/1900006491/application/102329389XXXX", server.requests[0].Path)
+}
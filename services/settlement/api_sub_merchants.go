@@ -0,0 +1,202 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户结算账户API
+//
+// 服务商查询与修改特约商户结算账户的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package settlement
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type SubMerchantsApiService services.Service
+
+// GetSettlement 查询结算账户
+//
+// # 应用场景
+// 服务商可以通过该接口查询特约商户当前生效的结算账户信息，银行账号以掩码形式返回。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *SubMerchantsApiService) GetSettlement(ctx context.Context, req GetSettlementRequest) (resp *Settlement, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in GetSettlementRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/apply4sub/sub_merchants/{sub_mchid}/settlement"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"sub_mchid"+"}", neturl.PathEscape(core.ParameterToString(*req.SubMchid, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Settlement from Http Response
+	resp = new(Settlement)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// GetSettlementApplication 查询结算账户修改申请状态
+//
+// # 应用场景
+// 服务商可以通过该接口查询结算账户修改申请的审核状态。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|申请单不存在|申请单号不存在|请确认申请单号是否正确|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *SubMerchantsApiService) GetSettlementApplication(ctx context.Context, req GetSettlementApplicationRequest) (resp *SettlementApplication, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in GetSettlementApplicationRequest")
+	}
+	if req.ApplicationNo == nil {
+		return nil, nil, fmt.Errorf("field `ApplicationNo` is required and must be specified in GetSettlementApplicationRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/apply4sub/sub_merchants/{sub_mchid}/application/{application_no}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"sub_mchid"+"}", neturl.PathEscape(core.ParameterToString(*req.SubMchid, "")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"application_no"+"}", neturl.PathEscape(core.ParameterToString(*req.ApplicationNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract SettlementApplication from Http Response
+	resp = new(SettlementApplication)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// ModifySettlement 修改结算账户
+//
+// # 应用场景
+// 服务商可以通过该接口为已完成进件的特约商户提交修改结算账户的申请，申请受理后返回申请单号，可通过查询结算账户修改申请状态接口获取审核结果。
+//
+// 注意：
+// 1、银行账号与开户名称属于敏感信息，需使用微信支付平台证书加密，并通过Wechatpay-Serial请求头传递加密所用的平台证书序列号
+// 2、结算账户修改审核中时不能再次提交申请
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|特约商户状态不允许修改结算账户，或已有修改申请审核中|请确认特约商户状态后重试|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *SubMerchantsApiService) ModifySettlement(ctx context.Context, req ModifySettlementRequest) (resp *ModifySettlementResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in ModifySettlementRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/apply4sub/sub_merchants/{sub_mchid}/modify-settlement"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"sub_mchid"+"}", neturl.PathEscape(core.ParameterToString(*req.SubMchid, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Header Params
+	if req.WechatpaySerial != nil {
+		localVarHeaderParams.Set("Wechatpay-Serial", core.ParameterToString(*req.WechatpaySerial, ""))
+	}
+
+	// Setup Body Params
+	localVarPostBody = &ModifySettlementBody{
+		ModifyMode:      req.ModifyMode,
+		AccountType:     req.AccountType,
+		AccountBank:     req.AccountBank,
+		BankAddressCode: req.BankAddressCode,
+		BankName:        req.BankName,
+		BankBranchId:    req.BankBranchId,
+		AccountNumber:   req.AccountNumber,
+		AccountName:     req.AccountName,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ModifySettlementResponse from Http Response
+	resp = new(ModifySettlementResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户结算账户API
+//
+// 服务商查询与修改特约商户结算账户的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package settlement_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/settlement"
+)
+
+func ExampleSubMerchantsApiService_GetSettlement() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := settlement.SubMerchantsApiService{Client: client}
+	resp, result, err := svc.GetSettlement(ctx,
+		settlement.GetSettlementRequest{
+			SubMchid: core.String("1900006491"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleSubMerchantsApiService_GetSettlementApplication() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := settlement.SubMerchantsApiService{Client: client}
+	resp, result, err := svc.GetSettlementApplication(ctx,
+		settlement.GetSettlementApplicationRequest{
+			ApplicationNo: core.String("102329389XXXX"),
+			SubMchid:      core.String("1900006491"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleSubMerchantsApiService_ModifySettlement() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := settlement.SubMerchantsApiService{Client: client}
+	resp, result, err := svc.ModifySettlement(ctx,
+		settlement.ModifySettlementRequest{
+			AccountBank:     core.String("工商银行"),
+			AccountName:     core.String("AOZdYGISxo4y44/UgZ69bdu9X+tfMUJ9dl+LetjM45/zMbrYu+wWZ8gn4CTdo+D/m9MrPg+V4sm73oxqdQu/hj7aWyDl4GQtPXVdaztB9jVbVZh3QFzV+BEmytMNQp9dt1uWJktlfdDdLR3AMWyMB377xd+m9bSr/ioDTzagEcGe+vLYiKrzcroQv3OR0p3ppFYoQ3IfYeU1KeXjZE9MBAIlF+Ux70apu8MWpUJleivt3Dj1NAzrSUGprnErpEu/IG5HUUCcIgcEvZbhQ2b43b6LFxhTXYxXt1zqaVlfVN+QcyuhtNoM0OddhX3ql6G3sQY9HaUNIDUYPzD1GPkTqA=="),
+			AccountNumber:   core.String("d+xT+MQCvrLHUVDWv/8MR/dB7TkXLVfSrUxMPZy6jWWYzpRrEEaYQE8ZRGYoeorwC+w=="),
+			AccountType:     settlement.ACCOUNTTYPE_ACCOUNT_TYPE_BUSINESS.Ptr(),
+			BankAddressCode: core.String("110000"),
+			BankBranchId:    core.String("402713354941"),
+			BankName:        core.String("中国工商银行股份有限公司北京市分行营业部"),
+			ModifyMode:      settlement.MODIFYMODE_MODIFY_MODE_ASYNC.Ptr(),
+			SubMchid:        core.String("1900006491"),
+			WechatpaySerial: core.String("5157F09EFDC096DE15EBE81A47057A7232F1B8E1"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
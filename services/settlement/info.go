@@ -0,0 +1,45 @@
+package settlement
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/batch"
+)
+
+// SettlementInfo 特约商户当前生效的结算账户的查询结果，用于服务商后台展示
+//
+// 微信支付仅以掩码形式返回开户名称与银行账号，不返回明文，也没有查询特约商户费率与结算周期的 APIv3 接口；
+// 费率由进件时选择的结算规则（applyment4sub.SettlementInfo.SettlementId）决定，需由服务商自行记录
+type SettlementInfo struct {
+	// SubMchid 特约商户号
+	SubMchid string
+	// Settlement 当前生效的结算账户，查询失败时为 nil
+	Settlement *Settlement
+	// Err 查询失败的原因
+	Err error
+}
+
+// Verified 结算账户是否已通过汇款验证、可正常结算
+func (i *SettlementInfo) Verified() bool {
+	return i.Settlement != nil && i.Settlement.VerifyResult != nil &&
+		*i.Settlement.VerifyResult == SETTLEMENTVERIFYRESULT_VERIFY_SUCCESS
+}
+
+// List 批量查询特约商户当前生效的结算账户，按 subMchids 的顺序返回，单个查询失败不影响其他查询
+//
+// 默认以 8 个并发查询，并对可重试的错误重试，可通过 opts 调整，见 batch.Query
+func (m *AccountManager) List(ctx context.Context, subMchids []string, opts ...batch.Option) []SettlementInfo {
+	results := batch.Query(ctx, len(subMchids), func(ctx context.Context, i int) (interface{}, error) {
+		resp, _, err := m.Get(ctx, subMchids[i])
+		return resp, err
+	}, opts...)
+
+	infos := make([]SettlementInfo, len(subMchids))
+	for i, result := range results {
+		infos[i] = SettlementInfo{SubMchid: subMchids[i], Err: result.Err}
+		if resp, ok := result.Value.(*Settlement); ok && result.Err == nil {
+			infos[i].Settlement = resp
+		}
+	}
+	return infos
+}
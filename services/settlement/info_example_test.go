@@ -0,0 +1,29 @@
+package settlement_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/batch"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/settlement"
+)
+
+func ExampleAccountManager_List() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	manager := settlement.NewAccountManager(client)
+	infos := manager.List(ctx, []string{"1900006491", "1900006492"}, batch.WithConcurrency(4))
+	for _, info := range infos {
+		if info.Err != nil {
+			log.Printf("query settlement of %s err:%v", info.SubMchid, info.Err)
+			continue
+		}
+		log.Printf("%s %s %s verified:%v", info.SubMchid, *info.Settlement.AccountBank,
+			*info.Settlement.AccountNumber, info.Verified())
+	}
+}
@@ -0,0 +1,86 @@
+package settlement_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/batch"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/settlement"
+)
+
+func settlementResponse(verifyResult string) map[string]interface{} {
+	resp := map[string]interface{}{
+		"account_type":   "ACCOUNT_TYPE_BUSINESS",
+		"account_bank":   "工商银行",
+		"account_number": "62*************78",
+	}
+	if verifyResult != "" {
+		resp["verify_result"] = verifyResult
+	}
+	return resp
+}
+
+func TestAccountManager_List(t *testing.T) {
+	server := &fakeSettlementServer{
+		settlements: map[string]interface{}{
+			"1900000001": settlementResponse("VERIFY_SUCCESS"),
+			"1900000002": settlementResponse("VERIFYING"),
+			"1900000004": settlementResponse(""),
+			"1900000005": settlementResponse("VERIFY_SUCCESS"),
+		},
+		errors: map[string]int{"1900000003": http.StatusForbidden},
+	}
+	manager := newTestAccountManager(t, server)
+	subMchids := []string{"1900000005", "1900000003", "1900000001", "1900000004", "1900000002"}
+
+	infos := manager.List(context.Background(), subMchids, batch.WithConcurrency(3), batch.WithRetry(1, time.Millisecond))
+	require.Len(t, infos, len(subMchids))
+
+	// 按 subMchids 的顺序返回
+	for i, info := range infos {
+		assert.Equal(t, subMchids[i], info.SubMchid)
+	}
+
+	// 单个查询失败只记录在对应的结果中
+	failed := infos[1]
+	require.Error(t, failed.Err)
+	assert.True(t, core.IsAPIError(failed.Err, "PARAM_ERROR"))
+	assert.Nil(t, failed.Settlement)
+	assert.False(t, failed.Verified())
+	for _, i := range []int{0, 2, 3, 4} {
+		assert.NoError(t, infos[i].Err, subMchids[i])
+		require.NotNil(t, infos[i].Settlement, subMchids[i])
+		assert.Equal(t, "62*************78", *infos[i].Settlement.AccountNumber, subMchids[i])
+	}
+
+	assert.True(t, infos[0].Verified())
+	assert.True(t, infos[2].Verified())
+	assert.False(t, infos[4].Verified())
+	// 应答中缺少 verify_result 时视为未验证
+	assert.Nil(t, infos[3].Settlement.VerifyResult)
+	assert.False(t, infos[3].Verified())
+}
+
+func TestAccountManager_ListNotFound(t *testing.T) {
+	manager := newTestAccountManager(t, &fakeSettlementServer{})
+	infos := manager.List(context.Background(), []string{"1900000009"}, batch.WithRetry(1, time.Millisecond))
+	require.Len(t, infos, 1)
+	assert.True(t, core.IsAPIError(infos[0].Err, "RESOURCE_NOT_EXISTS"))
+	assert.False(t, infos[0].Verified())
+
+	assert.Empty(t, manager.List(context.Background(), nil))
+}
+
+func TestSettlementInfo_Verified(t *testing.T) {
+	assert.False(t, (&settlement.SettlementInfo{}).Verified())
+	assert.False(t, (&settlement.SettlementInfo{Settlement: &settlement.Settlement{}}).Verified())
+	assert.True(t, (&settlement.SettlementInfo{Settlement: &settlement.Settlement{
+		VerifyResult: settlement.SETTLEMENTVERIFYRESULT_VERIFY_SUCCESS.Ptr(),
+	}}).Verified())
+}
@@ -0,0 +1,985 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户结算账户API
+//
+// 服务商查询与修改特约商户结算账户的API
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package settlement
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AccountType * `ACCOUNT_TYPE_BUSINESS` - 对公银行账户 * `ACCOUNT_TYPE_PRIVATE` - 经营者个人银行卡
+type AccountType string
+
+func (e AccountType) Ptr() *AccountType {
+	return &e
+}
+
+// Enums of AccountType
+const (
+	ACCOUNTTYPE_ACCOUNT_TYPE_BUSINESS AccountType = "ACCOUNT_TYPE_BUSINESS"
+	ACCOUNTTYPE_ACCOUNT_TYPE_PRIVATE  AccountType = "ACCOUNT_TYPE_PRIVATE"
+)
+
+func (v *AccountType) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := AccountType(value)
+	for _, existing := range []AccountType{"ACCOUNT_TYPE_BUSINESS", "ACCOUNT_TYPE_PRIVATE"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid AccountType", value)
+}
+
+// ApplicationVerifyResult * `AUDIT_SUCCESS` - 审核成功 * `AUDITING` - 审核中 * `AUDIT_FAIL` - 审核驳回
+type ApplicationVerifyResult string
+
+func (e ApplicationVerifyResult) Ptr() *ApplicationVerifyResult {
+	return &e
+}
+
+// Enums of ApplicationVerifyResult
+const (
+	APPLICATIONVERIFYRESULT_AUDIT_SUCCESS ApplicationVerifyResult = "AUDIT_SUCCESS"
+	APPLICATIONVERIFYRESULT_AUDITING      ApplicationVerifyResult = "AUDITING"
+	APPLICATIONVERIFYRESULT_AUDIT_FAIL    ApplicationVerifyResult = "AUDIT_FAIL"
+)
+
+func (v *ApplicationVerifyResult) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ApplicationVerifyResult(value)
+	for _, existing := range []ApplicationVerifyResult{"AUDIT_SUCCESS", "AUDITING", "AUDIT_FAIL"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ApplicationVerifyResult", value)
+}
+
+// GetSettlementApplicationRequest
+type GetSettlementApplicationRequest struct {
+	// 特约商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 修改结算账户申请单号，提交修改结算账户申请时返回
+	ApplicationNo *string `json:"application_no"`
+}
+
+func (o GetSettlementApplicationRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in GetSettlementApplicationRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.ApplicationNo == nil {
+		return nil, fmt.Errorf("field `ApplicationNo` is required and must be specified in GetSettlementApplicationRequest")
+	}
+	toSerialize["application_no"] = o.ApplicationNo
+	return json.Marshal(toSerialize)
+}
+
+func (o GetSettlementApplicationRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.ApplicationNo == nil {
+		ret += "ApplicationNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("ApplicationNo:%v", *o.ApplicationNo)
+	}
+
+	return fmt.Sprintf("GetSettlementApplicationRequest{%s}", ret)
+}
+
+func (o GetSettlementApplicationRequest) Clone() *GetSettlementApplicationRequest {
+	ret := GetSettlementApplicationRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.ApplicationNo != nil {
+		ret.ApplicationNo = new(string)
+		*ret.ApplicationNo = *o.ApplicationNo
+	}
+
+	return &ret
+}
+
+// GetSettlementRequest
+type GetSettlementRequest struct {
+	// 特约商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o GetSettlementRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in GetSettlementRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o GetSettlementRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("GetSettlementRequest{%s}", ret)
+}
+
+func (o GetSettlementRequest) Clone() *GetSettlementRequest {
+	ret := GetSettlementRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// ModifyMode * `MODIFY_MODE_ASYNC` - 异步提交，通过申请单号查询审核结果
+type ModifyMode string
+
+func (e ModifyMode) Ptr() *ModifyMode {
+	return &e
+}
+
+// Enums of ModifyMode
+const (
+	MODIFYMODE_MODIFY_MODE_ASYNC ModifyMode = "MODIFY_MODE_ASYNC"
+)
+
+func (v *ModifyMode) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ModifyMode(value)
+	for _, existing := range []ModifyMode{"MODIFY_MODE_ASYNC"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ModifyMode", value)
+}
+
+// ModifySettlementBody
+type ModifySettlementBody struct {
+	// 修改模式，不填时为同步修改
+	ModifyMode *ModifyMode `json:"modify_mode,omitempty"`
+	// 账户类型
+	AccountType *AccountType `json:"account_type"`
+	// 开户银行，详见文档开户银行对照表
+	AccountBank *string `json:"account_bank"`
+	// 开户银行省市编码，至少精确到市，详见文档省市区编号对照表
+	BankAddressCode *string `json:"bank_address_code"`
+	// 开户银行全称（含支行），17家直连银行无需填写，其他银行需填写
+	BankName *string `json:"bank_name,omitempty"`
+	// 开户银行联行号，17家直连银行无需填写，其他银行需填写，与开户银行全称二选一
+	BankBranchId *string `json:"bank_branch_id,omitempty"`
+	// 银行账号，数字，长度遵循系统支持的对私/对公卡号长度要求。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	AccountNumber *string `json:"account_number" encryption:"EM_APIV3"`
+	// 开户名称，需与进件时的开户名称一致，选填。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	AccountName *string `json:"account_name,omitempty" encryption:"EM_APIV3"`
+}
+
+func (o ModifySettlementBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ModifyMode != nil {
+		toSerialize["modify_mode"] = o.ModifyMode
+	}
+
+	if o.AccountType == nil {
+		return nil, fmt.Errorf("field `AccountType` is required and must be specified in ModifySettlementBody")
+	}
+	toSerialize["account_type"] = o.AccountType
+
+	if o.AccountBank == nil {
+		return nil, fmt.Errorf("field `AccountBank` is required and must be specified in ModifySettlementBody")
+	}
+	toSerialize["account_bank"] = o.AccountBank
+
+	if o.BankAddressCode == nil {
+		return nil, fmt.Errorf("field `BankAddressCode` is required and must be specified in ModifySettlementBody")
+	}
+	toSerialize["bank_address_code"] = o.BankAddressCode
+
+	if o.BankName != nil {
+		toSerialize["bank_name"] = o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		toSerialize["bank_branch_id"] = o.BankBranchId
+	}
+
+	if o.AccountNumber == nil {
+		return nil, fmt.Errorf("field `AccountNumber` is required and must be specified in ModifySettlementBody")
+	}
+	toSerialize["account_number"] = o.AccountNumber
+
+	if o.AccountName != nil {
+		toSerialize["account_name"] = o.AccountName
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifySettlementBody) String() string {
+	var ret string
+	if o.ModifyMode == nil {
+		ret += "ModifyMode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ModifyMode:%v, ", *o.ModifyMode)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountType:%v, ", *o.AccountType)
+	}
+
+	if o.AccountBank == nil {
+		ret += "AccountBank:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountBank:%v, ", *o.AccountBank)
+	}
+
+	if o.BankAddressCode == nil {
+		ret += "BankAddressCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankAddressCode:%v, ", *o.BankAddressCode)
+	}
+
+	if o.BankName == nil {
+		ret += "BankName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankName:%v, ", *o.BankName)
+	}
+
+	if o.BankBranchId == nil {
+		ret += "BankBranchId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankBranchId:%v, ", *o.BankBranchId)
+	}
+
+	if o.AccountNumber == nil {
+		ret += "AccountNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountNumber:%v, ", *o.AccountNumber)
+	}
+
+	if o.AccountName == nil {
+		ret += "AccountName:<nil>"
+	} else {
+		ret += fmt.Sprintf("AccountName:%v", *o.AccountName)
+	}
+
+	return fmt.Sprintf("ModifySettlementBody{%s}", ret)
+}
+
+func (o ModifySettlementBody) Clone() *ModifySettlementBody {
+	ret := ModifySettlementBody{}
+
+	if o.ModifyMode != nil {
+		ret.ModifyMode = new(ModifyMode)
+		*ret.ModifyMode = *o.ModifyMode
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(AccountType)
+		*ret.AccountType = *o.AccountType
+	}
+
+	if o.AccountBank != nil {
+		ret.AccountBank = new(string)
+		*ret.AccountBank = *o.AccountBank
+	}
+
+	if o.BankAddressCode != nil {
+		ret.BankAddressCode = new(string)
+		*ret.BankAddressCode = *o.BankAddressCode
+	}
+
+	if o.BankName != nil {
+		ret.BankName = new(string)
+		*ret.BankName = *o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		ret.BankBranchId = new(string)
+		*ret.BankBranchId = *o.BankBranchId
+	}
+
+	if o.AccountNumber != nil {
+		ret.AccountNumber = new(string)
+		*ret.AccountNumber = *o.AccountNumber
+	}
+
+	if o.AccountName != nil {
+		ret.AccountName = new(string)
+		*ret.AccountName = *o.AccountName
+	}
+
+	return &ret
+}
+
+// ModifySettlementRequest
+type ModifySettlementRequest struct {
+	// 特约商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 请求包体中存在敏感信息加密字段时，需传递用于加密的微信支付平台证书序列号
+	WechatpaySerial *string `json:"Wechatpay-Serial,omitempty"`
+	// 修改模式，不填时为同步修改
+	ModifyMode *ModifyMode `json:"modify_mode,omitempty"`
+	// 账户类型
+	AccountType *AccountType `json:"account_type"`
+	// 开户银行，详见文档开户银行对照表
+	AccountBank *string `json:"account_bank"`
+	// 开户银行省市编码，至少精确到市，详见文档省市区编号对照表
+	BankAddressCode *string `json:"bank_address_code"`
+	// 开户银行全称（含支行），17家直连银行无需填写，其他银行需填写
+	BankName *string `json:"bank_name,omitempty"`
+	// 开户银行联行号，17家直连银行无需填写，其他银行需填写，与开户银行全称二选一
+	BankBranchId *string `json:"bank_branch_id,omitempty"`
+	// 银行账号，数字，长度遵循系统支持的对私/对公卡号长度要求。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	AccountNumber *string `json:"account_number" encryption:"EM_APIV3"`
+	// 开户名称，需与进件时的开户名称一致，选填。该字段需进行加密处理，加密方法详见敏感信息加密说明
+	AccountName *string `json:"account_name,omitempty" encryption:"EM_APIV3"`
+}
+
+func (o ModifySettlementRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in ModifySettlementRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.WechatpaySerial != nil {
+		toSerialize["Wechatpay-Serial"] = o.WechatpaySerial
+	}
+
+	if o.ModifyMode != nil {
+		toSerialize["modify_mode"] = o.ModifyMode
+	}
+
+	if o.AccountType == nil {
+		return nil, fmt.Errorf("field `AccountType` is required and must be specified in ModifySettlementRequest")
+	}
+	toSerialize["account_type"] = o.AccountType
+
+	if o.AccountBank == nil {
+		return nil, fmt.Errorf("field `AccountBank` is required and must be specified in ModifySettlementRequest")
+	}
+	toSerialize["account_bank"] = o.AccountBank
+
+	if o.BankAddressCode == nil {
+		return nil, fmt.Errorf("field `BankAddressCode` is required and must be specified in ModifySettlementRequest")
+	}
+	toSerialize["bank_address_code"] = o.BankAddressCode
+
+	if o.BankName != nil {
+		toSerialize["bank_name"] = o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		toSerialize["bank_branch_id"] = o.BankBranchId
+	}
+
+	if o.AccountNumber == nil {
+		return nil, fmt.Errorf("field `AccountNumber` is required and must be specified in ModifySettlementRequest")
+	}
+	toSerialize["account_number"] = o.AccountNumber
+
+	if o.AccountName != nil {
+		toSerialize["account_name"] = o.AccountName
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifySettlementRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.WechatpaySerial == nil {
+		ret += "WechatpaySerial:<nil>, "
+	} else {
+		ret += fmt.Sprintf("WechatpaySerial:%v, ", *o.WechatpaySerial)
+	}
+
+	if o.ModifyMode == nil {
+		ret += "ModifyMode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ModifyMode:%v, ", *o.ModifyMode)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountType:%v, ", *o.AccountType)
+	}
+
+	if o.AccountBank == nil {
+		ret += "AccountBank:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountBank:%v, ", *o.AccountBank)
+	}
+
+	if o.BankAddressCode == nil {
+		ret += "BankAddressCode:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankAddressCode:%v, ", *o.BankAddressCode)
+	}
+
+	if o.BankName == nil {
+		ret += "BankName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankName:%v, ", *o.BankName)
+	}
+
+	if o.BankBranchId == nil {
+		ret += "BankBranchId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankBranchId:%v, ", *o.BankBranchId)
+	}
+
+	if o.AccountNumber == nil {
+		ret += "AccountNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountNumber:%v, ", *o.AccountNumber)
+	}
+
+	if o.AccountName == nil {
+		ret += "AccountName:<nil>"
+	} else {
+		ret += fmt.Sprintf("AccountName:%v", *o.AccountName)
+	}
+
+	return fmt.Sprintf("ModifySettlementRequest{%s}", ret)
+}
+
+func (o ModifySettlementRequest) Clone() *ModifySettlementRequest {
+	ret := ModifySettlementRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.WechatpaySerial != nil {
+		ret.WechatpaySerial = new(string)
+		*ret.WechatpaySerial = *o.WechatpaySerial
+	}
+
+	if o.ModifyMode != nil {
+		ret.ModifyMode = new(ModifyMode)
+		*ret.ModifyMode = *o.ModifyMode
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(AccountType)
+		*ret.AccountType = *o.AccountType
+	}
+
+	if o.AccountBank != nil {
+		ret.AccountBank = new(string)
+		*ret.AccountBank = *o.AccountBank
+	}
+
+	if o.BankAddressCode != nil {
+		ret.BankAddressCode = new(string)
+		*ret.BankAddressCode = *o.BankAddressCode
+	}
+
+	if o.BankName != nil {
+		ret.BankName = new(string)
+		*ret.BankName = *o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		ret.BankBranchId = new(string)
+		*ret.BankBranchId = *o.BankBranchId
+	}
+
+	if o.AccountNumber != nil {
+		ret.AccountNumber = new(string)
+		*ret.AccountNumber = *o.AccountNumber
+	}
+
+	if o.AccountName != nil {
+		ret.AccountName = new(string)
+		*ret.AccountName = *o.AccountName
+	}
+
+	return &ret
+}
+
+// ModifySettlementResponse
+type ModifySettlementResponse struct {
+	// 修改结算账户申请单号，修改模式为MODIFY_MODE_ASYNC时返回
+	ApplicationNo *string `json:"application_no,omitempty"`
+}
+
+func (o ModifySettlementResponse) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ApplicationNo != nil {
+		toSerialize["application_no"] = o.ApplicationNo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o ModifySettlementResponse) String() string {
+	var ret string
+	if o.ApplicationNo == nil {
+		ret += "ApplicationNo:<nil>"
+	} else {
+		ret += fmt.Sprintf("ApplicationNo:%v", *o.ApplicationNo)
+	}
+
+	return fmt.Sprintf("ModifySettlementResponse{%s}", ret)
+}
+
+func (o ModifySettlementResponse) Clone() *ModifySettlementResponse {
+	ret := ModifySettlementResponse{}
+
+	if o.ApplicationNo != nil {
+		ret.ApplicationNo = new(string)
+		*ret.ApplicationNo = *o.ApplicationNo
+	}
+
+	return &ret
+}
+
+// HasApplicationNo 应答中是否返回了 application_no，o 为 nil 时返回 false
+func (o *ModifySettlementResponse) HasApplicationNo() bool {
+	return o != nil && o.ApplicationNo != nil
+}
+
+// Settlement
+type Settlement struct {
+	// 账户类型
+	AccountType *AccountType `json:"account_type"`
+	// 开户银行
+	AccountBank *string `json:"account_bank"`
+	// 开户银行全称（含支行）
+	BankName *string `json:"bank_name,omitempty"`
+	// 开户银行联行号
+	BankBranchId *string `json:"bank_branch_id,omitempty"`
+	// 银行账号，掩码形式返回
+	AccountNumber *string `json:"account_number"`
+	// 汇款验证结果
+	VerifyResult *SettlementVerifyResult `json:"verify_result"`
+	// 汇款验证失败原因，验证结果为VERIFY_FAIL时返回
+	VerifyFailReason *string `json:"verify_fail_reason,omitempty"`
+}
+
+func (o Settlement) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.AccountType == nil {
+		return nil, fmt.Errorf("field `AccountType` is required and must be specified in Settlement")
+	}
+	toSerialize["account_type"] = o.AccountType
+
+	if o.AccountBank == nil {
+		return nil, fmt.Errorf("field `AccountBank` is required and must be specified in Settlement")
+	}
+	toSerialize["account_bank"] = o.AccountBank
+
+	if o.BankName != nil {
+		toSerialize["bank_name"] = o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		toSerialize["bank_branch_id"] = o.BankBranchId
+	}
+
+	if o.AccountNumber == nil {
+		return nil, fmt.Errorf("field `AccountNumber` is required and must be specified in Settlement")
+	}
+	toSerialize["account_number"] = o.AccountNumber
+
+	if o.VerifyResult == nil {
+		return nil, fmt.Errorf("field `VerifyResult` is required and must be specified in Settlement")
+	}
+	toSerialize["verify_result"] = o.VerifyResult
+
+	if o.VerifyFailReason != nil {
+		toSerialize["verify_fail_reason"] = o.VerifyFailReason
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Settlement) String() string {
+	var ret string
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountType:%v, ", *o.AccountType)
+	}
+
+	if o.AccountBank == nil {
+		ret += "AccountBank:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountBank:%v, ", *o.AccountBank)
+	}
+
+	if o.BankName == nil {
+		ret += "BankName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankName:%v, ", *o.BankName)
+	}
+
+	if o.BankBranchId == nil {
+		ret += "BankBranchId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankBranchId:%v, ", *o.BankBranchId)
+	}
+
+	if o.AccountNumber == nil {
+		ret += "AccountNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountNumber:%v, ", *o.AccountNumber)
+	}
+
+	if o.VerifyResult == nil {
+		ret += "VerifyResult:<nil>, "
+	} else {
+		ret += fmt.Sprintf("VerifyResult:%v, ", *o.VerifyResult)
+	}
+
+	if o.VerifyFailReason == nil {
+		ret += "VerifyFailReason:<nil>"
+	} else {
+		ret += fmt.Sprintf("VerifyFailReason:%v", *o.VerifyFailReason)
+	}
+
+	return fmt.Sprintf("Settlement{%s}", ret)
+}
+
+func (o Settlement) Clone() *Settlement {
+	ret := Settlement{}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(AccountType)
+		*ret.AccountType = *o.AccountType
+	}
+
+	if o.AccountBank != nil {
+		ret.AccountBank = new(string)
+		*ret.AccountBank = *o.AccountBank
+	}
+
+	if o.BankName != nil {
+		ret.BankName = new(string)
+		*ret.BankName = *o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		ret.BankBranchId = new(string)
+		*ret.BankBranchId = *o.BankBranchId
+	}
+
+	if o.AccountNumber != nil {
+		ret.AccountNumber = new(string)
+		*ret.AccountNumber = *o.AccountNumber
+	}
+
+	if o.VerifyResult != nil {
+		ret.VerifyResult = new(SettlementVerifyResult)
+		*ret.VerifyResult = *o.VerifyResult
+	}
+
+	if o.VerifyFailReason != nil {
+		ret.VerifyFailReason = new(string)
+		*ret.VerifyFailReason = *o.VerifyFailReason
+	}
+
+	return &ret
+}
+
+// HasBankName 应答中是否返回了 bank_name，o 为 nil 时返回 false
+func (o *Settlement) HasBankName() bool {
+	return o != nil && o.BankName != nil
+}
+
+// HasBankBranchId 应答中是否返回了 bank_branch_id，o 为 nil 时返回 false
+func (o *Settlement) HasBankBranchId() bool {
+	return o != nil && o.BankBranchId != nil
+}
+
+// HasVerifyFailReason 应答中是否返回了 verify_fail_reason，o 为 nil 时返回 false
+func (o *Settlement) HasVerifyFailReason() bool {
+	return o != nil && o.VerifyFailReason != nil
+}
+
+// SettlementApplication
+type SettlementApplication struct {
+	// 开户名称，掩码形式返回
+	AccountName *string `json:"account_name"`
+	// 账户类型
+	AccountType *AccountType `json:"account_type"`
+	// 开户银行
+	AccountBank *string `json:"account_bank"`
+	// 开户银行全称（含支行）
+	BankName *string `json:"bank_name,omitempty"`
+	// 开户银行联行号
+	BankBranchId *string `json:"bank_branch_id,omitempty"`
+	// 银行账号，掩码形式返回
+	AccountNumber *string `json:"account_number"`
+	// 审核状态
+	VerifyResult *ApplicationVerifyResult `json:"verify_result"`
+	// 审核驳回原因，审核状态为AUDIT_FAIL时返回
+	VerifyFailReason *string `json:"verify_fail_reason,omitempty"`
+	// 审核结果更新时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE
+	VerifyFinishTime *time.Time `json:"verify_finish_time,omitempty"`
+}
+
+func (o SettlementApplication) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.AccountName == nil {
+		return nil, fmt.Errorf("field `AccountName` is required and must be specified in SettlementApplication")
+	}
+	toSerialize["account_name"] = o.AccountName
+
+	if o.AccountType == nil {
+		return nil, fmt.Errorf("field `AccountType` is required and must be specified in SettlementApplication")
+	}
+	toSerialize["account_type"] = o.AccountType
+
+	if o.AccountBank == nil {
+		return nil, fmt.Errorf("field `AccountBank` is required and must be specified in SettlementApplication")
+	}
+	toSerialize["account_bank"] = o.AccountBank
+
+	if o.BankName != nil {
+		toSerialize["bank_name"] = o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		toSerialize["bank_branch_id"] = o.BankBranchId
+	}
+
+	if o.AccountNumber == nil {
+		return nil, fmt.Errorf("field `AccountNumber` is required and must be specified in SettlementApplication")
+	}
+	toSerialize["account_number"] = o.AccountNumber
+
+	if o.VerifyResult == nil {
+		return nil, fmt.Errorf("field `VerifyResult` is required and must be specified in SettlementApplication")
+	}
+	toSerialize["verify_result"] = o.VerifyResult
+
+	if o.VerifyFailReason != nil {
+		toSerialize["verify_fail_reason"] = o.VerifyFailReason
+	}
+
+	if o.VerifyFinishTime != nil {
+		toSerialize["verify_finish_time"] = o.VerifyFinishTime.Format(time.RFC3339)
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o SettlementApplication) String() string {
+	var ret string
+	if o.AccountName == nil {
+		ret += "AccountName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountName:%v, ", *o.AccountName)
+	}
+
+	if o.AccountType == nil {
+		ret += "AccountType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountType:%v, ", *o.AccountType)
+	}
+
+	if o.AccountBank == nil {
+		ret += "AccountBank:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountBank:%v, ", *o.AccountBank)
+	}
+
+	if o.BankName == nil {
+		ret += "BankName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankName:%v, ", *o.BankName)
+	}
+
+	if o.BankBranchId == nil {
+		ret += "BankBranchId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankBranchId:%v, ", *o.BankBranchId)
+	}
+
+	if o.AccountNumber == nil {
+		ret += "AccountNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("AccountNumber:%v, ", *o.AccountNumber)
+	}
+
+	if o.VerifyResult == nil {
+		ret += "VerifyResult:<nil>, "
+	} else {
+		ret += fmt.Sprintf("VerifyResult:%v, ", *o.VerifyResult)
+	}
+
+	if o.VerifyFailReason == nil {
+		ret += "VerifyFailReason:<nil>, "
+	} else {
+		ret += fmt.Sprintf("VerifyFailReason:%v, ", *o.VerifyFailReason)
+	}
+
+	if o.VerifyFinishTime == nil {
+		ret += "VerifyFinishTime:<nil>"
+	} else {
+		ret += fmt.Sprintf("VerifyFinishTime:%v", *o.VerifyFinishTime)
+	}
+
+	return fmt.Sprintf("SettlementApplication{%s}", ret)
+}
+
+func (o SettlementApplication) Clone() *SettlementApplication {
+	ret := SettlementApplication{}
+
+	if o.AccountName != nil {
+		ret.AccountName = new(string)
+		*ret.AccountName = *o.AccountName
+	}
+
+	if o.AccountType != nil {
+		ret.AccountType = new(AccountType)
+		*ret.AccountType = *o.AccountType
+	}
+
+	if o.AccountBank != nil {
+		ret.AccountBank = new(string)
+		*ret.AccountBank = *o.AccountBank
+	}
+
+	if o.BankName != nil {
+		ret.BankName = new(string)
+		*ret.BankName = *o.BankName
+	}
+
+	if o.BankBranchId != nil {
+		ret.BankBranchId = new(string)
+		*ret.BankBranchId = *o.BankBranchId
+	}
+
+	if o.AccountNumber != nil {
+		ret.AccountNumber = new(string)
+		*ret.AccountNumber = *o.AccountNumber
+	}
+
+	if o.VerifyResult != nil {
+		ret.VerifyResult = new(ApplicationVerifyResult)
+		*ret.VerifyResult = *o.VerifyResult
+	}
+
+	if o.VerifyFailReason != nil {
+		ret.VerifyFailReason = new(string)
+		*ret.VerifyFailReason = *o.VerifyFailReason
+	}
+
+	if o.VerifyFinishTime != nil {
+		ret.VerifyFinishTime = new(time.Time)
+		*ret.VerifyFinishTime = *o.VerifyFinishTime
+	}
+
+	return &ret
+}
+
+// HasBankName 应答中是否返回了 bank_name，o 为 nil 时返回 false
+func (o *SettlementApplication) HasBankName() bool {
+	return o != nil && o.BankName != nil
+}
+
+// HasBankBranchId 应答中是否返回了 bank_branch_id，o 为 nil 时返回 false
+func (o *SettlementApplication) HasBankBranchId() bool {
+	return o != nil && o.BankBranchId != nil
+}
+
+// HasVerifyFailReason 应答中是否返回了 verify_fail_reason，o 为 nil 时返回 false
+func (o *SettlementApplication) HasVerifyFailReason() bool {
+	return o != nil && o.VerifyFailReason != nil
+}
+
+// HasVerifyFinishTime 应答中是否返回了 verify_finish_time，o 为 nil 时返回 false
+func (o *SettlementApplication) HasVerifyFinishTime() bool {
+	return o != nil && o.VerifyFinishTime != nil
+}
+
+// SettlementVerifyResult * `VERIFY_SUCCESS` - 验证成功，可正常结算 * `VERIFY_FAIL` - 验证失败，结算账户不可用 * `VERIFYING` - 验证中
+type SettlementVerifyResult string
+
+func (e SettlementVerifyResult) Ptr() *SettlementVerifyResult {
+	return &e
+}
+
+// Enums of SettlementVerifyResult
+const (
+	SETTLEMENTVERIFYRESULT_VERIFY_SUCCESS SettlementVerifyResult = "VERIFY_SUCCESS"
+	SETTLEMENTVERIFYRESULT_VERIFY_FAIL    SettlementVerifyResult = "VERIFY_FAIL"
+	SETTLEMENTVERIFYRESULT_VERIFYING      SettlementVerifyResult = "VERIFYING"
+)
+
+func (v *SettlementVerifyResult) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := SettlementVerifyResult(value)
+	for _, existing := range []SettlementVerifyResult{"VERIFY_SUCCESS", "VERIFY_FAIL", "VERIFYING"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid SettlementVerifyResult", value)
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户开发配置API
+//
+// 服务商为特约商户配置JSAPI支付授权目录、绑定支付所使用的APPID与推荐关注的APPID，并查询当前的开发配置
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package subdevconfig
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type DevelopmentConfigApiService services.Service
+
+// AddDevelopmentConfig 新增开发配置
+//
+// # 应用场景
+// 服务商可以通过该接口为特约商户新增一项开发配置：JSAPI支付授权目录、支付所使用的APPID或推荐关注的APPID。
+//
+// 注意：
+// 1、每次请求只能新增一项配置，jsapi_path、sub_appid 与 subscribe_appid（或 receipt_appid）三者只能填写其一
+// 2、JSAPI支付授权目录最多配置5个，需以 http:// 或 https:// 开头、以 / 结尾
+// 3、sub_appid 需已与特约商户或服务商完成关联，推荐关注的APPID需与支付所使用的APPID同主体
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|无效请求|配置数量超过上限，或APPID未与商户关联|请检查配置后重试|
+// |NO_AUTH|没有权限|服务商与特约商户不存在受理关系|请检查特约商户号|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *DevelopmentConfigApiService) AddDevelopmentConfig(ctx context.Context, req AddDevelopmentConfigRequest) (result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in AddDevelopmentConfigRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/apply4sub/sub_merchants/{sub_mchid}/development-config"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"sub_mchid"+"}", neturl.PathEscape(core.ParameterToString(*req.SubMchid, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = &AddDevelopmentConfigBody{
+		Appid:          req.Appid,
+		JsapiPath:      req.JsapiPath,
+		SubAppid:       req.SubAppid,
+		SubscribeAppid: req.SubscribeAppid,
+		ReceiptAppid:   req.ReceiptAppid,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// QueryDevelopmentConfig 查询开发配置
+//
+// # 应用场景
+// 服务商可以通过该接口查询特约商户当前的JSAPI支付授权目录与APPID配置。
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NO_AUTH|没有权限|服务商与特约商户不存在受理关系|请检查特约商户号|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *DevelopmentConfigApiService) QueryDevelopmentConfig(ctx context.Context, req QueryDevelopmentConfigRequest) (resp *DevelopmentConfig, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryDevelopmentConfigRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/apply4sub/sub_merchants/{sub_mchid}/development-config"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"sub_mchid"+"}", neturl.PathEscape(core.ParameterToString(*req.SubMchid, "")), -1)
+
+	// Make sure All Required Params are properly set
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract DevelopmentConfig from Http Response
+	resp = new(DevelopmentConfig)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户开发配置API
+//
+// 服务商为特约商户配置JSAPI支付授权目录、绑定支付所使用的APPID与推荐关注的APPID，并查询当前的开发配置
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package subdevconfig_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/subdevconfig"
+)
+
+func ExampleDevelopmentConfigApiService_AddDevelopmentConfig() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := subdevconfig.DevelopmentConfigApiService{Client: client}
+	result, err := svc.AddDevelopmentConfig(ctx,
+		subdevconfig.AddDevelopmentConfigRequest{
+			Appid:     core.String("wxd678efh567hg6787"),
+			JsapiPath: core.String("https://www.qq.com/wechat/"),
+			SubMchid:  core.String("1900006491"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _ = result, err
+}
+
+func ExampleDevelopmentConfigApiService_QueryDevelopmentConfig() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := subdevconfig.DevelopmentConfigApiService{Client: client}
+	resp, result, err := svc.QueryDevelopmentConfig(ctx,
+		subdevconfig.QueryDevelopmentConfigRequest{
+			SubMchid: core.String("1900006491"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,446 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 特约商户开发配置API
+//
+// 服务商为特约商户配置JSAPI支付授权目录、绑定支付所使用的APPID与推荐关注的APPID，并查询当前的开发配置
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package subdevconfig
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AddDevelopmentConfigBody
+type AddDevelopmentConfigBody struct {
+	// 服务商的公众账号ID，新增JSAPI支付授权目录时必填
+	Appid *string `json:"appid,omitempty"`
+	// JSAPI支付授权目录，以 http:// 或 https:// 开头、以 / 结尾
+	JsapiPath *string `json:"jsapi_path,omitempty"`
+	// 特约商户支付所使用的APPID（公众号、小程序或APP的APPID）
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 支付完成后推荐关注的公众号APPID，与 receipt_appid 二选一
+	SubscribeAppid *string `json:"subscribe_appid,omitempty"`
+	// 支付完成后推荐关注并接收支付凭证的公众号APPID，与 subscribe_appid 二选一
+	ReceiptAppid *string `json:"receipt_appid,omitempty"`
+}
+
+func (o AddDevelopmentConfigBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid != nil {
+		toSerialize["appid"] = o.Appid
+	}
+
+	if o.JsapiPath != nil {
+		toSerialize["jsapi_path"] = o.JsapiPath
+	}
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubscribeAppid != nil {
+		toSerialize["subscribe_appid"] = o.SubscribeAppid
+	}
+
+	if o.ReceiptAppid != nil {
+		toSerialize["receipt_appid"] = o.ReceiptAppid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AddDevelopmentConfigBody) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.JsapiPath == nil {
+		ret += "JsapiPath:<nil>, "
+	} else {
+		ret += fmt.Sprintf("JsapiPath:%v, ", *o.JsapiPath)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubscribeAppid == nil {
+		ret += "SubscribeAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubscribeAppid:%v, ", *o.SubscribeAppid)
+	}
+
+	if o.ReceiptAppid == nil {
+		ret += "ReceiptAppid:<nil>"
+	} else {
+		ret += fmt.Sprintf("ReceiptAppid:%v", *o.ReceiptAppid)
+	}
+
+	return fmt.Sprintf("AddDevelopmentConfigBody{%s}", ret)
+}
+
+func (o AddDevelopmentConfigBody) Clone() *AddDevelopmentConfigBody {
+	ret := AddDevelopmentConfigBody{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.JsapiPath != nil {
+		ret.JsapiPath = new(string)
+		*ret.JsapiPath = *o.JsapiPath
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubscribeAppid != nil {
+		ret.SubscribeAppid = new(string)
+		*ret.SubscribeAppid = *o.SubscribeAppid
+	}
+
+	if o.ReceiptAppid != nil {
+		ret.ReceiptAppid = new(string)
+		*ret.ReceiptAppid = *o.ReceiptAppid
+	}
+
+	return &ret
+}
+
+// AddDevelopmentConfigRequest
+type AddDevelopmentConfigRequest struct {
+	// 特约商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 服务商的公众账号ID，新增JSAPI支付授权目录时必填
+	Appid *string `json:"appid,omitempty"`
+	// JSAPI支付授权目录，以 http:// 或 https:// 开头、以 / 结尾
+	JsapiPath *string `json:"jsapi_path,omitempty"`
+	// 特约商户支付所使用的APPID（公众号、小程序或APP的APPID）
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 支付完成后推荐关注的公众号APPID，与 receipt_appid 二选一
+	SubscribeAppid *string `json:"subscribe_appid,omitempty"`
+	// 支付完成后推荐关注并接收支付凭证的公众号APPID，与 subscribe_appid 二选一
+	ReceiptAppid *string `json:"receipt_appid,omitempty"`
+}
+
+func (o AddDevelopmentConfigRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in AddDevelopmentConfigRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Appid != nil {
+		toSerialize["appid"] = o.Appid
+	}
+
+	if o.JsapiPath != nil {
+		toSerialize["jsapi_path"] = o.JsapiPath
+	}
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubscribeAppid != nil {
+		toSerialize["subscribe_appid"] = o.SubscribeAppid
+	}
+
+	if o.ReceiptAppid != nil {
+		toSerialize["receipt_appid"] = o.ReceiptAppid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AddDevelopmentConfigRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.JsapiPath == nil {
+		ret += "JsapiPath:<nil>, "
+	} else {
+		ret += fmt.Sprintf("JsapiPath:%v, ", *o.JsapiPath)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubscribeAppid == nil {
+		ret += "SubscribeAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubscribeAppid:%v, ", *o.SubscribeAppid)
+	}
+
+	if o.ReceiptAppid == nil {
+		ret += "ReceiptAppid:<nil>"
+	} else {
+		ret += fmt.Sprintf("ReceiptAppid:%v", *o.ReceiptAppid)
+	}
+
+	return fmt.Sprintf("AddDevelopmentConfigRequest{%s}", ret)
+}
+
+func (o AddDevelopmentConfigRequest) Clone() *AddDevelopmentConfigRequest {
+	ret := AddDevelopmentConfigRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.JsapiPath != nil {
+		ret.JsapiPath = new(string)
+		*ret.JsapiPath = *o.JsapiPath
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubscribeAppid != nil {
+		ret.SubscribeAppid = new(string)
+		*ret.SubscribeAppid = *o.SubscribeAppid
+	}
+
+	if o.ReceiptAppid != nil {
+		ret.ReceiptAppid = new(string)
+		*ret.ReceiptAppid = *o.ReceiptAppid
+	}
+
+	return &ret
+}
+
+// AppidConfig
+type AppidConfig struct {
+	// 特约商户支付所使用的APPID
+	SubAppid *string `json:"sub_appid"`
+	// 推荐关注的公众号APPID
+	SubscribeAppid *string `json:"subscribe_appid,omitempty"`
+	// 推荐关注并接收支付凭证的公众号APPID
+	ReceiptAppid *string `json:"receipt_appid,omitempty"`
+}
+
+func (o AppidConfig) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubAppid == nil {
+		return nil, fmt.Errorf("field `SubAppid` is required and must be specified in AppidConfig")
+	}
+	toSerialize["sub_appid"] = o.SubAppid
+
+	if o.SubscribeAppid != nil {
+		toSerialize["subscribe_appid"] = o.SubscribeAppid
+	}
+
+	if o.ReceiptAppid != nil {
+		toSerialize["receipt_appid"] = o.ReceiptAppid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o AppidConfig) String() string {
+	var ret string
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubscribeAppid == nil {
+		ret += "SubscribeAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubscribeAppid:%v, ", *o.SubscribeAppid)
+	}
+
+	if o.ReceiptAppid == nil {
+		ret += "ReceiptAppid:<nil>"
+	} else {
+		ret += fmt.Sprintf("ReceiptAppid:%v", *o.ReceiptAppid)
+	}
+
+	return fmt.Sprintf("AppidConfig{%s}", ret)
+}
+
+func (o AppidConfig) Clone() *AppidConfig {
+	ret := AppidConfig{}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubscribeAppid != nil {
+		ret.SubscribeAppid = new(string)
+		*ret.SubscribeAppid = *o.SubscribeAppid
+	}
+
+	if o.ReceiptAppid != nil {
+		ret.ReceiptAppid = new(string)
+		*ret.ReceiptAppid = *o.ReceiptAppid
+	}
+
+	return &ret
+}
+
+// HasSubscribeAppid 应答中是否返回了 subscribe_appid，o 为 nil 时返回 false
+func (o *AppidConfig) HasSubscribeAppid() bool {
+	return o != nil && o.SubscribeAppid != nil
+}
+
+// HasReceiptAppid 应答中是否返回了 receipt_appid，o 为 nil 时返回 false
+func (o *AppidConfig) HasReceiptAppid() bool {
+	return o != nil && o.ReceiptAppid != nil
+}
+
+// DevelopmentConfig
+type DevelopmentConfig struct {
+	// 特约商户号
+	SubMchid *string `json:"sub_mchid,omitempty"`
+	// JSAPI支付授权目录列表
+	JsapiPathList []string `json:"jsapi_path_list,omitempty"`
+	// APPID配置列表
+	AppidConfigList []AppidConfig `json:"appid_config_list,omitempty"`
+}
+
+func (o DevelopmentConfig) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid != nil {
+		toSerialize["sub_mchid"] = o.SubMchid
+	}
+
+	if o.JsapiPathList != nil {
+		toSerialize["jsapi_path_list"] = o.JsapiPathList
+	}
+
+	if o.AppidConfigList != nil {
+		toSerialize["appid_config_list"] = o.AppidConfigList
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o DevelopmentConfig) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	ret += fmt.Sprintf("JsapiPathList:%v, ", o.JsapiPathList)
+
+	ret += fmt.Sprintf("AppidConfigList:%v", o.AppidConfigList)
+
+	return fmt.Sprintf("DevelopmentConfig{%s}", ret)
+}
+
+func (o DevelopmentConfig) Clone() *DevelopmentConfig {
+	ret := DevelopmentConfig{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.JsapiPathList != nil {
+		ret.JsapiPathList = make([]string, len(o.JsapiPathList))
+		for i, item := range o.JsapiPathList {
+			ret.JsapiPathList[i] = item
+		}
+	}
+
+	if o.AppidConfigList != nil {
+		ret.AppidConfigList = make([]AppidConfig, len(o.AppidConfigList))
+		for i, item := range o.AppidConfigList {
+			ret.AppidConfigList[i] = *item.Clone()
+		}
+	}
+
+	return &ret
+}
+
+// HasSubMchid 应答中是否返回了 sub_mchid，o 为 nil 时返回 false
+func (o *DevelopmentConfig) HasSubMchid() bool {
+	return o != nil && o.SubMchid != nil
+}
+
+// HasJsapiPathList 应答中是否返回了 jsapi_path_list，o 为 nil 时返回 false
+func (o *DevelopmentConfig) HasJsapiPathList() bool {
+	return o != nil && o.JsapiPathList != nil
+}
+
+// HasAppidConfigList 应答中是否返回了 appid_config_list，o 为 nil 时返回 false
+func (o *DevelopmentConfig) HasAppidConfigList() bool {
+	return o != nil && o.AppidConfigList != nil
+}
+
+// QueryDevelopmentConfigRequest
+type QueryDevelopmentConfigRequest struct {
+	// 特约商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryDevelopmentConfigRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryDevelopmentConfigRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryDevelopmentConfigRequest) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryDevelopmentConfigRequest{%s}", ret)
+}
+
+func (o QueryDevelopmentConfigRequest) Clone() *QueryDevelopmentConfigRequest {
+	ret := QueryDevelopmentConfigRequest{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
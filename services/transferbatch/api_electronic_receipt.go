@@ -0,0 +1,134 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家转账到零钱API
+//
+// 商家转账到零钱功能涉及的API文档
+//
+// API version: 1.0.5
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package transferbatch
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ElectronicReceiptApiService services.Service
+
+// ApplyElectronicReceipt 受理转账明细电子回单
+//
+// # 应用场景
+// 转账明细成功后，商户可以通过该接口申请转账明细的电子回单，受理成功后使用查询接口获取回单的下载地址。
+//
+// 注意：
+// 1、同一转账明细重复申请时返回已受理的回单申请单
+// 2、可使用 transferbatch.ReceiptDownloader 在下载回单文件的同时获取转账明细的结构化信息
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |INVALID_REQUEST|明细状态不支持|转账明细不是成功状态|请在转账明细成功后再申请电子回单|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ElectronicReceiptApiService) ApplyElectronicReceipt(ctx context.Context, req ApplyElectronicReceiptBody) (resp *ElectronicReceiptEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/transfer-detail/electronic-receipts"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ElectronicReceiptEntity from Http Response
+	resp = new(ElectronicReceiptEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// QueryElectronicReceipt 查询转账明细电子回单受理结果
+//
+// # 应用场景
+// 商户可以通过该接口查询电子回单的受理结果，受理完成（FINISHED）后返回回单文件的下载地址与摘要。
+//
+// 注意：
+// 1、下载地址有效期为10分钟，过期后请重新查询
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |NOT_FOUND|记录不存在|电子回单申请单不存在|请先申请电子回单|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ElectronicReceiptApiService) QueryElectronicReceipt(ctx context.Context, req QueryElectronicReceiptRequest) (resp *ElectronicReceiptEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/transfer-detail/electronic-receipts"
+	// Make sure All Required Params are properly set
+	if req.AcceptType == nil {
+		return nil, nil, fmt.Errorf("field `AcceptType` is required and must be specified in QueryElectronicReceiptRequest")
+	}
+	if req.OutDetailNo == nil {
+		return nil, nil, fmt.Errorf("field `OutDetailNo` is required and must be specified in QueryElectronicReceiptRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("accept_type", core.ParameterToString(*req.AcceptType, ""))
+	if req.OutBatchNo != nil {
+		localVarQueryParams.Add("out_batch_no", core.ParameterToString(*req.OutBatchNo, ""))
+	}
+	localVarQueryParams.Add("out_detail_no", core.ParameterToString(*req.OutDetailNo, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract ElectronicReceiptEntity from Http Response
+	resp = new(ElectronicReceiptEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
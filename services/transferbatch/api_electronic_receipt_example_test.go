@@ -0,0 +1,58 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家转账到零钱API
+//
+// 商家转账到零钱功能涉及的API文档
+//
+// API version: 1.0.5
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package transferbatch_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+func ExampleElectronicReceiptApiService_ApplyElectronicReceipt() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := transferbatch.ElectronicReceiptApiService{Client: client}
+	resp, result, err := svc.ApplyElectronicReceipt(ctx,
+		transferbatch.ApplyElectronicReceiptBody{
+			AcceptType:  core.String("BATCH_TRANSFER"),
+			OutBatchNo:  core.String("plfk2020042013"),
+			OutDetailNo: core.String("x23zy545Bd5436"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleElectronicReceiptApiService_QueryElectronicReceipt() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := transferbatch.ElectronicReceiptApiService{Client: client}
+	resp, result, err := svc.QueryElectronicReceipt(ctx,
+		transferbatch.QueryElectronicReceiptRequest{
+			AcceptType:  core.String("BATCH_TRANSFER"),
+			OutBatchNo:  core.String("plfk2020042013"),
+			OutDetailNo: core.String("x23zy545Bd5436"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家转账到零钱API
+//
+// 商家转账到零钱功能涉及的API文档
+//
+// API version: 1.0.5
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package transferbatch
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+	"strings"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type TransferBatchApiService services.Service
+
+// GetTransferBatchByNo 通过微信批次单号查询批次单
+//
+// # 应用场景
+// 商户可以通过该接口查询转账批次单以及批次内的转账明细单。返回的转账明细单按分页方式返回，每次最多返回100条，可使用offset与limit参数翻页。
+//
+// 注意：
+// 1、批次单状态为“FINISHED”（已完成）时，才会返回满足条件的转账明细单
+// 2、转账明细单的状态变化存在一定延迟，请在发起转账后间隔一段时间再查询
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |NOT_FOUND|记录不存在|批次单不存在|请确认批次单号是否正确，或稍后重试|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *TransferBatchApiService) GetTransferBatchByNo(ctx context.Context, req GetTransferBatchByNoRequest) (resp *TransferBatchEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.BatchId == nil {
+		return nil, nil, fmt.Errorf("field `BatchId` is required and must be specified in GetTransferBatchByNoRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/transfer/batches/batch-id/{batch_id}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"batch_id"+"}", neturl.PathEscape(core.ParameterToString(*req.BatchId, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.NeedQueryDetail == nil {
+		return nil, nil, fmt.Errorf("field `NeedQueryDetail` is required and must be specified in GetTransferBatchByNoRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("need_query_detail", core.ParameterToString(*req.NeedQueryDetail, ""))
+	if req.Offset != nil {
+		localVarQueryParams.Add("offset", core.ParameterToString(*req.Offset, ""))
+	}
+	if req.Limit != nil {
+		localVarQueryParams.Add("limit", core.ParameterToString(*req.Limit, ""))
+	}
+	if req.DetailStatus != nil {
+		localVarQueryParams.Add("detail_status", core.ParameterToString(*req.DetailStatus, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract TransferBatchEntity from Http Response
+	resp = new(TransferBatchEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// GetTransferBatchByOutNo 通过商家批次单号查询批次单
+//
+// # 应用场景
+// 商户可以通过该接口查询转账批次单以及批次内的转账明细单。返回的转账明细单按分页方式返回，每次最多返回100条，可使用offset与limit参数翻页。
+//
+// 注意：
+// 1、批次单状态为“FINISHED”（已完成）时，才会返回满足条件的转账明细单
+// 2、转账明细单的状态变化存在一定延迟，请在发起转账后间隔一段时间再查询
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |NOT_FOUND|记录不存在|批次单不存在|请确认批次单号是否正确，或稍后重试|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *TransferBatchApiService) GetTransferBatchByOutNo(ctx context.Context, req GetTransferBatchByOutNoRequest) (resp *TransferBatchEntity, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	// Make sure Path Params are properly set
+	if req.OutBatchNo == nil {
+		return nil, nil, fmt.Errorf("field `OutBatchNo` is required and must be specified in GetTransferBatchByOutNoRequest")
+	}
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/transfer/batches/out-batch-no/{out_batch_no}"
+	// Build Path with Path Params
+	localVarPath = strings.Replace(localVarPath, "{"+"out_batch_no"+"}", neturl.PathEscape(core.ParameterToString(*req.OutBatchNo, "")), -1)
+
+	// Make sure All Required Params are properly set
+	if req.NeedQueryDetail == nil {
+		return nil, nil, fmt.Errorf("field `NeedQueryDetail` is required and must be specified in GetTransferBatchByOutNoRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("need_query_detail", core.ParameterToString(*req.NeedQueryDetail, ""))
+	if req.Offset != nil {
+		localVarQueryParams.Add("offset", core.ParameterToString(*req.Offset, ""))
+	}
+	if req.Limit != nil {
+		localVarQueryParams.Add("limit", core.ParameterToString(*req.Limit, ""))
+	}
+	if req.DetailStatus != nil {
+		localVarQueryParams.Add("detail_status", core.ParameterToString(*req.DetailStatus, ""))
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract TransferBatchEntity from Http Response
+	resp = new(TransferBatchEntity)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
+
+// InitiateBatchTransfer 发起商家转账
+//
+// # 应用场景
+// 商户可以通过该接口同时向多个用户微信零钱进行转账操作。
+//
+// 注意：
+// 1、单个转账批次单最多包含1000笔转账明细，超过时请拆分为多个批次发起
+// 2、转账批次单中的转账总金额与转账总笔数，需与转账明细列表中的金额之和与笔数一致
+// 3、同一批次单号重复请求时，微信支付将返回首次受理的结果
+// 4、转账明细中的收款用户姓名属于敏感信息，需使用微信支付平台证书加密，并通过Wechatpay-Serial请求头传递加密所用的平台证书序列号
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |INVALID_REQUEST|参数错误|批次的总金额或总笔数与转账明细不一致|请检查转账总金额与总笔数|
+// |NOT_ENOUGH|资金不足|商户账户余额不足|请充值后原单重试|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后使用原批次单号重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请不要更换商家批次单号，请使用原参数重试|
+func (a *TransferBatchApiService) InitiateBatchTransfer(ctx context.Context, req InitiateBatchTransferRequest) (resp *InitiateBatchTransferResponse, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/transfer/batches"
+	// Make sure All Required Params are properly set
+
+	// Setup Header Params
+	if req.WechatpaySerial != nil {
+		localVarHeaderParams.Set("Wechatpay-Serial", core.ParameterToString(*req.WechatpaySerial, ""))
+	}
+
+	// Setup Body Params
+	localVarPostBody = &InitiateBatchTransferBody{
+		Appid:              req.Appid,
+		OutBatchNo:         req.OutBatchNo,
+		BatchName:          req.BatchName,
+		BatchRemark:        req.BatchRemark,
+		TotalAmount:        req.TotalAmount,
+		TotalNum:           req.TotalNum,
+		TransferDetailList: req.TransferDetailList,
+		TransferSceneId:    req.TransferSceneId,
+	}
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract InitiateBatchTransferResponse from Http Response
+	resp = new(InitiateBatchTransferResponse)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
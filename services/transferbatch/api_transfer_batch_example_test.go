@@ -0,0 +1,94 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家转账到零钱API
+//
+// 商家转账到零钱功能涉及的API文档
+//
+// API version: 1.0.5
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package transferbatch_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+func ExampleTransferBatchApiService_GetTransferBatchByNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := transferbatch.TransferBatchApiService{Client: client}
+	resp, result, err := svc.GetTransferBatchByNo(ctx,
+		transferbatch.GetTransferBatchByNoRequest{
+			BatchId:         core.String("1030000071100999991182020050700019480001"),
+			DetailStatus:    core.String("FAIL"),
+			Limit:           core.Int64(20),
+			NeedQueryDetail: core.Bool(true),
+			Offset:          core.Int64(0),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransferBatchApiService_GetTransferBatchByOutNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := transferbatch.TransferBatchApiService{Client: client}
+	resp, result, err := svc.GetTransferBatchByOutNo(ctx,
+		transferbatch.GetTransferBatchByOutNoRequest{
+			DetailStatus:    core.String("FAIL"),
+			Limit:           core.Int64(20),
+			NeedQueryDetail: core.Bool(true),
+			Offset:          core.Int64(0),
+			OutBatchNo:      core.String("plfk2020042013"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransferBatchApiService_InitiateBatchTransfer() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := transferbatch.TransferBatchApiService{Client: client}
+	resp, result, err := svc.InitiateBatchTransfer(ctx,
+		transferbatch.InitiateBatchTransferRequest{
+			Appid:       core.String("wxf636efh567hg4356"),
+			BatchName:   core.String("2019年1月深圳分部报销单"),
+			BatchRemark: core.String("2019年1月深圳分部报销单"),
+			OutBatchNo:  core.String("plfk2020042013"),
+			TotalAmount: core.Int64(4000000),
+			TotalNum:    core.Int64(200),
+			TransferDetailList: []transferbatch.TransferDetailInput{transferbatch.TransferDetailInput{
+				Openid:         core.String("o-MYE42l80oelYMDE34nYD456Xoy"),
+				OutDetailNo:    core.String("x23zy545Bd5436"),
+				TransferAmount: core.Int64(200000),
+				TransferRemark: core.String("2020年4月报销"),
+				UserName:       core.String("757b340b45ebef5467rter35gf464344v3542sdf4t6re4tb4f54ty45t4yyry45"),
+			}},
+			TransferSceneId: core.String("1000"),
+			WechatpaySerial: core.String("5157F09EFDC096DE15EBE81A47057A7232F1B8E1"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 商家转账到零钱API
+//
+// 商家转账到零钱功能涉及的API文档
+//
+// API version: 1.0.5
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package transferbatch_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+func ExampleTransferDetailApiService_GetTransferDetailByNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := transferbatch.TransferDetailApiService{Client: client}
+	resp, result, err := svc.GetTransferDetailByNo(ctx,
+		transferbatch.GetTransferDetailByNoRequest{
+			BatchId:  core.String("1030000071100999991182020050700019480001"),
+			DetailId: core.String("1040000071100999991182020050700019500100"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransferDetailApiService_GetTransferDetailByOutNo() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := transferbatch.TransferDetailApiService{Client: client}
+	resp, result, err := svc.GetTransferDetailByOutNo(ctx,
+		transferbatch.GetTransferDetailByOutNoRequest{
+			OutBatchNo:  core.String("plfk2020042013"),
+			OutDetailNo: core.String("x23zy545Bd5436"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,368 @@
+package transferbatch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+)
+
+const (
+	// MaxTransferDetailsPerBatch 单个转账批次单最多包含的转账明细数
+	MaxTransferDetailsPerBatch = 1000
+	// maxOutBatchNoLength 商家批次单号的最大长度
+	maxOutBatchNoLength = 32
+	// maxDetailPageSize 查询批次单时每页最多返回的转账明细数
+	maxDetailPageSize = 100
+)
+
+// 明细状态查询条件
+const (
+	DetailStatusFilterAll     = "ALL"
+	DetailStatusFilterSuccess = "SUCCESS"
+	DetailStatusFilterFail    = "FAIL"
+)
+
+// SplitBatchTransfer 将转账明细超过 maxDetails（不超过 MaxTransferDetailsPerBatch，<= 0 时取该值）笔的转账请求拆分为多个批次
+//
+// 拆分后各批次的转账总金额与总笔数根据批次内的明细重新计算，商家批次单号为原批次单号加上 3 位序号，如 plfk2020042013001。
+// 拆分结果只与请求内容有关，使用相同的请求重新拆分得到相同的商家批次单号，可用于失败后的重新发起。
+// 无需拆分时返回原请求（根据明细校验并补全总金额与总笔数）。req 中设置的总金额与总笔数与明细不一致时返回错误。
+func SplitBatchTransfer(req InitiateBatchTransferRequest, maxDetails int) ([]InitiateBatchTransferRequest, error) {
+	if maxDetails <= 0 || maxDetails > MaxTransferDetailsPerBatch {
+		maxDetails = MaxTransferDetailsPerBatch
+	}
+	if req.OutBatchNo == nil || *req.OutBatchNo == "" {
+		return nil, fmt.Errorf("field `OutBatchNo` is required and must be specified in InitiateBatchTransferRequest")
+	}
+	if len(req.TransferDetailList) == 0 {
+		return nil, fmt.Errorf("field `TransferDetailList` is required and must be specified in InitiateBatchTransferRequest")
+	}
+
+	var totalAmount int64
+	for i, detail := range req.TransferDetailList {
+		if detail.TransferAmount == nil || *detail.TransferAmount <= 0 {
+			return nil, fmt.Errorf("transfer amount of detail %d must be positive", i)
+		}
+		totalAmount += *detail.TransferAmount
+	}
+	if req.TotalAmount != nil && *req.TotalAmount != totalAmount {
+		return nil, fmt.Errorf("total amount %d does not match the sum of details %d", *req.TotalAmount, totalAmount)
+	}
+	if req.TotalNum != nil && *req.TotalNum != int64(len(req.TransferDetailList)) {
+		return nil, fmt.Errorf("total num %d does not match the number of details %d", *req.TotalNum, len(req.TransferDetailList))
+	}
+
+	if len(req.TransferDetailList) <= maxDetails {
+		batch := *req.Clone()
+		batch.TotalAmount = core.Int64(totalAmount)
+		batch.TotalNum = core.Int64(int64(len(batch.TransferDetailList)))
+		return []InitiateBatchTransferRequest{batch}, nil
+	}
+
+	count := (len(req.TransferDetailList) + maxDetails - 1) / maxDetails
+	if count > 999 {
+		return nil, fmt.Errorf("too many details to split: %d batches are required", count)
+	}
+	if len(*req.OutBatchNo)+3 > maxOutBatchNoLength {
+		return nil, fmt.Errorf("out_batch_no %s is too long to derive batch numbers, at most %d characters are allowed",
+			*req.OutBatchNo, maxOutBatchNoLength-3)
+	}
+
+	batches := make([]InitiateBatchTransferRequest, 0, count)
+	for i := 0; i < count; i++ {
+		end := (i + 1) * maxDetails
+		if end > len(req.TransferDetailList) {
+			end = len(req.TransferDetailList)
+		}
+		batch := req
+		batch.TransferDetailList = req.TransferDetailList[i*maxDetails : end]
+		batch = *batch.Clone()
+
+		var amount int64
+		for _, detail := range batch.TransferDetailList {
+			amount += *detail.TransferAmount
+		}
+		batch.OutBatchNo = core.String(fmt.Sprintf("%s%03d", *req.OutBatchNo, i+1))
+		batch.TotalAmount = core.Int64(amount)
+		batch.TotalNum = core.Int64(int64(len(batch.TransferDetailList)))
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+const (
+	defaultSubmitInterval   = time.Second
+	defaultTransferAttempts = 3
+)
+
+// BatchTransferrer 大批量商家转账编排器
+//
+// 将超过单批次明细上限的转账拆分为多个批次，按固定间隔依次发起（查询同样受该间隔限制），
+// 并可汇总所有批次的转账明细状态。遇到频率限制（FREQUENCY_LIMITED）时使用相同的商家批次单号重试。
+type BatchTransferrer struct {
+	client      *core.Client
+	svc         TransferBatchApiService
+	maxDetails  int
+	maxAttempts int
+	throttle    *throttle
+}
+
+// BatchTransferOption BatchTransferrer 的配置项
+type BatchTransferOption func(t *BatchTransferrer)
+
+// WithSubmitInterval 设置两次请求（发起转账或查询批次单）的最小间隔，默认为 1s
+func WithSubmitInterval(interval time.Duration) BatchTransferOption {
+	return func(t *BatchTransferrer) {
+		if interval >= 0 {
+			t.throttle.interval = interval
+		}
+	}
+}
+
+// WithMaxDetailsPerBatch 设置单个批次的最大明细数，默认为 MaxTransferDetailsPerBatch
+func WithMaxDetailsPerBatch(maxDetails int) BatchTransferOption {
+	return func(t *BatchTransferrer) {
+		if maxDetails > 0 && maxDetails <= MaxTransferDetailsPerBatch {
+			t.maxDetails = maxDetails
+		}
+	}
+}
+
+// WithTransferMaxAttempts 设置单个批次遇到频率限制时的最大尝试次数，默认为 3 次
+func WithTransferMaxAttempts(maxAttempts int) BatchTransferOption {
+	return func(t *BatchTransferrer) {
+		if maxAttempts > 0 {
+			t.maxAttempts = maxAttempts
+		}
+	}
+}
+
+// NewBatchTransferrer 创建 BatchTransferrer
+func NewBatchTransferrer(client *core.Client, opts ...BatchTransferOption) *BatchTransferrer {
+	t := &BatchTransferrer{
+		client:      client,
+		svc:         TransferBatchApiService{Client: client},
+		maxDetails:  MaxTransferDetailsPerBatch,
+		maxAttempts: defaultTransferAttempts,
+		throttle:    &throttle{interval: defaultSubmitInterval},
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// SubmittedBatch 已发起的转账批次
+type SubmittedBatch struct {
+	// OutBatchNo 商家批次单号
+	OutBatchNo string
+	// TotalAmount 批次转账总金额
+	TotalAmount int64
+	// TotalNum 批次转账总笔数
+	TotalNum int64
+	// Response 发起转账的应答，发起失败时为 nil
+	Response *InitiateBatchTransferResponse
+}
+
+// Submit 拆分并依次发起转账
+//
+// 请求中存在收款用户姓名且未设置 WechatpaySerial 时，将使用 Client 的 cipher 对各批次的敏感信息进行加密。
+// 某一批次发起失败时不再发起后续批次，并返回已成功发起的批次与错误。由于拆分结果是确定的，
+// 可使用相同的请求再次调用 Submit，已受理的批次将返回首次受理的结果。
+func (t *BatchTransferrer) Submit(ctx context.Context, req InitiateBatchTransferRequest) ([]*SubmittedBatch, error) {
+	batches, err := SplitBatchTransfer(req, t.maxDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	submitted := make([]*SubmittedBatch, 0, len(batches))
+	for i := range batches {
+		batch := &batches[i]
+		if batch.WechatpaySerial == nil && hasUserName(batch) {
+			serial, err := t.client.EncryptRequest(ctx, batch)
+			if err != nil {
+				return submitted, fmt.Errorf("encrypt batch %s err: %v", *batch.OutBatchNo, err)
+			}
+			if serial != "" {
+				batch.WechatpaySerial = core.String(serial)
+			}
+		}
+
+		resp, err := t.initiate(ctx, *batch)
+		if err != nil {
+			return submitted, fmt.Errorf("initiate batch %s err: %w", *batch.OutBatchNo, err)
+		}
+		submitted = append(submitted, &SubmittedBatch{
+			OutBatchNo:  *batch.OutBatchNo,
+			TotalAmount: *batch.TotalAmount,
+			TotalNum:    *batch.TotalNum,
+			Response:    resp,
+		})
+	}
+	return submitted, nil
+}
+
+func (t *BatchTransferrer) initiate(
+	ctx context.Context, req InitiateBatchTransferRequest,
+) (resp *InitiateBatchTransferResponse, err error) {
+	for attempt := 1; ; attempt++ {
+		if err = t.throttle.wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, _, err = t.svc.InitiateBatchTransfer(ctx, req)
+		if err == nil || !core.IsAPIError(err, "FREQUENCY_LIMITED") || attempt >= t.maxAttempts {
+			return resp, err
+		}
+	}
+}
+
+func hasUserName(req *InitiateBatchTransferRequest) bool {
+	for _, detail := range req.TransferDetailList {
+		if detail.UserName != nil && *detail.UserName != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// BatchTransferReport 多个转账批次的汇总报告
+type BatchTransferReport struct {
+	// Batches 各批次单的基本信息
+	Batches []*TransferBatchGet
+	// Details 已完成（FINISHED）批次的转账明细
+	Details []TransferDetailCompact
+	// DetailStatusCount 各明细状态的明细数
+	DetailStatusCount map[DetailStatus]int
+	// TotalAmount 转账总金额
+	TotalAmount int64
+	// TotalNum 转账总笔数
+	TotalNum int64
+	// SuccessAmount 转账成功的金额
+	SuccessAmount int64
+	// SuccessNum 转账成功的笔数
+	SuccessNum int64
+	// FailAmount 转账失败的金额
+	FailAmount int64
+	// FailNum 转账失败的笔数
+	FailNum int64
+}
+
+// Finished 所有批次均已完成或关闭时返回 true，此时报告中的数据不会再发生变化
+func (r *BatchTransferReport) Finished() bool {
+	for _, batch := range r.Batches {
+		if batch.BatchStatus == nil || (*batch.BatchStatus != BATCHSTATUS_FINISHED && *batch.BatchStatus != BATCHSTATUS_CLOSED) {
+			return false
+		}
+	}
+	return true
+}
+
+// add 将批次单基本信息计入报告
+func (r *BatchTransferReport) add(batch *TransferBatchGet) {
+	r.Batches = append(r.Batches, batch)
+	for _, v := range []struct {
+		sum *int64
+		val *int64
+	}{
+		{&r.TotalAmount, batch.TotalAmount},
+		{&r.TotalNum, batch.TotalNum},
+		{&r.SuccessAmount, batch.SuccessAmount},
+		{&r.SuccessNum, batch.SuccessNum},
+		{&r.FailAmount, batch.FailAmount},
+		{&r.FailNum, batch.FailNum},
+	} {
+		if v.val != nil {
+			*v.sum += *v.val
+		}
+	}
+}
+
+// Report 按商家批次单号查询各批次单，并分页查询已完成批次中 detailStatus（ALL/SUCCESS/FAIL，为空时取 ALL）状态的转账明细，汇总为一份报告
+func (t *BatchTransferrer) Report(ctx context.Context, outBatchNos []string, detailStatus string) (*BatchTransferReport, error) {
+	if detailStatus == "" {
+		detailStatus = DetailStatusFilterAll
+	}
+
+	report := &BatchTransferReport{DetailStatusCount: make(map[DetailStatus]int)}
+	for _, outBatchNo := range outBatchNos {
+		var offset int64
+		for {
+			if err := t.throttle.wait(ctx); err != nil {
+				return nil, err
+			}
+			resp, _, err := t.svc.GetTransferBatchByOutNo(ctx, GetTransferBatchByOutNoRequest{
+				OutBatchNo:      core.String(outBatchNo),
+				NeedQueryDetail: core.Bool(true),
+				Offset:          core.Int64(offset),
+				Limit:           core.Int64(maxDetailPageSize),
+				DetailStatus:    core.String(detailStatus),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("query batch %s err: %w", outBatchNo, err)
+			}
+			if resp.TransferBatch == nil {
+				return nil, fmt.Errorf("query batch %s err: transfer_batch is missing in response", outBatchNo)
+			}
+
+			if offset == 0 {
+				report.add(resp.TransferBatch)
+			}
+			for _, detail := range resp.TransferDetailList {
+				report.Details = append(report.Details, detail)
+				if detail.DetailStatus != nil {
+					report.DetailStatusCount[*detail.DetailStatus]++
+				}
+			}
+			if len(resp.TransferDetailList) < maxDetailPageSize {
+				break
+			}
+			offset += int64(len(resp.TransferDetailList))
+		}
+	}
+	return report, nil
+}
+
+// ReportSubmitted 汇总 Submit 发起的所有批次
+func (t *BatchTransferrer) ReportSubmitted(
+	ctx context.Context, submitted []*SubmittedBatch, detailStatus string,
+) (*BatchTransferReport, error) {
+	outBatchNos := make([]string, 0, len(submitted))
+	for _, batch := range submitted {
+		outBatchNos = append(outBatchNos, batch.OutBatchNo)
+	}
+	return t.Report(ctx, outBatchNos, detailStatus)
+}
+
+// throttle 保证两次请求之间的最小间隔
+type throttle struct {
+	interval time.Duration
+	next     time.Time
+	lock     sync.Mutex
+}
+
+func (t *throttle) wait(ctx context.Context) error {
+	t.lock.Lock()
+	now := time.Now()
+	at := t.next
+	if at.Before(now) {
+		at = now
+	}
+	t.next = at.Add(t.interval)
+	t.lock.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
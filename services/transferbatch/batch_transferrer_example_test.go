@@ -0,0 +1,76 @@
+package transferbatch_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+func ExampleBatchTransferrer_Submit() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	details := make([]transferbatch.TransferDetailInput, 0, 2500)
+	// TODO: 填充转账明细
+
+	transferrer := transferbatch.NewBatchTransferrer(client, transferbatch.WithSubmitInterval(500*time.Millisecond))
+	submitted, err := transferrer.Submit(ctx, transferbatch.InitiateBatchTransferRequest{
+		Appid:              core.String("wxf636efh567hg4356"),
+		OutBatchNo:         core.String("plfk2020042013"),
+		BatchName:          core.String("2019年1月深圳分部报销单"),
+		BatchRemark:        core.String("2019年1月深圳分部报销单"),
+		TransferDetailList: details,
+	})
+	if err != nil {
+		// 部分批次可能已发起，可使用相同的请求重新调用 Submit
+		log.Printf("submit batch transfer err:%s", err)
+		return
+	}
+
+	// 稍后汇总所有批次的转账结果
+	report, err := transferrer.ReportSubmitted(ctx, submitted, transferbatch.DetailStatusFilterFail)
+	if err != nil {
+		log.Printf("report batch transfer err:%s", err)
+		return
+	}
+	if report.Finished() {
+		log.Printf("success:%d/%d, fail details:%d", report.SuccessNum, report.TotalNum, len(report.Details))
+	}
+}
+
+func ExampleSplitBatchTransfer() {
+	req := transferbatch.InitiateBatchTransferRequest{
+		Appid:       core.String("wxf636efh567hg4356"),
+		OutBatchNo:  core.String("plfk2020042013"),
+		BatchName:   core.String("2019年1月深圳分部报销单"),
+		BatchRemark: core.String("2019年1月深圳分部报销单"),
+	}
+	for i := 0; i < 2500; i++ {
+		req.TransferDetailList = append(req.TransferDetailList, transferbatch.TransferDetailInput{
+			OutDetailNo:    core.String(fmt.Sprintf("x23zy545Bd%04d", i)),
+			TransferAmount: core.Int64(100),
+			TransferRemark: core.String("2020年4月报销"),
+			Openid:         core.String("o-MYE42l80oelYMDE34nYD456Xoy"),
+		})
+	}
+
+	batches, err := transferbatch.SplitBatchTransfer(req, transferbatch.MaxTransferDetailsPerBatch)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, batch := range batches {
+		fmt.Println(*batch.OutBatchNo, *batch.TotalNum, *batch.TotalAmount)
+	}
+	// Output:
+	// plfk2020042013001 1000 100000
+	// plfk2020042013002 1000 100000
+	// plfk2020042013003 500 50000
+}
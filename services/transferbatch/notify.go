@@ -0,0 +1,114 @@
+package transferbatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// 商家转账通知的通知类型（event_type）
+const (
+	// EventTypePrefix 所有商家转账通知类型的公共前缀
+	EventTypePrefix = "MCHTRANSFER."
+	// EventTypeBatchFinished 转账批次完成或关闭
+	EventTypeBatchFinished = "MCHTRANSFER.BATCH.FINISHED"
+	// EventTypeBillFinished 转账单完成、失败或撤销
+	EventTypeBillFinished = "MCHTRANSFER.BILL.FINISHED"
+)
+
+// BatchFinishedNotification 转账批次完成通知（MCHTRANSFER.BATCH.FINISHED）解密后的内容
+type BatchFinishedNotification struct {
+	// Mchid 商户号
+	Mchid string `json:"mchid"`
+	// OutBatchNo 商家批次单号
+	OutBatchNo string `json:"out_batch_no"`
+	// BatchId 微信批次单号
+	BatchId string `json:"batch_id"`
+	// BatchStatus 批次状态，取值见 BatchStatus，如 FINISHED、CLOSED
+	BatchStatus string `json:"batch_status"`
+	// TotalNum 转账总笔数
+	TotalNum int64 `json:"total_num"`
+	// TotalAmount 转账总金额，单位为分
+	TotalAmount int64 `json:"total_amount"`
+	// SuccessAmount 转账成功金额，单位为分
+	SuccessAmount int64 `json:"success_amount"`
+	// SuccessNum 转账成功笔数
+	SuccessNum int64 `json:"success_num"`
+	// FailAmount 转账失败金额，单位为分
+	FailAmount int64 `json:"fail_amount"`
+	// FailNum 转账失败笔数
+	FailNum int64 `json:"fail_num"`
+	// CloseReason 批次关闭原因，批次状态为 CLOSED 时返回，取值见 CloseReasonType
+	CloseReason string `json:"close_reason,omitempty"`
+	// UpdateTime 批次最近一次状态变更的时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+// BillFinishedNotification 转账单完成通知（MCHTRANSFER.BILL.FINISHED）解密后的内容
+type BillFinishedNotification struct {
+	// MchId 商户号
+	MchId string `json:"mch_id"`
+	// OutBillNo 商户单号
+	OutBillNo string `json:"out_bill_no"`
+	// TransferBillNo 微信转账单号
+	TransferBillNo string `json:"transfer_bill_no"`
+	// TransferAmount 转账金额，单位为分
+	TransferAmount int64 `json:"transfer_amount"`
+	// State 转账单状态，如 SUCCESS、FAIL、CANCELLED
+	State string `json:"state"`
+	// FailReason 转账失败原因，转账单状态为 FAIL 时返回
+	FailReason string `json:"fail_reason,omitempty"`
+	// Openid 收款用户的 openid
+	Openid string `json:"openid"`
+	// CreateTime 转账单创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime 转账单最近一次状态变更的时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+// BatchFinishedHandleFunc 转账批次完成通知处理函数，返回值的含义同 notify.HandleFunc
+type BatchFinishedHandleFunc func(ctx context.Context, req *notify.Request, content *BatchFinishedNotification) error
+
+// BillFinishedHandleFunc 转账单完成通知处理函数，返回值的含义同 notify.HandleFunc
+type BillFinishedHandleFunc func(ctx context.Context, req *notify.Request, content *BillFinishedNotification) error
+
+// HandleBatchFinished 将 fn 包装为 notify.HandleFunc：解析通知内容后调用 fn，内容无法解析时以 notify.Reject 应答
+func HandleBatchFinished(fn BatchFinishedHandleFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		content := new(BatchFinishedNotification)
+		if err := req.UnmarshalContent(content); err != nil {
+			return notify.Reject(err)
+		}
+		return fn(ctx, req, content)
+	}
+}
+
+// HandleBillFinished 将 fn 包装为 notify.HandleFunc：解析通知内容后调用 fn，内容无法解析时以 notify.Reject 应答
+func HandleBillFinished(fn BillFinishedHandleFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		content := new(BillFinishedNotification)
+		if err := req.UnmarshalContent(content); err != nil {
+			return notify.Reject(err)
+		}
+		return fn(ctx, req, content)
+	}
+}
+
+// RegisterNotifications 在 registry 中注册商家转账通知的通知内容类型，
+// 此后 registry 将 MCHTRANSFER.BATCH.FINISHED 与 MCHTRANSFER.BILL.FINISHED 通知分别解析为
+// *BatchFinishedNotification 与 *BillFinishedNotification
+func RegisterNotifications(registry *notify.Registry) {
+	registry.Register(EventTypeBatchFinished, new(BatchFinishedNotification))
+	registry.Register(EventTypeBillFinished, new(BillFinishedNotification))
+}
+
+// RouteNotifications 在 router 上注册处理转账批次完成与转账单完成通知的处理函数，为 nil 的处理函数不注册
+func RouteNotifications(router *notify.Router, batch BatchFinishedHandleFunc, bill BillFinishedHandleFunc) {
+	if batch != nil {
+		router.Handle(EventTypeBatchFinished, HandleBatchFinished(batch))
+	}
+	if bill != nil {
+		router.Handle(EventTypeBillFinished, HandleBillFinished(bill))
+	}
+}
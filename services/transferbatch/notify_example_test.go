@@ -0,0 +1,68 @@
+package transferbatch_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+func ExampleRouteNotifications() {
+	router := notify.NewRouter()
+	transferbatch.RouteNotifications(router,
+		func(ctx context.Context, req *notify.Request, content *transferbatch.BatchFinishedNotification) error {
+			// 根据商家批次单号更新转账批次，失败的明细可通过查询明细接口获取
+			fmt.Println(content.OutBatchNo, content.BatchStatus, content.SuccessNum, content.FailNum)
+			return nil
+		},
+		func(ctx context.Context, req *notify.Request, content *transferbatch.BillFinishedNotification) error {
+			fmt.Println(content.OutBillNo, content.State, content.TransferAmount)
+			return nil
+		},
+	)
+
+	// 实际使用时，将 router.Dispatch 作为 notify.Handler.HTTPHandler 的处理函数，由其完成验签与解密
+	err := router.Dispatch(context.Background(), &notify.Request{
+		ID:        "EV-2018022511223320873",
+		EventType: transferbatch.EventTypeBatchFinished,
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"mchid":"1900001109","out_batch_no":"plfk2020042013","batch_id":"1030000071100999991182020050700019480001",` +
+				`"batch_status":"FINISHED","total_num":2,"total_amount":200,"success_amount":100,"success_num":1,` +
+				`"fail_amount":100,"fail_num":1,"update_time":"2015-05-20T13:29:35+08:00"}`,
+		},
+	})
+	fmt.Println(err)
+	// Output:
+	// plfk2020042013 FINISHED 1 1
+	// <nil>
+}
+
+func ExampleRegisterNotifications() {
+	registry := notify.NewRegistry()
+	transferbatch.RegisterNotifications(registry)
+
+	handle := registry.HandleFunc(func(ctx context.Context, req *notify.Request, content *notify.Content) error {
+		switch value := content.Value.(type) {
+		case *transferbatch.BillFinishedNotification:
+			fmt.Println(value.OutBillNo, value.State, value.FailReason)
+		case *transferbatch.BatchFinishedNotification:
+			fmt.Println(value.OutBatchNo, value.BatchStatus)
+		}
+		return nil
+	})
+
+	err := handle(context.Background(), &notify.Request{
+		ID:        "EV-2018022511223320874",
+		EventType: transferbatch.EventTypeBillFinished,
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"mch_id":"1900001109","out_bill_no":"plfk2020042013","transfer_bill_no":"1330000071100999991182020050700019480001",` +
+				`"transfer_amount":100,"state":"FAIL","fail_reason":"PAYEE_ACCOUNT_ABNORMAL","openid":"o-MYE42l80oelYMDE34nYD456Xoy",` +
+				`"create_time":"2015-05-20T13:29:35+08:00","update_time":"2015-05-20T13:29:35+08:00"}`,
+		},
+	})
+	fmt.Println(err)
+	// Output:
+	// plfk2020042013 FAIL PAYEE_ACCOUNT_ABNORMAL
+	// <nil>
+}
@@ -0,0 +1,169 @@
+package transferbatch_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+const (
+	batchFinishedContent = `{"mchid":"1900001109","out_batch_no":"bfatestnotify000033","batch_id":"1030000071100999991182020050700019480001",` +
+		`"batch_status":"CLOSED","total_num":2,"total_amount":200,"success_amount":100,"success_num":1,"fail_amount":100,"fail_num":1,` +
+		`"close_reason":"OVERDUE_CLOSE","update_time":"2015-05-20T13:29:35+08:00"}`
+	billFinishedContent = `{"mch_id":"1900001109","out_bill_no":"plfk2020042013","transfer_bill_no":"1330000071100999991182020050700019480001",` +
+		`"transfer_amount":2000,"state":"FAIL","fail_reason":"PAYEE_ACCOUNT_ABNORMAL","openid":"o-MYE42l80oelYMDE34nYD456Xoy",` +
+		`"create_time":"2015-05-20T13:29:35+08:00","update_time":"2015-05-20T13:29:36+08:00","new_field":"v"}`
+)
+
+func transferNotifyRequest(eventType, plaintext string) *notify.Request {
+	return &notify.Request{
+		ID:        "EV-2018022511223320873",
+		EventType: eventType,
+		Resource:  &notify.EncryptedResource{Plaintext: plaintext},
+	}
+}
+
+func TestHandleBatchFinished(t *testing.T) {
+	var content *transferbatch.BatchFinishedNotification
+	handle := transferbatch.HandleBatchFinished(
+		func(_ context.Context, _ *notify.Request, c *transferbatch.BatchFinishedNotification) error {
+			content = c
+			return nil
+		},
+	)
+
+	require.NoError(t, handle(context.Background(), transferNotifyRequest(transferbatch.EventTypeBatchFinished, batchFinishedContent)))
+	require.NotNil(t, content)
+	assert.Equal(t, "1900001109", content.Mchid)
+	assert.Equal(t, "bfatestnotify000033", content.OutBatchNo)
+	assert.Equal(t, "1030000071100999991182020050700019480001", content.BatchId)
+	assert.Equal(t, "CLOSED", content.BatchStatus)
+	assert.Equal(t, int64(2), content.TotalNum)
+	assert.Equal(t, int64(200), content.TotalAmount)
+	assert.Equal(t, int64(100), content.SuccessAmount)
+	assert.Equal(t, int64(1), content.SuccessNum)
+	assert.Equal(t, int64(100), content.FailAmount)
+	assert.Equal(t, int64(1), content.FailNum)
+	assert.Equal(t, "OVERDUE_CLOSE", content.CloseReason)
+	require.NotNil(t, content.UpdateTime)
+	assert.True(t, time.Date(2015, 5, 20, 5, 29, 35, 0, time.UTC).Equal(*content.UpdateTime))
+}
+
+func TestHandleBillFinished(t *testing.T) {
+	var content *transferbatch.BillFinishedNotification
+	handle := transferbatch.HandleBillFinished(
+		func(_ context.Context, _ *notify.Request, c *transferbatch.BillFinishedNotification) error {
+			content = c
+			return nil
+		},
+	)
+
+	require.NoError(t, handle(context.Background(), transferNotifyRequest(transferbatch.EventTypeBillFinished, billFinishedContent)))
+	require.NotNil(t, content)
+	assert.Equal(t, "1900001109", content.MchId)
+	assert.Equal(t, "plfk2020042013", content.OutBillNo)
+	assert.Equal(t, "1330000071100999991182020050700019480001", content.TransferBillNo)
+	assert.Equal(t, int64(2000), content.TransferAmount)
+	assert.Equal(t, "FAIL", content.State)
+	assert.Equal(t, "PAYEE_ACCOUNT_ABNORMAL", content.FailReason)
+	assert.Equal(t, "o-MYE42l80oelYMDE34nYD456Xoy", content.Openid)
+	require.NotNil(t, content.CreateTime)
+	require.NotNil(t, content.UpdateTime)
+	assert.Equal(t, time.Second, content.UpdateTime.Sub(*content.CreateTime))
+}
+
+func TestHandleFinished_Reject(t *testing.T) {
+	called := false
+	batch := transferbatch.HandleBatchFinished(
+		func(context.Context, *notify.Request, *transferbatch.BatchFinishedNotification) error {
+			called = true
+			return nil
+		},
+	)
+	bill := transferbatch.HandleBillFinished(
+		func(context.Context, *notify.Request, *transferbatch.BillFinishedNotification) error {
+			called = true
+			return nil
+		},
+	)
+
+	tests := []struct {
+		name   string
+		handle notify.HandleFunc
+		req    *notify.Request
+	}{
+		{name: "invalid batch content", handle: batch, req: transferNotifyRequest(transferbatch.EventTypeBatchFinished, `{"total_num":"2"}`)},
+		{name: "invalid bill content", handle: bill, req: transferNotifyRequest(transferbatch.EventTypeBillFinished, `not json`)},
+		{name: "no resource", handle: bill, req: &notify.Request{ID: "EV-2018022511223320873", EventType: transferbatch.EventTypeBillFinished}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			err := tt.handle(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.True(t, notify.IsReject(err), "%v", err)
+			assert.False(t, called)
+		})
+	}
+}
+
+func TestRouteNotifications(t *testing.T) {
+	var batches, bills []string
+	router := notify.NewRouter()
+	transferbatch.RouteNotifications(router,
+		func(_ context.Context, _ *notify.Request, c *transferbatch.BatchFinishedNotification) error {
+			batches = append(batches, c.OutBatchNo)
+			return nil
+		},
+		func(_ context.Context, _ *notify.Request, c *transferbatch.BillFinishedNotification) error {
+			bills = append(bills, c.OutBillNo)
+			return notify.Retry(errors.New("database unavailable"))
+		},
+	)
+
+	ctx := context.Background()
+	require.NoError(t, router.Dispatch(ctx, transferNotifyRequest(transferbatch.EventTypeBatchFinished, batchFinishedContent)))
+	// 处理函数返回的错误原样返回
+	err := router.Dispatch(ctx, transferNotifyRequest(transferbatch.EventTypeBillFinished, billFinishedContent))
+	assert.True(t, notify.IsRetry(err), "%v", err)
+	assert.Equal(t, []string{"bfatestnotify000033"}, batches)
+	assert.Equal(t, []string{"plfk2020042013"}, bills)
+
+	// 为 nil 的处理函数不注册，对应的通知以 Reject 应答
+	router = notify.NewRouter()
+	transferbatch.RouteNotifications(router, nil, func(context.Context, *notify.Request, *transferbatch.BillFinishedNotification) error {
+		return nil
+	})
+	err = router.Dispatch(ctx, transferNotifyRequest(transferbatch.EventTypeBatchFinished, batchFinishedContent))
+	assert.True(t, notify.IsReject(err), "%v", err)
+	assert.NoError(t, router.Dispatch(ctx, transferNotifyRequest(transferbatch.EventTypeBillFinished, billFinishedContent)))
+}
+
+func TestRegisterNotifications(t *testing.T) {
+	registry := notify.NewRegistry()
+	transferbatch.RegisterNotifications(registry)
+	assert.True(t, registry.Registered(transferbatch.EventTypeBatchFinished))
+	assert.True(t, registry.Registered(transferbatch.EventTypeBillFinished))
+
+	content, err := registry.Decode(transferNotifyRequest(transferbatch.EventTypeBatchFinished, batchFinishedContent))
+	require.NoError(t, err)
+	batch, ok := content.Value.(*transferbatch.BatchFinishedNotification)
+	require.True(t, ok, "%T", content.Value)
+	assert.Equal(t, "bfatestnotify000033", batch.OutBatchNo)
+	assert.Empty(t, content.Unknown)
+
+	content, err = registry.Decode(transferNotifyRequest(transferbatch.EventTypeBillFinished, billFinishedContent))
+	require.NoError(t, err)
+	bill, ok := content.Value.(*transferbatch.BillFinishedNotification)
+	require.True(t, ok, "%T", content.Value)
+	assert.Equal(t, "plfk2020042013", bill.OutBillNo)
+	// 未定义的字段可以通过 Unknown 读取
+	assert.Equal(t, `"v"`, string(content.Unknown["new_field"]))
+}
@@ -0,0 +1,188 @@
+package transferbatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+)
+
+// ReceiptAcceptTypeBatchTransfer 批量转账明细电子回单的受理类型
+const ReceiptAcceptTypeBatchTransfer = "BATCH_TRANSFER"
+
+// ErrReceiptNotReady 电子回单尚未生成（受理状态不是 FINISHED），请稍后重新下载
+var ErrReceiptNotReady = errors.New("electronic receipt is not ready")
+
+// ReceiptMetadata 电子回单对应转账明细的结构化信息，归档系统可以据此建立索引，无需解析回单文件
+type ReceiptMetadata struct {
+	// Mchid 商户号
+	Mchid string `json:"mchid"`
+	// OutBatchNo 商家批次单号
+	OutBatchNo string `json:"out_batch_no"`
+	// BatchId 微信批次单号
+	BatchId string `json:"batch_id"`
+	// OutDetailNo 商家明细单号
+	OutDetailNo string `json:"out_detail_no"`
+	// DetailId 微信明细单号
+	DetailId string `json:"detail_id"`
+	// Openid 收款用户的 openid
+	Openid string `json:"openid"`
+	// TransferAmount 转账金额，单位为分
+	TransferAmount int64 `json:"transfer_amount"`
+	// TransferRemark 转账备注
+	TransferRemark string `json:"transfer_remark"`
+	// DetailStatus 明细状态
+	DetailStatus DetailStatus `json:"detail_status"`
+	// InitiateTime 转账发起的时间
+	InitiateTime *time.Time `json:"initiate_time,omitempty"`
+	// UpdateTime 明细最后一次状态变更的时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// SignatureNo 电子回单申请单号
+	SignatureNo string `json:"signature_no"`
+	// ReceiptCreateTime 电子回单的受理时间
+	ReceiptCreateTime *time.Time `json:"receipt_create_time,omitempty"`
+	// HashType 回单文件的摘要类型
+	HashType string `json:"hash_type"`
+	// HashValue 回单文件的摘要值
+	HashValue string `json:"hash_value"`
+}
+
+// Receipt 下载的电子回单
+type Receipt struct {
+	// Metadata 转账明细的结构化信息
+	Metadata *ReceiptMetadata
+	// Body 回单文件（PDF）内容，调用方读取完毕后需要关闭。读取到末尾时校验文件摘要，不一致时 Read 返回错误
+	Body io.ReadCloser
+}
+
+// ReceiptDownloader 转账明细电子回单下载器，下载回单文件的同时返回转账明细的结构化信息
+type ReceiptDownloader struct {
+	client   *core.Client
+	details  TransferDetailApiService
+	receipts ElectronicReceiptApiService
+}
+
+// NewReceiptDownloader 创建 ReceiptDownloader
+func NewReceiptDownloader(client *core.Client) *ReceiptDownloader {
+	return &ReceiptDownloader{
+		client:   client,
+		details:  TransferDetailApiService{Client: client},
+		receipts: ElectronicReceiptApiService{Client: client},
+	}
+}
+
+// Download 下载转账明细的电子回单，需先使用 ElectronicReceiptApiService.ApplyElectronicReceipt 申请
+//
+// 电子回单尚未生成时返回 ErrReceiptNotReady。回单下载地址有效期为 10 分钟，每次调用都会重新查询。
+func (d *ReceiptDownloader) Download(ctx context.Context, outBatchNo, outDetailNo string) (*Receipt, error) {
+	receipt, _, err := d.receipts.QueryElectronicReceipt(ctx, QueryElectronicReceiptRequest{
+		AcceptType:  core.String(ReceiptAcceptTypeBatchTransfer),
+		OutBatchNo:  core.String(outBatchNo),
+		OutDetailNo: core.String(outDetailNo),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if receipt.State == nil || *receipt.State != RECEIPTSTATE_FINISHED || receipt.DownloadUrl == nil {
+		return nil, fmt.Errorf("receipt of %s/%s: %w", outBatchNo, outDetailNo, ErrReceiptNotReady)
+	}
+	if err = checkReceiptURL(*receipt.DownloadUrl); err != nil {
+		return nil, err
+	}
+
+	detail, _, err := d.details.GetTransferDetailByOutNo(ctx, GetTransferDetailByOutNoRequest{
+		OutBatchNo:  core.String(outBatchNo),
+		OutDetailNo: core.String(outDetailNo),
+	})
+	if err != nil {
+		return nil, err
+	}
+	metadata := newReceiptMetadata(detail, receipt)
+
+	body, err := newReceiptBody(metadata.HashType, metadata.HashValue)
+	if err != nil {
+		return nil, err
+	}
+	result, err := d.client.Download(ctx, *receipt.DownloadUrl)
+	if err != nil {
+		return nil, err
+	}
+	body.ReadCloser = result.Response.Body
+	return &Receipt{Metadata: metadata, Body: body}, nil
+}
+
+func newReceiptMetadata(detail *TransferDetailEntity, receipt *ElectronicReceiptEntity) *ReceiptMetadata {
+	m := &ReceiptMetadata{
+		Mchid:             core.StringValue(detail.Mchid),
+		OutBatchNo:        core.StringValue(detail.OutBatchNo),
+		BatchId:           core.StringValue(detail.BatchId),
+		OutDetailNo:       core.StringValue(detail.OutDetailNo),
+		DetailId:          core.StringValue(detail.DetailId),
+		Openid:            core.StringValue(detail.Openid),
+		TransferAmount:    core.Int64Value(detail.TransferAmount),
+		TransferRemark:    core.StringValue(detail.TransferRemark),
+		InitiateTime:      detail.InitiateTime,
+		UpdateTime:        detail.UpdateTime,
+		SignatureNo:       core.StringValue(receipt.SignatureNo),
+		ReceiptCreateTime: receipt.CreateTime,
+		HashType:          core.StringValue(receipt.HashType),
+		HashValue:         core.StringValue(receipt.HashValue),
+	}
+	if detail.DetailStatus != nil {
+		m.DetailStatus = *detail.DetailStatus
+	}
+	return m
+}
+
+// checkReceiptURL 检查下载地址是否为微信支付 API 域名下的地址，避免将商户签名发送到其他地址
+func checkReceiptURL(downloadURL string) error {
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		return fmt.Errorf("parse receipt download url err:%v", err)
+	}
+	origin := u.Scheme + "://" + u.Host
+	if origin != consts.WechatPayAPIServer && origin != consts.WechatPayAPIServerBackup {
+		return fmt.Errorf("receipt download url must be under %s, got %s", consts.WechatPayAPIServer, origin)
+	}
+	return nil
+}
+
+// receiptBody 在读取到末尾时校验回单文件的摘要
+type receiptBody struct {
+	io.ReadCloser
+	expected string
+	hash     hash.Hash
+}
+
+func newReceiptBody(hashType, hashValue string) (*receiptBody, error) {
+	switch strings.ToUpper(hashType) {
+	case "SHA256":
+		return &receiptBody{expected: strings.ToLower(hashValue), hash: sha256.New()}, nil
+	case "":
+		return &receiptBody{}, nil
+	}
+	return nil, fmt.Errorf("unsupported receipt hash type: %s", hashType)
+}
+
+func (b *receiptBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.hash == nil {
+		return n, err
+	}
+	_, _ = b.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := hex.EncodeToString(b.hash.Sum(nil)); actual != b.expected {
+			return n, fmt.Errorf("receipt sha256 mismatch, expected %s, got %s", b.expected, actual)
+		}
+	}
+	return n, err
+}
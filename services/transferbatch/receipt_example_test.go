@@ -0,0 +1,62 @@
+package transferbatch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"os"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+func ExampleReceiptDownloader_Download() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	// 首先申请电子回单，回单生成需要一定时间
+	svc := transferbatch.ElectronicReceiptApiService{Client: client}
+	_, _, err := svc.ApplyElectronicReceipt(ctx, transferbatch.ApplyElectronicReceiptBody{
+		AcceptType:  core.String(transferbatch.ReceiptAcceptTypeBatchTransfer),
+		OutBatchNo:  core.String("plfk2020042013"),
+		OutDetailNo: core.String("x23zy545Bd5436"),
+	})
+	if err != nil {
+		log.Printf("apply electronic receipt err:%s", err)
+		return
+	}
+
+	// 稍后下载电子回单
+	downloader := transferbatch.NewReceiptDownloader(client)
+	receipt, err := downloader.Download(ctx, "plfk2020042013", "x23zy545Bd5436")
+	if errors.Is(err, transferbatch.ErrReceiptNotReady) {
+		// 回单尚未生成，稍后重试
+		return
+	}
+	if err != nil {
+		log.Printf("download electronic receipt err:%s", err)
+		return
+	}
+	defer receipt.Body.Close()
+
+	file, err := os.Create(receipt.Metadata.OutDetailNo + ".pdf")
+	if err != nil {
+		log.Printf("create receipt file err:%s", err)
+		return
+	}
+	defer file.Close()
+	// 读取到末尾时校验回单文件的摘要
+	if _, err = io.Copy(file, receipt.Body); err != nil {
+		log.Printf("save electronic receipt err:%s", err)
+		return
+	}
+
+	// 将结构化信息与回单文件一同归档，用于建立索引
+	index, _ := json.Marshal(receipt.Metadata)
+	log.Printf("archived receipt: %s", index)
+}
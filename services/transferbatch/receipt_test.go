@@ -0,0 +1,174 @@
+package transferbatch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/internal/clienttest"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/transferbatch"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	content, err := ioutil.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return content
+}
+
+// fakeReceiptServer 使用 testdata 中的应答模拟查询电子回单、查询转账明细与下载回单文件，
+// receipt 中的字段覆盖 electronic_receipt.json 中的对应字段
+type fakeReceiptServer struct {
+	t        *testing.T
+	receipt  map[string]interface{}
+	pdf      []byte
+	download int32
+}
+
+func newFakeReceiptServer(t *testing.T, override map[string]interface{}) *fakeReceiptServer {
+	receipt := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(readFixture(t, "electronic_receipt.json"), &receipt))
+	for k, v := range override {
+		if v == nil {
+			delete(receipt, k)
+		} else {
+			receipt[k] = v
+		}
+	}
+	return &fakeReceiptServer{t: t, receipt: receipt, pdf: readFixture(t, "receipt.pdf")}
+}
+
+func (s *fakeReceiptServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/v3/transfer-detail/electronic-receipts":
+		assert.Equal(s.t, transferbatch.ReceiptAcceptTypeBatchTransfer, r.URL.Query().Get("accept_type"))
+		assert.Equal(s.t, "plfk2020042013", r.URL.Query().Get("out_batch_no"))
+		assert.Equal(s.t, "x23zy545Bd5436", r.URL.Query().Get("out_detail_no"))
+		clienttest.WriteJSON(w, http.StatusOK, s.receipt)
+	case r.URL.Path == "/v3/transfer/batches/out-batch-no/plfk2020042013/details/out-detail-no/x23zy545Bd5436":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(readFixture(s.t, "transfer_detail.json"))
+	case r.URL.Path == "/v3/billdownload/file":
+		atomic.AddInt32(&s.download, 1)
+		assert.Equal(s.t, "xxx", r.URL.Query().Get("token"))
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write(s.pdf)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func downloadReceipt(t *testing.T, server *fakeReceiptServer) (*transferbatch.Receipt, error) {
+	client, err := clienttest.NewHandlerClient(server)
+	require.NoError(t, err)
+	return transferbatch.NewReceiptDownloader(client).Download(context.Background(), "plfk2020042013", "x23zy545Bd5436")
+}
+
+func TestReceiptDownloader_Download(t *testing.T) {
+	server := newFakeReceiptServer(t, nil)
+	receipt, err := downloadReceipt(t, server)
+	require.NoError(t, err)
+	defer receipt.Body.Close()
+
+	m := receipt.Metadata
+	assert.Equal(t, "19300009191", m.Mchid)
+	assert.Equal(t, "plfk2020042013", m.OutBatchNo)
+	assert.Equal(t, "1030000071100999991182020050700019480001", m.BatchId)
+	assert.Equal(t, "x23zy545Bd5436", m.OutDetailNo)
+	assert.Equal(t, "1040000071100999991182020050700019500100", m.DetailId)
+	assert.Equal(t, "o-MYE42l80oelYMDE34nYD456Xoy", m.Openid)
+	assert.Equal(t, int64(200000), m.TransferAmount)
+	assert.Equal(t, "2020年4月报销", m.TransferRemark)
+	assert.Equal(t, transferbatch.DETAILSTATUS_SUCCESS, m.DetailStatus)
+	assert.Equal(t, "1050000010509062000000000000000", m.SignatureNo)
+	assert.Equal(t, "SHA256", m.HashType)
+
+	cst := time.FixedZone("CST", 8*3600)
+	require.NotNil(t, m.InitiateTime)
+	assert.True(t, time.Date(2020, 4, 20, 13, 29, 35, 0, cst).Equal(*m.InitiateTime), m.InitiateTime)
+	require.NotNil(t, m.UpdateTime)
+	assert.True(t, time.Date(2020, 4, 20, 13, 30, 12, 0, cst).Equal(*m.UpdateTime), m.UpdateTime)
+	require.NotNil(t, m.ReceiptCreateTime)
+	assert.True(t, time.Date(2020, 4, 20, 14, 20, 0, 0, cst).Equal(*m.ReceiptCreateTime), m.ReceiptCreateTime)
+
+	// 摘要值大小写不敏感，读取到末尾时校验通过
+	content, err := ioutil.ReadAll(receipt.Body)
+	require.NoError(t, err)
+	assert.Equal(t, server.pdf, content)
+
+	// 结构化信息可以直接序列化后归档
+	index, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.Contains(t, string(index), `"out_detail_no":"x23zy545Bd5436"`)
+	assert.Contains(t, string(index), `"transfer_amount":200000`)
+	assert.Contains(t, string(index), `"initiate_time":"2020-04-20T13:29:35+08:00"`)
+}
+
+func TestReceiptDownloader_DownloadHashMismatch(t *testing.T) {
+	server := newFakeReceiptServer(t, nil)
+	server.pdf = []byte("%PDF-1.4 tampered")
+	receipt, err := downloadReceipt(t, server)
+	require.NoError(t, err)
+	defer receipt.Body.Close()
+
+	_, err = io.Copy(ioutil.Discard, receipt.Body)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sha256 mismatch")
+}
+
+func TestReceiptDownloader_DownloadWithoutHash(t *testing.T) {
+	server := newFakeReceiptServer(t, map[string]interface{}{"hash_type": nil, "hash_value": nil})
+	server.pdf = []byte("%PDF-1.4 any content")
+	receipt, err := downloadReceipt(t, server)
+	require.NoError(t, err)
+	defer receipt.Body.Close()
+
+	content, err := ioutil.ReadAll(receipt.Body)
+	require.NoError(t, err)
+	assert.Equal(t, server.pdf, content)
+	assert.Empty(t, receipt.Metadata.HashType)
+}
+
+func TestReceiptDownloader_DownloadRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		override map[string]interface{}
+		notReady bool
+	}{
+		{name: "receipt accepted", override: map[string]interface{}{"state": "ACCEPTED"}, notReady: true},
+		{name: "no download url", override: map[string]interface{}{"download_url": nil}, notReady: true},
+		{name: "other host", override: map[string]interface{}{"download_url": "https://evil.example.com/v3/billdownload/file?token=xxx"}},
+		{name: "unsupported hash type", override: map[string]interface{}{"hash_type": "SM3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeReceiptServer(t, tt.override)
+			receipt, err := downloadReceipt(t, server)
+			require.Error(t, err)
+			assert.Nil(t, receipt)
+			assert.Equal(t, tt.notReady, errors.Is(err, transferbatch.ErrReceiptNotReady), err)
+			// 不会下载回单文件
+			assert.Equal(t, int32(0), atomic.LoadInt32(&server.download))
+		})
+	}
+}
+
+func TestReceiptDownloader_DownloadQueryError(t *testing.T) {
+	client, err := clienttest.NewHandlerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clienttest.WriteError(w, http.StatusNotFound, "RESOURCE_NOT_EXISTS", "回单不存在")
+	}))
+	require.NoError(t, err)
+
+	_, err = transferbatch.NewReceiptDownloader(client).Download(context.Background(), "plfk2020042013", "x23zy545Bd5436")
+	require.Error(t, err)
+	assert.True(t, core.IsAPIError(err, "RESOURCE_NOT_EXISTS"))
+}
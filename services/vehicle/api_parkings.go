@@ -0,0 +1,72 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 车主服务API
+//
+// 微信支付分停车服务的API，服务商为子商户查询车牌服务开通信息、创建停车入场记录并发起扣费受理
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package vehicle
+
+import (
+	"context"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ParkingsApiService services.Service
+
+// CreateParking 创建通行记录
+//
+// # 应用场景
+// 车辆驶入停车场时，服务商通过该接口为车牌创建停车入场记录，驶出后使用通行记录ID发起扣费受理。
+//
+// 注意：
+// 1、同一商户通行记录号重复请求时返回首次创建的通行记录
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |USER_NOT_EXIST|用户未开通服务|车牌未开通车主服务或已暂停服务|请查询车牌服务开通信息|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ParkingsApiService) CreateParking(ctx context.Context, req CreateParkingBody) (resp *Parking, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/vehicle/parking/parkings"
+	// Make sure All Required Params are properly set
+
+	// Setup Body Params
+	localVarPostBody = req
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{"application/json"}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract Parking from Http Response
+	resp = new(Parking)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 车主服务API
+//
+// 微信支付分停车服务的API，服务商为子商户查询车牌服务开通信息、创建停车入场记录并发起扣费受理
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package vehicle_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/vehicle"
+)
+
+func ExampleParkingsApiService_CreateParking() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := vehicle.ParkingsApiService{Client: client}
+	resp, result, err := svc.CreateParking(ctx,
+		vehicle.CreateParkingBody{
+			FreeDuration: core.Int64(3600),
+			NotifyUrl:    core.String("https://yoursite.com/wxpay.html"),
+			OutParkingNo: core.String("1231243"),
+			ParkingName:  core.String("欢乐海岸停车场"),
+			PlateColor:   vehicle.PLATECOLOR_BLUE.Ptr(),
+			PlateNumber:  core.String("粤B888888"),
+			StartTime:    core.Time(time.Now()),
+			SubMchid:     core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
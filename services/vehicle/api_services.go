@@ -0,0 +1,93 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 车主服务API
+//
+// 微信支付分停车服务的API，服务商为子商户查询车牌服务开通信息、创建停车入场记录并发起扣费受理
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package vehicle
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	neturl "net/url"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/core/consts"
+	"github.com/wechatpay-apiv3/wechatpay-go/services"
+)
+
+type ServicesApiService services.Service
+
+// FindService 查询车牌服务开通信息
+//
+// # 应用场景
+// 车辆驶入或发起扣费前，服务商可以通过该接口查询车牌是否已开通车主服务，以及用户的服务状态。
+//
+// 注意：
+// 1、服务状态为NORMAL时才能创建通行记录并扣费，PAUSE表示用户已暂停服务，OUT_SERVICE表示用户未开通或已关闭服务
+//
+// # 错误码
+// |名称|描述|原因|解决方案|
+// |-|-|-|-|
+// |RESOURCE_NOT_EXISTS|车牌未开通服务|车牌未开通车主服务|请引导用户开通车主服务|
+// |PARAM_ERROR|参数错误|请求参数不符合要求|请检查请求参数后重新调用|
+// |FREQUENCY_LIMITED|频率超限|请求频率超过接口限制|请降低请求频率后重试|
+// |SYSTEM_ERROR|系统错误|系统超时等|请使用原参数重试|
+func (a *ServicesApiService) FindService(ctx context.Context, req FindServiceRequest) (resp *VehicleService, result *core.APIResult, err error) {
+	var (
+		localVarHTTPMethod   = nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarQueryParams  neturl.Values
+		localVarHeaderParams = nethttp.Header{}
+	)
+
+	localVarPath := consts.WechatPayAPIServer + "/v3/vehicle/parking/services/find"
+	// Make sure All Required Params are properly set
+	if req.Appid == nil {
+		return nil, nil, fmt.Errorf("field `Appid` is required and must be specified in FindServiceRequest")
+	}
+	if req.SubMchid == nil {
+		return nil, nil, fmt.Errorf("field `SubMchid` is required and must be specified in FindServiceRequest")
+	}
+	if req.PlateNumber == nil {
+		return nil, nil, fmt.Errorf("field `PlateNumber` is required and must be specified in FindServiceRequest")
+	}
+	if req.PlateColor == nil {
+		return nil, nil, fmt.Errorf("field `PlateColor` is required and must be specified in FindServiceRequest")
+	}
+	if req.Openid == nil {
+		return nil, nil, fmt.Errorf("field `Openid` is required and must be specified in FindServiceRequest")
+	}
+
+	// Setup Query Params
+	localVarQueryParams = neturl.Values{}
+	localVarQueryParams.Add("appid", core.ParameterToString(*req.Appid, ""))
+	localVarQueryParams.Add("sub_mchid", core.ParameterToString(*req.SubMchid, ""))
+	localVarQueryParams.Add("plate_number", core.ParameterToString(*req.PlateNumber, ""))
+	localVarQueryParams.Add("plate_color", core.ParameterToString(*req.PlateColor, ""))
+	localVarQueryParams.Add("openid", core.ParameterToString(*req.Openid, ""))
+
+	// Determine the Content-Type Header
+	localVarHTTPContentTypes := []string{}
+	// Setup Content-Type
+	localVarHTTPContentType := core.SelectHeaderContentType(localVarHTTPContentTypes)
+
+	// Perform Http Request
+	result, err = a.Client.Request(ctx, localVarHTTPMethod, localVarPath, localVarHeaderParams, localVarQueryParams, localVarPostBody, localVarHTTPContentType)
+	if err != nil {
+		return nil, result, err
+	}
+
+	// Extract VehicleService from Http Response
+	resp = new(VehicleService)
+	err = core.UnMarshalResponse(result.Response, resp)
+	if err != nil {
+		return nil, result, err
+	}
+	return resp, result, nil
+}
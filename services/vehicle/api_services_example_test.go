@@ -0,0 +1,40 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 车主服务API
+//
+// 微信支付分停车服务的API，服务商为子商户查询车牌服务开通信息、创建停车入场记录并发起扣费受理
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package vehicle_test
+
+import (
+	"context"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/vehicle"
+)
+
+func ExampleServicesApiService_FindService() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := vehicle.ServicesApiService{Client: client}
+	resp, result, err := svc.FindService(ctx,
+		vehicle.FindServiceRequest{
+			Appid:       core.String("wxcbda96de0b165486"),
+			Openid:      core.String("oUpF8uMuAJOM2pxb1Q"),
+			PlateColor:  core.String("BLUE"),
+			PlateNumber: core.String("粤B888888"),
+			SubMchid:    core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 车主服务API
+//
+// 微信支付分停车服务的API，服务商为子商户查询车牌服务开通信息、创建停车入场记录并发起扣费受理
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package vehicle_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/vehicle"
+)
+
+func ExampleTransactionsApiService_CreateTransaction() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := vehicle.TransactionsApiService{Client: client}
+	resp, result, err := svc.CreateTransaction(ctx,
+		vehicle.CreateTransactionBody{
+			Amount: &vehicle.TransactionAmount{
+				Currency: core.String("CNY"),
+				Total:    core.Int64(888),
+			},
+			Appid:       core.String("wxcbda96de0b165486"),
+			Attach:      core.String("深圳分店"),
+			Description: core.String("停车场扣费"),
+			GoodsTag:    core.String("WXG"),
+			NotifyUrl:   core.String("https://yoursite.com/wxpay.html"),
+			OutTradeNo:  core.String("20150806125346"),
+			ParkingInfo: &vehicle.ParkingInfo{
+				ChargingDuration: core.Int64(7200),
+				DeviceId:         core.String("12313"),
+				EndTime:          core.Time(time.Now()),
+				ParkingId:        core.String("5K8264ILTKCH16CQ250"),
+				ParkingName:      core.String("欢乐海岸停车场"),
+				PlateColor:       vehicle.PLATECOLOR_BLUE.Ptr(),
+				PlateNumber:      core.String("粤B888888"),
+				StartTime:        core.Time(time.Now()),
+			},
+			ProfitSharing: core.String("Y"),
+			SubAppid:      core.String("wxcbda96de0b165484"),
+			SubMchid:      core.String("1900000109"),
+			TradeScene:    vehicle.TRADESCENE_PARKING.Ptr(),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
+
+func ExampleTransactionsApiService_QueryTransaction() {
+	var (
+		ctx    context.Context
+		client *core.Client
+	)
+	// 假设已获得初始化后的 core.Client
+
+	svc := vehicle.TransactionsApiService{Client: client}
+	resp, result, err := svc.QueryTransaction(ctx,
+		vehicle.QueryTransactionRequest{
+			OutTradeNo: core.String("20150806125346"),
+			SubMchid:   core.String("1900000109"),
+		},
+	)
+
+	// TODO: 处理返回结果
+	_, _, _ = resp, result, err
+}
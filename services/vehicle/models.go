@@ -0,0 +1,1767 @@
+// Copyright 2021 Tencent Inc. All rights reserved.
+//
+// 车主服务API
+//
+// 微信支付分停车服务的API，服务商为子商户查询车牌服务开通信息、创建停车入场记录并发起扣费受理
+//
+// API version: 1.0.0
+
+// Code generated by WechatPay APIv3 Generator based on [OpenAPI Generator](https://openapi-generator.tech); DO NOT EDIT.
+
+package vehicle
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CreateParkingBody
+type CreateParkingBody struct {
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商户通行记录号，在商户系统内唯一
+	OutParkingNo *string `json:"out_parking_no"`
+	// 车牌号，仅包括省份+车牌，不包括特殊字符
+	PlateNumber *string `json:"plate_number"`
+	// 车牌颜色
+	PlateColor *PlateColor `json:"plate_color"`
+	// 接收通行记录状态变更通知的地址，不能携带参数
+	NotifyUrl *string `json:"notify_url"`
+	// 车辆驶入时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	StartTime *time.Time `json:"start_time"`
+	// 停车场名称
+	ParkingName *string `json:"parking_name"`
+	// 免费时长，单位为秒
+	FreeDuration *int64 `json:"free_duration"`
+}
+
+func (o CreateParkingBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.OutParkingNo == nil {
+		return nil, fmt.Errorf("field `OutParkingNo` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["out_parking_no"] = o.OutParkingNo
+
+	if o.PlateNumber == nil {
+		return nil, fmt.Errorf("field `PlateNumber` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["plate_number"] = o.PlateNumber
+
+	if o.PlateColor == nil {
+		return nil, fmt.Errorf("field `PlateColor` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["plate_color"] = o.PlateColor
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.StartTime == nil {
+		return nil, fmt.Errorf("field `StartTime` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["start_time"] = o.StartTime.Format(time.RFC3339)
+
+	if o.ParkingName == nil {
+		return nil, fmt.Errorf("field `ParkingName` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["parking_name"] = o.ParkingName
+
+	if o.FreeDuration == nil {
+		return nil, fmt.Errorf("field `FreeDuration` is required and must be specified in CreateParkingBody")
+	}
+	toSerialize["free_duration"] = o.FreeDuration
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateParkingBody) String() string {
+	var ret string
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.OutParkingNo == nil {
+		ret += "OutParkingNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutParkingNo:%v, ", *o.OutParkingNo)
+	}
+
+	if o.PlateNumber == nil {
+		ret += "PlateNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateNumber:%v, ", *o.PlateNumber)
+	}
+
+	if o.PlateColor == nil {
+		ret += "PlateColor:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateColor:%v, ", *o.PlateColor)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.StartTime == nil {
+		ret += "StartTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StartTime:%v, ", *o.StartTime)
+	}
+
+	if o.ParkingName == nil {
+		ret += "ParkingName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ParkingName:%v, ", *o.ParkingName)
+	}
+
+	if o.FreeDuration == nil {
+		ret += "FreeDuration:<nil>"
+	} else {
+		ret += fmt.Sprintf("FreeDuration:%v", *o.FreeDuration)
+	}
+
+	return fmt.Sprintf("CreateParkingBody{%s}", ret)
+}
+
+func (o CreateParkingBody) Clone() *CreateParkingBody {
+	ret := CreateParkingBody{}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.OutParkingNo != nil {
+		ret.OutParkingNo = new(string)
+		*ret.OutParkingNo = *o.OutParkingNo
+	}
+
+	if o.PlateNumber != nil {
+		ret.PlateNumber = new(string)
+		*ret.PlateNumber = *o.PlateNumber
+	}
+
+	if o.PlateColor != nil {
+		ret.PlateColor = new(PlateColor)
+		*ret.PlateColor = *o.PlateColor
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.StartTime != nil {
+		ret.StartTime = new(time.Time)
+		*ret.StartTime = *o.StartTime
+	}
+
+	if o.ParkingName != nil {
+		ret.ParkingName = new(string)
+		*ret.ParkingName = *o.ParkingName
+	}
+
+	if o.FreeDuration != nil {
+		ret.FreeDuration = new(int64)
+		*ret.FreeDuration = *o.FreeDuration
+	}
+
+	return &ret
+}
+
+// CreateTransactionBody
+type CreateTransactionBody struct {
+	// 服务商在微信公众平台或移动应用申请的appid
+	Appid *string `json:"appid"`
+	// 子商户在微信公众平台或移动应用申请的appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description"`
+	// 附加数据，在查询API和扣费结果通知中原样返回
+	Attach *string `json:"attach,omitempty"`
+	// 商户系统内部订单号，只能是数字、大小写字母_-*且在同一个商户号下唯一
+	OutTradeNo *string `json:"out_trade_no"`
+	// 交易场景
+	TradeScene *TradeScene `json:"trade_scene"`
+	// 订单优惠标记
+	GoodsTag *string `json:"goods_tag,omitempty"`
+	// 接收扣费结果通知的地址，不能携带参数
+	NotifyUrl *string `json:"notify_url"`
+	// 是否指定分账，枚举值：Y、N
+	ProfitSharing *string `json:"profit_sharing,omitempty"`
+	// 订单金额
+	Amount *TransactionAmount `json:"amount"`
+	// 通行信息
+	ParkingInfo *ParkingInfo `json:"parking_info"`
+}
+
+func (o CreateTransactionBody) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description == nil {
+		return nil, fmt.Errorf("field `Description` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["description"] = o.Description
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TradeScene == nil {
+		return nil, fmt.Errorf("field `TradeScene` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["trade_scene"] = o.TradeScene
+
+	if o.GoodsTag != nil {
+		toSerialize["goods_tag"] = o.GoodsTag
+	}
+
+	if o.NotifyUrl == nil {
+		return nil, fmt.Errorf("field `NotifyUrl` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["notify_url"] = o.NotifyUrl
+
+	if o.ProfitSharing != nil {
+		toSerialize["profit_sharing"] = o.ProfitSharing
+	}
+
+	if o.Amount == nil {
+		return nil, fmt.Errorf("field `Amount` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["amount"] = o.Amount
+
+	if o.ParkingInfo == nil {
+		return nil, fmt.Errorf("field `ParkingInfo` is required and must be specified in CreateTransactionBody")
+	}
+	toSerialize["parking_info"] = o.ParkingInfo
+	return json.Marshal(toSerialize)
+}
+
+func (o CreateTransactionBody) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TradeScene == nil {
+		ret += "TradeScene:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeScene:%v, ", *o.TradeScene)
+	}
+
+	if o.GoodsTag == nil {
+		ret += "GoodsTag:<nil>, "
+	} else {
+		ret += fmt.Sprintf("GoodsTag:%v, ", *o.GoodsTag)
+	}
+
+	if o.NotifyUrl == nil {
+		ret += "NotifyUrl:<nil>, "
+	} else {
+		ret += fmt.Sprintf("NotifyUrl:%v, ", *o.NotifyUrl)
+	}
+
+	if o.ProfitSharing == nil {
+		ret += "ProfitSharing:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ProfitSharing:%v, ", *o.ProfitSharing)
+	}
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("ParkingInfo:%v", o.ParkingInfo)
+
+	return fmt.Sprintf("CreateTransactionBody{%s}", ret)
+}
+
+func (o CreateTransactionBody) Clone() *CreateTransactionBody {
+	ret := CreateTransactionBody{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TradeScene != nil {
+		ret.TradeScene = new(TradeScene)
+		*ret.TradeScene = *o.TradeScene
+	}
+
+	if o.GoodsTag != nil {
+		ret.GoodsTag = new(string)
+		*ret.GoodsTag = *o.GoodsTag
+	}
+
+	if o.NotifyUrl != nil {
+		ret.NotifyUrl = new(string)
+		*ret.NotifyUrl = *o.NotifyUrl
+	}
+
+	if o.ProfitSharing != nil {
+		ret.ProfitSharing = new(string)
+		*ret.ProfitSharing = *o.ProfitSharing
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.ParkingInfo != nil {
+		ret.ParkingInfo = o.ParkingInfo.Clone()
+	}
+
+	return &ret
+}
+
+// FindServiceRequest
+type FindServiceRequest struct {
+	// 服务商在微信公众平台或移动应用申请的appid
+	Appid *string `json:"appid"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 车牌号，仅包括省份+车牌，不包括特殊字符
+	PlateNumber *string `json:"plate_number"`
+	// 车牌颜色，枚举值：BLUE（蓝色）、GREEN（绿色）、YELLOW（黄色）、BLACK（黑色）、WHITE（白色）、LIMEGREEN（黄绿色）
+	PlateColor *string `json:"plate_color"`
+	// 用户在appid下的唯一标识
+	Openid *string `json:"openid"`
+}
+
+func (o FindServiceRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in FindServiceRequest")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in FindServiceRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.PlateNumber == nil {
+		return nil, fmt.Errorf("field `PlateNumber` is required and must be specified in FindServiceRequest")
+	}
+	toSerialize["plate_number"] = o.PlateNumber
+
+	if o.PlateColor == nil {
+		return nil, fmt.Errorf("field `PlateColor` is required and must be specified in FindServiceRequest")
+	}
+	toSerialize["plate_color"] = o.PlateColor
+
+	if o.Openid == nil {
+		return nil, fmt.Errorf("field `Openid` is required and must be specified in FindServiceRequest")
+	}
+	toSerialize["openid"] = o.Openid
+	return json.Marshal(toSerialize)
+}
+
+func (o FindServiceRequest) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.PlateNumber == nil {
+		ret += "PlateNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateNumber:%v, ", *o.PlateNumber)
+	}
+
+	if o.PlateColor == nil {
+		ret += "PlateColor:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateColor:%v, ", *o.PlateColor)
+	}
+
+	if o.Openid == nil {
+		ret += "Openid:<nil>"
+	} else {
+		ret += fmt.Sprintf("Openid:%v", *o.Openid)
+	}
+
+	return fmt.Sprintf("FindServiceRequest{%s}", ret)
+}
+
+func (o FindServiceRequest) Clone() *FindServiceRequest {
+	ret := FindServiceRequest{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.PlateNumber != nil {
+		ret.PlateNumber = new(string)
+		*ret.PlateNumber = *o.PlateNumber
+	}
+
+	if o.PlateColor != nil {
+		ret.PlateColor = new(string)
+		*ret.PlateColor = *o.PlateColor
+	}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	return &ret
+}
+
+// Parking
+type Parking struct {
+	// 通行记录ID，由微信支付生成
+	Id *string `json:"id"`
+	// 商户通行记录号
+	OutParkingNo *string `json:"out_parking_no"`
+	// 车牌号，仅包括省份+车牌，不包括特殊字符
+	PlateNumber *string `json:"plate_number"`
+	// 车牌颜色
+	PlateColor *PlateColor `json:"plate_color"`
+	// 车辆驶入时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	StartTime *time.Time `json:"start_time"`
+	// 停车场名称
+	ParkingName *string `json:"parking_name"`
+	// 免费时长，单位为秒
+	FreeDuration *int64 `json:"free_duration"`
+	// 通行记录状态
+	State *ParkingState `json:"state"`
+	// 通行记录不可用的原因，状态为BLOCKED时返回
+	BlockReason *string `json:"block_reason,omitempty"`
+}
+
+func (o Parking) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Id == nil {
+		return nil, fmt.Errorf("field `Id` is required and must be specified in Parking")
+	}
+	toSerialize["id"] = o.Id
+
+	if o.OutParkingNo == nil {
+		return nil, fmt.Errorf("field `OutParkingNo` is required and must be specified in Parking")
+	}
+	toSerialize["out_parking_no"] = o.OutParkingNo
+
+	if o.PlateNumber == nil {
+		return nil, fmt.Errorf("field `PlateNumber` is required and must be specified in Parking")
+	}
+	toSerialize["plate_number"] = o.PlateNumber
+
+	if o.PlateColor == nil {
+		return nil, fmt.Errorf("field `PlateColor` is required and must be specified in Parking")
+	}
+	toSerialize["plate_color"] = o.PlateColor
+
+	if o.StartTime == nil {
+		return nil, fmt.Errorf("field `StartTime` is required and must be specified in Parking")
+	}
+	toSerialize["start_time"] = o.StartTime.Format(time.RFC3339)
+
+	if o.ParkingName == nil {
+		return nil, fmt.Errorf("field `ParkingName` is required and must be specified in Parking")
+	}
+	toSerialize["parking_name"] = o.ParkingName
+
+	if o.FreeDuration == nil {
+		return nil, fmt.Errorf("field `FreeDuration` is required and must be specified in Parking")
+	}
+	toSerialize["free_duration"] = o.FreeDuration
+
+	if o.State == nil {
+		return nil, fmt.Errorf("field `State` is required and must be specified in Parking")
+	}
+	toSerialize["state"] = o.State
+
+	if o.BlockReason != nil {
+		toSerialize["block_reason"] = o.BlockReason
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Parking) String() string {
+	var ret string
+	if o.Id == nil {
+		ret += "Id:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Id:%v, ", *o.Id)
+	}
+
+	if o.OutParkingNo == nil {
+		ret += "OutParkingNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutParkingNo:%v, ", *o.OutParkingNo)
+	}
+
+	if o.PlateNumber == nil {
+		ret += "PlateNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateNumber:%v, ", *o.PlateNumber)
+	}
+
+	if o.PlateColor == nil {
+		ret += "PlateColor:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateColor:%v, ", *o.PlateColor)
+	}
+
+	if o.StartTime == nil {
+		ret += "StartTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StartTime:%v, ", *o.StartTime)
+	}
+
+	if o.ParkingName == nil {
+		ret += "ParkingName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ParkingName:%v, ", *o.ParkingName)
+	}
+
+	if o.FreeDuration == nil {
+		ret += "FreeDuration:<nil>, "
+	} else {
+		ret += fmt.Sprintf("FreeDuration:%v, ", *o.FreeDuration)
+	}
+
+	if o.State == nil {
+		ret += "State:<nil>, "
+	} else {
+		ret += fmt.Sprintf("State:%v, ", *o.State)
+	}
+
+	if o.BlockReason == nil {
+		ret += "BlockReason:<nil>"
+	} else {
+		ret += fmt.Sprintf("BlockReason:%v", *o.BlockReason)
+	}
+
+	return fmt.Sprintf("Parking{%s}", ret)
+}
+
+func (o Parking) Clone() *Parking {
+	ret := Parking{}
+
+	if o.Id != nil {
+		ret.Id = new(string)
+		*ret.Id = *o.Id
+	}
+
+	if o.OutParkingNo != nil {
+		ret.OutParkingNo = new(string)
+		*ret.OutParkingNo = *o.OutParkingNo
+	}
+
+	if o.PlateNumber != nil {
+		ret.PlateNumber = new(string)
+		*ret.PlateNumber = *o.PlateNumber
+	}
+
+	if o.PlateColor != nil {
+		ret.PlateColor = new(PlateColor)
+		*ret.PlateColor = *o.PlateColor
+	}
+
+	if o.StartTime != nil {
+		ret.StartTime = new(time.Time)
+		*ret.StartTime = *o.StartTime
+	}
+
+	if o.ParkingName != nil {
+		ret.ParkingName = new(string)
+		*ret.ParkingName = *o.ParkingName
+	}
+
+	if o.FreeDuration != nil {
+		ret.FreeDuration = new(int64)
+		*ret.FreeDuration = *o.FreeDuration
+	}
+
+	if o.State != nil {
+		ret.State = new(ParkingState)
+		*ret.State = *o.State
+	}
+
+	if o.BlockReason != nil {
+		ret.BlockReason = new(string)
+		*ret.BlockReason = *o.BlockReason
+	}
+
+	return &ret
+}
+
+// HasBlockReason 应答中是否返回了 block_reason，o 为 nil 时返回 false
+func (o *Parking) HasBlockReason() bool {
+	return o != nil && o.BlockReason != nil
+}
+
+// ParkingInfo
+type ParkingInfo struct {
+	// 通行记录ID，创建通行记录时返回
+	ParkingId *string `json:"parking_id"`
+	// 车牌号，仅包括省份+车牌，不包括特殊字符
+	PlateNumber *string `json:"plate_number"`
+	// 车牌颜色
+	PlateColor *PlateColor `json:"plate_color"`
+	// 车辆驶入时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	StartTime *time.Time `json:"start_time"`
+	// 车辆驶出时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	EndTime *time.Time `json:"end_time"`
+	// 停车场名称
+	ParkingName *string `json:"parking_name"`
+	// 计费时长，单位为秒
+	ChargingDuration *int64 `json:"charging_duration"`
+	// 停车场或收费站出口的设备ID
+	DeviceId *string `json:"device_id"`
+}
+
+func (o ParkingInfo) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.ParkingId == nil {
+		return nil, fmt.Errorf("field `ParkingId` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["parking_id"] = o.ParkingId
+
+	if o.PlateNumber == nil {
+		return nil, fmt.Errorf("field `PlateNumber` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["plate_number"] = o.PlateNumber
+
+	if o.PlateColor == nil {
+		return nil, fmt.Errorf("field `PlateColor` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["plate_color"] = o.PlateColor
+
+	if o.StartTime == nil {
+		return nil, fmt.Errorf("field `StartTime` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["start_time"] = o.StartTime.Format(time.RFC3339)
+
+	if o.EndTime == nil {
+		return nil, fmt.Errorf("field `EndTime` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["end_time"] = o.EndTime.Format(time.RFC3339)
+
+	if o.ParkingName == nil {
+		return nil, fmt.Errorf("field `ParkingName` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["parking_name"] = o.ParkingName
+
+	if o.ChargingDuration == nil {
+		return nil, fmt.Errorf("field `ChargingDuration` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["charging_duration"] = o.ChargingDuration
+
+	if o.DeviceId == nil {
+		return nil, fmt.Errorf("field `DeviceId` is required and must be specified in ParkingInfo")
+	}
+	toSerialize["device_id"] = o.DeviceId
+	return json.Marshal(toSerialize)
+}
+
+func (o ParkingInfo) String() string {
+	var ret string
+	if o.ParkingId == nil {
+		ret += "ParkingId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ParkingId:%v, ", *o.ParkingId)
+	}
+
+	if o.PlateNumber == nil {
+		ret += "PlateNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateNumber:%v, ", *o.PlateNumber)
+	}
+
+	if o.PlateColor == nil {
+		ret += "PlateColor:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateColor:%v, ", *o.PlateColor)
+	}
+
+	if o.StartTime == nil {
+		ret += "StartTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("StartTime:%v, ", *o.StartTime)
+	}
+
+	if o.EndTime == nil {
+		ret += "EndTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("EndTime:%v, ", *o.EndTime)
+	}
+
+	if o.ParkingName == nil {
+		ret += "ParkingName:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ParkingName:%v, ", *o.ParkingName)
+	}
+
+	if o.ChargingDuration == nil {
+		ret += "ChargingDuration:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ChargingDuration:%v, ", *o.ChargingDuration)
+	}
+
+	if o.DeviceId == nil {
+		ret += "DeviceId:<nil>"
+	} else {
+		ret += fmt.Sprintf("DeviceId:%v", *o.DeviceId)
+	}
+
+	return fmt.Sprintf("ParkingInfo{%s}", ret)
+}
+
+func (o ParkingInfo) Clone() *ParkingInfo {
+	ret := ParkingInfo{}
+
+	if o.ParkingId != nil {
+		ret.ParkingId = new(string)
+		*ret.ParkingId = *o.ParkingId
+	}
+
+	if o.PlateNumber != nil {
+		ret.PlateNumber = new(string)
+		*ret.PlateNumber = *o.PlateNumber
+	}
+
+	if o.PlateColor != nil {
+		ret.PlateColor = new(PlateColor)
+		*ret.PlateColor = *o.PlateColor
+	}
+
+	if o.StartTime != nil {
+		ret.StartTime = new(time.Time)
+		*ret.StartTime = *o.StartTime
+	}
+
+	if o.EndTime != nil {
+		ret.EndTime = new(time.Time)
+		*ret.EndTime = *o.EndTime
+	}
+
+	if o.ParkingName != nil {
+		ret.ParkingName = new(string)
+		*ret.ParkingName = *o.ParkingName
+	}
+
+	if o.ChargingDuration != nil {
+		ret.ChargingDuration = new(int64)
+		*ret.ChargingDuration = *o.ChargingDuration
+	}
+
+	if o.DeviceId != nil {
+		ret.DeviceId = new(string)
+		*ret.DeviceId = *o.DeviceId
+	}
+
+	return &ret
+}
+
+// ParkingState * `NORMAL` - 正常 * `BLOCKED` - 不可用，可能的原因为用户已暂停或关闭服务
+type ParkingState string
+
+func (e ParkingState) Ptr() *ParkingState {
+	return &e
+}
+
+// Enums of ParkingState
+const (
+	PARKINGSTATE_NORMAL  ParkingState = "NORMAL"
+	PARKINGSTATE_BLOCKED ParkingState = "BLOCKED"
+)
+
+func (v *ParkingState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ParkingState(value)
+	for _, existing := range []ParkingState{"NORMAL", "BLOCKED"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ParkingState", value)
+}
+
+// Payer
+type Payer struct {
+	// 用户在服务商appid下的唯一标识
+	Openid *string `json:"openid,omitempty"`
+	// 用户在子商户appid下的唯一标识
+	SubOpenid *string `json:"sub_openid,omitempty"`
+}
+
+func (o Payer) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Openid != nil {
+		toSerialize["openid"] = o.Openid
+	}
+
+	if o.SubOpenid != nil {
+		toSerialize["sub_openid"] = o.SubOpenid
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Payer) String() string {
+	var ret string
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.SubOpenid == nil {
+		ret += "SubOpenid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubOpenid:%v", *o.SubOpenid)
+	}
+
+	return fmt.Sprintf("Payer{%s}", ret)
+}
+
+func (o Payer) Clone() *Payer {
+	ret := Payer{}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.SubOpenid != nil {
+		ret.SubOpenid = new(string)
+		*ret.SubOpenid = *o.SubOpenid
+	}
+
+	return &ret
+}
+
+// HasOpenid 应答中是否返回了 openid，o 为 nil 时返回 false
+func (o *Payer) HasOpenid() bool {
+	return o != nil && o.Openid != nil
+}
+
+// HasSubOpenid 应答中是否返回了 sub_openid，o 为 nil 时返回 false
+func (o *Payer) HasSubOpenid() bool {
+	return o != nil && o.SubOpenid != nil
+}
+
+// PlateColor * `BLUE` - 蓝色 * `GREEN` - 绿色 * `YELLOW` - 黄色 * `BLACK` - 黑色 * `WHITE` - 白色 * `LIMEGREEN` - 黄绿色
+type PlateColor string
+
+func (e PlateColor) Ptr() *PlateColor {
+	return &e
+}
+
+// Enums of PlateColor
+const (
+	PLATECOLOR_BLUE      PlateColor = "BLUE"
+	PLATECOLOR_GREEN     PlateColor = "GREEN"
+	PLATECOLOR_YELLOW    PlateColor = "YELLOW"
+	PLATECOLOR_BLACK     PlateColor = "BLACK"
+	PLATECOLOR_WHITE     PlateColor = "WHITE"
+	PLATECOLOR_LIMEGREEN PlateColor = "LIMEGREEN"
+)
+
+func (v *PlateColor) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := PlateColor(value)
+	for _, existing := range []PlateColor{"BLUE", "GREEN", "YELLOW", "BLACK", "WHITE", "LIMEGREEN"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid PlateColor", value)
+}
+
+// QueryTransactionRequest
+type QueryTransactionRequest struct {
+	// 商户系统内部订单号
+	OutTradeNo *string `json:"out_trade_no"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+}
+
+func (o QueryTransactionRequest) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in QueryTransactionRequest")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in QueryTransactionRequest")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+	return json.Marshal(toSerialize)
+}
+
+func (o QueryTransactionRequest) String() string {
+	var ret string
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>"
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v", *o.SubMchid)
+	}
+
+	return fmt.Sprintf("QueryTransactionRequest{%s}", ret)
+}
+
+func (o QueryTransactionRequest) Clone() *QueryTransactionRequest {
+	ret := QueryTransactionRequest{}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	return &ret
+}
+
+// ServiceState * `NORMAL` - 正常服务 * `PAUSE` - 暂停服务 * `OUT_SERVICE` - 未开通或已关闭服务
+type ServiceState string
+
+func (e ServiceState) Ptr() *ServiceState {
+	return &e
+}
+
+// Enums of ServiceState
+const (
+	SERVICESTATE_NORMAL      ServiceState = "NORMAL"
+	SERVICESTATE_PAUSE       ServiceState = "PAUSE"
+	SERVICESTATE_OUT_SERVICE ServiceState = "OUT_SERVICE"
+)
+
+func (v *ServiceState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := ServiceState(value)
+	for _, existing := range []ServiceState{"NORMAL", "PAUSE", "OUT_SERVICE"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid ServiceState", value)
+}
+
+// TradeScene * `PARKING` - 停车场景
+type TradeScene string
+
+func (e TradeScene) Ptr() *TradeScene {
+	return &e
+}
+
+// Enums of TradeScene
+const (
+	TRADESCENE_PARKING TradeScene = "PARKING"
+)
+
+func (v *TradeScene) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := TradeScene(value)
+	for _, existing := range []TradeScene{"PARKING"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid TradeScene", value)
+}
+
+// TradeState * `SUCCESS` - 扣费成功 * `ACCEPTED` - 已受理，扣费中 * `PAY_FAIL` - 扣费失败 * `REFUND` - 转入退款
+type TradeState string
+
+func (e TradeState) Ptr() *TradeState {
+	return &e
+}
+
+// Enums of TradeState
+const (
+	TRADESTATE_SUCCESS  TradeState = "SUCCESS"
+	TRADESTATE_ACCEPTED TradeState = "ACCEPTED"
+	TRADESTATE_PAY_FAIL TradeState = "PAY_FAIL"
+	TRADESTATE_REFUND   TradeState = "REFUND"
+)
+
+func (v *TradeState) UnmarshalJSON(src []byte) error {
+	var value string
+	err := json.Unmarshal(src, &value)
+	if err != nil {
+		return err
+	}
+	enumTypeValue := TradeState(value)
+	for _, existing := range []TradeState{"SUCCESS", "ACCEPTED", "PAY_FAIL", "REFUND"} {
+		if existing == enumTypeValue {
+			*v = enumTypeValue
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%+v is not a valid TradeState", value)
+}
+
+// Transaction
+type Transaction struct {
+	// 服务商appid
+	Appid *string `json:"appid"`
+	// 子商户appid
+	SubAppid *string `json:"sub_appid,omitempty"`
+	// 服务商商户号
+	SpMchid *string `json:"sp_mchid"`
+	// 子商户号
+	SubMchid *string `json:"sub_mchid"`
+	// 商品描述
+	Description *string `json:"description,omitempty"`
+	// 订单创建时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 商户系统内部订单号
+	OutTradeNo *string `json:"out_trade_no"`
+	// 微信支付订单号
+	TransactionId *string `json:"transaction_id,omitempty"`
+	// 交易状态
+	TradeState *TradeState `json:"trade_state"`
+	// 交易状态描述
+	TradeStateDescription *string `json:"trade_state_description,omitempty"`
+	// 支付完成时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	SuccessTime *time.Time `json:"success_time,omitempty"`
+	// 付款银行类型
+	BankType *string `json:"bank_type,omitempty"`
+	// 用户是否已还款，扣费失败后用户主动还款时为Y
+	UserRepaid *string `json:"user_repaid,omitempty"`
+	// 交易场景
+	TradeScene *TradeScene `json:"trade_scene,omitempty"`
+	// 附加数据
+	Attach *string `json:"attach,omitempty"`
+	// 支付者信息
+	Payer *Payer `json:"payer,omitempty"`
+	// 订单金额信息
+	Amount *TransactionAmountDetail `json:"amount,omitempty"`
+	// 通行信息
+	ParkingInfo *ParkingInfo `json:"parking_info,omitempty"`
+}
+
+func (o Transaction) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Appid == nil {
+		return nil, fmt.Errorf("field `Appid` is required and must be specified in Transaction")
+	}
+	toSerialize["appid"] = o.Appid
+
+	if o.SubAppid != nil {
+		toSerialize["sub_appid"] = o.SubAppid
+	}
+
+	if o.SpMchid == nil {
+		return nil, fmt.Errorf("field `SpMchid` is required and must be specified in Transaction")
+	}
+	toSerialize["sp_mchid"] = o.SpMchid
+
+	if o.SubMchid == nil {
+		return nil, fmt.Errorf("field `SubMchid` is required and must be specified in Transaction")
+	}
+	toSerialize["sub_mchid"] = o.SubMchid
+
+	if o.Description != nil {
+		toSerialize["description"] = o.Description
+	}
+
+	if o.CreateTime != nil {
+		toSerialize["create_time"] = o.CreateTime.Format(time.RFC3339)
+	}
+
+	if o.OutTradeNo == nil {
+		return nil, fmt.Errorf("field `OutTradeNo` is required and must be specified in Transaction")
+	}
+	toSerialize["out_trade_no"] = o.OutTradeNo
+
+	if o.TransactionId != nil {
+		toSerialize["transaction_id"] = o.TransactionId
+	}
+
+	if o.TradeState == nil {
+		return nil, fmt.Errorf("field `TradeState` is required and must be specified in Transaction")
+	}
+	toSerialize["trade_state"] = o.TradeState
+
+	if o.TradeStateDescription != nil {
+		toSerialize["trade_state_description"] = o.TradeStateDescription
+	}
+
+	if o.SuccessTime != nil {
+		toSerialize["success_time"] = o.SuccessTime.Format(time.RFC3339)
+	}
+
+	if o.BankType != nil {
+		toSerialize["bank_type"] = o.BankType
+	}
+
+	if o.UserRepaid != nil {
+		toSerialize["user_repaid"] = o.UserRepaid
+	}
+
+	if o.TradeScene != nil {
+		toSerialize["trade_scene"] = o.TradeScene
+	}
+
+	if o.Attach != nil {
+		toSerialize["attach"] = o.Attach
+	}
+
+	if o.Payer != nil {
+		toSerialize["payer"] = o.Payer
+	}
+
+	if o.Amount != nil {
+		toSerialize["amount"] = o.Amount
+	}
+
+	if o.ParkingInfo != nil {
+		toSerialize["parking_info"] = o.ParkingInfo
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o Transaction) String() string {
+	var ret string
+	if o.Appid == nil {
+		ret += "Appid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Appid:%v, ", *o.Appid)
+	}
+
+	if o.SubAppid == nil {
+		ret += "SubAppid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubAppid:%v, ", *o.SubAppid)
+	}
+
+	if o.SpMchid == nil {
+		ret += "SpMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SpMchid:%v, ", *o.SpMchid)
+	}
+
+	if o.SubMchid == nil {
+		ret += "SubMchid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SubMchid:%v, ", *o.SubMchid)
+	}
+
+	if o.Description == nil {
+		ret += "Description:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Description:%v, ", *o.Description)
+	}
+
+	if o.CreateTime == nil {
+		ret += "CreateTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("CreateTime:%v, ", *o.CreateTime)
+	}
+
+	if o.OutTradeNo == nil {
+		ret += "OutTradeNo:<nil>, "
+	} else {
+		ret += fmt.Sprintf("OutTradeNo:%v, ", *o.OutTradeNo)
+	}
+
+	if o.TransactionId == nil {
+		ret += "TransactionId:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TransactionId:%v, ", *o.TransactionId)
+	}
+
+	if o.TradeState == nil {
+		ret += "TradeState:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeState:%v, ", *o.TradeState)
+	}
+
+	if o.TradeStateDescription == nil {
+		ret += "TradeStateDescription:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeStateDescription:%v, ", *o.TradeStateDescription)
+	}
+
+	if o.SuccessTime == nil {
+		ret += "SuccessTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("SuccessTime:%v, ", *o.SuccessTime)
+	}
+
+	if o.BankType == nil {
+		ret += "BankType:<nil>, "
+	} else {
+		ret += fmt.Sprintf("BankType:%v, ", *o.BankType)
+	}
+
+	if o.UserRepaid == nil {
+		ret += "UserRepaid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("UserRepaid:%v, ", *o.UserRepaid)
+	}
+
+	if o.TradeScene == nil {
+		ret += "TradeScene:<nil>, "
+	} else {
+		ret += fmt.Sprintf("TradeScene:%v, ", *o.TradeScene)
+	}
+
+	if o.Attach == nil {
+		ret += "Attach:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Attach:%v, ", *o.Attach)
+	}
+
+	ret += fmt.Sprintf("Payer:%v, ", o.Payer)
+
+	ret += fmt.Sprintf("Amount:%v, ", o.Amount)
+
+	ret += fmt.Sprintf("ParkingInfo:%v", o.ParkingInfo)
+
+	return fmt.Sprintf("Transaction{%s}", ret)
+}
+
+func (o Transaction) Clone() *Transaction {
+	ret := Transaction{}
+
+	if o.Appid != nil {
+		ret.Appid = new(string)
+		*ret.Appid = *o.Appid
+	}
+
+	if o.SubAppid != nil {
+		ret.SubAppid = new(string)
+		*ret.SubAppid = *o.SubAppid
+	}
+
+	if o.SpMchid != nil {
+		ret.SpMchid = new(string)
+		*ret.SpMchid = *o.SpMchid
+	}
+
+	if o.SubMchid != nil {
+		ret.SubMchid = new(string)
+		*ret.SubMchid = *o.SubMchid
+	}
+
+	if o.Description != nil {
+		ret.Description = new(string)
+		*ret.Description = *o.Description
+	}
+
+	if o.CreateTime != nil {
+		ret.CreateTime = new(time.Time)
+		*ret.CreateTime = *o.CreateTime
+	}
+
+	if o.OutTradeNo != nil {
+		ret.OutTradeNo = new(string)
+		*ret.OutTradeNo = *o.OutTradeNo
+	}
+
+	if o.TransactionId != nil {
+		ret.TransactionId = new(string)
+		*ret.TransactionId = *o.TransactionId
+	}
+
+	if o.TradeState != nil {
+		ret.TradeState = new(TradeState)
+		*ret.TradeState = *o.TradeState
+	}
+
+	if o.TradeStateDescription != nil {
+		ret.TradeStateDescription = new(string)
+		*ret.TradeStateDescription = *o.TradeStateDescription
+	}
+
+	if o.SuccessTime != nil {
+		ret.SuccessTime = new(time.Time)
+		*ret.SuccessTime = *o.SuccessTime
+	}
+
+	if o.BankType != nil {
+		ret.BankType = new(string)
+		*ret.BankType = *o.BankType
+	}
+
+	if o.UserRepaid != nil {
+		ret.UserRepaid = new(string)
+		*ret.UserRepaid = *o.UserRepaid
+	}
+
+	if o.TradeScene != nil {
+		ret.TradeScene = new(TradeScene)
+		*ret.TradeScene = *o.TradeScene
+	}
+
+	if o.Attach != nil {
+		ret.Attach = new(string)
+		*ret.Attach = *o.Attach
+	}
+
+	if o.Payer != nil {
+		ret.Payer = o.Payer.Clone()
+	}
+
+	if o.Amount != nil {
+		ret.Amount = o.Amount.Clone()
+	}
+
+	if o.ParkingInfo != nil {
+		ret.ParkingInfo = o.ParkingInfo.Clone()
+	}
+
+	return &ret
+}
+
+// HasSubAppid 应答中是否返回了 sub_appid，o 为 nil 时返回 false
+func (o *Transaction) HasSubAppid() bool {
+	return o != nil && o.SubAppid != nil
+}
+
+// HasDescription 应答中是否返回了 description，o 为 nil 时返回 false
+func (o *Transaction) HasDescription() bool {
+	return o != nil && o.Description != nil
+}
+
+// HasCreateTime 应答中是否返回了 create_time，o 为 nil 时返回 false
+func (o *Transaction) HasCreateTime() bool {
+	return o != nil && o.CreateTime != nil
+}
+
+// HasTransactionId 应答中是否返回了 transaction_id，o 为 nil 时返回 false
+func (o *Transaction) HasTransactionId() bool {
+	return o != nil && o.TransactionId != nil
+}
+
+// HasTradeStateDescription 应答中是否返回了 trade_state_description，o 为 nil 时返回 false
+func (o *Transaction) HasTradeStateDescription() bool {
+	return o != nil && o.TradeStateDescription != nil
+}
+
+// HasSuccessTime 应答中是否返回了 success_time，o 为 nil 时返回 false
+func (o *Transaction) HasSuccessTime() bool {
+	return o != nil && o.SuccessTime != nil
+}
+
+// HasBankType 应答中是否返回了 bank_type，o 为 nil 时返回 false
+func (o *Transaction) HasBankType() bool {
+	return o != nil && o.BankType != nil
+}
+
+// HasUserRepaid 应答中是否返回了 user_repaid，o 为 nil 时返回 false
+func (o *Transaction) HasUserRepaid() bool {
+	return o != nil && o.UserRepaid != nil
+}
+
+// HasTradeScene 应答中是否返回了 trade_scene，o 为 nil 时返回 false
+func (o *Transaction) HasTradeScene() bool {
+	return o != nil && o.TradeScene != nil
+}
+
+// HasAttach 应答中是否返回了 attach，o 为 nil 时返回 false
+func (o *Transaction) HasAttach() bool {
+	return o != nil && o.Attach != nil
+}
+
+// HasPayer 应答中是否返回了 payer，o 为 nil 时返回 false
+func (o *Transaction) HasPayer() bool {
+	return o != nil && o.Payer != nil
+}
+
+// HasAmount 应答中是否返回了 amount，o 为 nil 时返回 false
+func (o *Transaction) HasAmount() bool {
+	return o != nil && o.Amount != nil
+}
+
+// HasParkingInfo 应答中是否返回了 parking_info，o 为 nil 时返回 false
+func (o *Transaction) HasParkingInfo() bool {
+	return o != nil && o.ParkingInfo != nil
+}
+
+// TransactionAmount
+type TransactionAmount struct {
+	// 订单总金额，单位为分
+	Total *int64 `json:"total"`
+	// 符合ISO 4217标准的三位字母代码，目前只支持人民币：CNY
+	Currency *string `json:"currency,omitempty"`
+}
+
+func (o TransactionAmount) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in TransactionAmount")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TransactionAmount) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>"
+	} else {
+		ret += fmt.Sprintf("Currency:%v", *o.Currency)
+	}
+
+	return fmt.Sprintf("TransactionAmount{%s}", ret)
+}
+
+func (o TransactionAmount) Clone() *TransactionAmount {
+	ret := TransactionAmount{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	return &ret
+}
+
+// TransactionAmountDetail
+type TransactionAmountDetail struct {
+	// 订单总金额，单位为分
+	Total *int64 `json:"total"`
+	// 用户支付金额，单位为分
+	PayerTotal *int64 `json:"payer_total,omitempty"`
+	// 货币类型
+	Currency *string `json:"currency,omitempty"`
+}
+
+func (o TransactionAmountDetail) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.Total == nil {
+		return nil, fmt.Errorf("field `Total` is required and must be specified in TransactionAmountDetail")
+	}
+	toSerialize["total"] = o.Total
+
+	if o.PayerTotal != nil {
+		toSerialize["payer_total"] = o.PayerTotal
+	}
+
+	if o.Currency != nil {
+		toSerialize["currency"] = o.Currency
+	}
+	return json.Marshal(toSerialize)
+}
+
+func (o TransactionAmountDetail) String() string {
+	var ret string
+	if o.Total == nil {
+		ret += "Total:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Total:%v, ", *o.Total)
+	}
+
+	if o.PayerTotal == nil {
+		ret += "PayerTotal:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PayerTotal:%v, ", *o.PayerTotal)
+	}
+
+	if o.Currency == nil {
+		ret += "Currency:<nil>"
+	} else {
+		ret += fmt.Sprintf("Currency:%v", *o.Currency)
+	}
+
+	return fmt.Sprintf("TransactionAmountDetail{%s}", ret)
+}
+
+func (o TransactionAmountDetail) Clone() *TransactionAmountDetail {
+	ret := TransactionAmountDetail{}
+
+	if o.Total != nil {
+		ret.Total = new(int64)
+		*ret.Total = *o.Total
+	}
+
+	if o.PayerTotal != nil {
+		ret.PayerTotal = new(int64)
+		*ret.PayerTotal = *o.PayerTotal
+	}
+
+	if o.Currency != nil {
+		ret.Currency = new(string)
+		*ret.Currency = *o.Currency
+	}
+
+	return &ret
+}
+
+// HasPayerTotal 应答中是否返回了 payer_total，o 为 nil 时返回 false
+func (o *TransactionAmountDetail) HasPayerTotal() bool {
+	return o != nil && o.PayerTotal != nil
+}
+
+// HasCurrency 应答中是否返回了 currency，o 为 nil 时返回 false
+func (o *TransactionAmountDetail) HasCurrency() bool {
+	return o != nil && o.Currency != nil
+}
+
+// VehicleService
+type VehicleService struct {
+	// 车牌号，仅包括省份+车牌，不包括特殊字符
+	PlateNumber *string `json:"plate_number"`
+	// 车牌颜色
+	PlateColor *PlateColor `json:"plate_color"`
+	// 车主服务开通时间，遵循rfc3339标准格式，格式为YYYY-MM-DDTHH:mm:ss+TIMEZONE，YYYY-MM-DD表示年月日，T出现在字符串中，表示time元素的开头，HH:mm:ss表示时分秒，TIMEZONE表示时区（+08:00表示东八区时间，领先UTC 8小时，即北京时间）。例如：2015-05-20T13:29:35+08:00表示，北京时间2015年5月20日13点29分35秒。
+	ServiceOpenTime *time.Time `json:"service_open_time"`
+	// 用户在appid下的唯一标识
+	Openid *string `json:"openid"`
+	// 车主服务状态
+	ServiceState *ServiceState `json:"service_state"`
+}
+
+func (o VehicleService) MarshalJSON() ([]byte, error) {
+	toSerialize := map[string]interface{}{}
+
+	if o.PlateNumber == nil {
+		return nil, fmt.Errorf("field `PlateNumber` is required and must be specified in VehicleService")
+	}
+	toSerialize["plate_number"] = o.PlateNumber
+
+	if o.PlateColor == nil {
+		return nil, fmt.Errorf("field `PlateColor` is required and must be specified in VehicleService")
+	}
+	toSerialize["plate_color"] = o.PlateColor
+
+	if o.ServiceOpenTime == nil {
+		return nil, fmt.Errorf("field `ServiceOpenTime` is required and must be specified in VehicleService")
+	}
+	toSerialize["service_open_time"] = o.ServiceOpenTime.Format(time.RFC3339)
+
+	if o.Openid == nil {
+		return nil, fmt.Errorf("field `Openid` is required and must be specified in VehicleService")
+	}
+	toSerialize["openid"] = o.Openid
+
+	if o.ServiceState == nil {
+		return nil, fmt.Errorf("field `ServiceState` is required and must be specified in VehicleService")
+	}
+	toSerialize["service_state"] = o.ServiceState
+	return json.Marshal(toSerialize)
+}
+
+func (o VehicleService) String() string {
+	var ret string
+	if o.PlateNumber == nil {
+		ret += "PlateNumber:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateNumber:%v, ", *o.PlateNumber)
+	}
+
+	if o.PlateColor == nil {
+		ret += "PlateColor:<nil>, "
+	} else {
+		ret += fmt.Sprintf("PlateColor:%v, ", *o.PlateColor)
+	}
+
+	if o.ServiceOpenTime == nil {
+		ret += "ServiceOpenTime:<nil>, "
+	} else {
+		ret += fmt.Sprintf("ServiceOpenTime:%v, ", *o.ServiceOpenTime)
+	}
+
+	if o.Openid == nil {
+		ret += "Openid:<nil>, "
+	} else {
+		ret += fmt.Sprintf("Openid:%v, ", *o.Openid)
+	}
+
+	if o.ServiceState == nil {
+		ret += "ServiceState:<nil>"
+	} else {
+		ret += fmt.Sprintf("ServiceState:%v", *o.ServiceState)
+	}
+
+	return fmt.Sprintf("VehicleService{%s}", ret)
+}
+
+func (o VehicleService) Clone() *VehicleService {
+	ret := VehicleService{}
+
+	if o.PlateNumber != nil {
+		ret.PlateNumber = new(string)
+		*ret.PlateNumber = *o.PlateNumber
+	}
+
+	if o.PlateColor != nil {
+		ret.PlateColor = new(PlateColor)
+		*ret.PlateColor = *o.PlateColor
+	}
+
+	if o.ServiceOpenTime != nil {
+		ret.ServiceOpenTime = new(time.Time)
+		*ret.ServiceOpenTime = *o.ServiceOpenTime
+	}
+
+	if o.Openid != nil {
+		ret.Openid = new(string)
+		*ret.Openid = *o.Openid
+	}
+
+	if o.ServiceState != nil {
+		ret.ServiceState = new(ServiceState)
+		*ret.ServiceState = *o.ServiceState
+	}
+
+	return &ret
+}
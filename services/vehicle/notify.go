@@ -0,0 +1,48 @@
+package vehicle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+)
+
+// ParkingNotifyFunc 通行记录状态变更通知处理函数，返回值的含义同 notify.HandleFunc
+type ParkingNotifyFunc func(ctx context.Context, req *notify.Request, parking *Parking) error
+
+// TransactionNotifyFunc 扣费结果通知处理函数，返回值的含义同 notify.HandleFunc
+type TransactionNotifyFunc func(ctx context.Context, req *notify.Request, transaction *Transaction) error
+
+// HandleParkingNotify 将 fn 包装为 notify.HandleFunc：将通知内容解析为 Parking 后调用 fn
+//
+// 用户暂停或关闭车主服务后通行记录状态变为 BLOCKED，此时无法使用该通行记录扣费。
+// 通知内容无法解析或不包含通行记录ID时以 notify.Reject 应答。
+func HandleParkingNotify(fn ParkingNotifyFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		parking := new(Parking)
+		if err := req.UnmarshalContent(parking); err != nil {
+			return notify.Reject(err)
+		}
+		if parking.Id == nil || *parking.Id == "" {
+			return notify.Reject(fmt.Errorf("parking notify %s has no id", req.ID))
+		}
+		return fn(ctx, req, parking)
+	}
+}
+
+// HandleTransactionNotify 将 fn 包装为 notify.HandleFunc：将通知内容解析为 Transaction 后调用 fn
+//
+// 扣费成功与扣费失败使用同一通知内容，处理函数可以通过 transaction.TradeState 区分。
+// 通知内容无法解析或不包含商户订单号时以 notify.Reject 应答。
+func HandleTransactionNotify(fn TransactionNotifyFunc) notify.HandleFunc {
+	return func(ctx context.Context, req *notify.Request) error {
+		transaction := new(Transaction)
+		if err := req.UnmarshalContent(transaction); err != nil {
+			return notify.Reject(err)
+		}
+		if transaction.OutTradeNo == nil || *transaction.OutTradeNo == "" {
+			return notify.Reject(fmt.Errorf("transaction notify %s has no out_trade_no", req.ID))
+		}
+		return fn(ctx, req, transaction)
+	}
+}
@@ -0,0 +1,48 @@
+package vehicle_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wechatpay-apiv3/wechatpay-go/core/notify"
+	"github.com/wechatpay-apiv3/wechatpay-go/services/vehicle"
+)
+
+func ExampleHandleTransactionNotify() {
+	handle := vehicle.HandleTransactionNotify(
+		func(ctx context.Context, req *notify.Request, transaction *vehicle.Transaction) error {
+			switch *transaction.TradeState {
+			case vehicle.TRADESTATE_SUCCESS:
+				fmt.Println("paid", *transaction.OutTradeNo, *transaction.Amount.PayerTotal)
+			case vehicle.TRADESTATE_PAY_FAIL:
+				// 扣费失败，等待用户还款后再次收到通知，此时 transaction.UserRepaid 为 Y
+				fmt.Println("pay fail", *transaction.OutTradeNo)
+			}
+			return nil
+		},
+	)
+
+	// 实际使用时，将 handle 作为 notify.Handler.HTTPHandler 的处理函数，由其完成验签与解密
+	_ = handle(context.Background(), &notify.Request{
+		ID: "EV-2018022511223320873",
+		Resource: &notify.EncryptedResource{
+			Plaintext: `{"appid":"wxcbda96de0b165486","sp_mchid":"1230000109","sub_mchid":"1900000109",` +
+				`"out_trade_no":"20150806125346","transaction_id":"1009660380201506130728806387","trade_state":"SUCCESS",` +
+				`"trade_scene":"PARKING","amount":{"total":888,"payer_total":888,"currency":"CNY"}}`,
+		},
+	})
+	// Output:
+	// paid 20150806125346 888
+}
+
+func ExampleHandleParkingNotify() {
+	var handler *notify.Handler
+
+	handle := vehicle.HandleParkingNotify(
+		func(ctx context.Context, req *notify.Request, parking *vehicle.Parking) error {
+			// 通行记录不可用时，车辆驶出后需要使用其它方式收费
+			return nil
+		},
+	)
+	_ = handler.HTTPHandler(handle)
+}
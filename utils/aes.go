@@ -4,6 +4,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/base64"
+	"fmt"
 )
 
 // DecryptAES256GCM 使用 AEAD_AES_256_GCM 算法进行解密
@@ -29,3 +30,22 @@ func DecryptAES256GCM(aesKey, associatedData, nonce, ciphertext string) (plainte
 	}
 	return string(dataBytes), nil
 }
+
+// EncryptAES256GCM 使用 AEAD_AES_256_GCM 算法进行加密，返回 Base64 编码的密文
+//
+// 与 DecryptAES256GCM 互逆，可用于构造回调通知等加密数据的测试用例。
+func EncryptAES256GCM(aesKey, associatedData, nonce, plaintext string) (ciphertext string, err error) {
+	c, err := aes.NewCipher([]byte(aesKey))
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(c)
+	if err != nil {
+		return "", err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return "", fmt.Errorf("nonce must be %d bytes, got %d", gcm.NonceSize(), len(nonce))
+	}
+	sealed := gcm.Seal(nil, []byte(nonce), []byte(plaintext), []byte(associatedData))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
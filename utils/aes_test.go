@@ -88,3 +88,20 @@ func TestDecryptAes256Gcm(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptAES256GCM(t *testing.T) {
+	ciphertext, err := EncryptAES256GCM(testAESUtilAPIV3Key, testAESUtilAssociatedData, testAESUtilNonce, testAESUtilPlaintext)
+	require.NoError(t, err)
+	assert.Equal(t, testAESUtilCiphertext, ciphertext)
+
+	ciphertext, err = EncryptAES256GCM(testAESUtilAPIV3Key, "", testAESUtilNonce, "中文")
+	require.NoError(t, err)
+	plaintext, err := DecryptAES256GCM(testAESUtilAPIV3Key, "", testAESUtilNonce, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "中文", plaintext)
+
+	_, err = EncryptAES256GCM("not a aes key", testAESUtilAssociatedData, testAESUtilNonce, testAESUtilPlaintext)
+	assert.Error(t, err)
+	_, err = EncryptAES256GCM(testAESUtilAPIV3Key, testAESUtilAssociatedData, "short", testAESUtilPlaintext)
+	assert.EqualError(t, err, "nonce must be 12 bytes, got 5")
+}
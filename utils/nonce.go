@@ -2,25 +2,96 @@ package utils
 
 import (
 	"crypto/rand"
+	"fmt"
 )
 
 const (
 	// NonceSymbols 随机字符串可用字符集
 	NonceSymbols = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	// NonceLength 随机字符串的长度
+	// NonceLength 随机字符串的长度，也是微信支付允许的最大长度
 	NonceLength = 32
+	// MinNonceLength 随机字符串的最小长度
+	MinNonceLength = 16
+	// MinNonceSymbols 随机字符串字符集的最少字符数
+	MinNonceSymbols = 10
 )
 
+var defaultNonceGenerator, _ = NewNonceGenerator(NonceLength, NonceSymbols)
+
+// NonceGenerator 使用 crypto/rand 生成指定长度与字符集的随机字符串
+//
+// 随机字节按字符集大小做拒绝采样，每个字符的出现概率相同
+type NonceGenerator struct {
+	length  int
+	symbols string
+	// 不小于 limit 的随机字节被丢弃，以免取模造成的偏差
+	limit int
+}
+
+// NewNonceGenerator 创建 NonceGenerator
+//
+// length 需在 MinNonceLength 与 NonceLength 之间；symbols 只能包含字母与数字，不能重复，且不少于 MinNonceSymbols 个字符
+func NewNonceGenerator(length int, symbols string) (*NonceGenerator, error) {
+	if length < MinNonceLength || length > NonceLength {
+		return nil, fmt.Errorf("nonce length must be between %d and %d, got %d", MinNonceLength, NonceLength, length)
+	}
+	if len(symbols) < MinNonceSymbols {
+		return nil, fmt.Errorf("nonce symbols must contain at least %d characters, got %d", MinNonceSymbols, len(symbols))
+	}
+	seen := make(map[byte]bool, len(symbols))
+	for i := 0; i < len(symbols); i++ {
+		c := symbols[i]
+		if !isNonceSymbol(c) {
+			return nil, fmt.Errorf("nonce symbols must only contain letters and digits, got %q", c)
+		}
+		if seen[c] {
+			return nil, fmt.Errorf("nonce symbols must not contain duplicate character %q", c)
+		}
+		seen[c] = true
+	}
+	return &NonceGenerator{length: length, symbols: symbols, limit: 256 - 256%len(symbols)}, nil
+}
+
+// GenerateNonce 生成一个随机字符串
+func (g *NonceGenerator) GenerateNonce() (string, error) {
+	nonce := make([]byte, 0, g.length)
+	// 多读取一些随机字节，通常一次读取即可完成
+	buf := make([]byte, g.length+g.length/2)
+	for len(nonce) < g.length {
+		if _, err := rand.Read(buf); err != nil {
+			return "", err
+		}
+		for _, b := range buf {
+			if int(b) >= g.limit {
+				continue
+			}
+			nonce = append(nonce, g.symbols[int(b)%len(g.symbols)])
+			if len(nonce) == g.length {
+				break
+			}
+		}
+	}
+	return string(nonce), nil
+}
+
 // GenerateNonce 生成一个长度为 NonceLength 的随机字符串（只包含大小写字母与数字）
 func GenerateNonce() (string, error) {
-	bytes := make([]byte, NonceLength)
-	_, err := rand.Read(bytes)
-	if err != nil {
-		return "", err
+	return defaultNonceGenerator.GenerateNonce()
+}
+
+// CheckNonce 检查随机字符串的长度在 MinNonceLength 与 NonceLength 之间，且只包含字母与数字
+func CheckNonce(nonce string) error {
+	if len(nonce) < MinNonceLength || len(nonce) > NonceLength {
+		return fmt.Errorf("nonce length must be between %d and %d, got %d", MinNonceLength, NonceLength, len(nonce))
 	}
-	symbolsByteLength := byte(len(NonceSymbols))
-	for i, b := range bytes {
-		bytes[i] = NonceSymbols[b%symbolsByteLength]
+	for i := 0; i < len(nonce); i++ {
+		if !isNonceSymbol(nonce[i]) {
+			return fmt.Errorf("nonce must only contain letters and digits, got %q", nonce[i])
+		}
 	}
-	return string(bytes), nil
+	return nil
+}
+
+func isNonceSymbol(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
 }
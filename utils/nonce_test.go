@@ -1,7 +1,11 @@
 package utils
 
 import (
+	"math/rand"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/quick"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,4 +21,92 @@ func TestGenerateNonce(t *testing.T) {
 	t.Log(s2)
 
 	assert.NotEqual(t, s1, s2)
+	assert.Len(t, s1, NonceLength)
+	assert.NoError(t, CheckNonce(s1))
+}
+
+// nonceConfig 随机生成的合法 NonceGenerator 配置
+type nonceConfig struct {
+	Length  int
+	Symbols string
+}
+
+// Generate 实现 quick.Generator
+func (nonceConfig) Generate(r *rand.Rand, _ int) reflect.Value {
+	symbols := []byte(NonceSymbols)
+	r.Shuffle(len(symbols), func(i, j int) { symbols[i], symbols[j] = symbols[j], symbols[i] })
+	return reflect.ValueOf(nonceConfig{
+		Length:  MinNonceLength + r.Intn(NonceLength-MinNonceLength+1),
+		Symbols: string(symbols[:MinNonceSymbols+r.Intn(len(symbols)-MinNonceSymbols+1)]),
+	})
+}
+
+func TestNonceGenerator_Property(t *testing.T) {
+	property := func(config nonceConfig) bool {
+		generator, err := NewNonceGenerator(config.Length, config.Symbols)
+		if err != nil {
+			return false
+		}
+		nonce, err := generator.GenerateNonce()
+		if err != nil || len(nonce) != config.Length || CheckNonce(nonce) != nil {
+			return false
+		}
+		for _, c := range nonce {
+			if !strings.ContainsRune(config.Symbols, c) {
+				return false
+			}
+		}
+		return true
+	}
+	assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 500}))
+}
+
+func TestNonceGenerator_Uniform(t *testing.T) {
+	// 62 个字符时，直接取模会使前 8 个字符的概率高出约 25%
+	const samples = 2000
+	counts := make(map[rune]int)
+	for i := 0; i < samples; i++ {
+		nonce, err := GenerateNonce()
+		require.NoError(t, err)
+		for _, c := range nonce {
+			counts[c]++
+		}
+	}
+	assert.Len(t, counts, len(NonceSymbols))
+
+	expected := float64(samples*NonceLength) / float64(len(NonceSymbols))
+	chiSquare := 0.0
+	for _, c := range NonceSymbols {
+		d := float64(counts[c]) - expected
+		chiSquare += d * d / expected
+	}
+	// 自由度为 61 时，chiSquare 超过 120 的概率约为 1e-5
+	assert.True(t, chiSquare < 120, "chi-square %f", chiSquare)
+}
+
+func TestNewNonceGenerator_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		length  int
+		symbols string
+	}{
+		{"too short", MinNonceLength - 1, NonceSymbols},
+		{"too long", NonceLength + 1, NonceSymbols},
+		{"too few symbols", NonceLength, "012345678"},
+		{"not alphanumeric", NonceLength, "0123456789-"},
+		{"duplicate symbols", NonceLength, "01234567890"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewNonceGenerator(tt.length, tt.symbols)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCheckNonce(t *testing.T) {
+	assert.NoError(t, CheckNonce(strings.Repeat("a", MinNonceLength)))
+	assert.Error(t, CheckNonce(strings.Repeat("a", MinNonceLength-1)))
+	assert.Error(t, CheckNonce(strings.Repeat("a", NonceLength+1)))
+	assert.Error(t, CheckNonce(strings.Repeat("a", MinNonceLength)+`"`))
 }
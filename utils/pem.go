@@ -26,7 +26,7 @@ func LoadCertificate(certificateStr string) (certificate *x509.Certificate, err
 	return certificate, nil
 }
 
-// LoadPrivateKey 通过私钥的文本内容加载私钥
+// LoadPrivateKey 通过私钥的文本内容加载私钥，加载的私钥已预计算 CRT 参数
 func LoadPrivateKey(privateKeyStr string) (privateKey *rsa.PrivateKey, err error) {
 	block, _ := pem.Decode([]byte(privateKeyStr))
 	if block == nil {
@@ -43,6 +43,8 @@ func LoadPrivateKey(privateKeyStr string) (privateKey *rsa.PrivateKey, err error
 	if !ok {
 		return nil, fmt.Errorf("%s is not rsa private key", privateKeyStr)
 	}
+	// 加载时预计算 CRT 参数，之后的签名无需重复计算，私钥也可以被并发使用
+	privateKey.Precompute()
 	return privateKey, nil
 }
 
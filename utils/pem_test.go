@@ -171,8 +171,11 @@ func TestLoadPrivateKey(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := LoadPrivateKey(tt.args.privateKeyStr)
+			privateKey, err := LoadPrivateKey(tt.args.privateKeyStr)
 			assert.Equal(t, tt.wantErr, err != nil)
+			if err == nil {
+				assert.NotNil(t, privateKey.Precomputed.Dp)
+			}
 		})
 	}
 }
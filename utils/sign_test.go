@@ -101,3 +101,18 @@ func TestSha256WithRsa(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkSignSHA256WithRSA(b *testing.B) {
+	privateKey, err := LoadPrivateKey(testAlgorithmPrivateKeyStr)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := SignSHA256WithRSA("source", privateKey); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}